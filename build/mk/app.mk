@@ -105,7 +105,9 @@ generate-api: lint-api ## 🤖 Generate API specs from OpenAPI definition.
 		--ext json \
 		--config .redocly.yaml \
 	&& \
-	cd services/svc-api-gateway/internal/tools && go generate .
+	(cd services/svc-api-gateway/internal/tools && go generate .) \
+	&& \
+	cd services/svc-api-gateway && go generate ./cmd/openapi-spec
 
 .PHONY: create-migration
 create-migration: ## 🗂️ Creates migration files based on a passed argument "migration_name".
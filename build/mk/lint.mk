@@ -33,3 +33,18 @@ lint-ci: check-docker ## 🤖 Run golangci-lint with JSON output for CI.
 lint-verbose: check-docker ## 📝 Run golangci-lint with verbose output for debugging.
 	$(call printMessage,"📝  Linting Go code (verbose)",$(INFO_CLR))
 	$(GOLANGCI_LINT_DOCKER) golangci-lint run --verbose ./...
+
+# buf Docker image version used to lint the protobuf contracts, pinned so lint
+# results do not change with new buf releases
+BUF_VERSION ?= 1.73.0
+
+# Docker run command for buf, working from the buf module in docs/contracts/proto
+BUF_DOCKER := docker run --rm \
+	-v "${CURDIR}/docs/contracts/proto:/workspace" \
+	-w /workspace \
+	bufbuild/buf:$(BUF_VERSION)
+
+.PHONY: proto-lint
+proto-lint: check-docker ## 📐 Lint the protobuf contracts against the buf.yaml rules.
+	$(call printMessage,"📐  Linting protobuf contracts",$(INFO_CLR))
+	$(BUF_DOCKER) lint
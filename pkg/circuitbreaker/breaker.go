@@ -23,7 +23,7 @@ func New[T any](cfg Config) *CircuitBreaker[T] {
 		return nil
 	}
 
-	cb := gobreaker.NewCircuitBreaker[T](gobreaker.Settings{
+	settings := gobreaker.Settings{
 		Name:        cfg.Name,
 		MaxRequests: uint32(cfg.MaxRequests),
 		Interval:    cfg.Interval,
@@ -31,7 +31,15 @@ func New[T any](cfg Config) *CircuitBreaker[T] {
 		ReadyToTrip: func(counts gobreaker.Counts) bool {
 			return counts.ConsecutiveFailures >= uint32(cfg.FailureThreshold)
 		},
-	})
+	}
+
+	if cfg.OnStateChange != nil {
+		settings.OnStateChange = func(name string, from, to gobreaker.State) {
+			cfg.OnStateChange(name, from.String(), to.String())
+		}
+	}
+
+	cb := gobreaker.NewCircuitBreaker[T](settings)
 
 	return &CircuitBreaker[T]{cb: cb}
 }
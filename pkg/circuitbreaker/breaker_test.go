@@ -211,6 +211,41 @@ func TestCircuitBreaker_HalfOpenState(t *testing.T) {
 	require.Equal(t, "recovered", result)
 }
 
+func TestCircuitBreaker_OnStateChange(t *testing.T) {
+	t.Parallel()
+
+	var transitions []string
+
+	cb := New[string](Config{
+		Name:             "state-change-test",
+		Enabled:          true,
+		MaxRequests:      1,
+		Interval:         100 * time.Millisecond,
+		Timeout:          100 * time.Millisecond,
+		FailureThreshold: 1,
+		OnStateChange: func(name, from, to string) {
+			require.Equal(t, "state-change-test", name)
+			transitions = append(transitions, from+"->"+to)
+		},
+	})
+	require.NotNil(t, cb)
+
+	// Trip the breaker.
+	_, _ = Execute(cb, func() (string, error) {
+		return "", errors.New("failure")
+	})
+
+	// Wait for timeout, then recover through half-open.
+	time.Sleep(150 * time.Millisecond)
+
+	_, err := Execute(cb, func() (string, error) {
+		return "recovered", nil
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"closed->open", "open->half-open", "half-open->closed"}, transitions)
+}
+
 func TestCircuitBreaker_TooManyRequests(t *testing.T) {
 	t.Parallel()
 
@@ -32,5 +32,9 @@ type (
 		// FailureThreshold is the number of consecutive failures required to
 		// trip the circuit breaker from closed to open state.
 		FailureThreshold uint
+
+		// OnStateChange is called whenever the circuit breaker changes state,
+		// e.g. from "closed" to "open". It is optional.
+		OnStateChange func(name, from, to string)
 	}
 )
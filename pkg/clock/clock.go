@@ -0,0 +1,74 @@
+package clock
+
+import (
+	"sync"
+	"time"
+)
+
+type (
+	// Clock tells the time, so code depending on it can be tested without waiting
+	// on the wall clock.
+	Clock interface {
+		Now() time.Time
+		Since(t time.Time) time.Duration
+		Sleep(d time.Duration)
+	}
+
+	// RealClock is the wall clock.
+	RealClock struct{}
+
+	// MockClock is a manually driven clock for tests. It only moves through
+	// Advance, Set and Sleep, and is safe for concurrent use.
+	MockClock struct {
+		mu  sync.RWMutex
+		now time.Time
+	}
+)
+
+var (
+	_ Clock = RealClock{}
+	_ Clock = (*MockClock)(nil)
+)
+
+func (RealClock) Now() time.Time { return time.Now() }
+
+func (RealClock) Since(t time.Time) time.Duration { return time.Since(t) }
+
+func (RealClock) Sleep(d time.Duration) { time.Sleep(d) }
+
+// NewMockClock creates a mock clock reading now.
+func NewMockClock(now time.Time) *MockClock {
+	return &MockClock{now: now}
+}
+
+func (c *MockClock) Now() time.Time {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.now
+}
+
+func (c *MockClock) Since(t time.Time) time.Duration {
+	return c.Now().Sub(t)
+}
+
+// Sleep returns at once, moving the clock forward by d as if the time had passed.
+func (c *MockClock) Sleep(d time.Duration) {
+	c.Advance(d)
+}
+
+// Advance moves the clock forward by d.
+func (c *MockClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+}
+
+// Set moves the clock to t.
+func (c *MockClock) Set(t time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = t
+}
@@ -0,0 +1,86 @@
+package clock
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMockClock(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name     string
+		run      func(c *MockClock)
+		expected time.Time
+	}{
+		{
+			name:     "reads the start time",
+			run:      func(*MockClock) {},
+			expected: start,
+		},
+		{
+			name:     "advance moves forward",
+			run:      func(c *MockClock) { c.Advance(90 * time.Second) },
+			expected: start.Add(90 * time.Second),
+		},
+		{
+			name:     "sleep advances without blocking",
+			run:      func(c *MockClock) { c.Sleep(time.Hour) },
+			expected: start.Add(time.Hour),
+		},
+		{
+			name:     "set jumps to the given time",
+			run:      func(c *MockClock) { c.Set(start.AddDate(0, 0, -1)) },
+			expected: start.AddDate(0, 0, -1),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := NewMockClock(start)
+			tc.run(c)
+
+			require.Equal(t, tc.expected, c.Now())
+			require.Equal(t, tc.expected.Sub(start), c.Since(start))
+		})
+	}
+}
+
+func TestMockClock_ConcurrentAdvance(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := NewMockClock(start)
+
+	var wg sync.WaitGroup
+
+	for range 100 {
+		wg.Go(func() {
+			c.Advance(time.Second)
+			_ = c.Now()
+		})
+	}
+
+	wg.Wait()
+
+	require.Equal(t, 100*time.Second, c.Since(start))
+}
+
+func TestRealClock(t *testing.T) {
+	t.Parallel()
+
+	var c RealClock
+
+	before := time.Now()
+	c.Sleep(time.Millisecond)
+
+	require.False(t, c.Now().Before(before))
+	require.GreaterOrEqual(t, c.Since(before), time.Millisecond)
+}
@@ -0,0 +1,176 @@
+package cursor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursorCodec_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		sortKey string
+		id      string
+		desc    bool
+	}{
+		{
+			name:    "ascending position",
+			sortKey: "2026-01-13T01:00:00Z",
+			id:      "0198f0a2-6b1c-7d3e-8f4a-1b2c3d4e5f60",
+		},
+		{
+			name:    "descending position",
+			sortKey: "iPhone",
+			id:      "0198f0a2-6b1c-7d3e-8f4a-1b2c3d4e5f61",
+			desc:    true,
+		},
+		{
+			name:    "sort key needing escaping",
+			sortKey: `"quoted" / ünïcode & more`,
+			id:      "0198f0a2-6b1c-7d3e-8f4a-1b2c3d4e5f62",
+		},
+	}
+
+	codec := NewCursor([]byte("test-secret"))
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			token, err := codec.Encode(tc.sortKey, tc.id, tc.desc)
+			require.NoError(t, err)
+			require.Regexp(t, `^[A-Za-z0-9_-]+$`, token, "tokens are URL-safe")
+
+			sortKey, id, desc, err := codec.Decode(token)
+			require.NoError(t, err)
+			require.Equal(t, tc.sortKey, sortKey)
+			require.Equal(t, tc.id, id)
+			require.Equal(t, tc.desc, desc)
+		})
+	}
+}
+
+func TestCursorCodec_Decode(t *testing.T) {
+	t.Parallel()
+
+	codec := NewCursor([]byte("test-secret"))
+
+	token, err := codec.Encode("2026-01-13T01:00:00Z", "0198f0a2-6b1c-7d3e-8f4a-1b2c3d4e5f60", true)
+	require.NoError(t, err)
+
+	foreign, err := NewCursor([]byte("other-secret")).Encode("2026-01-13T01:00:00Z", "0198f0a2-6b1c-7d3e-8f4a-1b2c3d4e5f60", true)
+	require.NoError(t, err)
+
+	cases := []struct {
+		name        string
+		token       string
+		expectedErr error
+	}{
+		{
+			name: "empty token is the first page",
+		},
+		{
+			name:        "token signed with another secret",
+			token:       foreign,
+			expectedErr: ErrInvalidCursor,
+		},
+		{
+			name:        "not base64",
+			token:       "not a cursor!",
+			expectedErr: ErrInvalidCursor,
+		},
+		{
+			name:        "shorter than a signature",
+			token:       "c2hvcnQ",
+			expectedErr: ErrInvalidCursor,
+		},
+		{
+			name:        "truncated token",
+			token:       token[:len(token)-4],
+			expectedErr: ErrInvalidCursor,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			sortKey, id, desc, err := codec.Decode(tc.token)
+
+			require.ErrorIs(t, err, tc.expectedErr)
+			require.Empty(t, sortKey)
+			require.Empty(t, id)
+			require.False(t, desc)
+		})
+	}
+}
+
+func TestCursorCodec_DecodeRejectsTampering(t *testing.T) {
+	t.Parallel()
+
+	codec := NewCursor([]byte("test-secret"))
+
+	token, err := codec.Encode("iPhone", "0198f0a2-6b1c-7d3e-8f4a-1b2c3d4e5f60", false)
+	require.NoError(t, err)
+
+	for i := range len(token) {
+		tampered := []byte(token)
+		if tampered[i] == 'A' {
+			tampered[i] = 'B'
+		} else {
+			tampered[i] = 'A'
+		}
+
+		_, _, _, err := codec.Decode(string(tampered))
+		require.ErrorIs(t, err, ErrInvalidCursor, "changing byte %d must invalidate the token", i)
+	}
+}
+
+func TestCursorCodec_EncodeValue(t *testing.T) {
+	t.Parallel()
+
+	type position struct {
+		Field string `json:"f"`
+		Value string `json:"v"`
+		Next  bool   `json:"n"`
+	}
+
+	codec := NewCursor([]byte("test-secret"))
+	expected := position{Field: "-name", Value: "iPhone", Next: true}
+
+	token, err := codec.EncodeValue(expected)
+	require.NoError(t, err)
+
+	var decoded position
+	require.NoError(t, codec.DecodeValue(token, &decoded))
+	require.Equal(t, expected, decoded)
+
+	var foreign position
+	err = NewCursor([]byte("other-secret")).DecodeValue(token, &foreign)
+	require.ErrorIs(t, err, ErrInvalidCursor)
+	require.Zero(t, foreign)
+}
+
+func TestCursorCodec_Verify(t *testing.T) {
+	t.Parallel()
+
+	codec := NewCursor([]byte("test-secret"))
+
+	token, err := codec.EncodeValue(map[string]string{"f": "-name", "v": "iPhone"})
+	require.NoError(t, err)
+
+	foreign, err := NewCursor([]byte("other-secret")).Encode("iPhone", "0198f0a2-6b1c-7d3e-8f4a-1b2c3d4e5f60", false)
+	require.NoError(t, err)
+
+	require.NoError(t, codec.Verify(token))
+	require.ErrorIs(t, codec.Verify(foreign), ErrInvalidCursor)
+	require.ErrorIs(t, codec.Verify("not a cursor"), ErrInvalidCursor)
+
+	require.True(t, WellFormed(token))
+	require.True(t, WellFormed(foreign), "the signature is not checked")
+	require.False(t, WellFormed("not a cursor"))
+	require.False(t, WellFormed("c2hvcnQ"), "shorter than a signature")
+	require.False(t, WellFormed(""))
+}
@@ -33,7 +33,7 @@ func (d commandMetricsDecorator[C, R]) Handle(ctx context.Context, cmd C) (resul
 
 		end := time.Since(start)
 
-		d.client.Inc(ctx, fmt.Sprintf("commands.%s.duration", actionName), int64(end.Seconds()))
+		_ = d.client.Observe(ctx, fmt.Sprintf("commands.%s.duration", actionName), end.Seconds())
 
 		if err == nil {
 			d.client.Inc(ctx, fmt.Sprintf("commands.%s.success", actionName), 1)
@@ -57,7 +57,7 @@ func (d queryMetricsDecorator[Q, R]) Execute(ctx context.Context, query Q) (resu
 
 		end := time.Since(start)
 
-		d.client.Inc(ctx, fmt.Sprintf("queries.%s.duration", actionName), int64(end.Seconds()))
+		_ = d.client.Observe(ctx, fmt.Sprintf("queries.%s.duration", actionName), end.Seconds())
 
 		if err == nil {
 			d.client.Inc(ctx, fmt.Sprintf("queries.%s.success", actionName), 1)
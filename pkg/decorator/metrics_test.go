@@ -0,0 +1,105 @@
+package decorator_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/architeacher/devices/pkg/decorator"
+	"github.com/architeacher/devices/pkg/logger"
+	"github.com/architeacher/devices/pkg/metrics/recording"
+)
+
+type (
+	createThing struct{}
+
+	createThingHandler struct {
+		err error
+	}
+
+	listThings struct{}
+
+	listThingsHandler struct{}
+)
+
+func (h createThingHandler) Handle(context.Context, createThing) (string, error) {
+	time.Sleep(time.Millisecond)
+
+	return "created", h.err
+}
+
+func (listThingsHandler) Execute(context.Context, listThings) (testResult, error) {
+	return testResult{Value: "things"}, nil
+}
+
+func TestCommandMetricsDecorator(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name          string
+		err           error
+		expectedCount string
+	}{
+		{
+			name:          "success",
+			expectedCount: "commands.creatething.success",
+		},
+		{
+			name:          "failure",
+			err:           errors.New("boom"),
+			expectedCount: "commands.creatething.failure",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			metricsClient := recording.NewMetricsClient()
+			handler := decorator.ApplyCommandDecorators[createThing, string](
+				createThingHandler{err: tc.err},
+				logger.NewTestLogger(),
+				metricsClient,
+				noop.NewTracerProvider(),
+			)
+
+			_, err := handler.Handle(t.Context(), createThing{})
+			require.ErrorIs(t, err, tc.err)
+
+			durations := metricsClient.Records("commands.creatething.duration")
+			require.Len(t, durations, 1)
+			require.Equal(t, recording.KindHistogram, durations[0].Kind)
+
+			seconds, ok := durations[0].Value.(float64)
+			require.True(t, ok)
+			require.Greater(t, seconds, 0.0, "sub-second durations must not be truncated")
+
+			require.Equal(t, float64(1), metricsClient.Sum(tc.expectedCount))
+		})
+	}
+}
+
+func TestQueryMetricsDecorator(t *testing.T) {
+	t.Parallel()
+
+	metricsClient := recording.NewMetricsClient()
+	handler := decorator.ApplyQueryDecorators[listThings, testResult](
+		listThingsHandler{},
+		logger.NewTestLogger(),
+		metricsClient,
+		noop.NewTracerProvider(),
+	)
+
+	result, err := handler.Execute(t.Context(), listThings{})
+	require.NoError(t, err)
+	require.Equal(t, "things", result.Value)
+
+	durations := metricsClient.Records("queries.listthings.duration")
+	require.Len(t, durations, 1)
+	require.Equal(t, recording.KindHistogram, durations[0].Kind)
+	require.Equal(t, float64(1), metricsClient.Sum("queries.listthings.success"))
+}
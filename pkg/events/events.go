@@ -0,0 +1,105 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+type (
+	// Event is something that happened which other parts of the system may react
+	// to. EventType routes the event to the handlers subscribed to that type.
+	Event interface {
+		EventType() string
+	}
+
+	// Handler reacts to a published event.
+	Handler func(ctx context.Context, event Event) error
+
+	// CancelFunc removes a subscription; calling it more than once is a no-op.
+	CancelFunc func()
+
+	// EventBus decouples the code that publishes events from the code reacting
+	// to them. InMemoryEventBus serves a single process; a broker-backed
+	// implementation such as Kafka or NATS can satisfy the same interface once
+	// events have to reach other instances, routing by EventType as the topic.
+	EventBus interface {
+		// Publish delivers the event to every handler subscribed to its type and
+		// returns the errors they reported.
+		Publish(ctx context.Context, event Event) error
+
+		// Subscribe registers handler for events of eventType.
+		Subscribe(eventType string, handler Handler) CancelFunc
+	}
+
+	// InMemoryEventBus calls the handlers of an event synchronously on the
+	// publishing goroutine, in subscription order. It is safe for concurrent use.
+	InMemoryEventBus struct {
+		mu            sync.RWMutex
+		subscriptions map[string][]*subscription
+	}
+
+	subscription struct {
+		handler Handler
+	}
+)
+
+var _ EventBus = (*InMemoryEventBus)(nil)
+
+func NewInMemoryEventBus() *InMemoryEventBus {
+	return &InMemoryEventBus{
+		subscriptions: make(map[string][]*subscription),
+	}
+}
+
+// Publish runs every handler even when some of them fail, joining their errors.
+func (b *InMemoryEventBus) Publish(ctx context.Context, event Event) error {
+	b.mu.RLock()
+	subs := b.subscriptions[event.EventType()]
+	b.mu.RUnlock()
+
+	var errs []error
+
+	for _, sub := range subs {
+		if err := sub.handler(ctx, event); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (b *InMemoryEventBus) Subscribe(eventType string, handler Handler) CancelFunc {
+	sub := &subscription{handler: handler}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.subscriptions[eventType] = append(b.subscriptions[eventType], sub)
+
+	return func() { b.unsubscribe(eventType, sub) }
+}
+
+// unsubscribe replaces the slice instead of editing it in place, because
+// Publish may still be iterating over the previous one.
+func (b *InMemoryEventBus) unsubscribe(eventType string, sub *subscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	subs := b.subscriptions[eventType]
+
+	remaining := make([]*subscription, 0, len(subs))
+	for _, s := range subs {
+		if s != sub {
+			remaining = append(remaining, s)
+		}
+	}
+
+	if len(remaining) == 0 {
+		delete(b.subscriptions, eventType)
+
+		return
+	}
+
+	b.subscriptions[eventType] = remaining
+}
@@ -0,0 +1,143 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testEvent struct {
+	eventType string
+}
+
+func (e testEvent) EventType() string { return e.eventType }
+
+func TestInMemoryEventBus(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		run  func(t *testing.T, bus *InMemoryEventBus)
+	}{
+		{
+			name: "delivers events to the handlers of their type in subscription order",
+			run: func(t *testing.T, bus *InMemoryEventBus) {
+				var calls []string
+
+				bus.Subscribe("created", func(context.Context, Event) error {
+					calls = append(calls, "first")
+
+					return nil
+				})
+				bus.Subscribe("created", func(context.Context, Event) error {
+					calls = append(calls, "second")
+
+					return nil
+				})
+				bus.Subscribe("deleted", func(context.Context, Event) error {
+					calls = append(calls, "other type")
+
+					return nil
+				})
+
+				require.NoError(t, bus.Publish(context.Background(), testEvent{eventType: "created"}))
+				require.Equal(t, []string{"first", "second"}, calls)
+			},
+		},
+		{
+			name: "passes the published event to the handler",
+			run: func(t *testing.T, bus *InMemoryEventBus) {
+				var received Event
+
+				bus.Subscribe("created", func(_ context.Context, event Event) error {
+					received = event
+
+					return nil
+				})
+
+				published := testEvent{eventType: "created"}
+				require.NoError(t, bus.Publish(context.Background(), published))
+				require.Equal(t, published, received)
+			},
+		},
+		{
+			name: "publishing without subscribers succeeds",
+			run: func(t *testing.T, bus *InMemoryEventBus) {
+				require.NoError(t, bus.Publish(context.Background(), testEvent{eventType: "created"}))
+			},
+		},
+		{
+			name: "runs every handler and joins their errors",
+			run: func(t *testing.T, bus *InMemoryEventBus) {
+				errFirst := errors.New("first failed")
+				errLast := errors.New("last failed")
+				called := false
+
+				bus.Subscribe("created", func(context.Context, Event) error { return errFirst })
+				bus.Subscribe("created", func(context.Context, Event) error {
+					called = true
+
+					return nil
+				})
+				bus.Subscribe("created", func(context.Context, Event) error { return errLast })
+
+				err := bus.Publish(context.Background(), testEvent{eventType: "created"})
+				require.ErrorIs(t, err, errFirst)
+				require.ErrorIs(t, err, errLast)
+				require.True(t, called)
+			},
+		},
+		{
+			name: "cancel removes only its own subscription",
+			run: func(t *testing.T, bus *InMemoryEventBus) {
+				cancelledCalls, keptCalls := 0, 0
+
+				cancel := bus.Subscribe("created", func(context.Context, Event) error {
+					cancelledCalls++
+
+					return nil
+				})
+				bus.Subscribe("created", func(context.Context, Event) error {
+					keptCalls++
+
+					return nil
+				})
+
+				cancel()
+				cancel()
+
+				require.NoError(t, bus.Publish(context.Background(), testEvent{eventType: "created"}))
+				require.Zero(t, cancelledCalls)
+				require.Equal(t, 1, keptCalls)
+			},
+		},
+		{
+			name: "a handler may cancel its subscription while being called",
+			run: func(t *testing.T, bus *InMemoryEventBus) {
+				calls := 0
+
+				var cancel CancelFunc
+				cancel = bus.Subscribe("created", func(context.Context, Event) error {
+					calls++
+					cancel()
+
+					return nil
+				})
+
+				require.NoError(t, bus.Publish(context.Background(), testEvent{eventType: "created"}))
+				require.NoError(t, bus.Publish(context.Background(), testEvent{eventType: "created"}))
+				require.Equal(t, 1, calls)
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			tc.run(t, NewInMemoryEventBus())
+		})
+	}
+}
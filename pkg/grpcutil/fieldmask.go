@@ -0,0 +1,29 @@
+package grpcutil
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// ValidateFieldMask checks that mask names at least one path and only paths listed in
+// valid. It returns an InvalidArgument status error otherwise, so handlers can return
+// it as is.
+func ValidateFieldMask(mask *fieldmaskpb.FieldMask, valid []string) error {
+	if len(mask.GetPaths()) == 0 {
+		return status.Error(codes.InvalidArgument, "update_mask must name at least one field")
+	}
+
+	for _, path := range mask.GetPaths() {
+		if !slices.Contains(valid, path) {
+			return status.Error(codes.InvalidArgument,
+				fmt.Sprintf("update_mask path %q is not supported, supported paths are %s", path, strings.Join(valid, ", ")))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package grpcutil_test
+
+import (
+	"testing"
+
+	"github.com/architeacher/devices/pkg/grpcutil"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestValidateFieldMask(t *testing.T) {
+	t.Parallel()
+
+	valid := []string{"name", "brand", "state"}
+
+	cases := []struct {
+		name         string
+		mask         *fieldmaskpb.FieldMask
+		expectedCode codes.Code
+	}{
+		{
+			name:         "single valid path",
+			mask:         &fieldmaskpb.FieldMask{Paths: []string{"name"}},
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "every valid path",
+			mask:         &fieldmaskpb.FieldMask{Paths: []string{"state", "brand", "name"}},
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "nil mask",
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "empty mask",
+			mask:         &fieldmaskpb.FieldMask{},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "unknown path",
+			mask:         &fieldmaskpb.FieldMask{Paths: []string{"name", "id"}},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "paths are case-sensitive",
+			mask:         &fieldmaskpb.FieldMask{Paths: []string{"Name"}},
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := grpcutil.ValidateFieldMask(tc.mask, valid)
+
+			require.Equal(t, tc.expectedCode, status.Code(err))
+		})
+	}
+}
@@ -0,0 +1,60 @@
+// Package grpcutil provides helpers shared by the gRPC servers of the services.
+package grpcutil
+
+import (
+	"sync/atomic"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// ObservableServerStream wraps a grpc.ServerStream and counts the messages and bytes
+// successfully sent and received on it, for telemetry. Bytes are the protobuf-encoded
+// size of each message; messages that are not protobuf messages count zero bytes.
+// The counters are safe to read while the stream is in use.
+type ObservableServerStream struct {
+	grpc.ServerStream
+
+	MessagesSent     atomic.Uint64
+	MessagesReceived atomic.Uint64
+	BytesSent        atomic.Uint64
+	BytesReceived    atomic.Uint64
+}
+
+// NewObservableServerStream wraps ss. Context, headers and trailers are those of ss.
+func NewObservableServerStream(ss grpc.ServerStream) *ObservableServerStream {
+	return &ObservableServerStream{ServerStream: ss}
+}
+
+// SendMsg sends m on the underlying stream, counting it once it was sent.
+func (s *ObservableServerStream) SendMsg(m any) error {
+	if err := s.ServerStream.SendMsg(m); err != nil {
+		return err
+	}
+
+	s.MessagesSent.Add(1)
+	s.BytesSent.Add(messageSize(m))
+
+	return nil
+}
+
+// RecvMsg receives into m from the underlying stream, counting it once it was received.
+func (s *ObservableServerStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	s.MessagesReceived.Add(1)
+	s.BytesReceived.Add(messageSize(m))
+
+	return nil
+}
+
+func messageSize(m any) uint64 {
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return 0
+	}
+
+	return uint64(proto.Size(msg))
+}
@@ -0,0 +1,121 @@
+package grpcutil_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/architeacher/devices/pkg/grpcutil"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type contextKey string
+
+// fakeServerStream delivers incoming in order, then io.EOF, and fails sends once
+// sendErr is set.
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	mu       sync.Mutex
+	incoming []*wrapperspb.StringValue
+	sendErr  error
+}
+
+func (f *fakeServerStream) Context() context.Context {
+	return f.ctx
+}
+
+func (f *fakeServerStream) SendMsg(any) error {
+	return f.sendErr
+}
+
+func (f *fakeServerStream) RecvMsg(m any) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if len(f.incoming) == 0 {
+		return io.EOF
+	}
+
+	proto.Merge(m.(proto.Message), f.incoming[0])
+	f.incoming = f.incoming[1:]
+
+	return nil
+}
+
+func TestObservableServerStream_Counts(t *testing.T) {
+	t.Parallel()
+
+	first := wrapperspb.String("first")
+	second := wrapperspb.String("second message")
+	update := wrapperspb.String("update")
+
+	fake := &fakeServerStream{ctx: t.Context(), incoming: []*wrapperspb.StringValue{first, second}}
+	stream := grpcutil.NewObservableServerStream(fake)
+
+	for {
+		if err := stream.RecvMsg(&wrapperspb.StringValue{}); err != nil {
+			require.ErrorIs(t, err, io.EOF)
+
+			break
+		}
+	}
+
+	for range 3 {
+		require.NoError(t, stream.SendMsg(update))
+	}
+
+	require.NoError(t, stream.SendMsg("not a protobuf message"))
+
+	require.EqualValues(t, 2, stream.MessagesReceived.Load(), "the EOF is not a message")
+	require.EqualValues(t, proto.Size(first)+proto.Size(second), stream.BytesReceived.Load())
+	require.EqualValues(t, 4, stream.MessagesSent.Load())
+	require.EqualValues(t, 3*proto.Size(update), stream.BytesSent.Load(), "non-protobuf messages count no bytes")
+}
+
+func TestObservableServerStream_FailedSendIsNotCounted(t *testing.T) {
+	t.Parallel()
+
+	fake := &fakeServerStream{ctx: t.Context(), sendErr: errors.New("transport closing")}
+	stream := grpcutil.NewObservableServerStream(fake)
+
+	require.ErrorIs(t, stream.SendMsg(wrapperspb.String("update")), fake.sendErr)
+	require.Zero(t, stream.MessagesSent.Load())
+	require.Zero(t, stream.BytesSent.Load())
+}
+
+func TestObservableServerStream_Context(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.WithValue(t.Context(), contextKey("request-id"), "42")
+	stream := grpcutil.NewObservableServerStream(&fakeServerStream{ctx: ctx})
+
+	require.Equal(t, ctx, stream.Context())
+}
+
+func TestObservableServerStream_ConcurrentSends(t *testing.T) {
+	t.Parallel()
+
+	stream := grpcutil.NewObservableServerStream(&fakeServerStream{ctx: t.Context()})
+	update := wrapperspb.String("update")
+
+	const senders, sends = 8, 100
+
+	var wg sync.WaitGroup
+	for range senders {
+		wg.Go(func() {
+			for range sends {
+				_ = stream.SendMsg(update)
+			}
+		})
+	}
+	wg.Wait()
+
+	require.EqualValues(t, senders*sends, stream.MessagesSent.Load())
+	require.EqualValues(t, senders*sends*proto.Size(update), stream.BytesSent.Load())
+}
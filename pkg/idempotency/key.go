@@ -1,10 +1,12 @@
 package idempotency
 
 import (
+	"bytes"
 	"crypto/sha256"
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"io"
 	"regexp"
 )
 
@@ -46,3 +48,21 @@ func BuildCacheKey(method, path, idempotencyKey string) string {
 
 	return fmt.Sprintf("%s:%s", KeyPrefix, hex.EncodeToString(hash[:]))
 }
+
+// HashBody returns the hex-encoded SHA-256 digest of a request body.
+func HashBody(body []byte) string {
+	hash, _ := HashReader(bytes.NewReader(body))
+
+	return hash
+}
+
+// HashReader returns the hex-encoded SHA-256 digest of everything read from r, the
+// same digest HashBody returns for those bytes.
+func HashReader(r io.Reader) (string, error) {
+	hasher := sha256.New()
+	if _, err := io.Copy(hasher, r); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hasher.Sum(nil)), nil
+}
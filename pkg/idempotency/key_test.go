@@ -1,6 +1,7 @@
 package idempotency
 
 import (
+	"bytes"
 	"strings"
 	"testing"
 
@@ -190,3 +191,25 @@ func TestBuildCacheKey_UniqueForDifferentInputs(t *testing.T) {
 		})
 	}
 }
+
+func TestHashBody(t *testing.T) {
+	t.Parallel()
+
+	hash := HashBody([]byte(`{"name":"test"}`))
+
+	require.Len(t, hash, 64)
+	require.Equal(t, hash, HashBody([]byte(`{"name":"test"}`)), "hash should be deterministic")
+	require.NotEqual(t, hash, HashBody([]byte(`{"name":"other"}`)), "different bodies should hash differently")
+	require.Equal(t, HashBody(nil), HashBody([]byte{}), "nil and empty bodies should hash the same")
+}
+
+func TestHashReader_MatchesHashBody(t *testing.T) {
+	t.Parallel()
+
+	body := []byte(`{"name":"test"}`)
+
+	hash, err := HashReader(bytes.NewReader(body))
+
+	require.NoError(t, err)
+	require.Equal(t, HashBody(body), hash)
+}
@@ -0,0 +1,36 @@
+package logger
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// Field is an extra key/value pair attached to a log entry.
+type Field struct {
+	Key   string
+	Value any
+}
+
+// LogError logs err at error level with its full wrapping chain. Each error in the
+// errors.Unwrap chain, starting with err itself, is written as cause_0, cause_1, …,
+// and error_type records the concrete type of err.
+func LogError(log Logger, err error, msg string, fields ...Field) {
+	event := log.Error()
+
+	if err != nil {
+		event = event.
+			Err(err).
+			Str("error_type", reflect.TypeOf(err).String())
+
+		for index, cause := 0, err; cause != nil; index, cause = index+1, errors.Unwrap(cause) {
+			event = event.Str(fmt.Sprintf("cause_%d", index), cause.Error())
+		}
+	}
+
+	for _, field := range fields {
+		event = event.Interface(field.Key, field.Value)
+	}
+
+	event.Msg(msg)
+}
@@ -14,7 +14,8 @@ import (
 )
 
 const (
-	JSONLoggingFormat = "json"
+	JSONLoggingFormat    = "json"
+	ConsoleLoggingFormat = "console"
 
 	LogLevelDebug   = "debug"
 	LogLevelInfo    = "info"
@@ -40,6 +41,10 @@ func New(level, format string) Logger {
 	return NewWithWriter(level, format, os.Stdout)
 }
 
+// NewWithWriter creates a logger that writes to w, as JSON when format is
+// JSONLoggingFormat and as human-readable console output otherwise. The level
+// applies to the returned logger only, so loggers built for different tests or
+// components do not change each other's verbosity.
 func NewWithWriter(level, format string, w io.Writer) Logger {
 	var logLevel zerolog.Level
 
@@ -60,15 +65,13 @@ func NewWithWriter(level, format string, w io.Writer) Logger {
 		logLevel = zerolog.InfoLevel
 	}
 
-	zerolog.SetGlobalLevel(logLevel)
-
 	logger := zerolog.New(zerolog.ConsoleWriter{Out: w, TimeFormat: time.RFC3339})
 
 	if format == JSONLoggingFormat {
 		logger = zerolog.New(w)
 	}
 
-	logger = logger.With().Timestamp().Logger()
+	logger = logger.Level(logLevel).With().Timestamp().Logger()
 
 	return Logger{
 		Logger: logger,
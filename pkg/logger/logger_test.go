@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -51,6 +52,55 @@ func TestNew(t *testing.T) {
 	}
 }
 
+func TestNewWithWriter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("json format writes valid JSON entries", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		log := logger.NewWithWriter(logger.LogLevelInfo, logger.JSONLoggingFormat, &buf)
+		log.Info().Str("device_id", "42").Msg("device created")
+
+		var entry map[string]any
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+		require.Equal(t, "info", entry["level"])
+		require.Equal(t, "device created", entry["message"])
+		require.Equal(t, "42", entry["device_id"])
+		require.Contains(t, entry, "time")
+	})
+
+	t.Run("console format writes human-readable text", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		log := logger.NewWithWriter(logger.LogLevelInfo, logger.ConsoleLoggingFormat, &buf)
+		log.Info().Msg("device created")
+
+		output := buf.String()
+		require.False(t, json.Valid(buf.Bytes()))
+		require.Contains(t, output, "INF")
+		require.Contains(t, output, "device created")
+	})
+
+	t.Run("level filters only the returned logger", func(t *testing.T) {
+		t.Parallel()
+
+		var warnBuf, debugBuf bytes.Buffer
+
+		warnLog := logger.NewWithWriter(logger.LogLevelWarn, logger.JSONLoggingFormat, &warnBuf)
+		debugLog := logger.NewWithWriter(logger.LogLevelDebug, logger.JSONLoggingFormat, &debugBuf)
+
+		warnLog.Info().Msg("suppressed")
+		debugLog.Debug().Msg("kept")
+
+		require.Empty(t, warnBuf.Bytes())
+		require.Contains(t, debugBuf.String(), "kept")
+	})
+}
+
 func TestWithContext(t *testing.T) {
 	t.Parallel()
 
@@ -105,3 +155,76 @@ func TestWithContext(t *testing.T) {
 		})
 	}
 }
+
+type notFoundError struct {
+	id string
+}
+
+func (e *notFoundError) Error() string {
+	return "device " + e.id + " not found"
+}
+
+func TestLogError(t *testing.T) {
+	t.Parallel()
+
+	t.Run("logs every error in the wrapping chain", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		root := &notFoundError{id: "42"}
+		middle := fmt.Errorf("fetching device: %w", root)
+		top := fmt.Errorf("handling request: %w", middle)
+
+		logger.LogError(
+			logger.NewBufferedTestLogger(&buf),
+			top,
+			"request failed",
+			logger.Field{Key: "device_id", Value: "42"},
+		)
+
+		var entry map[string]any
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+
+		require.Equal(t, "error", entry["level"])
+		require.Equal(t, "request failed", entry["message"])
+		require.Equal(t, top.Error(), entry["error"])
+		require.Equal(t, "*fmt.wrapError", entry["error_type"])
+		require.Equal(t, top.Error(), entry["cause_0"])
+		require.Equal(t, middle.Error(), entry["cause_1"])
+		require.Equal(t, root.Error(), entry["cause_2"])
+		require.NotContains(t, entry, "cause_3")
+		require.Equal(t, "42", entry["device_id"])
+	})
+
+	t.Run("unwrapped error has a single cause", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		logger.LogError(logger.NewBufferedTestLogger(&buf), &notFoundError{id: "7"}, "lookup failed")
+
+		var entry map[string]any
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+
+		require.Equal(t, "*logger_test.notFoundError", entry["error_type"])
+		require.Equal(t, "device 7 not found", entry["cause_0"])
+		require.NotContains(t, entry, "cause_1")
+	})
+
+	t.Run("nil error logs only the message and fields", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		logger.LogError(logger.NewBufferedTestLogger(&buf), nil, "no error", logger.Field{Key: "attempt", Value: 3})
+
+		var entry map[string]any
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+
+		require.Equal(t, "no error", entry["message"])
+		require.InDelta(t, 3, entry["attempt"], 0)
+		require.NotContains(t, entry, "error_type")
+		require.NotContains(t, entry, "cause_0")
+	})
+}
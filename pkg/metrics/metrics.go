@@ -14,6 +14,11 @@ import (
 type (
 	Client interface {
 		Inc(ctx context.Context, key string, value any, attributes ...attribute.KeyValue)
+		// Observe records value in the histogram named key, for durations, sizes and ratios.
+		Observe(ctx context.Context, key string, value float64, attributes ...attribute.KeyValue) error
+		// Add moves the up/down counter named key by delta, for values that go back down
+		// such as in-flight requests or open connections.
+		Add(ctx context.Context, key string, delta int64, attributes ...attribute.KeyValue)
 		Handler() http.Handler
 		Shutdown(ctx context.Context) error
 	}
@@ -19,6 +19,12 @@ func NewMetricsClient() MetricsClient {
 
 func (c MetricsClient) Inc(_ context.Context, _ string, _ any, _ ...attribute.KeyValue) {}
 
+func (c MetricsClient) Observe(_ context.Context, _ string, _ float64, _ ...attribute.KeyValue) error {
+	return nil
+}
+
+func (c MetricsClient) Add(_ context.Context, _ string, _ int64, _ ...attribute.KeyValue) {}
+
 func (c MetricsClient) Handler() http.Handler {
 	return http.NotFoundHandler()
 }
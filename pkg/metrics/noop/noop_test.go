@@ -0,0 +1,20 @@
+package noop_test
+
+import (
+	"testing"
+
+	"github.com/architeacher/devices/pkg/metrics"
+	"github.com/architeacher/devices/pkg/metrics/noop"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func TestMetricsClient_Observe(t *testing.T) {
+	t.Parallel()
+
+	var client metrics.Client = noop.NewMetricsClient()
+
+	err := client.Observe(t.Context(), "http_request_duration_seconds", 0.25, attribute.String("method", "GET"))
+
+	require.NoError(t, err)
+}
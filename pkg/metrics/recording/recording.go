@@ -0,0 +1,156 @@
+// Package recording provides a metrics client that keeps every recorded value in
+// memory, for asserting on metrics in tests.
+package recording
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	KindCounter       Kind = "counter"
+	KindHistogram     Kind = "histogram"
+	KindUpDownCounter Kind = "up_down_counter"
+)
+
+type (
+	// Kind is the instrument a Record was recorded on.
+	Kind string
+
+	// Record is one call made on the MetricsClient.
+	Record struct {
+		Kind       Kind
+		Name       string
+		Value      any
+		Attributes []attribute.KeyValue
+	}
+
+	// MetricsClient is a metrics.Client recording every Inc, Observe and Add call.
+	MetricsClient struct {
+		mu      sync.Mutex
+		records []Record
+	}
+)
+
+func NewMetricsClient() *MetricsClient {
+	return &MetricsClient{}
+}
+
+func (c *MetricsClient) Inc(_ context.Context, key string, value any, attributes ...attribute.KeyValue) {
+	c.record(KindCounter, key, value, attributes)
+}
+
+func (c *MetricsClient) Observe(_ context.Context, key string, value float64, attributes ...attribute.KeyValue) error {
+	c.record(KindHistogram, key, value, attributes)
+
+	return nil
+}
+
+func (c *MetricsClient) Add(_ context.Context, key string, delta int64, attributes ...attribute.KeyValue) {
+	c.record(KindUpDownCounter, key, delta, attributes)
+}
+
+func (c *MetricsClient) Handler() http.Handler {
+	return http.NotFoundHandler()
+}
+
+func (c *MetricsClient) Shutdown(_ context.Context) error {
+	return nil
+}
+
+// All returns every record in the order it was recorded.
+func (c *MetricsClient) All() []Record {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return slices.Clone(c.records)
+}
+
+// Records returns the records of the metric named name, in the order they were recorded.
+func (c *MetricsClient) Records(name string) []Record {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var records []Record
+
+	for _, record := range c.records {
+		if record.Name == name {
+			records = append(records, record)
+		}
+	}
+
+	return records
+}
+
+// Has reports whether the metric named name was recorded.
+func (c *MetricsClient) Has(name string) bool {
+	return len(c.Records(name)) > 0
+}
+
+// HasAttribute reports whether the metric named name was recorded with the attribute
+// key set to value.
+func (c *MetricsClient) HasAttribute(name, key, value string) bool {
+	for _, record := range c.Records(name) {
+		if attr, ok := record.Attribute(key); ok && attr == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Sum adds up the values recorded for the metric named name.
+func (c *MetricsClient) Sum(name string) float64 {
+	var total float64
+
+	for _, record := range c.Records(name) {
+		switch value := record.Value.(type) {
+		case int:
+			total += float64(value)
+		case int64:
+			total += float64(value)
+		case float64:
+			total += value
+		}
+	}
+
+	return total
+}
+
+func (c *MetricsClient) record(kind Kind, name string, value any, attributes []attribute.KeyValue) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.records = append(c.records, Record{
+		Kind:       kind,
+		Name:       name,
+		Value:      value,
+		Attributes: slices.Clone(attributes),
+	})
+}
+
+// Attribute returns the value of the attribute key, formatted as a string.
+func (r Record) Attribute(key string) (string, bool) {
+	for _, attr := range r.Attributes {
+		if string(attr.Key) == key {
+			return attr.Value.Emit(), true
+		}
+	}
+
+	return "", false
+}
+
+// AttributeMap returns the attributes as a map of keys to values formatted as strings.
+func (r Record) AttributeMap() map[string]string {
+	attributes := make(map[string]string, len(r.Attributes))
+
+	for _, attr := range r.Attributes {
+		attributes[string(attr.Key)] = attr.Value.Emit()
+	}
+
+	return attributes
+}
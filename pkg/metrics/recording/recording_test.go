@@ -0,0 +1,44 @@
+package recording_test
+
+import (
+	"testing"
+
+	"github.com/architeacher/devices/pkg/metrics"
+	"github.com/architeacher/devices/pkg/metrics/recording"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func TestMetricsClient_RecordsEveryInstrument(t *testing.T) {
+	t.Parallel()
+
+	client := recording.NewMetricsClient()
+
+	var _ metrics.Client = client
+
+	client.Inc(t.Context(), "requests_total", int64(1), attribute.String("method", "GET"))
+	client.Inc(t.Context(), "requests_total", 2, attribute.String("method", "POST"))
+	require.NoError(t, client.Observe(t.Context(), "request_duration_seconds", 0.25))
+	client.Add(t.Context(), "requests_in_flight", 1)
+	client.Add(t.Context(), "requests_in_flight", -1)
+
+	require.Len(t, client.All(), 5)
+	require.Equal(t, float64(3), client.Sum("requests_total"))
+	require.Zero(t, client.Sum("requests_in_flight"))
+
+	require.True(t, client.Has("request_duration_seconds"))
+	require.False(t, client.Has("unknown"))
+	require.True(t, client.HasAttribute("requests_total", "method", "POST"))
+	require.False(t, client.HasAttribute("requests_total", "method", "DELETE"))
+
+	durations := client.Records("request_duration_seconds")
+	require.Equal(t, []recording.Record{{
+		Kind:  recording.KindHistogram,
+		Name:  "request_duration_seconds",
+		Value: 0.25,
+	}}, durations)
+
+	inFlight := client.Records("requests_in_flight")
+	require.Equal(t, recording.KindUpDownCounter, inFlight[0].Kind)
+	require.Equal(t, map[string]string{"method": "GET"}, client.Records("requests_total")[0].AttributeMap())
+}
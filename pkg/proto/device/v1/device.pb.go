@@ -126,7 +126,7 @@ func (x HealthCheckResponse_ServingStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use HealthCheckResponse_ServingStatus.Descriptor instead.
 func (HealthCheckResponse_ServingStatus) EnumDescriptor() ([]byte, []int) {
-	return file_device_v1_device_proto_rawDescGZIP(), []int{14, 0}
+	return file_device_v1_device_proto_rawDescGZIP(), []int{17, 0}
 }
 
 type Device struct {
@@ -317,6 +317,68 @@ func (x *CreateDeviceResponse) GetDevice() *Device {
 	return nil
 }
 
+type BulkCreateDeviceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the created device; empty when the creation failed.
+	DeviceId string `protobuf:"bytes,1,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	Success  bool   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	// gRPC status code name (e.g. "AlreadyExists") when the creation failed.
+	ErrorCode     string `protobuf:"bytes,3,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkCreateDeviceResponse) Reset() {
+	*x = BulkCreateDeviceResponse{}
+	mi := &file_device_v1_device_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkCreateDeviceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkCreateDeviceResponse) ProtoMessage() {}
+
+func (x *BulkCreateDeviceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_device_v1_device_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkCreateDeviceResponse.ProtoReflect.Descriptor instead.
+func (*BulkCreateDeviceResponse) Descriptor() ([]byte, []int) {
+	return file_device_v1_device_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BulkCreateDeviceResponse) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *BulkCreateDeviceResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BulkCreateDeviceResponse) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
 type GetDeviceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -326,7 +388,7 @@ type GetDeviceRequest struct {
 
 func (x *GetDeviceRequest) Reset() {
 	*x = GetDeviceRequest{}
-	mi := &file_device_v1_device_proto_msgTypes[3]
+	mi := &file_device_v1_device_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -338,7 +400,7 @@ func (x *GetDeviceRequest) String() string {
 func (*GetDeviceRequest) ProtoMessage() {}
 
 func (x *GetDeviceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_device_v1_device_proto_msgTypes[3]
+	mi := &file_device_v1_device_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -351,7 +413,7 @@ func (x *GetDeviceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDeviceRequest.ProtoReflect.Descriptor instead.
 func (*GetDeviceRequest) Descriptor() ([]byte, []int) {
-	return file_device_v1_device_proto_rawDescGZIP(), []int{3}
+	return file_device_v1_device_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetDeviceRequest) GetId() string {
@@ -370,7 +432,7 @@ type GetDeviceResponse struct {
 
 func (x *GetDeviceResponse) Reset() {
 	*x = GetDeviceResponse{}
-	mi := &file_device_v1_device_proto_msgTypes[4]
+	mi := &file_device_v1_device_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -382,7 +444,7 @@ func (x *GetDeviceResponse) String() string {
 func (*GetDeviceResponse) ProtoMessage() {}
 
 func (x *GetDeviceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_device_v1_device_proto_msgTypes[4]
+	mi := &file_device_v1_device_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -395,7 +457,7 @@ func (x *GetDeviceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDeviceResponse.ProtoReflect.Descriptor instead.
 func (*GetDeviceResponse) Descriptor() ([]byte, []int) {
-	return file_device_v1_device_proto_rawDescGZIP(), []int{4}
+	return file_device_v1_device_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetDeviceResponse) GetDevice() *Device {
@@ -427,7 +489,7 @@ type ListDevicesRequest struct {
 
 func (x *ListDevicesRequest) Reset() {
 	*x = ListDevicesRequest{}
-	mi := &file_device_v1_device_proto_msgTypes[5]
+	mi := &file_device_v1_device_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -439,7 +501,7 @@ func (x *ListDevicesRequest) String() string {
 func (*ListDevicesRequest) ProtoMessage() {}
 
 func (x *ListDevicesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_device_v1_device_proto_msgTypes[5]
+	mi := &file_device_v1_device_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -452,7 +514,7 @@ func (x *ListDevicesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListDevicesRequest.ProtoReflect.Descriptor instead.
 func (*ListDevicesRequest) Descriptor() ([]byte, []int) {
-	return file_device_v1_device_proto_rawDescGZIP(), []int{5}
+	return file_device_v1_device_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ListDevicesRequest) GetQuery() string {
@@ -514,7 +576,7 @@ type ListDevicesResponse struct {
 
 func (x *ListDevicesResponse) Reset() {
 	*x = ListDevicesResponse{}
-	mi := &file_device_v1_device_proto_msgTypes[6]
+	mi := &file_device_v1_device_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -526,7 +588,7 @@ func (x *ListDevicesResponse) String() string {
 func (*ListDevicesResponse) ProtoMessage() {}
 
 func (x *ListDevicesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_device_v1_device_proto_msgTypes[6]
+	mi := &file_device_v1_device_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -539,7 +601,7 @@ func (x *ListDevicesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListDevicesResponse.ProtoReflect.Descriptor instead.
 func (*ListDevicesResponse) Descriptor() ([]byte, []int) {
-	return file_device_v1_device_proto_rawDescGZIP(), []int{6}
+	return file_device_v1_device_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ListDevicesResponse) GetDevices() []*Device {
@@ -572,7 +634,7 @@ type Pagination struct {
 
 func (x *Pagination) Reset() {
 	*x = Pagination{}
-	mi := &file_device_v1_device_proto_msgTypes[7]
+	mi := &file_device_v1_device_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -584,7 +646,7 @@ func (x *Pagination) String() string {
 func (*Pagination) ProtoMessage() {}
 
 func (x *Pagination) ProtoReflect() protoreflect.Message {
-	mi := &file_device_v1_device_proto_msgTypes[7]
+	mi := &file_device_v1_device_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -597,7 +659,7 @@ func (x *Pagination) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Pagination.ProtoReflect.Descriptor instead.
 func (*Pagination) Descriptor() ([]byte, []int) {
-	return file_device_v1_device_proto_rawDescGZIP(), []int{7}
+	return file_device_v1_device_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Pagination) GetPage() uint32 {
@@ -668,7 +730,7 @@ type UpdateDeviceRequest struct {
 
 func (x *UpdateDeviceRequest) Reset() {
 	*x = UpdateDeviceRequest{}
-	mi := &file_device_v1_device_proto_msgTypes[8]
+	mi := &file_device_v1_device_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -680,7 +742,7 @@ func (x *UpdateDeviceRequest) String() string {
 func (*UpdateDeviceRequest) ProtoMessage() {}
 
 func (x *UpdateDeviceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_device_v1_device_proto_msgTypes[8]
+	mi := &file_device_v1_device_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -693,7 +755,7 @@ func (x *UpdateDeviceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateDeviceRequest.ProtoReflect.Descriptor instead.
 func (*UpdateDeviceRequest) Descriptor() ([]byte, []int) {
-	return file_device_v1_device_proto_rawDescGZIP(), []int{8}
+	return file_device_v1_device_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *UpdateDeviceRequest) GetId() string {
@@ -733,7 +795,7 @@ type UpdateDeviceResponse struct {
 
 func (x *UpdateDeviceResponse) Reset() {
 	*x = UpdateDeviceResponse{}
-	mi := &file_device_v1_device_proto_msgTypes[9]
+	mi := &file_device_v1_device_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -745,7 +807,7 @@ func (x *UpdateDeviceResponse) String() string {
 func (*UpdateDeviceResponse) ProtoMessage() {}
 
 func (x *UpdateDeviceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_device_v1_device_proto_msgTypes[9]
+	mi := &file_device_v1_device_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -758,7 +820,7 @@ func (x *UpdateDeviceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateDeviceResponse.ProtoReflect.Descriptor instead.
 func (*UpdateDeviceResponse) Descriptor() ([]byte, []int) {
-	return file_device_v1_device_proto_rawDescGZIP(), []int{9}
+	return file_device_v1_device_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *UpdateDeviceResponse) GetDevice() *Device {
@@ -769,19 +831,25 @@ func (x *UpdateDeviceResponse) GetDevice() *Device {
 }
 
 type PatchDeviceRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Brand         *string                `protobuf:"bytes,3,opt,name=brand,proto3,oneof" json:"brand,omitempty"`
-	State         *DeviceState           `protobuf:"varint,4,opt,name=state,proto3,enum=device.v1.DeviceState,oneof" json:"state,omitempty"`
-	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,5,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Brand *string                `protobuf:"bytes,3,opt,name=brand,proto3,oneof" json:"brand,omitempty"`
+	State *DeviceState           `protobuf:"varint,4,opt,name=state,proto3,enum=device.v1.DeviceState,oneof" json:"state,omitempty"`
+	// Fields to update, among "name", "brand" and "state". Only the named fields are
+	// updated; the others are left unchanged even when set. A missing or empty mask,
+	// or an unknown path, is rejected with INVALID_ARGUMENT.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,5,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// When set, the patch is applied only if the device was last updated at this time,
+	// as read by the caller; otherwise it is rejected with FAILED_PRECONDITION.
+	ExpectedUpdatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expected_updated_at,json=expectedUpdatedAt,proto3" json:"expected_updated_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *PatchDeviceRequest) Reset() {
 	*x = PatchDeviceRequest{}
-	mi := &file_device_v1_device_proto_msgTypes[10]
+	mi := &file_device_v1_device_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -793,7 +861,7 @@ func (x *PatchDeviceRequest) String() string {
 func (*PatchDeviceRequest) ProtoMessage() {}
 
 func (x *PatchDeviceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_device_v1_device_proto_msgTypes[10]
+	mi := &file_device_v1_device_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -806,7 +874,7 @@ func (x *PatchDeviceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PatchDeviceRequest.ProtoReflect.Descriptor instead.
 func (*PatchDeviceRequest) Descriptor() ([]byte, []int) {
-	return file_device_v1_device_proto_rawDescGZIP(), []int{10}
+	return file_device_v1_device_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *PatchDeviceRequest) GetId() string {
@@ -844,6 +912,13 @@ func (x *PatchDeviceRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
 	return nil
 }
 
+func (x *PatchDeviceRequest) GetExpectedUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpectedUpdatedAt
+	}
+	return nil
+}
+
 type PatchDeviceResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Device        *Device                `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
@@ -853,7 +928,7 @@ type PatchDeviceResponse struct {
 
 func (x *PatchDeviceResponse) Reset() {
 	*x = PatchDeviceResponse{}
-	mi := &file_device_v1_device_proto_msgTypes[11]
+	mi := &file_device_v1_device_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -865,7 +940,7 @@ func (x *PatchDeviceResponse) String() string {
 func (*PatchDeviceResponse) ProtoMessage() {}
 
 func (x *PatchDeviceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_device_v1_device_proto_msgTypes[11]
+	mi := &file_device_v1_device_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -878,7 +953,7 @@ func (x *PatchDeviceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PatchDeviceResponse.ProtoReflect.Descriptor instead.
 func (*PatchDeviceResponse) Descriptor() ([]byte, []int) {
-	return file_device_v1_device_proto_rawDescGZIP(), []int{11}
+	return file_device_v1_device_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *PatchDeviceResponse) GetDevice() *Device {
@@ -897,7 +972,7 @@ type DeleteDeviceRequest struct {
 
 func (x *DeleteDeviceRequest) Reset() {
 	*x = DeleteDeviceRequest{}
-	mi := &file_device_v1_device_proto_msgTypes[12]
+	mi := &file_device_v1_device_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -909,7 +984,7 @@ func (x *DeleteDeviceRequest) String() string {
 func (*DeleteDeviceRequest) ProtoMessage() {}
 
 func (x *DeleteDeviceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_device_v1_device_proto_msgTypes[12]
+	mi := &file_device_v1_device_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -922,7 +997,7 @@ func (x *DeleteDeviceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteDeviceRequest.ProtoReflect.Descriptor instead.
 func (*DeleteDeviceRequest) Descriptor() ([]byte, []int) {
-	return file_device_v1_device_proto_rawDescGZIP(), []int{12}
+	return file_device_v1_device_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *DeleteDeviceRequest) GetId() string {
@@ -932,6 +1007,94 @@ func (x *DeleteDeviceRequest) GetId() string {
 	return ""
 }
 
+type WatchDeviceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchDeviceRequest) Reset() {
+	*x = WatchDeviceRequest{}
+	mi := &file_device_v1_device_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchDeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchDeviceRequest) ProtoMessage() {}
+
+func (x *WatchDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_device_v1_device_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchDeviceRequest.ProtoReflect.Descriptor instead.
+func (*WatchDeviceRequest) Descriptor() ([]byte, []int) {
+	return file_device_v1_device_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *WatchDeviceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type WatchDeviceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Device        *Device                `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchDeviceResponse) Reset() {
+	*x = WatchDeviceResponse{}
+	mi := &file_device_v1_device_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchDeviceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchDeviceResponse) ProtoMessage() {}
+
+func (x *WatchDeviceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_device_v1_device_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchDeviceResponse.ProtoReflect.Descriptor instead.
+func (*WatchDeviceResponse) Descriptor() ([]byte, []int) {
+	return file_device_v1_device_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *WatchDeviceResponse) GetDevice() *Device {
+	if x != nil {
+		return x.Device
+	}
+	return nil
+}
+
 type HealthCheckRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Service       string                 `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
@@ -941,7 +1104,7 @@ type HealthCheckRequest struct {
 
 func (x *HealthCheckRequest) Reset() {
 	*x = HealthCheckRequest{}
-	mi := &file_device_v1_device_proto_msgTypes[13]
+	mi := &file_device_v1_device_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -953,7 +1116,7 @@ func (x *HealthCheckRequest) String() string {
 func (*HealthCheckRequest) ProtoMessage() {}
 
 func (x *HealthCheckRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_device_v1_device_proto_msgTypes[13]
+	mi := &file_device_v1_device_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -966,7 +1129,7 @@ func (x *HealthCheckRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HealthCheckRequest.ProtoReflect.Descriptor instead.
 func (*HealthCheckRequest) Descriptor() ([]byte, []int) {
-	return file_device_v1_device_proto_rawDescGZIP(), []int{13}
+	return file_device_v1_device_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *HealthCheckRequest) GetService() string {
@@ -985,7 +1148,7 @@ type HealthCheckResponse struct {
 
 func (x *HealthCheckResponse) Reset() {
 	*x = HealthCheckResponse{}
-	mi := &file_device_v1_device_proto_msgTypes[14]
+	mi := &file_device_v1_device_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -997,7 +1160,7 @@ func (x *HealthCheckResponse) String() string {
 func (*HealthCheckResponse) ProtoMessage() {}
 
 func (x *HealthCheckResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_device_v1_device_proto_msgTypes[14]
+	mi := &file_device_v1_device_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1010,7 +1173,7 @@ func (x *HealthCheckResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HealthCheckResponse.ProtoReflect.Descriptor instead.
 func (*HealthCheckResponse) Descriptor() ([]byte, []int) {
-	return file_device_v1_device_proto_rawDescGZIP(), []int{14}
+	return file_device_v1_device_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *HealthCheckResponse) GetStatus() HealthCheckResponse_ServingStatus {
@@ -1042,7 +1205,12 @@ const file_device_v1_device_proto_rawDesc = "" +
 	"\x05state\x18\x03 \x01(\x0e2\x16.device.v1.DeviceStateB\n" +
 	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x05state\"A\n" +
 	"\x14CreateDeviceResponse\x12)\n" +
-	"\x06device\x18\x01 \x01(\v2\x11.device.v1.DeviceR\x06device\",\n" +
+	"\x06device\x18\x01 \x01(\v2\x11.device.v1.DeviceR\x06device\"p\n" +
+	"\x18BulkCreateDeviceResponse\x12\x1b\n" +
+	"\tdevice_id\x18\x01 \x01(\tR\bdeviceId\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x1d\n" +
+	"\n" +
+	"error_code\x18\x03 \x01(\tR\terrorCode\",\n" +
 	"\x10GetDeviceRequest\x12\x18\n" +
 	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\">\n" +
 	"\x11GetDeviceResponse\x12)\n" +
@@ -1085,7 +1253,7 @@ const file_device_v1_device_proto_rawDesc = "" +
 	"\x05state\x18\x04 \x01(\x0e2\x16.device.v1.DeviceStateB\n" +
 	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x05state\"A\n" +
 	"\x14UpdateDeviceResponse\x12)\n" +
-	"\x06device\x18\x01 \x01(\v2\x11.device.v1.DeviceR\x06device\"\x93\x02\n" +
+	"\x06device\x18\x01 \x01(\v2\x11.device.v1.DeviceR\x06device\"\xdf\x02\n" +
 	"\x12PatchDeviceRequest\x12\x18\n" +
 	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12#\n" +
 	"\x04name\x18\x02 \x01(\tB\n" +
@@ -1095,7 +1263,8 @@ const file_device_v1_device_proto_rawDesc = "" +
 	"\x05state\x18\x04 \x01(\x0e2\x16.device.v1.DeviceStateB\n" +
 	"\xbaH\a\x82\x01\x04\x10\x01 \x00H\x02R\x05state\x88\x01\x01\x12;\n" +
 	"\vupdate_mask\x18\x05 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
-	"updateMaskB\a\n" +
+	"updateMask\x12J\n" +
+	"\x13expected_updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x11expectedUpdatedAtB\a\n" +
 	"\x05_nameB\b\n" +
 	"\x06_brandB\b\n" +
 	"\x06_state\"@\n" +
@@ -1103,6 +1272,10 @@ const file_device_v1_device_proto_rawDesc = "" +
 	"\x06device\x18\x01 \x01(\v2\x11.device.v1.DeviceR\x06device\"/\n" +
 	"\x13DeleteDeviceRequest\x12\x18\n" +
 	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\".\n" +
+	"\x12WatchDeviceRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"@\n" +
+	"\x13WatchDeviceResponse\x12)\n" +
+	"\x06device\x18\x01 \x01(\v2\x11.device.v1.DeviceR\x06device\".\n" +
 	"\x12HealthCheckRequest\x12\x18\n" +
 	"\aservice\x18\x01 \x01(\tR\aservice\"\xe9\x01\n" +
 	"\x13HealthCheckResponse\x12D\n" +
@@ -1116,14 +1289,16 @@ const file_device_v1_device_proto_rawDesc = "" +
 	"\x18DEVICE_STATE_UNSPECIFIED\x10\x00\x12\x1a\n" +
 	"\x16DEVICE_STATE_AVAILABLE\x10\x01\x12\x17\n" +
 	"\x13DEVICE_STATE_IN_USE\x10\x02\x12\x19\n" +
-	"\x15DEVICE_STATE_INACTIVE\x10\x032\xdd\x03\n" +
+	"\x15DEVICE_STATE_INACTIVE\x10\x032\x8b\x05\n" +
 	"\rDeviceService\x12O\n" +
 	"\fCreateDevice\x12\x1e.device.v1.CreateDeviceRequest\x1a\x1f.device.v1.CreateDeviceResponse\x12F\n" +
 	"\tGetDevice\x12\x1b.device.v1.GetDeviceRequest\x1a\x1c.device.v1.GetDeviceResponse\x12L\n" +
 	"\vListDevices\x12\x1d.device.v1.ListDevicesRequest\x1a\x1e.device.v1.ListDevicesResponse\x12O\n" +
 	"\fUpdateDevice\x12\x1e.device.v1.UpdateDeviceRequest\x1a\x1f.device.v1.UpdateDeviceResponse\x12L\n" +
 	"\vPatchDevice\x12\x1d.device.v1.PatchDeviceRequest\x1a\x1e.device.v1.PatchDeviceResponse\x12F\n" +
-	"\fDeleteDevice\x12\x1e.device.v1.DeleteDeviceRequest\x1a\x16.google.protobuf.Empty2\xa1\x01\n" +
+	"\fDeleteDevice\x12\x1e.device.v1.DeleteDeviceRequest\x1a\x16.google.protobuf.Empty\x12\\\n" +
+	"\x11BulkCreateDevices\x12\x1e.device.v1.CreateDeviceRequest\x1a#.device.v1.BulkCreateDeviceResponse(\x010\x01\x12N\n" +
+	"\vWatchDevice\x12\x1d.device.v1.WatchDeviceRequest\x1a\x1e.device.v1.WatchDeviceResponse0\x012\xa1\x01\n" +
 	"\rHealthService\x12F\n" +
 	"\x05Check\x12\x1d.device.v1.HealthCheckRequest\x1a\x1e.device.v1.HealthCheckResponse\x12H\n" +
 	"\x05Watch\x12\x1d.device.v1.HealthCheckRequest\x1a\x1e.device.v1.HealthCheckResponse0\x01B\x9f\x01\n" +
@@ -1143,66 +1318,75 @@ func file_device_v1_device_proto_rawDescGZIP() []byte {
 }
 
 var file_device_v1_device_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_device_v1_device_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_device_v1_device_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_device_v1_device_proto_goTypes = []any{
 	(DeviceState)(0),                       // 0: device.v1.DeviceState
 	(HealthCheckResponse_ServingStatus)(0), // 1: device.v1.HealthCheckResponse.ServingStatus
 	(*Device)(nil),                         // 2: device.v1.Device
 	(*CreateDeviceRequest)(nil),            // 3: device.v1.CreateDeviceRequest
 	(*CreateDeviceResponse)(nil),           // 4: device.v1.CreateDeviceResponse
-	(*GetDeviceRequest)(nil),               // 5: device.v1.GetDeviceRequest
-	(*GetDeviceResponse)(nil),              // 6: device.v1.GetDeviceResponse
-	(*ListDevicesRequest)(nil),             // 7: device.v1.ListDevicesRequest
-	(*ListDevicesResponse)(nil),            // 8: device.v1.ListDevicesResponse
-	(*Pagination)(nil),                     // 9: device.v1.Pagination
-	(*UpdateDeviceRequest)(nil),            // 10: device.v1.UpdateDeviceRequest
-	(*UpdateDeviceResponse)(nil),           // 11: device.v1.UpdateDeviceResponse
-	(*PatchDeviceRequest)(nil),             // 12: device.v1.PatchDeviceRequest
-	(*PatchDeviceResponse)(nil),            // 13: device.v1.PatchDeviceResponse
-	(*DeleteDeviceRequest)(nil),            // 14: device.v1.DeleteDeviceRequest
-	(*HealthCheckRequest)(nil),             // 15: device.v1.HealthCheckRequest
-	(*HealthCheckResponse)(nil),            // 16: device.v1.HealthCheckResponse
-	(*timestamppb.Timestamp)(nil),          // 17: google.protobuf.Timestamp
-	(*fieldmaskpb.FieldMask)(nil),          // 18: google.protobuf.FieldMask
-	(*emptypb.Empty)(nil),                  // 19: google.protobuf.Empty
+	(*BulkCreateDeviceResponse)(nil),       // 5: device.v1.BulkCreateDeviceResponse
+	(*GetDeviceRequest)(nil),               // 6: device.v1.GetDeviceRequest
+	(*GetDeviceResponse)(nil),              // 7: device.v1.GetDeviceResponse
+	(*ListDevicesRequest)(nil),             // 8: device.v1.ListDevicesRequest
+	(*ListDevicesResponse)(nil),            // 9: device.v1.ListDevicesResponse
+	(*Pagination)(nil),                     // 10: device.v1.Pagination
+	(*UpdateDeviceRequest)(nil),            // 11: device.v1.UpdateDeviceRequest
+	(*UpdateDeviceResponse)(nil),           // 12: device.v1.UpdateDeviceResponse
+	(*PatchDeviceRequest)(nil),             // 13: device.v1.PatchDeviceRequest
+	(*PatchDeviceResponse)(nil),            // 14: device.v1.PatchDeviceResponse
+	(*DeleteDeviceRequest)(nil),            // 15: device.v1.DeleteDeviceRequest
+	(*WatchDeviceRequest)(nil),             // 16: device.v1.WatchDeviceRequest
+	(*WatchDeviceResponse)(nil),            // 17: device.v1.WatchDeviceResponse
+	(*HealthCheckRequest)(nil),             // 18: device.v1.HealthCheckRequest
+	(*HealthCheckResponse)(nil),            // 19: device.v1.HealthCheckResponse
+	(*timestamppb.Timestamp)(nil),          // 20: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),          // 21: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),                  // 22: google.protobuf.Empty
 }
 var file_device_v1_device_proto_depIdxs = []int32{
 	0,  // 0: device.v1.Device.state:type_name -> device.v1.DeviceState
-	17, // 1: device.v1.Device.created_at:type_name -> google.protobuf.Timestamp
-	17, // 2: device.v1.Device.updated_at:type_name -> google.protobuf.Timestamp
+	20, // 1: device.v1.Device.created_at:type_name -> google.protobuf.Timestamp
+	20, // 2: device.v1.Device.updated_at:type_name -> google.protobuf.Timestamp
 	0,  // 3: device.v1.CreateDeviceRequest.state:type_name -> device.v1.DeviceState
 	2,  // 4: device.v1.CreateDeviceResponse.device:type_name -> device.v1.Device
 	2,  // 5: device.v1.GetDeviceResponse.device:type_name -> device.v1.Device
 	0,  // 6: device.v1.ListDevicesRequest.states:type_name -> device.v1.DeviceState
 	2,  // 7: device.v1.ListDevicesResponse.devices:type_name -> device.v1.Device
-	9,  // 8: device.v1.ListDevicesResponse.pagination:type_name -> device.v1.Pagination
+	10, // 8: device.v1.ListDevicesResponse.pagination:type_name -> device.v1.Pagination
 	0,  // 9: device.v1.UpdateDeviceRequest.state:type_name -> device.v1.DeviceState
 	2,  // 10: device.v1.UpdateDeviceResponse.device:type_name -> device.v1.Device
 	0,  // 11: device.v1.PatchDeviceRequest.state:type_name -> device.v1.DeviceState
-	18, // 12: device.v1.PatchDeviceRequest.update_mask:type_name -> google.protobuf.FieldMask
-	2,  // 13: device.v1.PatchDeviceResponse.device:type_name -> device.v1.Device
-	1,  // 14: device.v1.HealthCheckResponse.status:type_name -> device.v1.HealthCheckResponse.ServingStatus
-	3,  // 15: device.v1.DeviceService.CreateDevice:input_type -> device.v1.CreateDeviceRequest
-	5,  // 16: device.v1.DeviceService.GetDevice:input_type -> device.v1.GetDeviceRequest
-	7,  // 17: device.v1.DeviceService.ListDevices:input_type -> device.v1.ListDevicesRequest
-	10, // 18: device.v1.DeviceService.UpdateDevice:input_type -> device.v1.UpdateDeviceRequest
-	12, // 19: device.v1.DeviceService.PatchDevice:input_type -> device.v1.PatchDeviceRequest
-	14, // 20: device.v1.DeviceService.DeleteDevice:input_type -> device.v1.DeleteDeviceRequest
-	15, // 21: device.v1.HealthService.Check:input_type -> device.v1.HealthCheckRequest
-	15, // 22: device.v1.HealthService.Watch:input_type -> device.v1.HealthCheckRequest
-	4,  // 23: device.v1.DeviceService.CreateDevice:output_type -> device.v1.CreateDeviceResponse
-	6,  // 24: device.v1.DeviceService.GetDevice:output_type -> device.v1.GetDeviceResponse
-	8,  // 25: device.v1.DeviceService.ListDevices:output_type -> device.v1.ListDevicesResponse
-	11, // 26: device.v1.DeviceService.UpdateDevice:output_type -> device.v1.UpdateDeviceResponse
-	13, // 27: device.v1.DeviceService.PatchDevice:output_type -> device.v1.PatchDeviceResponse
-	19, // 28: device.v1.DeviceService.DeleteDevice:output_type -> google.protobuf.Empty
-	16, // 29: device.v1.HealthService.Check:output_type -> device.v1.HealthCheckResponse
-	16, // 30: device.v1.HealthService.Watch:output_type -> device.v1.HealthCheckResponse
-	23, // [23:31] is the sub-list for method output_type
-	15, // [15:23] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	21, // 12: device.v1.PatchDeviceRequest.update_mask:type_name -> google.protobuf.FieldMask
+	20, // 13: device.v1.PatchDeviceRequest.expected_updated_at:type_name -> google.protobuf.Timestamp
+	2,  // 14: device.v1.PatchDeviceResponse.device:type_name -> device.v1.Device
+	2,  // 15: device.v1.WatchDeviceResponse.device:type_name -> device.v1.Device
+	1,  // 16: device.v1.HealthCheckResponse.status:type_name -> device.v1.HealthCheckResponse.ServingStatus
+	3,  // 17: device.v1.DeviceService.CreateDevice:input_type -> device.v1.CreateDeviceRequest
+	6,  // 18: device.v1.DeviceService.GetDevice:input_type -> device.v1.GetDeviceRequest
+	8,  // 19: device.v1.DeviceService.ListDevices:input_type -> device.v1.ListDevicesRequest
+	11, // 20: device.v1.DeviceService.UpdateDevice:input_type -> device.v1.UpdateDeviceRequest
+	13, // 21: device.v1.DeviceService.PatchDevice:input_type -> device.v1.PatchDeviceRequest
+	15, // 22: device.v1.DeviceService.DeleteDevice:input_type -> device.v1.DeleteDeviceRequest
+	3,  // 23: device.v1.DeviceService.BulkCreateDevices:input_type -> device.v1.CreateDeviceRequest
+	16, // 24: device.v1.DeviceService.WatchDevice:input_type -> device.v1.WatchDeviceRequest
+	18, // 25: device.v1.HealthService.Check:input_type -> device.v1.HealthCheckRequest
+	18, // 26: device.v1.HealthService.Watch:input_type -> device.v1.HealthCheckRequest
+	4,  // 27: device.v1.DeviceService.CreateDevice:output_type -> device.v1.CreateDeviceResponse
+	7,  // 28: device.v1.DeviceService.GetDevice:output_type -> device.v1.GetDeviceResponse
+	9,  // 29: device.v1.DeviceService.ListDevices:output_type -> device.v1.ListDevicesResponse
+	12, // 30: device.v1.DeviceService.UpdateDevice:output_type -> device.v1.UpdateDeviceResponse
+	14, // 31: device.v1.DeviceService.PatchDevice:output_type -> device.v1.PatchDeviceResponse
+	22, // 32: device.v1.DeviceService.DeleteDevice:output_type -> google.protobuf.Empty
+	5,  // 33: device.v1.DeviceService.BulkCreateDevices:output_type -> device.v1.BulkCreateDeviceResponse
+	17, // 34: device.v1.DeviceService.WatchDevice:output_type -> device.v1.WatchDeviceResponse
+	19, // 35: device.v1.HealthService.Check:output_type -> device.v1.HealthCheckResponse
+	19, // 36: device.v1.HealthService.Watch:output_type -> device.v1.HealthCheckResponse
+	27, // [27:37] is the sub-list for method output_type
+	17, // [17:27] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_device_v1_device_proto_init() }
@@ -1210,14 +1394,14 @@ func file_device_v1_device_proto_init() {
 	if File_device_v1_device_proto != nil {
 		return
 	}
-	file_device_v1_device_proto_msgTypes[10].OneofWrappers = []any{}
+	file_device_v1_device_proto_msgTypes[11].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_device_v1_device_proto_rawDesc), len(file_device_v1_device_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   15,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
@@ -0,0 +1,77 @@
+package devicev1_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	devicev1 "github.com/architeacher/devices/pkg/proto/device/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// deviceServiceDescriptor rebuilds the DeviceService descriptor from its
+// FileDescriptorProto, so the test also catches a file that no longer resolves.
+func deviceServiceDescriptor(t *testing.T) protoreflect.ServiceDescriptor {
+	t.Helper()
+
+	fdp := protodesc.ToFileDescriptorProto(devicev1.File_device_v1_device_proto)
+
+	file, err := protodesc.NewFile(fdp, protoregistry.GlobalFiles)
+	require.NoError(t, err)
+
+	service := file.Services().ByName("DeviceService")
+	require.NotNil(t, service)
+
+	return service
+}
+
+func TestDeviceService_MethodDescriptors(t *testing.T) {
+	t.Parallel()
+
+	service := deviceServiceDescriptor(t)
+	serverType := reflect.TypeFor[devicev1.DeviceServiceServer]()
+	emptyName := (&emptypb.Empty{}).ProtoReflect().Descriptor().FullName()
+
+	implemented := 0
+
+	for i := range serverType.NumMethod() {
+		name := serverType.Method(i).Name
+		if strings.HasPrefix(name, "mustEmbed") {
+			continue
+		}
+
+		implemented++
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			method := service.Methods().ByName(protoreflect.Name(name))
+			require.NotNil(t, method, "DeviceService has no %s method", name)
+			require.NotNil(t, method.Input())
+			require.NotNil(t, method.Output())
+
+			input := string(method.Input().Name())
+			require.True(t, strings.HasSuffix(input, "Request"), "%s takes %s", name, input)
+
+			if method.Output().FullName() == emptyName {
+				return
+			}
+
+			output := string(method.Output().Name())
+			require.True(t, strings.HasSuffix(output, "Response"), "%s returns %s", name, output)
+
+			if method.IsStreamingClient() || method.IsStreamingServer() {
+				return
+			}
+
+			require.Equal(t, name+"Request", input)
+			require.Equal(t, name+"Response", output)
+		})
+	}
+
+	require.Equal(t, service.Methods().Len(), implemented)
+}
@@ -20,24 +20,35 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	DeviceService_CreateDevice_FullMethodName = "/device.v1.DeviceService/CreateDevice"
-	DeviceService_GetDevice_FullMethodName    = "/device.v1.DeviceService/GetDevice"
-	DeviceService_ListDevices_FullMethodName  = "/device.v1.DeviceService/ListDevices"
-	DeviceService_UpdateDevice_FullMethodName = "/device.v1.DeviceService/UpdateDevice"
-	DeviceService_PatchDevice_FullMethodName  = "/device.v1.DeviceService/PatchDevice"
-	DeviceService_DeleteDevice_FullMethodName = "/device.v1.DeviceService/DeleteDevice"
+	DeviceService_CreateDevice_FullMethodName      = "/device.v1.DeviceService/CreateDevice"
+	DeviceService_GetDevice_FullMethodName         = "/device.v1.DeviceService/GetDevice"
+	DeviceService_ListDevices_FullMethodName       = "/device.v1.DeviceService/ListDevices"
+	DeviceService_UpdateDevice_FullMethodName      = "/device.v1.DeviceService/UpdateDevice"
+	DeviceService_PatchDevice_FullMethodName       = "/device.v1.DeviceService/PatchDevice"
+	DeviceService_DeleteDevice_FullMethodName      = "/device.v1.DeviceService/DeleteDevice"
+	DeviceService_BulkCreateDevices_FullMethodName = "/device.v1.DeviceService/BulkCreateDevices"
+	DeviceService_WatchDevice_FullMethodName       = "/device.v1.DeviceService/WatchDevice"
 )
 
 // DeviceServiceClient is the client API for DeviceService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type DeviceServiceClient interface {
+	// CreateDeviceRequest is also the item streamed to BulkCreateDevices.
+	// buf:lint:ignore RPC_REQUEST_RESPONSE_UNIQUE
 	CreateDevice(ctx context.Context, in *CreateDeviceRequest, opts ...grpc.CallOption) (*CreateDeviceResponse, error)
 	GetDevice(ctx context.Context, in *GetDeviceRequest, opts ...grpc.CallOption) (*GetDeviceResponse, error)
 	ListDevices(ctx context.Context, in *ListDevicesRequest, opts ...grpc.CallOption) (*ListDevicesResponse, error)
 	UpdateDevice(ctx context.Context, in *UpdateDeviceRequest, opts ...grpc.CallOption) (*UpdateDeviceResponse, error)
 	PatchDevice(ctx context.Context, in *PatchDeviceRequest, opts ...grpc.CallOption) (*PatchDeviceResponse, error)
 	DeleteDevice(ctx context.Context, in *DeleteDeviceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// BulkCreateDevices creates each streamed device in order and answers every
+	// request with its own result as soon as it has been processed.
+	// buf:lint:ignore RPC_REQUEST_RESPONSE_UNIQUE
+	BulkCreateDevices(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[CreateDeviceRequest, BulkCreateDeviceResponse], error)
+	// WatchDevice streams the device every time it changes, in the order the
+	// changes were made. The stream ends once the device has been deleted.
+	WatchDevice(ctx context.Context, in *WatchDeviceRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchDeviceResponse], error)
 }
 
 type deviceServiceClient struct {
@@ -108,16 +119,57 @@ func (c *deviceServiceClient) DeleteDevice(ctx context.Context, in *DeleteDevice
 	return out, nil
 }
 
+func (c *deviceServiceClient) BulkCreateDevices(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[CreateDeviceRequest, BulkCreateDeviceResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &DeviceService_ServiceDesc.Streams[0], DeviceService_BulkCreateDevices_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[CreateDeviceRequest, BulkCreateDeviceResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DeviceService_BulkCreateDevicesClient = grpc.BidiStreamingClient[CreateDeviceRequest, BulkCreateDeviceResponse]
+
+func (c *deviceServiceClient) WatchDevice(ctx context.Context, in *WatchDeviceRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchDeviceResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &DeviceService_ServiceDesc.Streams[1], DeviceService_WatchDevice_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchDeviceRequest, WatchDeviceResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DeviceService_WatchDeviceClient = grpc.ServerStreamingClient[WatchDeviceResponse]
+
 // DeviceServiceServer is the server API for DeviceService service.
 // All implementations must embed UnimplementedDeviceServiceServer
 // for forward compatibility.
 type DeviceServiceServer interface {
+	// CreateDeviceRequest is also the item streamed to BulkCreateDevices.
+	// buf:lint:ignore RPC_REQUEST_RESPONSE_UNIQUE
 	CreateDevice(context.Context, *CreateDeviceRequest) (*CreateDeviceResponse, error)
 	GetDevice(context.Context, *GetDeviceRequest) (*GetDeviceResponse, error)
 	ListDevices(context.Context, *ListDevicesRequest) (*ListDevicesResponse, error)
 	UpdateDevice(context.Context, *UpdateDeviceRequest) (*UpdateDeviceResponse, error)
 	PatchDevice(context.Context, *PatchDeviceRequest) (*PatchDeviceResponse, error)
 	DeleteDevice(context.Context, *DeleteDeviceRequest) (*emptypb.Empty, error)
+	// BulkCreateDevices creates each streamed device in order and answers every
+	// request with its own result as soon as it has been processed.
+	// buf:lint:ignore RPC_REQUEST_RESPONSE_UNIQUE
+	BulkCreateDevices(grpc.BidiStreamingServer[CreateDeviceRequest, BulkCreateDeviceResponse]) error
+	// WatchDevice streams the device every time it changes, in the order the
+	// changes were made. The stream ends once the device has been deleted.
+	WatchDevice(*WatchDeviceRequest, grpc.ServerStreamingServer[WatchDeviceResponse]) error
 	mustEmbedUnimplementedDeviceServiceServer()
 }
 
@@ -146,6 +198,12 @@ func (UnimplementedDeviceServiceServer) PatchDevice(context.Context, *PatchDevic
 func (UnimplementedDeviceServiceServer) DeleteDevice(context.Context, *DeleteDeviceRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteDevice not implemented")
 }
+func (UnimplementedDeviceServiceServer) BulkCreateDevices(grpc.BidiStreamingServer[CreateDeviceRequest, BulkCreateDeviceResponse]) error {
+	return status.Error(codes.Unimplemented, "method BulkCreateDevices not implemented")
+}
+func (UnimplementedDeviceServiceServer) WatchDevice(*WatchDeviceRequest, grpc.ServerStreamingServer[WatchDeviceResponse]) error {
+	return status.Error(codes.Unimplemented, "method WatchDevice not implemented")
+}
 func (UnimplementedDeviceServiceServer) mustEmbedUnimplementedDeviceServiceServer() {}
 func (UnimplementedDeviceServiceServer) testEmbeddedByValue()                       {}
 
@@ -275,6 +333,24 @@ func _DeviceService_DeleteDevice_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DeviceService_BulkCreateDevices_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DeviceServiceServer).BulkCreateDevices(&grpc.GenericServerStream[CreateDeviceRequest, BulkCreateDeviceResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DeviceService_BulkCreateDevicesServer = grpc.BidiStreamingServer[CreateDeviceRequest, BulkCreateDeviceResponse]
+
+func _DeviceService_WatchDevice_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchDeviceRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DeviceServiceServer).WatchDevice(m, &grpc.GenericServerStream[WatchDeviceRequest, WatchDeviceResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DeviceService_WatchDeviceServer = grpc.ServerStreamingServer[WatchDeviceResponse]
+
 // DeviceService_ServiceDesc is the grpc.ServiceDesc for DeviceService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -307,7 +383,19 @@ var DeviceService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _DeviceService_DeleteDevice_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "BulkCreateDevices",
+			Handler:       _DeviceService_BulkCreateDevices_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "WatchDevice",
+			Handler:       _DeviceService_WatchDevice_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "device/v1/device.proto",
 }
 
@@ -319,8 +407,12 @@ const (
 // HealthServiceClient is the client API for HealthService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// HealthService follows grpc.health.v1, where Check and Watch share their messages.
 type HealthServiceClient interface {
+	// buf:lint:ignore RPC_REQUEST_RESPONSE_UNIQUE
 	Check(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error)
+	// buf:lint:ignore RPC_REQUEST_RESPONSE_UNIQUE
 	Watch(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[HealthCheckResponse], error)
 }
 
@@ -364,8 +456,12 @@ type HealthService_WatchClient = grpc.ServerStreamingClient[HealthCheckResponse]
 // HealthServiceServer is the server API for HealthService service.
 // All implementations must embed UnimplementedHealthServiceServer
 // for forward compatibility.
+//
+// HealthService follows grpc.health.v1, where Check and Watch share their messages.
 type HealthServiceServer interface {
+	// buf:lint:ignore RPC_REQUEST_RESPONSE_UNIQUE
 	Check(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error)
+	// buf:lint:ignore RPC_REQUEST_RESPONSE_UNIQUE
 	Watch(*HealthCheckRequest, grpc.ServerStreamingServer[HealthCheckResponse]) error
 	mustEmbedUnimplementedHealthServiceServer()
 }
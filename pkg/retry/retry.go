@@ -0,0 +1,133 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"time"
+)
+
+const (
+	DefaultMaxAttempts       uint    = 3
+	DefaultBackoffBase               = 100 * time.Millisecond
+	DefaultBackoffMultiplier float64 = 2
+	DefaultMaxDelay                  = 5 * time.Second
+)
+
+// Policy decides how often and how long apart Do calls its function.
+type Policy struct {
+	// MaxAttempts is the total number of calls, including the first. Zero means one.
+	MaxAttempts uint
+	// BackoffBase is the wait after the first failed attempt.
+	BackoffBase time.Duration
+	// BackoffMultiplier grows the wait after every further failure.
+	BackoffMultiplier float64
+	// Jitter randomises each wait by up to this fraction in either direction.
+	Jitter float64
+	// MaxDelay caps a single wait before jitter. Zero leaves it uncapped.
+	MaxDelay time.Duration
+	// Retryable reports whether an error is worth another attempt. A nil
+	// predicate retries every error.
+	Retryable func(error) bool
+	// Backoff, when set, replaces Delay in picking the wait after the given number of
+	// failures, the last of which returned err, e.g. to back off differently per error.
+	Backoff func(failures uint, err error) time.Duration
+}
+
+// ExponentialBackoff returns the policy with unset fields filled from the defaults.
+func ExponentialBackoff(policy Policy) Policy {
+	if policy.MaxAttempts == 0 {
+		policy.MaxAttempts = DefaultMaxAttempts
+	}
+
+	if policy.BackoffBase == 0 {
+		policy.BackoffBase = DefaultBackoffBase
+	}
+
+	if policy.BackoffMultiplier == 0 {
+		policy.BackoffMultiplier = DefaultBackoffMultiplier
+	}
+
+	if policy.MaxDelay == 0 {
+		policy.MaxDelay = DefaultMaxDelay
+	}
+
+	return policy
+}
+
+// ConstantBackoff returns a policy waiting the same delay between up to maxAttempts calls.
+func ConstantBackoff(delay time.Duration, maxAttempts uint) Policy {
+	return Policy{
+		MaxAttempts:       maxAttempts,
+		BackoffBase:       delay,
+		BackoffMultiplier: 1,
+	}
+}
+
+// Delay returns the wait before the retry following the given number of failures,
+// counting from one: BackoffBase * BackoffMultiplier^(failures-1), capped by MaxDelay
+// and then jittered.
+func (p Policy) Delay(failures uint) time.Duration {
+	if failures == 0 {
+		return 0
+	}
+
+	multiplier := p.BackoffMultiplier
+	if multiplier <= 0 {
+		multiplier = 1
+	}
+
+	delay := float64(p.BackoffBase) * math.Pow(multiplier, float64(failures-1))
+
+	if maxDelay := float64(p.MaxDelay); maxDelay > 0 && delay > maxDelay {
+		delay = maxDelay
+	}
+
+	delay *= 1 + p.Jitter*(rand.Float64()*2-1)
+	if delay < 0 {
+		return 0
+	}
+
+	return time.Duration(delay)
+}
+
+// Do calls fn until it succeeds, returns an error the policy does not retry, or
+// MaxAttempts calls have been made, and returns fn's last error. Cancelling ctx
+// stops the retries; the returned error then matches both ctx.Err() and fn's last error.
+func Do(ctx context.Context, policy Policy, fn func() error) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	maxAttempts := max(policy.MaxAttempts, 1)
+
+	for attempt := uint(1); ; attempt++ {
+		err := fn()
+		if err == nil {
+			return nil
+		}
+
+		if attempt >= maxAttempts || (policy.Retryable != nil && !policy.Retryable(err)) {
+			return err
+		}
+
+		timer := time.NewTimer(policy.wait(attempt, err))
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return errors.Join(ctx.Err(), err)
+		case <-timer.C:
+		}
+	}
+}
+
+func (p Policy) wait(failures uint, err error) time.Duration {
+	if p.Backoff != nil {
+		return p.Backoff(failures, err)
+	}
+
+	return p.Delay(failures)
+}
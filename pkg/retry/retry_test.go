@@ -0,0 +1,226 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	errTransient = errors.New("transient")
+	errPermanent = errors.New("permanent")
+)
+
+func TestDo(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name          string
+		policy        Policy
+		results       []error
+		expectedErr   error
+		expectedCalls int
+	}{
+		{
+			name:          "calls MaxAttempts times on repeated failure",
+			policy:        ConstantBackoff(time.Millisecond, 4),
+			results:       []error{errTransient, errTransient, errTransient, errTransient, nil},
+			expectedErr:   errTransient,
+			expectedCalls: 4,
+		},
+		{
+			name:          "stops on the first success",
+			policy:        ConstantBackoff(time.Millisecond, 5),
+			results:       []error{errTransient, nil, errTransient},
+			expectedCalls: 2,
+		},
+		{
+			name:          "first success makes a single call",
+			policy:        ConstantBackoff(time.Millisecond, 5),
+			results:       []error{nil},
+			expectedCalls: 1,
+		},
+		{
+			name:          "zero attempts still calls once",
+			policy:        Policy{},
+			results:       []error{errTransient, nil},
+			expectedErr:   errTransient,
+			expectedCalls: 1,
+		},
+		{
+			name: "non-retryable error is returned at once",
+			policy: Policy{
+				MaxAttempts: 5,
+				BackoffBase: time.Millisecond,
+				Retryable:   func(err error) bool { return errors.Is(err, errTransient) },
+			},
+			results:       []error{errTransient, errPermanent, nil},
+			expectedErr:   errPermanent,
+			expectedCalls: 2,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			calls := 0
+
+			err := Do(t.Context(), tc.policy, func() error {
+				result := tc.results[calls]
+				calls++
+
+				return result
+			})
+
+			require.ErrorIs(t, err, tc.expectedErr)
+			require.Equal(t, tc.expectedCalls, calls)
+		})
+	}
+}
+
+func TestDo_Backoff(t *testing.T) {
+	t.Parallel()
+
+	var (
+		failures []uint
+		errs     []error
+	)
+
+	policy := Policy{
+		MaxAttempts: 3,
+		BackoffBase: time.Hour,
+		Backoff: func(failed uint, err error) time.Duration {
+			failures = append(failures, failed)
+			errs = append(errs, err)
+
+			return time.Millisecond
+		},
+	}
+
+	results := []error{errTransient, errPermanent, nil}
+	calls := 0
+
+	err := Do(t.Context(), policy, func() error {
+		result := results[calls]
+		calls++
+
+		return result
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, 3, calls)
+	require.Equal(t, []uint{1, 2}, failures, "Backoff replaces the hour-long Delay")
+	require.Equal(t, []error{errTransient, errPermanent}, errs)
+}
+
+func TestDo_ContextCancellation(t *testing.T) {
+	t.Parallel()
+
+	t.Run("aborts the wait between attempts", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(t.Context())
+		calls := 0
+
+		err := Do(ctx, ConstantBackoff(time.Hour, 3), func() error {
+			calls++
+			cancel()
+
+			return errTransient
+		})
+
+		require.ErrorIs(t, err, context.Canceled)
+		require.ErrorIs(t, err, errTransient, "the last error is kept")
+		require.Equal(t, 1, calls)
+	})
+
+	t.Run("cancelled context makes no call", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(t.Context())
+		cancel()
+
+		err := Do(ctx, ConstantBackoff(time.Millisecond, 3), func() error {
+			t.Fatal("fn must not be called")
+
+			return nil
+		})
+
+		require.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestPolicy_Delay(t *testing.T) {
+	t.Parallel()
+
+	policy := Policy{
+		BackoffBase:       10 * time.Millisecond,
+		BackoffMultiplier: 2,
+		MaxDelay:          50 * time.Millisecond,
+	}
+
+	cases := []struct {
+		name     string
+		policy   Policy
+		failures uint
+		expected time.Duration
+	}{
+		{name: "no failure waits nothing", policy: policy, failures: 0, expected: 0},
+		{name: "first failure waits the base", policy: policy, failures: 1, expected: 10 * time.Millisecond},
+		{name: "grows by the multiplier", policy: policy, failures: 3, expected: 40 * time.Millisecond},
+		{name: "capped by MaxDelay", policy: policy, failures: 10, expected: 50 * time.Millisecond},
+		{name: "constant backoff", policy: ConstantBackoff(7*time.Millisecond, 3), failures: 5, expected: 7 * time.Millisecond},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tc.expected, tc.policy.Delay(tc.failures))
+		})
+	}
+
+	t.Run("jitter stays within its fraction", func(t *testing.T) {
+		t.Parallel()
+
+		jittered := policy
+		jittered.Jitter = 0.5
+
+		for range 100 {
+			delay := jittered.Delay(1)
+			require.GreaterOrEqual(t, delay, 5*time.Millisecond)
+			require.LessOrEqual(t, delay, 15*time.Millisecond)
+		}
+	})
+}
+
+func TestExponentialBackoff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("fills unset fields with defaults", func(t *testing.T) {
+		t.Parallel()
+
+		policy := ExponentialBackoff(Policy{Jitter: 0.1})
+
+		require.Equal(t, DefaultMaxAttempts, policy.MaxAttempts)
+		require.Equal(t, DefaultBackoffBase, policy.BackoffBase)
+		require.Equal(t, DefaultBackoffMultiplier, policy.BackoffMultiplier)
+		require.Equal(t, DefaultMaxDelay, policy.MaxDelay)
+		require.InDelta(t, 0.1, policy.Jitter, 0)
+	})
+
+	t.Run("keeps set fields", func(t *testing.T) {
+		t.Parallel()
+
+		set := Policy{MaxAttempts: 7, BackoffBase: time.Second, BackoffMultiplier: 3, MaxDelay: time.Minute}
+
+		require.Equal(t, set.MaxAttempts, ExponentialBackoff(set).MaxAttempts)
+		require.Equal(t, set.BackoffBase, ExponentialBackoff(set).BackoffBase)
+		require.InDelta(t, set.BackoffMultiplier, ExponentialBackoff(set).BackoffMultiplier, 0)
+		require.Equal(t, set.MaxDelay, ExponentialBackoff(set).MaxDelay)
+	})
+}
@@ -0,0 +1,49 @@
+// Package trace provides tracing helpers for tests.
+package trace
+
+import (
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// RecordingTracerProvider is a TracerProvider that keeps every ended span in memory,
+// so tests can assert on the spans the code under test emits.
+type RecordingTracerProvider struct {
+	*sdktrace.TracerProvider
+
+	exporter *tracetest.InMemoryExporter
+}
+
+// NewRecordingTracerProvider creates a TracerProvider exporting spans synchronously
+// to an in-memory exporter as soon as they end.
+func NewRecordingTracerProvider() *RecordingTracerProvider {
+	exporter := tracetest.NewInMemoryExporter()
+
+	return &RecordingTracerProvider{
+		TracerProvider: sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)),
+		exporter:       exporter,
+	}
+}
+
+// Spans returns the ended spans in the order they ended.
+func (p *RecordingTracerProvider) Spans() []sdktrace.ReadOnlySpan {
+	return p.exporter.GetSpans().Snapshots()
+}
+
+// SpansByName returns the ended spans with the given name.
+func (p *RecordingTracerProvider) SpansByName(name string) []sdktrace.ReadOnlySpan {
+	var spans []sdktrace.ReadOnlySpan
+
+	for _, span := range p.Spans() {
+		if span.Name() == name {
+			spans = append(spans, span)
+		}
+	}
+
+	return spans
+}
+
+// Reset drops the recorded spans.
+func (p *RecordingTracerProvider) Reset() {
+	p.exporter.Reset()
+}
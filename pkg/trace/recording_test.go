@@ -0,0 +1,38 @@
+package trace_test
+
+import (
+	"testing"
+
+	"github.com/architeacher/devices/pkg/trace"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func TestRecordingTracerProvider(t *testing.T) {
+	t.Parallel()
+
+	tp := trace.NewRecordingTracerProvider()
+	tracer := tp.Tracer("queries.getdevicequery")
+
+	_, span := tracer.Start(t.Context(), "Handle")
+	span.SetAttributes(attribute.String("device.id", "42"))
+	span.End()
+
+	_, open := tracer.Start(t.Context(), "Open")
+
+	spans := tp.Spans()
+	require.Len(t, spans, 1, "only ended spans are recorded")
+	require.Equal(t, "Handle", spans[0].Name())
+	require.Equal(t, "queries.getdevicequery", spans[0].InstrumentationScope().Name)
+	require.Contains(t, spans[0].Attributes(), attribute.String("device.id", "42"))
+
+	open.End()
+
+	require.Len(t, tp.Spans(), 2)
+	require.Len(t, tp.SpansByName("Open"), 1)
+	require.Empty(t, tp.SpansByName("Missing"))
+
+	tp.Reset()
+
+	require.Empty(t, tp.Spans())
+}
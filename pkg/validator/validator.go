@@ -0,0 +1,256 @@
+// Package validator validates structs through `validate` tags, wrapping
+// go-playground/validator with the device-specific tags shared by the services.
+package validator
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/architeacher/devices/pkg/cursor"
+	playground "github.com/go-playground/validator/v10"
+	"github.com/go-playground/validator/v10/non-standard/validators"
+	"github.com/google/uuid"
+)
+
+const (
+	// TagDeviceID requires a string holding a UUID, plain or in URN form.
+	TagDeviceID = "deviceid"
+	// TagDeviceState requires one of the states set with WithDeviceStates.
+	TagDeviceState = "devicestate"
+	// TagPageCursor requires a token signed by the configured cursor codec, or shaped like
+	// one when no codec is set; empty is the first page.
+	TagPageCursor = "pagecursor"
+	// TagSortField requires a field listed in the tag parameter, optionally prefixed with "-".
+	TagSortField = "sortfield"
+	// TagNotBlank requires a value that is not empty or only whitespace.
+	TagNotBlank = "notblank"
+)
+
+var defaultValidator = New()
+
+type (
+	// FieldError describes one field failing one validation tag.
+	FieldError struct {
+		// Field is the path of the field, named after its json tag or its lower-cased
+		// Go name, with the index for elements of a slice, e.g. sort[1].
+		Field string
+		// Tag is the validation tag that failed, e.g. max.
+		Tag string
+		// Param is the tag parameter, e.g. 255 for max=255.
+		Param string
+		// Value is the rejected value.
+		Value any
+		// Message is a human-readable description of the failure.
+		Message string
+	}
+
+	// ValidationErrors lists every failing field, in struct field order.
+	ValidationErrors []FieldError
+
+	// Validator validates structs. It is safe for concurrent use.
+	Validator struct {
+		validate *playground.Validate
+		cursors  *cursor.CursorCodec
+		states   []string
+		aliases  map[string]string
+	}
+
+	// Option configures the Validator.
+	Option func(*Validator)
+)
+
+// WithCursorCodec sets the codec whose signature pagecursor fields must carry. Without
+// it, a cursor is only checked to be shaped like a signed token, leaving the signature
+// to the service that issued it.
+func WithCursorCodec(codec *cursor.CursorCodec) Option {
+	return func(v *Validator) {
+		v.cursors = codec
+	}
+}
+
+// WithDeviceStates sets the states devicestate fields accept, so they come from the
+// domain model rather than a copy of it. Without it, no state is accepted.
+func WithDeviceStates(states ...string) Option {
+	return func(v *Validator) {
+		v.states = slices.Clone(states)
+	}
+}
+
+// WithAlias registers alias as a shorthand for tags, e.g. to build a tag from domain
+// constants that a struct tag literal cannot reference. Failures are reported under
+// the tag that failed, not under the alias.
+func WithAlias(alias, tags string) Option {
+	return func(v *Validator) {
+		if v.aliases == nil {
+			v.aliases = make(map[string]string)
+		}
+
+		v.aliases[alias] = tags
+	}
+}
+
+// New creates a Validator with the device-specific tags registered.
+func New(opts ...Option) *Validator {
+	v := &Validator{
+		validate: playground.New(playground.WithRequiredStructEnabled()),
+	}
+
+	for _, opt := range opts {
+		opt(v)
+	}
+
+	v.validate.RegisterTagNameFunc(fieldName)
+
+	// The functions and tags are all valid, so registration cannot fail.
+	_ = v.validate.RegisterValidation(TagDeviceID, isDeviceID)
+	_ = v.validate.RegisterValidation(TagDeviceState, v.isDeviceState)
+	_ = v.validate.RegisterValidation(TagPageCursor, v.isPageCursor)
+	_ = v.validate.RegisterValidation(TagSortField, isSortField)
+	_ = v.validate.RegisterValidation(TagNotBlank, validators.NotBlank)
+
+	for alias, tags := range v.aliases {
+		v.validate.RegisterAlias(alias, tags)
+	}
+
+	return v
+}
+
+// Validate checks the struct with the default Validator, which has no cursor codec, no
+// device states and no aliases.
+func Validate(v any) (ValidationErrors, error) {
+	return defaultValidator.Validate(v)
+}
+
+// Validate checks every tagged field of s. It returns the failing fields, or nil when
+// s is valid, and an error only when s cannot be validated at all, e.g. it is not a struct.
+func (v *Validator) Validate(s any) (ValidationErrors, error) {
+	err := v.validate.Struct(s)
+	if err == nil {
+		return nil, nil
+	}
+
+	var fieldErrs playground.ValidationErrors
+	if !errors.As(err, &fieldErrs) {
+		return nil, fmt.Errorf("failed to validate %T: %w", s, err)
+	}
+
+	errs := make(ValidationErrors, 0, len(fieldErrs))
+	for _, fieldErr := range fieldErrs {
+		errs = append(errs, FieldError{
+			Field:   fieldPath(fieldErr.Namespace()),
+			Tag:     fieldErr.ActualTag(),
+			Param:   fieldErr.Param(),
+			Value:   fieldErr.Value(),
+			Message: v.message(fieldErr),
+		})
+	}
+
+	return errs, nil
+}
+
+func (e ValidationErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, fieldErr := range e {
+		messages[i] = fieldErr.Message
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+// BaseField returns the field name without the slice index, e.g. sort for sort[1].
+func (e FieldError) BaseField() string {
+	name, _, _ := strings.Cut(e.Field, "[")
+
+	return name
+}
+
+func isDeviceID(fl playground.FieldLevel) bool {
+	_, err := uuid.Parse(fl.Field().String())
+
+	return err == nil
+}
+
+func (v *Validator) isDeviceState(fl playground.FieldLevel) bool {
+	return slices.Contains(v.states, fl.Field().String())
+}
+
+func (v *Validator) isPageCursor(fl playground.FieldLevel) bool {
+	token := fl.Field().String()
+	if token == "" {
+		return true
+	}
+
+	if v.cursors == nil {
+		return cursor.WellFormed(token)
+	}
+
+	return v.cursors.Verify(token) == nil
+}
+
+func isSortField(fl playground.FieldLevel) bool {
+	return slices.Contains(strings.Fields(fl.Param()), strings.TrimPrefix(fl.Field().String(), "-"))
+}
+
+// fieldName names a field after its json tag, falling back to its Go name with the
+// first letter lower-cased.
+func fieldName(field reflect.StructField) string {
+	if name, _, _ := strings.Cut(field.Tag.Get("json"), ","); name != "" && name != "-" {
+		return name
+	}
+
+	return strings.ToLower(field.Name[:1]) + field.Name[1:]
+}
+
+// fieldPath drops the struct name leading the namespace, e.g. DeviceFilter.sort[1].
+func fieldPath(namespace string) string {
+	_, path, found := strings.Cut(namespace, ".")
+	if !found {
+		return namespace
+	}
+
+	return path
+}
+
+func (v *Validator) message(fieldErr playground.FieldError) string {
+	field := fieldPath(fieldErr.Namespace())
+	isText := fieldErr.Kind() == reflect.String
+
+	switch fieldErr.ActualTag() {
+	case "required", TagNotBlank:
+		return field + " is required"
+	case "min", "gte":
+		if isText {
+			return fmt.Sprintf("%s must be at least %s characters", field, fieldErr.Param())
+		}
+
+		return fmt.Sprintf("%s must be greater than or equal to %s", field, fieldErr.Param())
+	case "max", "lte":
+		if isText {
+			return fmt.Sprintf("%s must be at most %s characters", field, fieldErr.Param())
+		}
+
+		return fmt.Sprintf("%s must be less than or equal to %s", field, fieldErr.Param())
+	case "oneof":
+		return fmt.Sprintf("%s must be one of %s", field, strings.Join(strings.Fields(fieldErr.Param()), ", "))
+	case TagDeviceID:
+		return field + " must be a valid UUID"
+	case TagDeviceState:
+		if len(v.states) == 0 {
+			return field + " must be a valid device state"
+		}
+
+		return fmt.Sprintf("%s must be one of %s", field, strings.Join(v.states, ", "))
+	case TagPageCursor:
+		return field + " must be a valid page cursor"
+	case TagSortField:
+		name, _, _ := strings.Cut(field, "[")
+
+		return fmt.Sprintf("unsupported %s field %q: must be one of %s",
+			name, fieldErr.Value(), strings.Join(strings.Fields(fieldErr.Param()), ", "))
+	default:
+		return fmt.Sprintf("%s failed the %s validation", field, fieldErr.ActualTag())
+	}
+}
@@ -0,0 +1,252 @@
+package validator_test
+
+import (
+	"testing"
+
+	"github.com/architeacher/devices/pkg/cursor"
+	"github.com/architeacher/devices/pkg/validator"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	deviceRequest struct {
+		ID    string `json:"id" validate:"deviceid"`
+		State string `json:"state" validate:"devicestate"`
+	}
+
+	listRequest struct {
+		Cursor string   `validate:"pagecursor"`
+		Sort   []string `validate:"dive,sortfield=name createdAt"`
+	}
+
+	namedRequest struct {
+		Name string `validate:"notblank,max=5"`
+		Size uint   `validate:"min=1,max=10"`
+	}
+
+	aliasedRequest struct {
+		Size uint     `validate:"pagesize"`
+		Sort []string `validate:"dive,devicesort"`
+	}
+)
+
+var deviceStates = []string{"available", "in-use", "inactive"}
+
+func TestValidate_DeviceID(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name            string
+		id              string
+		expectedMessage string
+	}{
+		{
+			name: "uuid",
+			id:   "0190a0d6-8f4e-7c1a-9b2d-3e4f5a6b7c8d",
+		},
+		{
+			name: "urn uuid",
+			id:   "urn:uuid:0190a0d6-8f4e-7c1a-9b2d-3e4f5a6b7c8d",
+		},
+		{
+			name:            "not a uuid",
+			id:              "device-1",
+			expectedMessage: "id must be a valid UUID",
+		},
+		{
+			name:            "empty",
+			expectedMessage: "id must be a valid UUID",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			errs, err := validator.New(validator.WithDeviceStates(deviceStates...)).Validate(deviceRequest{ID: tc.id, State: "available"})
+			require.NoError(t, err)
+
+			if tc.expectedMessage == "" {
+				require.Nil(t, errs)
+
+				return
+			}
+
+			require.Len(t, errs, 1)
+			require.Equal(t, "id", errs[0].Field)
+			require.Equal(t, validator.TagDeviceID, errs[0].Tag)
+			require.Equal(t, tc.expectedMessage, errs[0].Message)
+		})
+	}
+}
+
+func TestValidate_DeviceState(t *testing.T) {
+	t.Parallel()
+
+	v := validator.New(validator.WithDeviceStates(deviceStates...))
+
+	for _, state := range deviceStates {
+		t.Run(state, func(t *testing.T) {
+			t.Parallel()
+
+			errs, err := v.Validate(deviceRequest{ID: "0190a0d6-8f4e-7c1a-9b2d-3e4f5a6b7c8d", State: state})
+			require.NoError(t, err)
+			require.Nil(t, errs)
+		})
+	}
+
+	for _, state := range []string{"", "broken", "Available", " in-use"} {
+		t.Run("rejects "+state, func(t *testing.T) {
+			t.Parallel()
+
+			errs, err := v.Validate(deviceRequest{ID: "0190a0d6-8f4e-7c1a-9b2d-3e4f5a6b7c8d", State: state})
+			require.NoError(t, err)
+			require.Len(t, errs, 1)
+			require.Equal(t, "state", errs[0].Field)
+			require.Equal(t, state, errs[0].Value)
+			require.Equal(t, "state must be one of available, in-use, inactive", errs[0].Message)
+		})
+	}
+}
+
+func TestValidate_DeviceStateWithoutStates(t *testing.T) {
+	t.Parallel()
+
+	errs, err := validator.Validate(deviceRequest{ID: "0190a0d6-8f4e-7c1a-9b2d-3e4f5a6b7c8d", State: "available"})
+	require.NoError(t, err)
+	require.Len(t, errs, 1)
+	require.Equal(t, validator.TagDeviceState, errs[0].Tag)
+	require.Equal(t, "state must be a valid device state", errs[0].Message)
+}
+
+func TestValidate_PageCursor(t *testing.T) {
+	t.Parallel()
+
+	codec := cursor.NewCursor([]byte("secret"))
+	token, err := codec.Encode("2026-01-13T01:00:00Z", "0190a0d6-8f4e-7c1a-9b2d-3e4f5a6b7c8d", true)
+	require.NoError(t, err)
+
+	forged, err := cursor.NewCursor([]byte("other")).Encode("2026-01-13T01:00:00Z", "0190a0d6-8f4e-7c1a-9b2d-3e4f5a6b7c8d", true)
+	require.NoError(t, err)
+
+	cases := []struct {
+		name        string
+		validator   *validator.Validator
+		cursor      string
+		expectValid bool
+	}{
+		{
+			name:        "empty cursor is the first page",
+			validator:   validator.New(),
+			expectValid: true,
+		},
+		{
+			name:        "token signed by the codec",
+			validator:   validator.New(validator.WithCursorCodec(codec)),
+			cursor:      token,
+			expectValid: true,
+		},
+		{
+			name:      "token signed with another secret",
+			validator: validator.New(validator.WithCursorCodec(codec)),
+			cursor:    forged,
+		},
+		{
+			name:      "malformed token",
+			validator: validator.New(validator.WithCursorCodec(codec)),
+			cursor:    "not a cursor",
+		},
+		{
+			name:        "well-formed token without a codec",
+			validator:   validator.New(),
+			cursor:      forged,
+			expectValid: true,
+		},
+		{
+			name:      "malformed token without a codec",
+			validator: validator.New(),
+			cursor:    "not a cursor",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			errs, err := tc.validator.Validate(listRequest{Cursor: tc.cursor})
+			require.NoError(t, err)
+
+			if tc.expectValid {
+				require.Nil(t, errs)
+
+				return
+			}
+
+			require.Len(t, errs, 1)
+			require.Equal(t, "cursor", errs[0].Field)
+			require.Equal(t, "cursor must be a valid page cursor", errs[0].Message)
+		})
+	}
+}
+
+func TestValidate_SortField(t *testing.T) {
+	t.Parallel()
+
+	errs, err := validator.Validate(listRequest{Sort: []string{"name", "-createdAt"}})
+	require.NoError(t, err)
+	require.Nil(t, errs)
+
+	errs, err = validator.Validate(listRequest{Sort: []string{"-name", "-price"}})
+	require.NoError(t, err)
+	require.Len(t, errs, 1)
+	require.Equal(t, "sort[1]", errs[0].Field)
+	require.Equal(t, "sort", errs[0].BaseField())
+	require.Equal(t, `unsupported sort field "-price": must be one of name, createdAt`, errs[0].Message)
+}
+
+func TestValidate_Alias(t *testing.T) {
+	t.Parallel()
+
+	v := validator.New(
+		validator.WithAlias("pagesize", "min=1,max=10"),
+		validator.WithAlias("devicesort", "sortfield=name createdAt"),
+	)
+
+	errs, err := v.Validate(aliasedRequest{Size: 10, Sort: []string{"-name"}})
+	require.NoError(t, err)
+	require.Nil(t, errs)
+
+	errs, err = v.Validate(aliasedRequest{Size: 11, Sort: []string{"price"}})
+	require.NoError(t, err)
+	require.Len(t, errs, 2)
+	require.Equal(t, "max", errs[0].Tag, "reported under the failing tag, not the alias")
+	require.Equal(t, "10", errs[0].Param)
+	require.Equal(t, "size must be less than or equal to 10", errs[0].Message)
+	require.Equal(t, validator.TagSortField, errs[1].Tag)
+	require.Equal(t, `unsupported sort field "price": must be one of name, createdAt`, errs[1].Message)
+}
+
+func TestValidate_Messages(t *testing.T) {
+	t.Parallel()
+
+	errs, err := validator.Validate(namedRequest{Name: "  ", Size: 11})
+	require.NoError(t, err)
+	require.Len(t, errs, 2)
+	require.Equal(t, "name is required", errs[0].Message)
+	require.Equal(t, "size must be less than or equal to 10", errs[1].Message)
+	require.Equal(t, "name is required; size must be less than or equal to 10", errs.Error())
+
+	errs, err = validator.Validate(namedRequest{Name: "ééééééé", Size: 0})
+	require.NoError(t, err)
+	require.Len(t, errs, 2)
+	require.Equal(t, "name must be at most 5 characters", errs[0].Message)
+	require.Equal(t, "size must be greater than or equal to 1", errs[1].Message)
+}
+
+func TestValidate_NotAStruct(t *testing.T) {
+	t.Parallel()
+
+	errs, err := validator.Validate("device")
+	require.Error(t, err)
+	require.Nil(t, errs)
+}
@@ -0,0 +1,67 @@
+// Command openapi-spec writes the OpenAPI document the public HTTP handlers are
+// generated from, as YAML. The document is read from the spec oapi-codegen embeds in
+// the handlers package, so the output always matches what the server routes and
+// validates requests against.
+package main
+
+//go:generate go run . -out ../../../../docs/contracts/openapi/devices/v1/public/openapi.yaml
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/adapters/inbound/http/handlers/public"
+	"gopkg.in/yaml.v3"
+)
+
+const header = "# Code generated by cmd/openapi-spec from the spec embedded in the public handlers. DO NOT EDIT.\n"
+
+func main() {
+	out := flag.String("out", "openapi.yaml", "path of the generated OpenAPI document")
+	flag.Parse()
+
+	spec, err := render()
+	if err != nil {
+		log.Fatalf("rendering the OpenAPI document: %v", err)
+	}
+
+	if err := os.WriteFile(*out, spec, 0o644); err != nil {
+		log.Fatalf("writing %s: %v", *out, err)
+	}
+}
+
+// render returns the embedded spec as YAML. Map keys are sorted, so the output is
+// stable across runs and can be diffed against the committed document.
+func render() ([]byte, error) {
+	swagger, err := public.GetSwagger()
+	if err != nil {
+		return nil, fmt.Errorf("loading the embedded spec: %w", err)
+	}
+
+	// Operations outside the public tags are filtered out at generation time, leaving
+	// their paths empty.
+	for path, item := range swagger.Paths.Map() {
+		if len(item.Operations()) == 0 {
+			swagger.Paths.Delete(path)
+		}
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(header)
+
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+
+	if err := encoder.Encode(swagger); err != nil {
+		return nil, fmt.Errorf("encoding the spec: %w", err)
+	}
+
+	if err := encoder.Close(); err != nil {
+		return nil, fmt.Errorf("encoding the spec: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	specPath        = "../../../../docs/contracts/openapi/devices/v1/public/openapi.yaml"
+	schemaRefPrefix = "#/components/schemas/"
+)
+
+type OpenAPISpecTestSuite struct {
+	suite.Suite
+}
+
+func TestOpenAPISpecTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(OpenAPISpecTestSuite))
+}
+
+func (s *OpenAPISpecTestSuite) TestCommittedSpecIsUpToDate() {
+	s.T().Parallel()
+
+	committed, err := os.ReadFile(specPath)
+	s.Require().NoError(err)
+
+	rendered, err := render()
+	s.Require().NoError(err)
+
+	s.Require().Equal(string(rendered), string(committed), "run go generate ./cmd/openapi-spec and commit the result")
+}
+
+func (s *OpenAPISpecTestSuite) TestSpecIsValid() {
+	s.T().Parallel()
+
+	doc := s.loadSpec()
+
+	s.Require().NoError(doc.Validate(s.T().Context()))
+	s.Require().NotNil(doc.Paths.Value("/devices"))
+	s.Require().NotNil(doc.Paths.Value("/devices/{deviceId}"))
+	s.Require().Nil(doc.Paths.Value("/admin/cache/health"), "admin endpoints are not part of the public spec")
+}
+
+func (s *OpenAPISpecTestSuite) TestSpecHasNoRefCycles() {
+	s.T().Parallel()
+
+	doc := s.loadSpec()
+
+	refs := make(map[string][]string, len(doc.Components.Schemas))
+	for name, schema := range doc.Components.Schemas {
+		refs[name] = schemaRefs(schema.Value, nil)
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+
+	marks := make(map[string]int, len(refs))
+
+	var visit func(name string, path []string)
+	visit = func(name string, path []string) {
+		switch marks[name] {
+		case visiting:
+			s.Failf("$ref cycle", "%s -> %s", strings.Join(path, " -> "), name)
+
+			return
+		case done:
+			return
+		}
+
+		marks[name] = visiting
+
+		for _, ref := range refs[name] {
+			visit(ref, append(path, name))
+		}
+
+		marks[name] = done
+	}
+
+	for name := range refs {
+		visit(name, nil)
+	}
+}
+
+func (s *OpenAPISpecTestSuite) loadSpec() *openapi3.T {
+	doc, err := openapi3.NewLoader().LoadFromFile(specPath)
+	s.Require().NoError(err)
+
+	return doc
+}
+
+// schemaRefs appends the component schemas referenced from schema to refs. A
+// referenced schema is not descended into, so every edge leads to a component name.
+func schemaRefs(schema *openapi3.Schema, refs []string) []string {
+	if schema == nil {
+		return refs
+	}
+
+	children := make([]*openapi3.SchemaRef, 0, len(schema.Properties)+4)
+	for _, property := range schema.Properties {
+		children = append(children, property)
+	}
+
+	children = append(children, schema.Items, schema.Not, schema.AdditionalProperties.Schema)
+	children = append(children, schema.AllOf...)
+	children = append(children, schema.OneOf...)
+	children = append(children, schema.AnyOf...)
+
+	for _, child := range children {
+		switch {
+		case child == nil:
+		case strings.HasPrefix(child.Ref, schemaRefPrefix):
+			refs = append(refs, strings.TrimPrefix(child.Ref, schemaRefPrefix))
+		default:
+			refs = schemaRefs(child.Value, refs)
+		}
+	}
+
+	return refs
+}
@@ -5,10 +5,22 @@ import (
 
 	"github.com/architeacher/devices/pkg/logger"
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/adapters/inbound/http/handlers/admin"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/adapters/inbound/http/handlers/graphql"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/adapters/inbound/http/middleware"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/config"
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/ports"
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/usecases"
 	"github.com/go-chi/chi/v5"
 	chimiddleware "github.com/go-chi/chi/v5/middleware"
+	"github.com/throttled/throttled/v2/store/memstore"
+)
+
+const (
+	// adminPurgeRateLimitMaxKeys bounds the admin tokens tracked by the purge rate limit.
+	adminPurgeRateLimitMaxKeys = 64
+
+	adminGraphQLPath    = "/admin/graphql"
+	adminPlaygroundPath = "/admin/playground"
 )
 
 // AdminRouterConfig holds dependencies for the admin router.
@@ -16,6 +28,14 @@ type AdminRouterConfig struct {
 	App          *usecases.WebApplication
 	DevicesCache ports.DevicesCache
 	Logger       logger.Logger
+	// AdminToken is the value required in the X-Admin-Token header for /admin/ routes.
+	// Without a token those routes answer 503.
+	AdminToken string
+	// Environment is the config.GetEnvironment() value; the GraphQL endpoint and its
+	// playground are not served in production.
+	Environment int
+	// PurgeRateLimit throttles the cache purge endpoints per admin token.
+	PurgeRateLimit config.AdminRateLimit
 }
 
 // NewAdminRouter creates a router for internal admin endpoints.
@@ -26,10 +46,32 @@ func NewAdminRouter(cfg AdminRouterConfig) http.Handler {
 	router.Use(chimiddleware.RealIP)
 	router.Use(chimiddleware.Recoverer)
 
+	if cfg.AdminToken == "" {
+		cfg.Logger.Warn().Msg("admin router: no admin token configured, admin endpoints will return 503")
+	}
+
+	// The token is checked first, so requests without it never spend the purge quota.
+	router.Use(middleware.AdminTokenMiddleware(cfg.AdminToken))
+
+	purgeStore, err := memstore.NewCtx(adminPurgeRateLimitMaxKeys)
+	if err != nil {
+		cfg.Logger.Fatal().Err(err).Msg("failed to create the admin purge rate limit store")
+	}
+
+	router.Use(middleware.AdminPurgeRateLimitMiddleware(cfg.PurgeRateLimit, purgeStore, cfg.Logger))
+
 	if cfg.DevicesCache == nil {
 		cfg.Logger.Warn().Msg("admin router: devices cache not available, cache endpoints will return 503")
 	}
 
+	if cfg.Environment != config.Production {
+		playground := graphql.PlaygroundHandler(adminPlaygroundPath, adminGraphQLPath)
+
+		router.Handle(adminGraphQLPath, graphql.NewHandler(cfg.App, true))
+		router.Method(http.MethodGet, adminPlaygroundPath, playground)
+		router.Method(http.MethodGet, adminPlaygroundPath+"/*", playground)
+	}
+
 	adminHandler := admin.NewAdminHandler(cfg.DevicesCache, cfg.App)
 
 	// Use generated routing from oapi-codegen for consistency with OpenAPI spec.
@@ -2,6 +2,7 @@ package admin
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 	"runtime"
 	"time"
@@ -9,6 +10,7 @@ import (
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/domain/model"
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/ports"
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/usecases"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/usecases/commands"
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/usecases/queries"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
@@ -22,6 +24,9 @@ const (
 	statusHealthy     = "healthy"
 	statusUnhealthy   = "unhealthy"
 	statusUnavailable = "unavailable"
+
+	// noExpiryTTLSeconds is reported for cache entries that never expire.
+	noExpiryTTLSeconds = -1
 )
 
 // AdminHandler provides internal admin endpoints for cache management and system health.
@@ -65,6 +70,34 @@ func (h *AdminHandler) GetCacheHealth(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// GetCacheStats returns hit/miss counters, key count and memory usage of the cache.
+func (h *AdminHandler) GetCacheStats(w http.ResponseWriter, r *http.Request) {
+	if h.cache == nil {
+		writeJSONResponse(w, http.StatusServiceUnavailable, map[string]string{
+			"error": "cache not available",
+		})
+
+		return
+	}
+
+	stats, err := h.cache.Stats(r.Context())
+	if err != nil {
+		writeJSONResponse(w, http.StatusServiceUnavailable, map[string]string{
+			"error": "failed to read cache stats: " + err.Error(),
+		})
+
+		return
+	}
+
+	writeJSONResponse(w, http.StatusOK, CacheStats{
+		HitCount:        stats.HitCount,
+		MissCount:       stats.MissCount,
+		KeyCount:        stats.KeyCount,
+		UsedMemoryBytes: stats.UsedMemoryBytes,
+		HitRatio:        stats.HitRatio,
+	})
+}
+
 // PurgeAllDeviceCaches purges all device-related caches.
 func (h *AdminHandler) PurgeAllDeviceCaches(w http.ResponseWriter, r *http.Request) {
 	if h.cache == nil {
@@ -121,6 +154,110 @@ func (h *AdminHandler) PurgeDeviceCache(w http.ResponseWriter, r *http.Request,
 	})
 }
 
+// GetDeviceCacheStatus reports whether a device is cached, its cache key and remaining TTL.
+func (h *AdminHandler) GetDeviceCacheStatus(w http.ResponseWriter, r *http.Request, deviceId openapi_types.UUID) {
+	if h.cache == nil {
+		writeJSONResponse(w, http.StatusServiceUnavailable, map[string]string{
+			"error": "cache not available",
+		})
+
+		return
+	}
+
+	deviceID, err := model.ParseDeviceID(deviceId.String())
+	if err != nil {
+		writeJSONResponse(w, http.StatusBadRequest, map[string]string{
+			"error": "invalid device ID: " + err.Error(),
+		})
+
+		return
+	}
+
+	ttl, cached, err := h.cache.DeviceCacheTTL(r.Context(), deviceID)
+	if err != nil {
+		writeJSONResponse(w, http.StatusInternalServerError, map[string]string{
+			"error": "failed to read device cache status: " + err.Error(),
+		})
+
+		return
+	}
+
+	if !cached {
+		writeJSONResponse(w, http.StatusNotFound, map[string]string{
+			"error": "device not cached",
+		})
+
+		return
+	}
+
+	ttlSeconds := int64(ttl / time.Second)
+	if ttl < 0 {
+		ttlSeconds = noExpiryTTLSeconds
+	}
+
+	writeJSONResponse(w, http.StatusOK, CacheEntryStatus{
+		Cached:     true,
+		TtlSeconds: ttlSeconds,
+		Key:        h.cache.DeviceKey(deviceID),
+	})
+}
+
+// ForceDeviceState sets a device's state without validating the transition, e.g. to
+// release a device stuck in use.
+func (h *AdminHandler) ForceDeviceState(w http.ResponseWriter, r *http.Request, deviceId openapi_types.UUID) {
+	deviceID, err := model.ParseDeviceID(deviceId.String())
+	if err != nil {
+		writeJSONResponse(w, http.StatusBadRequest, map[string]string{
+			"error": "invalid device ID: " + err.Error(),
+		})
+
+		return
+	}
+
+	var req ForceDeviceStateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONResponse(w, http.StatusBadRequest, map[string]string{
+			"error": "invalid request body: " + err.Error(),
+		})
+
+		return
+	}
+
+	state, err := model.ParseState(string(req.State))
+	if err != nil {
+		writeJSONResponse(w, http.StatusBadRequest, map[string]string{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	device, err := h.app.Commands.ForceState.Handle(r.Context(), commands.ForceStateCommand{
+		ID:    deviceID,
+		State: state,
+	})
+	if err != nil {
+		if errors.Is(err, model.ErrDeviceNotFound) {
+			writeJSONResponse(w, http.StatusNotFound, map[string]string{
+				"error": "device not found",
+			})
+
+			return
+		}
+
+		writeJSONResponse(w, http.StatusInternalServerError, map[string]string{
+			"error": "failed to force device state: " + err.Error(),
+		})
+
+		return
+	}
+
+	writeJSONResponse(w, http.StatusOK, ForcedDeviceState{
+		Id:    device.ID.UUID,
+		State: DeviceState(device.State),
+	})
+}
+
 // PurgeDeviceListCaches purges all device list caches.
 func (h *AdminHandler) PurgeDeviceListCaches(w http.ResponseWriter, r *http.Request) {
 	if h.cache == nil {
@@ -5,13 +5,18 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/alicebob/miniredis/v2"
 	"github.com/architeacher/devices/pkg/logger"
 	"github.com/architeacher/devices/pkg/metrics/noop"
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/adapters/inbound/http/handlers/admin"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/adapters/repos"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/config"
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/domain/model"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/infrastructure"
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/mocks"
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/usecases"
 	openapi_types "github.com/oapi-codegen/runtime/types"
@@ -29,12 +34,15 @@ func TestAdminHandlerTestSuite(t *testing.T) {
 }
 
 func newTestApp(healthChecker *mocks.FakeHealthChecker) *usecases.WebApplication {
-	deviceSvc := &mocks.FakeDevicesService{}
+	return newTestAppWithDevices(&mocks.FakeDevicesService{}, healthChecker)
+}
 
+func newTestAppWithDevices(deviceSvc *mocks.FakeDevicesService, healthChecker *mocks.FakeHealthChecker) *usecases.WebApplication {
 	return usecases.NewWebApplication(
 		deviceSvc,
 		healthChecker,
 		nil,
+		nil,
 		logger.NewTestLogger(),
 		noop.NewMetricsClient(),
 		otelNoop.NewTracerProvider(),
@@ -139,6 +147,289 @@ func (s *AdminHandlerTestSuite) TestGetCacheHealth_NilCache() {
 	s.Require().Equal(http.StatusServiceUnavailable, rec.Code)
 }
 
+// newMiniredisCache returns a devices cache backed by an in-memory KeyDB stand-in.
+func (s *AdminHandlerTestSuite) newMiniredisCache() (*repos.DevicesCacheRepository, *infrastructure.KeydbClient) {
+	miniRedis := miniredis.RunT(s.T())
+
+	client := infrastructure.NewKeyDBClient(config.Cache{
+		Address:      miniRedis.Addr(),
+		PoolSize:     1,
+		DialTimeout:  time.Second,
+		ReadTimeout:  time.Second,
+		WriteTimeout: time.Second,
+	}, logger.NewTestLogger())
+
+	return repos.NewDevicesCacheRepository(client, logger.NewTestLogger(), nil), client
+}
+
+func (s *AdminHandlerTestSuite) TestGetCacheStats_Success() {
+	s.T().Parallel()
+
+	cache, client := s.newMiniredisCache()
+	defer func() { _ = client.Close() }()
+
+	device := model.NewDevice("Test Device", "Test Brand", model.StateAvailable)
+	s.Require().NoError(cache.SetDevice(s.T().Context(), device, time.Hour))
+
+	app := newTestApp(newDefaultHealthChecker())
+	handler := admin.NewAdminHandler(cache, app)
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/cache/stats", nil)
+	rec := httptest.NewRecorder()
+
+	handler.GetCacheStats(rec, req)
+
+	s.Require().Equal(http.StatusOK, rec.Code)
+	s.Require().Equal("application/json", rec.Header().Get("Content-Type"))
+
+	var response admin.CacheStats
+	err := json.Unmarshal(rec.Body.Bytes(), &response)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(1), response.KeyCount)
+	s.Require().GreaterOrEqual(response.HitRatio, 0.0)
+	s.Require().LessOrEqual(response.HitRatio, 1.0)
+}
+
+func (s *AdminHandlerTestSuite) TestGetCacheStats_ClosedCache() {
+	s.T().Parallel()
+
+	cache, client := s.newMiniredisCache()
+	s.Require().NoError(client.Close())
+
+	app := newTestApp(newDefaultHealthChecker())
+	handler := admin.NewAdminHandler(cache, app)
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/cache/stats", nil)
+	rec := httptest.NewRecorder()
+
+	handler.GetCacheStats(rec, req)
+
+	s.Require().Equal(http.StatusServiceUnavailable, rec.Code)
+
+	var response map[string]string
+	err := json.Unmarshal(rec.Body.Bytes(), &response)
+	s.Require().NoError(err)
+	s.Require().Contains(response["error"], "failed to read cache stats")
+}
+
+func (s *AdminHandlerTestSuite) TestGetCacheStats_NilCache() {
+	s.T().Parallel()
+
+	app := newTestApp(newDefaultHealthChecker())
+	handler := admin.NewAdminHandler(nil, app)
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/cache/stats", nil)
+	rec := httptest.NewRecorder()
+
+	handler.GetCacheStats(rec, req)
+
+	s.Require().Equal(http.StatusServiceUnavailable, rec.Code)
+}
+
+func (s *AdminHandlerTestSuite) TestGetDeviceCacheStatus() {
+	s.T().Parallel()
+
+	cases := []struct {
+		name               string
+		ttl                time.Duration
+		expectedTTLSeconds int64
+	}{
+		{
+			name:               "expiring entry",
+			ttl:                4 * time.Minute,
+			expectedTTLSeconds: 240,
+		},
+		{
+			name:               "entry without expiry",
+			ttl:                0,
+			expectedTTLSeconds: -1,
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			cache, client := s.newMiniredisCache()
+			defer func() { _ = client.Close() }()
+
+			device := model.NewDevice("Test Device", "Test Brand", model.StateAvailable)
+			s.Require().NoError(cache.SetDevice(s.T().Context(), device, tc.ttl))
+
+			app := newTestApp(newDefaultHealthChecker())
+			handler := admin.NewAdminHandler(cache, app)
+
+			req := httptest.NewRequest(http.MethodGet, "/admin/devices/"+device.ID.String()+"/cache-status", nil)
+			rec := httptest.NewRecorder()
+
+			handler.GetDeviceCacheStatus(rec, req, device.ID.UUID)
+
+			s.Require().Equal(http.StatusOK, rec.Code)
+
+			var response map[string]any
+			err := json.Unmarshal(rec.Body.Bytes(), &response)
+			s.Require().NoError(err)
+			s.Require().Equal(true, response["cached"])
+			s.Require().InDelta(float64(tc.expectedTTLSeconds), response["ttl_seconds"], 0)
+			s.Require().Equal("device:v1:"+device.ID.String(), response["key"])
+		})
+	}
+}
+
+func (s *AdminHandlerTestSuite) TestGetDeviceCacheStatus_NotCached() {
+	s.T().Parallel()
+
+	cache, client := s.newMiniredisCache()
+	defer func() { _ = client.Close() }()
+
+	app := newTestApp(newDefaultHealthChecker())
+	handler := admin.NewAdminHandler(cache, app)
+	deviceID := model.NewDeviceID()
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/devices/"+deviceID.String()+"/cache-status", nil)
+	rec := httptest.NewRecorder()
+
+	handler.GetDeviceCacheStatus(rec, req, deviceID.UUID)
+
+	s.Require().Equal(http.StatusNotFound, rec.Code)
+
+	var response map[string]string
+	err := json.Unmarshal(rec.Body.Bytes(), &response)
+	s.Require().NoError(err)
+	s.Require().Equal("device not cached", response["error"])
+}
+
+func (s *AdminHandlerTestSuite) TestGetDeviceCacheStatus_NilCache() {
+	s.T().Parallel()
+
+	app := newTestApp(newDefaultHealthChecker())
+	handler := admin.NewAdminHandler(nil, app)
+	deviceID := model.NewDeviceID()
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/devices/"+deviceID.String()+"/cache-status", nil)
+	rec := httptest.NewRecorder()
+
+	handler.GetDeviceCacheStatus(rec, req, deviceID.UUID)
+
+	s.Require().Equal(http.StatusServiceUnavailable, rec.Code)
+}
+
+func (s *AdminHandlerTestSuite) TestGetDeviceCacheStatus_Error() {
+	s.T().Parallel()
+
+	cache := &mocks.FakeDevicesCache{}
+	cache.DeviceCacheTTLReturns(0, false, errors.New("connection refused"))
+	app := newTestApp(newDefaultHealthChecker())
+	handler := admin.NewAdminHandler(cache, app)
+	deviceID := model.NewDeviceID()
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/devices/"+deviceID.String()+"/cache-status", nil)
+	rec := httptest.NewRecorder()
+
+	handler.GetDeviceCacheStatus(rec, req, deviceID.UUID)
+
+	s.Require().Equal(http.StatusInternalServerError, rec.Code)
+	s.Require().Equal(1, cache.DeviceCacheTTLCallCount())
+}
+
+func (s *AdminHandlerTestSuite) TestForceDeviceState() {
+	s.T().Parallel()
+
+	cases := []struct {
+		name   string
+		target model.State
+	}{
+		{
+			name:   "in-use device is released",
+			target: model.StateAvailable,
+		},
+		{
+			name:   "transition the state machine rejects",
+			target: model.StateInactive,
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			deviceID := model.NewDeviceID()
+			deviceSvc := &mocks.FakeDevicesService{}
+			deviceSvc.GetDeviceReturns(&model.Device{ID: deviceID, State: model.StateInUse}, nil)
+			deviceSvc.PatchDeviceReturns(&model.Device{ID: deviceID, State: tc.target}, nil)
+
+			handler := admin.NewAdminHandler(nil, newTestAppWithDevices(deviceSvc, newDefaultHealthChecker()))
+
+			body := `{"state":"` + tc.target.String() + `"}`
+			req := httptest.NewRequest(http.MethodPost, "/admin/devices/"+deviceID.String()+"/state", strings.NewReader(body))
+			rec := httptest.NewRecorder()
+
+			handler.ForceDeviceState(rec, req, deviceID.UUID)
+
+			s.Require().Equal(http.StatusOK, rec.Code)
+
+			var response admin.ForcedDeviceState
+			s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+			s.Require().Equal(deviceID.UUID, response.Id)
+			s.Require().Equal(admin.DeviceState(tc.target), response.State)
+
+			s.Require().Equal(1, deviceSvc.PatchDeviceCallCount())
+			_, _, updates := deviceSvc.PatchDeviceArgsForCall(0)
+			s.Require().Equal(map[string]any{"state": tc.target.String()}, updates)
+		})
+	}
+}
+
+func (s *AdminHandlerTestSuite) TestForceDeviceState_BadRequest() {
+	s.T().Parallel()
+
+	cases := []struct {
+		name string
+		body string
+	}{
+		{
+			name: "malformed JSON",
+			body: `{"state":`,
+		},
+		{
+			name: "unknown state",
+			body: `{"state":"broken"}`,
+		},
+		{
+			name: "missing state",
+			body: `{}`,
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			deviceID := model.NewDeviceID()
+			deviceSvc := &mocks.FakeDevicesService{}
+			handler := admin.NewAdminHandler(nil, newTestAppWithDevices(deviceSvc, newDefaultHealthChecker()))
+
+			req := httptest.NewRequest(http.MethodPost, "/admin/devices/"+deviceID.String()+"/state", strings.NewReader(tc.body))
+			rec := httptest.NewRecorder()
+
+			handler.ForceDeviceState(rec, req, deviceID.UUID)
+
+			s.Require().Equal(http.StatusBadRequest, rec.Code)
+			s.Require().Zero(deviceSvc.PatchDeviceCallCount())
+		})
+	}
+}
+
+func (s *AdminHandlerTestSuite) TestForceDeviceState_NotFound() {
+	s.T().Parallel()
+
+	deviceID := model.NewDeviceID()
+	deviceSvc := &mocks.FakeDevicesService{}
+	deviceSvc.PatchDeviceReturns(nil, model.ErrDeviceNotFound)
+	handler := admin.NewAdminHandler(nil, newTestAppWithDevices(deviceSvc, newDefaultHealthChecker()))
+
+	req := httptest.NewRequest(http.MethodPost, "/admin/devices/"+deviceID.String()+"/state", strings.NewReader(`{"state":"available"}`))
+	rec := httptest.NewRecorder()
+
+	handler.ForceDeviceState(rec, req, deviceID.UUID)
+
+	s.Require().Equal(http.StatusNotFound, rec.Code)
+}
+
 func (s *AdminHandlerTestSuite) TestPurgeAllDeviceCaches_Success() {
 	s.T().Parallel()
 
@@ -22,7 +22,8 @@ import (
 )
 
 const (
-	BasicAuthScopes = "BasicAuth.Scopes"
+	AdminTokenScopes = "AdminToken.Scopes"
+	BasicAuthScopes  = "BasicAuth.Scopes"
 )
 
 // Defines values for CacheDependencyCheckStatus.
@@ -89,6 +90,46 @@ const (
 	ApiVersionHeaderV1 ApiVersionHeader = "v1"
 )
 
+// Defines values for ExportFormatParam.
+const (
+	Csv    ExportFormatParam = "csv"
+	Ndjson ExportFormatParam = "ndjson"
+)
+
+// BulkCreateDeviceResult Outcome of creating one item of a bulk request
+type BulkCreateDeviceResult struct {
+	// Code Machine-readable error code, set when the item failed
+	Code *string `json:"code,omitempty"`
+
+	// Details Invalid fields of the item, for validation errors
+	Details *[]ErrorDetail `json:"details,omitempty"`
+
+	// Device A device resource
+	Device *Device `json:"device,omitempty"`
+
+	// Index Position of the item in the request body, starting at 0
+	Index int `json:"index"`
+
+	// Message Human-readable error message, set when the item failed
+	Message *string `json:"message,omitempty"`
+
+	// Status HTTP status the item would have had as a single create request
+	Status int `json:"status"`
+}
+
+// BulkCreateDevices Request body for creating several devices. Each item has the fields of CreateDevice and is
+// validated on its own, so an invalid item fails without rejecting the rest of the batch.
+type BulkCreateDevices = []map[string]interface{}
+
+// BulkCreateDevicesEnvelope Response envelope listing the outcome of every item of a bulk create request, in request order
+type BulkCreateDevicesEnvelope struct {
+	Data []BulkCreateDeviceResult `json:"data"`
+
+	// Meta Response metadata containing tracing information and API versioning.
+	// All successful responses include this field to support observability and debugging.
+	Meta Meta `json:"meta"`
+}
+
 // CacheDependencyCheck defines model for CacheDependencyCheck.
 type CacheDependencyCheck struct {
 	// Details Cache-specific details
@@ -119,6 +160,18 @@ type CacheDependencyCheck struct {
 // CacheDependencyCheckStatus The status of the dependency
 type CacheDependencyCheckStatus string
 
+// CacheEntryStatus Cache status of a single device entry
+type CacheEntryStatus struct {
+	// Cached Whether the device is currently cached
+	Cached bool `json:"cached"`
+
+	// Key Cache key of the device entry
+	Key string `json:"key"`
+
+	// TtlSeconds Remaining time-to-live in seconds, or -1 when the entry never expires
+	TtlSeconds int64 `json:"ttl_seconds"`
+}
+
 // CacheError Error response for cache operations
 type CacheError struct {
 	// Error Error message describing the failure
@@ -158,6 +211,24 @@ type CachePurge struct {
 	Status string `json:"status"`
 }
 
+// CacheStats Cache server statistics
+type CacheStats struct {
+	// HitCount Number of successful key lookups since the server started
+	HitCount int64 `json:"hitCount"`
+
+	// HitRatio Share of lookups that were hits, or 0 when there were no lookups
+	HitRatio float64 `json:"hitRatio"`
+
+	// KeyCount Number of keys in the selected database
+	KeyCount int64 `json:"keyCount"`
+
+	// MissCount Number of failed key lookups since the server started
+	MissCount int64 `json:"missCount"`
+
+	// UsedMemoryBytes Memory allocated by the cache server in bytes
+	UsedMemoryBytes int64 `json:"usedMemoryBytes"`
+}
+
 // CreateDevice Request body for creating a new device
 type CreateDevice struct {
 	// Brand The brand/manufacturer of the device
@@ -283,6 +354,15 @@ type ErrorDetail struct {
 	Message string `json:"message"`
 }
 
+// ForcedDeviceState Response after forcing a device state change
+type ForcedDeviceState struct {
+	// Id ID of the device whose state was forced
+	Id openapi_types.UUID `json:"id"`
+
+	// State The current state of the device
+	State DeviceState `json:"state"`
+}
+
 // Health Comprehensive health check response with system metrics
 type Health struct {
 	// Checks Status of individual dependency checks grouped by category
@@ -494,6 +574,16 @@ type Readiness struct {
 // ReadinessStatus The overall readiness status of the service
 type ReadinessStatus string
 
+// SimilarDevicesEnvelope Response envelope containing the devices similar to a device, without pagination
+type SimilarDevicesEnvelope struct {
+	// Data Similar devices, at most 5
+	Data []Device `json:"data"`
+
+	// Meta Response metadata containing tracing information and API versioning.
+	// All successful responses include this field to support observability and debugging.
+	Meta Meta `json:"meta"`
+}
+
 // SystemInfo System resource information
 type SystemInfo struct {
 	// CpuCores Number of CPU cores available
@@ -506,6 +596,12 @@ type SystemInfo struct {
 	Memory *MemoryInfo `json:"memory,omitempty"`
 }
 
+// TransitionDeviceState Request body for transitioning a device to a new state
+type TransitionDeviceState struct {
+	// State The current state of the device
+	State DeviceState `json:"state"`
+}
+
 // UpdateDevice Request body for fully updating a device (PUT)
 type UpdateDevice struct {
 	// Brand The brand/manufacturer of the device.
@@ -568,6 +664,9 @@ type CursorParam = string
 // DeviceIdParam defines model for DeviceIdParam.
 type DeviceIdParam = openapi_types.UUID
 
+// ExportFormatParam defines model for ExportFormatParam.
+type ExportFormatParam string
+
 // FieldsParam defines model for FieldsParam.
 type FieldsParam = string
 
@@ -604,12 +703,30 @@ type TraceparentHeader = string
 // TracestateHeader defines model for TracestateHeader.
 type TracestateHeader = string
 
+// AdminBadRequest Error response for cache operations
+type AdminBadRequest = CacheError
+
+// AdminDeviceNotFound Error response for cache operations
+type AdminDeviceNotFound = CacheError
+
+// AdminServerError Error response for cache operations
+type AdminServerError = CacheError
+
+// AdminUnauthorized Error response for cache operations
+type AdminUnauthorized = CacheError
+
 // BadRequest Standard error response format
 type BadRequest = Error
 
 // CacheBadRequest Error response for cache operations
 type CacheBadRequest = CacheError
 
+// CacheDeviceNotCached Error response for cache operations
+type CacheDeviceNotCached = CacheError
+
+// CacheDeviceStatus Cache status of a single device entry
+type CacheDeviceStatus = CacheEntryStatus
+
 // CacheHealthOk Cache health status response
 type CacheHealthOk = CacheHealth
 
@@ -631,6 +748,9 @@ type CachePurgePattern = CachePatternPurge
 // CacheServerError Error response for cache operations
 type CacheServerError = CacheError
 
+// CacheStatsOk Cache server statistics
+type CacheStatsOk = CacheStats
+
 // CacheUnavailable Error response for cache operations
 type CacheUnavailable = CacheError
 
@@ -643,9 +763,15 @@ type DeviceCreated = DeviceEnvelope
 // DeviceRetrieved Response envelope containing a single device with metadata
 type DeviceRetrieved = DeviceEnvelope
 
+// DeviceStateForced Response after forcing a device state change
+type DeviceStateForced = ForcedDeviceState
+
 // DeviceUpdated Response envelope containing a single device with metadata
 type DeviceUpdated = DeviceEnvelope
 
+// DevicesBulkCreated Response envelope listing the outcome of every item of a bulk create request, in request order
+type DevicesBulkCreated = BulkCreateDevicesEnvelope
+
 // DevicesList Response envelope containing a paginated list of devices with metadata
 type DevicesList = DevicesListEnvelope
 
@@ -667,6 +793,9 @@ type NotAcceptable = Error
 // NotFound Standard error response format
 type NotFound = Error
 
+// PayloadTooLarge Standard error response format
+type PayloadTooLarge = Error
+
 // PreconditionFailed Standard error response format
 type PreconditionFailed = Error
 
@@ -682,6 +811,9 @@ type ReadinessOk = Readiness
 // ServerError Standard error response format
 type ServerError = Error
 
+// SimilarDevices Response envelope containing the devices similar to a device, without pagination
+type SimilarDevices = SimilarDevicesEnvelope
+
 // Unauthorized Standard error response format
 type Unauthorized = Error
 
@@ -695,6 +827,9 @@ type PurgeCacheByPatternParams struct {
 	Pattern CachePatternParam `form:"pattern" json:"pattern"`
 }
 
+// ForceDeviceStateJSONRequestBody defines body for ForceDeviceState for application/json ContentType.
+type ForceDeviceStateJSONRequestBody = TransitionDeviceState
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// Purge all device caches
@@ -712,6 +847,15 @@ type ServerInterface interface {
 	// Purge cache entries by pattern
 	// (DELETE /admin/cache/pattern)
 	PurgeCacheByPattern(w http.ResponseWriter, r *http.Request, params PurgeCacheByPatternParams)
+	// Get cache statistics
+	// (GET /admin/cache/stats)
+	GetCacheStats(w http.ResponseWriter, r *http.Request)
+	// Get cache status for a specific device
+	// (GET /admin/devices/{deviceId}/cache-status)
+	GetDeviceCacheStatus(w http.ResponseWriter, r *http.Request, deviceId DeviceIdParam)
+	// Force a device state change
+	// (POST /admin/devices/{deviceId}/state)
+	ForceDeviceState(w http.ResponseWriter, r *http.Request, deviceId DeviceIdParam)
 	// Health check
 	// (GET /health)
 	HealthCheck(w http.ResponseWriter, r *http.Request)
@@ -757,6 +901,24 @@ func (_ Unimplemented) PurgeCacheByPattern(w http.ResponseWriter, r *http.Reques
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get cache statistics
+// (GET /admin/cache/stats)
+func (_ Unimplemented) GetCacheStats(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get cache status for a specific device
+// (GET /admin/devices/{deviceId}/cache-status)
+func (_ Unimplemented) GetDeviceCacheStatus(w http.ResponseWriter, r *http.Request, deviceId DeviceIdParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Force a device state change
+// (POST /admin/devices/{deviceId}/state)
+func (_ Unimplemented) ForceDeviceState(w http.ResponseWriter, r *http.Request, deviceId DeviceIdParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Health check
 // (GET /health)
 func (_ Unimplemented) HealthCheck(w http.ResponseWriter, r *http.Request) {
@@ -915,6 +1077,88 @@ func (siw *ServerInterfaceWrapper) PurgeCacheByPattern(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
+// GetCacheStats operation middleware
+func (siw *ServerInterfaceWrapper) GetCacheStats(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCacheStats(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetDeviceCacheStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetDeviceCacheStatus(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "deviceId" -------------
+	var deviceId DeviceIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "deviceId", chi.URLParam(r, "deviceId"), &deviceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "deviceId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetDeviceCacheStatus(w, r, deviceId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ForceDeviceState operation middleware
+func (siw *ServerInterfaceWrapper) ForceDeviceState(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "deviceId" -------------
+	var deviceId DeviceIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "deviceId", chi.URLParam(r, "deviceId"), &deviceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "deviceId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ForceDeviceState(w, r, deviceId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // HealthCheck operation middleware
 func (siw *ServerInterfaceWrapper) HealthCheck(w http.ResponseWriter, r *http.Request) {
 
@@ -1091,6 +1335,15 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Delete(options.BaseURL+"/admin/cache/pattern", wrapper.PurgeCacheByPattern)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/cache/stats", wrapper.GetCacheStats)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/devices/{deviceId}/cache-status", wrapper.GetDeviceCacheStatus)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/devices/{deviceId}/state", wrapper.ForceDeviceState)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/health", wrapper.HealthCheck)
 	})
@@ -1107,219 +1360,255 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9C3PbuLU4/lUw6p2pnb+oSPIjiTqZjmIriVrLdmx50806fxsiIQkJCaoAaFub+rv/",
-	"BgcACYqkHo6zzaa5M7friHidg4PzxsGXmh9Hs5gRJkWt86VG7nA0Cwn8PcKC+uoPkUQR5vNap3bACZYE",
-	"YcTILQrIDfUJuqVyigIyxkkokZBYklq9doPDhMAgHLOg1ql1Z7NQfWA4IrVOjZ5OY0ZQaw+d8rh2f1+v",
-	"+difkqspwaGcXsWfF+ZVHxEVSH+fuzOoKRNR69TsNxgNFhrkRzkmt+EcmU9m+e5IAZa4bM2mR1fWOrV2",
-	"s73rNVtea2/YanZ2mp1m80OtXqOqfbP1or2zi/e8/dEz33sevCBec9xqezu7e/vPnr9o4pEf1Oq1kLLP",
-	"gGBBwnGtU3uqVyKertX/vgKH9ZrGfaeGbzAN8QiWnsyC5Uu/r9ciosHGM/oL4YLGrNap3bRq9Ron/06I",
-	"kH0F3N5ekzzfbTY90n4x8nZbwa6Hn7X2vd3d/f29vd3dZrPZrNVrkmOfQIcmHj/b32u9aO37we5OEDzf",
-	"3X1ORu1Wy3/e3Gm98Gv3aqPMLuT2qXdHhaRs8uNuEWVeIpbtz25nd+/R96eV25/WaOn+BGZ/4luW351z",
-	"wuHcU4FYLBEO6Q0pPY/QtV6TNCJC4mhWvTU3DliNZqMJR5hwHvOrEQ6uDJj5ZfTZDQ5pgOxHZwXQE7Cs",
-	"mxhG1T9E45hHWDrDz7CUhDOYgvJFhnGqv6IZ5jgiknCUtiuZzoyF/p0QPnf6UJF1y2YWhN8QXkQt4UgP",
-	"WDLDGNOQBEjGaJbwCUHAM50xE5ad/RL+CdvlMIfC+H5JMzX6OAnD/ICvkzCcI029CJcc1HX4Phrgu+Kh",
-	"UBMaMbCU+BJWIgz8KfH1yaVszOHYaCQp3kEkpiF8nMVxeC6xlnlTqv7b2mvv7CouEZKDmDHiSxozUevs",
-	"1WsRFUKJxN02LHahQVuTeJyoUZr1mowlDnMtWs167RZTeRAnTNY6rfZz/e/DhGPV5FhN04T/uzf9/0nm",
-	"0LG9e1+vhVjIAwWYos+qMxRiSZg/H6huimcIgScKq2ckoAL5ej1AuOkBTWaKvQgZc2ia0UFAcYikP0Ot",
-	"9jN1Hhutzt7uTrtjh6ExQ5yMEwHjbbq8pru8g7IR8yxEEYTQ+y70PqZ/bjp12516cnZ64EKkuNQopGJa",
-	"xBIsYYGvibmQJAIKmyUHMVcrel6vTWIeJ5IySzARiWJFul9qOAxjfzCqdXb3Gnv12sQ/mPugarX29mE4",
-	"9e1Zu7FjaKBr2ysyaDxXa1iDlyYz1QjwZMhLtZ3uNKPWnqjV01/PiR+zQNQ6L5qtPYCOlwii5vNOM1UU",
-	"UjYNssgKoVFCQ5AnilI8PPJb7Z3dmkKEwnHcarT3NAIrdDvnSP880I98oDedaK/kaGqBcxoLOeHk/N0R",
-	"au03WoUD8n0d0fjzzwP64AO6QosA0bumGuHHbEwnCV/YLpZXL0K6qNwdUSFRPEaWjgoWwG//a1ZaBu85",
-	"jkTCJlUQ7yqSaO1tCDH5SoiJA/EbHOK7OTpv76KLUHK8gd3TfJFRcwbxmzieVG/xjrKW2ptu8fgrAR47",
-	"AJ/SOxKi5wUbD/tS20al0Lrrvv/4XzTD67UZnlBmWNGX2hSLY3Ina50xDgWpq3+fcnJDY3V4zW8z4M+t",
-	"ek3Q30mt07Zisi9JJGodyyFP8QT4J7CXJYIfjEiEWbDUwQNc/aHm5AxLf3qld8xdxYW2YWIWzpGcEmss",
-	"QkNnEVX2C2rv7b955cxgtn+NKQq+sgLlpKMWDVMuKc5MsOBHdpUsP0Z7w5YrAh/tFO3kTtFOsPQUjbUA",
-	"Bav8CofhlaMAZbvWDUO79yAihTbjg1Jix1WNs4mU3FyY4lD3UF/WmCOobJ1NYrwaZZqAbotGc2QbueRH",
-	"QgIe2L16LR3DzNh54qoDfsVg2RoEZZOQXJX5Cs/hUw5TJRBvQtCL2MmNqdbECQ6U+iiuVjrHVNM52jIa",
-	"OVLtt39aNz/dFf8Fd8VD5WZG7Uvkt6ZzGSPs+2QmkeR4PKb+T1L/acg/giH/UNI1+kKZMvajKUzlrvRq",
-	"lWk/tTweUWVq51Smtr9UZVLarXE6BIQDQrq+T4Q4iJnkMThXbt/qj/o/mh6Fz+nMeE0OTs7OkR4AURZQ",
-	"H0PY8HZK/Sl6Oxyemo8C+ZihEUGKYaMg4aqV0sSxLxMc2hhS45IpxRrFY/gIo884GYd0MlWyXMxiJgja",
-	"ek2kP0XnErMA82C7can4q4mcK7pJ5DTm9HfgIHWk4CFMesP5jNTRmZ7K6wfqC+ckhGbw7+5p3zM7UEf9",
-	"sTdQqj/8dRwzYv8JGJ5hTpg0/7CGhPCnJIKtlPOZWomQClI4CzncDvBdd0I2xOo0vkVhbBDHiUhCKRSq",
-	"cA5HAJ1FNzD4oHHJflFnTAkKypDQfq1VaHy+v9tslsBEmSQTwjVQKcVWwdI97SPDG/Tmj2OO5BRElt7O",
-	"3NYB1WdTEpZEtc5v6ueP9RKkghpscFqJTVAfA8oJmDHCrICkC2hcMg9dzzi9wZJcd9CZpTMqkJgRn46p",
-	"rxi36pMIwqF5hO88PFHNB/iORkmEFJN00etOkd8PGIDFHvxLjZAItXMQK1V2KiR06PAkGpFxzNW8igJ0",
-	"93TUBbI3ENSRWdvLHfBELCNKczR6zI8DyiaVKIyjGScCNhGHk5hTOY3c7XQgHcXBPLesye90Vrqp5kNA",
-	"xqE+PiMOnJwwSeW8YsOzE9sPqpebNkJ6uDElXC+VY19h0pwTgbDPYyFQlISSzkKCrOxFW2bLZjy+oYE2",
-	"jPyQEiZRzNGEMMJBjOl98gQNyHYO7nWtnRQvJjbeqSUJVV+K0PeGuHKPeoA1JPEEANVGkyEp2DcWoHgm",
-	"aUSFpL5SBfyEc6UrqL/VAWpcsgtB9OG80fyCpVxQAZ3jgylnV7OJZCQURlnKgcQiU76s4dao7e8Eu2Rv",
-	"vH9ZW0GZR1jIQRyonavc56FVS9DtlDBLhnHCfYJusUBKYUKRGSS3mPckqCvB/Q/MkJLKyOZ7oDeDYfmm",
-	"qJPpQeCgbGeOYh/QXLXUi7O+lWoslwRlF5xb3mYaSTkNcVq60DMsyRGNqIT/qVqu5WksiUaEq5VnB0ap",
-	"BSRAM8I1y7ulLIhv0dbZ6wO0v7/7HPlxNAspZjJ3HlorhUm6tDMSYcqW8KPj4rK47aOIVqFZU7fcaI0v",
-	"9tZfoiCV2Ltg9A6lOjPaMhJh2yFTxeZDNZBdGlcDitVYfNbc22krc2jVSq3muGSR/05IqjBU8MmtGeGe",
-	"aVNHOLzFc/FfYn5nRPJ5dywJX00WqQyOkbImrRTlagiaalA2byld9v4qrA4z1c9qCVWLeb9zgKC51j/v",
-	"JNL9rGKnsBxQBd8oActQYzyPxaa3KnrgjZ7hYH/0rLX/ot3c2dlpec3WCtY6TFXWzWGAbi4IN4QFMfcy",
-	"PQmagyXnQuLHbBK/lPst7r//PBn83luxxl8wn1etytgkSE6xRHg8Jr50FS1/qnZYiTtfazeIkUksqQ6v",
-	"5OwE8JV4Vvupo5zhsHSFEBcw+WWp6TRbqUjpViSA013QqEpVU5OSdkvDUGlc8HmkTmykpDCAavsvilyl",
-	"YNWR0a/qSKtXTCfqquWlluwCItawZGbVooMEFCPVa0tsG3cUHoWkDLYDzaPDuQ7NXOPZLKRakD79JGJ2",
-	"DSp4MpvFXIIZc8n6Y/DrGnpTYtxkPsNhL47QgC6YoYSlA6EoXaPNDSRCqrE4kQlnAu0299FxLFE3Xf4i",
-	"bhcnWo7aHEbNgssHKUH3RjaWjIFKHCtLW9ZoOeJuWorUUgSZ0UQH3bQWAAcLrRzUzHqugBf6rrLpcoew",
-	"CuTT7nlveIJudtGIYK5UkfgzYQA2TuRUyTKN18Ylew2ipYNe6ZY3u41ZMgqp3/gyw/MwxsF944ugE4Zl",
-	"wsn9AriFTmT+j5C87dIT2p8PDvvNo2H37mjYa/1y2JuffOreqv9/T/uiH4XT4KC/3//Uvx18eicHhz05",
-	"GP5yMRh29weH6v9f4T69pf7OL7T/KaaDw97e4NOg+evwQh5H/Z1f583dD4dheDR8FQ2GfTn4/V3r+JO/",
-	"ezJ8Nf01Ov7cZ81GuurKLVlgaFmWreQJcTcpixD9/ynIl5eNLQ31f8LYx+H25WWj8f/9XymVvuKYBa9p",
-	"KAk/VYyxuGX6ozKjwL+3JbYb6CCOIuwJJVJBn1D7d3KWsrbGJevpneigv0Ovl+ATrJsUjPxe/WYchh/V",
-	"b7MwDkgaLQfkQFpwhhvtZXRxQHXs/EstwndHhE3k1KitEWXpvwvA11VzE3ZvNdPPmHM8107kOVCS0nBq",
-	"1mdhEpsrUPUmjEce9LOxOHVGASvGsPtM5iLDjuigaxvYu67bv0UnpEJ2blqdJ9cLVO1EActQk0UTqwmm",
-	"xDZPuIirdv9khpW66UMb2GcFApHeCAtlTaQJEI1L9l6pydburoPQuJ7hCbnO53TTCYu5EQtPnlwIPCGd",
-	"J08uWauBXlMuUlO0gw5j9leJKPPDJEjXsJUIItTEpLCG7UvWbqDzolHbQcpCvnZWy8id1IBfKxPZ/TQz",
-	"ORv285jHEbI/Ok4ctfpXhJExlQLFN6DBjgWRzoIALg+da0lqfX/khjBtUyh9C/lTzCZEoBGRt4SwdNGq",
-	"5yuidlQZbaBoM1+LiBDzidbWtPXBYnTy+vV5b4iEj5kyp7ZV74OYCSpAl1L4QoL+ToRe+HEsFdaRBlIg",
-	"zAmK9V5r0hDIQ0EMsmeGuSAKS2CTQypGQWch839Eih0evT+ef3j/uvnh/dmr4KAv+uzXMpZ7e/Jp4LLc",
-	"z6rv8fDi9sNw0hwcduWHYX/vV9psDt6/ax697+0Mhr/K48N37eNPF63jw3e3g8PurWLDHxSrjvZC8vYd",
-	"Hb+rOBeacnI8w2EVe81mGWfUGQH9oOJgDKcEJdoWc2ww4yswEfCti4v+Ibp59iAbCwCZYTnN4AjMkpYe",
-	"8NUW2WtKwkBUsnsSBuoUfzIRJxlbR5OJD4yhO1CM1rtIYI13R0dURHZoLreNyBTfUHV2WWy7pyxhGw7J",
-	"mdHgiBAKmTi07ZSG2UHXNFAMUuFB/RdkgPoD7JprPdt7KqeF0XODp4lEqTZl2jeAP/gLUk2xYQNJpmLp",
-	"DuZgq2UhD5nkkSI5bBnL27CwYFu7kBUUWTf1T/hdQ5V9iDBLxthXioJ2XmtoswbaqttKMyLrSMev1H91",
-	"8o+eMA3Eqb5wFRE21no6oE0a8FJtjrCQ9pJKvhkE4VSTt91h76R7jhi+oRM9IHwz7AUUc7sbYs4kvgOc",
-	"AR+GnztbIhnBX626/au9fQ38jenu8UgRoXDVCb2AzpYg4Xj7OlX8s50l4RgWkmNQ+oqmJa2FS3NlFJeF",
-	"N2s0qKsdqsPu1HXI6L5eu6VyepRGJJ3bVVpYWfTooGVxNBin7gJjB029oxUjy+z70kXW012vZ8FMdfzL",
-	"OKQGvVahWf6Gvd+73od6Z2v7Y4Ue2Q9INIshhv1PMl/hvPpMIOeBMJFwOC+6q0SnJ+dD1xPd1+xU4Eh3",
-	"UmalaocnmDKItxjGMxwepc7C9i6axgkX2/VLpkkCLHFLKuBkzAdkEGVCEhwo9g1YA/McBYk28yw7O9M8",
-	"NyJMWgYAIaARQVi77JFh+O4nwxVmhKMwnlAfhyieEZ0mAUJar0WRvV35gmzdRGAsWhLOvnj/JPOvlBz9",
-	"McQQKmMZQzwxIQgFzsqwxTBz52lHCRxjkfg+UTJlnHMIpyECmAWUaiKcqMcagYtyDJlIyQrvSX98HDOy",
-	"Cfh+zAKqPmWBcqHNWvSmN+wgK5V2mrvgtLBhEwt4CvAUC6UHaz0xMEOcXgyfnnaHB2876JQrjVIKw7GF",
-	"GiDtTO6oUCpiIgi6rD25rG1/BaKyMNIKbJ3iCalQMNQnG6BQaMq0ZbTV8igLyB0J8s7zKmtnQsodFi0w",
-	"/WiURK7h9w3c7JgFKCCjZDJR/5olfBYr42QD73vjkhVDB6An/cuD/AB6V9iwr+EHWRrFhm78c4K5P61S",
-	"GpMw9LSjGZqZq7wmSKumBlSBdLIqF+gCwk2rGi+OAgH1HpuEVExRiNkkAStGkijSXgbFlV8TcKWkHNkw",
-	"htuYB+gGc+0/FmiLNCaNOrqs8QQMpMtaykPgt8uaNpmwIB5lgjBBlQJllgJWHPylDLVYTsuB0itKrXuj",
-	"JP793y91zpHSm7JJc3lIlzW1tsEc6V/VP4n0G7a/cZy4AxhngUaS+a4XYzvp+yT5SbM7JnpG8+8hHmVT",
-	"KhgO4mik43K3Wq0OJeFFiC6TZrO9D/rGy1QNVTOm/zAAabXKdlYAQ0/HOaR6wR95yC5rgB1lYWhFOXcU",
-	"9OAVZt+/qyy+9t5ezjnULiV4+nsVC8sCVuB6AtluuFG6tHazfFFw76OUa6kekQ7gZv6rZUzsPOZymRUH",
-	"HmIRc5l6Hkbzct8dpFF4QMPQQZ+uU2A/ehuuPa2Zq2kICxRhxDwgPOd+NrYRbFRd02JdGyl1lGmjKFVH",
-	"XTehmvall7WC87UFqx/Ns97osHd+AL4lTQ+oe36wvehPzIaxeF/Tt6imK9+c3KAf65nP0VGTvb9vqXH+",
-	"A4D/B+D+T9rpPynU2yUatOuM3FvtizxXY6/ptYV1bOy1XTjSdWtQLqI6u423LooLOXcpKv+Pk3GtU/vL",
-	"06y0zlPdTDzVFu+5tb4ybO2sxpYT/V0/Yro83Iu2TmaEDUlIIiL5HEQ3lnQUgkTPYhfXX0xI5t77AiFW",
-	"jwb33he9GP23/nkc4om4v1YM0vTooDaakjsU0AlVCpxRIy5rzaaRVXbADtrJN23to9FcEgGt0rk6qLWf",
-	"a/bcaeWsYnFioTZbway+bjvBvLyrVzgBT6vrmIJHOrjsa8wWtJkHB8tLFRwny7PKnG02vd+wN256Lz5+",
-	"2WnfZ/9o7d97vzW9F9gbf/zSvi+3dLMw/DcJvzcu2UGJH0oJm89k/lKbFzNMeSFTqxCrr/P4U/yy2Rw3",
-	"959h3BzhF8326NlSxK3OiL1Ps5tfxQHVnhXN5LzsmpGJ4Ovk6IVYaVWxrLLTbxs+1a0gwz1d2TJ2oett",
-	"aaZh6yW5W2TUYMh61MZaZvZnJboK1rK9VvgwUPM3KZfC6zQtXpBco6dxU62Nr1PVawN0zfL3KI2/xHi9",
-	"wSzdLkOeloEPw56tpbMUemi0Ptj6TsMGcI+TKpgvhiUQw2HRPiZD7IHnFGXaAHpTkunKfivWc/rH+cmx",
-	"8bTmrg+BQK696h5enfXeXfTOhzX3fklJb6VqcwfwhfsMa1qfa9w92ajkmb6zRNnkymDtSnMmFxUD3SJ3",
-	"cwClfG5dlJT0RpH18xUzP74D3KxN7z24+FdC6K9wYG9YIA/l/HJYoAiHSpciAdJuLYkpE8hWCUtpzr2R",
-	"4uSUVKzJtH5ayJPJp4t7/WDVCGXJ5ZmPZ40BFr1B9/WcGrGid3VyoR1nKefODVOW3nefVnn0vp5/0GAl",
-	"Dy3Wj7tPbyLn6r2tMUqh2wYiXEFcSbALVezQ1ggX69VBzNrwBLsCJ/BYS/Gqiyd4+qLmBljVHZbiYbE8",
-	"54YIeAsdyzBQKO25CM1COZoNwFrouRS+kto3jw+iM7ra06yCXQozXDP3cBh6zs3LTXQzfU19pXZVKFSw",
-	"IbCnaoAyWKtqHOhwiBCgeSzC+zA1dBNQ8xUEHgvYw2KFgKVwpgUbvhWYeoJHBq9YHmIpkE7BiG8Fplsh",
-	"YhNATdZbFbz6nBImOSUiS3C2OXBLYTfBEFOSYCPQ0z5rCCJTNfSxxM/r8oKiFqg/hvUWa5c+FnhlZU8V",
-	"cDEbh9TfVPfQzOOKsqtEKFYSElkokMbUZPqTZYNwT0BffdV3shcV+IOT49dH/YMF7b1kqI4dkgobTg7n",
-	"2bjfhXWTR5I2lEuRZNJyGI7IUx17Suu9bYSytHbEb+nX/mBwMey+Oupdve73jg5rdZ0XUuvUTFWnAppH",
-	"JC2kdDulWT2ZbA339TWGtzm9Dxn/Y0k3B0dKX4Dhv38i+Hoz7sAc0AUbzp5bE1Jzsyq0ZzLOZxz8tOW+",
-	"qS2n6dBzSu1vwEydXsvtDtNufarSWkuP3ZAwni1V28wd2JxAf1yS0R6Y9GLTSqIpuw7/WLRn7wiv6r5w",
-	"l9i9durB/64k3bI7vrlh0hu2aw+1eCd3YThB5AZDZXdnv/ZI/oJ15dhl3Zy7hN/vIU6rtX0pPyvm+7c8",
-	"K4/BXn8S6p9LdqjGlTSnU/4el8rArDEVW1YSWbG6i8PUbdLN4uLP6e+uIpJVJVE6GgSq0RYdoze9Ibol",
-	"XJckyqUJtqHY9LJr4I9yVnpDvJKqnYIfpiaGZ7M7V0qRYgGNH5SG45kpJvel6AqDUhURkdM4ECbvCEi7",
-	"QkMF3mrJ04P+3tvs+1JqX1E7Ky0BtTD8QC/uIbW1LFyYk7QsB1wHwzBRVuhAw/pI1bXe9IZ1dHqh/qc7",
-	"PHhbR4e9o96wV0dve93DOjo5HfZPjs/XqoaVomKA77zuhGyE41wNLTWkwkBp7aLSJIg8Bg323OJUFmcX",
-	"Qt9LMoCliBKmzs4Mj2hI5RwFVPjxDeFzXcXjWXunhc7N5adnjd1G61ug0jkHnEhOyc3GlkDm/F1qCGzs",
-	"ul3bDkgX/g21m8eTO9+HMfHfkR4/1bsf3Q5xSnZumpG0TvTAtMvXBl3axbb7BnzHegT/R/wPm7OMn+f9",
-	"Rz/vosICPIjD0KguEZEY6gvYS9r/cwbhbvPFd2oRfhUND2OJQ89U3i6UJVAfnfp/+oJKGpJVuLQJ+dn9",
-	"ur1V9dO+10NgH0DaQOTZLkuFFzTaVHKJIyrkMvG18DjTT/35pzD8KQwfhQ88wJUkkJ/Kyp/epAd6k07O",
-	"hz/9Rw/1H22IvOyhQc8+n7OJs8h0WSeXM3uNZS3pV52/WfrocAbGt0i3fUii7WoA9KjIvK4BLy/eEKYo",
-	"+VttxYZ7cGTWs2IXsnfHXRi+xT5stAFrrj5dOYull1Uo3TjDLi0oegUFRUsukpzZ0qJuyVEow2m7luRW",
-	"HZ8Mr7oHB71TyHEqz7C6OD6/OD09ORv2Dq8GvcN+92r462nPyYRK645mOU0XpRVQO7m7KHdRuJAJ5eT/",
-	"FCqn5iBpoPQCd+eHvd+SLwqbT49ajp6fuVDfVINTR3kcJ+xhwY8rFsurtHvhNT+1kfpr+Wl9fXJxfJg7",
-	"a6YjpMn1D9Ff1yH4v+bm+WGOy2sFUOGkpHWFgpjokwLZBj9PyTc/JZETAiruVlo8ykNndosSZkpGIUGZ",
-	"T/S7GumFKaeMFrjNviunw+Zm/ve2ZTNO0gJg3hiuC2x6vUHiyVVEBezRQs1C2DvzCXn551Ocl1MWmd7p",
-	"We/g5Piwr6yNq9fd/lHvsFxP6Q27b64G/fNBd3jw1lFPnGJpGdM8tU/twLJSxmDqHi+Wb7MvzuXVlTOn",
-	"2BkaEcJSMPLECx4zHP4ojPbUoRJkLpVolps+4GOM/6yZ2mPAL/kO2e4f7Av/3k49x5J4ofUzbnDYVccr",
-	"6Lj47uJZ9uYMufMJCUpP9ll32Ls66g/6w6vevw56vcNeXrEpGaWBTkOChXleBeGxJBztN+0jLD/KERvG",
-	"MRpgNrf324USkRk2Un7jIPdndu6fxIMNbwt58LjQ6t4LzxB9j9zDPhn8zdxKC09wr3+8zmzHNTxM5vHu",
-	"gMwICwjzKcnd4IZ79xmo38L7lHt7+fGBrHq12bwB/MDrrAGWeIQFuUo7Owat+ZZ7ChiaFUVB/3jYOzvu",
-	"Hl31zs5OznJSwMIgSTSLOeY0nLs7k0oEkAdQYznEEoqnfBd3JCmThDMclmGob77ZOmQPwE6XoYSRu5l+",
-	"8hkGQLEPCmzwfaPm66Vkij7zbhU0RB5ahpOfRv83lQYJw+YZoI1NR1vtBV5XKi8XFXNE7mZQEUW3Kh6V",
-	"i+PuxfDtyVn/w4Iy2c091WTecKJZRaBs7O+tdlQJQmzRKFwC1GMgJS1984NwiguHLBWDyIPtAKzIQGnX",
-	"xvnxYzGL9+/few7opCT1II8YwCtBlOnSRItvGprHuzjBYfTyMk1swDO68snd749vzXjsq3MxColnXoV+",
-	"GP9KV1PkX/riuj6dxVP6S/eof9gFN5eV82X1Do6h3VXv+GJw9Uv36MKNxNlSmNkJ11PaUnAxIyged9CS",
-	"11eqQ3I6jzEtpea89wwiVnw/GpfeCCgLX7oP8OKFKR//tfvw+uRs0B06e+A8eJSh0f6YFeVzHt9YgvIU",
-	"25ilkiqr6/+9YDwjhTIt95cSQnkYzs967y76Z73D1aU+oIqGK8jckh5m5456x2+Gb5dW9NC1Suye2cfO",
-	"WlBDv9VsIn+KOfbhXdY/+bF5DBnrsFBknogv1mW8JWHomcqMo8ShcEEirERPhpafivq3EnjpbgNyIZx1",
-	"aD0f84Mp8cFdgcPwZAznb3kicb6jOmlllZlS18oc+aqhDljP4jgEuQhv7qhdn/F4RrikNmZuuEDpoFkx",
-	"attusb8a/1xiubJY+2naUGE5ljj8J5mL1cnqnwm8lZY90ZR/iL696zyJ0Cx9EsH8pB8OK/vlo41P9ixz",
-	"XXjDByzcNA1Sp/oplKdvNhXxQpYNZfgY0t9GNh1TKcX6AVingHdJ1a2yCuRZDc7fzNwfC3AaKE1qW/mO",
-	"59PaUqAfBh8dG0TlyzVWAOjHbEwniTaLCm8hmDy74qpNLDG/bpPJmhKMeafY5hsqhdT9e+HFAru2rMly",
-	"hJu1VWI8VyuvAIFlHybaMks4vBzk5wrojeYoe8l18QhXlBvJniTJj2U7OKDu1bPnfiiT+7u15ceqXnMq",
-	"ExYfwUxfup1iLZUSYTJqDXRVL9iuve1n8HpJSmlmv3XhvfRYlhCaqTuYQ+dam1t3XtK1CKze8IfvdGF7",
-	"aXVRj/5hhmED2BY8l6Ywrat02iIJ8PlBb46ueAbqMbcIV9Q7/aoD6Fb9L1njmjX/83uiNdlS0odPT91n",
-	"OvMPwOYA1o9c1zd9pNo+F1CcXVe3q5wv97xUft7F949aFTtNNnqVZWGfjB2h0Ve2WyVa0kLEJeXvOKNw",
-	"22eZdoMD+/DeqdNEP3e44KRJWzpDl2lChdWvKxRlXl3LpcIvvN5hAzucjBUPLTt/IRYSsFUmA9KXQy1V",
-	"QM6MEZZaT0wvOuQQma2iwvxJ+QI836DspfLFwTuTgxL+cKQ/VS+MMhTRMKRZ8oErr5aLp9RU/FK9u47f",
-	"DeFRnMjFjUlZf4aMA70lus6r805da7/R2oQ5qsOa11Xy2DcKSzJT4ia+1VJnwrFORkjYZ6Z+zGkr0PbB",
-	"fLKKQ3ZLyux8V8wwe4JrCfHD5SfnFW4lMW3Jwi0aRYnUUehHo/syqX1ReAtaH73HeRt8pZwO7cvEq7m3",
-	"fsT4TyVp0ioUmxNCmL1sHVRSwG5nd28DClg4eLAjOelXT53JuTfkKs5leoW4Wqckpon1+GglJq8FgkvA",
-	"FgEoSkv141o41xx2deuBarOICzM39K+GOH1HOw9u7g1ttwpY9uZ4AS5BwnGJ+KHss2LiRvQt8riMCky1",
-	"JPF044PIqcfJmHDC/HISqYD9XJZGj4ZVNXqz82fEhmtq6wAE/GEiEDmpUe1WqNfuPDWg56xCq1JZNXJr",
-	"iVDhvO6pdsVOCXO7zbIyyyOiSBQswy2ncrW/WOW57vxkLK9tFxx3dPsjeJBzXpN0VfcpmvOX8zc9WeaN",
-	"Yucaae6N0pWnbGkdgFp9kycQa/fFBw/XP57KsrXPLa9+EyxtuexY54YsO+MVnjZ7tdgklbguNx1LyqNS",
-	"BxwWBxlgf0oZ8TjBAey8Hgwau5RfEhMpyKFK96ijRurhTUv9kl9JDGKt7QS0HMJI5XtaodS+TSLMFgG2",
-	"rV2Yq+MmNvpltrGACSeG8qB3sb+JSuNEadYQ+YVMpUfS9dJA0OpHH/Wbjjo6Wv6G6JjHEXKiGSbXf0El",
-	"XBVwWqWPmMOQkUi2vS5WK4+uodESx5TUFxaKthVGqQlt8/C+8jRnlmth5AxVhZhmYftMeLJM2urXj8HH",
-	"5mOQVCkd5SYxml3xAd+qA3uY/Utx/VvzRsMtj+3r2tJOX5hoIYFo+UbbIexKyna0MiAQRzNOpoQJJVFz",
-	"pnnKmWGtYi4kiZTI42UxJugilvlyKAvoDQ2SnMtFTyXQhMfJTPtsfSzJJOZFRw9lY14iVfvqZyF5AsYn",
-	"ymUfbwkZczwhde1r1A+dbxcXDyGEdV5OKQbqamaK1VJ8oWfBbNfDlG2e0Om7Zeg1ib15qIP4lgnJCY6Q",
-	"7bpd4jdLx/yaddthPpYF4XLmEWyfA0wppEtcKfEN4TgMy6NAZlRHP44/5/0pxsMSYcokYVip6zklGdoX",
-	"jVQg+1VIOodWfTaO15ZYZt3uiXs8aZXM4MvKV1BVK7vqm+WpAbaTyQvQvarCKBkGsnHTVdUtsygjgLQk",
-	"RIn2rL+gGY9HpDpquYyEbOmLP4h4NiGEdGmPTArOtpazjmx/shlvWo1mo7l+2Kxsv8t2d0CimM+BdopK",
-	"AHxDiXag59Il8xuMwzD2B6PqMHGkR4J2JjuVocGrXInAvYYbDh2HMZgeZsE6M0EteOIfzP2wjPM6gddY",
-	"jakUvDcHyNfNc1X29lc5ssVclMGj0yQMNPFI6fAkQFp5nBJ0cl6E61m7sbMOXJCc0a1CZG5ig8b0Qq6Q",
-	"mMvizK1me7fxfPXc96VkUWYup7Z5WtHSsc2tLp3TQVmAuqd9S9GUTRqXrBuGTpk9p5oTZX6YBEQrl0YJ",
-	"jG39DxSPFFOwpZ7UyAEZJZOJHrRIk2nCVIkZmS1Je0JkjEyaly0+pZ9bzdjPTSvPXm5aDzPXCm5oV482",
-	"3RuXDC4cEwFUdZ2laF1nKqA2UHR1LIMxUNBNkheboDCeiDI8fQOD8AGmGLmTkGToHJ+i/dW4ZKecCPUD",
-	"RNjBqCwz4KhAhClDJXAxImMzH7cXTrHPYyFQlISSzsJUzhRe8P9qU8+17BxSLGPBpzk/0MKt9PRbdubU",
-	"PoPzK3vQfJH4p1gck7sSl/z7KZFTAuEPrv2HiKltmS24LHSE1ix1FMchwQzqhWFxyskNjcvkecngM9O4",
-	"MMEYh6J0hrXiJRlaspgJuZMHCRdlDq6TGVZnz4fP+vV44pRhTTGAErh/8pnMBZEoc6Y1LtmJIr+ZoUUg",
-	"Q4NjBafC1iIFkfk/ov6nmB69P55/eP+6+eH92avgoC/67Fd6QvvzwWG/eTTs3h0Ne61fDnu3J58Gtyef",
-	"urfvaV/0o/Cz6ns8vLj9MJw0B4dd+WHY3/uVNpuD9++aR+97O4Phr/L48F37+NNF6/jw3e3gsHvbp7f0",
-	"w0F/vx/theTtOzp+V3ZaZ6W2sRXVgAeTCLjV8igLyN1CNd+WIz1bpVlKZtcfuB85otl0Tyx5PtK+zNWe",
-	"fOW+3KX7wl7NP/zr14p9EfR3skyr0QWEZ4QXDlO7CaE+syMmertkf0DX6FvX6Dpliw3fVMaemlwUihYv",
-	"V6dgwlPouHLCwvjPV+aauozX4AaQmYM0t4rlfHjtKFhGjssiYWPKhVwWCiMImhT2NQ2C/V19edm6TJrN",
-	"9r4C7WW7uUHMS2erLF8BhGNXLOD5wxfASsWRu4CMC2+xJAwRHaOYZcvaXrKu9trrUiPrGFpOwjnMsVK6",
-	"uWvNcyh3vdlGbn/VOlZFT7OY5LcimvvSIyL96dpZfTPMJcVhONcxRR3As0kX8F7Ptk54dXPDWo+Y6NK4",
-	"ZE+eHMeSdJ48QcV3bKnb1sR2qUCXJoB6WVsQHX9A3uAjrziXD4IG+O4Pyj4sEo57YaHwUq5Nt1t1bWJK",
-	"5VK737EqYShon5NU7Z3dVbKKBiHJ1rR0PtXUqQOR3phQk2+WN0eFWO7SAHhMM9dr0l7pyZB4bXigbQ4g",
-	"TqL4xrXRFkFbOb+kEYkTucJfk5JA2tyZYz31YimMi0rGGpvWWjntLaay4vGHDDZwxCHswggXxjCVOjk/",
-	"N2f7+TqTHiY6jfu4ElI1KxIzUIwxBdar3QM5sBlmcVmaZxP+b9MrPvVaVrWlRDiYTwvOYh3KKsv+/BnN",
-	"+hnN+q9Es9KSRd9hTCJb238pKIG29AsPONx+tPjEkuCTE8YrrkzHv9NylssiFP4sOYj5chF7cHqBfNUI",
-	"ld6Ze77Kqp7EPE6k2p1ls5hMOafxRuJchwBWp5ilUZ1SRn0B+uTaijy8hlOqxF8Mt2s/FfY/v8JeeV3I",
-	"jvexlIrSaHUl29AR5qVnMzDazMoEOzNW2j7H/KY7zai1J0rTCU2Hc6PqFGMzdpGoRBt60WztraFk8/WT",
-	"4I0gQaZXFRNvPu80mw9Pfs/WlGGgdBvd/IHC8s3Hils7mUgsBN+WRt1qq0Npo4SW5Ye9Uj+nwghU2sgU",
-	"D53mRgV55OGR32rv7JZNMCmB9k2MeMKAGMpWOolbjfbeSswr6C0ApWqLIH7CqZyfQyUiwNgrLKjfTcoS",
-	"wuCTfi1noTKW4s04UKQppNrgG4IIC2YxZWBA6UJHtU5tpEbIlj2Vcqa9OYLI2E46grpHry2hnXbPe8OT",
-	"WqFMMvyMtk5DLBVFeN0Ji5W1js4NUGgYfyZMbKObXV16q3HJughAJnXNAkMItEJZLk1VGpIccI1LptfS",
-	"QaYi081uY5aMQuo3vszwPIxxcN/4IuiEYSVJ7oGTZiBDn0WYdSEdTecQuvbhxGqGb/PTIWJtXuKo1WsJ",
-	"D01/0Xn6dELlNBk1/Dh6irk/pZIotZ1bn1utUECki85650MYUwEZYYbh+vHC3QeTv64kLTo4uzh0qhxA",
-	"DHdMQ0m4vrds3juiELa8ZH/5CzKp+IexUj3Vbz3sT9NLvSbZuHPJPPTkST948qSDiuHo9BqUbnaMI6Ia",
-	"2gcccET0h1dKLjhfXGmuLxPodiBcVLuD3OWJrSVVmszUcHlY0bfinWqEtW6UGVS8SoRWkM+SkAj1o4fS",
-	"AeFkF646qCYKXEC0vg6RsbNi8wWhDvcfkBLmzEN9gCh72GzxCoVZpKKGX9KcCPXjcEo14SWCOFVjssQJ",
-	"WJzJhXAC2E4D4AFkQonaaTXNX+wc6Fx/mmv8XpwdoVMsp84SFJavn960nl6jrRmnEeZz8zSX2RNdZWWx",
-	"h1PApoNuWte2RPoWDqGIpdnU/GL6mShRY3fDshwQd+jrkmfX1Aa4KRxqJNM8uzSsH13QD7IFsZ9EhEn9",
-	"lBg40OFrGE9U31ec4M9wvEwfw9BRhD/FPJ2KMp8TNYwFSm3ZIZlxYlgyPDz2fO/F7vYlew9vvzE3Awbp",
-	"C7/QnAR1hHPA39IwtBiA03rtDN2BcOY1UkQGaDDpIZbj54eG3ucJE0R20GXSbO74injhLxgkfSBNCRZP",
-	"fcsOl1owrAUcNeABhPGOKPtsR0t4qAf7G+IkfHlZM87XmHsG1suamufirJ8Zr7MQ+4A+NYUme5Lmsgg0",
-	"JeEM+SFVqiqK6EQRLZIxYuSWpHsg0IiMY06QAOgsC7Tip3iYjMjS8iYvZAxLdFsIRdgrpRvySiRafuyF",
-	"dSHz/KD6uZzkhb2GY9UDixdNCv/y7Pufw/mMeCf6ZlgHsVgwOh5fm0avOY6cr4e941/tp3+dn3unPJba",
-	"A9hBrb+hKA7Iy1EY+591o3PJqS+9IcdMKE7j2eV3UITvPDwhL3daezv7zWbzb3bh58lICx6hx7DLtF29",
-	"0zik/ryDAjLGSSg9wX30V0HC8V91hzMyJpwTnjYUehUxpxPKPEWWHsSfzS+61ynhUEs0ZiLt6OOIcPxy",
-	"a7uOIurzeKasKfjnhMQ29/Dl1vY1KAsh9QkTxNEABv1hQeLHM8LMU4Qxnzw1ncRT1Ra8NzJcVB7eYElu",
-	"8dxJvTT6qOqgxgP9uLbTaDZ2dImTKSiBT0GZewruwqeO/0wLjzJDXZ1NHZaHToEVSpAopPdH5+Q5vlG9",
-	"TiVOIItI1/xomFPjchOo3xygWDMFW/JZa5wIcvK2zJZ20PPm8xfmdcVUc4EqbVCUpRuGGj/g5NTF4Wzu",
-	"UudLrd1sVhmsaTuNFQ9Kk3g4DD1H49pttlb3zxXxva/X9tafNFdKHLrurNvVrXLkqv5QgMxR+n/7eP+x",
-	"7pQXBLShQmkWpdDiiVBWRldtQ+2jGrSMbuDl5AdSD9DFvxPCtYrZX6QeWydGyVW47jkeQ3WGb0tE9oay",
-	"kI9ERRpD/yP045z1DYjoiy3xeb8OJVkqslmKixfhR3NEpUD9wz+CUA5MFbIZVhIRqllWFf3LmhjHWD84",
-	"VT9B+cuvo7EgvSq8u37XEQ48m4L8J6E0XU3LbHpWtMd4h1aR2zS9Czchsoy+ZMKZyKW9VFeeQyIZ6UtC",
-	"34zM3hDpFvV7OJFk7+8+nA3tbDjZQzca4nMGxflneFdvcK5u3ZriKK2cB8+XmcIamrRIYAvJNS7ZuTWK",
-	"J2E88oSch2kpPIG2SGPSqKNrW+3uOv1bdBRL7Dy53v623AgI5dX8NKsjuBFDypUyfCSmZHfjf4QrlVZz",
-	"LKNYR+XebJNKqvOu7FMsp7uyi1NDd8NOYKTaPh8dWBck/FcJy/qPiKcVomnJbW2T2WKu5pvb2m4cJbPP",
-	"dGSrwIdm+jlHLfgEjkrKpCEsnKSAUSKtVS4uWVZ8b+GueAMZNwAJ9CohQFB0wBcYmpZ3Byb1YHMm9AeJ",
-	"O/dx/k2Yxtv81V/LInSigeERoXMdtpQizmk0CxdvjyIZK1IgPKIsLUYonHesEmZux10InaYUc6WqS45l",
-	"zAXaCulngv6ZjAhnRBKxXTqg8fQTjsQ0TsJA++9MILBsP+0N3ofvqPt0vdrT9ovVfZynGdfd0TD3yv/C",
-	"nub2MH8puWoXuZuptsbBXsi7Wbmd5c+SNS7Zgc5HAyWHU3XWwnxyVcYU7Ctk+g5fMeWqklgKi9Ozu0QR",
-	"J6aYItyTo0xIzHxSRiJp4t7DaST3wtw3JJKFR/uWUclCOmIpmSwyDjdcajgH6DVaVC4k6sd6Y6GEFTi8",
-	"dduCdxHPaMOIYvXfp1+Mx/AenpPgVCk+gOlcghYonjZ0XkxTcQMOMjZVwdybrAq4wlVDHgeJTlBdY61+",
-	"HP1xa/2Ybk9FSWaIqurAQq46fD6wW1LE3+SQWfZSzw56HY6dEehAJM6AhkjuP97/vwAAAP//2jba534E",
-	"AQA=",
+	"H4sIAAAAAAAC/+y9iXPbuvE4/q9g1O9M7XxERZKPOOpkOoqtJGp9xZZf+vKcnw2RkISEIlUCtK2X+n//",
+	"zS4AErx0OPZ7aZrOtI1FXLtYLPbC7teaG05nYcACKWqdrzV2R6czn+G/h1RwF/4h4umURvNap7YfMSoZ",
+	"oSRgt8RjN9xl5JbLCfHYiMa+JEJSyWr12g31Y4aDRDTwap1adzbz4UNAp6zWqfHTSRgw0tohp1FYu7+v",
+	"11zqTtjVhFFfTq7CL7l54SPhgqjvc3sGmDIWtU7NfMPRcKFedpRjduvPif6kl2+P5FFJy9ase3RlrVNr",
+	"N9vbTrPltHYGrWZnq9lpNj/W6jUO7Zutl+2tbbrj7A5fuM6e95I5zVGr7Wxt7+y+2HvZpEPXq9VrPg++",
+	"IIIF80e1Tu25Wol4vlL/+woc1msK950avaHcp0NcejzzFi/9vl6bMgU2nfFfWCR4GNQ6tZtWrV6L2L9j",
+	"JmQfgNvZabK97WbTYe2XQ2e75W079EVr19ne3t3d2dnebjabzVq9JiPqMuzQpKMXuzutl61d19ve8ry9",
+	"7e09Nmy3Wu5ec6v10q3dw0bpXcjsU++OC8mD8Y+7RTxwYrFof7Y72zuPvj+tzP60hgv3x7P35wpPZ+44",
+	"HeAnOJX6q7VPpr2MYlavfWHQXg3VuWl1VtwFKf0rwdww8ESt095u4nrC2yC7jHMWmXUEoSTU5zeslD9g",
+	"13pN8ikTkk5n1aRyY6G50Ww0kaWwKAqjqyH1rjTas8voBzfU5x4xH60VYE/cddVEM87+ARmF0ZRKa3iN",
+	"7iCUV6MwDsoxDmCqryWTePk26eCjMHLZlaZBe9w38EHxbjKi3GelI6svRIYEBzJgqPHSWTSUZfMYHBVu",
+	"iTyGsEGHTGMhyZAROEPhiCSMrU7UAYL/p67kN/b8gLsF5AqIKdJrCfZ0o3TgGZWSRQFuP4/yw5+qr2RG",
+	"IzplkkUkaVcyjx6L/Dtm0dzqw0XaLZ1ZsOiGRUWyZxFRAy7crVkcjZkCxxozDmgsJ2HEf89DcsSFAO4b",
+	"RsTsB/WmPCAy/MKCsrmmi3tkJk0up5ILHs+vdXsVJnJLmsHoo9j3cyQd+/6cKPZKaMlNsopgQo7oXZFr",
+	"w4RaTlnIjeKgRFpxJ8xVVwsPRhFNeCX8w2OSch8/zsLQP5dUCWUTDv/f2mlvbcM15rP9MAiYK3kYiFpn",
+	"p474Z6LW2W7jYnMN2ornhTGM0qzXZCipn2nRatZrt5TL/TAOZK3Tau+pvw/iiEKTY5imif+51/3/yebY",
+	"sb19X6/5VMh9AIx51UzVp5IF7vwIusGlJgQds1qndsY8uELUepin8Y0cO57B/SdkGNFxhg48Tn0i3Rlp",
+	"tV8Ag260OjvbW+2OGYaHAYnYKBY43rrLa9rL2y8bMXunAEEIte9C7WPyz3WnbttTj89O922ImJB06HMx",
+	"KWLp/t76QV90Yi4kmyKFzeL9MIIV7dVr4zAKY8kDQzBTNg2BdL/WqO+H7tGw1tneaezUa2N3f+6iLtDa",
+	"2cXh4NuLdmNL00DXtAcyaOzd3ytCW3K5xjNohHjS5AVtJ1vNaWtH1OrJr+fm5n/ZbO0gdFGJpNTc6zQT",
+	"STa5t1FYMlLSMOY+CjxAKQ4duq321nYNEAE4DluN9o5CYIXyYR3pnwf6kQ/0uhPtlBxNdcudhkKOI3b+",
+	"/pC0dhutwgH5vo5o+OXnAX3wAV0iReDVu6IY4YbBiI/jKLddQVa88Hle2j/kQoJIauiooKL+9r9mRkjh",
+	"PadTEQfjKoi3gSRaO2tCzL4RYmZB/Jb69G5Oztvb5MKXEV1DMW++7DSLEL8Nw3H1Fm+BOt9ed4tH3wjw",
+	"yAL4lN8xn+wVjBBad6qA1l73/ac/0U5Ur83omAeaFX2tTag4Zney1hlRX7A6/H0asRsexiL5bYb8uVWv",
+	"Cf47q3Xa5prsSzYVtY7hkKd0jPwT2cuCix+tCoQG3kILJHL1h9oXZlS6kyu1Y/YqLpQOEwb+nMhJonZj",
+	"Q2sRVfoLae/svn1tzVCil1dMUVDTC5STjFrUhiPJaaqCeT+yLW/xMdoZtOwr8NFO0VbmFG15C0/RSF2g",
+	"aAq4or5/ZQlA6a51fd/sPV6RQtkOvFJip1WN04ng3hSlVhj4ssIcXmXrdBJtSimTBFRbMpwT08gmP+Yz",
+	"dBHs1GvJGHrGzjNbHHArBkvXANYPn12VGbPP8VMGUyUQr0PQeexkxoQ1RYx6ID6Kq6XWUmg6JxtaIifQ",
+	"fvOndvPTXPEnmCseem+m1L7g/lZ0LkNCXZfNJJERHY24+5PUfyryj6DIP5R0YRSm3DLlzgrtklENvuG6",
+	"yOpzZmpR4WeHb+CBdTN67SSlwh0QpydcngEN1jrNxh76+PRntFlwIfSfW3vNeg0Y0RHaMV7PJUrcze29",
+	"nRe79/eJ1FQmkv5oYmO5Q6FacNxN9K9HFBzbGcGx7S4UHIFatOnFYxEipOu6DHY3kFGIJqbbd+qj+j91",
+	"KoUb8Zm2He2fnJ0TNQDhgcddit792wl3J+TdYHCqP4IjOQB/H1AL8eIIWoE+Ql0ZU9+4VhuXAagXYH6B",
+	"jzj6LGIjn48nkkRMzMJAMLLxhkl3Qs4lDTwaeZuNS7hldIAL0I12fyEfrROAhwXSGcxnrE7O1FRO34Mv",
+	"UcR8bIZ/d0/7jt6BOumPnCNQgPBfx2HAzJ+I4RmNWCD1H0adEu6ETXEr5XwGKxESIMVjmcHtEb3rjtma",
+	"WJ2Et8QPNeIiJmJfCkAVzeAIoTPoVp7OxmXwC5wxuC55QLTjfRka93a3m80SmHgg2ZhFCqiEYqtg6Z72",
+	"ieaQavNHYUTkhItkOzNbh1SfTsmCeFrr/AY/f6qXIBW5msZpJTahDfF4xFCZE3oFLFlA4zJwyPUs4jdU",
+	"susOOdO/A7rEjLl8xF24vqBPLFiEzaf0zqFjaH5E7/g0nhK4Kmz02lNk9wMHCEIH/4IRwBkeMXSvUqnj",
+	"rpRnmAzZKIxgXqAA1T0ZNUf2GoI60Wt7tdVsZrBZgj91NHqBG3o8GFeiMJzOIiZwE6k/DiMuJ1N7Oy1I",
+	"h6E3zyxr/DuflW6q/uCxka+OzzBCTs4CyeW8YsPTE9v3qpebNCJquBFnkVpqRF3ApD4nglA3CoUg09iX",
+	"fOYzYiQQsqG3bBaFN9xT6qHrcxZIcIaPWcAivMbUPjmCe2wzA/eql3iCFx0y0qnFMfdqZdD3BrRyj3qI",
+	"NSLpGAFVqqMmKdy3wCMh2P/x/geByI2jCCQm4qoD1LgMLgRTh/NG8Ysg4YIAdIYPJpwdZhPxUABGg4QD",
+	"iTxTvqzR1rDtbnnbbGe0e1lbQpmHVMij0IOdq9zngRHOyO2EBYYMwziC2EUqCIiNZKoHySzmA/PqcHH/",
+	"gwYEbmViwrLI26NB+abAyXTgjJfuzGHoIpqrlnpx1je3WpCJVTQLzixvPYmknIYiXrrQMyrZIZ9yif9T",
+	"tVzD04J4OmQRrDw9MCAWMI/MWKRY3i0PvPCWbJy92Se7u9t7BOJOfU4DmTkPraWXSbK0MzalPFjAj46L",
+	"y4pMHyBaQLOibrnWGl/urL5EwSqxdxHwO5JoDmRD3wibFplSCbavKZdmaREMKJZj8UVzZ6sNSuGylRrJ",
+	"ccEi/x2zRGCo4JMbMxY5uk2dUP+WzsWfxPzOmIzm3ZFk0XKySO7gkIBObW7RCIbgiQRlwvmSZe8uw+og",
+	"Ff2MlFC1mA9b+wSbK/nzThLVzwh2gGWPA3zDGPVjhfEsFpvOMh+KM3xBvd3hi9buy3Zza2ur5TRbS1jr",
+	"IBFZ14cBu9kg3LDACyMnlZOwOWpyNiRuGIzDV3K3FbkfvoyPfu8tWeMvNJpXreqdvnjkhEpCRyPmSlvQ",
+	"cieww3DduUq6IQEbh5IrJ1NGT0CLkWOknzrJKA4LV4jeER3al6hOs6WClGrFPOKWSVSloqmOBrzlvg8S",
+	"F34ewomdUqlBNf3zVy4IWHWi5as6UeJVoOLpYXmJJptDxAqazKz66mAepwR6bYhNbZQD20QZbNd0NvO5",
+	"ujmffxZhcK04itC+KvXyINlbo0ds3LRIHPhMCMvLT0I5YdEtF2wzP/JN4DX0jdq4af2fmggIpLpV+//M",
+	"cnwgL2rm/ttlcNM2sQHEpVE0J9fqTzAdsmvCAyEZ9YALXQv1E0xFSRD7aKQh19o/0ZXXjcvgMuiP0GCv",
+	"jxBIJhpy5F9FHDWwC7r04kDEs1kYAUnpFQpCo4S5wWARk3EUCLLd3CXHoSTdZEvy9JKfaTG5ZKhEr7h8",
+	"kBISWktvlCFSvqU5KmsBWYy5mxYcnwJ+OuSmdRkUtc5yUFOLQAW82HeZnpphLFUgn3bPe4MTcrNNhoxG",
+	"LFIxtgg2BPTC/azw2rgM3uB12SGvVcub7cYsHvrcbXyd0bkfUu++8VXwcUBlHLH7HLiFTmz+D5+96/IT",
+	"3p8fHfSbh4Pu3eGg1/rloDc/+dy9hf9+4H3Rn/oTb7+/2//cvz36/F4eHfTk0eCXi6NBd/foAP77mvb5",
+	"LXe3fuH9zyE/OujtHH0+av46uJDH0/7Wr/Pm9scD3z8cvJ4eDfry6Pf3rePP7vbJ4PXk1+nxl37QbCSr",
+	"rtySHJNOg7bVk4R0k1Lf3/+XgHx52dhQUP/HD13qb15eNhr/9/9KqfQ12CHfcF+y6BSYfXHL1EdQDdFm",
+	"uSE2G2Q/nE6pI0BMQBkJ9u/kLGHXjcugp3aiQ/6OvV6hnbOug2uye/WbNoJ+gt9mfuixJA4CkYNR5ilu",
+	"cLwMoXIVFfG1NqV3hywYy4kWxac8SP4uAF+H5jqgotVMPtMoonPlHpgjJYHUVjN2GB0nX4Gqt344dLCf",
+	"8bLCGUWsaGX1C5uLFDuiYxhr59l13fxbdMBjDC9Nnl3nqNry75ahJvUTVxNMib0hjkRYtfsnMwoitItt",
+	"cJ8BBCadIRWgISWhLY3L4AOI/saWUMf77RoiWa6zTwT4OAgjtNhdBs+eXYALo/Ps2WXQapA3PBKJet0h",
+	"B2HwV0l44Pqxl6xhIxbgSKdjVljD5mXQbpDzoqLeIRdCLcasNmB3UgF+DWq//Wmmo3HM51EUTon50TJM",
+	"wepfs4CNONgob1AqHwkmrQUhXA6YH4d+as9kNyxQepJHJSXuhAZjJsiQyVvGgmTR0PM1gx0FRRSVh8BV",
+	"V4RP4VEE9FYaVRCSkzdvznsDIlwagIq4Cb33w0BwgfIh4ItANJFQCz8OJWCdKCDVhRqqvVakIYhDvBDv",
+	"nhmNBAMsoZ0Bg2wKchib/2MK7PDww/H844c3zY8fzl57+33RD34tY7m3J5+PbJb7BfoeDy5uPw7GzaOD",
+	"rvw46O/8ypvNow/vm4cfeltHg1/l8cH79vHni9bxwfvbo4PuLbDhj8Cqpzs+e/eej95XnAtFORmeYbGK",
+	"nWazjDMqJ1bfqzgYA7CUKv3S0iu1/UPHNmxcXPQPyM2LB+mNCMiMykkKh6eXtPCAr2BiuwNxQV2vFdCd",
+	"s4hTX19A+qGXAY7daWnDSJJo63XFzXXHaE1ReEtGobaiDOf4BErjBGwqPg8Ydgo8lPY62OAf5yfHplU4",
+	"/MxcaTXOalripmKnNfDlYozqZuQY9ZdaQblE84Yz3xOV1yLzPeB2n7XPVYbGyKh9QyPsrkVVkE+ZZww3",
+	"ln4Ah/FAawFDNqE3HHhcEJruCevcRGZypiVddgNPr7CNHuQDWNTznTJ9kgi5RJjU7RvIHt3cpQ63UOMy",
+	"6AYZERx7YPyeikz4rFzfaM/fbjbJa+oZ/5Ne2Hm2r9BM8Zp718Qh2ldcPEob2hKj2b+3qSiGTpnVDf7E",
+	"31EqsD5MaRCPwPMWaWeG1lOSBvg32VjwKm/zb1o1EgX1h9y0kz0UivyN/xamwIfmSBPGQIZtEj8ptAHj",
+	"r3nhlW2Gvlto8q476J10z0lAb/hYDYjf7NOg3r0LAWijuVdkPfOzIQsggNQZXeNeHfBXR9zV9UvIem0a",
+	"Dnk+Nv2IB3xKfaIlb7yEVDvtMBAl4yYjAnEcJh5p60GlutgNnAhc1Th19VWPlpjFK4aU6feF8NaTfasn",
+	"u4Nnv5S5IBZrFeL3b9T5vet8rJNPz8pF7b7HprMQAzj+yeZLbJZfGAb8sEDEER4L1VWS05Pzge2A6Cum",
+	"LOhUdQKHHrSjY8oDPJaa5wwGh4mNuL1NJmEcic36ZYC9lQHGqNHwU84PZ+v8iDO0yhAvVpqw4WRn6lqa",
+	"AkXoc36kn8FS5akh+k60P+nDj9w+HHOX+iScMRUjhHKMWgsQnVl57kZY507NK1vWvjj/ZPNvvFz7I3Qd",
+	"VbqwBnSsPU8AzlJv1SC14ir7GDJxEbsug+tklPEDJJ4hnAX1DiYsZ9cK/qpyDGkH2RKjWX8ErrN1wAcL",
+	"NodP1Ldp+k0Ykbe9AbipFUFuNbfRrmO8ZQbwBOAJFaAqKFHa00OcXgyen3YH++86BCLsgSY1xxUwQNKZ",
+	"QcYGgYoFuaw9u6xtfgOiUu/hEmxB8H6FbAGfjF8K0JQqFGSj5fDAY3fMy/pMqhTCMSsXhlqoHYMDzNaN",
+	"n8C7AoZBjw3j8Rj+msXRLAT9bQ2nS+MyKHqMUET6l4NhIfxus/GI/CCNnlnTe3POaOROquTF2Pcd5V/A",
+	"ZvrxvPbNw9SIKrybjFiGzmphxxSO8qOg3NULxhDsR3wajGNU9CSbTpUhBrjyG4bWpoQja8ZwG0YeuaGR",
+	"chsIssEa40adXNaiGHXIy1rCQ/C3y5rSKqlgDg8ECwQHOUkvBRVd/BfosqGclAOlVpQYQLQs+Pd/v1Kh",
+	"ZiD3pJNmws8ua7C2ozlRv8KfTLoN01/bluwBjA0bkaS/q8WYTuoxVXbS9IGVmlH/PaDDdEqAYT+cDpU7",
+	"9laJ3r5kURGiy7jZbO+itPEqkTZhxuQPDZCSz0xnABh7WvYz6IX/yEJ2WYPGNZBLlTycOQpq8Ap96d9V",
+	"SnF7ZydjP2uXEjz/vYqFpX5KtM7h3a65UbK0drN8UfjoqZRrQY+p8tunJr5FTOw8jOQiBQ6N6CKMZGKc",
+	"Gc7LzZsYPeMo7Qc6qNN1iuxHbcO1c40tYRoWeCqlhMeijIVeq0CEe3XcrLqix7rSR+oklUdJIpDa1lSY",
+	"+pWTtsIztoEQDOdpb3LQO99HE5yiCdI939/Mm13TYQzuVzTBwnTlG5QZ9FM9Nc1agrLz9w3u/QeG+g/C",
+	"/h8E/T9Jv/8kgG+WCNK22XZnudUWVbYV7du4jrXt27mTXTfqYx7bSYuVsVyIuEyw+f8iNqp1an95nuY/",
+	"e66aiecHqaqaxdbWcmxZvv/V/eWLnf1k42TGggHz2ZTJaI43OJV86OPFnnp5rr9q59W98xW6Mod7985X",
+	"tRj1b/XzyKdjcX8NfFL36JA2mbA74vExmGKN4eCy1mzqK8sM2CFb2aatXTKcSyawVTJXh7R2M832rFbW",
+	"KvITC9hsgBm+blp+z6xRXFjubiPy6Kx0OLhy6t/JglDz4FCJUjnHivGt0mmbTec36oyazstPX7fa9+kf",
+	"rd1757em85I6o09f2/flCm8ahPEkwReNy2C/xGQFd84XNn+ltIwZ5VEhTq8QqVGPws/hq2Zz1Nx9QWlz",
+	"SF8228MXCxG3PB76Poltfx16XGcljP0vmkM61uMPHcVRwwD5nG85zWtY+i6/PCVh6YP2wgPysneg959s",
+	"mBYxmtex/0WlVDzQkOica/YO609oHcW2IKXAStPQpKy2naQ/dNL3iKuhJ5v2sWzlpuFz1QpWuxqoNphl",
+	"UGqVAQODlWKbmkjSZJNlsOL744eBmn1yvRBeq2nxJfUKPdMsaavh6xR6rYGuWfbBtbYtaScKqvCbZciT",
+	"EUUdJAw0Bp0EqFVP1NdCfqxVYRwkk2du3CK0A3DWyfQ1VAqa+imFogxGRMgDD4NJLLZwh7HR6lurnjat",
+	"sbejuGpfLwYlu4pcU1vWEUZIxeYMqedYiQvXwEEhod9CZJQlAVyDTVB3wnowRBlm8jkW7+saOE27QSid",
+	"JG3iGhBm0i2uAF0hS+NjAVhI8JhAqM05+kXxWtAlfVaAzE4R+VhAvcnljSxkmEiAzOckXAPIfNcVYM10",
+	"eSxglyRPvK/Xvv0gmm/FxJroABbzQOIzw/RpMypKtdfdg6uz3vuL3vmgZr99LekNMkZk8aHcK8NVE7gu",
+	"fxe7Vr7gusk0eaWxdqUkxrLslfZ7PpLIn6uipKR3ko20JHbxO8DNyvRbSbqW35k4JOM2oYJMqQ86LvOI",
+	"8jpIygOR0HhCc/Y7USsqsmJNuvXzQqRn9hEXGJKXjFD25Cs1wa8wQN5Yf1/PqHdLeleH/JtxFt6emWHK",
+	"gu7vkxTpj3CR81U5pJ3s+D7JkpJJgLvCKIVuTyQOkI0hLSZXxqgrzRPMCqyQkFqCV0uGSNMHrylEpB1X",
+	"wIvV4ZHlB53cxQyeg9DkLlgLuhUhy6YLXxesQEbzc7W6auBwbMKgLdGgJBCq5FxO+GVN6MIvVQtMIMvX",
+	"J1gTtnfYsQysQm2DPDS5dIfrCUV2z4XwleRWfHwQrdHhXMZBAWZMY+RQ31/fuINNsf9yOi0mwloT2FMY",
+	"oAzWqhxaKuJACFTm8vA+zHqxDqjZDFWPBexBMQPVQjiThGBPBaaa4JHBK6YfWwiklZDsqcC0M5CtA6iO",
+	"va+Cdz9hrZyJ9OnYzKS2XwT7H6SgqmmeQDfNZMlPgJJUiie5TpL8O2tColJPVe6dlbonAeKPuT+KCf4f",
+	"a4/KagMAcGEw8rm7rhCsJRQeXMWCXalHdvkkSAFMpj4ZXo7PSFVmFJWyJ69J7p8cvzns7+fUyJKhOmZI",
+	"LkzYmT9Px/0u1OwskpQBtRRJ6hM6yJ+rGJVw9BCUJQnWfku+9o+OLgbd14e9qzf93uFBra6iR2udmk59",
+	"WkDzkOn1eBA/niZdTNdwX19hePM86iHjfyrpZuEIhB4c/r+BCDI23CvLxp5NspezwOsiPMnDhby1j0zx",
+	"7fXKRyhTEsaap0NchV5raj0PPjdSzgjg7FaC1h/CTrOvGV/OSGP4oQ5psqNaFe7CbMTnT2PNkxprtLJt",
+	"FaJbR9tOey1WSnW71alKibS94Ib54WyhTK+Gzkp7j0syysSa5BNYSjRlWagei/ZMap5l3XMpfOxsLw7+",
+	"71LSLUutkxkmSWyz8lD5VDi54QSTawyVpqz51iP5C43my7pZKTy+30OcpIr+Wn5W9PenPCuPwV5/Eup/",
+	"190BjStpTj25eFwqQ3VRJ0pcSmTFpIoWUzdBz4VXsfx3WxBJkwGC7IsRgmSDj+DpCrllkcoEmnmm0cZK",
+	"N4uyLz3KWYFXNsu6Wnn2dCo6x7yuWXqLFPPW/aA0HM50JuuvRTspqghTJiehJ3TcN5J2hYSKvNWQp4P9",
+	"nXfp94XUviRl7X29fPgjtbiHpLQ1cNGIJeoQZiygOFGaX0zB+khJbd/2BnV4tVUnGPNVJwe9w96gVyfv",
+	"et2DOjk5HfRPjs9XSkKboOKI3jndMVsLx5nUtTAkYKA0ZWhp9GkWgxp7dk5Yg7MLoZ6Ea8ASRCl6cumM",
+	"DrkPGS89LtwQX3xj8rwX7a0WOdfvzl80thutp0CldQ4iJiPObtbWBFLPwAp+t6fQA5KFP6F083j3zveh",
+	"TPw5t8dP8e5H10Pwu5PWC1gnkDXptNQtkVQkWJ2bYAFpb0kUb1lNgxQ2qwrAuhHcq7jNdLtsuYGFXUy7",
+	"J+Cpeuj/FdvK+uzwJy/70XmZcKyHPN567w2SCpupcPTwYiBttPc7ey/p0HnhsZEDPznKBwAuAFZaiPGm",
+	"ZWXfXmGIRy+3WcMcBlg1yMRztZut+3qZM0U3zdT20aoW9VVlpMSaYAbbbr78U2s+3j/4DdUi/nuET5BV",
+	"iBlxdAooLtkUQ1xnUQjsmHmQtkjFqSt0gErCqDvBpj8wo/7Jdn98tltuVNwPfV9rw3DkMauiSbn2P2dj",
+	"3G6+/E6NjN9Ew4NQUt/RVbkKyRjho1XJQeWcSELAAJfmcX2aMmdnWSb87/UQmILea2gapstCnQEbrasw",
+	"CCgmvujWyhUb/2mS+amD/LwMH4UPPMA7IYib3JU/HRQPdFCcnA9+uiQe6pJYE3m6eiO8JDHloNfxP+gu",
+	"q7wdSasLr3T7Vb8XsQr3Zp6IPOHznoc87FkOgBrVeqbk8xsWACU/1VasuQeHej1LdkHFdGJyEwuGp9iH",
+	"8Mvjrz5ZObztS2vNrB0Mn6Rwvpoyj9OSx8dnpqAKmSYFbhB5SdeSsNfjk8FVd3+/d4rhyOXB0BfH5xen",
+	"pydng97B1VHvoN+9Gvx62rOClpNqK6mJ5yJdsLWcTub98t3UzwUtWyGlWTA0GSRjQpkA/c/OD/smOlsK",
+	"Jxtxuxg9P8Nrn1SCe2iqj0LqjrKy22n+jfLT+ubk4vggc9Z0R4y87h+Qv65C8H/NzPPDHJc3AFDhpCSp",
+	"gr2QqZOCJuefp+TJT8nUiioo7laSD9ohZ2aL4kBngSaCBy5TFVKTR/ZWZmw0m31XRof11fzvbct0fQBH",
+	"hqGDRWrW841pLnXa/fXwpHtwNTg5uTrsnr3tZbgVJeB8S7ZUiUSCUEmmoZCk3TS67hPxpW9nNKcKS2QQ",
+	"huQQsJR7DIPmXl1KMG/UZHcuY7r0tlHksMrqT2b0tJQdsSRbvTPCh7drXt5M0vHVlAvkPrlKHciV9Cfi",
+	"ZEs8W9Wd89f56Vlv/+T4oA969NWbbv+wd1AugfcG3bdXR/3zIwgGtARvK7N/esBOTTlwXFZy5anFFWoN",
+	"6CSsOUH8zMrMT4aMBQkYWbaMtmDq/ygixKlFJUQ/z1Zn22DamLXSZrdU45d9h2f4D/byfG+nPqKSOb6x",
+	"oK9x2KHjFXZkOSn9LK2LrXh56ck+6w56V4f9o/7gqvev/V7voJcV2UtGaZBTn1GhS0ATOpIsIrtNUyj6",
+	"RzlicGke0WBusn1BKISFjYTfWMj9+ZTpv8Q3g/XPHSyAvrx3rlT698g9GPX4kxpMkxnWNV+fmY4r2E5V",
+	"kNWGx2Ys8FjgcpbJhYRZyFJQn8KumoIZfnkCIBWAMtS6BJERHY24i5L+wxPDeFTSIRXsKulsmWr0NxAD",
+	"Au01Uc2KV0H/eNA7O+4eXvXOzk7OMreAgUGy6SyMaMT9ub0zyY2A9wEWBPOpZNH3k6NBsiigfhmG+vqb",
+	"yZb/AOxg5UJ2N1MVCnEAEroowHrfN2q+/ZZM0Kdr62NDKB+zACc/NcgnvQ0En3KfRmukhluNBs7VuCsE",
+	"kOqWf2gozk+B6X/p7fc35MA2CXtUqunSBNFhBMWHMQeqalW8Di6OuxeDdydn/Y85hQlK6rNA6hWo/ir3",
+	"UX7s7y1bdAlCTJpoWgLUYyAlSXb7g9yGFxZZwiWYBdsCGMgANEht4PuxLsQPHz44FuisJHAsixjEKyM8",
+	"UMmIVcxYGtDzmtEIq8ZSf/rqMglLozOOFTIXRUR9f3xLP58AsdkBFMj5txaaKPIv/KTKn5ac0l+6h/2D",
+	"LppyjSxblljuGNtd9Y4vjq5+6R5e2HEUpihResLVlCb5exgwEo46ZEHV6+qACuWWSJKnI0g01VzE95b5",
+	"Det0lu4DliBOSsV/2z68OTk76g6sPbCK9OcfLPU9Mi2phrwA5Qm2aZDcVGmh1e8F4ykplGlyv5QQysNw",
+	"DrUO+me9g+U5FdP69CZre72wc4e947eDdwtTJ+IvyZ4NmbxlLCAtLGraajaJO6ERdSWLxH/7sXmMO9Zi",
+	"oaSHLLSkEsMt831H12IYxhaFCzalcPWkaPmpjD7VhZfsNiI3/wjxDEuhFqWDk1i64ZRlSsDDjYJPEMMR",
+	"yTrma+AzDWcsktwou16JyHGkEmo6EaMeUo6ySUDjOhFMqqhuOdHTJIJZKoZYb0ULtUITJvK1ovrCSFWB",
+	"DUfJFHVVdrDkmK5UfhPPxwFOW7tPVpRU3FycESX7tMZ6KFuoEB7qpKHWwoku9G/zHawri8VqCZWkaeOt",
+	"WSyYa3Gw/ITv4ikN8pukW6+4T1WPdgublpZ4yK0BgtjVx3Si2zD2PTKhN4xMqEeoIJSodPGm4GBKj1bl",
+	"4fJywWltkN805pPVfEo6hMPPzMWSIsUKiIU1V1cGFOyGRdSk2xcN0jNvdFEDAQBT4rRnwduHi8tA0yho",
+	"LQHhUpDwNqgTEWaEBbMZKrFqGEsSMVi/9UIieRYxBAe1CttPaD2nICTpkU09x3qasLV8ubUSxE15oIvS",
+	"topHpPpNdAl29cMNppvggwcDWpjyK+utdMqosvRRt6tGYeHmAgdTT/dXrMNbwVVLeIJ5pr5otCNokydR",
+	"XI/uX0afGGV2YNw28/0Jc9HXQn3/ZISC1WImlO14X8+jH8cniV9oTlxoqAhiFoa+ndC9gMsqzryvcteb",
+	"eq+mXb4/jK/SyS+rRZk0BNSHkvr/ZHOx/A3pFzYXhqWqxPr249Fme9sqPt4s5Sa5/Sj+8snskV07phwh",
+	"1lv+hL/po4jFZIq3bVLyJldyd8LkhEV2ZutMFm/dz4JVRjFLlj4MQ59RLAX3hc2rFvuFzQ1PyS0yfx10",
+	"blqdVUXe/D0hpX9lghtKWIM22BIQlR0ZOvCOAjZUd6mDrcdppfcWLpEE7IYZU6DI3BnbzXpNK424z7vb",
+	"NYsEnOU3SoJae+EKj5Wnt2f0qSx0+HP6bk29zQLMAwmg2FI8MWzRUPoqJ+rb0LBQuDniKEP6CoxcRYOy",
+	"8s826GruSij1W6QKasq8Q0qAfhh8fKQRla3nUwEgZCXn41hZQleWU/Z1iFx23fo8JKwkAKr5rWYeiIEN",
+	"yv53rly8WVvaZDHCF0gtxWIq1feqCiKC6iJAEW6mwspwbmqrlDD3ipTDxwl7zY5lOlig7iw6baXCq1W6",
+	"JsfaJ8wsVdV1AEU0FvoJpIaujDk9W2fb1QWfUJrebxjdOpYlhKYL02TQudLmphDXE4xXb/jDd7qwvbw6",
+	"sW//IMWwBmwjDHwl+2bvLfycSV2xqvEjoQs08T3pFtGKgljffAAT2aX0sldO+wXi04TLilwY6RFLnbp4",
+	"Ifth+CWeCR1/KzPTRLnD19ppN9c+fxMuzwCDJUlNJjRCdJs1KBphESMTLtVV3Exu4oipT0Fo2mf01sae",
+	"tTIvjBX7ntI7tbRW6TKVXKfFlqWYs2U/wXwVLGHieezFbLfXxhK49ZYuQKnPa2/b1t76uwZ88IhNw2j+",
+	"ei7LVFj1ER8buFSmdVFcm1RNcpqskLy9t/Nid80V5c5RQug25qxdLAJgEWLp6bN1087Xh1byzx5HncGt",
+	"7OLBT8+nNIhH1JVxpDY4FY0z7MZkgJvSO5MgqNVsIsKSv0v4ncrOVjZ7QKdswXz5fG7WvO2dnaXzLjRE",
+	"Zo1Z50lVbnt3teFeoa9st0q01xxrKdOLTJ9FWif1VPw99U+tJkrZyRk9kpbW0GUaamH1q4qkMqtGZzIH",
+	"ZASGNFowYiMg/LLbz6dCIrbKJLCBcRwYqoDWRlRV+nuSFyKDyHQVFf6GlCdTyRzQusoXJ2HAoxJGc6g+",
+	"VS+MB2TKfZ+nupMtLS5hu1WWTWt3LUc3oUOwleU2JhG8LOuz2hJVhu80FHIcsfP3h6S122itI5oMMjp+",
+	"dl5LXYhntboKDQYqHUdURbjHwZcAfszoCvGsuIDVpZQqDtktSXT/XTFDK3tmNfEnWr8GBuRV3ZFs8Ok0",
+	"liq0+dHovkxmvgj4v2NmOXT10UtWtYGe6psXTyMlJ3lBl3PvQ2z6X3XTZBKRrkcIyHl070oK2O5s76xB",
+	"AXnvAgycuf3qSfRGSsDV53Idm7h2sSohJquDoanW5EystHev5qp6MjO2TYJFp1B30DvpnhMkZrsOR0Bv",
+	"+NgodFm4VB7cwvXDgy/AxPXVl+dxKRVYyXPXPIgRdyI2YhEL3HISqYD9XFJZcexKq+Sl509fG7ahS0X8",
+	"4D90yE/m1qg26tVrdw4M6FirUKJU0sUu4p78irsSC2tuu1lqeh4yIFG0y2xYNTndfP3KuvWTtnts2uDY",
+	"o5sf0WmWsVkmq7pP0JzNZbjuyZrRMQ8yWbdMnPVqp2xh2sRV3c/pcXy4l6le06AsiLZIvCtpy0XHOjNk",
+	"2RmvsHObTGza4WwbvFXw1jeGGGTOdUkQ0uoBBZYYqYbXLZ80muBB7voMzNWBSsaDrLexgAkraKlCrjHj",
+	"5uWbiLp5e+tjiTRWWNQKV37h+csjyXpJ5FXB+ba1TzAuh2C+0Dt8aqaspnhzcRhjGKM2obBENrAerBU+",
+	"pB+Q50TCZRFey+QRfRhSEkm318Zq5dHVNFpiFpbKlFXUrShJVGjzuOsbT3OquRZGTlFVCCIsbJ+OByy7",
+	"bfGTsl66FG+qhI4yk2jJrjB05YE9SP8Crn+rq0/fRmEwVvdHEmFRmCgXsb94o80QZiVlO1osiLLMbwAF",
+	"UdQtmKmWrDLxrOQ/6B/kPMa3k1CYcUAo1zVXnspd8E1mLJ6K72X4rHRvhtNZxCYsECChZEwdyU2Hey/m",
+	"QrIpiBBRmTMAu4hFtjEeePyGe3HGhKWmEmQchfFM2XVdKtk4LAkn4MEoKpFS+vCzkFGMyjzJPBHeEDKM",
+	"MDwMLcV1wqTb2CwPVFipxn4xIKWmp1i+d7meBTOIGqZs84R6Y1uGXvUlBzUYZ4SMGJ0S03WzxA6ZjPkt",
+	"6zbDfCoLNskGs8H2WcCUQrrANBVisJpf7tPWo1r6Rvgla5/SFisIyZAsoIGbUzqwffFcItkvff+IrfrB",
+	"KFxZAtDrtk/c493+8Qy/LFn1BbYyq75ZHNtsOunAZtWryimcYiAdN1lV3TCLMgJIMpKWaCPqC1QZGbLq",
+	"GIxFJGQyr/5BxLMOISRLe2RSsLa1nHWk+5POeNNqNBvN1YMAyva7bHeVjwxpp8rHFyuHROa9V3aD0Qt4",
+	"NKwOeplmvIX4vC4gR68zTtOdhh3cMfJDVOUKntqxuz93fSYWOUrhkKjy1W/3iauaZ/zYu8scA2IujoZV",
+	"4YAamnAIOhHziBLGJ4ycnBfhetFubK0CFwYhdqsQmZk4dboq9y/6fIszQzhiY2/53PelZFFmfkjku6Sg",
+	"imXrMLpJRqYPPNI97RuK5sG4cRl0fd+OQkiTifPA9WOPKWFdC9WhST9LwiEwBZNpHEb22DAej9WgRZq0",
+	"yjsV1PJ0ScqyJENTKUlNbkWHa/Zz08qyl5vWw9Tfglnf1kt098ZlgFnBmAr4vk7fmFynIqBS+FRydo0x",
+	"VHj0K5VgTPxwLMrw9AQK9gNUW3Yn8ZWUdXyK+ixk6I+YgB8wXgiV9DKFmAvCAlD8PBsjMtTzRSYrFHWj",
+	"UAgyhaJZMz+5Z0QBM9+qOtuaskWKZSz4NGNXyyeFNN/SMwf7jMbE5OQUA4GoOGZ3cmGgb6TssSSAbZnl",
+	"TEBV4b0TKk4jdsPDWKw0+Ew3Lkwwor4onWEl/1OKltQHxe7kfhyJMoPhyYzC2XPxM+JvxKwqQAkGSIwP",
+	"6CHQh0mSGicbl8EJkN9M0yKSocYxwAnYylMQm/9j2v8c8sMPx/OPH940P344e+3t90U/+JWf8P786KDf",
+	"PBx07w4HvdYvB73bk89Htyefu7cfeF/0p/4X6Hs8uLj9OBg3jw668uOgv/MrbzaPPrxvHn7obR0NfpXH",
+	"B+/bx58vWscH72+PDrq3fX7LP+73d/vTHZ+9e89H78tO66zU1mCuasSDDnjfaDn44CVXTMoOpmqVxlzq",
+	"XX/gfmSIZt09MeT5SPsyhz35xn25S/YleD3/+K9fK/ZF8N/ZIqlGpXqdsahwmNpNO9RNe8MX7A/KGv3y",
+	"xzzlVbM03wRlDyYXhZpZi8UpnPAUOy6dsDD+3lqhYRo3iMwMpJlVLObDK3sVU3Jc5Fkc8UjIRa5FsOFF",
+	"osiFE6fi3+HLq9Zl3Gy2dwG0V+3mGj5EFf2zeAU+Xb6AvYcvIGB3SxaQcuGNIPZ9iIAKg3RZmwvW1V55",
+	"XTCy8klmbjiLOVbebvZasxzKXm+6kZvftI5l3ujUx/tURHNfekSkO1k5SnJGI8mp78+VjzZjCt44haTD",
+	"myrs0461az1i4FDjMnj27DiUrPPsGdnPe4wJt9tqozIX5FI7pC9rl8FjhB6tEx3zyCvOxNeQI3r3B0Vz",
+	"FgnHfpiXt3cn4YvLngdCWPiKse04FLbPBrBvbS+7q7jns3RNC+eDplayxiQ6HCZfLw6RC7HYpIHw6Ga5",
+	"UPPFQwtJV4YH22YAitg0vLF1tDxoS+eXfMrCWC6x1yQkkDTPPkpfQbxYCGNeyFhh01pLp72lK7y3AIBA",
+	"E7JgxIwXlEv11CgzZ3tvlUkPYvUo5bgSUpiViBkKxpQj61XmgQzYAQ3CsrDZJv5n3aes9VqaWrXkctCf",
+	"csZi5coqi6b96c366c36U7xZSV7h79Anka7tT3JKkA1VYJT6m4/mn1jgfKpIY7peFF4qMgmi861iXmf9",
+	"Yz1JgmGFo60Wj5fLnVpP6s3s/JGheWtGzlqu0SJA+C2t47HI6+PO4v0wWiy27J9egE2aCVL6qnpvmaVi",
+	"HEZhLHmweBYdzWk1XktEUm6V5bhOPGWll98gooHKv7MkRianJcmkX0ZBkqF+XGYCwIs+1W+KTqkOTLlA",
+	"ZWNlLQ/TA5dqeBeDzdpPbe6/X5urfJtXX0hFSShD5Z2iwg8WMhlPi7pLo1n1WEn7zM042WpOWzuleaVM",
+	"h/OqnCEXZpGkRFR+2WztrKCBRau/ONFSRtlDXvuGb+51ms2HvzRJ15RioHQb7eCSwvL1x4oncqm8VPDM",
+	"LnTJ1pb7WYcxLwvGfA0/m2EI6jtTXf5lkhkVhRWHDt1We2u7bIJxCbRvQxLFARJD2UrHYavR3lmKeYDe",
+	"AFAq0wrmxhGX83M4jQpjXW/Kg4HJ+Fzyil6nvk4zFuvX2BQ6EhZ4s5AHUigq6x4c9Y+vIG/a1Xnv7Jfe",
+	"2dXg5J+9Y2A9AqtN8UDlFFHZrhTDq/3LwVU4A51O2ogrM/5PpjJ0UcFdyBVcsi/wSZUbzyWnhgsEF8mF",
+	"BCq8YelqTbZgdBDCCOmsEylnyh4pmAzNpENGIxa9MafhtHveG5zUCtW48GeycepTCWTrdMdBKCR3ybnG",
+	"PEEYxSa52VZ4haAFgvvC6opP+xgqgDhXpK8gyQDXuAzUWjpEJ0W+2W7M4qHP3cZXXRPxvvFV8HFA4bq7",
+	"vwwyIGOfPMwql606jCjiushW9CZpORljLnQpY4jyinzdX3SePx9zOYmHDTecPqeRO+GSgeIZGatxrZDD",
+	"s0vOeucDHBOAnNKAYjqQ3Gso/aIFxAGyf3ZxYGUdwiiEEfcli1QeEV0wnqPj/TL4y1+IWjk5CEF5gt8w",
+	"8Z2ewjw/6FwGDnn2rO89e9YhxYCK5GGkanZMpwwaHpinX1OmPryGy8v6Yosc6nmRaoc3ILTbzzyn2liQ",
+	"KFlPjekEgL6BwcMIK70x1ah4HQul4p3FPhPwo0OSAZH9FB4/QRMAFxGNEJCU5xJ3ieSBL6IISByBQ/oI",
+	"UaIvFR5V6UUCNfySRPXAj4MJV4QXC2Ylbk1Df3BxOprHCsGwGiAPYGPOREdN8xczBzlXn+YKvxdnh+SU",
+	"yom1BMDy9fOb1vNrsjGLOKQZJlMmJ6Gn90QlOs33sHLIdshN69pU4tugQK0B1ZuaXUw/ve9g7K5fFsVk",
+	"D50MywMPuYPWSu0gJBhJN0/TCKhYeUFoxIgXuvGUBbh/ioTUVz8cQ9/XEaNf8HjpPvoyIFP6GV4bJXe1",
+	"GzEYxgAFW3bAZhHTLHnj7M0+2dt5ub15GXwAYqWBHcNFVAoAbM68OqEZ4G+57xsM4Gm9tobuoEP+mgCR",
+	"IRp0gJPh+Nmhsfd5HAgmOwScWFsuEC/+CweBdb5ob7XwYnE8fBJgDhcsGNcyZMaGjeOBA82MFkc+/oP9",
+	"jUTMf3VZ0+6DMHI0rJc1mOfirJ+aX2Y+dRF9MIUie5ZEYwkyYf6MuD5ncNtO+RiIFlS5gN2yZA8EGbJR",
+	"GDEiEDrDAs31UzxM+spS9032ktEs0W4hgLCX3m7EKbnRsmPn1kXUCUKOVE7ywjzMMzKMwYsihX9hIWQW",
+	"SGcwnzHnRL0V7ZAgFAEfja51ozcRnVpfD3rHv5pP/zo/d06jUCobdoe0/kamocdeDf3Q/aIancuIu9JB",
+	"bRw4jWOW3yFTeueAS3SrtbO122w2/2YWfh4P1cUj1Bhmmaarcxr63J13iMdGNPalIyKX/BVctH9VHc7Y",
+	"iEURi5KGQq0ijPiYBw6QpYMRFPoX1euURVjOIwxE0tGlUxbRVxubdTLlbhTOQOXDP8csNNGzrzY2r1FY",
+	"8LnLAsEsCeCoPyjc+OGMBeqOboTR+LnuJJ5DW7Q/Sj8vPLylkt3SuRU8rIVm6ADjoRBf22o0G1sq5dgE",
+	"JdXnKMw9R4P3c8/Of+uzcmsInE0VWIKdPHMpYaib2h8VVWpZ99U64TrBODjsKBr61NjcBFQQlQkXTq+p",
+	"LKbFYowq3dBb2iF7zb2XmypaKJFcMFE6Jknr+r7CD5rpVX52Tf4AVbvZrNKqk3YKKw6mCnOo7zuWxLXd",
+	"bC3vn6mjc1+v7aw+aaZiHXbdWrWrnXXQ1k8wVawl9P/2CbLdpxn+EW2kkCoNBFo6hgz+Sq2pfYJBy+jm",
+	"OWzuA6kH6eLfMYuUiNnPU49eDN6r+AB8NGKuZN7TEpHJWSDkI1GRwtD/CP1YZ30NIvpqqmzcr0JJhopM",
+	"nG0+NcZwjjm1+wd/BKHs66ygMwo3omSRqEzPnDbR1ru+dwo/YQWKb6MxL/FQbK/edUg9xwTR/5dQGo5h",
+	"Nj1N42WylS8ht0nymnPMZBl9yTgKRCZwqzoTLBHxUD1zezIye8uknWT34USioIACrw9nQ1trTvbQjUYP",
+	"s0ZxBvsrbHAmj+yK11GSyRar5OtUO4q0mGcSuzYug3OjFI/9cOgIOfeT1LSCbLDGuFEn1yb77HXyb9EB",
+	"lth5dr35tNwICeX1/DTN67sWQ8qkFn4kpmR243+EK5VmV15GscLE2y3kSBMun0+5EMQN4wD2q44JRvEv",
+	"FLGn9ivAiAHllKf7fHJepQIIH046iJA/iFM9dNffMmmQasdBVu91Uc6xoI0X7b9iObf60Q5dkPi/jkKP",
+	"m2TxB7KIklT6g8EhGsgHg0O4xZwWmTIaiKr0+U9JJpbcdJ6mw/5zpCeFTrMN38Sotpvba84ahNJRe/f9",
+	"M7osycfiATJYySFIPN2zsOzpwzmTikKTFGnUTn8HPkyTn0nbatM4DVUaXEjLhn4ZqHGmKg9NncClDYa/",
+	"iKmK4jR188cqaem18h1cNwi+Y0rXou23jcvgTFlw9TPQjAfuOn0h+TSnCdO92JEAj3GSkKJfh968mqZM",
+	"E87E8xTl9mli6qXtuqfSHsHReWNWPZWIuuKpbK3aM3+RrHSgVVfrQI/COFj9PKvu2fOcP5W2Yzl/LJEA",
+	"KnP3lJ1Fy963HqmUVOdb2qdYTm9pF6uG3pqdEHjT55MF63OoGfU/BTC7Q69y56v9Y9bI8k18ov4jI8++",
+	"n1To6E98rYqv5D7/ia0ctpbYnhYkFNOPL3Q2Pp1QzI7mSh0wKr6uIG7MovCGe1pEEXRakhmdUGHFrUN5",
+	"VzUqE5dBmm8/l86sQbSfj3lqlRgBVIywKcgtyqC1r6Pj1xcT/iB7lp4GXwysIyy/y2anMtewitvW97Bv",
+	"ZWwqpYhzPp35+QRHIK16TLJoyoOk/oCJRuSCRHGgE7hcCGUACCN3wjBmI4wE2fD5F0b+GQ9ZFDDJxGbp",
+	"gDqUh0VETLBIp3oLpMIRy/bTJJl6+I4aMM2etl8u7xOBkOjzKZcr72gyTdmeZvYwmzerahcj+zHVCgc7",
+	"9zRk6XZivVUZEuq6bIaJWUYj7jYug331ZAqtmBGHs+Zn3/+kTMFUv1FpZoqvgiqJpbA4NbtNFGGs6ydg",
+	"KhceCEkDl5WRSPK27OE0kiDviYkknWcpleRezJWSSZ5x2PGQmnOg/K+uytxb8lBtLL6XwYgW1bYQPkBn",
+	"vGHq0Xrs5vlXHRJwjyXbIw4KP2I684YI9UsTwFsMlrcjimSoE4HbyZYAuEI2nCj0YvWGcoW1QoTjH7bW",
+	"T8n2VNRAw7BJFTmUKceYjdwsqaeqdjth1vX0oNfx2OkLHYnEGlB1Awnh/x8AgV75nxE7AQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,26 @@
+package graphql
+
+import (
+	"net/http"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/usecases"
+)
+
+// NewHandler returns the GraphQL endpoint, accepting queries over GET and POST and
+// mutations over POST. Schema introspection, which the playground relies on, is only answered
+// when introspection is true.
+func NewHandler(app *usecases.WebApplication, introspection bool) http.Handler {
+	srv := handler.New(NewExecutableSchema(Config{Resolvers: NewResolver(app)}))
+
+	srv.AddTransport(transport.GET{})
+	srv.AddTransport(transport.POST{})
+
+	if introspection {
+		srv.Use(extension.Introspection{})
+	}
+
+	return srv
+}
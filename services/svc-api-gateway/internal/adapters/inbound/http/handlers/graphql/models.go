@@ -0,0 +1,221 @@
+package graphql
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/domain/model"
+)
+
+// DeviceState is the GraphQL enum of device states. GraphQL enum values cannot
+// contain dashes, so in-use is exposed as IN_USE.
+type DeviceState string
+
+const (
+	DeviceStateAvailable DeviceState = "AVAILABLE"
+	DeviceStateInUse     DeviceState = "IN_USE"
+	DeviceStateInactive  DeviceState = "INACTIVE"
+)
+
+var (
+	deviceStatesToModel = map[DeviceState]model.State{
+		DeviceStateAvailable: model.StateAvailable,
+		DeviceStateInUse:     model.StateInUse,
+		DeviceStateInactive:  model.StateInactive,
+	}
+
+	deviceStatesFromModel = map[model.State]DeviceState{
+		model.StateAvailable: DeviceStateAvailable,
+		model.StateInUse:     DeviceStateInUse,
+		model.StateInactive:  DeviceStateInactive,
+	}
+)
+
+func (e DeviceState) IsValid() bool {
+	_, ok := deviceStatesToModel[e]
+
+	return ok
+}
+
+func (e DeviceState) String() string {
+	return string(e)
+}
+
+// toModel maps the enum value to the domain state. An unknown value maps to an
+// invalid state, which command validation rejects.
+func (e DeviceState) toModel() model.State {
+	if state, ok := deviceStatesToModel[e]; ok {
+		return state
+	}
+
+	return model.State(e)
+}
+
+// UnmarshalGQL implements the gqlgen Unmarshaler interface.
+func (e *DeviceState) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = DeviceState(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid DeviceState", str)
+	}
+
+	return nil
+}
+
+// MarshalGQL implements the gqlgen Marshaler interface.
+func (e DeviceState) MarshalGQL(w io.Writer) {
+	_, _ = fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type (
+	Device struct {
+		ID        string      `json:"id"`
+		Name      string      `json:"name"`
+		Brand     string      `json:"brand"`
+		State     DeviceState `json:"state"`
+		CreatedAt time.Time   `json:"createdAt"`
+		UpdatedAt time.Time   `json:"updatedAt"`
+	}
+
+	PageInfo struct {
+		Page           int     `json:"page"`
+		Size           int     `json:"size"`
+		TotalItems     int     `json:"totalItems"`
+		TotalPages     int     `json:"totalPages"`
+		HasNext        bool    `json:"hasNext"`
+		HasPrevious    bool    `json:"hasPrevious"`
+		NextCursor     *string `json:"nextCursor,omitempty"`
+		PreviousCursor *string `json:"previousCursor,omitempty"`
+	}
+
+	DeviceList struct {
+		Devices  []*Device `json:"devices"`
+		PageInfo *PageInfo `json:"pageInfo"`
+	}
+
+	DeviceFilter struct {
+		Q      *string       `json:"q,omitempty"`
+		Brands []string      `json:"brands,omitempty"`
+		States []DeviceState `json:"states,omitempty"`
+		Sort   []string      `json:"sort,omitempty"`
+		Page   *int          `json:"page,omitempty"`
+		Size   *int          `json:"size,omitempty"`
+		Cursor *string       `json:"cursor,omitempty"`
+	}
+
+	CreateDeviceInput struct {
+		Name  string       `json:"name"`
+		Brand string       `json:"brand"`
+		State *DeviceState `json:"state,omitempty"`
+	}
+
+	UpdateDeviceInput struct {
+		Name  string      `json:"name"`
+		Brand string      `json:"brand"`
+		State DeviceState `json:"state"`
+	}
+)
+
+func toDevice(device *model.Device) *Device {
+	if device == nil {
+		return nil
+	}
+
+	return &Device{
+		ID:        device.ID.String(),
+		Name:      device.Name,
+		Brand:     device.Brand,
+		State:     deviceStatesFromModel[device.State],
+		CreatedAt: device.CreatedAt,
+		UpdatedAt: device.UpdatedAt,
+	}
+}
+
+func toDeviceList(list *model.DeviceList) *DeviceList {
+	devices := make([]*Device, 0, len(list.Devices))
+	for _, device := range list.Devices {
+		devices = append(devices, toDevice(device))
+	}
+
+	pagination := list.Pagination
+
+	return &DeviceList{
+		Devices: devices,
+		PageInfo: &PageInfo{
+			Page:           int(pagination.Page),
+			Size:           int(pagination.Size),
+			TotalItems:     int(pagination.TotalItems),
+			TotalPages:     int(pagination.TotalPages),
+			HasNext:        pagination.HasNext,
+			HasPrevious:    pagination.HasPrevious,
+			NextCursor:     optionalString(pagination.NextCursor),
+			PreviousCursor: optionalString(pagination.PreviousCursor),
+		},
+	}
+}
+
+// toModelFilter applies the given filter fields over the REST defaults and validates
+// the result, returning *model.ValidationErrors for out-of-range or unsupported values.
+func toModelFilter(filter *DeviceFilter) (model.DeviceFilter, error) {
+	result := model.DefaultDeviceFilter()
+
+	if filter != nil {
+		if filter.Q != nil {
+			result.Keyword = *filter.Q
+		}
+
+		if len(filter.Brands) > 0 {
+			result.Brands = filter.Brands
+		}
+
+		for _, state := range filter.States {
+			result.States = append(result.States, state.toModel())
+		}
+
+		if len(filter.Sort) > 0 {
+			result.Sort = filter.Sort
+		}
+
+		if filter.Page != nil {
+			result.Page = nonNegative(*filter.Page)
+		}
+
+		if filter.Size != nil {
+			result.Size = nonNegative(*filter.Size)
+		}
+
+		if filter.Cursor != nil {
+			result.Cursor = *filter.Cursor
+		}
+	}
+
+	if err := result.Validate(); err != nil {
+		return model.DeviceFilter{}, err
+	}
+
+	return result, nil
+}
+
+// nonNegative converts a GraphQL Int to a page or size, mapping negative values to
+// zero so validation rejects them instead of them wrapping around.
+func nonNegative(value int) uint {
+	if value < 0 {
+		return 0
+	}
+
+	return uint(value)
+}
+
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+
+	return &value
+}
@@ -0,0 +1,57 @@
+package graphql
+
+import (
+	"embed"
+	"html/template"
+	"net/http"
+	"strings"
+)
+
+//go:embed static
+var staticFiles embed.FS
+
+var (
+	playgroundPage = template.Must(template.ParseFS(staticFiles, "static/playground.html"))
+
+	playgroundAssets = map[string]struct{}{
+		"playground.css": {},
+		"playground.js":  {},
+	}
+)
+
+// PlaygroundHandler serves the GraphQL playground page at pagePath, pointed at endpoint,
+// and its script and stylesheet under pagePath. Everything is embedded in the binary, so
+// the page works without reaching a CDN. Mount it at pagePath and at pagePath followed
+// by /*.
+func PlaygroundHandler(pagePath, endpoint string) http.Handler {
+	fileServer := http.FileServerFS(staticFiles)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == pagePath {
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+
+			_ = playgroundPage.Execute(w, struct {
+				Endpoint   string
+				AssetsPath string
+			}{
+				Endpoint:   endpoint,
+				AssetsPath: pagePath,
+			})
+
+			return
+		}
+
+		name, ok := strings.CutPrefix(r.URL.Path, pagePath+"/")
+		if _, asset := playgroundAssets[name]; !ok || !asset {
+			http.NotFound(w, r)
+
+			return
+		}
+
+		req := r.Clone(r.Context())
+		req.URL.Path = "/static/" + name
+		req.URL.RawPath = ""
+
+		fileServer.ServeHTTP(w, req)
+	})
+}
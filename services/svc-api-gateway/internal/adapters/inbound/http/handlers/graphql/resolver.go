@@ -0,0 +1,133 @@
+//go:generate go tool github.com/99designs/gqlgen generate
+
+// Package graphql serves the device API as GraphQL, resolving every field through the
+// same use case handlers as the REST API.
+package graphql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/domain/model"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/usecases"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/usecases/commands"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/usecases/queries"
+)
+
+type (
+	// Resolver is the root resolver of the schema.
+	Resolver struct {
+		app *usecases.WebApplication
+	}
+
+	queryResolver struct{ *Resolver }
+
+	mutationResolver struct{ *Resolver }
+)
+
+func NewResolver(app *usecases.WebApplication) *Resolver {
+	return &Resolver{app: app}
+}
+
+func (r *Resolver) Query() QueryResolver {
+	return &queryResolver{r}
+}
+
+func (r *Resolver) Mutation() MutationResolver {
+	return &mutationResolver{r}
+}
+
+// Device returns null rather than an error for an unknown ID, the GraphQL convention
+// for a missing nullable object.
+func (r *queryResolver) Device(ctx context.Context, id string) (*Device, error) {
+	deviceID, err := parseDeviceID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	device, err := r.app.Queries.GetDevice.Execute(ctx, queries.GetDeviceQuery{ID: deviceID})
+	if err != nil {
+		if errors.Is(err, model.ErrDeviceNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return toDevice(device), nil
+}
+
+func (r *queryResolver) Devices(ctx context.Context, filter *DeviceFilter) (*DeviceList, error) {
+	modelFilter, err := toModelFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := r.app.Queries.ListDevices.Execute(ctx, queries.ListDevicesQuery{Filter: modelFilter})
+	if err != nil {
+		return nil, err
+	}
+
+	return toDeviceList(list), nil
+}
+
+func (r *mutationResolver) CreateDevice(ctx context.Context, input CreateDeviceInput) (*Device, error) {
+	state := model.StateAvailable
+	if input.State != nil {
+		state = input.State.toModel()
+	}
+
+	device, err := r.app.Commands.CreateDevice.Handle(ctx, commands.CreateDeviceCommand{
+		Name:  input.Name,
+		Brand: input.Brand,
+		State: state,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toDevice(device), nil
+}
+
+func (r *mutationResolver) UpdateDevice(ctx context.Context, id string, input UpdateDeviceInput) (*Device, error) {
+	deviceID, err := parseDeviceID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	device, err := r.app.Commands.UpdateDevice.Handle(ctx, commands.UpdateDeviceCommand{
+		ID:    deviceID,
+		Name:  input.Name,
+		Brand: input.Brand,
+		State: input.State.toModel(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toDevice(device), nil
+}
+
+func (r *mutationResolver) DeleteDevice(ctx context.Context, id string) (bool, error) {
+	deviceID, err := parseDeviceID(id)
+	if err != nil {
+		return false, err
+	}
+
+	result, err := r.app.Commands.DeleteDevice.Handle(ctx, commands.DeleteDeviceCommand{ID: deviceID})
+	if err != nil {
+		return false, err
+	}
+
+	return result.Success, nil
+}
+
+func parseDeviceID(id string) (model.DeviceID, error) {
+	deviceID, err := model.ParseDeviceID(id)
+	if err != nil {
+		return model.DeviceID{}, fmt.Errorf("invalid device ID %q: %w", id, err)
+	}
+
+	return deviceID, nil
+}
@@ -0,0 +1,403 @@
+package graphql_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/architeacher/devices/pkg/logger"
+	"github.com/architeacher/devices/pkg/metrics/noop"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/adapters/inbound/http/handlers/graphql"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/domain/model"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/mocks"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/usecases"
+	"github.com/stretchr/testify/suite"
+	otelNoop "go.opentelemetry.io/otel/trace/noop"
+)
+
+type ResolverTestSuite struct {
+	suite.Suite
+}
+
+func TestResolverTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(ResolverTestSuite))
+}
+
+func newResolver(deviceSvc *mocks.FakeDevicesService) *graphql.Resolver {
+	return graphql.NewResolver(usecases.NewWebApplication(
+		deviceSvc,
+		&mocks.FakeHealthChecker{},
+		nil,
+		nil,
+		logger.NewTestLogger(),
+		noop.NewMetricsClient(),
+		otelNoop.NewTracerProvider(),
+	))
+}
+
+func newDevice(state model.State) *model.Device {
+	now := time.Now().UTC()
+
+	return &model.Device{
+		ID:        model.NewDeviceID(),
+		Name:      "iPhone 15",
+		Brand:     "Apple",
+		State:     state,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+func ptr[T any](value T) *T {
+	return &value
+}
+
+func (s *ResolverTestSuite) TestDevice() {
+	s.T().Parallel()
+
+	device := newDevice(model.StateInUse)
+
+	cases := []struct {
+		name        string
+		id          string
+		setup       func(*mocks.FakeDevicesService)
+		expected    *graphql.Device
+		expectError bool
+		expectCalls int
+	}{
+		{
+			name:  "returns the device",
+			id:    device.ID.String(),
+			setup: func(svc *mocks.FakeDevicesService) { svc.GetDeviceReturns(device, nil) },
+			expected: &graphql.Device{
+				ID:        device.ID.String(),
+				Name:      "iPhone 15",
+				Brand:     "Apple",
+				State:     graphql.DeviceStateInUse,
+				CreatedAt: device.CreatedAt,
+				UpdatedAt: device.UpdatedAt,
+			},
+			expectCalls: 1,
+		},
+		{
+			name:        "unknown device resolves to null",
+			id:          device.ID.String(),
+			setup:       func(svc *mocks.FakeDevicesService) { svc.GetDeviceReturns(nil, model.ErrDeviceNotFound) },
+			expectCalls: 1,
+		},
+		{
+			name:        "service error",
+			id:          device.ID.String(),
+			setup:       func(svc *mocks.FakeDevicesService) { svc.GetDeviceReturns(nil, model.ErrServiceUnavailable) },
+			expectError: true,
+			expectCalls: 1,
+		},
+		{
+			name:        "invalid ID is rejected before calling the service",
+			id:          "not-a-uuid",
+			setup:       func(*mocks.FakeDevicesService) {},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			svc := &mocks.FakeDevicesService{}
+			tc.setup(svc)
+
+			result, err := newResolver(svc).Query().Device(s.T().Context(), tc.id)
+
+			s.Require().Equal(tc.expectCalls, svc.GetDeviceCallCount())
+
+			if tc.expectError {
+				s.Require().Error(err)
+				s.Require().Nil(result)
+
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expected, result)
+		})
+	}
+}
+
+func (s *ResolverTestSuite) TestDevices() {
+	s.T().Parallel()
+
+	device := newDevice(model.StateAvailable)
+
+	cases := []struct {
+		name           string
+		filter         *graphql.DeviceFilter
+		expectedFilter model.DeviceFilter
+		expectError    bool
+	}{
+		{
+			name:           "no filter uses the REST defaults",
+			expectedFilter: model.DefaultDeviceFilter(),
+		},
+		{
+			name: "maps every filter field",
+			filter: &graphql.DeviceFilter{
+				Q:      ptr("phone"),
+				Brands: []string{"Apple", "Google"},
+				States: []graphql.DeviceState{graphql.DeviceStateInUse, graphql.DeviceStateInactive},
+				Sort:   []string{"name"},
+				Page:   ptr(2),
+				Size:   ptr(50),
+			},
+			expectedFilter: model.DeviceFilter{
+				Keyword: "phone",
+				Brands:  []string{"Apple", "Google"},
+				States:  []model.State{model.StateInUse, model.StateInactive},
+				Sort:    []string{"name"},
+				Page:    2,
+				Size:    50,
+			},
+		},
+		{
+			name:        "oversized page size is rejected",
+			filter:      &graphql.DeviceFilter{Size: ptr(int(model.MaxPageSize) + 1)},
+			expectError: true,
+		},
+		{
+			name:        "negative page is rejected",
+			filter:      &graphql.DeviceFilter{Page: ptr(-1)},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			svc := &mocks.FakeDevicesService{}
+			svc.ListDevicesReturns(&model.DeviceList{
+				Devices: []*model.Device{device},
+				Pagination: model.Pagination{
+					Page: 1, Size: 20, TotalItems: 21, TotalPages: 2, HasNext: true, NextCursor: "next",
+				},
+			}, nil)
+
+			result, err := newResolver(svc).Query().Devices(s.T().Context(), tc.filter)
+
+			if tc.expectError {
+				var validationErrs *model.ValidationErrors
+				s.Require().ErrorAs(err, &validationErrs)
+				s.Require().Nil(result)
+				s.Require().Zero(svc.ListDevicesCallCount())
+
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(1, svc.ListDevicesCallCount())
+
+			_, filter := svc.ListDevicesArgsForCall(0)
+			s.Require().Equal(tc.expectedFilter, filter)
+
+			s.Require().Len(result.Devices, 1)
+			s.Require().Equal(device.ID.String(), result.Devices[0].ID)
+			s.Require().Equal(&graphql.PageInfo{
+				Page: 1, Size: 20, TotalItems: 21, TotalPages: 2, HasNext: true, NextCursor: ptr("next"),
+			}, result.PageInfo)
+		})
+	}
+}
+
+func (s *ResolverTestSuite) TestCreateDevice() {
+	s.T().Parallel()
+
+	cases := []struct {
+		name          string
+		input         graphql.CreateDeviceInput
+		serviceErr    error
+		expectedState model.State
+		expectError   bool
+		expectCalls   int
+	}{
+		{
+			name:          "state defaults to available",
+			input:         graphql.CreateDeviceInput{Name: "iPhone 15", Brand: "Apple"},
+			expectedState: model.StateAvailable,
+			expectCalls:   1,
+		},
+		{
+			name:          "explicit state",
+			input:         graphql.CreateDeviceInput{Name: "iPhone 15", Brand: "Apple", State: ptr(graphql.DeviceStateInUse)},
+			expectedState: model.StateInUse,
+			expectCalls:   1,
+		},
+		{
+			name:        "invalid input is rejected before calling the service",
+			input:       graphql.CreateDeviceInput{Name: " ", Brand: "Apple"},
+			expectError: true,
+		},
+		{
+			name:        "service error",
+			input:       graphql.CreateDeviceInput{Name: "iPhone 15", Brand: "Apple"},
+			serviceErr:  model.ErrDeviceAlreadyExists,
+			expectError: true,
+			expectCalls: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			svc := &mocks.FakeDevicesService{}
+			if tc.serviceErr != nil {
+				svc.CreateDeviceReturns(nil, tc.serviceErr)
+			} else {
+				svc.CreateDeviceReturns(newDevice(tc.expectedState), nil)
+			}
+
+			result, err := newResolver(svc).Mutation().CreateDevice(s.T().Context(), tc.input)
+
+			s.Require().Equal(tc.expectCalls, svc.CreateDeviceCallCount())
+
+			if tc.expectError {
+				s.Require().Error(err)
+				s.Require().Nil(result)
+
+				return
+			}
+
+			s.Require().NoError(err)
+
+			_, name, brand, state := svc.CreateDeviceArgsForCall(0)
+			s.Require().Equal(tc.input.Name, name)
+			s.Require().Equal(tc.input.Brand, brand)
+			s.Require().Equal(tc.expectedState, state)
+			s.Require().Equal("iPhone 15", result.Name)
+		})
+	}
+}
+
+func (s *ResolverTestSuite) TestUpdateDevice() {
+	s.T().Parallel()
+
+	device := newDevice(model.StateAvailable)
+
+	cases := []struct {
+		name        string
+		id          string
+		input       graphql.UpdateDeviceInput
+		serviceErr  error
+		expectError error
+		expectCalls int
+	}{
+		{
+			name:        "updates the device",
+			id:          device.ID.String(),
+			input:       graphql.UpdateDeviceInput{Name: "iPhone 15", Brand: "Apple", State: graphql.DeviceStateInactive},
+			expectCalls: 1,
+		},
+		{
+			name:        "in-use device cannot be renamed",
+			id:          device.ID.String(),
+			input:       graphql.UpdateDeviceInput{Name: "iPhone 16", Brand: "Apple", State: graphql.DeviceStateInUse},
+			serviceErr:  model.ErrCannotUpdateInUseDevice,
+			expectError: model.ErrCannotUpdateInUseDevice,
+			expectCalls: 1,
+		},
+		{
+			name:        "invalid ID",
+			id:          "42",
+			input:       graphql.UpdateDeviceInput{Name: "iPhone 15", Brand: "Apple", State: graphql.DeviceStateAvailable},
+			expectError: errors.New(`invalid device ID "42"`),
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			svc := &mocks.FakeDevicesService{}
+			svc.UpdateDeviceReturns(device, tc.serviceErr)
+
+			result, err := newResolver(svc).Mutation().UpdateDevice(s.T().Context(), tc.id, tc.input)
+
+			s.Require().Equal(tc.expectCalls, svc.UpdateDeviceCallCount())
+
+			if tc.expectError != nil {
+				s.Require().ErrorContains(err, tc.expectError.Error())
+				s.Require().Nil(result)
+
+				return
+			}
+
+			s.Require().NoError(err)
+
+			_, id, name, brand, state := svc.UpdateDeviceArgsForCall(0)
+			s.Require().Equal(device.ID, id)
+			s.Require().Equal(tc.input.Name, name)
+			s.Require().Equal(tc.input.Brand, brand)
+			s.Require().Equal(model.StateInactive, state)
+			s.Require().Equal(device.ID.String(), result.ID)
+		})
+	}
+}
+
+func (s *ResolverTestSuite) TestDeleteDevice() {
+	s.T().Parallel()
+
+	id := model.NewDeviceID()
+
+	cases := []struct {
+		name        string
+		id          string
+		serviceErr  error
+		expected    bool
+		expectError bool
+		expectCalls int
+	}{
+		{
+			name:        "deletes the device",
+			id:          id.String(),
+			expected:    true,
+			expectCalls: 1,
+		},
+		{
+			name:        "in-use device cannot be deleted",
+			id:          id.String(),
+			serviceErr:  model.ErrCannotDeleteInUseDevice,
+			expectError: true,
+			expectCalls: 1,
+		},
+		{
+			name:        "invalid ID",
+			id:          "nope",
+			expectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			svc := &mocks.FakeDevicesService{}
+			svc.DeleteDeviceReturns(tc.serviceErr)
+
+			deleted, err := newResolver(svc).Mutation().DeleteDevice(s.T().Context(), tc.id)
+
+			s.Require().Equal(tc.expectCalls, svc.DeleteDeviceCallCount())
+			s.Require().Equal(tc.expected, deleted)
+
+			if tc.expectError {
+				s.Require().Error(err)
+
+				return
+			}
+
+			s.Require().NoError(err)
+		})
+	}
+}
+
+func (s *ResolverTestSuite) TestDeviceState_UnmarshalGQL() {
+	s.T().Parallel()
+
+	var state graphql.DeviceState
+
+	s.Require().NoError(state.UnmarshalGQL("IN_USE"))
+	s.Require().Equal(graphql.DeviceStateInUse, state)
+
+	s.Require().Error(state.UnmarshalGQL("in-use"), "the REST spelling is not an enum value")
+	s.Require().Error(state.UnmarshalGQL(1))
+}
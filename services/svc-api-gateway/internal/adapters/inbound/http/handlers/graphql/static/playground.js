@@ -0,0 +1,172 @@
+'use strict';
+
+(function () {
+  const endpoint = document.querySelector('meta[name="graphql-endpoint"]').content;
+  const storageKey = 'devices-graphql-playground:' + endpoint;
+
+  const query = document.getElementById('query');
+  const variables = document.getElementById('variables');
+  const headers = document.getElementById('headers');
+  const result = document.getElementById('result');
+  const schema = document.getElementById('schema');
+  const run = document.getElementById('run');
+
+  const defaultQuery = [
+    'query Devices {',
+    '  devices(filter: {size: 10}) {',
+    '    devices {',
+    '      id',
+    '      name',
+    '      brand',
+    '      state',
+    '    }',
+    '    pageInfo {',
+    '      totalItems',
+    '      nextCursor',
+    '    }',
+    '  }',
+    '}',
+  ].join('\n');
+
+  const typeRef = 'kind name ofType { kind name ofType { kind name ofType { kind name } } }';
+  const fieldsQuery = 'fields { name description args { name type { ' + typeRef + ' } } type { ' + typeRef + ' } }';
+  const introspectionQuery =
+    '{ __schema { queryType { ' + fieldsQuery + ' } mutationType { ' + fieldsQuery + ' } } }';
+
+  function load() {
+    const saved = JSON.parse(localStorage.getItem(storageKey) || '{}');
+
+    query.value = saved.query || defaultQuery;
+    variables.value = saved.variables || '{}';
+    headers.value = saved.headers || '{}';
+  }
+
+  function save() {
+    localStorage.setItem(storageKey, JSON.stringify({
+      query: query.value,
+      variables: variables.value,
+      headers: headers.value,
+    }));
+  }
+
+  function parseJSON(label, value) {
+    if (value.trim() === '') {
+      return {};
+    }
+
+    try {
+      return JSON.parse(value);
+    } catch (err) {
+      throw new Error(label + ' must be a JSON object: ' + err.message);
+    }
+  }
+
+  async function execute(body) {
+    const response = await fetch(endpoint, {
+      method: 'POST',
+      headers: Object.assign(
+        {'Content-Type': 'application/json', 'Accept': 'application/json'},
+        parseJSON('Headers', headers.value),
+      ),
+      body: JSON.stringify(body),
+    });
+
+    const text = await response.text();
+
+    try {
+      return JSON.parse(text);
+    } catch (err) {
+      throw new Error(response.status + ' ' + response.statusText + ': ' + text);
+    }
+  }
+
+  async function runQuery() {
+    save();
+
+    run.disabled = true;
+    result.classList.remove('error');
+
+    try {
+      const response = await execute({
+        query: query.value,
+        variables: parseJSON('Variables', variables.value),
+      });
+
+      result.textContent = JSON.stringify(response, null, 2);
+    } catch (err) {
+      result.classList.add('error');
+      result.textContent = err.message;
+    } finally {
+      run.disabled = false;
+    }
+  }
+
+  function typeName(type) {
+    switch (type.kind) {
+      case 'NON_NULL':
+        return typeName(type.ofType) + '!';
+      case 'LIST':
+        return '[' + typeName(type.ofType) + ']';
+      default:
+        return type.name;
+    }
+  }
+
+  function renderFields(title, root) {
+    if (!root) {
+      return;
+    }
+
+    const heading = document.createElement('h2');
+    heading.textContent = title;
+    schema.appendChild(heading);
+
+    const list = document.createElement('ul');
+
+    root.fields.forEach(function (field) {
+      const args = field.args.map(function (arg) {
+        return arg.name + ': ' + typeName(arg.type);
+      });
+
+      const item = document.createElement('li');
+      item.textContent = field.name + '(' + args.join(', ') + '): ' + typeName(field.type);
+      item.title = field.description || '';
+      item.addEventListener('click', function () {
+        query.setRangeText(field.name, query.selectionStart, query.selectionEnd, 'end');
+        query.focus();
+      });
+
+      list.appendChild(item);
+    });
+
+    schema.appendChild(list);
+  }
+
+  async function loadSchema() {
+    try {
+      const response = await execute({query: introspectionQuery});
+      if (response.errors) {
+        throw new Error(response.errors.map(function (err) { return err.message; }).join('\n'));
+      }
+
+      schema.textContent = '';
+      renderFields('Queries', response.data.__schema.queryType);
+      renderFields('Mutations', response.data.__schema.mutationType);
+    } catch (err) {
+      schema.classList.add('error');
+      schema.textContent = 'Could not load the schema: ' + err.message;
+    }
+  }
+
+  document.getElementById('endpoint').textContent = endpoint;
+  run.addEventListener('click', runQuery);
+  document.addEventListener('keydown', function (event) {
+    if ((event.ctrlKey || event.metaKey) && event.key === 'Enter') {
+      event.preventDefault();
+      runQuery();
+    }
+  });
+
+  load();
+  loadSchema();
+})();
@@ -0,0 +1,151 @@
+package public_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/adapters/inbound/http/handlers/public"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/adapters/inbound/http/middleware"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/domain/model"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/mocks"
+	"github.com/stretchr/testify/suite"
+)
+
+type BareResponseTestSuite struct {
+	suite.Suite
+}
+
+func TestBareResponseTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(BareResponseTestSuite))
+}
+
+func (s *BareResponseTestSuite) newDevicesService() (*mocks.FakeDevicesService, model.DeviceID) {
+	id := model.NewDeviceID()
+	device := &model.Device{
+		ID:        id,
+		Name:      "Test Device",
+		Brand:     "Test Brand",
+		State:     model.StateAvailable,
+		CreatedAt: time.Now().UTC(),
+		UpdatedAt: time.Now().UTC(),
+	}
+
+	deviceSvc := &mocks.FakeDevicesService{}
+	deviceSvc.GetDeviceReturns(device, nil)
+	deviceSvc.ListDevicesReturns(&model.DeviceList{
+		Devices:    []*model.Device{device},
+		Pagination: model.Pagination{Page: 1, Size: 10, TotalItems: 1, TotalPages: 1},
+		Filters:    model.DeviceFilter{Page: 1, Size: 10},
+	}, nil)
+
+	return deviceSvc, id
+}
+
+// serve runs the request through BareResponseMiddleware in front of the handler.
+func (s *BareResponseTestSuite) serve(
+	deviceSvc *mocks.FakeDevicesService,
+	req *http.Request,
+	serve func(handler *public.DeviceHandler, w http.ResponseWriter, r *http.Request),
+	opts ...public.DeviceHandlerOption,
+) map[string]any {
+	handler := public.NewDeviceHandler(newTestApp(deviceSvc, newDefaultHealthChecker()), opts...)
+
+	rec := httptest.NewRecorder()
+	middleware.BareResponseMiddleware()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		serve(handler, w, r)
+	})).ServeHTTP(rec, withRequestContext(req))
+
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var body map[string]any
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &body))
+
+	return body
+}
+
+func (s *BareResponseTestSuite) TestGetDevice() {
+	s.T().Parallel()
+
+	cases := []struct {
+		name         string
+		query        string
+		opts         []public.DeviceHandlerOption
+		expectedBare bool
+	}{
+		{
+			name: "enveloped by default",
+		},
+		{
+			name:  "bare mode is disabled by default",
+			query: "?envelope=false",
+		},
+		{
+			name:  "allowed but not requested",
+			opts:  []public.DeviceHandlerOption{public.WithBareResponseAllowed(true)},
+			query: "?envelope=true",
+		},
+		{
+			name:  "allowed with an invalid value",
+			opts:  []public.DeviceHandlerOption{public.WithBareResponseAllowed(true)},
+			query: "?envelope=nope",
+		},
+		{
+			name:         "allowed and requested",
+			opts:         []public.DeviceHandlerOption{public.WithBareResponseAllowed(true)},
+			query:        "?envelope=false",
+			expectedBare: true,
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			deviceSvc, id := s.newDevicesService()
+			req := httptest.NewRequest(http.MethodGet, "/v1/devices/"+id.String()+tc.query, nil)
+
+			body := s.serve(deviceSvc, req, func(handler *public.DeviceHandler, w http.ResponseWriter, r *http.Request) {
+				handler.GetDevice(w, r, id.UUID, public.GetDeviceParams{})
+			}, tc.opts...)
+
+			if tc.expectedBare {
+				s.Require().Equal(id.String(), body["id"])
+				s.Require().Equal("available", body["state"])
+				s.Require().NotContains(body, "data")
+				s.Require().NotContains(body, "meta")
+
+				return
+			}
+
+			s.Require().Contains(body, "meta")
+			s.Require().NotContains(body, "id")
+
+			data, ok := body["data"].(map[string]any)
+			s.Require().True(ok)
+			s.Require().Equal(id.String(), data["id"])
+		})
+	}
+}
+
+func (s *BareResponseTestSuite) TestListDevices() {
+	s.T().Parallel()
+
+	deviceSvc, id := s.newDevicesService()
+	req := httptest.NewRequest(http.MethodGet, "/v1/devices?envelope=false", nil)
+
+	handler := public.NewDeviceHandler(newTestApp(deviceSvc, newDefaultHealthChecker()), public.WithBareResponseAllowed(true))
+
+	rec := httptest.NewRecorder()
+	middleware.BareResponseMiddleware()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handler.ListDevices(w, r, public.ListDevicesParams{})
+	})).ServeHTTP(rec, withRequestContext(req))
+
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var devices []map[string]any
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &devices))
+	s.Require().Len(devices, 1)
+	s.Require().Equal(id.String(), devices[0]["id"])
+}
@@ -0,0 +1,126 @@
+package public
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/adapters/inbound/http/handlers/shared"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/domain/model"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/usecases/commands"
+)
+
+const (
+	codePayloadTooLarge = "PAYLOAD_TOO_LARGE"
+
+	msgBulkEmpty = "at least one device is required"
+)
+
+// bulkCreateResult is the outcome of one item of a bulk create request: the created
+// device, or the status and error the item would have had as a single create request.
+type bulkCreateResult struct {
+	Index   int            `json:"index"`
+	Status  int            `json:"status"`
+	Device  any            `json:"device,omitempty"`
+	Code    string         `json:"code,omitempty"`
+	Message string         `json:"message,omitempty"`
+	Details *[]ErrorDetail `json:"details,omitempty"`
+}
+
+// BulkCreateDevices creates every device of the request body in order and answers
+// 207 Multi-Status with the outcome of each item. A failing item does not stop the
+// ones after it; only a malformed, empty or oversized batch fails as a whole.
+func (h *DeviceHandler) BulkCreateDevices(w http.ResponseWriter, r *http.Request, _ BulkCreateDevicesParams) {
+	var items []json.RawMessage
+	if err := json.NewDecoder(r.Body).Decode(&items); err != nil {
+		writeError(w, http.StatusBadRequest, codeInvalidJSON, msgInvalidRequestBody)
+
+		return
+	}
+
+	if len(items) == 0 {
+		writeError(w, http.StatusBadRequest, codeValidationError, msgBulkEmpty)
+
+		return
+	}
+
+	if h.bulkMaxItems > 0 && uint(len(items)) > h.bulkMaxItems {
+		writeError(w, http.StatusRequestEntityTooLarge, codePayloadTooLarge,
+			fmt.Sprintf("a bulk request accepts at most %d devices", h.bulkMaxItems))
+
+		return
+	}
+
+	results := make([]bulkCreateResult, 0, len(items))
+	for index, item := range items {
+		results = append(results, h.createBulkItem(r.Context(), index, item))
+	}
+
+	response := shared.EnvelopedResponse{
+		Data: results,
+		Meta: shared.NewMeta(r),
+	}
+
+	h.writeEnvelopedResponse(w, r, http.StatusMultiStatus, response)
+}
+
+func (h *DeviceHandler) createBulkItem(ctx context.Context, index int, item json.RawMessage) bulkCreateResult {
+	var req CreateDevice
+	if err := json.Unmarshal(item, &req); err != nil {
+		return bulkCreateResult{
+			Index:   index,
+			Status:  http.StatusBadRequest,
+			Code:    codeInvalidJSON,
+			Message: msgInvalidDevice,
+		}
+	}
+
+	state := model.StateAvailable
+	if req.State != nil {
+		state = model.State(*req.State)
+	}
+
+	device, err := h.app.Commands.CreateDevice.Handle(ctx, commands.CreateDeviceCommand{
+		Name:  req.Name,
+		Brand: req.Brand,
+		State: state,
+	})
+	if err != nil {
+		return bulkCreateError(index, err)
+	}
+
+	return bulkCreateResult{Index: index, Status: http.StatusCreated, Device: h.presentDevice(ctx, toDeviceData(device))}
+}
+
+func bulkCreateError(index int, err error) bulkCreateResult {
+	var validationErrs *model.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		details := toErrorDetails(validationErrs)
+
+		return bulkCreateResult{
+			Index:   index,
+			Status:  http.StatusBadRequest,
+			Code:    codeValidationError,
+			Message: msgInvalidDevice,
+			Details: &details,
+		}
+	}
+
+	if domainErr, ok := model.IsDomainError(err); ok {
+		return bulkCreateResult{
+			Index:   index,
+			Status:  domainErr.HTTPStatus,
+			Code:    domainErr.Code,
+			Message: domainErr.Error(),
+		}
+	}
+
+	return bulkCreateResult{
+		Index:   index,
+		Status:  http.StatusInternalServerError,
+		Code:    codeInternalError,
+		Message: err.Error(),
+	}
+}
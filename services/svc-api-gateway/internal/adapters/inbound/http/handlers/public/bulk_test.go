@@ -0,0 +1,204 @@
+package public_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/adapters/inbound/http/handlers/public"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/domain/model"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/mocks"
+	"github.com/stretchr/testify/suite"
+)
+
+type BulkCreateDevicesTestSuite struct {
+	suite.Suite
+}
+
+func TestBulkCreateDevicesTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(BulkCreateDevicesTestSuite))
+}
+
+type bulkCreateResponse struct {
+	Data []struct {
+		Index  int `json:"index"`
+		Status int `json:"status"`
+		Device *struct {
+			Name  string `json:"name"`
+			Brand string `json:"brand"`
+			State string `json:"state"`
+		} `json:"device"`
+		Code    string               `json:"code"`
+		Details []public.ErrorDetail `json:"details"`
+	} `json:"data"`
+}
+
+// newBulkDevicesService creates devices from their arguments, except those named
+// "duplicate" or "broken", which fail as already existing or with an internal error.
+func newBulkDevicesService() *mocks.FakeDevicesService {
+	deviceSvc := &mocks.FakeDevicesService{}
+	deviceSvc.CreateDeviceCalls(func(_ context.Context, name, brand string, state model.State) (*model.Device, error) {
+		switch name {
+		case "duplicate":
+			return nil, model.ErrDeviceAlreadyExists
+		case "broken":
+			return nil, errors.New("connection reset")
+		default:
+			return model.NewDevice(name, brand, state), nil
+		}
+	})
+
+	return deviceSvc
+}
+
+func (s *BulkCreateDevicesTestSuite) bulkCreate(
+	deviceSvc *mocks.FakeDevicesService,
+	body string,
+	opts ...public.DeviceHandlerOption,
+) *httptest.ResponseRecorder {
+	handler := public.NewDeviceHandler(newTestApp(deviceSvc, newDefaultHealthChecker()), opts...)
+
+	req := withRequestContext(httptest.NewRequest(http.MethodPost, "/v1/devices/bulk", strings.NewReader(body)))
+	rec := httptest.NewRecorder()
+
+	handler.BulkCreateDevices(rec, req, public.BulkCreateDevicesParams{})
+
+	return rec
+}
+
+func (s *BulkCreateDevicesTestSuite) decode(rec *httptest.ResponseRecorder) bulkCreateResponse {
+	s.Require().Equal(http.StatusMultiStatus, rec.Code)
+
+	var response bulkCreateResponse
+	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&response))
+
+	return response
+}
+
+func (s *BulkCreateDevicesTestSuite) TestAllSucceed() {
+	s.T().Parallel()
+
+	deviceSvc := newBulkDevicesService()
+
+	rec := s.bulkCreate(deviceSvc, `[
+		{"name": "iPhone 15 Pro", "brand": "Apple"},
+		{"name": "Galaxy S24", "brand": "Samsung", "state": "inactive"}
+	]`)
+
+	response := s.decode(rec)
+	s.Require().Len(response.Data, 2)
+	s.Require().Equal(2, deviceSvc.CreateDeviceCallCount())
+
+	for index, item := range response.Data {
+		s.Require().Equal(index, item.Index)
+		s.Require().Equal(http.StatusCreated, item.Status)
+		s.Require().NotNil(item.Device)
+		s.Require().Empty(item.Code)
+	}
+
+	s.Require().Equal("Apple", response.Data[0].Device.Brand)
+	s.Require().Equal("available", response.Data[0].Device.State, "state defaults to available")
+	s.Require().Equal("inactive", response.Data[1].Device.State)
+}
+
+func (s *BulkCreateDevicesTestSuite) TestPartialFailure() {
+	s.T().Parallel()
+
+	deviceSvc := newBulkDevicesService()
+
+	rec := s.bulkCreate(deviceSvc, `[
+		{"name": "iPhone 15 Pro", "brand": "Apple"},
+		{"name": "duplicate", "brand": "Apple"},
+		{"name": "", "brand": "Apple"},
+		{"name": 42},
+		{"name": "Pixel 8", "brand": "Google"}
+	]`)
+
+	response := s.decode(rec)
+	s.Require().Len(response.Data, 5)
+
+	expected := []struct {
+		status int
+		code   string
+	}{
+		{status: http.StatusCreated},
+		{status: http.StatusConflict, code: "CONFLICT"},
+		{status: http.StatusBadRequest, code: "VALIDATION_ERROR"},
+		{status: http.StatusBadRequest, code: "INVALID_JSON"},
+		{status: http.StatusCreated},
+	}
+
+	for index, item := range response.Data {
+		s.Require().Equal(index, item.Index)
+		s.Require().Equal(expected[index].status, item.Status, "item %d", index)
+		s.Require().Equal(expected[index].code, item.Code, "item %d", index)
+	}
+
+	s.Require().Equal("name", response.Data[2].Details[0].Field)
+	s.Require().Equal(3, deviceSvc.CreateDeviceCallCount(), "invalid items never reach the service")
+}
+
+func (s *BulkCreateDevicesTestSuite) TestAllFail() {
+	s.T().Parallel()
+
+	rec := s.bulkCreate(newBulkDevicesService(), `[
+		{"name": "duplicate", "brand": "Apple"},
+		{"name": "broken", "brand": "Apple"}
+	]`)
+
+	response := s.decode(rec)
+	s.Require().Len(response.Data, 2)
+	s.Require().Equal(http.StatusConflict, response.Data[0].Status)
+	s.Require().Equal(http.StatusInternalServerError, response.Data[1].Status)
+	s.Require().Equal("INTERNAL_ERROR", response.Data[1].Code)
+}
+
+func (s *BulkCreateDevicesTestSuite) TestRejectedBatches() {
+	s.T().Parallel()
+
+	cases := []struct {
+		name           string
+		body           string
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name:           "empty array",
+			body:           `[]`,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_ERROR",
+		},
+		{
+			name:           "not an array",
+			body:           `{"name": "iPhone 15 Pro", "brand": "Apple"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "INVALID_JSON",
+		},
+		{
+			name:           "more items than allowed",
+			body:           `[{"name": "a", "brand": "b"}, {"name": "c", "brand": "d"}, {"name": "e", "brand": "f"}]`,
+			expectedStatus: http.StatusRequestEntityTooLarge,
+			expectedCode:   "PAYLOAD_TOO_LARGE",
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			deviceSvc := newBulkDevicesService()
+
+			rec := s.bulkCreate(deviceSvc, tc.body, public.WithBulkMaxItems(2))
+
+			s.Require().Equal(tc.expectedStatus, rec.Code)
+
+			var response public.Error
+			s.Require().NoError(json.NewDecoder(rec.Body).Decode(&response))
+			s.Require().Equal(tc.expectedCode, response.Code)
+			s.Require().Zero(deviceSvc.CreateDeviceCallCount())
+		})
+	}
+}
@@ -0,0 +1,167 @@
+package public
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/adapters/inbound/http/handlers/shared"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/domain/model"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/usecases/queries"
+)
+
+const (
+	// exportPageSize is the number of devices fetched per cursor page while exporting.
+	exportPageSize = 100
+
+	textCSV           = "text/csv"
+	applicationNDJSON = "application/x-ndjson"
+)
+
+var exportCSVHeader = []string{"id", "name", "brand", "state", "createdAt", "updatedAt"}
+
+// csvFormulaPrefixes are the leading characters that make a spreadsheet read a cell as a
+// formula rather than as text.
+const csvFormulaPrefixes = "=+-@\t\r"
+
+type (
+	// deviceExportWriter serializes exported devices in a single output format.
+	deviceExportWriter interface {
+		WriteDevices(devices []*model.Device) error
+	}
+
+	csvExportWriter struct {
+		writer *csv.Writer
+	}
+
+	ndjsonExportWriter struct {
+		encoder *json.Encoder
+	}
+)
+
+// ExportDevices streams every device matching the list filters as CSV or NDJSON.
+// Pages are fetched through cursor pagination and flushed to the client one at a time,
+// so the export never holds more than a single page in memory. Each page gets a fresh
+// write deadline, so the export may outlast the server's write timeout.
+func (h *DeviceHandler) ExportDevices(w http.ResponseWriter, r *http.Request, params ExportDevicesParams) {
+	format := ExportDevicesParamsFormatCsv
+	if params.Format != nil {
+		format = *params.Format
+	}
+
+	filter, err := buildDeviceFilter(DeviceListFilterInput{
+		Q:     params.Q,
+		Brand: params.Brand,
+		State: params.State,
+		Sort:  params.Sort,
+	})
+	if err != nil {
+		writeValidationError(w, err, msgInvalidListFilter)
+
+		return
+	}
+
+	filter.Size = exportPageSize
+
+	// Fetch the first page before committing to a 200 so upstream failures still
+	// produce a regular error response.
+	result, err := h.app.Queries.ListDevices.Execute(r.Context(), queries.ListDevicesQuery{Filter: filter})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, codeInternalError, err.Error())
+
+		return
+	}
+
+	var exporter deviceExportWriter
+
+	switch format {
+	case ExportDevicesParamsFormatNdjson:
+		w.Header().Set(contentTypeHeader, applicationNDJSON)
+		exporter = &ndjsonExportWriter{encoder: json.NewEncoder(w)}
+	default:
+		w.Header().Set(contentTypeHeader, textCSV)
+		exporter = newCSVExportWriter(w)
+	}
+
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="devices.%s"`, format))
+	w.Header().Set(shared.HeaderCacheControl, "no-store")
+	w.WriteHeader(http.StatusOK)
+
+	controller := http.NewResponseController(w)
+
+	for {
+		if h.exportWriteTimeout > 0 {
+			_ = controller.SetWriteDeadline(time.Now().Add(h.exportWriteTimeout))
+		}
+
+		if err := exporter.WriteDevices(result.Devices); err != nil {
+			return
+		}
+
+		_ = controller.Flush()
+
+		nextCursor := result.Pagination.NextCursor
+		if nextCursor == "" || nextCursor == filter.Cursor {
+			return
+		}
+
+		filter.Cursor = nextCursor
+
+		result, err = h.app.Queries.ListDevices.Execute(r.Context(), queries.ListDevicesQuery{Filter: filter})
+		if err != nil {
+			// Headers are already on the wire, so the truncated body is all the client gets.
+			return
+		}
+	}
+}
+
+func newCSVExportWriter(w http.ResponseWriter) *csvExportWriter {
+	writer := csv.NewWriter(w)
+	_ = writer.Write(exportCSVHeader)
+
+	return &csvExportWriter{writer: writer}
+}
+
+func (e *csvExportWriter) WriteDevices(devices []*model.Device) error {
+	for _, device := range devices {
+		record := []string{
+			device.ID.String(),
+			csvText(device.Name),
+			csvText(device.Brand),
+			string(device.State),
+			device.CreatedAt.Format(time.RFC3339),
+			device.UpdatedAt.Format(time.RFC3339),
+		}
+
+		if err := e.writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	e.writer.Flush()
+
+	return e.writer.Error()
+}
+
+// csvText escapes a user-provided value against formula injection by prefixing a
+// value a spreadsheet would evaluate with a single quote, which makes it read as text.
+func csvText(value string) string {
+	if value != "" && strings.ContainsRune(csvFormulaPrefixes, rune(value[0])) {
+		return "'" + value
+	}
+
+	return value
+}
+
+func (e *ndjsonExportWriter) WriteDevices(devices []*model.Device) error {
+	for _, device := range devices {
+		if err := e.encoder.Encode(toDeviceData(device)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
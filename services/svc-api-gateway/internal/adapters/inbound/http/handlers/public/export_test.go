@@ -0,0 +1,229 @@
+package public_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/adapters/inbound/http/handlers/public"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/domain/model"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/mocks"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/suite"
+)
+
+type ExportDevicesTestSuite struct {
+	suite.Suite
+}
+
+func TestExportDevicesTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(ExportDevicesTestSuite))
+}
+
+func newExportDevice(name, brand string, state model.State) *model.Device {
+	now := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+
+	return &model.Device{
+		ID:        model.NewDeviceID(),
+		Name:      name,
+		Brand:     brand,
+		State:     state,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// newPagedDevicesService returns a fake device service serving two cursor-linked pages.
+func newPagedDevicesService() (*mocks.FakeDevicesService, []*model.Device) {
+	devices := []*model.Device{
+		newExportDevice("iPhone 15", "Apple", model.StateAvailable),
+		newExportDevice("MacBook, Pro", "Apple", model.StateInUse),
+		newExportDevice("iPad", "Apple", model.StateAvailable),
+	}
+
+	deviceSvc := &mocks.FakeDevicesService{}
+	deviceSvc.ListDevicesReturnsOnCall(0, &model.DeviceList{
+		Devices:    devices[:2],
+		Pagination: model.Pagination{Page: 1, Size: 100, HasNext: true, NextCursor: "cursor-1"},
+	}, nil)
+	deviceSvc.ListDevicesReturnsOnCall(1, &model.DeviceList{
+		Devices:    devices[2:],
+		Pagination: model.Pagination{Page: 1, Size: 100},
+	}, nil)
+
+	return deviceSvc, devices
+}
+
+func (s *ExportDevicesTestSuite) export(deviceSvc *mocks.FakeDevicesService, params public.ExportDevicesParams) *httptest.ResponseRecorder {
+	handler := public.NewDeviceHandler(newTestApp(deviceSvc, newDefaultHealthChecker()))
+
+	req := withRequestContext(httptest.NewRequest(http.MethodGet, "/v1/devices/export", nil))
+	rec := httptest.NewRecorder()
+
+	handler.ExportDevices(rec, req, params)
+
+	return rec
+}
+
+func (s *ExportDevicesTestSuite) TestCSV() {
+	s.T().Parallel()
+
+	deviceSvc, devices := newPagedDevicesService()
+
+	rec := s.export(deviceSvc, public.ExportDevicesParams{})
+
+	s.Require().Equal(http.StatusOK, rec.Code)
+	s.Require().Equal("text/csv", rec.Header().Get("Content-Type"))
+	s.Require().Equal(`attachment; filename="devices.csv"`, rec.Header().Get("Content-Disposition"))
+	s.Require().True(rec.Flushed)
+
+	records, err := csv.NewReader(rec.Body).ReadAll()
+	s.Require().NoError(err)
+	s.Require().Len(records, len(devices)+1)
+	s.Require().Equal([]string{"id", "name", "brand", "state", "createdAt", "updatedAt"}, records[0])
+
+	for index, device := range devices {
+		s.Require().Equal([]string{
+			device.ID.String(),
+			device.Name,
+			device.Brand,
+			string(device.State),
+			"2024-01-15T10:30:00Z",
+			"2024-01-15T10:30:00Z",
+		}, records[index+1])
+	}
+
+	s.Require().Equal(2, deviceSvc.ListDevicesCallCount())
+	_, secondPage := deviceSvc.ListDevicesArgsForCall(1)
+	s.Require().Equal("cursor-1", secondPage.Cursor)
+}
+
+func (s *ExportDevicesTestSuite) TestCSVEscapesFormulas() {
+	s.T().Parallel()
+
+	cases := []struct {
+		value    string
+		expected string
+	}{
+		{value: "=HYPERLINK(\"http://evil\")", expected: "'=HYPERLINK(\"http://evil\")"},
+		{value: "+1+2", expected: "'+1+2"},
+		{value: "-2+3", expected: "'-2+3"},
+		{value: "@SUM(A1)", expected: "'@SUM(A1)"},
+		{value: "\tcmd", expected: "'\tcmd"},
+		{value: "\rcmd", expected: "'\rcmd"},
+		{value: "iPhone 1+1", expected: "iPhone 1+1"},
+	}
+
+	devices := make([]*model.Device, 0, len(cases))
+	for _, tc := range cases {
+		devices = append(devices, newExportDevice(tc.value, tc.value, model.StateAvailable))
+	}
+
+	deviceSvc := &mocks.FakeDevicesService{}
+	deviceSvc.ListDevicesReturns(&model.DeviceList{Devices: devices}, nil)
+
+	rec := s.export(deviceSvc, public.ExportDevicesParams{})
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	records, err := csv.NewReader(rec.Body).ReadAll()
+	s.Require().NoError(err)
+	s.Require().Len(records, len(cases)+1)
+
+	for index, tc := range cases {
+		s.Require().Equal(tc.expected, records[index+1][1], "name %q", tc.value)
+		s.Require().Equal(tc.expected, records[index+1][2], "brand %q", tc.value)
+	}
+}
+
+func (s *ExportDevicesTestSuite) TestNDJSON() {
+	s.T().Parallel()
+
+	deviceSvc, devices := newPagedDevicesService()
+	format := public.ExportDevicesParamsFormatNdjson
+
+	rec := s.export(deviceSvc, public.ExportDevicesParams{Format: &format})
+
+	s.Require().Equal(http.StatusOK, rec.Code)
+	s.Require().Equal("application/x-ndjson", rec.Header().Get("Content-Type"))
+	s.Require().Equal(`attachment; filename="devices.ndjson"`, rec.Header().Get("Content-Disposition"))
+
+	lines := bytes.Split(bytes.TrimSpace(rec.Body.Bytes()), []byte("\n"))
+	s.Require().Len(lines, len(devices))
+
+	for index, line := range lines {
+		var entry map[string]any
+		s.Require().NoError(json.Unmarshal(line, &entry))
+		s.Require().Equal(devices[index].ID.String(), entry["id"])
+		s.Require().Equal(devices[index].Name, entry["name"])
+		s.Require().Equal(string(devices[index].State), entry["state"])
+	}
+}
+
+func (s *ExportDevicesTestSuite) TestFiltersArePassedToEveryPage() {
+	s.T().Parallel()
+
+	deviceSvc, _ := newPagedDevicesService()
+	q := public.SearchParam("phone")
+	brands := public.BrandFilterParam{"Apple"}
+	states := public.StateFilterParam{"available"}
+	sort := public.SortParam{"name"}
+
+	rec := s.export(deviceSvc, public.ExportDevicesParams{
+		Q:     &q,
+		Brand: &brands,
+		State: &states,
+		Sort:  &sort,
+	})
+	s.Require().Equal(http.StatusOK, rec.Code)
+	s.Require().Equal(2, deviceSvc.ListDevicesCallCount())
+
+	for call := range deviceSvc.ListDevicesCallCount() {
+		_, filter := deviceSvc.ListDevicesArgsForCall(call)
+		s.Require().Equal("phone", filter.Keyword)
+		s.Require().Equal([]string{"Apple"}, filter.Brands)
+		s.Require().Equal([]model.State{model.StateAvailable}, filter.States)
+		s.Require().Equal([]string{"name"}, filter.Sort)
+		s.Require().EqualValues(100, filter.Size)
+	}
+}
+
+func (s *ExportDevicesTestSuite) TestUpstreamErrorBeforeFirstPage() {
+	s.T().Parallel()
+
+	deviceSvc := &mocks.FakeDevicesService{}
+	deviceSvc.ListDevicesReturns(nil, errors.New("devices service unavailable"))
+
+	rec := s.export(deviceSvc, public.ExportDevicesParams{})
+
+	s.Require().Equal(http.StatusInternalServerError, rec.Code)
+	s.Require().Empty(rec.Header().Get("Content-Disposition"))
+}
+
+func (s *ExportDevicesTestSuite) TestRouteTakesPrecedenceOverDeviceID() {
+	s.T().Parallel()
+
+	deviceSvc, _ := newPagedDevicesService()
+	router := public.HandlerWithOptions(
+		public.NewDeviceHandler(newTestApp(deviceSvc, newDefaultHealthChecker())),
+		public.ChiServerOptions{BaseRouter: chi.NewRouter(), BaseURL: "/v1"},
+	)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/devices/export?format=ndjson&brand=Apple", nil)
+	req.Header.Set("Authorization", "Bearer v4.public.token")
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	s.Require().Equal(http.StatusOK, rec.Code)
+	s.Require().Equal(`attachment; filename="devices.ndjson"`, rec.Header().Get("Content-Disposition"))
+	s.Require().Zero(deviceSvc.GetDeviceCallCount())
+
+	_, filter := deviceSvc.ListDevicesArgsForCall(0)
+	s.Require().Equal([]string{"Apple"}, filter.Brands)
+}
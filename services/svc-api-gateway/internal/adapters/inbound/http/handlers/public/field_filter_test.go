@@ -0,0 +1,170 @@
+package public_test
+
+import (
+	"encoding/json"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/adapters/inbound/http/handlers/public"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/adapters/inbound/http/middleware"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/domain/model"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/mocks"
+	"github.com/stretchr/testify/suite"
+)
+
+var allDeviceFields = []string{"brand", "createdAt", "id", "links", "name", "state", "updatedAt"}
+
+type FieldFilterTestSuite struct {
+	suite.Suite
+}
+
+func TestFieldFilterTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(FieldFilterTestSuite))
+}
+
+func (s *FieldFilterTestSuite) newDevicesService() (*mocks.FakeDevicesService, model.DeviceID) {
+	id := model.NewDeviceID()
+	device := &model.Device{
+		ID:        id,
+		Name:      "Test Device",
+		Brand:     "Test Brand",
+		State:     model.StateInUse,
+		CreatedAt: time.Now().UTC(),
+		UpdatedAt: time.Now().UTC(),
+	}
+
+	deviceSvc := &mocks.FakeDevicesService{}
+	deviceSvc.GetDeviceReturns(device, nil)
+	deviceSvc.ListDevicesReturns(&model.DeviceList{
+		Devices:    []*model.Device{device},
+		Pagination: model.Pagination{Page: 1, Size: 10, TotalItems: 1, TotalPages: 1},
+		Filters:    model.DeviceFilter{Page: 1, Size: 10},
+	}, nil)
+
+	return deviceSvc, id
+}
+
+// serve runs the request through the response version and field filter middlewares in
+// front of the handler, as the router does.
+func (s *FieldFilterTestSuite) serve(
+	deviceSvc *mocks.FakeDevicesService,
+	req *http.Request,
+	serve func(handler *public.DeviceHandler, w http.ResponseWriter, r *http.Request),
+) *httptest.ResponseRecorder {
+	handler := public.NewDeviceHandler(newTestApp(deviceSvc, newDefaultHealthChecker()))
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		serve(handler, w, r)
+	})
+
+	rec := httptest.NewRecorder()
+	middleware.ResponseVersionMiddleware()(middleware.FieldFilterMiddleware()(next)).ServeHTTP(rec, withRequestContext(req))
+
+	return rec
+}
+
+func (s *FieldFilterTestSuite) TestGetDevice_Fields() {
+	s.T().Parallel()
+
+	cases := []struct {
+		name           string
+		query          string
+		accept         string
+		expectedFields []string
+	}{
+		{
+			name:           "no fields parameter returns every field",
+			expectedFields: allDeviceFields,
+		},
+		{
+			name:           "every field requested",
+			query:          "?fields=id,name,brand,state,createdAt,updatedAt,links",
+			expectedFields: allDeviceFields,
+		},
+		{
+			name:           "id and name only",
+			query:          "?fields=id,name",
+			expectedFields: []string{"id", "name"},
+		},
+		{
+			name:           "id is always returned",
+			query:          "?fields=state",
+			expectedFields: []string{"id", "state"},
+		},
+		{
+			name:           "state selects deviceState in v2",
+			query:          "?fields=state",
+			accept:         "application/vnd.devices.v2+json",
+			expectedFields: []string{"deletedAt", "deviceState", "id"},
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			deviceSvc, id := s.newDevicesService()
+
+			req := httptest.NewRequest(http.MethodGet, "/v1/devices/"+id.String()+tc.query, nil)
+			if tc.accept != "" {
+				req.Header.Set("Accept", tc.accept)
+			}
+
+			rec := s.serve(deviceSvc, req, func(handler *public.DeviceHandler, w http.ResponseWriter, r *http.Request) {
+				handler.GetDevice(w, r, id.UUID, public.GetDeviceParams{})
+			})
+
+			s.Require().Equal(http.StatusOK, rec.Code)
+
+			data := decodeDeviceData(rec)
+			s.Require().Equal(tc.expectedFields, slices.Sorted(maps.Keys(data)))
+			s.Require().Equal(id.String(), data["id"])
+		})
+	}
+}
+
+func (s *FieldFilterTestSuite) TestListDevices_Fields() {
+	s.T().Parallel()
+
+	deviceSvc, id := s.newDevicesService()
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/devices?fields=id,name", nil)
+
+	rec := s.serve(deviceSvc, req, func(handler *public.DeviceHandler, w http.ResponseWriter, r *http.Request) {
+		handler.ListDevices(w, r, public.ListDevicesParams{})
+	})
+
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var response struct {
+		Data       []map[string]any `json:"data"`
+		Pagination map[string]any   `json:"pagination"`
+	}
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+
+	s.Require().Equal([]map[string]any{{"id": id.String(), "name": "Test Device"}}, response.Data)
+	s.Require().NotEmpty(response.Pagination)
+}
+
+func (s *FieldFilterTestSuite) TestUnknownField() {
+	s.T().Parallel()
+
+	deviceSvc, id := s.newDevicesService()
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/devices/"+id.String()+"?fields=id,serialNumber", nil)
+
+	rec := s.serve(deviceSvc, req, func(handler *public.DeviceHandler, w http.ResponseWriter, r *http.Request) {
+		handler.GetDevice(w, r, id.UUID, public.GetDeviceParams{})
+	})
+
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+	s.Require().Zero(deviceSvc.GetDeviceCallCount())
+
+	var response public.Error
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+	s.Require().Equal("INVALID_FIELDS", response.Code)
+	s.Require().Contains(response.Message, "serialNumber")
+}
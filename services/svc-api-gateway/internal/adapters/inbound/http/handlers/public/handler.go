@@ -1,14 +1,19 @@
 package public
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
+	"reflect"
 	"runtime"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/adapters/inbound/http/handlers/shared"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/adapters/inbound/http/middleware"
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/domain/model"
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/usecases"
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/usecases/commands"
@@ -20,17 +25,18 @@ const (
 	contentTypeHeader = "Content-Type"
 	applicationJSON   = "application/json"
 
-	codeNotFound      = "NOT_FOUND"
-	codeConflict      = "CONFLICT"
-	codeInternalError = "INTERNAL_ERROR"
-	codeInvalidID     = "INVALID_ID"
-	codeInvalidJSON   = "INVALID_JSON"
+	codeInternalError   = "INTERNAL_ERROR"
+	codeInvalidID       = "INVALID_ID"
+	codeInvalidJSON     = "INVALID_JSON"
+	codeInvalidState    = "INVALID_STATE"
+	codeValidationError = "VALIDATION_ERROR"
 
-	msgDeviceNotFound     = "device not found"
 	msgInvalidDeviceID    = "invalid device ID"
 	msgInvalidRequestBody = "invalid request body"
-	msgCannotUpdateInUse  = "cannot update name or brand of in-use device"
-	msgCannotDeleteInUse  = "cannot delete in-use device"
+	msgStateRequired      = "state is required"
+	msgInvalidState       = "invalid state"
+	msgInvalidListFilter  = "the list filter contains invalid parameters"
+	msgInvalidDevice      = "the device contains invalid fields"
 )
 
 type (
@@ -38,16 +44,31 @@ type (
 		Self *string `json:"self,omitempty"`
 	}
 
+	// deviceData is the v1 response shape. The field tag names the ?fields= entry
+	// selecting a field when it differs from the JSON name; "-" marks a field always sent.
 	deviceData struct {
 		Brand     string             `json:"brand"`
 		CreatedAt time.Time          `json:"createdAt"`
-		Id        openapi_types.UUID `json:"id"`
+		Id        openapi_types.UUID `json:"id" field:"-"`
 		Links     *deviceLinks       `json:"links,omitempty"`
 		Name      string             `json:"name"`
 		State     string             `json:"state"`
 		UpdatedAt *time.Time         `json:"updatedAt,omitempty"`
 	}
 
+	// deviceDataV2 is the v2 response shape, which renames state to deviceState and
+	// always carries deletedAt.
+	deviceDataV2 struct {
+		Brand       string             `json:"brand"`
+		CreatedAt   time.Time          `json:"createdAt"`
+		DeletedAt   *time.Time         `json:"deletedAt" field:"-"`
+		DeviceState string             `json:"deviceState" field:"state"`
+		Id          openapi_types.UUID `json:"id" field:"-"`
+		Links       *deviceLinks       `json:"links,omitempty"`
+		Name        string             `json:"name"`
+		UpdatedAt   *time.Time         `json:"updatedAt,omitempty"`
+	}
+
 	// HTTPCacheConfig holds HTTP caching configuration for the handler.
 	HTTPCacheConfig struct {
 		Enabled              bool
@@ -58,9 +79,15 @@ type (
 	}
 
 	DeviceHandler struct {
-		app       *usecases.WebApplication
-		cacheConf HTTPCacheConfig
-		startTime time.Time
+		app          *usecases.WebApplication
+		cacheConf    HTTPCacheConfig
+		bulkMaxItems uint
+		// exportWriteTimeout is the write deadline granted to every exported page.
+		exportWriteTimeout time.Duration
+		responseVersion    string
+		// bareResponseAllowed lets clients drop the response envelope with ?envelope=false.
+		bareResponseAllowed bool
+		startTime           time.Time
 	}
 
 	// DeviceHandlerOption configures the DeviceHandler.
@@ -69,8 +96,9 @@ type (
 
 func NewDeviceHandler(app *usecases.WebApplication, opts ...DeviceHandlerOption) *DeviceHandler {
 	h := &DeviceHandler{
-		app:       app,
-		startTime: time.Now().UTC(),
+		app:             app,
+		responseVersion: middleware.ResponseVersionV1,
+		startTime:       time.Now().UTC(),
 	}
 
 	for _, opt := range opts {
@@ -87,6 +115,39 @@ func WithHTTPCacheConfig(cfg HTTPCacheConfig) DeviceHandlerOption {
 	}
 }
 
+// WithBulkMaxItems caps the number of devices in a bulk create request; 0 disables the cap.
+func WithBulkMaxItems(maxItems uint) DeviceHandlerOption {
+	return func(h *DeviceHandler) {
+		h.bulkMaxItems = maxItems
+	}
+}
+
+// WithExportWriteTimeout grants every page of a device export its own write deadline,
+// so a long export is not cut off by the server's write timeout; 0 keeps that timeout.
+func WithExportWriteTimeout(timeout time.Duration) DeviceHandlerOption {
+	return func(h *DeviceHandler) {
+		h.exportWriteTimeout = timeout
+	}
+}
+
+// WithResponseVersion sets the response shape served when the client does not negotiate
+// one through the Accept header. Unsupported versions are ignored, keeping v1.
+func WithResponseVersion(version string) DeviceHandlerOption {
+	return func(h *DeviceHandler) {
+		if slices.Contains(middleware.SupportedResponseVersions, version) {
+			h.responseVersion = version
+		}
+	}
+}
+
+// WithBareResponseAllowed lets clients request the response data without its envelope
+// through ?envelope=false. Bare responses are disabled by default.
+func WithBareResponseAllowed(allowed bool) DeviceHandlerOption {
+	return func(h *DeviceHandler) {
+		h.bareResponseAllowed = allowed
+	}
+}
+
 // setCacheControlHeaders sets Cache-Control and Vary headers for cacheable responses.
 func (h *DeviceHandler) setCacheControlHeaders(w http.ResponseWriter, isList bool) {
 	if !h.cacheConf.Enabled {
@@ -145,8 +206,9 @@ type DeviceListFilterInput struct {
 	Cursor *CursorParam
 }
 
-// buildDeviceFilter constructs a DeviceFilter from the common list/head parameters.
-func buildDeviceFilter(input DeviceListFilterInput) model.DeviceFilter {
+// buildDeviceFilter constructs a DeviceFilter from the common list/head parameters
+// and validates it, returning *model.ValidationErrors for out-of-range or unsupported values.
+func buildDeviceFilter(input DeviceListFilterInput) (model.DeviceFilter, error) {
 	filter := model.DefaultDeviceFilter()
 
 	if input.Q != nil && *input.Q != "" {
@@ -181,11 +243,15 @@ func buildDeviceFilter(input DeviceListFilterInput) model.DeviceFilter {
 		filter.Cursor = *input.Cursor
 	}
 
-	return filter
+	if err := filter.Validate(); err != nil {
+		return model.DeviceFilter{}, err
+	}
+
+	return filter, nil
 }
 
 func (h *DeviceHandler) ListDevices(w http.ResponseWriter, r *http.Request, params ListDevicesParams) {
-	filter := buildDeviceFilter(DeviceListFilterInput{
+	filter, err := buildDeviceFilter(DeviceListFilterInput{
 		Q:      params.Q,
 		Brand:  params.Brand,
 		State:  params.State,
@@ -194,8 +260,16 @@ func (h *DeviceHandler) ListDevices(w http.ResponseWriter, r *http.Request, para
 		Size:   params.Size,
 		Cursor: params.Cursor,
 	})
+	if err != nil {
+		writeValidationError(w, err, msgInvalidListFilter)
+
+		return
+	}
 
-	result, err := h.app.Queries.ListDevices.Execute(r.Context(), queries.ListDevicesQuery{Filter: filter})
+	result, err := h.app.Queries.ListDevices.Execute(r.Context(), queries.ListDevicesQuery{
+		Filter:      filter,
+		BypassCache: shared.IsCacheBypassRequested(r),
+	})
 	if err != nil {
 		writeError(w, http.StatusInternalServerError, codeInternalError, err.Error())
 
@@ -204,7 +278,7 @@ func (h *DeviceHandler) ListDevices(w http.ResponseWriter, r *http.Request, para
 
 	data, pagination := toDeviceListData(result)
 	response := shared.EnvelopedResponse{
-		Data:       data,
+		Data:       h.presentDevices(r.Context(), data),
 		Meta:       shared.NewMeta(r),
 		Pagination: pagination,
 	}
@@ -214,7 +288,12 @@ func (h *DeviceHandler) ListDevices(w http.ResponseWriter, r *http.Request, para
 
 	h.setCacheControlHeaders(w, true)
 	h.setCacheObservabilityHeaders(w, r, cacheKey)
-	writeJSONResponse(w, http.StatusOK, response)
+
+	if writeNotModified(w, r, generateListETag(result)) {
+		return
+	}
+
+	h.writeEnvelopedResponse(w, r, http.StatusOK, response)
 }
 
 // buildListCacheKey generates a cache key for list queries based on filter parameters.
@@ -224,7 +303,7 @@ func buildListCacheKey(filter model.DeviceFilter) string {
 }
 
 func (h *DeviceHandler) HeadDevices(w http.ResponseWriter, r *http.Request, params HeadDevicesParams) {
-	filter := buildDeviceFilter(DeviceListFilterInput{
+	filter, err := buildDeviceFilter(DeviceListFilterInput{
 		Q:      params.Q,
 		Brand:  params.Brand,
 		State:  params.State,
@@ -233,8 +312,16 @@ func (h *DeviceHandler) HeadDevices(w http.ResponseWriter, r *http.Request, para
 		Size:   params.Size,
 		Cursor: params.Cursor,
 	})
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+
+		return
+	}
 
-	result, err := h.app.Queries.ListDevices.Execute(r.Context(), queries.ListDevicesQuery{Filter: filter})
+	result, err := h.app.Queries.ListDevices.Execute(r.Context(), queries.ListDevicesQuery{
+		Filter:      filter,
+		BypassCache: shared.IsCacheBypassRequested(r),
+	})
 	if err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
 
@@ -271,7 +358,7 @@ func (h *DeviceHandler) CreateDevice(w http.ResponseWriter, r *http.Request, _ C
 
 	device, err := h.app.Commands.CreateDevice.Handle(r.Context(), cmd)
 	if err != nil {
-		writeError(w, http.StatusInternalServerError, codeInternalError, err.Error())
+		handleDeviceError(w, err)
 
 		return
 	}
@@ -279,11 +366,11 @@ func (h *DeviceHandler) CreateDevice(w http.ResponseWriter, r *http.Request, _ C
 	w.Header().Set("Location", fmt.Sprintf("/v1/devices/%s", device.ID.String()))
 
 	response := shared.EnvelopedResponse{
-		Data: toDeviceData(device),
+		Data: h.presentDevice(r.Context(), toDeviceData(device)),
 		Meta: shared.NewMeta(r),
 	}
 
-	writeJSONResponse(w, http.StatusCreated, response)
+	h.writeEnvelopedResponse(w, r, http.StatusCreated, response)
 }
 
 func (h *DeviceHandler) GetDevice(w http.ResponseWriter, r *http.Request, deviceId openapi_types.UUID, _ GetDeviceParams) {
@@ -294,21 +381,15 @@ func (h *DeviceHandler) GetDevice(w http.ResponseWriter, r *http.Request, device
 		return
 	}
 
-	device, err := h.app.Queries.GetDevice.Execute(r.Context(), queries.GetDeviceQuery{ID: id})
+	device, err := h.app.Queries.GetDeviceWithCache.Execute(r.Context(), queries.GetDeviceWithCacheQuery{ID: id})
 	if err != nil {
-		if errors.Is(err, model.ErrDeviceNotFound) {
-			writeError(w, http.StatusNotFound, codeNotFound, msgDeviceNotFound)
-
-			return
-		}
-
-		writeError(w, http.StatusInternalServerError, codeInternalError, err.Error())
+		handleDeviceError(w, err)
 
 		return
 	}
 
 	response := shared.EnvelopedResponse{
-		Data: toDeviceData(device),
+		Data: h.presentDevice(r.Context(), toDeviceData(device)),
 		Meta: shared.NewMeta(r),
 	}
 
@@ -318,7 +399,44 @@ func (h *DeviceHandler) GetDevice(w http.ResponseWriter, r *http.Request, device
 	h.setCacheControlHeaders(w, false)
 	h.setCacheObservabilityHeaders(w, r, cacheKey)
 	shared.SetLastModified(w, device.UpdatedAt)
-	writeJSONResponse(w, http.StatusOK, response)
+
+	if writeNotModified(w, r, generateETag(device)) {
+		return
+	}
+
+	h.writeEnvelopedResponse(w, r, http.StatusOK, response)
+}
+
+// generateETag derives a weak ETag from the device ID and its last modification time.
+func generateETag(device *model.Device) string {
+	return fmt.Sprintf("%s-%d", device.ID.String(), device.UpdatedAt.Unix())
+}
+
+// generateListETag derives a weak ETag for a list page from the total item count and
+// the modification times of the first and last device on the page.
+func generateListETag(list *model.DeviceList) string {
+	var first, last int64
+
+	if len(list.Devices) > 0 {
+		first = list.Devices[0].UpdatedAt.Unix()
+		last = list.Devices[len(list.Devices)-1].UpdatedAt.Unix()
+	}
+
+	return fmt.Sprintf("%d-%d-%d", list.Pagination.TotalItems, first, last)
+}
+
+// writeNotModified sets the weak ETag header and answers with 304 Not Modified when
+// the request's If-None-Match already holds it. It reports whether the response was written.
+func writeNotModified(w http.ResponseWriter, r *http.Request, etag string) bool {
+	shared.SetWeakETagHeader(w, etag)
+
+	if !shared.ETagMatches(r, etag) {
+		return false
+	}
+
+	w.WriteHeader(http.StatusNotModified)
+
+	return true
 }
 
 func (h *DeviceHandler) HeadDevice(w http.ResponseWriter, r *http.Request, deviceId openapi_types.UUID, _ HeadDeviceParams) {
@@ -329,10 +447,10 @@ func (h *DeviceHandler) HeadDevice(w http.ResponseWriter, r *http.Request, devic
 		return
 	}
 
-	_, err = h.app.Queries.GetDevice.Execute(r.Context(), queries.GetDeviceQuery{ID: id})
+	_, err = h.app.Queries.GetDeviceWithCache.Execute(r.Context(), queries.GetDeviceWithCacheQuery{ID: id})
 	if err != nil {
-		if errors.Is(err, model.ErrDeviceNotFound) {
-			w.WriteHeader(http.StatusNotFound)
+		if domainErr, ok := model.IsDomainError(err); ok {
+			w.WriteHeader(domainErr.HTTPStatus)
 
 			return
 		}
@@ -374,17 +492,17 @@ func (h *DeviceHandler) UpdateDevice(w http.ResponseWriter, r *http.Request, dev
 
 	device, err := h.app.Commands.UpdateDevice.Handle(r.Context(), cmd)
 	if err != nil {
-		handleDeviceUpdateError(w, err)
+		handleDeviceError(w, err)
 
 		return
 	}
 
 	response := shared.EnvelopedResponse{
-		Data: toDeviceData(device),
+		Data: h.presentDevice(r.Context(), toDeviceData(device)),
 		Meta: shared.NewMeta(r),
 	}
 
-	writeJSONResponse(w, http.StatusOK, response)
+	h.writeEnvelopedResponse(w, r, http.StatusOK, response)
 }
 
 func (h *DeviceHandler) PatchDevice(w http.ResponseWriter, r *http.Request, deviceId openapi_types.UUID, _ PatchDeviceParams) {
@@ -420,20 +538,22 @@ func (h *DeviceHandler) PatchDevice(w http.ResponseWriter, r *http.Request, devi
 
 	device, err := h.app.Commands.PatchDevice.Handle(r.Context(), cmd)
 	if err != nil {
-		handleDeviceUpdateError(w, err)
+		handleDeviceError(w, err)
 
 		return
 	}
 
 	response := shared.EnvelopedResponse{
-		Data: toDeviceData(device),
+		Data: h.presentDevice(r.Context(), toDeviceData(device)),
 		Meta: shared.NewMeta(r),
 	}
 
-	writeJSONResponse(w, http.StatusOK, response)
+	h.writeEnvelopedResponse(w, r, http.StatusOK, response)
 }
 
-func (h *DeviceHandler) DeleteDevice(w http.ResponseWriter, r *http.Request, deviceId openapi_types.UUID, _ DeleteDeviceParams) {
+// TransitionDeviceState changes only the state of a device. Unlike PatchDevice it
+// rejects transitions the device state machine does not allow with 409 Conflict.
+func (h *DeviceHandler) TransitionDeviceState(w http.ResponseWriter, r *http.Request, deviceId openapi_types.UUID, _ TransitionDeviceStateParams) {
 	id, err := model.ParseDeviceID(deviceId.String())
 	if err != nil {
 		writeError(w, http.StatusBadRequest, codeInvalidID, msgInvalidDeviceID)
@@ -441,22 +561,89 @@ func (h *DeviceHandler) DeleteDevice(w http.ResponseWriter, r *http.Request, dev
 		return
 	}
 
-	cmd := commands.DeleteDeviceCommand{ID: id}
+	var req TransitionDeviceState
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, codeInvalidJSON, msgInvalidRequestBody)
 
-	_, err = h.app.Commands.DeleteDevice.Handle(r.Context(), cmd)
+		return
+	}
+
+	if req.State == "" {
+		writeError(w, http.StatusBadRequest, codeInvalidState, msgStateRequired)
+
+		return
+	}
+
+	state := model.State(req.State)
+	if !state.IsValid() {
+		writeError(w, http.StatusBadRequest, codeInvalidState, msgInvalidState)
+
+		return
+	}
+
+	cmd := commands.TransitionDeviceStateCommand{
+		ID:    id,
+		State: state,
+	}
+
+	device, err := h.app.Commands.TransitionDeviceState.Handle(r.Context(), cmd)
 	if err != nil {
-		if errors.Is(err, model.ErrDeviceNotFound) {
-			writeError(w, http.StatusNotFound, codeNotFound, msgDeviceNotFound)
+		handleDeviceError(w, err)
 
-			return
-		}
-		if errors.Is(err, model.ErrCannotDeleteInUseDevice) {
-			writeError(w, http.StatusConflict, codeConflict, msgCannotDeleteInUse)
+		return
+	}
 
-			return
-		}
+	response := shared.EnvelopedResponse{
+		Data: h.presentDevice(r.Context(), toDeviceData(device)),
+		Meta: shared.NewMeta(r),
+	}
 
-		writeError(w, http.StatusInternalServerError, codeInternalError, err.Error())
+	h.writeEnvelopedResponse(w, r, http.StatusOK, response)
+}
+
+// GetSimilarDevices lists the available devices of the same brand as the device, at most
+// queries.MaxSimilarDevices of them. The list is not paginated and may be empty.
+func (h *DeviceHandler) GetSimilarDevices(w http.ResponseWriter, r *http.Request, deviceId openapi_types.UUID, _ GetSimilarDevicesParams) {
+	id, err := model.ParseDeviceID(deviceId.String())
+	if err != nil {
+		writeError(w, http.StatusBadRequest, codeInvalidID, msgInvalidDeviceID)
+
+		return
+	}
+
+	devices, err := h.app.Queries.SimilarDevices.Execute(r.Context(), queries.SimilarDevicesQuery{ID: id})
+	if err != nil {
+		handleDeviceError(w, err)
+
+		return
+	}
+
+	data := make([]deviceData, 0, len(devices))
+	for _, device := range devices {
+		data = append(data, toDeviceData(device))
+	}
+
+	response := shared.EnvelopedResponse{
+		Data: h.presentDevices(r.Context(), data),
+		Meta: shared.NewMeta(r),
+	}
+
+	h.writeEnvelopedResponse(w, r, http.StatusOK, response)
+}
+
+func (h *DeviceHandler) DeleteDevice(w http.ResponseWriter, r *http.Request, deviceId openapi_types.UUID, _ DeleteDeviceParams) {
+	id, err := model.ParseDeviceID(deviceId.String())
+	if err != nil {
+		writeError(w, http.StatusBadRequest, codeInvalidID, msgInvalidDeviceID)
+
+		return
+	}
+
+	cmd := commands.DeleteDeviceCommand{ID: id}
+
+	_, err = h.app.Commands.DeleteDevice.Handle(r.Context(), cmd)
+	if err != nil {
+		handleDeviceError(w, err)
 
 		return
 	}
@@ -553,6 +740,18 @@ func (h *DeviceHandler) HealthCheck(w http.ResponseWriter, r *http.Request) {
 	writeJSONResponse(w, httpStatus, response)
 }
 
+// writeEnvelopedResponse writes the enveloped response, or only its data when bare responses
+// are allowed and the client asked for one. Bare responses carry no meta or pagination.
+func (h *DeviceHandler) writeEnvelopedResponse(w http.ResponseWriter, r *http.Request, status int, response shared.EnvelopedResponse) {
+	if h.bareResponseAllowed && middleware.IsBareResponse(r.Context()) {
+		writeJSONResponse(w, status, response.Data)
+
+		return
+	}
+
+	writeJSONResponse(w, status, response)
+}
+
 func writeJSONResponse(w http.ResponseWriter, status int, data any) {
 	w.Header().Set(contentTypeHeader, applicationJSON)
 	w.WriteHeader(status)
@@ -572,15 +771,54 @@ func writeError(w http.ResponseWriter, status int, code, message string) {
 	_ = json.NewEncoder(w).Encode(response)
 }
 
-func handleDeviceUpdateError(w http.ResponseWriter, err error) {
-	if errors.Is(err, model.ErrDeviceNotFound) {
-		writeError(w, http.StatusNotFound, codeNotFound, msgDeviceNotFound)
+// writeValidationError writes a 400 listing every field reported by *model.ValidationErrors.
+func writeValidationError(w http.ResponseWriter, err error, message string) {
+	var validationErrs *model.ValidationErrors
+	if !errors.As(err, &validationErrs) {
+		writeError(w, http.StatusBadRequest, codeValidationError, err.Error())
 
 		return
 	}
 
-	if errors.Is(err, model.ErrCannotUpdateInUseDevice) {
-		writeError(w, http.StatusConflict, codeConflict, msgCannotUpdateInUse)
+	details := toErrorDetails(validationErrs)
+
+	w.Header().Set(contentTypeHeader, applicationJSON)
+	w.WriteHeader(http.StatusBadRequest)
+
+	_ = json.NewEncoder(w).Encode(Error{
+		Code:      codeValidationError,
+		Message:   message,
+		Details:   &details,
+		Timestamp: time.Now().UTC(),
+	})
+}
+
+func toErrorDetails(validationErrs *model.ValidationErrors) []ErrorDetail {
+	details := make([]ErrorDetail, 0, len(validationErrs.Errors))
+	for _, validationErr := range validationErrs.Errors {
+		detail := ErrorDetail{Field: validationErr.Field, Message: validationErr.Message}
+		if validationErr.Code != "" {
+			detail.Code = &validationErr.Code
+		}
+
+		details = append(details, detail)
+	}
+
+	return details
+}
+
+// handleDeviceError answers err with the fields of a validation error, the status and
+// code a model.DomainError carries, or 500 for anything else.
+func handleDeviceError(w http.ResponseWriter, err error) {
+	var validationErrs *model.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		writeValidationError(w, err, msgInvalidDevice)
+
+		return
+	}
+
+	if domainErr, ok := model.IsDomainError(err); ok {
+		writeError(w, domainErr.HTTPStatus, domainErr.Code, domainErr.Error())
 
 		return
 	}
@@ -603,6 +841,99 @@ func toDeviceData(device *model.Device) deviceData {
 	}
 }
 
+// presentDevice returns data in the response version negotiated for the request, limited
+// to the fields the client selected.
+func (h *DeviceHandler) presentDevice(ctx context.Context, data deviceData) any {
+	fields := middleware.GetFieldSet(ctx)
+
+	if h.negotiatedVersion(ctx) == middleware.ResponseVersionV2 {
+		return projectFields(toDeviceDataV2(data), fields)
+	}
+
+	return projectFields(data, fields)
+}
+
+// presentDevices returns data in the response version negotiated for the request, limited
+// to the fields the client selected.
+func (h *DeviceHandler) presentDevices(ctx context.Context, data []deviceData) any {
+	fields := middleware.GetFieldSet(ctx)
+	isV2 := h.negotiatedVersion(ctx) == middleware.ResponseVersionV2
+
+	if fields == nil && !isV2 {
+		return data
+	}
+
+	devices := make([]any, 0, len(data))
+	for index := range data {
+		if isV2 {
+			devices = append(devices, projectFields(toDeviceDataV2(data[index]), fields))
+		} else {
+			devices = append(devices, projectFields(data[index], fields))
+		}
+	}
+
+	return devices
+}
+
+// projectFields keeps the fields of the device struct the set selects, keyed by their JSON
+// names and dropping omitempty fields holding zero values, as encoding/json would. A nil
+// set returns the device unchanged.
+func projectFields(device any, fields middleware.FieldSet) any {
+	if fields == nil {
+		return device
+	}
+
+	value := reflect.ValueOf(device)
+	deviceType := value.Type()
+	projected := make(map[string]any, deviceType.NumField())
+
+	for index := range deviceType.NumField() {
+		field := deviceType.Field(index)
+
+		name, options, _ := strings.Cut(field.Tag.Get("json"), ",")
+
+		selector := field.Tag.Get("field")
+		if selector == "" {
+			selector = name
+		}
+
+		if selector != "-" && !fields.Has(selector) {
+			continue
+		}
+
+		fieldValue := value.Field(index)
+		if strings.Contains(options, "omitempty") && fieldValue.IsZero() {
+			continue
+		}
+
+		projected[name] = fieldValue.Interface()
+	}
+
+	return projected
+}
+
+func (h *DeviceHandler) negotiatedVersion(ctx context.Context) string {
+	if version := middleware.GetResponseVersion(ctx); version != "" {
+		return version
+	}
+
+	return h.responseVersion
+}
+
+// toDeviceDataV2 converts a v1 device to the v2 shape. Devices are deleted outright, so
+// a device that can still be served has no deletion time.
+func toDeviceDataV2(data deviceData) deviceDataV2 {
+	return deviceDataV2{
+		Brand:       data.Brand,
+		CreatedAt:   data.CreatedAt,
+		DeviceState: data.State,
+		Id:          data.Id,
+		Links:       data.Links,
+		Name:        data.Name,
+		UpdatedAt:   data.UpdatedAt,
+	}
+}
+
 func toDeviceListData(list *model.DeviceList) ([]deviceData, *shared.PaginationData) {
 	data := make([]deviceData, 0, len(list.Devices))
 	for index := range list.Devices {
@@ -4,8 +4,11 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -25,6 +28,7 @@ func newTestApp(deviceSvc *mocks.FakeDevicesService, healthChecker *mocks.FakeHe
 	return usecases.NewWebApplication(
 		deviceSvc,
 		healthChecker,
+		nil,
 		nil, logger.NewTestLogger(),
 		noop.NewMetricsClient(),
 		otelNoop.NewTracerProvider(),
@@ -124,6 +128,55 @@ func (s *HandlerTestSuite) TestListDevices_Success() {
 	s.Require().NotNil(response.Data)
 }
 
+func (s *HandlerTestSuite) TestListDevices_InvalidFilter() {
+	s.T().Parallel()
+
+	page := 0
+	size := 501
+	sort := []string{"-price"}
+
+	deviceSvc := &mocks.FakeDevicesService{}
+	handler := public.NewDeviceHandler(newTestApp(deviceSvc, newDefaultHealthChecker()))
+
+	req := withRequestContext(httptest.NewRequest(http.MethodGet, "/v1/devices?page=0&size=501&sort=-price", nil))
+	rec := httptest.NewRecorder()
+
+	handler.ListDevices(rec, req, public.ListDevicesParams{Page: &page, Size: &size, Sort: &sort})
+
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+	s.Require().Zero(deviceSvc.ListDevicesCallCount())
+
+	var response public.Error
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+	s.Require().Equal("VALIDATION_ERROR", response.Code)
+	s.Require().NotNil(response.Details)
+
+	fields := make([]string, 0, len(*response.Details))
+	for _, detail := range *response.Details {
+		s.Require().NotEmpty(detail.Message)
+		fields = append(fields, detail.Field)
+	}
+
+	s.Require().Equal([]string{"page", "size", "sort"}, fields)
+}
+
+func (s *HandlerTestSuite) TestHeadDevices_InvalidFilter() {
+	s.T().Parallel()
+
+	size := 0
+
+	deviceSvc := &mocks.FakeDevicesService{}
+	handler := public.NewDeviceHandler(newTestApp(deviceSvc, newDefaultHealthChecker()))
+
+	req := withRequestContext(httptest.NewRequest(http.MethodHead, "/v1/devices?size=0", nil))
+	rec := httptest.NewRecorder()
+
+	handler.HeadDevices(rec, req, public.HeadDevicesParams{Size: &size})
+
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+	s.Require().Zero(deviceSvc.ListDevicesCallCount())
+}
+
 func (s *HandlerTestSuite) TestCreateDevice_Success() {
 	s.T().Parallel()
 
@@ -151,6 +204,28 @@ func (s *HandlerTestSuite) TestCreateDevice_Success() {
 	s.Require().NotEmpty(rec.Header().Get("Location"))
 }
 
+func (s *HandlerTestSuite) TestCreateDevice_AlreadyExists() {
+	s.T().Parallel()
+
+	deviceSvc := &mocks.FakeDevicesService{}
+	deviceSvc.CreateDeviceReturns(nil, model.ErrDeviceAlreadyExists)
+
+	handler := public.NewDeviceHandler(newTestApp(deviceSvc, newDefaultHealthChecker()))
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/devices", strings.NewReader(`{"name": "iPhone 15", "brand": "Apple"}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	handler.CreateDevice(rec, req, public.CreateDeviceParams{})
+
+	s.Require().Equal(http.StatusConflict, rec.Code)
+
+	var response public.Error
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+	s.Require().Equal("CONFLICT", response.Code)
+	s.Require().Equal("device already exists", response.Message)
+}
+
 func (s *HandlerTestSuite) TestCreateDevice_InvalidJSON() {
 	s.T().Parallel()
 
@@ -167,6 +242,60 @@ func (s *HandlerTestSuite) TestCreateDevice_InvalidJSON() {
 	s.Require().Equal(http.StatusBadRequest, rec.Code)
 }
 
+func (s *HandlerTestSuite) TestCreateDevice_InvalidFields() {
+	s.T().Parallel()
+
+	deviceSvc := &mocks.FakeDevicesService{}
+	handler := public.NewDeviceHandler(newTestApp(deviceSvc, newDefaultHealthChecker()))
+
+	body := `{"name":"","brand":"` + strings.Repeat("b", 256) + `","state":"broken"}`
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/devices", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	handler.CreateDevice(rec, req, public.CreateDeviceParams{})
+
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+	s.Require().Zero(deviceSvc.CreateDeviceCallCount())
+
+	var response public.Error
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+	s.Require().Equal("VALIDATION_ERROR", response.Code)
+	s.Require().NotNil(response.Details)
+
+	fields := make([]string, 0, len(*response.Details))
+	for _, detail := range *response.Details {
+		fields = append(fields, detail.Field)
+	}
+
+	s.Require().Equal([]string{"name", "brand", "state"}, fields)
+}
+
+func (s *HandlerTestSuite) TestUpdateDevice_InvalidFields() {
+	s.T().Parallel()
+
+	deviceSvc := &mocks.FakeDevicesService{}
+	handler := public.NewDeviceHandler(newTestApp(deviceSvc, newDefaultHealthChecker()))
+
+	deviceID := model.NewDeviceID()
+
+	req := httptest.NewRequest(http.MethodPut, "/v1/devices/"+deviceID.String(), strings.NewReader(`{"name":"iPhone","brand":" ","state":"available"}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	handler.UpdateDevice(rec, req, deviceID.UUID, public.UpdateDeviceParams{})
+
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+	s.Require().Zero(deviceSvc.UpdateDeviceCallCount())
+
+	var response public.Error
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+	s.Require().Equal("VALIDATION_ERROR", response.Code)
+	s.Require().Len(*response.Details, 1)
+	s.Require().Equal("brand", (*response.Details)[0].Field)
+}
+
 func (s *HandlerTestSuite) TestGetDevice_Success() {
 	s.T().Parallel()
 
@@ -215,6 +344,341 @@ func (s *HandlerTestSuite) TestGetDevice_NotFound() {
 	s.Require().Equal(http.StatusNotFound, rec.Code)
 }
 
+// getSimilarDevices requests the devices similar to source, with svc-devices listing neighbors.
+func (s *HandlerTestSuite) getSimilarDevices(
+	source *model.Device,
+	neighbors ...*model.Device,
+) (public.SimilarDevicesEnvelope, *mocks.FakeDevicesService) {
+	deviceSvc := &mocks.FakeDevicesService{}
+	deviceSvc.GetDeviceReturns(source, nil)
+	deviceSvc.ListDevicesReturns(&model.DeviceList{Devices: neighbors}, nil)
+
+	handler := public.NewDeviceHandler(newTestApp(deviceSvc, newDefaultHealthChecker()))
+
+	req := withRequestContext(httptest.NewRequest(http.MethodGet, "/v1/devices/"+source.ID.String()+"/similar", nil))
+	rec := httptest.NewRecorder()
+
+	handler.GetSimilarDevices(rec, req, source.ID.UUID, public.GetSimilarDevicesParams{})
+
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var response public.SimilarDevicesEnvelope
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+	s.Require().NotNil(response.Data)
+
+	return response, deviceSvc
+}
+
+func (s *HandlerTestSuite) TestGetSimilarDevices_ExcludesSource() {
+	s.T().Parallel()
+
+	source := model.NewDevice("iPhone 15", "Apple", model.StateAvailable)
+	neighbor := model.NewDevice("iPhone 14", "Apple", model.StateAvailable)
+
+	response, deviceSvc := s.getSimilarDevices(source, source, neighbor)
+
+	s.Require().Len(response.Data, 1)
+	s.Require().Equal(neighbor.ID.UUID, uuid.UUID(response.Data[0].Id))
+
+	_, filter := deviceSvc.ListDevicesArgsForCall(0)
+	s.Require().Equal([]string{"Apple"}, filter.Brands)
+	s.Require().Equal([]model.State{model.StateAvailable}, filter.States)
+}
+
+func (s *HandlerTestSuite) TestGetSimilarDevices_LimitedToFive() {
+	s.T().Parallel()
+
+	source := model.NewDevice("Galaxy S24", "Samsung", model.StateInUse)
+
+	neighbors := make([]*model.Device, 0, 8)
+	for i := range 8 {
+		neighbors = append(neighbors, model.NewDevice(fmt.Sprintf("Galaxy A%d", i), "Samsung", model.StateAvailable))
+	}
+
+	response, _ := s.getSimilarDevices(source, neighbors...)
+
+	s.Require().Len(response.Data, 5)
+
+	for i, device := range response.Data {
+		s.Require().Equal(neighbors[i].ID.UUID, uuid.UUID(device.Id))
+	}
+}
+
+func (s *HandlerTestSuite) TestGetSimilarDevices_NoNeighbors() {
+	s.T().Parallel()
+
+	source := model.NewDevice("Pixel 9", "Google", model.StateAvailable)
+
+	response, _ := s.getSimilarDevices(source, source)
+
+	s.Require().Empty(response.Data)
+}
+
+func (s *HandlerTestSuite) TestGetSimilarDevices_SourceNotFound() {
+	s.T().Parallel()
+
+	deviceSvc := &mocks.FakeDevicesService{}
+	deviceSvc.GetDeviceReturns(nil, model.ErrDeviceNotFound)
+
+	handler := public.NewDeviceHandler(newTestApp(deviceSvc, newDefaultHealthChecker()))
+
+	id := model.NewDeviceID()
+	req := withRequestContext(httptest.NewRequest(http.MethodGet, "/v1/devices/"+id.String()+"/similar", nil))
+	rec := httptest.NewRecorder()
+
+	handler.GetSimilarDevices(rec, req, id.UUID, public.GetSimilarDevicesParams{})
+
+	s.Require().Equal(http.StatusNotFound, rec.Code)
+	s.Require().Zero(deviceSvc.ListDevicesCallCount())
+}
+
+// transitionDeviceState sends a state transition request for a device currently in the given state.
+func (s *HandlerTestSuite) transitionDeviceState(current model.State, body string) (*httptest.ResponseRecorder, *mocks.FakeDevicesService) {
+	id := model.NewDeviceID()
+	deviceSvc := &mocks.FakeDevicesService{}
+	deviceSvc.GetDeviceReturns(&model.Device{ID: id, Name: "iPhone", Brand: "Apple", State: current}, nil)
+	deviceSvc.PatchDeviceIfUnmodifiedStub = func(_ context.Context, deviceID model.DeviceID, updates map[string]any, _ time.Time) (*model.Device, error) {
+		return &model.Device{ID: deviceID, Name: "iPhone", Brand: "Apple", State: model.State(updates["state"].(string))}, nil
+	}
+
+	handler := public.NewDeviceHandler(newTestApp(deviceSvc, newDefaultHealthChecker()))
+
+	req := withRequestContext(httptest.NewRequest(http.MethodPatch, "/v1/devices/"+id.String()+"/state", bytes.NewBufferString(body)))
+	rec := httptest.NewRecorder()
+
+	handler.TransitionDeviceState(rec, req, id.UUID, public.TransitionDeviceStateParams{})
+
+	return rec, deviceSvc
+}
+
+func (s *HandlerTestSuite) TestTransitionDeviceState_ValidTransitions() {
+	s.T().Parallel()
+
+	cases := []struct {
+		from model.State
+		to   model.State
+	}{
+		{model.StateAvailable, model.StateInUse},
+		{model.StateAvailable, model.StateInactive},
+		{model.StateInUse, model.StateAvailable},
+		{model.StateInactive, model.StateAvailable},
+	}
+
+	for _, tc := range cases {
+		s.Run(fmt.Sprintf("%s to %s", tc.from, tc.to), func() {
+			rec, deviceSvc := s.transitionDeviceState(tc.from, fmt.Sprintf(`{"state":%q}`, tc.to))
+
+			s.Require().Equal(http.StatusOK, rec.Code)
+
+			var response public.DeviceEnvelope
+			s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+			s.Require().Equal(public.DeviceState(tc.to), response.Data.State)
+
+			s.Require().Equal(1, deviceSvc.PatchDeviceIfUnmodifiedCallCount())
+			_, _, updates, _ := deviceSvc.PatchDeviceIfUnmodifiedArgsForCall(0)
+			s.Require().Equal(map[string]any{"state": tc.to.String()}, updates)
+		})
+	}
+}
+
+func (s *HandlerTestSuite) TestTransitionDeviceState_InvalidTransitions() {
+	s.T().Parallel()
+
+	cases := []struct {
+		from model.State
+		to   model.State
+	}{
+		{model.StateAvailable, model.StateAvailable},
+		{model.StateInUse, model.StateInUse},
+		{model.StateInUse, model.StateInactive},
+		{model.StateInactive, model.StateInactive},
+		{model.StateInactive, model.StateInUse},
+	}
+
+	for _, tc := range cases {
+		s.Run(fmt.Sprintf("%s to %s", tc.from, tc.to), func() {
+			rec, deviceSvc := s.transitionDeviceState(tc.from, fmt.Sprintf(`{"state":%q}`, tc.to))
+
+			s.Require().Equal(http.StatusConflict, rec.Code)
+
+			var response public.Error
+			s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+			s.Require().Equal("CONFLICT", response.Code)
+			s.Require().Equal(
+				fmt.Sprintf("invalid state transition: cannot transition device from %s to %s", tc.from, tc.to),
+				response.Message,
+			)
+			s.Require().Zero(deviceSvc.PatchDeviceIfUnmodifiedCallCount())
+		})
+	}
+}
+
+func (s *HandlerTestSuite) TestTransitionDeviceState_BadRequest() {
+	s.T().Parallel()
+
+	cases := []struct {
+		name         string
+		body         string
+		expectedCode string
+	}{
+		{name: "missing state", body: `{}`, expectedCode: "INVALID_STATE"},
+		{name: "unknown state", body: `{"state":"broken"}`, expectedCode: "INVALID_STATE"},
+		{name: "malformed body", body: `{"state":`, expectedCode: "INVALID_JSON"},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			rec, deviceSvc := s.transitionDeviceState(model.StateAvailable, tc.body)
+
+			s.Require().Equal(http.StatusBadRequest, rec.Code)
+
+			var response public.Error
+			s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+			s.Require().Equal(tc.expectedCode, response.Code)
+			s.Require().Zero(deviceSvc.GetDeviceCallCount())
+		})
+	}
+}
+
+func (s *HandlerTestSuite) TestTransitionDeviceState_ConcurrentModification() {
+	s.T().Parallel()
+
+	deviceSvc := &mocks.FakeDevicesService{}
+	deviceSvc.GetDeviceReturns(&model.Device{ID: model.NewDeviceID(), State: model.StateAvailable}, nil)
+	deviceSvc.PatchDeviceIfUnmodifiedReturns(nil, model.ErrDeviceModified)
+
+	handler := public.NewDeviceHandler(newTestApp(deviceSvc, newDefaultHealthChecker()))
+
+	id := model.NewDeviceID()
+	req := withRequestContext(httptest.NewRequest(http.MethodPatch, "/v1/devices/"+id.String()+"/state", bytes.NewBufferString(`{"state":"in-use"}`)))
+	rec := httptest.NewRecorder()
+
+	handler.TransitionDeviceState(rec, req, id.UUID, public.TransitionDeviceStateParams{})
+
+	s.Require().Equal(http.StatusConflict, rec.Code)
+
+	var response public.Error
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+	s.Require().Equal("CONFLICT", response.Code)
+	s.Require().Equal("device was modified concurrently", response.Message)
+}
+
+func (s *HandlerTestSuite) TestTransitionDeviceState_NotFound() {
+	s.T().Parallel()
+
+	deviceSvc := &mocks.FakeDevicesService{}
+	deviceSvc.GetDeviceReturns(nil, model.ErrDeviceNotFound)
+
+	handler := public.NewDeviceHandler(newTestApp(deviceSvc, newDefaultHealthChecker()))
+
+	id := model.NewDeviceID()
+	req := httptest.NewRequest(http.MethodPatch, "/v1/devices/"+id.String()+"/state", bytes.NewBufferString(`{"state":"in-use"}`))
+	rec := httptest.NewRecorder()
+
+	handler.TransitionDeviceState(rec, req, id.UUID, public.TransitionDeviceStateParams{})
+
+	s.Require().Equal(http.StatusNotFound, rec.Code)
+}
+
+func (s *HandlerTestSuite) TestGetDevice_ConditionalGET() {
+	s.T().Parallel()
+
+	device := &model.Device{
+		ID:        model.NewDeviceID(),
+		Name:      "Test Device",
+		Brand:     "Test Brand",
+		State:     model.StateAvailable,
+		CreatedAt: time.Unix(1700000000, 0).UTC(),
+		UpdatedAt: time.Unix(1700000000, 0).UTC(),
+	}
+
+	deviceSvc := &mocks.FakeDevicesService{}
+	deviceSvc.GetDeviceStub = func(context.Context, model.DeviceID) (*model.Device, error) {
+		return device.Clone(), nil
+	}
+
+	handler := public.NewDeviceHandler(newTestApp(deviceSvc, newDefaultHealthChecker()))
+
+	get := func(ifNoneMatch string) *httptest.ResponseRecorder {
+		req := withRequestContext(httptest.NewRequest(http.MethodGet, "/v1/devices/"+device.ID.String(), nil))
+		if ifNoneMatch != "" {
+			req.Header.Set("If-None-Match", ifNoneMatch)
+		}
+
+		rec := httptest.NewRecorder()
+		handler.GetDevice(rec, req, device.ID.UUID, public.GetDeviceParams{})
+
+		return rec
+	}
+
+	first := get("")
+	s.Require().Equal(http.StatusOK, first.Code)
+
+	etag := first.Header().Get("ETag")
+	s.Require().Equal(fmt.Sprintf(`W/"%s-%d"`, device.ID.String(), device.UpdatedAt.Unix()), etag)
+
+	notModified := get(etag)
+	s.Require().Equal(http.StatusNotModified, notModified.Code)
+	s.Require().Empty(notModified.Body.Bytes())
+	s.Require().Equal(etag, notModified.Header().Get("ETag"))
+
+	device.UpdatedAt = device.UpdatedAt.Add(time.Minute)
+
+	stale := get(etag)
+	s.Require().Equal(http.StatusOK, stale.Code)
+	s.Require().NotEmpty(stale.Body.Bytes())
+	s.Require().NotEqual(etag, stale.Header().Get("ETag"))
+}
+
+func (s *HandlerTestSuite) TestListDevices_ConditionalGET() {
+	s.T().Parallel()
+
+	updatedAt := time.Unix(1700000000, 0).UTC()
+	list := &model.DeviceList{
+		Devices: []*model.Device{
+			{ID: model.NewDeviceID(), Name: "iPhone", Brand: "Apple", State: model.StateAvailable, UpdatedAt: updatedAt.Add(time.Hour)},
+			{ID: model.NewDeviceID(), Name: "Pixel", Brand: "Google", State: model.StateInUse, UpdatedAt: updatedAt},
+		},
+		Pagination: model.Pagination{Page: 1, Size: 20, TotalItems: 2, TotalPages: 1},
+	}
+
+	deviceSvc := &mocks.FakeDevicesService{}
+	deviceSvc.ListDevicesStub = func(context.Context, model.DeviceFilter) (*model.DeviceList, error) {
+		return list.Clone(), nil
+	}
+
+	handler := public.NewDeviceHandler(newTestApp(deviceSvc, newDefaultHealthChecker()))
+
+	get := func(ifNoneMatch string) *httptest.ResponseRecorder {
+		req := withRequestContext(httptest.NewRequest(http.MethodGet, "/v1/devices", nil))
+		if ifNoneMatch != "" {
+			req.Header.Set("If-None-Match", ifNoneMatch)
+		}
+
+		rec := httptest.NewRecorder()
+		handler.ListDevices(rec, req, public.ListDevicesParams{})
+
+		return rec
+	}
+
+	first := get("")
+	s.Require().Equal(http.StatusOK, first.Code)
+
+	etag := first.Header().Get("ETag")
+	s.Require().Equal(fmt.Sprintf(`W/"2-%d-%d"`, updatedAt.Add(time.Hour).Unix(), updatedAt.Unix()), etag)
+
+	notModified := get(etag)
+	s.Require().Equal(http.StatusNotModified, notModified.Code)
+	s.Require().Empty(notModified.Body.Bytes())
+
+	list.Pagination.TotalItems = 3
+
+	stale := get(etag)
+	s.Require().Equal(http.StatusOK, stale.Code)
+	s.Require().NotEmpty(stale.Body.Bytes())
+	s.Require().NotEqual(etag, stale.Header().Get("ETag"))
+}
+
 func (s *HandlerTestSuite) TestDeleteDevice_Success() {
 	s.T().Parallel()
 
@@ -237,19 +701,46 @@ func (s *HandlerTestSuite) TestDeleteDevice_Errors() {
 	s.T().Parallel()
 
 	cases := []struct {
-		name           string
-		err            error
-		expectedStatus int
+		name            string
+		err             error
+		expectedStatus  int
+		expectedCode    string
+		expectedMessage string
 	}{
 		{
-			name:           "not found",
-			err:            model.ErrDeviceNotFound,
-			expectedStatus: http.StatusNotFound,
+			name:            "not found",
+			err:             model.ErrDeviceNotFound,
+			expectedStatus:  http.StatusNotFound,
+			expectedCode:    "NOT_FOUND",
+			expectedMessage: "device not found",
 		},
 		{
-			name:           "in use conflict",
-			err:            model.ErrCannotDeleteInUseDevice,
-			expectedStatus: http.StatusConflict,
+			name:            "in use conflict",
+			err:             model.ErrCannotDeleteInUseDevice,
+			expectedStatus:  http.StatusConflict,
+			expectedCode:    "CONFLICT",
+			expectedMessage: "cannot delete in-use device",
+		},
+		{
+			name:            "service unavailable",
+			err:             model.ErrServiceUnavailable,
+			expectedStatus:  http.StatusServiceUnavailable,
+			expectedCode:    "SERVICE_UNAVAILABLE",
+			expectedMessage: "service unavailable",
+		},
+		{
+			name:            "timeout",
+			err:             model.ErrTimeout,
+			expectedStatus:  http.StatusGatewayTimeout,
+			expectedCode:    "TIMEOUT",
+			expectedMessage: "request timeout",
+		},
+		{
+			name:            "unexpected error",
+			err:             errors.New("connection reset"),
+			expectedStatus:  http.StatusInternalServerError,
+			expectedCode:    "INTERNAL_ERROR",
+			expectedMessage: "connection reset",
 		},
 	}
 
@@ -268,6 +759,11 @@ func (s *HandlerTestSuite) TestDeleteDevice_Errors() {
 			handler.DeleteDevice(rec, req, id.UUID, public.DeleteDeviceParams{})
 
 			s.Require().Equal(tc.expectedStatus, rec.Code)
+
+			var response public.Error
+			s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+			s.Require().Equal(tc.expectedCode, response.Code)
+			s.Require().Equal(tc.expectedMessage, response.Message)
 		})
 	}
 }
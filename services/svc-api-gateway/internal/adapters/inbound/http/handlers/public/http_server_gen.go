@@ -90,6 +90,52 @@ const (
 	ApiVersionHeaderV1 ApiVersionHeader = "v1"
 )
 
+// Defines values for ExportFormatParam.
+const (
+	ExportFormatParamCsv    ExportFormatParam = "csv"
+	ExportFormatParamNdjson ExportFormatParam = "ndjson"
+)
+
+// Defines values for ExportDevicesParamsFormat.
+const (
+	ExportDevicesParamsFormatCsv    ExportDevicesParamsFormat = "csv"
+	ExportDevicesParamsFormatNdjson ExportDevicesParamsFormat = "ndjson"
+)
+
+// BulkCreateDeviceResult Outcome of creating one item of a bulk request
+type BulkCreateDeviceResult struct {
+	// Code Machine-readable error code, set when the item failed
+	Code *string `json:"code,omitempty"`
+
+	// Details Invalid fields of the item, for validation errors
+	Details *[]ErrorDetail `json:"details,omitempty"`
+
+	// Device A device resource
+	Device *Device `json:"device,omitempty"`
+
+	// Index Position of the item in the request body, starting at 0
+	Index int `json:"index"`
+
+	// Message Human-readable error message, set when the item failed
+	Message *string `json:"message,omitempty"`
+
+	// Status HTTP status the item would have had as a single create request
+	Status int `json:"status"`
+}
+
+// BulkCreateDevices Request body for creating several devices. Each item has the fields of CreateDevice and is
+// validated on its own, so an invalid item fails without rejecting the rest of the batch.
+type BulkCreateDevices = []map[string]interface{}
+
+// BulkCreateDevicesEnvelope Response envelope listing the outcome of every item of a bulk create request, in request order
+type BulkCreateDevicesEnvelope struct {
+	Data []BulkCreateDeviceResult `json:"data"`
+
+	// Meta Response metadata containing tracing information and API versioning.
+	// All successful responses include this field to support observability and debugging.
+	Meta Meta `json:"meta"`
+}
+
 // CacheDependencyCheck defines model for CacheDependencyCheck.
 type CacheDependencyCheck struct {
 	// Details Cache-specific details
@@ -120,6 +166,18 @@ type CacheDependencyCheck struct {
 // CacheDependencyCheckStatus The status of the dependency
 type CacheDependencyCheckStatus string
 
+// CacheEntryStatus Cache status of a single device entry
+type CacheEntryStatus struct {
+	// Cached Whether the device is currently cached
+	Cached bool `json:"cached"`
+
+	// Key Cache key of the device entry
+	Key string `json:"key"`
+
+	// TtlSeconds Remaining time-to-live in seconds, or -1 when the entry never expires
+	TtlSeconds int64 `json:"ttl_seconds"`
+}
+
 // CacheError Error response for cache operations
 type CacheError struct {
 	// Error Error message describing the failure
@@ -159,6 +217,24 @@ type CachePurge struct {
 	Status string `json:"status"`
 }
 
+// CacheStats Cache server statistics
+type CacheStats struct {
+	// HitCount Number of successful key lookups since the server started
+	HitCount int64 `json:"hitCount"`
+
+	// HitRatio Share of lookups that were hits, or 0 when there were no lookups
+	HitRatio float64 `json:"hitRatio"`
+
+	// KeyCount Number of keys in the selected database
+	KeyCount int64 `json:"keyCount"`
+
+	// MissCount Number of failed key lookups since the server started
+	MissCount int64 `json:"missCount"`
+
+	// UsedMemoryBytes Memory allocated by the cache server in bytes
+	UsedMemoryBytes int64 `json:"usedMemoryBytes"`
+}
+
 // CreateDevice Request body for creating a new device
 type CreateDevice struct {
 	// Brand The brand/manufacturer of the device
@@ -284,6 +360,15 @@ type ErrorDetail struct {
 	Message string `json:"message"`
 }
 
+// ForcedDeviceState Response after forcing a device state change
+type ForcedDeviceState struct {
+	// Id ID of the device whose state was forced
+	Id openapi_types.UUID `json:"id"`
+
+	// State The current state of the device
+	State DeviceState `json:"state"`
+}
+
 // Health Comprehensive health check response with system metrics
 type Health struct {
 	// Checks Status of individual dependency checks grouped by category
@@ -495,6 +580,16 @@ type Readiness struct {
 // ReadinessStatus The overall readiness status of the service
 type ReadinessStatus string
 
+// SimilarDevicesEnvelope Response envelope containing the devices similar to a device, without pagination
+type SimilarDevicesEnvelope struct {
+	// Data Similar devices, at most 5
+	Data []Device `json:"data"`
+
+	// Meta Response metadata containing tracing information and API versioning.
+	// All successful responses include this field to support observability and debugging.
+	Meta Meta `json:"meta"`
+}
+
 // SystemInfo System resource information
 type SystemInfo struct {
 	// CpuCores Number of CPU cores available
@@ -507,6 +602,12 @@ type SystemInfo struct {
 	Memory *MemoryInfo `json:"memory,omitempty"`
 }
 
+// TransitionDeviceState Request body for transitioning a device to a new state
+type TransitionDeviceState struct {
+	// State The current state of the device
+	State DeviceState `json:"state"`
+}
+
 // UpdateDevice Request body for fully updating a device (PUT)
 type UpdateDevice struct {
 	// Brand The brand/manufacturer of the device.
@@ -569,6 +670,9 @@ type CursorParam = string
 // DeviceIdParam defines model for DeviceIdParam.
 type DeviceIdParam = openapi_types.UUID
 
+// ExportFormatParam defines model for ExportFormatParam.
+type ExportFormatParam string
+
 // FieldsParam defines model for FieldsParam.
 type FieldsParam = string
 
@@ -605,12 +709,30 @@ type TraceparentHeader = string
 // TracestateHeader defines model for TracestateHeader.
 type TracestateHeader = string
 
+// AdminBadRequest Error response for cache operations
+type AdminBadRequest = CacheError
+
+// AdminDeviceNotFound Error response for cache operations
+type AdminDeviceNotFound = CacheError
+
+// AdminServerError Error response for cache operations
+type AdminServerError = CacheError
+
+// AdminUnauthorized Error response for cache operations
+type AdminUnauthorized = CacheError
+
 // BadRequest Standard error response format
 type BadRequest = Error
 
 // CacheBadRequest Error response for cache operations
 type CacheBadRequest = CacheError
 
+// CacheDeviceNotCached Error response for cache operations
+type CacheDeviceNotCached = CacheError
+
+// CacheDeviceStatus Cache status of a single device entry
+type CacheDeviceStatus = CacheEntryStatus
+
 // CacheHealthOk Cache health status response
 type CacheHealthOk = CacheHealth
 
@@ -632,6 +754,9 @@ type CachePurgePattern = CachePatternPurge
 // CacheServerError Error response for cache operations
 type CacheServerError = CacheError
 
+// CacheStatsOk Cache server statistics
+type CacheStatsOk = CacheStats
+
 // CacheUnavailable Error response for cache operations
 type CacheUnavailable = CacheError
 
@@ -644,9 +769,15 @@ type DeviceCreated = DeviceEnvelope
 // DeviceRetrieved Response envelope containing a single device with metadata
 type DeviceRetrieved = DeviceEnvelope
 
+// DeviceStateForced Response after forcing a device state change
+type DeviceStateForced = ForcedDeviceState
+
 // DeviceUpdated Response envelope containing a single device with metadata
 type DeviceUpdated = DeviceEnvelope
 
+// DevicesBulkCreated Response envelope listing the outcome of every item of a bulk create request, in request order
+type DevicesBulkCreated = BulkCreateDevicesEnvelope
+
 // DevicesList Response envelope containing a paginated list of devices with metadata
 type DevicesList = DevicesListEnvelope
 
@@ -668,6 +799,9 @@ type NotAcceptable = Error
 // NotFound Standard error response format
 type NotFound = Error
 
+// PayloadTooLarge Standard error response format
+type PayloadTooLarge = Error
+
 // PreconditionFailed Standard error response format
 type PreconditionFailed = Error
 
@@ -683,6 +817,9 @@ type ReadinessOk = Readiness
 // ServerError Standard error response format
 type ServerError = Error
 
+// SimilarDevices Response envelope containing the devices similar to a device, without pagination
+type SimilarDevices = SimilarDevicesEnvelope
+
 // Unauthorized Standard error response format
 type Unauthorized = Error
 
@@ -707,7 +844,7 @@ type ListDevicesParams struct {
 
 	// Sort Fields to sort results by. Comma-separated for multi-field sorting.
 	// Prefix with `-` for descending order.
-	// Supported fields: name, brand, state, createdAt, updatedAt
+	// Supported fields: id, name, brand, state, createdAt, updatedAt
 	// Example: ?sort=-createdAt,name (sort by createdAt DESC, then name ASC)
 	Sort *SortParam `form:"sort,omitempty" json:"sort,omitempty"`
 
@@ -747,23 +884,20 @@ type ListDevicesParams struct {
 	// Fields Field projection to control which fields are returned in the response.
 	//
 	// **Default behavior (no fields parameter):**
-	// Returns essential fields only: `id`, `name`, `brand`, `state`
+	// Returns every field.
 	//
 	// **With fields parameter:**
 	// Returns only the specified fields. Use comma-separated list.
+	// An unsupported field name is rejected with 400 Bad Request.
 	//
 	// **Supported fields:**
 	// - `id` - Device unique identifier (always included)
 	// - `name` - Device name
 	// - `brand` - Device manufacturer
-	// - `state` - Device state (available, in-use, inactive)
+	// - `state` - Device state (available, in-use, inactive); selects `deviceState` in v2 responses
 	// - `createdAt` - Creation timestamp
 	// - `updatedAt` - Last update timestamp
 	// - `links` - HATEOAS navigation links
-	//
-	// **Nested fields syntax:**
-	// Use `field:(subfield1,subfield2)` for nested objects.
-	// Example: `links:(self)` returns only the self link.
 	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
 
 	// Authorization PASETO v4 bearer token for authentication.
@@ -780,10 +914,12 @@ type ListDevicesParams struct {
 	AcceptEncoding *AcceptEncodingHeader `json:"Accept-Encoding,omitempty"`
 
 	// Accept Media type(s) acceptable for the response.
-	// Currently only `application/json` is supported.
+	// `application/json` serves the default response version (v1 unless configured otherwise).
+	// `application/vnd.devices.v1+json` and `application/vnd.devices.v2+json` select a version;
+	// v2 devices carry `deviceState` instead of `state` and a nullable `deletedAt`.
 	//
 	// If not specified, defaults to `application/json`.
-	// If an unsupported media type is requested, returns 406 Not Acceptable.
+	// If only unsupported versions are requested, returns 406 Not Acceptable.
 	Accept *AcceptHeader `json:"Accept,omitempty"`
 
 	// APIVersion API version to use for this request. If not specified, defaults to v1.
@@ -828,7 +964,7 @@ type HeadDevicesParams struct {
 
 	// Sort Fields to sort results by. Comma-separated for multi-field sorting.
 	// Prefix with `-` for descending order.
-	// Supported fields: name, brand, state, createdAt, updatedAt
+	// Supported fields: id, name, brand, state, createdAt, updatedAt
 	// Example: ?sort=-createdAt,name (sort by createdAt DESC, then name ASC)
 	Sort *SortParam `form:"sort,omitempty" json:"sort,omitempty"`
 
@@ -941,10 +1077,12 @@ type CreateDeviceParams struct {
 	IdempotencyKey IdempotencyKeyHeader `json:"Idempotency-Key"`
 
 	// Accept Media type(s) acceptable for the response.
-	// Currently only `application/json` is supported.
+	// `application/json` serves the default response version (v1 unless configured otherwise).
+	// `application/vnd.devices.v1+json` and `application/vnd.devices.v2+json` select a version;
+	// v2 devices carry `deviceState` instead of `state` and a nullable `deletedAt`.
 	//
 	// If not specified, defaults to `application/json`.
-	// If an unsupported media type is requested, returns 406 Not Acceptable.
+	// If only unsupported versions are requested, returns 406 Not Acceptable.
 	Accept *AcceptHeader `json:"Accept,omitempty"`
 
 	// APIVersion API version to use for this request. If not specified, defaults to v1.
@@ -971,6 +1109,93 @@ type CreateDeviceParams struct {
 	Tracestate *TracestateHeader `json:"tracestate,omitempty"`
 }
 
+// BulkCreateDevicesParams defines parameters for BulkCreateDevices.
+type BulkCreateDevicesParams struct {
+	// Authorization PASETO v4 bearer token for authentication.
+	// Format: Bearer v4.public.{payload}.{signature}
+	Authorization AuthorizationHeader `json:"Authorization"`
+
+	// IdempotencyKey Unique key to ensure idempotent POST requests.
+	// If the same key is sent again within the TTL window (24 hours),
+	// the server returns the cached response instead of creating a duplicate.
+	//
+	// **Requirements:**
+	// - Must be a valid UUID v7
+	// - Must be unique per logical operation
+	// - Cached for 24 hours
+	IdempotencyKey IdempotencyKeyHeader `json:"Idempotency-Key"`
+
+	// APIVersion API version to use for this request. If not specified, defaults to v1.
+	// Supported versions: v1
+	APIVersion *ApiVersionHeader `json:"API-Version,omitempty"`
+
+	// RequestId Unique request identifier for tracing and debugging purposes (per-request, always generated server-side).
+	// RFC 6648 compliant (no X- prefix).
+	RequestId *RequestIdHeader `json:"Request-Id,omitempty"`
+
+	// Traceparent W3C Trace Context header for distributed tracing (OpenTelemetry compatible).
+	//
+	// Format: `{version}-{trace-id}-{parent-id}-{trace-flags}`
+	// - version: 2 hex digits (always "00")
+	// - trace-id: 32 hex digits (16 bytes)
+	// - parent-id: 16 hex digits (8 bytes)
+	// - trace-flags: 2 hex digits (sampling flag)
+	//
+	// If not provided, the server will generate a new trace context.
+	Traceparent *TraceparentHeader `json:"traceparent,omitempty"`
+
+	// Tracestate W3C Trace Context state header for vendor-specific trace data.
+	// Comma-separated list of key=value pairs.
+	Tracestate *TracestateHeader `json:"tracestate,omitempty"`
+}
+
+// ExportDevicesParams defines parameters for ExportDevices.
+type ExportDevicesParams struct {
+	// Format Serialization format of the exported devices.
+	// - `csv`: header row followed by one device per line
+	// - `ndjson`: one JSON device object per line
+	Format *ExportDevicesParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// Brand Filter by brand(s). Comma-separated for OR matching.
+	// Example: ?brand=Apple,Samsung
+	Brand *BrandFilterParam `form:"brand,omitempty" json:"brand,omitempty"`
+
+	// State Filter by state(s). Comma-separated for OR matching.
+	// Example: ?state=available,inactive
+	State *StateFilterParam `form:"state,omitempty" json:"state,omitempty"`
+
+	// Sort Fields to sort results by. Comma-separated for multi-field sorting.
+	// Prefix with `-` for descending order.
+	// Supported fields: id, name, brand, state, createdAt, updatedAt
+	// Example: ?sort=-createdAt,name (sort by createdAt DESC, then name ASC)
+	Sort *SortParam `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Q Full-text search query across name and brand fields.
+	// Uses PostgreSQL full-text search with English language stemming.
+	//
+	// **Features:**
+	// - Matches word variations (e.g., "running" matches "run")
+	// - Case-insensitive search
+	// - Searches both name and brand fields
+	//
+	// **Examples:**
+	// - `?q=iPhone` - matches "iPhone 15 Pro", "My iPhone", etc.
+	// - `?q=Samsung` - matches devices with Samsung brand
+	// - `?q=Galaxy` - matches "Galaxy S24", "Galaxy Tab", etc.
+	//
+	// **Combining with filters:**
+	// - `?q=iPhone&state=available` - available iPhones only
+	// - `?q=Pro&brand=Apple` - Apple devices with "Pro" in name
+	Q *SearchParam `form:"q,omitempty" json:"q,omitempty"`
+
+	// Authorization PASETO v4 bearer token for authentication.
+	// Format: Bearer v4.public.{payload}.{signature}
+	Authorization AuthorizationHeader `json:"Authorization"`
+}
+
+// ExportDevicesParamsFormat defines parameters for ExportDevices.
+type ExportDevicesParamsFormat string
+
 // DeleteDeviceParams defines parameters for DeleteDevice.
 type DeleteDeviceParams struct {
 	// Authorization PASETO v4 bearer token for authentication.
@@ -1006,23 +1231,20 @@ type GetDeviceParams struct {
 	// Fields Field projection to control which fields are returned in the response.
 	//
 	// **Default behavior (no fields parameter):**
-	// Returns essential fields only: `id`, `name`, `brand`, `state`
+	// Returns every field.
 	//
 	// **With fields parameter:**
 	// Returns only the specified fields. Use comma-separated list.
+	// An unsupported field name is rejected with 400 Bad Request.
 	//
 	// **Supported fields:**
 	// - `id` - Device unique identifier (always included)
 	// - `name` - Device name
 	// - `brand` - Device manufacturer
-	// - `state` - Device state (available, in-use, inactive)
+	// - `state` - Device state (available, in-use, inactive); selects `deviceState` in v2 responses
 	// - `createdAt` - Creation timestamp
 	// - `updatedAt` - Last update timestamp
 	// - `links` - HATEOAS navigation links
-	//
-	// **Nested fields syntax:**
-	// Use `field:(subfield1,subfield2)` for nested objects.
-	// Example: `links:(self)` returns only the self link.
 	Fields *FieldsParam `form:"fields,omitempty" json:"fields,omitempty"`
 
 	// Authorization PASETO v4 bearer token for authentication.
@@ -1039,10 +1261,12 @@ type GetDeviceParams struct {
 	AcceptEncoding *AcceptEncodingHeader `json:"Accept-Encoding,omitempty"`
 
 	// Accept Media type(s) acceptable for the response.
-	// Currently only `application/json` is supported.
+	// `application/json` serves the default response version (v1 unless configured otherwise).
+	// `application/vnd.devices.v1+json` and `application/vnd.devices.v2+json` select a version;
+	// v2 devices carry `deviceState` instead of `state` and a nullable `deletedAt`.
 	//
 	// If not specified, defaults to `application/json`.
-	// If an unsupported media type is requested, returns 406 Not Acceptable.
+	// If only unsupported versions are requested, returns 406 Not Acceptable.
 	Accept *AcceptHeader `json:"Accept,omitempty"`
 
 	// APIVersion API version to use for this request. If not specified, defaults to v1.
@@ -1141,10 +1365,12 @@ type PatchDeviceParams struct {
 	IfMatch *IfMatchHeader `json:"If-Match,omitempty"`
 
 	// Accept Media type(s) acceptable for the response.
-	// Currently only `application/json` is supported.
+	// `application/json` serves the default response version (v1 unless configured otherwise).
+	// `application/vnd.devices.v1+json` and `application/vnd.devices.v2+json` select a version;
+	// v2 devices carry `deviceState` instead of `state` and a nullable `deletedAt`.
 	//
 	// If not specified, defaults to `application/json`.
-	// If an unsupported media type is requested, returns 406 Not Acceptable.
+	// If only unsupported versions are requested, returns 406 Not Acceptable.
 	Accept *AcceptHeader `json:"Accept,omitempty"`
 
 	// APIVersion API version to use for this request. If not specified, defaults to v1.
@@ -1182,10 +1408,12 @@ type UpdateDeviceParams struct {
 	IfMatch *IfMatchHeader `json:"If-Match,omitempty"`
 
 	// Accept Media type(s) acceptable for the response.
-	// Currently only `application/json` is supported.
+	// `application/json` serves the default response version (v1 unless configured otherwise).
+	// `application/vnd.devices.v1+json` and `application/vnd.devices.v2+json` select a version;
+	// v2 devices carry `deviceState` instead of `state` and a nullable `deletedAt`.
 	//
 	// If not specified, defaults to `application/json`.
-	// If an unsupported media type is requested, returns 406 Not Acceptable.
+	// If only unsupported versions are requested, returns 406 Not Acceptable.
 	Accept *AcceptHeader `json:"Accept,omitempty"`
 
 	// APIVersion API version to use for this request. If not specified, defaults to v1.
@@ -1212,15 +1440,81 @@ type UpdateDeviceParams struct {
 	Tracestate *TracestateHeader `json:"tracestate,omitempty"`
 }
 
+// GetSimilarDevicesParams defines parameters for GetSimilarDevices.
+type GetSimilarDevicesParams struct {
+	// Authorization PASETO v4 bearer token for authentication.
+	// Format: Bearer v4.public.{payload}.{signature}
+	Authorization AuthorizationHeader `json:"Authorization"`
+
+	// APIVersion API version to use for this request. If not specified, defaults to v1.
+	// Supported versions: v1
+	APIVersion *ApiVersionHeader `json:"API-Version,omitempty"`
+
+	// RequestId Unique request identifier for tracing and debugging purposes (per-request, always generated server-side).
+	// RFC 6648 compliant (no X- prefix).
+	RequestId *RequestIdHeader `json:"Request-Id,omitempty"`
+
+	// Traceparent W3C Trace Context header for distributed tracing (OpenTelemetry compatible).
+	//
+	// Format: `{version}-{trace-id}-{parent-id}-{trace-flags}`
+	// - version: 2 hex digits (always "00")
+	// - trace-id: 32 hex digits (16 bytes)
+	// - parent-id: 16 hex digits (8 bytes)
+	// - trace-flags: 2 hex digits (sampling flag)
+	//
+	// If not provided, the server will generate a new trace context.
+	Traceparent *TraceparentHeader `json:"traceparent,omitempty"`
+
+	// Tracestate W3C Trace Context state header for vendor-specific trace data.
+	// Comma-separated list of key=value pairs.
+	Tracestate *TracestateHeader `json:"tracestate,omitempty"`
+}
+
+// TransitionDeviceStateParams defines parameters for TransitionDeviceState.
+type TransitionDeviceStateParams struct {
+	// Authorization PASETO v4 bearer token for authentication.
+	// Format: Bearer v4.public.{payload}.{signature}
+	Authorization AuthorizationHeader `json:"Authorization"`
+
+	// APIVersion API version to use for this request. If not specified, defaults to v1.
+	// Supported versions: v1
+	APIVersion *ApiVersionHeader `json:"API-Version,omitempty"`
+
+	// RequestId Unique request identifier for tracing and debugging purposes (per-request, always generated server-side).
+	// RFC 6648 compliant (no X- prefix).
+	RequestId *RequestIdHeader `json:"Request-Id,omitempty"`
+
+	// Traceparent W3C Trace Context header for distributed tracing (OpenTelemetry compatible).
+	//
+	// Format: `{version}-{trace-id}-{parent-id}-{trace-flags}`
+	// - version: 2 hex digits (always "00")
+	// - trace-id: 32 hex digits (16 bytes)
+	// - parent-id: 16 hex digits (8 bytes)
+	// - trace-flags: 2 hex digits (sampling flag)
+	//
+	// If not provided, the server will generate a new trace context.
+	Traceparent *TraceparentHeader `json:"traceparent,omitempty"`
+
+	// Tracestate W3C Trace Context state header for vendor-specific trace data.
+	// Comma-separated list of key=value pairs.
+	Tracestate *TracestateHeader `json:"tracestate,omitempty"`
+}
+
 // CreateDeviceJSONRequestBody defines body for CreateDevice for application/json ContentType.
 type CreateDeviceJSONRequestBody = CreateDevice
 
+// BulkCreateDevicesJSONRequestBody defines body for BulkCreateDevices for application/json ContentType.
+type BulkCreateDevicesJSONRequestBody = BulkCreateDevices
+
 // PatchDeviceJSONRequestBody defines body for PatchDevice for application/json ContentType.
 type PatchDeviceJSONRequestBody = PatchDevice
 
 // UpdateDeviceJSONRequestBody defines body for UpdateDevice for application/json ContentType.
 type UpdateDeviceJSONRequestBody = UpdateDevice
 
+// TransitionDeviceStateJSONRequestBody defines body for TransitionDeviceState for application/json ContentType.
+type TransitionDeviceStateJSONRequestBody = TransitionDeviceState
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// List all devices
@@ -1235,6 +1529,12 @@ type ServerInterface interface {
 	// Create a new device
 	// (POST /devices)
 	CreateDevice(w http.ResponseWriter, r *http.Request, params CreateDeviceParams)
+	// Create devices in bulk
+	// (POST /devices/bulk)
+	BulkCreateDevices(w http.ResponseWriter, r *http.Request, params BulkCreateDevicesParams)
+	// Export devices
+	// (GET /devices/export)
+	ExportDevices(w http.ResponseWriter, r *http.Request, params ExportDevicesParams)
 	// Delete a device
 	// (DELETE /devices/{deviceId})
 	DeleteDevice(w http.ResponseWriter, r *http.Request, deviceId DeviceIdParam, params DeleteDeviceParams)
@@ -1253,6 +1553,12 @@ type ServerInterface interface {
 	// Fully update a device
 	// (PUT /devices/{deviceId})
 	UpdateDevice(w http.ResponseWriter, r *http.Request, deviceId DeviceIdParam, params UpdateDeviceParams)
+	// Get similar devices
+	// (GET /devices/{deviceId}/similar)
+	GetSimilarDevices(w http.ResponseWriter, r *http.Request, deviceId DeviceIdParam, params GetSimilarDevicesParams)
+	// Transition device state
+	// (PATCH /devices/{deviceId}/state)
+	TransitionDeviceState(w http.ResponseWriter, r *http.Request, deviceId DeviceIdParam, params TransitionDeviceStateParams)
 	// Health check
 	// (GET /health)
 	HealthCheck(w http.ResponseWriter, r *http.Request)
@@ -1292,6 +1598,18 @@ func (_ Unimplemented) CreateDevice(w http.ResponseWriter, r *http.Request, para
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Create devices in bulk
+// (POST /devices/bulk)
+func (_ Unimplemented) BulkCreateDevices(w http.ResponseWriter, r *http.Request, params BulkCreateDevicesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Export devices
+// (GET /devices/export)
+func (_ Unimplemented) ExportDevices(w http.ResponseWriter, r *http.Request, params ExportDevicesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Delete a device
 // (DELETE /devices/{deviceId})
 func (_ Unimplemented) DeleteDevice(w http.ResponseWriter, r *http.Request, deviceId DeviceIdParam, params DeleteDeviceParams) {
@@ -1328,6 +1646,18 @@ func (_ Unimplemented) UpdateDevice(w http.ResponseWriter, r *http.Request, devi
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get similar devices
+// (GET /devices/{deviceId}/similar)
+func (_ Unimplemented) GetSimilarDevices(w http.ResponseWriter, r *http.Request, deviceId DeviceIdParam, params GetSimilarDevicesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Transition device state
+// (PATCH /devices/{deviceId}/state)
+func (_ Unimplemented) TransitionDeviceState(w http.ResponseWriter, r *http.Request, deviceId DeviceIdParam, params TransitionDeviceStateParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Health check
 // (GET /health)
 func (_ Unimplemented) HealthCheck(w http.ResponseWriter, r *http.Request) {
@@ -2068,20 +2398,11 @@ func (siw *ServerInterfaceWrapper) CreateDevice(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteDevice operation middleware
-func (siw *ServerInterfaceWrapper) DeleteDevice(w http.ResponseWriter, r *http.Request) {
+// BulkCreateDevices operation middleware
+func (siw *ServerInterfaceWrapper) BulkCreateDevices(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "deviceId" -------------
-	var deviceId DeviceIdParam
-
-	err = runtime.BindStyledParameterWithOptions("simple", "deviceId", chi.URLParam(r, "deviceId"), &deviceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "deviceId", Err: err})
-		return
-	}
-
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, PasetoAuthScopes, []string{})
@@ -2089,7 +2410,7 @@ func (siw *ServerInterfaceWrapper) DeleteDevice(w http.ResponseWriter, r *http.R
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params DeleteDeviceParams
+	var params BulkCreateDevicesParams
 
 	headers := r.Header
 
@@ -2116,6 +2437,29 @@ func (siw *ServerInterfaceWrapper) DeleteDevice(w http.ResponseWriter, r *http.R
 		return
 	}
 
+	// ------------- Required header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKeyHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = IdempotencyKey
+
+	} else {
+		err := fmt.Errorf("Header parameter Idempotency-Key is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Idempotency-Key", Err: err})
+		return
+	}
+
 	// ------------- Optional header parameter "API-Version" -------------
 	if valueList, found := headers[http.CanonicalHeaderKey("API-Version")]; found {
 		var APIVersion ApiVersionHeader
@@ -2193,7 +2537,7 @@ func (siw *ServerInterfaceWrapper) DeleteDevice(w http.ResponseWriter, r *http.R
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteDevice(w, r, deviceId, params)
+		siw.Handler.BulkCreateDevices(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2203,19 +2547,244 @@ func (siw *ServerInterfaceWrapper) DeleteDevice(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// GetDevice operation middleware
-func (siw *ServerInterfaceWrapper) GetDevice(w http.ResponseWriter, r *http.Request) {
+// ExportDevices operation middleware
+func (siw *ServerInterfaceWrapper) ExportDevices(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "deviceId" -------------
-	var deviceId DeviceIdParam
+	ctx := r.Context()
 
-	err = runtime.BindStyledParameterWithOptions("simple", "deviceId", chi.URLParam(r, "deviceId"), &deviceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "deviceId", Err: err})
-		return
-	}
+	ctx = context.WithValue(ctx, PasetoAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportDevicesParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "brand" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "brand", r.URL.Query(), &params.Brand)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "brand", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "state" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "state", r.URL.Query(), &params.State)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "state", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "q", r.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Required header parameter "Authorization" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Authorization")]; found {
+		var Authorization AuthorizationHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Authorization", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Authorization", valueList[0], &Authorization, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Authorization", Err: err})
+			return
+		}
+
+		params.Authorization = Authorization
+
+	} else {
+		err := fmt.Errorf("Header parameter Authorization is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Authorization", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportDevices(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteDevice operation middleware
+func (siw *ServerInterfaceWrapper) DeleteDevice(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "deviceId" -------------
+	var deviceId DeviceIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "deviceId", chi.URLParam(r, "deviceId"), &deviceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "deviceId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, PasetoAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteDeviceParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "Authorization" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Authorization")]; found {
+		var Authorization AuthorizationHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Authorization", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Authorization", valueList[0], &Authorization, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Authorization", Err: err})
+			return
+		}
+
+		params.Authorization = Authorization
+
+	} else {
+		err := fmt.Errorf("Header parameter Authorization is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Authorization", Err: err})
+		return
+	}
+
+	// ------------- Optional header parameter "API-Version" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("API-Version")]; found {
+		var APIVersion ApiVersionHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "API-Version", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "API-Version", valueList[0], &APIVersion, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "API-Version", Err: err})
+			return
+		}
+
+		params.APIVersion = &APIVersion
+
+	}
+
+	// ------------- Optional header parameter "Request-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Request-Id")]; found {
+		var RequestId RequestIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Request-Id", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Request-Id", valueList[0], &RequestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Request-Id", Err: err})
+			return
+		}
+
+		params.RequestId = &RequestId
+
+	}
+
+	// ------------- Optional header parameter "traceparent" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("traceparent")]; found {
+		var Traceparent TraceparentHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "traceparent", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "traceparent", valueList[0], &Traceparent, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "traceparent", Err: err})
+			return
+		}
+
+		params.Traceparent = &Traceparent
+
+	}
+
+	// ------------- Optional header parameter "tracestate" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("tracestate")]; found {
+		var Tracestate TracestateHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "tracestate", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "tracestate", valueList[0], &Tracestate, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tracestate", Err: err})
+			return
+		}
+
+		params.Tracestate = &Tracestate
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteDevice(w, r, deviceId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetDevice operation middleware
+func (siw *ServerInterfaceWrapper) GetDevice(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "deviceId" -------------
+	var deviceId DeviceIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "deviceId", chi.URLParam(r, "deviceId"), &deviceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "deviceId", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
@@ -3009,6 +3578,276 @@ func (siw *ServerInterfaceWrapper) UpdateDevice(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
+// GetSimilarDevices operation middleware
+func (siw *ServerInterfaceWrapper) GetSimilarDevices(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "deviceId" -------------
+	var deviceId DeviceIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "deviceId", chi.URLParam(r, "deviceId"), &deviceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "deviceId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, PasetoAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSimilarDevicesParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "Authorization" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Authorization")]; found {
+		var Authorization AuthorizationHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Authorization", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Authorization", valueList[0], &Authorization, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Authorization", Err: err})
+			return
+		}
+
+		params.Authorization = Authorization
+
+	} else {
+		err := fmt.Errorf("Header parameter Authorization is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Authorization", Err: err})
+		return
+	}
+
+	// ------------- Optional header parameter "API-Version" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("API-Version")]; found {
+		var APIVersion ApiVersionHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "API-Version", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "API-Version", valueList[0], &APIVersion, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "API-Version", Err: err})
+			return
+		}
+
+		params.APIVersion = &APIVersion
+
+	}
+
+	// ------------- Optional header parameter "Request-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Request-Id")]; found {
+		var RequestId RequestIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Request-Id", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Request-Id", valueList[0], &RequestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Request-Id", Err: err})
+			return
+		}
+
+		params.RequestId = &RequestId
+
+	}
+
+	// ------------- Optional header parameter "traceparent" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("traceparent")]; found {
+		var Traceparent TraceparentHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "traceparent", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "traceparent", valueList[0], &Traceparent, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "traceparent", Err: err})
+			return
+		}
+
+		params.Traceparent = &Traceparent
+
+	}
+
+	// ------------- Optional header parameter "tracestate" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("tracestate")]; found {
+		var Tracestate TracestateHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "tracestate", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "tracestate", valueList[0], &Tracestate, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tracestate", Err: err})
+			return
+		}
+
+		params.Tracestate = &Tracestate
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSimilarDevices(w, r, deviceId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// TransitionDeviceState operation middleware
+func (siw *ServerInterfaceWrapper) TransitionDeviceState(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "deviceId" -------------
+	var deviceId DeviceIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "deviceId", chi.URLParam(r, "deviceId"), &deviceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "deviceId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, PasetoAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TransitionDeviceStateParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "Authorization" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Authorization")]; found {
+		var Authorization AuthorizationHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Authorization", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Authorization", valueList[0], &Authorization, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Authorization", Err: err})
+			return
+		}
+
+		params.Authorization = Authorization
+
+	} else {
+		err := fmt.Errorf("Header parameter Authorization is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Authorization", Err: err})
+		return
+	}
+
+	// ------------- Optional header parameter "API-Version" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("API-Version")]; found {
+		var APIVersion ApiVersionHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "API-Version", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "API-Version", valueList[0], &APIVersion, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "API-Version", Err: err})
+			return
+		}
+
+		params.APIVersion = &APIVersion
+
+	}
+
+	// ------------- Optional header parameter "Request-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Request-Id")]; found {
+		var RequestId RequestIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Request-Id", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Request-Id", valueList[0], &RequestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Request-Id", Err: err})
+			return
+		}
+
+		params.RequestId = &RequestId
+
+	}
+
+	// ------------- Optional header parameter "traceparent" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("traceparent")]; found {
+		var Traceparent TraceparentHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "traceparent", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "traceparent", valueList[0], &Traceparent, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "traceparent", Err: err})
+			return
+		}
+
+		params.Traceparent = &Traceparent
+
+	}
+
+	// ------------- Optional header parameter "tracestate" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("tracestate")]; found {
+		var Tracestate TracestateHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "tracestate", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "tracestate", valueList[0], &Tracestate, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tracestate", Err: err})
+			return
+		}
+
+		params.Tracestate = &Tracestate
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.TransitionDeviceState(w, r, deviceId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // HealthCheck operation middleware
 func (siw *ServerInterfaceWrapper) HealthCheck(w http.ResponseWriter, r *http.Request) {
 
@@ -3182,6 +4021,12 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/devices", wrapper.CreateDevice)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/devices/bulk", wrapper.BulkCreateDevices)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/devices/export", wrapper.ExportDevices)
+	})
 	r.Group(func(r chi.Router) {
 		r.Delete(options.BaseURL+"/devices/{deviceId}", wrapper.DeleteDevice)
 	})
@@ -3200,6 +4045,12 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/devices/{deviceId}", wrapper.UpdateDevice)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/devices/{deviceId}/similar", wrapper.GetSimilarDevices)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/devices/{deviceId}/state", wrapper.TransitionDeviceState)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/health", wrapper.HealthCheck)
 	})
@@ -3216,227 +4067,272 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9C3MTObY4/lVUvrdqE/5uYzsPwLeoLZMY8G6chMQZdpjwT+Ru2Ra0JW9LncTD5rv/",
-	"SkdSt/rlRwgzDMutujvErdc5Ojo6L53zpebz2ZwzwqSodb7UyB2ezUMC/x5hQX31DxHPZjha1Dq1g4hg",
-	"SRBGjNyigNxQn6BbKqcoIGMchxIJiSWp1Ws3OIwJDBJhFtQ6te58HqoPDM9IrVOjp1POCGrtodOI1+7v",
-	"6zUf+1NyNSU4lNMr/jk3r/qIqED6+8KdQU0Zi1qnZr/BaLDQIDvKMbkNF8h8Mst3RwqwxGVrNj26stap",
-	"tZvtXa/Z8lp7w1azs9PsNJsfavUaVe2brRftnV285+2Pnvne8+AF8ZrjVtvb2d3bf/b8RROP/KBWr4WU",
-	"fQYECxKOa53aU70S8XSt/vcVOKzXNO47NXyDaYhHsPR4Hixf+n29NiMabDynv5BIUM5qndpNq1avReTf",
-	"MRGyr4Db22uS57vNpkfaL0bebivY9fCz1r63u7u/v7e3u9tsNpu1ek1G2CfQoYnHz/b3Wi9a+36wuxME",
-	"z3d3n5NRu9Xynzd3Wi/82r3aKLMLmX3q3VEhKZv8uFtEmReLZfuz29nde/T9aWX2pzVauj+B2R9+y7K7",
-	"c04iOPdUIMYlwiG9IaXnEbrWa5LOiJB4Nq/emhsHrEaz0YQjTKKIR1cjHFwZMLPL6LMbHNIA2Y/OCqAn",
-	"YFk3MYyqf4jGPJph6Qw/x1KSiMEUNMozjFP9Fc1xhGdEkggl7UqmM2Ohf8ckWjh9qEi7pTMLEt2QqIha",
-	"EiE9YMkMY0xDEiDJ0TyOJgQBz3TGjFl69kv4J2yXwxwK4/slzdTo4zgMswO+jsNwgTT1IlxyUNfh+2iA",
-	"74qHQk1oroGlxBezksvAnxJfn1zKxhEcG40kxTuIxDSEj3POw3OJ9Z03peq/rb32zq7iEiE54IwRX1LO",
-	"RK2zV6/NqBDqStxtw2JzDdqaxHmsRmnWa5JLHGZatJr12i2m8oDHTNY6rfZz/fdhHGHV5FhN04T/uzf9",
-	"/0kW0LG9e1+vhVjIAwWYos+qMxRiSZi/GKhuimcIgScKq2ckoAL5ej1AuMkBjeeKvQjJI2ia0kFAcYik",
-	"P0et9jN1Hhutzt7uTrtjh6GcoYiMYwHjbbq8pru8g7IRsyxEEYTQ+y70Pib/3HTqtjv15Oz0wIVIcalR",
-	"SMW0iCVYQo6viYWQZAYUNo8PeKRW9Lxem/CIx5IySzAzMuOKdL/UcBhyfzCqdXb3Gnv12sQ/WPggarX2",
-	"9mE49e1Zu7FjaKBr2ysyaDxXa1iDl8Zz1QjwZMhLtZ3uNGetPVGrJ7+eE5+zQNQ6L5qtPYAuKrmIms87",
-	"zURQSNg03EX2EhrFNIT7RFGKh0d+q72zW1OIUDjmrUZ7TyOwQrZzjvTPA/3IB3rTifZKjqa+cE65kJOI",
-	"nL87Qq39RqtwQL6vI8o//zygDz6gK6QIuHrXFCN8zsZ0Eke57WJZ8SKkeeHuiAqJ+BhZOipoAL/9t2lp",
-	"KbzneCZiNqmCeFeRRGtvQ4jJV0JMHIjf4BDfLdB5exddhDLCG+g9zRcpNacQv+F8Ur3FO0pbam+6xeOv",
-	"BHjsAHxK70iInhd0POxLrRuVQuuu+/7jn6iG12tzPKHMsKIvtSkWx+RO1jpjHApSV3+fRuSGcnV4zW9z",
-	"4M+tek3Q30mt07bXZF+Smah1LIc8xRPgn8Bellz8oEQizIKlBh7g6g9VJ+dY+tMrvWPuKi60DsNZuEBy",
-	"SqyyCA2dRVTpL6i9t//mlTOD2f41pijYygqUk4xaVEwjSXGqggU/sqlk+THaG7bcK/DRTtFO5hTtBEtP",
-	"0VhfoKCVX+EwvHIEoHTXumFo9x6uSKHV+KCU2HFV43QidW/mpjjUPdSXNeYIKlunkxirRpkkoNui0QLZ",
-	"Ri75kZCABXavXkvGMDN2nrjigF8xWLoGQdkkJFdltsJz+JTBVAnEmxB0HjuZMdWaIoIDJT6Kq5XGMdV0",
-	"gbaMRI5U++2f2s1Pc8WfYK546L2ZUvuS+1vTueQI+z6ZSyQjPB5T/yep/1TkH0GRfyjpGnmhTBj70QSm",
-	"clN6tci0n2gejygytTMiU9tfKjIp6dYYHQISAUK6vk+EOOBMRhyMK7dv9Uf9H02Pwo/o3FhNDk7OzpEe",
-	"AFEWUB+D2/B2Sv0pejscnpqPAvmYoRFBimGjII5UKyWJY1/GOLQ+pMYlU4I14mP4CKPPIzIO6WSq7nIx",
-	"50wQtPWaSH+KziVmAY6C7cal4q/Gc67oJpZTHtHfgYPUkYKHMOkNF3NSR2d6Kq8fqC9RREJoBn93T/ue",
-	"2YE66o+9gRL94V/HnBH7J2B4jiPCpPnDKhLCn5IZbKVczNVKhFSQwlnI4HaA77oTsiFWp/wWhdwgLiIi",
-	"DqVQqMIZHAF0Ft3A4IPGJftFnTF1UVCGhLZrrULj8/3dZrMEJsokmZBIA5VQbBUs3dM+MrxBb/6YR0hO",
-	"4crS25nZOqD6dErC4lmt85v6+WO9BKkgBhucVmITxMeARgTUGGFWQJIFNC6Zh67nEb3Bklx30JmlMyqQ",
-	"mBOfjqmvGLfqEwsSQfMZvvPwRDUf4Ds6i2dIMUkXve4U2f2AARj34C81QizUzoGvVOmpENCh3ZNoRMY8",
-	"UvMqCtDdk1FzZG8gqCOztpc7YIlYRpTmaPSYzwPKJpUo5LN5RARsIg4nPKJyOnO304F0xINFZlmT3+m8",
-	"dFPNh4CMQ318RhFwcsIklYuKDU9PbD+oXm7SCOnhxpREeqkR9hUmzTkRCPsRFwLN4lDSeUiQvXvRltmy",
-	"ecRvaKAVIz+khEnEIzQhjERwjel98gQNyHYG7nW1nQQvxjfeqcUxVV+K0PeGuHKPeoA1JPEEANVKkyEp",
-	"2DcWID6XdEaFpL4SBfw4ipSsoP6tDlDjkl0Iog/njeYXLOGCCugMH0w4u5pNxCOhMMoSDiTyTPmyhluj",
-	"tr8T7JK98f5lbQVlHmEhBzxQO1e5z0MrlqDbKWGWDHkc+QTdYoGUwIRmZpDMYt6ToK4u7n9ghtStjGy8",
-	"B3ozGJZvijqZHjgOynbmiPuA5qqlXpz17a3GMkFQdsGZ5W0mkZTTUERLF3qGJTmiMyrhf6qWa3kai2cj",
-	"EqmVpwdGiQUkQHMSaZZ3S1nAb9HW2esDtL+/+xz5fDYPKWYycx5aKy+TZGlnZIYpW8KPjovLimwfRbQK",
-	"zZq65UZrfLG3/hIFqcTeBaN3KJGZ0Za5EbYdMlVsPlQD2aVFakCxGovPmns7baUOrVqplRyXLPLfMUkE",
-	"hgo+uTUnkWfa1BEOb/FC/EnM74zIaNEdSxKtJovkDuZIaZP2Fo3UEDSRoGzcUrLs/VVYHaain5USqhbz",
-	"fucAQXMtf95JpPtZwU5hOaAKvlEMmqHGeBaLTW+V98AbPcPB/uhZa/9Fu7mzs9Pymq0VrHWYiKybwwDd",
-	"XBBuCAt45KVyEjQHTc6FxOdswl/K/Vbkv/88GfzeW7HGX3C0qFqV0UmQnGKJ8HhMfOkKWv5U7bC67nwt",
-	"3SBGJlxS7V7J6AlgK/Gs9FNHGcVh6QrBL2DiyxLVab5SkNKtSACnuyBRlYqmJiTtloahkrjg80id2Jm6",
-	"hQFU2z9/5SoBq46MfFVHWrxiOlBXLS/RZHOIWEOTmVdfHSSgGKleW2LbmKPwKCRlsB1oHh0utGvmGs/n",
-	"IdUX6dNPgrNrEMHj+ZxHEtSYS9Yfg13X0Ju6xk3kMxz24ggN6IIZilkyEJola7SxgURINVZEZBwxgXab",
-	"++iYS9RNlp/HbX6i5ajNYNQsuHyQEnRvpGNJDlTiaFlas0bLEXfTUqSWIMiMJjroppUDHDS0clBT7bkC",
-	"Xui7SqfLHMIqkE+7573hCbrZRSOCIyWK8M+EAdg4llN1l2m8Ni7Za7haOuiVbnmz25jHo5D6jS9zvAg5",
-	"Du4bXwSdMCzjiNznwC10Iot/hORtl57Q/mJw2G8eDbt3R8Ne65fD3uLkU/dW/f972hf9WTgNDvr7/U/9",
-	"28Gnd3Jw2JOD4S8Xg2F3f3Co/v8V7tNb6u/8QvufOB0c9vYGnwbNX4cX8njW3/l10dz9cBiGR8NXs8Gw",
-	"Lwe/v2sdf/J3T4avpr/Ojj/3WbORrLpyS3IMLY2ylVFM3E1KPUT/fwLy5WVjS0P9n5D7ONy+vGw0/r//",
-	"LaXSVxFmwWsaShKdKsZY3DL9UalRYN/bEtsNdMBnM+wJdaWCPKH27+QsYW2NS9bTO9FBf4deL8EmWDch",
-	"GNm9+s0YDD+q3+YhD0jiLQfkQFhwihttZXRxQLXv/Etthu+OCJvIqRFbZ5QlfxeAr6vmxu3eaiafcRTh",
-	"hTYiL4CSlIRTszYLE9hcgao3IR950M/64tQZBawYxe4zWYgUO6KDrq1j77pu/y06IRWyc9PqPLnOUbXj",
-	"BSxDTepNrCaYEt08jgSv2v2TOVbipg9tYJ8VCER6IyyUNpEEQDQu2XslJlu9uw6XxvUcT8h1NqabThiP",
-	"zLXw5MmFwBPSefLkkrUa6DWNRKKKdtAhZ3+TiDI/jINkDVuxIEJNTApr2L5k7QY6Lyq1HaQ05GtntYzc",
-	"SQ34tVKR3U9zE7NhP48jPkP2R8eIo1b/ijAyplIgfgMS7FgQ6SwI4PLQub5Jre2P3BCmdQolbyF/itmE",
-	"CDQi8pYQlixa9XxF1I4qpQ0EbebrKyLE0URLa1r7YBydvH593hsi4WOm1Klt1fuAM0EFyFIKX0jQ34nQ",
-	"Cz/mUmEdaSAFwhFBXO+1Jg2BPBRwuHvmOBJEYQl0cgjFKMgsZPGPmWKHR++PFx/ev25+eH/2Kjjoiz77",
-	"tYzl3p58Grgs97Pqezy8uP0wnDQHh135Ydjf+5U2m4P375pH73s7g+Gv8vjwXfv400Xr+PDd7eCwe6vY",
-	"8AfFqmd7IXn7jo7fVZwLTTkZnuGwir1ms4wz6oiAflBxMIZTgmKtizk6mLEVGA/41sVF/xDdPHuQjgWA",
-	"zLGcpnAEZklLD/hqjew1JWEgKtk9CQN1ij8Zj5Pk1tBk/ANj6A4Uo+UuEljl3ZERFZEdmsdtIzLFN1Sd",
-	"XcZt94QlbMMhOTMSHBFCIROHtp2SMDvomgaKQSo8qP/CHaD+AXrNtZ7tPZXTwuiZwZNAokSaMu0bwB/8",
-	"3K2m2LCBJBWxdAdzsNWykIdM8EiRHLaM5m1YWLCtTcgKirSb+hN+11ClH2aYxWPsK0FBG681tGkDrdVt",
-	"JRGRdaT9V+q/OvhHT5g44lRfeIoIG2stHdAmcXipNkdYSPtIJdsMnHCqydvusHfSPUcM39CJHhC+GfYC",
-	"grndDbFgEt8BzoAPw8+dLRGP4F+tuv1Xe/sa+BvT3flIEaFwxQm9gM6WIOF4+zoR/NOdJeEYFpJhUPqJ",
-	"piWt3KO5MopL3Zs1GtTVDtVhd+raZXRfr91SOT1KPJLO6yp9WVn0aKdlcTQYp+4CYwdNrKMVI8v0+9JF",
-	"1pNdr6fOTHX8yzikBr1WIVn+hr3fu96Hemdr+2OFHNkPyGzOwYf9T7JYYbz6TCDmgTARR3BedFeJTk/O",
-	"h64luq/ZqcAz3UmplaodnmDKwN9iGM9weJQYC9u7aMrjSGzXL5kmCdDELamAkTHrkEGUCUlwoNg3YA3U",
-	"cxTEWs2z7OxM89wZYdIyAHABjQjC2mSPDMN3PxmuMCcRCvmE+jhEfE50mARc0notiuztynN36yYXRl6T",
-	"cPbF+ydZfOXN0R+DD6HSlzHEE+OCUOCsdFsMU3OeNpTAMRax7xN1p4wzBuHERQCzgFBNhOP1WMNxUY4h",
-	"4ylZYT3pj485I5uA73MWUPUpdZQLrdaiN71hB9lbaae5C0YL6zaxgCcAT7FQcrCWEwMzxOnF8Olpd3jw",
-	"toNOIyVRSmE4tlADJJ3JHRVKRIwFQZe1J5e17a9AVOpGWoGtUzwhFQKG+mQdFApNqbSMtloeZQG5I0HW",
-	"eF6l7UxIucGiBaofncUzV/H7BmZ2zAIUkFE8mai/5nE050o52cD63rhkRdcByEn/8iA+gN4VNuxr+EEa",
-	"RrGhGf+c4MifVgmNcRh62tAMzcxTXuOkVVMDquB2siIXyALCDasa50cBh3qPTUIqpijEbBKDFiPJbKat",
-	"DIorvyZgSkk4smEMtzwK0A2OtP1YoC3SmDTq6LIWxaAgXdYSHgK/Xda0yoQF8SgThAmqBCizFNDi4F9K",
-	"UeNyWg6UXlGi3Rsh8e//fqljjpTclE6aiUO6rKm1DRZI/6r+JNJv2P7GcOIOYIwFGknmu16M7aTfk2Qn",
-	"Td+Y6BnN30M8SqdUMBzw2Uj75W61WB1KEhUhuoybzfY+yBsvEzFUzZj8YQDSYpXtrACGno5xSPWCf2Qh",
-	"u6wBdpSGoQXlzFHQg1eoff+u0vjae3sZ41C7lODp71UsLHVYgekJ7nbDjZKltZvli4J3H6VcS/WYaQdu",
-	"ar9axsTOeSSXaXFgIRY8konlYbQot91BGIUHNAwd9Ok6Bfajt+Ha05K5moawQBEGjwISZczPRjeCjapr",
-	"WqxrJaWOUmkUJeKoayZU07700lZwvrZg9aNF2hsd9s4PwLak6QF1zw+28/bEdBiL9zVti2q68s3JDPqx",
-	"ntocHTHZ+/uWGuc/APh/AO7/JJ3+k0C9XSJBu8bIvdW2yHM19ppWW1jHxlbb3JGuW4Uyj+r0Nd66KC7E",
-	"3CWo/N+IjGud2v88TVPrPNXNxFOt8Z5b7SvF1s5qbDne3/U9psvdvWjrZE7YkIRkRmS0gKsbSzoK4UZP",
-	"fRfXX4xL5t77Ai5Wjwb33he9GP1v/fM4xBNxf60YpOnRQW00JXcooBOqBDgjRlzWmk1zV9kBO2gn27S1",
-	"j0YLSQS0SubqoNZ+ptlzp5WzivzEQm22gll93XaceVlTr3AcnlbWMQmPtHPZ15gtSDMPdpaXCjhOlGeV",
-	"Ottser9hb9z0Xnz8stO+T/9o7d97vzW9F9gbf/zSvi/XdFM3/Ddxvzcu2UGJHUpdNp/J4qVWL+aYRoVI",
-	"rYKvvh7xT/xlszlu7j/DuDnCL5rt0bOliFsdEXufRDe/4gHVlhXN5Lz0mZHx4Ovg6JyvtCpZVtnptw2f",
-	"6lYQ4Z6sbBm70Pm2NNOw+ZLcLTJiMEQ9amUtVfvTFF0Fbdk+K3wYqNmXlEvhdZoWH0iu0dOYqdbG16nq",
-	"tQG65tl3lMZeYqzeoJZulyFP34EPw57NpbMUemi0Ptj6TcMGcI/jKpgvhiUQw2HRNiZD7IHnJGXaAHqT",
-	"kunKfivmc/rH+cmxsbRmng/BhVx71T28Ouu9u+idD2vu+5KS3krUjhzAc+8Z1tQ+13h7slHKM/1mibLJ",
-	"lcHaleZMLioGukXm5QBK+Ny6KCnpjWbWzleM/PgOcLM2vffg4V8Job/CgX1hgTyUscthgWY4VLIUCZA2",
-	"a0lMmUA2S1hCc+6LFCempGJNpvXTQpxMNlzc6werRigLLk9tPGsMkLcG3dczYsSK3tXBhXacpZw7M0xZ",
-	"eN99kuXR+3r+QYOVPLSYP+4+eYmcyfe2xiiFbhtc4QriSoLNZbFDWyNczFcHPmvDE+wKHMdjLcGrTp7g",
-	"6YeaG2BVd1iKh3x6zg0R8BY6lmGgkNozD00uHc0GYOV6LoWvJPfN44PojK72NM1gl8AMz8w9HIae8/Jy",
-	"E9lMP1NfKV0VEhVsCOypGqAM1qocB9odIgRIHnl4HyaGbgJqNoPAYwF7WMwQsBTOJGHDtwJTT/DI4BXT",
-	"QywF0kkY8a3AdDNEbAKoiXqrglefU8JkRIlIA5xtDNxS2I0zxKQk2Aj0pM8aF5HJGvpY18/r8oSiFqg/",
-	"hvUWc5c+FnhlaU8VcJyNQ+pvKnto5nFF2VUsFCsJiSwkSGNqMv3JskF4J6Cfvuo32XkB/uDk+PVR/yAn",
-	"vZcM1bFDUmHdyeEiHfe70G6ySNKKcimSTFgOwzPyVPueknxvG6EsyR3xW/K1PxhcDLuvjnpXr/u9o8Na",
-	"XceF1Do1k9WpgOYRSRIp3U5pmk8mXcN9fY3hbUzvQ8b/WNLNwZGSF2D4758Ivl6NOzAHNKfD2XNrXGpu",
-	"VIW2TPJsxMFPXe6b6nKaDj0n1f4GzNTptVzvMO3WpyottfTYDQn5fKnYZt7AZi70xyUZbYFJHjatJJqy",
-	"5/CPRXv2jfCq7rm3xO6zUw/+dyXplr3xzQyTvLBde6j8m9zccILIDYZK385+7ZH8BevMscu6OW8Jv99D",
-	"nGRr+1J+Vsz3b3lWHoO9/iTUv9bdoRpX0pwO+XtcKgO1xmRsWUlkxewuDlO3QTf5xZ/T311BJM1KomQ0",
-	"cFSjLTpGb3pDdEsinZIoEybYhmTTy56BP8pZ6Q3xSqp2En6YnBieje5ceYsUE2j8oDTM5yaZ3JeiKQxS",
-	"VcyInPJAmLgjIO0KCRV4qyVPD/p7b9PvS6l9Re6sJAVUbviBXtxDcmtZuHBEkrQc8BwMw0RpogMN6yNl",
-	"13rTG9bR6YX6n+7w4G0dHfaOesNeHb3tdQ/r6OR02D85Pl8rG1aCigG+87oTshGOMzm01JAKA6W5i0qD",
-	"ILIYNNhzk1NZnF0I/S7JAJYgSpg8O3M8oiGVCxRQ4fMbEi10Fo9n7Z0WOjePn541dhutb4FK5xxEREaU",
-	"3GysCaTG36WKwMam27X1gGTh31C6ebx75/tQJv6c2+OnePej6yFOys5NI5LW8R6YdtncoEu72HbfgO9Y",
-	"i+B/if1hc5bx87z/6OddVGiABzwMjegyIxJDfgH7SPu/TiHcbb74TjXCr6LhIZc49Ezm7UJaAvXRyf+n",
-	"H6gkLlmFSxuQn76v21uVP+17PQS2ANIGV57tsvTygkab3lziiAq57PrKFWf6KT//vAx/XoaPwgceYEoS",
-	"yE/uyp/WpAdak07Ohz/tRw+1H22IvLTQoGfL52xiLDJd1onlTKuxrHX7VcdvlhYdTsH4FuG2Dwm0XQ2A",
-	"HhWZ6hpQefGGMEXJ32orNtyDI7OeFbuQ1h13YfgW+7DRBqy5+mTljEsvzVC6cYRdklD0ChKKljwkObOp",
-	"Rd2Uo5CG03Ytia06PhledQ8OeqcQ41QeYXVxfH5xenpyNuwdXg16h/3u1fDX054TCZXkHU1jmi5KM6B2",
-	"Mm9R7mZhLhLKif8pZE7NQNJAyQPuzg/7viWbFDYbHrUcPT9job6pBKeO8pjH7GHOjyvG5VXSvVDNT22k",
-	"/lp+Wl+fXBwfZs6a6Qhhcv1D9Ld1CP5vmXl+mOPyWgFUOClJXqGAE31SINrg5yn55qdk5riAiruVJI/y",
-	"0JndopiZlFFIUOYTXVcjeTDlpNECs9l3ZXTYXM3/3rZsHpEkAZg3hucCmz5vkHhyNaMC9iiXsxD2znxC",
-	"XrZ8ilM5Jc/0Ts96ByfHh32lbVy97vaPeoflckpv2H1zNeifD7rDg7eOeOIkS0uZ5qkttQPLShiDyXuc",
-	"T99mK85lxZUzJ9kZGhHCEjCyxAsWMxz+KIz21KESZB6VaJabFPAxyn/aTO0x4Jd8h2z3D7aFf2+nPsKS",
-	"eKG1M25w2FXHK+iYr7t4ltacIXc+IUHpyT7rDntXR/1Bf3jV+9dBr3fYywo2JaM00GlIsDDlVRAeSxKh",
-	"/aYtwvKjHLEh52iA2cK+bxfqikyxkfAbB7k/o3P/IhZsqC3kQXGh1b1zZYi+R+5hSwZ/M7NSrgT3+sfr",
-	"zHZcw8JkincHZE5YQJhPSeYFN7y7T0H9FtanTO3lxweyqmqzqQH8wOesAZZ4hAW5Sjo7Cq35likFDM2K",
-	"V0H/eNg7O+4eXfXOzk7OMreAhUGS2ZxHOKLhwt2Z5EaA+wByLIdYQvKU7+KNJGWSRAyHZRjqm282D9kD",
-	"sNNlKGbkbq5LPsMAiPsgwAbfN2q+/pZM0GfqVkFD5KFlOPmp9H/T2yBm2JQB2lh1tNleoLpSebooHiFy",
-	"N4eMKLpV8ahcHHcvhm9PzvofcsJkN1OqydRwomlGoHTs7y13VAlCbNIoXALUYyAlSX3zg3CKC4csFYPI",
-	"gu0ArMhASdfG+PFjMYv37997DuikJPQgixjAK0GU6dRE+ZqGpnhXRHA4e3mZBDbgOV1Zcvf741vziPvq",
-	"XIxC4pmq0A/jX8lqivxLP1zXp7N4Sn/pHvUPu2Dmsvd8Wb6DY2h31Tu+GFz90j26cD1xNhVmesL1lDYV",
-	"HGcE8XEHLam+Uu2S03GMSSo1p94zXLHi+5G49EZAWvjSfYCKFyZ9/Nfuw+uTs0F36OyBU/AoRaP9MU3K",
-	"5xTfWILyBNuYJTdVmtf/e8F4SgplUu4vJYTyMJyf9d5d9M96h6tTfUAWDfcic1N6mJ076h2/Gb5dmtFD",
-	"5yqxe2aLnbUgh36r2UT+FEfYh7qsf/Fj8xh3rMNCkSkRX8zLeEvC0DOZGUexQ+GCzLC6elK0/BTUv9WF",
-	"l+w2IBfcWYfW8rE4mBIfzBU4DE/GcP6WBxJnO6qTVpaZKTGtLJCvGmqH9ZzzEO5FqLmjdn0e8TmJJLU+",
-	"c8MFSgdNk1Hbdvn+avxzieXKZO2nSUOFZS5x+E+yEKuD1T8TqJWWlmjKFqJv7zolEZqlJRHMT7pwWNkv",
-	"H61/smeZa66GD2i4SRikDvVTKE9qNhXxQpYNZfgY0t9GNhxTCcW6AKyTwLsk61ZZBvI0B+dvZu6PBTgN",
-	"lCa0rXzHs2FtCdAPg4+ODaKy6RorAPQ5G9NJrNWiQi0EE2dXXLXxJWbXbSJZE4IxdYptvKESSN1/5yoW",
-	"2LWlTZYj3KytEuOZXHkFCCz7MN6WeRxB5SA/k0BvtEBpJdf8Ea5IN5KWJMmOZTs4oO7V03I/lMn93dry",
-	"Y1WvOZkJi0Uwk0q3U6xvpViYiFoDXVUF27W3/QyqlySUZvZbJ95LjmUJoZm8gxl0rrW5daeSrkVg9YY/",
-	"fKcL20urk3r0D1MMG8C2oFyawrTO0mmTJMDnB9UcXVEG6jG3CFfkO/2qA+hm/S9Z45o5/7N7oiXZUtKH",
-	"T0/dMp3ZArAZgHWR6/qmRaptuYDi7Dq7XeV8mfJS2Xnz9Y9aFTtNNqrKktsno0do9JXtVomUlPO4JPwd",
-	"pxRu+yyTbnBgC++dOk10ucOckSZp6QxdJgkVVr/upSiz4lomFD5XvcM6diIyVjy07PyFWEjAVtkdkFQO",
-	"tVQBMTPmstRyYvLQIYPIdBUV6k/CF6B8g9KXyhcHdSYHJfzhSH+qXhhlaEbDkKbBB+59tfx6SlTFL9W7",
-	"69jdEB7xWOY3JmH9KTIO9JboPK9OnbrWfqO1CXNUhzUrq2SxbwSWeK6uG36rb51JhHUwQsw+M/VjRlqB",
-	"tg/mk1UcsluSZue7YoZpCa4lxA+Pn5wq3OrGtCkLt+hsFkvthX40ui+7tS8KtaD10Xuc2uAr7+nQViZe",
-	"zb11EeO/1E2TZKHYnBDCtLJ1UEkBu53dvQ0oIHfwYEcyt189MSZnashVnMvkCXG1TElME2vx0UJMVgoE",
-	"k4BNAlC8LdWPa+Fcc9jVrQeqTR4XZm7oXw1xUkc7C26mhrabBSytOV6AS5BwXHL9UPZZMXFz9eV5XEoF",
-	"JluSeLrxQYyoF5ExiQjzy0mkAvZzWeo9Glbl6E3Pn7k2XFVbOyDgH8YDkbk1qs0K9dqdpwb0nFVoUSrN",
-	"Rm41ESqc6p5qV+yUMLfbLE2zPCKKREEz3HIyV/v5LM915yejeW274Lij2x/BgpyxmiSruk/QnH2cv+nJ",
-	"MjWKnWekmRqlK0/Z0jwAtfomJRBr98WCh+sfT6XZ2nLLq2uCJS2XHevMkGVnvMLSZp8Wm6AS1+SmfUlZ",
-	"VGqHQ36QAfanlBEvIjiAndeDQWOX8kt8IoV7qNI86oiRenjTUlfyK/FBrLWdgJZDGKl8TyuE2rfxDLM8",
-	"wLa1C3O138R6v8w2FjDh+FAeVBf7m4g0jpdmjSu/EKn0SLJe4ghaXfRR13TU3tHyGqLjiM+Q480wsf45",
-	"kXCVw2mVPGIOQ0oi6fa6WK08uoZGSwxTUj9YKOpWGCUqtI3D+8rTnGquhZFTVBV8moXtM+7JsttWVz8G",
-	"G5uP4aZK6CgziZHsigV8qw7sYfqX4vq3pkbDbcRtdW1ppy9MlAsgWr7Rdgi7krIdrXQI8Nk8IlPChLpR",
-	"M6p5wplhrWIhJJmpKy8q8zFBF7HMlkNZQG9oEGdMLnoqgSYRj+faZutjSSY8Khp6KBtHJbdqX/0sZBSD",
-	"8oky0cdbQvIIT0hd2xp1ofPt4uLBhbBO5ZSio65mplh9i+d6FtR2PUzZ5gkdvluGXhPYm4U64LdMyIjg",
-	"GbJdt0vsZsmYX7NuO8zHMidcRj2C7XOAKYV0iSmF35AIh2G5F8iM6sjH/HPWnmIsLDNMmSQMK3E9IyRD",
-	"+6KSCmS/Cknn0KrPxnztG8us2z1xj3dbxXP4srIKqmplV32zPDTAdjJxAbpXlRslxUA6brKqumUWZQSQ",
-	"pIQokZ71FzSP+IhUey2XkZBNffEHEc8mhJAs7ZFJwdnWctaR7k86402r0Ww013eble132e4OyIxHC6Cd",
-	"ohAA31CsDeiZcMnsBuMw5P5gVO0mnumRoJ2JTmVo8CqTInCv4bpDxyEH1cMsWEcmqAVP/IOFH5ZxXsfx",
-	"ytWYSsB7c4B83TyTZW9/lSFbLEQZPDpMwkDDR0qGJwHSwuOUoJPzIlzP2o2ddeCC4IxuFSIzExs0Jg9y",
-	"hcSRLM7carZ3G89Xz31fShZl6nKimycZLR3d3MrSGRmUBah72rcUTdmkccm6Yeik2XOyOVHmh3FAtHBp",
-	"hEBu838gPlJMwaZ6UiMHZBRPJnrQIk0mAVMlamS6JG0JkRyZMC+bfEqXW03Zz00ry15uWg9T1wpmaFeO",
-	"Nt0blwweHBMBVHWdhmhdpyKgVlB0diyDMRDQTZAXm6CQT0QZnr6BQvgAVYzcSQgydI5PUf9qXLLTiAj1",
-	"A3jYQaksU+CoQIQpRSVwMSK5mS+yD06xH3Eh0CwOJZ2HyT1TqOD/1aqeq9k5pFjGgk8zdqDcq/TkW3rm",
-	"1D6D8SstaJ4n/ikWx+SuxCT/fkrklID7I9L2Q8TUtsxzJgvtoTVLHXEeEswgXxgWpxG5obzsPi8ZfG4a",
-	"FyYY41CUzrCWvyRFS+ozIXfyII5EmYHrZI7V2fPhs64eT5w0rAkGUAzvTz6ThSASpca0xiU7UeQ3N7QI",
-	"ZGhwrOBU2MpTEFn8Y9b/xOnR++PFh/evmx/en70KDvqiz36lJ7S/GBz2m0fD7t3RsNf65bB3e/JpcHvy",
-	"qXv7nvZFfxZ+Vn2Phxe3H4aT5uCwKz8M+3u/0mZz8P5d8+h9b2cw/FUeH75rH3+6aB0fvrsdHHZv+/SW",
-	"fjjo7/dneyF5+46O35Wd1nmpbmyvasCDCQTcanmUBeQul8235dyerdIoJbPrD9yPDNFsuieWPB9pXxZq",
-	"T75yX+6SfWGvFh/+9WvFvgj6O1km1egEwnMSFQ5TuwmuPrMjxnu7ZH9A1uhb0+g6aYsN31TKnppcFJIW",
-	"LxenYMJT6LhywsL4z1fGmrqM1+AGkJmBNLOK5Xx4bS9YSo7LPGFjGgm5zBVGEDQp7GviBPu7+vKydRk3",
-	"m+19BdrLdnMDn5eOVlm+AnDHrljA84cvgJVeR+4CUi68xeIwRHSMOEuXtb1kXe2116VG1j60zA3nMMfK",
-	"281da5ZDuetNN3L7q9axynua+iS/FdHclx4R6U/Xjuqb40hSHIYL7VPUDjwbdAH1erZ1wKsbG9Z6xECX",
-	"xiV78uSYS9J58gQV69hSt63x7VKBLo0D9bKWuzr+gLjBR15xJh4EDfDdHxR9WCQc98FCoVKuDbdb9Wxi",
-	"SuVSvd/RKmEoaJ+5qdo7u6vuKhqEJF3T0vlUUycPRPJiQk2+WdwcFWK5SQPgMc1cq0l7pSVD4rXhgbYZ",
-	"gCIy4zeujpYHbeX8ks4Ij+UKe01CAklzZ471xIulMOaFjDU2rbVy2ltMZUXxhxQ2MMQh7MIID8YwlTo4",
-	"PzNn+/k6kx7GOoz7uBJSNSsScxCMMQXWq80DGbAZZrwszLMJ/7fpE596Lc3aUnI5mE85Y7F2ZZVFf/70",
-	"Zv30Zv0p3qwkZdF36JNI1/YnOSXQlq7wgMPtR/NPLHE+OW684sq0/ztJZ7nMQ+HP4wMeLb9iD04vkK8a",
-	"odI3c89XadUTHvFYqt1ZNouJlHMab3SdaxfA6hCzxKtTyqgvQJ5cW5CHajilQvzFcLv2U2D/6wvslc+F",
-	"7HgfS6ko8VZXsg3tYV56NgMjzawMsDNjJe0zzG+605y19kRpOKHpcG5EnaJvxi4SlUhDL5qtvTWE7Gj9",
-	"IHhzkSDTq4qJN593ms2HB7+na0oxULqNbvxAYfnmY8WrnfRKLDjflnrdaqtdaaOYlsWHvVI/J5cRiLQz",
-	"kzx0mhkV7iMPj/xWe2e3bIJJCbRvOIpiBsRQttIJbzXaeysxr6C3AJSKLYL4cUTl4hwyEQHGXmFB/W5c",
-	"FhAGn3S1nFxmLMWbcaBIU0i1wTcEERbMOWWgQOlER7VObaRGSJc9lXKurTmCSG4nHUHeo9eW0E67573h",
-	"Sa2QJhl+RlunIZaKIrzuhHGlraNzAxQa8s+EiW10s6tTbzUuWRcByKSuWWAIjlZIy6WpSkOSAa5xyfRa",
-	"OshkZLrZbczjUUj9xpc5XoQcB/eNL4JOGFY3yT1w0hRk6JOHWSfS0XQOrmsfTqxm+DY+HTzWphJHrV6L",
-	"o9D0F52nTydUTuNRw+ezpzjyp1QSJbZH1uZWKyQQ6aKz3vkQxlRAzjDD8Pw49/bBxK+rmxYdnF0cOlkO",
-	"wIc7pqEkkX63bOodUXBbXrL/+R9kQvEPuRI91W897E+TR70m2LhzyTz05Ek/ePKkg4ru6OQZlG52jGdE",
-	"NbQFHPCM6A+v1L3gfHFvc/2YQLeDy0W1O8g8nthakqXJTA2PhxV9K96pRljrRZlBxatYaAH5LA6JUD96",
-	"KBkQTnbhqYNqosAFROvnECk7KzbPXerw/gGpy5x5qA8QpYXN8k8ozCIVNfySxESoH4dTqgkvFsTJGpMG",
-	"TsDiTCyE48B2GgAPIBNK1E6raf7HzoHO9aeFxu/F2RE6xXLqLEFh+frpTevpNdqaR3SGo4UpzWX2RGdZ",
-	"yfdwEth00E3r2qZI38IhJLE0m5pdTD+9StTY3bAsBsQd+rqk7JraADeEQ41kmqePhnXRBV2QLeB+PCNM",
-	"6lJiYECHryGfqL6vIoI/w/EyfQxDRzP8iUfJVJT5EVHDWKDUlh2SeUQMS4bCY8/3XuxuX7L3UPuNuREw",
-	"SD/4heYkqCOcAf6WhqHFAJzWa2foDrgzr5EiMkCDCQ+xHD87NPQ+j5kgsoMu42Zzx1fEC/+CQZICaepi",
-	"8dS39HCpBcNawFADFkAY74iyz3a0OAr1YP+HIhK+vKwZ4yuPPAPrZU3Nc3HWT5XXeYh9QJ+aQpM9SWJZ",
-	"BJqScI78kCpRFc3oRBEtkhwxckuSPRBoRMY8IkgAdJYF2uuneJjMlaXvm+wlY1ii20Iowl55uyGv5EbL",
-	"jp1bFzLlB9XP5SQv7DMcKx5YvGhS+Jdn638OF3PineiXYR3EuGB0PL42jV5HeOZ8Pewd/2o//ev83DuN",
-	"uNQWwA5q/R+a8YC8HIXc/6wbncuI+tIbRpgJxWk8u/wOmuE7D0/Iy53W3s5+s9n8P7vw83ikLx6hx7DL",
-	"tF29Ux5Sf9FBARnjOJSeiHz0N0HC8d90hzMyJlFEoqSh0KvgEZ1Q5imy9MD/bH7RvU5JBLlEORNJRx/P",
-	"SIRfbm3X0Yz6EZ8rbQr+nBBuYw9fbm1fg7AQUp8wQRwJYNAfFm58PifMlCLk0eSp6SSeqrZgvZFhXnh4",
-	"gyW5xQsn9NLIo6qDGg/k49pOo9nY0SlOpiAEPgVh7imYC5+m9rP7eukXqI277PsXm5/vvqTR1D4/yH9I",
-	"U8yoL44Vb0Jkma1AV84VpW/z0tQiIiluJhxZZrQw9y1cbuog1S8ZiDtxGHoQMCaIkrNsAALLXtMQJChM",
-	"iAjEyv37Gs2xOgISosdqgO/IZD0zL/4Ok9d+SVNRmRAsbfK0a7KtwmhJ8rKV3U7xhJyqP9dpfE5/X78x",
-	"iGKvAZvrT6CwvGkfHsn1G8OGrd1cxwit3fw17PjazfvjY84IRFOuv2G6PF2hbPKa/Wz7j0optAF6nS+1",
-	"drNZZZVJ2j3NlNO+r9d2mrurO2VqUt3Xa7vrzDTCgWfDXKFPa3WfTB5s6LS/3uqcEpGqW/vF6m5O/Zb7",
-	"em1vHZAyGf9dPRsOt6vt/vZRbU+azBPeATvMSumNeKKYguXrtY+6WmpQygLjiIlEiklyQaVKglviGG0x",
-	"nq2iv61jVsdxqJ10U+JrUTTtI6ACf/rG1eav0i9H0Q3FUPKpjOMpevzJ8f66HK+EhX0VawEifjhreQib",
-	"+O7O+xsiy06mkz2g7PhX1ja3HEAd+CVVrcu4gS1vrVoUKoDboHXIz1Cscl122o3gnx74HKHsrk8oFtwH",
-	"3SjZ3SggH69bDb5sHzbkYcXMuSv7FFPdruzi5LfdsBPIvc7RnvOyWE2dUU9kMuSlRdIhIXGSbkZr7lgI",
-	"OmEkQBjF2tpnLHyO1U9L22ANydjIkjFiyWdYqbRhuECCyHz0YeLHLSPGTBbAP+ju6QdkNueQ4+yfZPFV",
-	"whvQwCseLKpp3zahRDzVdkgvSJKD5I5ea92j5xmL5l9DlmuvM1dJHYLv8mLQFJtPQ1lkQo5qnFWzTZbS",
-	"stwD6neROs9HC0SlsEczPZJg0HryJGvH7jx5csk8dOjmutH29NSVXGZtLhxJvYzHPJIfH37HeDal68OJ",
-	"dk0ZRteEhh5rkJzP2Tik2jv6/dGo3sKEkCouyZXWmmyOskpqLFDQGyL/WI7+X6Hye6agLvmjZfMHnKAf",
-	"ROkHOdSh//7h46j9+aO1tr5Px+l6oJa7+GqV/w+Tuh5VSf0zdNTNz8F3rNV+a022mLvxm+qxX6HG/kla",
-	"rJvT8utV2EMjYK59B/71dF5bWT///D3zgoxAxShgjWkITQOZd8Cm9L3xCVkN1gRtLJOqV4SHoC07Fp0w",
-	"HukAEDvddknwiP/AMNDsCXDf2v1hXPxBYtPXKM2w8dU68/pXhs0k/MfqzH+U8LSx1tJaQy2fRxDtChk1",
-	"PVMb8sdT6fM8ZJXiNI9LFKfX8Som1A1Dy3psZTXLI/56vCfzPuDHZT4aRT+5z0/u8824j8s4lnOe+3ot",
-	"DcopN98sSQ1qnlGaPLAmNagbtK+DtSD3N7x2aJgwuSR80HAVLfsLxVUKrzIRFs4LtFEsbQiYuGRppZdc",
-	"YtIGMjFnJNCrhGj0YrR3mRYbyumBeee2+dHU+PH45wefsL3mztrTwNu/Amk4Yf15ynibzTNpCUK/ajP0",
-	"EDq5F0sp4pzO5mE+VSGSXJECiWaUJZVv7KMTKlAUM5OKDVS20QLxyJ8SiB/mkUBbIf1M0D/jEYkYkURs",
-	"lw5owspJhMSUx2Ggg0XNq5Py0Cu9yIfvqAXT7ulDTvvOBtOU7WkubsTNgFm1i5H7LHqNg5175LlyOwkO",
-	"FqqR5tpIRng8pn7jkh3ox884DJEfUfDiZV/ypkwhwBKPsNCiRMn73kpiKSxOz+4SBY9N5R4wHFAmJGY+",
-	"KSOR5JX4w2kkQd43JpJ0npVUknv7Xkoma9wpcAdpCSyXFYbrjYV6CRBdrdsWQlnxnDZsOGZAbp5+MeGp",
-	"91C7OKLqNgVMZ14DQ9CufadVfBPpRrdLbkpQuGkTFXAFxT7iQWxCm1av1eezP26tH5Ptqaj/B094dBR7",
-	"phRp9hVRScVY82DZspd6etDrcOzMhQ5E4gxoiOT+4/3/CwAA//8y2dnA6xoBAA==",
+	"H4sIAAAAAAAC/+y9+3IbN/Iw+ioo/r6qlfzj0CR1sc2t1JYsyQk3ukWi4k0iHxmcAUnYQwx3gJHEePXv",
+	"eYDziOdJvuoGMIO58SJLieP1Vu2uxcGtG41G39D9qeFH01kkmFCy0fvUYHd0OgsZ/ntIJffhHzKZTmk8",
+	"b/Qa+zGjihFKBLslAbvhPiO3XE1IwEY0CRWRiirWaDZuaJgwHCSmImj0GnuzWQgfBJ2yRq/BzyaRYKSz",
+	"Q87iqHF/32z41J+w6wmjoZpcRx8L88JHwiXR3+fuDDBlIhu9hv2Go+FCg/woJ+w2nBPzySzfHSmgilat",
+	"2fTYU41eo9vubnvtjtfZGXTava12r93+tdFscGjf7rzqbm3THW93+ML3XgavmNcedbre1vbO7ouXr9p0",
+	"6AeNZiPk4iMiWLJw1Og1nuuVyOcr9b+vwWGzoXHfa9AbykM6xKUns2Dx0u+bjSnTYNMZ/5nFkkei0Wvc",
+	"dBrNRsz+nTCp+gDczk6bvdxutz3WfTX0tjvBtkdfdHa97e3d3Z2d7e12u91uNBsqpj7DDm06erG703nV",
+	"2fWD7a0geLm9/ZINu52O/7K91XnlN+5ho8wu5Pbp8I5LxcX4690iLrxELtqf7d72zqPvTye3P53hwv0J",
+	"3P25xtNZOE4H+AlOpfnq7JNtr+KENRsfGbTXQ/VuOr0Vd0Gp8FoyPxKBbPS6221cT3Qr8su4YLFdh4gU",
+	"oSG/YZX8Abs2G4pPmVR0OqsnlRsHza12q40shcVxFF8PaXBt0J5fRl/c0JAHxH50VoA9cdd1E8M4+wdk",
+	"FMVTqpzhDbpFpK5HUSKqMQ5g6q8VkwTFNtngoyj22bWhQXfcN/BB824yojxklSPrL0RFBAeyYOjxslkM",
+	"lFXzWByVbokihrBBj0wTqciQEThD0YikjK1J9AGC/6e+4jfu/IC7BeQKiCnTawX2TKNs4BlVisUCt5/H",
+	"xeHP9FcyozGdMsVikrarmMeMRf6dsHju9OEy65bNLFl8w+Iy2bOY6AEX7tYsicdMg+OMmQiaqEkU89+L",
+	"kBxzKYH7RjGx+0GDKRdERR+ZqJprurhHbtL0cqq44PH8OrdXaSK/ohmMPkrCsEDSSRjOiWavhFbcJKsI",
+	"JuSY3pW5Nkxo5JSF3CgRFdKKP2G+vlq4GMU05ZXwj4ApykP8OIui8EJRLZRNOPx/Z6e7tQ3XWMj2IyGY",
+	"r3gkZKO300T8M9nobXdxsYUGXc3zogRGaTcbKlI0zLXotJuNW8rVfpQI1eh1ui/13wdJTKHJCUzTxv/c",
+	"m/4/sjl27G7fNxshlWofAGNBPVMNqWLCnx9DN7jUpKRj1ug1zlkAV4heDwsMvpFjJzO4/6SKYjrO0UHA",
+	"aUiUPyOd7gtg0K1Ob2d7q9uzw/BIkJiNEonjrbu8tru8/aoR83cKEITU+y71Pqb/XHfqrjv1+Pxs34WI",
+	"SUWHIZeTMpbu750fzEUn51KxKVLYLNmPYljRy2ZjHMVRoriwBDNl0whI91ODhmHkHw8bve2d1k6zMfb3",
+	"5z7qAp2dXRwOvr3otrYMDezZ9kAGrZf395rQllyuyQwaIZ4MeUHbyVZ72tmRjWb664W9+V+1OzsIXVwh",
+	"KbVf9tqpJJve2ygsWSlpmPAQBR6gFI8O/U53a7sBiAAcR51Wd0cjsEb5cI70twP9yAd63Yl2Ko6mvuXO",
+	"IqnGMbv46Yh0dlud0gH5so5o9PHbAX3wAV0iReDVu6IY4UdixMdJXNgukRcvQl6U9o+4VCCSWjoqqai/",
+	"/beZETJ4L+hUJmJcB/E2kERnZ02I2WdCzByIv6chvZuTi+42uQxVTNdQzNuveu0yxN9H0bh+i7dAne+u",
+	"u8WjzwR45AB8xu9YSF6WjBBGd6qB1l33/bs/0U7UbMzomAvDij41JlSesDvV6I1oKFkT/j6L2Q2PEpn+",
+	"NkP+3Gk2JP+dNXpde032FZvKRs9yyDM6Rv6J7GXBxY9WBUJFsNACiVz9ofaFGVX+5FrvmLuKS63DRCKc",
+	"EzVJ1W5s6CyiTn8h3Z3d7187M1To5TVTlNT0EuWko5a14Vhxmqlgwddsy1t8jHYGHfcKfLRTtJU7RVvB",
+	"wlM00hcomgKuaRheOwJQtmt7YWj3Hq9IqW0HQSWx07rG2URwb8pKKwx8WWGOoLZ1NokxpVRJArotGc6J",
+	"beSSHwsZugh2mo10DDNj75krDvg1g2VrAOtHyK6rjNkX+CmHqQqI1yHoInZyY8KaYkYDEB/l9VJrKTSd",
+	"kw0jkRNov/lNu/lmrvgTzBUPvTczal9wf2s6VxGhvs9miqiYjkbc/0bq3xT5R1DkH0q6MArTbplqZ4Vx",
+	"yegGn3Fd5PU5O7Ws8bPDN/DA+jm9dpJR4Q6I0xOuzoEGG7126yX6+MxntFlwKc2fWy/bzQYwomO0Y7ye",
+	"K5S429svd17s3t+nUlOVSPq1iY3VDoV6wXE31b8eUXDs5gTHrr9QcARqMaaXgMWIkD3fZ7C7QsURmphu",
+	"f9Af9f/pUyn9mM+M7Wj/9PyC6AEIFwH3KXr3byfcn5AfBoMz8xEcyQL8fUAtJEhiaAX6CPVVQkPrWm1d",
+	"CVAvwPwCH3H0WcxGIR9PFImZnEVCMrLxhil/Qi4UFQGNg83WFdwyJsAF6Ma4v5CPNgnAw4TyBvMZa5Jz",
+	"PZXXD+BLHLMQm+Hfe2d9z+xAk/RH3jEoQPivk0gw+ydieEZjJpT5w6pT0p+wKW6lms9gJVIBpHgsc7g9",
+	"pnd7Y7YmVifRLQkjg7iYySRUElBFczhC6Cy6taezdSV+hjMG1yUXxDjel6Hx5e52u10BExeKjVmsgUop",
+	"tg6WvbM+MRxSb/4oiomacJluZ27rkOqzKZlIpo3eb/Dzu2YFUpGrGZzWYhPakIDHDJU5aVbA0gW0roRH",
+	"3s9ifkMVe98j5+Z3QJecMZ+PuA/XF/RJJIux+ZTeeXQMzY/pHZ8mUwJXhYted4r8fuAAIvLwLxgBnOEx",
+	"Q/cqVSbuSnuGyZCNohjmBQrQ3dNRC2RvIGgSs7bvttrtHDYr8KePxqHwo4CLcS0Ko+ksZhI3kYbjKOZq",
+	"MnW304F0GAXz3LLGv/NZ5aaaDwEbhfr4DGPk5EworuY1G56d2H5Qv9y0EdHDjTiL9VJj6gMmzTmRhPpx",
+	"JCWZJqHis5ARK4GQDbNlszi64YFWD/2QM6HAGT5mgsV4jel98iQP2GYO7lUv8RQvJmSk10gSHjSqoD8c",
+	"0No9OkSsEUXHCKhWHQ1J4b6JgERg/8f7HwQiP4ljkJiIrw9Q60pcSqYP543mFyLlggB0jg+mnB1mk8lQ",
+	"AkZFyoFkkSlfNWhn2PW3gm22M9q9aiyhzCMq1XEUwM7V7vPACmfkdsKEJcMoiSF2kUoCYiOZmkFyi3nL",
+	"giZc3P+kgsCtTGxYFvn+eFC9KXAyPTjjlTtzFPmI5rqlXp737a0mcrGKdsG55a0nkVTTUMwrF3pOFTvi",
+	"U67wf+qWa3maSKZDFsPKswMDYgELyIzFmuXdchFEt2Tj/M0+2d3dfkkg7jTkVKjceegsvUzSpZ2zKeVi",
+	"AT86KS8rtn2AaAHNmrrVWmt8tbP6EiWrxd6l4Hck1RzIhrkRNh0ypQpsX1Ou7NJiGFAux+KL9s5WF5TC",
+	"ZSu1kuOCRf47YanAUMMnN2Ys9kybJqHhLZ3LP4n5nTMVz/dGisXLySK9gyMCOrW9RWMYgqcSlA3nS5e9",
+	"uwyrg0z0s1JC3WLebu0TbK7lzztFdD8r2AGWAw7wDRPUjzXG81hse8t8KN7wBQ12hy86u6+67a2trY7X",
+	"7ixhrYNUZF0fBuzmgnDDRBDFXiYnYXPU5FxI/EiMo+/Ubif2334cH/9+uGSNP9N4XreqH8zFoyZUEToa",
+	"MV+5gpY/gR2G687X0g0RbBwprp1MOT0BLUaelX6aJKc4LFwhekdMaF+qOs2WClK6FQuIXyVRVYqmJhrw",
+	"lochSFz4eQgndkqVAdX2L165IGA1iZGvmkSLV0LH08PyUk22gIgVNJlZ/dXBAk4J9NqQm8YoB7aJKtje",
+	"09ks5PrmfP5BRuK95ijS+Kr0y4N0b60esXHTIYkImZSOl59EasLiWy7ZZnHkGxG0zI3auun8r54ICKS+",
+	"Vfd/7XJCIC9q5/77lbjp2tgA4tM4npP3+k8wHbL3hAupGA2AC72X+ieYihKRhGikIe+Nf2JPvW9diSvR",
+	"H6HB3hwhkEwM5Mi/yjhqYRd06SVCJrNZFANJmRVKQuOUucFgMVNJLCTZbu+Sk0iRvXRLivRSnGkxueSo",
+	"xKy4epAKElpLb1QRUr6jOWprAVmMuZsOHJ8SfnrkpnMlylpnNaiZRaAGXuy7TE/NMZY6kM/2Lg4Hp+Rm",
+	"mwwZjVmsY2wRbAjohftZ47V1Jd7gddkjr3XLm+3WLBmG3G99mtF5GNHgvvVJ8rGgKonZfQHcUic2/2fI",
+	"ftjjp7w/Pz7ot48Ge3dHg8POzweH89MPe7fw37e8L/vTcBLs93f7H/q3xx9+UscHh+p48PPl8WBv9/gA",
+	"/vua9vkt97d+5v0PET8+ONw5/nDc/mVwqU6m/a1f5u3tXw/C8Gjweno86Kvj33/qnHzwt08Hrye/TE8+",
+	"9kW7la66dksKTDoL2tZPErJNynx//08K8tVVa0ND/Z8w8mm4eXXVav3v/6mk0tdgh3zDQ8XiM2D25S3T",
+	"H0E1RJvlhtxskf1oOqWeBDEBZSTYv9PzlF23rsSh3oke+Qf2+g7tnE0TXJPfq9+MEfQd/DYLo4ClcRCI",
+	"HIwyz3CD4+UIleuoiE+NKb07YmKsJkYUn3KR/l0CvgnNTUBFp51+pnFM59o9MEdKAqmtYe0wJk6+BlXf",
+	"h9HQw37WywpnFLFilNWPbC4z7MieZay9Z++b9t+yBx5jeGny7H2Bqh3/bhVqMj9xPcFU2BuSWEZ1u386",
+	"oyBC+9gG9xlAYMobUgkaUhra0roSb0H0t7aEJt5v7yGS5X3+iQAfiyhGi92VePbsElwYvWfPrkSnRd7w",
+	"WKbqdY8cROJvinDhh0mQrmEjkeBIp2NWWsPmlei2yEVZUe+RS6kXY1cr2J3SgL8Htd/9NDPROPbzKI6m",
+	"xP7oGKZg9a+ZYCMONsoblMpHkilnQQiXB+bHYZjZM9kNE1pPCqiixJ9QMWaSDJm6ZUyki4aerxnsKCii",
+	"qDwIX18RIYVHEdBba1QiIqdv3lwcDoj0qQAVcRN670dCconyIeCLQDSR1As/iRRgnWgg9YUa6b3WpCGJ",
+	"R4II754ZjSUDLKGdAYNsSnIYm/9zCuzw6O3J/Ne3b9q/vj1/Hez3ZV/8UsVyb08/HLss9yP0PRlc3v46",
+	"GLePD/bUr4P+zi+83T5++1P76O3h1vHgF3Vy8FP35MNl5+Tgp9vjg71bYMO/Aque7oTsh5/46Keac6Ep",
+	"J8czHFax025XcUbtxOoHNQdjAJZSrV86eqWxf5jYho3Ly/4BuXnxIL0RAZlRNcngCMySFh7wFUxsdyAu",
+	"6Ou1BroLFnMamgvIPPSywLE7I21YSRJtvb68ed+zWlMc3ZJRZKwowzk+gTI4AZtKyAXDTiJAaa+HDf55",
+	"cXpiW0XDD8xXTuO8piVvanbaAF8txuhuVo7Rf+kVVEs0bzgLA1l7LbIwAG73wfhcVWSNjMY3NMLuRlQF",
+	"+ZQF1nDj6AdwGA+MFjBkE3rDgceJyHZPWecmMpNzI+myG3h6hW3MIG/Bol7slOuTRsilwqRp30L26Bcu",
+	"dbiFWldiT+REcOyB8Xs6MuGDdn2jPX+73SavaWD9T2ZhF/m+0jDF9zx4TzxifMXlo7RhLDGG/QebmmLo",
+	"lDnd4E/8HaUC58OUimQEnrfYODOMnpI2wL/JxoJXeZt/N6qRLKk/5Kab7qHU5G/9tzAFPjRHmrAGMmyT",
+	"+kmhDRh/7QuvfDP03UKTH/YGh6d7F0TQGz7WA+I39zTod+9SAtpo4RXZof3ZkgUQQOaMbvCgCfhrIu6a",
+	"5iVkszGNhrwYm37MBZ/SkBjJGy8h3c44DGTFuOmIQBxHqUfaeVCpL3YLJwJXN05TfzWjpWbxmiFV9n0h",
+	"vM1035rp7uDZr2QuiMVGjfj9G/V+3/N+bZJ3z6pF7X7AprMIAzh+ZPMlNsuPDAN+mJBJjMdCd1Xk7PRi",
+	"4Dog+popSzrVncChB+3omHKBx9LwnMHgKLURd7fJJEpiudm8EthbG2CsGg0/Ffxwrs6POEOrDAkSrQlb",
+	"Tnaur6UpUIQ558fmGSzVnhpi7kT3kzn8yO2jMfdpSKIZ0zFCKMfotQDR2ZUXboR17tSisuXsi/cjm3/m",
+	"5dofoeuo1oU1oGPjeQJwlnqrBpkVV9vHkInLxPcZXCejnB8g9QzhLKh3MOk4u1bwV1VjyDjIlhjN+iNw",
+	"na0DPliwOXyioUvTb6KYfH84ADe1Jsit9jbaday3zAKeAjyhElQFLUoHZoizy8Hzs73B/g89AhH2QJOG",
+	"40oYIO3MIGODRMWCXDWeXTU2PwNRmfdwCbYgeL9GtoBP1i8FaMoUCrLR8bgI2B0L8j6TOoVwzKqFoQ5q",
+	"x+AAc3XjJ/CugGEwYMNkPIa/Zkk8i0B/W8Pp0roSZY8Rikj/8jAshN9tth6RH2TRM2t6by4Yjf1JnbyY",
+	"hKGn/QvYzDyeN755mBpRhXeTFcvQWS3dmMJRcRSUuw7FGIL9SEjFOEFFT7HpVBtigCu/YWhtSjmyYQy3",
+	"URyQGxprt4EkG6w1bjXJVSNOUIe8aqQ8BH+7amitkkrmcSGZkBzkJLMUVHTxX6DLRmpSDZReUWoAMbLg",
+	"P/79nQ41A7knmzQXfnbVgLUdz4n+Ff5kym/Z/sa25A5gbdiIJPNdL8Z20o+p8pNmD6z0jObvAR1mUwIM",
+	"+9F0qN2xt1r0DhWLyxBdJe12dxelje9SaRNmTP8wAGn5zHYGgLGnYz+DXviPPGRXDWjcALlUy8O5o6AH",
+	"r9GX/l2nFHd3dnL2s24lwfPf61hY5qdE6xze7YYbpUvrtqsXhY+eKrkW9Jhqv31m4lvExC6iWC1S4NCI",
+	"LqNYpcaZ4bzavInRM57WfqCDPl1nyH70Nrz33mNLmIaJQKeUCFics9AbFYjwoImb1dT02NT6SJNk8ihJ",
+	"BVLXmgpTf+dlrfCMbSAEw3nWmxwcXuyjCU7TBNm72N8sml2zYSzuVzTBwnTVG5Qb9F0zM806grL3jw0e",
+	"/AeG+g/C/h8E/T9pv/+kgG9WCNKu2XZnudUWVbYV7du4jrXt24WT3bTqYxHbaYuVsVyKuEyx+X9iNmr0",
+	"Gv/zPMt/9lw3k88PMlU1j62t5dhyfP+r+8sXO/vJxumMiQEL2ZSpeI43OFV8GOLFnnl53n8yzqt77xN0",
+	"ZR4P7r1PejH63/rnUUjH8v498EnTo0e6ZMLuSMDHYIq1hoOrRrttriw7YI9s5Zt2dslwrpjEVulcPdLZ",
+	"zTV76bRyVlGcWMJmA8zwddPxe+aN4tJxd1uRx2Slw8G1U/9OlYSaB4dKVMo5ToxvnU7bbnu/UW/U9l69",
+	"+7TVvc/+6Ozee7+1vVfUG7371L2vVnizIIwnCb5oXYn9CpMV3Dkf2fw7rWXMKI9LcXqlSI1mHH2Ivmu3",
+	"R+3dF5S2h/RVuzt8sRBxy+Oh79PY9tdRwE1WwiT8aDik5zz+MFEcDQyQL/iWs7yGle/yq1MSVj5oLz0g",
+	"r3oHev/OhWkRo3mdhB91SsUDA4nJuebusPmE1lFsC1IKrDQLTcpr22n6Qy97j7gaevJpH6tWbhs+161g",
+	"tauB6oJZBaVRGTAwWCu2mYkkSzZZBSu+P34YqPkn1wvhdZqWX1Kv0DPLkrYavs6g1xromuUfXBvbknGi",
+	"oAq/WYU8FVPUQSJhMOilQK16oj6V8mOtCuMgnTx345ahHYCzTmWvoTLQ9E8ZFFUwIkIeeBhsYrGFO4yN",
+	"Vt9a/bRpjb0dJXX7ejmo2FXkmsayjjBCKjZvSAPPSVy4Bg5KCf0WIqMqCeAabIL6E3YIQ1Rhpphj8b5p",
+	"gDO0KyLlpWkT14Awl25xBehKWRofC8BSgscUQmPOMS+K14Iu7bMCZG6KyMcC6k0hb2Qpw0QKZDEn4RpA",
+	"FruuAGuuy2MBuyR54n2z8fkH0X4rJ9ZEB7CcC4XPDLOnzagoNV7vHVyfH/50eXgxaLhvXyt6g4wRO3yo",
+	"8Mpw1QSuy9/FrpUvuGkzTV4brF1ribEqe6X7no+k8ueqKKnonWYjrYhd/AJwszL91pKu43cmHsm5Tagk",
+	"UxqCjssCor0OinIhUxpPac59J+pERdasybR+Xor0zD/iAkPykhGqnnxlJvgVBiga6++bOfVuSe/6kH87",
+	"zsLbMzdMVdD9fZoi/REucr4qh3STHd+nWVJyCXBXGKXU7YnEAbIxpOXkyhh1ZXiCXYETEtJI8erIEFn6",
+	"4DWFiKzjCnhxOjyy/GCSu9jBCxDa3AVrQbciZPl04euCJVQ8v9CrqwcOxyYM2hIDSgqhTs7lRR/XhC76",
+	"WLfAFLJifYI1YfsBO1aBVaptUISmkO5wPaHI7bkQvorcio8PojM6nMtElGDGNEYeDcP1jTvYFPsvp9Ny",
+	"Iqw1gT2DAapgrcuhpSMOpERlrgjvw6wX64Caz1D1WMAelDNQLYQzTQj2VGDqCR4ZvHL6sYVAOgnJngpM",
+	"NwPZOoCa2Ps6ePdT1sqZzJ6OzWxq+0Ww/0EKqp7mCXTTXJb8FChFlXyS6yTNv7MmJDr1VO3eOal7UiD+",
+	"mPujnOD/sfaoqjYAABeJUcj9dYVgI6FwcZ1Idq0f2RWTIAmYTH+yvByfkerMKDplT1GT3D89eXPU3y+o",
+	"kRVD9eyQXNqws3CejftFqNl5JGkDaiWS9Cd0kD/XMSrR6CEoSxOs/ZZ+7R8fXw72Xh8dXr/pHx4dNJo6",
+	"erTRa5jUpyU0D5lZTwDx41nSxWwN980VhrfPox4y/ruKbg6OQOjB4f8KRJCz4V47NvZ8kr2CBd4U4Ukf",
+	"LhStfWSKb69XPkK5kjDOPD3ia/Q6U5t58LmRdkYAZ3cStH4Vdpp9w/gKRhrLD01IkxvVqnEX5SM+vxlr",
+	"ntRYY5RtpxDdOtp21muxUmrarU5VWqQ9FDcsjGYLZXo9dF7ae1yS0SbWNJ/AUqKpykL1WLRnU/Ms615I",
+	"4eNme/Hwf5eSblVqndwwaWKblYcqpsIpDCeZWmOoLGXN5x7Jn2k8X9bNSeHx5R7iNFX0p+qzYr4/5Vl5",
+	"DPb6jVD/WncHNK6lOf3k4nGpDNVFkyhxKZGVkyo6TN0GPZdexfLfXUEkSwYIsi9GCJINPoKnK+SWxToT",
+	"aO6ZRhcr3SzKvvQoZwVe2Szr6uTZM6noPPu6ZuktUs5b95XScDQzmaw/le2kqCJMmZpEgTRx30jaNRIq",
+	"8lZLnh72937Ivi+k9iUpa++b1cMf68U9JKWthYvGLFWHMGMBxYmy/GIa1kdKavv94aAJr7aaBGO+muTg",
+	"8OhwcNgkPxzuHTTJ6dmgf3pysVIS2hQVx/TO2xuztXCcS10LQwIGKlOGVkaf5jFosOfmhLU4u5T6SbgB",
+	"LEWUpiefzuiQh5DxMuDSj/DFNybPe9Hd6pAL8+78RWu71XkKVDrnIGYq5uxmbU0g8wys4Hd7Cj0gXfgT",
+	"SjePd+98GcrEn3N7fBPvvnY9BL97Wb2AdQJZ005L3RJpRYLVuQkWkA6WRPFW1TTIYHOqAKwbwb2K28y0",
+	"y5cbWNjFtnsCnmqG/m+xrazPDr/xsq+dl0nPecgTrPfeIK2wmQlHDy8G0kV7v/fyFR16LwI28uAnT/sA",
+	"wAXAKgsx3nSc7NsrDPHo5TYbmMMAqwbZeK5uu3PfrHKmmKa52j5G1aKhroyUWhPsYNvtV39qzcf7B7+h",
+	"WsR/j/EJsg4xI55JAcUVm2KI6yyOgB2zANIW6Th1jQ5QSRj1J9j0K2bU39ju1892q42K+1EYGm0Yjjxm",
+	"VbQp1/7rbIzb7VdfqJHxs2h4ECkaeqYqVykZI3x0KjnonBNpCBjg0j6uz1Lm7CzLhP+lHgJb0HsNTcN2",
+	"WagzYKN1FQYJxcQX3VqFYuPfTDLfdJBvl+Gj8IEHeCck8dO78puD4oEOitOLwTeXxENdEmsiz1RvhJck",
+	"thz0Ov4H02WVtyNZdeGVbr/69yJO4d7cE5EnfN7zkIc9ywHQozrPlEJ+wwRQ8lNtxZp7cGTWs2QXdEwn",
+	"JjdxYHiKfYg+Pv7q05XD276s1szawfBpCufrKQs4rXh8fG4LqpBpWuAGkZd2rQh7PTkdXO/t7x+eYThy",
+	"dTD05cnF5dnZ6fng8OD6+PCgv3c9+OXs0AlaTqutZCaey2zBznJ6uffLd9OwELTshJTmwTBkkI4JZQLM",
+	"P3tf7ZvofCmcfMTtYvR8C699Ugnuoak+Sqk7qspuZ/k3qk/rm9PLk4PcWTMdMfK6f0D+tgrB/y03z1dz",
+	"XN4AQKWTkqYKDiKmTwqanL+dkic/JVMnqqC8W2k+aI+c2y1KhMkCTSQXPtMVUtNH9k5mbDSbfVFGh/XV",
+	"/C9ty0x9AE9FkYdFatbzjRkudbb3y9Hp3sH14PT0+mjv/PvDHLeiBJxv6ZZqkUgSqsg0kop021bXfSK+",
+	"9PmM5kxjiQyiiBwBlgqPYdDca0oJFo2a7M5nzJTetoocVln9xoyelrJjlmar90b48HbNy5spOr6econc",
+	"p1CpA7mS+US8fIlnp7pz8To/Oz/cPz056IMeff1mr390eFAtgR8O9r6/Pu5fHEMwoCN4O5n9swN2ZsuB",
+	"47LSK08vrlRrwCRhLQji505mfjJkTKRg5Nky2oJp+LWIEGcOlRDzPFufbYtpa9bKmt1Sg1/2BZ7hP9jL",
+	"86Wd+pgq5oXWgr7GYYeO19iRFaT086wutubllSf7fG9weH3UP+4Prg//tX94eHCYF9krRmmRs5BRaUpA",
+	"EzpSLCa7bVso+ms5YnBpHlMxt9m+IBTCwUbKbxzkfnvK9BfxzWD9cw8LoC/vXSiV/iVyD0YD/qQG03SG",
+	"dc3X57bjCrZTHWS1EbAZEwETPme5XEiYhSwD9SnsqhmY0ccnAFIDqCKjSxAV09GI+yjpPzwxTEAVHVLJ",
+	"rtPOjqnGfAMxQBiviW5Wvgr6J4PD85O9o+vD8/PT89wtYGFQbDqLYhrzcO7uTHoj4H2ABcFCqlj85eRo",
+	"UCwWNKzCUN98s9nyH4AdrFzI7ma6QiEOQCIfBdjgy0bN59+SKfpMbX1sCOVjFuDkmwb5pLeB5FMe0niN",
+	"1HCr0cCFHneFAFLT8g8NxfkmMP03vf3+jBzYNmGPTjVdmSA6iqH4MOZA1a3K18Hlyd7l4IfT8/6vBYUJ",
+	"SuozocwKdH+d+6g49peWLboCITZNNK0A6jGQkia7/Upuw0uHLOESzIPtAAxkABqkMfB9XRfi27dvPQd0",
+	"VhE4lkcM4pURLnQyYh0zlgX0vGY0xqqxNJx+d5WGpdEZxwqZiyKivjy+ZZ5PgNjsAQrU/HMLTZT5F37S",
+	"5U8rTunPe0f9gz005VpZtiqx3Am2uz48uTy+/nnv6NKNo7BFibITrqe0yd8jwUg06pEFVa/rAyq0WyJN",
+	"no4g0UxzkV9a5jes01m5D1iCOC0V/3n78Ob0/Hhv4OyBU6S/+GCpH5BpRTXkBShPsU1FelNlhVa/FIxn",
+	"pFClyf1cQSgPwznUOuifHx4sz6mY1ae3WdubpZ07Ojz5fvDDwtSJ+Eu6Z0OmbhkTpINFTTvtNvEnNKa+",
+	"YrH8qx+bx7hjHRZKDpGFVlRiuGVh6JlaDMPEoXDJphSungwt35TRp7rw0t1G5BYfIZ5jKdSydHCaKD+a",
+	"slwJeLhR8AliNCJ5x3wDfKbRjMWKW2U3qBA5jnVCTS9mNEDK0TYJaNwkkikd1a0mZppUMMvEEOetaKlW",
+	"aMpEPtVUXxjpKrDRKJ2iqcsOVhzTlcpv4vk4wGkb9+mK0oqbizOi5J/WOA9lSxXCI5M01Fk4MYX+Xb6D",
+	"dWWxWC2hirRdvLXLBXMdDlac8IdkSkVxk0zrFfep7tFuadOyEg+FNUAQu/6YTXQbJWFAJvSGkQkNCJWE",
+	"Ep0u3hYczOjRqTxcXS44qw3ym8F8upp3aYdo+IH5WFKkXAGxtOb6yoCS3bCY2nT7skUO7Rtd1EAAwIw4",
+	"3Vnw9uHyShgaBa1FEK4kiW5Fk8goJyzYzdCJVaNEkZjB+p0XEumziCE4qHXYfkrrBQUhTY9s6zk2s4St",
+	"1cttVCBuyoUpStspH5H6N9EV2DUPN5hpgg8eLGhRxq+ct9IZo8rTR9OtGoWFm0scTD/dX7EObw1XreAJ",
+	"9pn6otGOoU2RRHE9pn8VfWKU2YF128z3J8xHXwsNw9MRClaLmVC+432ziH4cn6R+oTnxoaEmiFkUhW5C",
+	"9xIu6zjzvs5db+u92nbF/jC+Tie/rBZl2hBQHyka/sjmcvkb0o9sLi1L1Yn13cej7e62U3y8XclNCvtR",
+	"/uWd3SO3dkw1Qpy3/Cl/M0cRi8mUb9u05E2h5O6EqQmL3czWuSzepp8Dq4oTli59GEUho1gK7iOb1y32",
+	"I5tbnlJYZPE66N10equKvMV7Qqnw2gY3VLAGY7AlICp7KvLgHQVsqOnSBFuP18nuLVwiEeyGWVOgzN0Z",
+	"2+1mwyiNuM+72w2HBLzlN0qKWnfhGo+1p/fQ6lN56PDn7N2afpsFmAcSQLGlfGLYoqHMVU70t6FloXBz",
+	"JHGO9DUYhYoGVeWfXdD13LVQmrdINdSUe4eUAv0w+PjIICpfz6cGQMhKzseJtoSuLKfsmxC5/LrNeUhZ",
+	"iQCq+a1hH4iBDcr9d6FcvF1b1mQxwhdILeViKvX3qg4iguoiQBF+rsLKcG5rq1Qw95qUwycpe82PZTs4",
+	"oO4sOm2VwqtTuqbA2ifMLlXXdQBFNJHmCaSBroo5PVtn2/UFn1Ka2W8Y3TmWFYRmCtPk0LnS5mYQN1OM",
+	"12/4w3e6tL28PrFv/yDDsAFsIxKhln3z9xZ+zqWuWNX4kdIFmviedItoTUGszz6AqexSedlrp/0C8WnC",
+	"VU0ujOyIZU5dvJDDKPqYzKSJv1W5aeLC4evsdNtrn78JV+eAwYqkJhMaI7rtGjSNsJiRCVf6Km6nN3HM",
+	"9CcR2fY5vbX10llZECWafU/pnV5ap3KZWq4zYstSzLmyn2ShDpaw8TzuYra7a2MJ3HpLF6DV57W3bevl",
+	"+rsGfPCYTaN4/nquqlRY/REfG/hUZXVRfJdUbXKavJC8/XLnxe6aKyqco5TQXcw5u1gGwCHEytPn6qa9",
+	"Tw+t5J8/jiaDW9XFg5+eT6lIRtRXSaw3OBONc+zGZoCb0jubIKjTbiPC0r8r+J3OzlY1u6BTtmC+Yj43",
+	"Z97uzs7SeRcaIvPGrIu0Kre7u8Zwr9FXtVsV2muBtVTpRbbPIq2TBjr+noZnThOt7BSMHmlLZ+gqDbW0",
+	"+lVFUpVXo3OZA3ICQxYtGLMREH7V7RdSqRBbVRLYwDoOLFVAayuqav09zQuRQ2S2ihp/Q8aTqWIeaF3V",
+	"i1Mw4HEFoznSn+oXxgWZ8jDkme7kSotL2G6dZdPZXcfRTegQbGWFjUkFL8f6rLdEl+E7i6Qax+zipyPS",
+	"2W111hFNBjkdPz+voy4ks0ZThwYDlY5jqiPcE/FRwI85XSGZlRewupRSxyH3KhLdf1HM0MmeWU/8qdZv",
+	"gAF51XQkG3w6TZQObX40uq+SmS8F/3fCHIeuOXrpqjbQU33z4mmk5DQv6HLufYRN/1I3TS4R6XqEgJzH",
+	"9K6lgO3e9s4aFFD0LsDAuduvmUZvZARcfy7XsYkbF6sWYvI6GJpqbc7EWnv3aq6qJzNjuyRYdgrtDQ5P",
+	"9y4IErNbh0PQGz62Cl0eLp0Ht3T9cPERmLi5+oo8LqMCJ3numgcx5l7MRixmwq8mkRrYLxRVNceuskpe",
+	"dv7MteEaunTED/7DhPzkbo16o16zcefBgJ6zCi1KpV3cIu7pr7griXTmdptlpuchAxJFu8yGU5PTL9av",
+	"bDo/GbvHpguOO7r9EZ1mOZtluqr7FM35XIbrnqwZHXORy7pl46xXO2UL0yau6n7OjuPDvUzNhgFlQbRF",
+	"6l3JWi461rkhq854jZ3bZmIzDmfX4K2Dtz4zxCB3riuCkFYPKHDESD28afmk0QQPctfnYK4PVLIeZLON",
+	"JUw4QUs1co0dtyjfxNQv2lsfS6RxwqJWuPJLz18eSdZLI69KzretfYJxOQTzhd7hUzNtNcWbi8MYwwS1",
+	"CY0lsoH1YJ3wIfOAvCASLovwWiaPmMOQkUi2vS5Wa4+uodEKs7DSpqyybkVJqkLbx12feZozzbU0coaq",
+	"UhBhaftMPGDVbYuftPXSp3hTpXSUm8RIdqWhaw/sQfYXcP1bU336No7EWN8faYRFaaJCxP7ijbZD2JVU",
+	"7Wi5IMoyvwEURNG3YK5ass7Es5L/oH9Q8BjfTiJpxwGh3NRceSp3wWeZsXgmvlfhs9a9GU1nMZswIUFC",
+	"yZk60psO917OpWJTECHiKmcAdpGLbGNcBPyGB0nOhKWnkmQcR8lM23V9qtg4qggn4GIUV0gpffhZqjhB",
+	"ZZ7knghvSBXFGB6GluImYcpvbVYHKqxUY78ckNIwUyzfu0LPkhlED1O1eVK/sa1Cr/5SgBqMM1LFjE6J",
+	"7bpZYYdMx/ycddth3lUFm+SD2WD7HGAqIV1gmoowWC2s9mmbUR19I/qYt08ZixWEZCgmqPALSge2L59L",
+	"JPul7x+xVV+MopUlALNu98Q93u2fzPDLklVfYiu76pvFsc22kwls1r3qnMIZBrJx01U1LbOoIoA0I2mF",
+	"NqK/QJWRIauPwVhEQjbz6h9EPOsQQrq0RyYFZ1urWUe2P9mMN51Wu9VePQigar+rdlf7yJB26nx8iXZI",
+	"5N575TcYvYDHw/qgl2nOW4jP6wQ5fp1zmu603OCOURihKlfy1I79/bkfMrnIUQqHRJev/n6f+Lp5zo+9",
+	"u8wxIOfyeFgXDmigiYagE7GAaGF8wsjpRRmuF93W1ipwYRDiXh0icxNnTlft/kWfb3lmCEdsvVw+930l",
+	"WVSZH1L5Li2o4tg6rG6Sk+lFQPbO+paiuRi3rsReGLpRCFkycS78MAmYFtaNUB3Z9LMkGgJTsJnGYeSA",
+	"DZPxWA9apkmnvFNJLc+WpC1LKrKVkvTkTnS4YT83nTx7uek8TP0tmfVdvcR0b10JzArGdMD3++yNyftM",
+	"BNQKn07ObjCGCo95pSLGJIzGsgpPT6BgP0C1ZXcKX0k5x6esz0KG/phJ+AHjhVBJr1KIuSRMgOIXuBhR",
+	"kZkvtlmhqB9HUpIpFM2ahek9I0uY+VzV2dWUHVKsYsFnObtaMSmk/ZadOdhnNCamJ6ccCETlCbtTCwN9",
+	"Y22PJQK2ZVYwAdWF906oPIvZDY8SudLgM9O4NMGIhrJyhpX8TxlaMh8Uu1P7SSyrDIanMwpnz8fPiL8R",
+	"c6oApRggCT6gh0AfpkhmnGxdiVMgv5mhRSRDg2OAE7BVpCA2/+e0/yHiR29P5r++fdP+9e3562C/L/vi",
+	"F37K+/Pjg377aLB3dzQ47Px8cHh7+uH49vTD3u1b3pf9afgR+p4MLm9/HYzbxwd76tdBf+cX3m4fv/2p",
+	"ffT2cOt48Is6Ofipe/LhsnNy8NPt8cHebZ/f8l/3+7v96U7IfviJj36qOq2zSluDvaoRDybgfaPj4YOX",
+	"QjEpN5iqUxlzaXb9gfuRI5p198SS5yPtyxz25DP35S7dF/F6/uu/fqnZF8l/Z4ukGp3qdcbi0mHqtt1Q",
+	"N+MNX7A/KGv0qx/zVFfNMnwTlD2YXJZqZi0Wp3DCM+y4dMLS+C/XCg0zuEFk5iDNrWIxH17Zq5iR4yLP",
+	"4ojHUi1yLYINL5ZlLpw6Ff8BX77rXCXtdncXQPuu217Dh6ijfxavIKTLF/Dy4QsQ7G7JAjIuvCGSMIQI",
+	"qEhky9pcsK7uyuuCkbVPMnfDOcyx9nZz15rnUO56s43c/Kx1LPNGZz7epyKa+8ojovzJylGSMxorTsNw",
+	"rn20OVPwxhkkHd7UYZ9urF3nEQOHWlfi2bOTSLHes2dkv+gxJtxta4zKXJIr45C+alyJxwg9Wic65pFX",
+	"nIuvIcf07g+K5iwTjvswr2jvTsMXlz0PhLDwFWPbcShsnw9g39pedlfxIGTZmhbOB02dZI1pdDhMvl4c",
+	"IpdysUkD4THNCqHmi4eWiq4MD7bNARSzaXTj6mhF0JbOr/iURYlaYq9JSSBtnn+UvoJ4sRDGopCxwqZ1",
+	"lk57S1d4bwEAgSbkwIgZLyhX+qlRbs7uy1UmPUj0o5STWkhhViJnKBhTjqxXmwdyYAsqoqqw2Tb+Z92n",
+	"rM1Gllq14nIwnwrGYu3Kqoqm/ebN+ubN+lO8WWle4S/QJ5Gt7U9ySpANXWCUhpuP5p9Y4HyqSWO6XhRe",
+	"JjJJYvKtYl5n82MzTYLhhKOtFo9XyJ3aTOvN7PyRoXlrRs46rtEyQPgtq+OxyOvjz5L9KF4stuyfXRIf",
+	"GpHKV9Uvl1kqxlEcJYqLxbOYaE6n8VoiknarLMd16imrvPwGMRU6/86SGJmClqTSfjkFSUXmcZkNAC/7",
+	"VD8rOqU+MOUSlY2VtTxMD1yp4V0ONhvftLm/vjZX+zavuZCK0lCG2jtFhx8sZDKBEXWXRrOasdL2uZtx",
+	"stWednYq80rZDhd1OUMu7SJJhaj8qt3ZWUEDi1d/cWKkjKqHvO4N337Za7cf/tIkW1OGgcptdINLSss3",
+	"H2ueyGXyUskzu9Al21juZx0mvCoY8zX8bIchqO9MTfmXSW5UFFY8OvQ73a3tqgnGFdB+H5E4EUgMVSsd",
+	"R51Wd2cp5gF6C0ClTCuZn8RczS/gNGqM7QVTLgY243PFK3qT+jrLWGxeY1PoSJgIZhEXSmoq2zs47p9c",
+	"Q96064vD858Pz68Hpz8engDrkVhtigudU0Rnu9IMr/EvD1fhDUw6aSuuzPiPTGfoopL7kCu4Yl/gky43",
+	"XkhODRcILpJLBVR4w7LV2mzB6CCEEbJZJ0rNtD1SMhXZSYeMxix+Y0/D2d7F4eC0UarGhT+TjbOQKiBb",
+	"b28sIqm4Ty4M5gnCKDfJzbbGKwQtENwX1tR8OsRQAcS5Jn0NSQ641pXQa+kRkxT5Zrs1S4Yh91ufTE3E",
+	"+9YnyceCwnV3fyVyIGOfIsw6l60+jCji+shWzCYZORljLkwpY4jyikPTX/aePx9zNUmGLT+aPqexP+GK",
+	"geIZW6txo5TDc4+cH14McEwAckoFxXQghddQ5kULiANk//zywMk6hFEIIx4qFus8IqZgPEfH+5X4n/8h",
+	"euXkIALlCX7DxHdmCvv8oHclPPLsWT949qxHygEV6cNI3eyEThk0PLBPv6ZMf3gNl5fzxRU59PMi3Q5v",
+	"QGi3n3tOtbEgUbKZGtMJAH0Dg4cRVnpjalDxGjyeQF/nScgk/OiRdEBkP6XHT9AEwEVEIwQk47nEXyJ5",
+	"4IsoAhKH8EgfIUr1pdKjKrNIoIaf06ge+HEAERfwcyKZk7g1C/3BxZloHicEw2mAPICNOZM9Pc3/2DnI",
+	"hf401/i9PD8iZ1RNnCUAlt8/v+k8f082ZjGHNMNkytQkCsye6ESnxR5ODtkeuem8t5X4NihQq6BmU/OL",
+	"6Wf3HYy9F1ZFMblDp8OCkcqnaTJCNwgJRjLNszQCOlZeEhozEkR+MmUC90+TkP4aRmPo+zpm9CMeL9PH",
+	"XAZkSj/Aa6P0rvZjBsNYoGDLDtgsZoYlb5y/2Scvd15tb16Jt0CsVLgxXESnAMDmLGgSmgP+loehxQCe",
+	"1vfO0D10yL8nQGSIBhPgZDl+fmjsfZEIyVSPgBNrywfixX/hILDOF92tDl4sXoBPAuzhggXjWobM2rBx",
+	"PHCg2dGSOMR/sL+TmIXfXTWM+yCKPQPrVQPmuTzvZ+aXWUh9RB9MocmepdFYkkxYOCN+yBnctlM+BqIF",
+	"VU6wW5bugSRDNopiRiRCZ1mgvX7Kh8lcWfq+yV8yhiW6LSQQ9tLbjXgVN1p+7MK6iD5ByJGqSV7ah3lW",
+	"hrF40aTwLyyEzITyBvMZ8071W9EeEZEUfDR6bxq9ienU+XpwePKL/fSviwvvLI6UtmH3SOfvZBoF7Lth",
+	"GPkfdaMLFXNfeaiNA6fx7PJ7ZErvPHCJbnV2tnbb7fbf7cIvkqG+eKQewy7TdvXOopD78x4J2IgmofJk",
+	"7JO/gYv2b7rDORuxOGZx2lDqVUQxH3PhAVl6GEFhftG9zliM5TwiIdOOPp2ymH63sdkkU+7H0QxUPvxz",
+	"zCIbPfvdxuZ7FBZC7jMhmSMBHPcHpRs/mjGh7+hWFI+fm07yObRF+6MKi8LD91SxWzp3goeN0AwdYDwU",
+	"4htbrXZrS6ccm6Ck+hyFuedo8H6eWYDvm5VfnoeY7bf++yebIv++otHEPqApfshSvhW/SOOCzH4vz6Sb",
+	"eqmleGFbq09DowzcxpipKsuJLqwkK58FZznFZMvKbtIRmoZzc7HDf3Ha5pWAf4PA5WFspWQg0NlYHZGX",
+	"B0weXh1NhWGl/34PHno6ZQoDLRvNRiqx9QPz2PggfWicNpW1OWKzJs/3TGUVHC1NVL60GwTnnMGfqzS+",
+	"4L+v3hhlvjeIzdUnACyv2yeK1eqNccNWbq7D6VZu/gZ3fOXm/dFJJBgGHq++YXtYG/FQ+FHglsFasZ9t",
+	"/67ZyGJZe58a3Xa7zkaVtrPHzYMDBFxpq729vJOIlJeWzr5vNrZXmWlIA89GhGOfzvI+uZpX2Gl3tdXp",
+	"apNoKIdu3VfLuzn1iO+bjZ1VQMpVsHStDni4XbX6t3ewPVnhDkxB4DArUFDpGJiCvUAakMEYLv5KFpjE",
+	"QqbiUpoE0nXR+FEYGk/5hogyTzFYnDd1eDdEeEA39ByhzJv1gUgn4jyvt4kr9aN1csMpljCv4nhAj984",
+	"3l+X41WwsM9iLUjED2ctD2ETX9x5/56pqpPpJC6pOv7RrCYQx3IAOPBoENQ6OoYWR7cm+W41N9BHX7fY",
+	"Pz2/ILOYjUI+nijnfYcIMvPSnARc+hFk+a867UbDyA58gVC2VycUC+6DbpT8bpSQbxFjEZVlM3KRU7MP",
+	"a/KwcpWcpX3KZW2WdnFq2azZCeVe52jPoqqwZp3MU+aSc6ZmyhYWH0ozXWkTAZVghWUBoSTRZkVjSnTM",
+	"i1raRrNLzhiXjpGoCGxCPka8SqaKgbppyEMVMRaKY/whd08/YNNZhOkVf2TzzxLekAZeR8G8nvZtE87k",
+	"c8QgM0Vv9cO53NHrrHr0PD3SX0SW664yV0XNwS/yYtAUW8yAW2ZCjmr8HCqswJr+q9mSLjtjOFBqWHTL",
+	"M0WCgb4fCdbEGy1m2hpQrF+T1gbigtAr0W2/IMdgVvcu8lURWmQPk0fjS0loH0RMV/GXKprpYdWExbJn",
+	"FqO7oW1CuiVwrkQUByxuZmyQBSkksZs+yVkd2jffRDFBYyiSZ1ZnyFY0TATc3hJBt7/OWKwHQJZdfJLE",
+	"wTkxm6XOzivxHn2ary+Pfrw+3vvXdX9weHzxnkimcL4NY7wj3fbm30lI4zGLdXEjY1/XJZBYoP1Y250t",
+	"cqaddGQQReQIOlRx7nK9pz+VfT+EHcOx9HI8WVYw5Rery0POgH8wa+5sLe9kfK+eiiIP6eCLZrBW1oNk",
+	"6sA+l/FYdodu3zor5AXG7Ra40JQ6zy+1zRFP4/7Fz3CuTw7+eXF60jL+Un1a8MUmeFXwCdRw7j7WNA87",
+	"nddxaIEMEznRj+2RfaCXxKSKQmYBIzSvhIz06XSkWz2lrsIzTEYjFmsnmI7cqzqVh4iFP/hE6km10/8r",
+	"0tNrdOiaMsh3nghsKeSF1Z7ZnXruyxunZHKj1+BBU9Apa6LRuqnt3Gnq22aavDcLlagcvRTDYClXHw4W",
+	"OE+FwvkjVxXVvqMDLmemHmNFtJNS1J+gG3HEQwYQ58+g4YNa29VJLTMc0bT339PuWantli9vlpba/lb7",
+	"tFjs+4+7or64i0azrYUGXfeCyTnlTI2hqtyFIdNKuG4OVwRX0urXmV6N4uGzZ/mol96zZ+AiPXBz5eLW",
+	"OdGxVbEppXtAL+Mx9ep3DzcUeWadn6F5rmiIHEWJMD1WoDY/EqOQ++rLJE+9hSkh1Vi6lrpc8znOa6mx",
+	"REHfM/XHmmX+K/x2Xmy2Jvgr+O7WP3VfibcPDdDOmekfPI6/r3gcV3b08VG2Hl0s+rN9fX+Yvv6o3qk/",
+	"wzm1/jn4gt1ZT+3CKteLeFIH1mf4r/4k95VbR+PzfVcHRihd+d7861mVgXNUpYjLZVlhQEOaNWZB+i1i",
+	"cmVFNzxgaTCYdV3pjsEiSXxJADrZsGPxsYhiHWJup9usCE/3H/gaLn8C3Hw0fxgXf5Co9TnmWdz4emfZ",
+	"6leGQfVXKjytrel0VvDHzWJ89Id2HE8X4PwKfXlFHrJM2ZolFcrWm2QZE4KoccN6ponE0295xF+P9+Se",
+	"SX+9zEej6Bv3+cZ9noz7vElW5TzVZsjnJqlHrc/rCPXEZEZURHacYl9mqDTBC7AJE2Svxfsxv2EiTRPC",
+	"7uC1SD6jCOEK3oG08CGQDubnkrDpTJlsxSLSvvWCw19P4xZEq5Lwv2cqnwHlqUyYq+yvXojrIP6Sj/EX",
+	"qXnKfL6Yb4rPCopP3Zm3Lp9v2FpJTdw3r0LRBKcmLKsNmeVBMmY0FhA6plxIVZaKprrQlhHX9oxyn+Ww",
+	"sTJbxmT////3/ys9k4YW+jf8nDbWv+s2xS9XYk/MDS/N5muaN3zIlfPxltp5TGJjrtluvyL75gLWEUWp",
+	"nAc4uRL63WxRGJxQHSk1ZEyk8qKuu8CVfr2tq2r/XSf5s7Pr0QgNZWSXcCVya6jg99U5hB6P568p+2Vo",
+	"Nlzfk7aM7lcvBf7l3WYZLeVOcK1clT6nrJahFpQlMykcTU0/U5bMzQmTHVGdpadlHjinD7+NtiYzMayU",
+	"ERJEsiz73TBRZlQmr0RWtb9QFK1FzGthG9WHeUTKeTqqvAOhmuybHHvrE7vGjxd9fDDN7rS3Vp4G8w6W",
+	"iMNJyFKkjR/yNa4sQejsb4YeQqfuU3UkGYd4lEKZJGDCAVMsnnLBLCu1OY24JHEiTBkYNIVDlGvsTxhm",
+	"fohiSTZC/pGRH5MhiwVTTG5WDmgSgrCYyEmUhIF+5m+SGlW/ZdWLfPiOWjDtnj7kvG+tMU3VnhYe4rnV",
+	"t+p2MXZTsq5wsAsJJpduJ6PBHBppbRgu5tGI+60rgZjWFQP8mOOziHwW0YwpgBNmSKU20VTkFq0lltLi",
+	"9OwuUUS6TIwp4M2FVFT4lbpWmqH24TSSIu+JiSSbZymVFPLuVpLJCrcK3kJa+Cio1pHeWMy6iQFtum0p",
+	"CQGd8ZaNUAvYzfNPJrHAPeQYoDEHIQ8xnctEihHbNg1YOeWem5dERaacuFuyCYArOUziKEjMW9Hla/Wj",
+	"6R+31nfp9nyqDGDUyZd0/pGUemWWpOsg1c1rEnta9tLMDrp+YmAudCQSZ0DdDbSw/zsAOP6bR1dbAQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
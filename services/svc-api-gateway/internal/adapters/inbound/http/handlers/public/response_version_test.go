@@ -0,0 +1,215 @@
+package public_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/adapters/inbound/http/handlers/public"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/adapters/inbound/http/middleware"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/domain/model"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/mocks"
+	"github.com/stretchr/testify/suite"
+)
+
+type ResponseVersionTestSuite struct {
+	suite.Suite
+}
+
+func TestResponseVersionTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(ResponseVersionTestSuite))
+}
+
+func (s *ResponseVersionTestSuite) newDevicesService() (*mocks.FakeDevicesService, model.DeviceID) {
+	id := model.NewDeviceID()
+	device := &model.Device{
+		ID:        id,
+		Name:      "Test Device",
+		Brand:     "Test Brand",
+		State:     model.StateInUse,
+		CreatedAt: time.Now().UTC(),
+		UpdatedAt: time.Now().UTC(),
+	}
+
+	deviceSvc := &mocks.FakeDevicesService{}
+	deviceSvc.GetDeviceReturns(device, nil)
+	deviceSvc.ListDevicesReturns(&model.DeviceList{
+		Devices:    []*model.Device{device},
+		Pagination: model.Pagination{Page: 1, Size: 10, TotalItems: 1, TotalPages: 1},
+		Filters:    model.DeviceFilter{Page: 1, Size: 10},
+	}, nil)
+
+	return deviceSvc, id
+}
+
+// serve runs the request through ResponseVersionMiddleware in front of the handler.
+func (s *ResponseVersionTestSuite) serve(
+	deviceSvc *mocks.FakeDevicesService,
+	req *http.Request,
+	serve func(handler *public.DeviceHandler, w http.ResponseWriter, r *http.Request),
+	opts ...public.DeviceHandlerOption,
+) *httptest.ResponseRecorder {
+	handler := public.NewDeviceHandler(newTestApp(deviceSvc, newDefaultHealthChecker()), opts...)
+
+	rec := httptest.NewRecorder()
+	middleware.ResponseVersionMiddleware()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		serve(handler, w, r)
+	})).ServeHTTP(rec, withRequestContext(req))
+
+	return rec
+}
+
+func (s *ResponseVersionTestSuite) getDevice(accept string, opts ...public.DeviceHandlerOption) *httptest.ResponseRecorder {
+	deviceSvc, id := s.newDevicesService()
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/devices/"+id.String(), nil)
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+
+	return s.serve(deviceSvc, req, func(handler *public.DeviceHandler, w http.ResponseWriter, r *http.Request) {
+		handler.GetDevice(w, r, id.UUID, public.GetDeviceParams{})
+	}, opts...)
+}
+
+func decodeDeviceData(rec *httptest.ResponseRecorder) map[string]any {
+	var response struct {
+		Data map[string]any `json:"data"`
+	}
+
+	_ = json.Unmarshal(rec.Body.Bytes(), &response)
+
+	return response.Data
+}
+
+func (s *ResponseVersionTestSuite) TestGetDevice_Versions() {
+	s.T().Parallel()
+
+	cases := []struct {
+		name            string
+		accept          string
+		opts            []public.DeviceHandlerOption
+		expectedVersion string
+	}{
+		{
+			name:            "no accept header defaults to v1",
+			expectedVersion: middleware.ResponseVersionV1,
+		},
+		{
+			name:            "application/json defaults to v1",
+			accept:          "application/json",
+			expectedVersion: middleware.ResponseVersionV1,
+		},
+		{
+			name:            "explicit v1",
+			accept:          "application/vnd.devices.v1+json",
+			expectedVersion: middleware.ResponseVersionV1,
+		},
+		{
+			name:            "explicit v2",
+			accept:          "application/vnd.devices.v2+json",
+			expectedVersion: middleware.ResponseVersionV2,
+		},
+		{
+			name:            "configured default",
+			accept:          "application/json",
+			opts:            []public.DeviceHandlerOption{public.WithResponseVersion(middleware.ResponseVersionV2)},
+			expectedVersion: middleware.ResponseVersionV2,
+		},
+		{
+			name:            "accept header overrides configured default",
+			accept:          "application/vnd.devices.v1+json",
+			opts:            []public.DeviceHandlerOption{public.WithResponseVersion(middleware.ResponseVersionV2)},
+			expectedVersion: middleware.ResponseVersionV1,
+		},
+		{
+			name:            "unsupported configured default is ignored",
+			opts:            []public.DeviceHandlerOption{public.WithResponseVersion("v9")},
+			expectedVersion: middleware.ResponseVersionV1,
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			rec := s.getDevice(tc.accept, tc.opts...)
+
+			s.Require().Equal(http.StatusOK, rec.Code)
+
+			data := decodeDeviceData(rec)
+
+			switch tc.expectedVersion {
+			case middleware.ResponseVersionV1:
+				s.Require().Equal("in-use", data["state"])
+				s.Require().NotContains(data, "deviceState")
+				s.Require().NotContains(data, "deletedAt")
+			case middleware.ResponseVersionV2:
+				s.Require().Equal("in-use", data["deviceState"])
+				s.Require().NotContains(data, "state")
+				s.Require().Contains(data, "deletedAt")
+				s.Require().Nil(data["deletedAt"])
+			}
+		})
+	}
+}
+
+func (s *ResponseVersionTestSuite) TestGetDevice_UnsupportedVersion() {
+	s.T().Parallel()
+
+	rec := s.getDevice("application/vnd.devices.v3+json")
+
+	s.Require().Equal(http.StatusNotAcceptable, rec.Code)
+
+	var response public.Error
+	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&response))
+	s.Require().Equal("NOT_ACCEPTABLE", response.Code)
+}
+
+func (s *ResponseVersionTestSuite) TestListDevices_V2() {
+	s.T().Parallel()
+
+	deviceSvc, _ := s.newDevicesService()
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/devices", nil)
+	req.Header.Set("Accept", "application/vnd.devices.v2+json")
+
+	rec := s.serve(deviceSvc, req, func(handler *public.DeviceHandler, w http.ResponseWriter, r *http.Request) {
+		handler.ListDevices(w, r, public.ListDevicesParams{})
+	})
+
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var response struct {
+		Data []map[string]any `json:"data"`
+	}
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+	s.Require().Len(response.Data, 1)
+	s.Require().Equal("in-use", response.Data[0]["deviceState"])
+	s.Require().Contains(response.Data[0], "deletedAt")
+}
+
+func (s *ResponseVersionTestSuite) TestBulkCreateDevices_V2() {
+	s.T().Parallel()
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/devices/bulk", strings.NewReader(`[{"name": "Pixel 8", "brand": "Google"}]`))
+	req.Header.Set("Accept", "application/vnd.devices.v2+json")
+
+	rec := s.serve(newBulkDevicesService(), req, func(handler *public.DeviceHandler, w http.ResponseWriter, r *http.Request) {
+		handler.BulkCreateDevices(w, r, public.BulkCreateDevicesParams{})
+	})
+
+	s.Require().Equal(http.StatusMultiStatus, rec.Code)
+
+	var response struct {
+		Data []struct {
+			Device map[string]any `json:"device"`
+		} `json:"data"`
+	}
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+	s.Require().Len(response.Data, 1)
+	s.Require().Equal("available", response.Data[0].Device["deviceState"])
+	s.Require().Contains(response.Data[0].Device, "deletedAt")
+}
@@ -1,6 +1,8 @@
 package shared
 
 import (
+	"bytes"
+	"encoding/json"
 	"net/http"
 
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/adapters/inbound/http/middleware"
@@ -64,6 +66,48 @@ func NewMeta(r *http.Request) ResponseMeta {
 	}
 }
 
+// RefreshMeta returns body with the request and trace IDs of its meta replaced by those of
+// r, so a response stored for one request can be replayed to another. Bodies that are not
+// an enveloped JSON response are returned as they are.
+func RefreshMeta(r *http.Request, body []byte) []byte {
+	var envelope map[string]json.RawMessage
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return body
+	}
+
+	rawMeta, ok := envelope["meta"]
+	if !ok {
+		return body
+	}
+
+	var meta ResponseMeta
+	if err := json.Unmarshal(rawMeta, &meta); err != nil {
+		return body
+	}
+
+	meta.RequestID = middleware.GetRequestID(r.Context())
+	meta.TraceID = ExtractTraceID(r)
+
+	refreshedMeta, err := json.Marshal(meta)
+	if err != nil {
+		return body
+	}
+
+	envelope["meta"] = refreshedMeta
+
+	refreshed, err := json.Marshal(envelope)
+	if err != nil {
+		return body
+	}
+
+	// Keep the trailing newline written by json.Encoder.
+	if bytes.HasSuffix(body, []byte("\n")) {
+		refreshed = append(refreshed, '\n')
+	}
+
+	return refreshed
+}
+
 // ExtractTraceID extracts the trace ID from the traceparent header.
 // Format: {version}-{trace-id}-{parent-id}-{trace-flags}
 // Example: 00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01
@@ -1,11 +1,13 @@
 package shared_test
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/adapters/inbound/http/handlers/shared"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/adapters/inbound/http/middleware"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -70,6 +72,47 @@ func (s *ResponseSuite) TestNewMeta() {
 	}
 }
 
+func (s *ResponseSuite) TestRefreshMeta() {
+	s.T().Parallel()
+
+	cases := []struct {
+		name     string
+		body     string
+		expected string
+	}{
+		{
+			name:     "replaces the request and trace IDs",
+			body:     `{"data":{"name":"iPhone"},"meta":{"requestId":"old","traceId":"old","apiVersion":"v1"}}` + "\n",
+			expected: `{"data":{"name":"iPhone"},"meta":{"requestId":"new","traceId":"0af7651916cd43dd8448eb211c80319c","apiVersion":"v1"}}` + "\n",
+		},
+		{
+			name:     "keeps the pagination",
+			body:     `{"data":[],"meta":{"requestId":"old","apiVersion":"v1"},"pagination":{"page":2}}`,
+			expected: `{"data":[],"meta":{"requestId":"new","traceId":"0af7651916cd43dd8448eb211c80319c","apiVersion":"v1"},"pagination":{"page":2}}`,
+		},
+		{
+			name:     "leaves a bare body untouched",
+			body:     `{"name":"iPhone"}`,
+			expected: `{"name":"iPhone"}`,
+		},
+		{
+			name:     "leaves a non-JSON body untouched",
+			body:     "id,name\n",
+			expected: "id,name\n",
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			ctx := context.WithValue(s.T().Context(), middleware.RequestIDKey, "new")
+			req := httptest.NewRequestWithContext(ctx, http.MethodGet, "/v1/devices", nil)
+			req.Header.Set("traceparent", "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01")
+
+			s.Require().Equal(tc.expected, string(shared.RefreshMeta(req, []byte(tc.body))))
+		})
+	}
+}
+
 func (s *ResponseSuite) TestExtractTraceID() {
 	s.T().Parallel()
 
@@ -0,0 +1,50 @@
+package middleware
+
+import (
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+)
+
+// throttleHistory remembers when each rate limit key was last throttled so that
+// keys with a clean history inside a sliding window can be granted a larger burst.
+// It tracks at most maxKeys keys, dropping the least recently throttled one first.
+type throttleHistory struct {
+	period      time.Duration
+	now         func() time.Time
+	lastLimited *lru.Cache[string, time.Time]
+}
+
+func newThrottleHistory(period time.Duration, maxKeys int) (*throttleHistory, error) {
+	lastLimited, err := lru.New[string, time.Time](maxKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	return &throttleHistory{
+		period:      period,
+		now:         time.Now,
+		lastLimited: lastLimited,
+	}, nil
+}
+
+// Record notes that the key has just been throttled.
+func (h *throttleHistory) Record(key string) {
+	h.lastLimited.Add(key, h.now())
+}
+
+// IsClean reports whether the key has not been throttled within the period.
+func (h *throttleHistory) IsClean(key string) bool {
+	last, ok := h.lastLimited.Peek(key)
+	if !ok {
+		return true
+	}
+
+	if h.now().Sub(last) >= h.period {
+		h.lastLimited.Remove(key)
+
+		return true
+	}
+
+	return false
+}
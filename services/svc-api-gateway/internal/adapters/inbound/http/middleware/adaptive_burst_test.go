@@ -0,0 +1,56 @@
+package middleware
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestThrottleHistory(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	history, err := newThrottleHistory(time.Minute, 10)
+	require.NoError(t, err)
+
+	history.now = func() time.Time { return now }
+
+	require.True(t, history.IsClean("key"), "fresh key should be clean")
+
+	history.Record("key")
+	require.False(t, history.IsClean("key"), "throttled key should not be clean")
+	require.True(t, history.IsClean("other"), "other keys should stay clean")
+
+	now = now.Add(59 * time.Second)
+	require.False(t, history.IsClean("key"), "key should stay dirty inside the window")
+
+	now = now.Add(time.Second)
+	require.True(t, history.IsClean("key"), "key should be clean once the window has passed")
+}
+
+func TestThrottleHistory_EvictsLeastRecentlyThrottledKeyAtCapacity(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	history, err := newThrottleHistory(time.Minute, 3)
+	require.NoError(t, err)
+
+	history.now = func() time.Time { return now }
+
+	for index := range 3 {
+		history.Record("key-" + strconv.Itoa(index))
+		now = now.Add(time.Second)
+	}
+
+	history.Record("fresh")
+
+	require.Equal(t, 3, history.lastLimited.Len())
+	require.True(t, history.IsClean("key-0"), "the least recently throttled key should be evicted")
+	require.False(t, history.IsClean("key-1"))
+	require.False(t, history.IsClean("key-2"))
+	require.False(t, history.IsClean("fresh"))
+}
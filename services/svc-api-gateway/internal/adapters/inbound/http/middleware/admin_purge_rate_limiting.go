@@ -0,0 +1,65 @@
+package middleware
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"time"
+
+	appLogger "github.com/architeacher/devices/pkg/logger"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/config"
+	"github.com/throttled/throttled/v2"
+)
+
+const adminCachePathPrefix = "/admin/cache/"
+
+// AdminPurgeRateLimitMiddleware throttles DELETE requests under /admin/cache/ per admin
+// token, answering 429 with Retry-After once the purge quota is spent. Other admin
+// requests pass through. A zero PurgeRPS disables the limit.
+func AdminPurgeRateLimitMiddleware(
+	cfg config.AdminRateLimit,
+	store throttled.GCRAStoreCtx,
+	logger appLogger.Logger,
+) func(http.Handler) http.Handler {
+	if cfg.PurgeRPS == 0 {
+		return func(next http.Handler) http.Handler {
+			return next
+		}
+	}
+
+	limit := ThrottledRateLimitingMiddleware(
+		config.ThrottledRateLimiting{
+			Enabled:           true,
+			RequestsPerSecond: cfg.PurgeRPS,
+			BurstSize:         cfg.PurgeBurst,
+			WindowDuration:    time.Second,
+			GracefulDegraded:  true,
+		},
+		store,
+		logger,
+		WithRateLimitKey(adminTokenRateLimitKey),
+	)
+
+	return func(next http.Handler) http.Handler {
+		limited := limit(next)
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodDelete || !strings.HasPrefix(r.URL.Path, adminCachePathPrefix) {
+				next.ServeHTTP(w, r)
+
+				return
+			}
+
+			limited.ServeHTTP(w, r)
+		})
+	}
+}
+
+// adminTokenRateLimitKey keys the purge quota by a digest of the admin token, so the
+// token itself is not kept in the store.
+func adminTokenRateLimitKey(r *http.Request) string {
+	digest := sha256.Sum256([]byte(r.Header.Get(AdminTokenHeader)))
+
+	return "admin:" + hex.EncodeToString(digest[:8])
+}
@@ -0,0 +1,54 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+)
+
+const (
+	// AdminTokenHeader carries the shared token for the admin endpoints.
+	AdminTokenHeader = "X-Admin-Token"
+
+	adminPathPrefix       = "/admin/"
+	adminUnauthorizedBody = `{"error":"missing or invalid admin token"}`
+	adminDisabledBody     = `{"error":"admin token not configured"}`
+)
+
+// AdminTokenMiddleware rejects requests under /admin/ whose X-Admin-Token header does not
+// match token. It fails closed: with an empty token every /admin/ request is answered
+// with 503. Other paths on the admin server, such as the health probes, pass through.
+func AdminTokenMiddleware(token string) func(http.Handler) http.Handler {
+	expected := []byte(token)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !strings.HasPrefix(r.URL.Path, adminPathPrefix) {
+				next.ServeHTTP(w, r)
+
+				return
+			}
+
+			if len(expected) == 0 {
+				writeAdminTokenError(w, http.StatusServiceUnavailable, adminDisabledBody)
+
+				return
+			}
+
+			provided := []byte(r.Header.Get(AdminTokenHeader))
+			if len(provided) == 0 || subtle.ConstantTimeCompare(provided, expected) != 1 {
+				writeAdminTokenError(w, http.StatusUnauthorized, adminUnauthorizedBody)
+
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func writeAdminTokenError(w http.ResponseWriter, status int, body string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write([]byte(body))
+}
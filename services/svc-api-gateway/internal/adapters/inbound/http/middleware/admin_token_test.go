@@ -0,0 +1,129 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/adapters/inbound/http/middleware"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdminTokenMiddleware(t *testing.T) {
+	t.Parallel()
+
+	const token = "s3cret-admin-token"
+
+	handler := middleware.AdminTokenMiddleware(token)(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	cases := []struct {
+		name           string
+		path           string
+		header         string
+		expectedStatus int
+	}{
+		{
+			name:           "admin route with matching token",
+			path:           "/admin/cache/health",
+			header:         token,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "admin route without token",
+			path:           "/admin/cache/health",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "admin route with wrong token",
+			path:           "/admin/cache/health",
+			header:         "guess",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "admin route with token prefix",
+			path:           "/admin/cache/health",
+			header:         token[:5],
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "probe outside /admin/ needs no token",
+			path:           "/liveness",
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+			if tc.header != "" {
+				req.Header.Set(middleware.AdminTokenHeader, tc.header)
+			}
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			require.Equal(t, tc.expectedStatus, rec.Code)
+
+			if tc.expectedStatus == http.StatusUnauthorized {
+				require.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+				require.JSONEq(t, `{"error":"missing or invalid admin token"}`, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestAdminTokenMiddleware_WithoutTokenFailsClosed(t *testing.T) {
+	t.Parallel()
+
+	handler := middleware.AdminTokenMiddleware("")(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	cases := []struct {
+		name           string
+		path           string
+		header         string
+		expectedStatus int
+	}{
+		{
+			name:           "admin route without token",
+			path:           "/admin/cache/health",
+			expectedStatus: http.StatusServiceUnavailable,
+		},
+		{
+			name:           "admin route with any token",
+			path:           "/admin/cache/health",
+			header:         "guess",
+			expectedStatus: http.StatusServiceUnavailable,
+		},
+		{
+			name:           "probe outside /admin/ stays open",
+			path:           "/liveness",
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+			if tc.header != "" {
+				req.Header.Set(middleware.AdminTokenHeader, tc.header)
+			}
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			require.Equal(t, tc.expectedStatus, rec.Code)
+
+			if tc.expectedStatus == http.StatusServiceUnavailable {
+				require.JSONEq(t, `{"error":"admin token not configured"}`, rec.Body.String())
+			}
+		})
+	}
+}
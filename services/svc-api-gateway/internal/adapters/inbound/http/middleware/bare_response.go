@@ -0,0 +1,36 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+)
+
+const (
+	// EnvelopeQueryParam is the query parameter clients set to false to request bare responses.
+	EnvelopeQueryParam = "envelope"
+
+	// BareResponseKey flags requests that asked for the response data without its envelope.
+	BareResponseKey contextKey = "bareResponse"
+)
+
+// BareResponseMiddleware flags requests carrying ?envelope=false in the request context.
+// Handlers decide whether to honour the flag; values that are not booleans are ignored.
+func BareResponseMiddleware() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if enveloped, err := strconv.ParseBool(r.URL.Query().Get(EnvelopeQueryParam)); err == nil && !enveloped {
+				r = r.WithContext(context.WithValue(r.Context(), BareResponseKey, true))
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// IsBareResponse reports whether the client asked for a response without the envelope.
+func IsBareResponse(ctx context.Context) bool {
+	bare, _ := ctx.Value(BareResponseKey).(bool)
+
+	return bare
+}
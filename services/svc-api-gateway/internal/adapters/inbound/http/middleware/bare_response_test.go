@@ -0,0 +1,69 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/adapters/inbound/http/middleware"
+	"github.com/stretchr/testify/suite"
+)
+
+type BareResponseMiddlewareSuite struct {
+	suite.Suite
+}
+
+func TestBareResponseMiddlewareSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(BareResponseMiddlewareSuite))
+}
+
+func (s *BareResponseMiddlewareSuite) TestDetection() {
+	s.T().Parallel()
+
+	cases := []struct {
+		name         string
+		target       string
+		expectedBare bool
+	}{
+		{
+			name:   "no query parameter",
+			target: "/v1/devices",
+		},
+		{
+			name:   "envelope requested",
+			target: "/v1/devices?envelope=true",
+		},
+		{
+			name:   "invalid value",
+			target: "/v1/devices?envelope=bare",
+		},
+		{
+			name:         "envelope disabled",
+			target:       "/v1/devices?envelope=false",
+			expectedBare: true,
+		},
+		{
+			name:         "envelope disabled with numeric value",
+			target:       "/v1/devices?page=2&envelope=0",
+			expectedBare: true,
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			var bare bool
+			handler := middleware.BareResponseMiddleware()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				bare = middleware.IsBareResponse(r.Context())
+				w.WriteHeader(http.StatusOK)
+			}))
+
+			rec := httptest.NewRecorder()
+
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tc.target, nil))
+
+			s.Require().Equal(http.StatusOK, rec.Code)
+			s.Require().Equal(tc.expectedBare, bare)
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package middleware
+
+import (
+	"errors"
+	"io"
+	"net/http"
+)
+
+const payloadTooLargeBody = `{"code":"PAYLOAD_TOO_LARGE","message":"request body exceeds limit"}`
+
+// BodyLimitMiddleware caps request bodies at maxBytes using http.MaxBytesReader.
+// Requests declaring a larger Content-Length are rejected upfront; otherwise, once the
+// downstream handler reads past the limit, its response is replaced with a 413.
+func BodyLimitMiddleware(maxBytes int64) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Body == nil || r.Body == http.NoBody {
+				next.ServeHTTP(w, r)
+
+				return
+			}
+
+			if r.ContentLength > maxBytes {
+				logPayloadTooLarge(r, maxBytes)
+				writePayloadTooLarge(w)
+
+				return
+			}
+
+			body := &limitedBody{ReadCloser: http.MaxBytesReader(w, r.Body, maxBytes)}
+			r.Body = body
+
+			lw := &bodyLimitResponseWriter{ResponseWriter: w, body: body}
+			next.ServeHTTP(lw, r)
+
+			if body.exceeded && !lw.wroteHeader {
+				logPayloadTooLarge(r, maxBytes)
+				lw.writePayloadTooLarge()
+			}
+		})
+	}
+}
+
+func logPayloadTooLarge(r *http.Request, maxBytes int64) {
+	reqLogger := LoggerFromContext(r.Context())
+	reqLogger.Warn().
+		Str("method", r.Method).
+		Str("path", r.URL.Path).
+		Int64("limit_bytes", maxBytes).
+		Msg("request body exceeds limit")
+}
+
+func writePayloadTooLarge(w http.ResponseWriter) {
+	w.Header().Del("Content-Length")
+	w.Header().Del("Content-Encoding")
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusRequestEntityTooLarge)
+	_, _ = w.Write([]byte(payloadTooLargeBody))
+}
+
+// limitedBody records whether the wrapped http.MaxBytesReader hit its limit.
+type limitedBody struct {
+	io.ReadCloser
+	exceeded bool
+}
+
+func (b *limitedBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		b.exceeded = true
+	}
+
+	return n, err
+}
+
+// bodyLimitResponseWriter swaps the handler's response for a 413 once the body limit is hit.
+type bodyLimitResponseWriter struct {
+	http.ResponseWriter
+	body        *limitedBody
+	wroteHeader bool
+	replaced    bool
+}
+
+func (w *bodyLimitResponseWriter) WriteHeader(code int) {
+	if w.wroteHeader {
+		return
+	}
+
+	if w.body.exceeded {
+		w.writePayloadTooLarge()
+
+		return
+	}
+
+	w.wroteHeader = true
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *bodyLimitResponseWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+
+	if w.replaced {
+		// Discard the handler's output; the 413 body has already been written.
+		return len(b), nil
+	}
+
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *bodyLimitResponseWriter) writePayloadTooLarge() {
+	w.wroteHeader = true
+	w.replaced = true
+
+	writePayloadTooLarge(w.ResponseWriter)
+}
+
+func (w *bodyLimitResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
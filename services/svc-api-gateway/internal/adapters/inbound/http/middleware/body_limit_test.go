@@ -0,0 +1,151 @@
+package middleware_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/adapters/inbound/http/middleware"
+	"github.com/stretchr/testify/suite"
+)
+
+const testBodyLimit = 16
+
+type BodyLimitMiddlewareSuite struct {
+	suite.Suite
+}
+
+func TestBodyLimitMiddlewareSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(BodyLimitMiddlewareSuite))
+}
+
+// echoHandler mirrors a typical handler: it reads the body and fails with 500 on read errors.
+func echoHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(`{"code":"INTERNAL_ERROR"}`))
+
+			return
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write(body)
+	})
+}
+
+func newBodyLimitRequest(body string, knownLength bool) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/v1/devices", strings.NewReader(body))
+	if !knownLength {
+		req.ContentLength = -1
+	}
+
+	return req
+}
+
+func (s *BodyLimitMiddlewareSuite) TestPassesThrough() {
+	s.T().Parallel()
+
+	cases := []struct {
+		name string
+		body string
+	}{
+		{
+			name: "body under limit",
+			body: strings.Repeat("a", testBodyLimit-1),
+		},
+		{
+			name: "body exactly at limit",
+			body: strings.Repeat("a", testBodyLimit),
+		},
+	}
+
+	handler := middleware.BodyLimitMiddleware(testBodyLimit)(echoHandler())
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			for _, knownLength := range []bool{true, false} {
+				rec := httptest.NewRecorder()
+				handler.ServeHTTP(rec, newBodyLimitRequest(tc.body, knownLength))
+
+				s.Require().Equal(http.StatusCreated, rec.Code)
+				s.Require().Equal(tc.body, rec.Body.String())
+			}
+		})
+	}
+}
+
+func (s *BodyLimitMiddlewareSuite) TestRejectsDeclaredContentLengthOverLimit() {
+	s.T().Parallel()
+
+	handlerCalled := false
+	handler := middleware.BodyLimitMiddleware(testBodyLimit)(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			handlerCalled = true
+		}),
+	)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, newBodyLimitRequest(strings.Repeat("a", testBodyLimit+1), true))
+
+	s.Require().False(handlerCalled)
+	s.Require().Equal(http.StatusRequestEntityTooLarge, rec.Code)
+	s.Require().Equal("application/json", rec.Header().Get("Content-Type"))
+	s.Require().JSONEq(`{"code":"PAYLOAD_TOO_LARGE","message":"request body exceeds limit"}`, rec.Body.String())
+}
+
+func (s *BodyLimitMiddlewareSuite) TestReplacesHandlerErrorWhenStreamedBodyExceedsLimit() {
+	s.T().Parallel()
+
+	handler := middleware.BodyLimitMiddleware(testBodyLimit)(echoHandler())
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, newBodyLimitRequest(strings.Repeat("a", testBodyLimit+1), false))
+
+	s.Require().Equal(http.StatusRequestEntityTooLarge, rec.Code)
+	s.Require().JSONEq(`{"code":"PAYLOAD_TOO_LARGE","message":"request body exceeds limit"}`, rec.Body.String())
+}
+
+func (s *BodyLimitMiddlewareSuite) TestWritesErrorWhenHandlerIgnoresReadFailure() {
+	s.T().Parallel()
+
+	handler := middleware.BodyLimitMiddleware(testBodyLimit)(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = io.ReadAll(r.Body)
+		}),
+	)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, newBodyLimitRequest(strings.Repeat("a", testBodyLimit*2), false))
+
+	s.Require().Equal(http.StatusRequestEntityTooLarge, rec.Code)
+	s.Require().JSONEq(`{"code":"PAYLOAD_TOO_LARGE","message":"request body exceeds limit"}`, rec.Body.String())
+}
+
+func (s *BodyLimitMiddlewareSuite) TestLimitIsAppliedPerRequest() {
+	s.T().Parallel()
+
+	handler := middleware.BodyLimitMiddleware(testBodyLimit)(echoHandler())
+
+	// Each request gets its own budget, so consecutive bodies near the limit all pass
+	for range 3 {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, newBodyLimitRequest(strings.Repeat("a", testBodyLimit), false))
+
+		s.Require().Equal(http.StatusCreated, rec.Code)
+	}
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, newBodyLimitRequest(strings.Repeat("a", testBodyLimit+1), false))
+	s.Require().Equal(http.StatusRequestEntityTooLarge, rec.Code)
+
+	// An oversized request does not affect the next one
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, newBodyLimitRequest("small", false))
+	s.Require().Equal(http.StatusCreated, rec.Code)
+	s.Require().Equal("small", rec.Body.String())
+}
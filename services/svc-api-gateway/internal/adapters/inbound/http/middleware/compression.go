@@ -17,6 +17,7 @@ import (
 	"github.com/architeacher/devices/pkg/logger"
 	"github.com/architeacher/devices/pkg/metrics"
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/config"
+	"github.com/klauspost/compress/zstd"
 	"go.opentelemetry.io/otel/attribute"
 )
 
@@ -36,8 +37,8 @@ var DefaultCompressibleTypes = []string{
 }
 
 // serverPreferenceOrder defines the server's algorithm preference when client
-// quality values are equal. Per spec FR-018: gzip > brotli > deflate.
-var serverPreferenceOrder = []string{"gzip", "br", "deflate"}
+// quality values are equal: zstd > gzip > brotli > deflate.
+var serverPreferenceOrder = []string{"zstd", "gzip", "br", "deflate"}
 
 // Compression metrics constants.
 const (
@@ -57,35 +58,87 @@ const (
 	skipReasonNonCompressible = "non_compressible_type"
 	skipReasonNoEncoding      = "no_accept_encoding"
 	skipReasonSkippedPath     = "skipped_path"
+	skipReasonCPUOverloaded   = "cpu_overloaded"
+	skipReasonAlreadyEncoded  = "already_encoded"
 )
 
+type (
+	// CompressionOption configures optional compression middleware behaviour.
+	CompressionOption func(*compressionOptions)
+
+	compressionOptions struct {
+		cpuGuard *CPUGuard
+	}
+)
+
+// WithCPUGuard disables compression while the guard reports CPU overload.
+func WithCPUGuard(guard *CPUGuard) CompressionOption {
+	return func(o *compressionOptions) {
+		o.cpuGuard = guard
+	}
+}
+
+// cpuOverloaded reports whether compression should be shed due to CPU load.
+func (o compressionOptions) cpuOverloaded() bool {
+	return o.cpuGuard != nil && o.cpuGuard.Overloaded()
+}
+
 // encoderPool pools compression writers to reduce GC pressure.
 type encoderPool struct {
 	gzip    sync.Pool
 	deflate sync.Pool
 	brotli  sync.Pool
+	zstd    sync.Pool
 }
 
-var pools = encoderPool{
-	gzip: sync.Pool{
-		New: func() any {
-			w, _ := gzip.NewWriterLevel(io.Discard, gzip.DefaultCompression)
+var pools = newEncoderPool(zstd.WithEncoderLevel(zstd.SpeedDefault))
 
-			return w
+// newEncoderPool creates the writer pools; zstd encoders are built with zstdOptions.
+func newEncoderPool(zstdOptions ...zstd.EOption) *encoderPool {
+	return &encoderPool{
+		gzip: sync.Pool{
+			New: func() any {
+				w, _ := gzip.NewWriterLevel(io.Discard, gzip.DefaultCompression)
+
+				return w
+			},
 		},
-	},
-	deflate: sync.Pool{
-		New: func() any {
-			w, _ := flate.NewWriter(io.Discard, flate.DefaultCompression)
+		deflate: sync.Pool{
+			New: func() any {
+				w, _ := flate.NewWriter(io.Discard, flate.DefaultCompression)
 
-			return w
+				return w
+			},
 		},
-	},
-	brotli: sync.Pool{
-		New: func() any {
-			return brotli.NewWriterLevel(io.Discard, brotli.DefaultCompression)
+		brotli: sync.Pool{
+			New: func() any {
+				return brotli.NewWriterLevel(io.Discard, brotli.DefaultCompression)
+			},
 		},
-	},
+		zstd: sync.Pool{
+			New: func() any {
+				w, err := zstd.NewWriter(io.Discard, zstdOptions...)
+				if err != nil {
+					return nil
+				}
+
+				return w
+			},
+		},
+	}
+}
+
+// zstdAvailable reports whether the zstd pool hands out encoders. When it can't build
+// one, selectEncoding falls back to the next accepted encoding.
+func (p *encoderPool) zstdAvailable() bool {
+	encoder, ok := p.zstd.Get().(*zstd.Encoder)
+	if !ok {
+		return false
+	}
+
+	p.zstd.Put(encoder)
+
+	return true
 }
 
 // acceptEncoding represents a parsed Accept-Encoding value.
@@ -94,10 +147,10 @@ type acceptEncoding struct {
 	quality  float64
 }
 
-// CompressionMiddleware creates a new compression middleware with support for gzip,
-// deflate, and brotli compression. It respects Accept-Encoding quality values and
+// CompressionMiddleware creates a new compression middleware with support for zstd,
+// gzip, deflate, and brotli compression. It respects Accept-Encoding quality values and
 // applies the server's preference order when quality values are equal.
-func CompressionMiddleware(cfg config.Compression, _ logger.Logger) func(http.Handler) http.Handler {
+func CompressionMiddleware(cfg config.Compression, _ logger.Logger, opts ...CompressionOption) func(http.Handler) http.Handler {
 	if !cfg.Enabled {
 		return func(next http.Handler) http.Handler {
 			return next
@@ -110,10 +163,20 @@ func CompressionMiddleware(cfg config.Compression, _ logger.Logger) func(http.Ha
 		contentTypes = DefaultCompressibleTypes
 	}
 
+	skipPaths := NewPathMatcher(cfg.SkipPaths)
+	options := newCompressionOptions(opts)
+
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// Shed compression while the CPU is saturated
+			if options.cpuOverloaded() {
+				next.ServeHTTP(w, r)
+
+				return
+			}
+
 			// Skip compression for configured paths (health checks, etc.)
-			if shouldSkipPath(r.URL.Path, cfg.SkipPaths) {
+			if skipPaths.Match(r.URL.Path) {
 				next.ServeHTTP(w, r)
 
 				return
@@ -154,11 +217,12 @@ func CompressionMiddleware(cfg config.Compression, _ logger.Logger) func(http.Ha
 				level:          cfg.Level,
 				minSize:        cfg.MinSize,
 				contentTypes:   contentTypes,
+				streaming:      cfg.StreamingMode,
 			}
 
 			defer func() { _ = cw.Close() }()
 
-			w.Header().Set("Vary", "Accept-Encoding")
+			appendVary(w.Header(), "Accept-Encoding")
 			next.ServeHTTP(cw, r)
 		})
 	}
@@ -166,7 +230,12 @@ func CompressionMiddleware(cfg config.Compression, _ logger.Logger) func(http.Ha
 
 // CompressionMiddlewareWithMetrics creates compression middleware with metrics and structured logging.
 // This is the observability-enabled version that records compression statistics.
-func CompressionMiddlewareWithMetrics(cfg config.Compression, log logger.Logger, metricsClient metrics.Client) func(http.Handler) http.Handler {
+func CompressionMiddlewareWithMetrics(
+	cfg config.Compression,
+	_ logger.Logger,
+	metricsClient metrics.Client,
+	opts ...CompressionOption,
+) func(http.Handler) http.Handler {
 	if !cfg.Enabled {
 		return func(next http.Handler) http.Handler {
 			return next
@@ -179,12 +248,23 @@ func CompressionMiddlewareWithMetrics(cfg config.Compression, log logger.Logger,
 		contentTypes = DefaultCompressibleTypes
 	}
 
+	skipPaths := NewPathMatcher(cfg.SkipPaths)
+	options := newCompressionOptions(opts)
+
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			ctx := r.Context()
 
+			// Shed compression while the CPU is saturated
+			if options.cpuOverloaded() {
+				recordCompressionSkipped(ctx, metricsClient, skipReasonCPUOverloaded)
+				next.ServeHTTP(w, r)
+
+				return
+			}
+
 			// Skip compression for configured paths (health checks, etc.)
-			if shouldSkipPath(r.URL.Path, cfg.SkipPaths) {
+			if skipPaths.Match(r.URL.Path) {
 				recordCompressionSkipped(ctx, metricsClient, skipReasonSkippedPath)
 				next.ServeHTTP(w, r)
 
@@ -205,7 +285,8 @@ func CompressionMiddlewareWithMetrics(cfg config.Compression, log logger.Logger,
 
 			// Check for identity;q=0 case (client rejects uncompressed)
 			if rejectsIdentity(encodings) && !hasValidEncoding(encodings) {
-				log.Warn().
+				reqLogger := LoggerFromContext(r.Context())
+				reqLogger.Warn().
 					Str("accept_encoding", acceptHeader).
 					Msg("client rejected all encodings, returning 406")
 
@@ -233,20 +314,32 @@ func CompressionMiddlewareWithMetrics(cfg config.Compression, log logger.Logger,
 					level:          cfg.Level,
 					minSize:        cfg.MinSize,
 					contentTypes:   contentTypes,
+					streaming:      cfg.StreamingMode,
 				},
 				ctx:           ctx,
-				log:           log,
+				log:           LoggerFromContext(ctx),
 				metricsClient: metricsClient,
 			}
 
 			defer func() { _ = cw.Close() }()
 
-			w.Header().Set("Vary", "Accept-Encoding")
+			appendVary(w.Header(), "Accept-Encoding")
 			next.ServeHTTP(cw, r)
 		})
 	}
 }
 
+// newCompressionOptions applies the given options over the defaults.
+func newCompressionOptions(opts []CompressionOption) compressionOptions {
+	options := compressionOptions{}
+
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	return options
+}
+
 // recordCompressionSkipped records a skip metric with the given reason.
 func recordCompressionSkipped(ctx context.Context, metricsClient metrics.Client, reason string) {
 	if metricsClient == nil {
@@ -278,7 +371,7 @@ func recordCompressionMetrics(ctx context.Context, metricsClient metrics.Client,
 	// Calculate and record ratio (as percentage, e.g., 0.65 = 65% of original)
 	if originalSize > 0 {
 		ratio := float64(compressedSize) / float64(originalSize)
-		metricsClient.Inc(ctx, httpCompressionRatio, ratio, attrs...)
+		_ = metricsClient.Observe(ctx, httpCompressionRatio, ratio, attrs...)
 	}
 }
 
@@ -337,7 +430,7 @@ func hasValidEncoding(encodings []acceptEncoding) bool {
 	for _, enc := range encodings {
 		if enc.quality > 0 {
 			switch enc.encoding {
-			case "gzip", "deflate", "br":
+			case "zstd", "gzip", "deflate", "br":
 				return true
 			case "*":
 				// Wildcard with quality > 0 means any encoding is acceptable
@@ -351,11 +444,18 @@ func hasValidEncoding(encodings []acceptEncoding) bool {
 
 // selectEncoding selects the best encoding based on client preferences and server order.
 func selectEncoding(encodings []acceptEncoding) string {
+	available := serverPreferenceOrder
+	if !pools.zstdAvailable() {
+		available = slices.DeleteFunc(slices.Clone(serverPreferenceOrder), func(encoding string) bool {
+			return encoding == "zstd"
+		})
+	}
+
 	// Check for wildcard first
 	for _, enc := range encodings {
 		if enc.encoding == "*" && enc.quality > 0 {
 			// Use server's preferred encoding
-			return serverPreferenceOrder[0]
+			return available[0]
 		}
 	}
 
@@ -375,7 +475,7 @@ func selectEncoding(encodings []acceptEncoding) string {
 
 		priority := -1
 
-		for index, pref := range serverPreferenceOrder {
+		for index, pref := range available {
 			if pref == enc.encoding {
 				priority = index
 
@@ -413,17 +513,6 @@ func selectEncoding(encodings []acceptEncoding) string {
 	return candidates[0].encoding
 }
 
-// shouldSkipPath checks if the given path should skip compression.
-func shouldSkipPath(path string, skipPaths []string) bool {
-	for _, skipPath := range skipPaths {
-		if strings.HasPrefix(path, skipPath) {
-			return true
-		}
-	}
-
-	return false
-}
-
 // compressResponseWriter wraps http.ResponseWriter to apply compression.
 type compressResponseWriter struct {
 	http.ResponseWriter
@@ -431,11 +520,13 @@ type compressResponseWriter struct {
 	level        int
 	minSize      int
 	contentTypes []string
+	streaming    bool
 
 	writer        io.WriteCloser
 	headerWritten bool
 	buf           []byte
 	shouldSkip    bool
+	skipReason    string
 }
 
 func (w *compressResponseWriter) Write(b []byte) (int, error) {
@@ -443,23 +534,20 @@ func (w *compressResponseWriter) Write(b []byte) (int, error) {
 		return w.ResponseWriter.Write(b)
 	}
 
-	// Buffer until we have enough data to decide
-	if w.writer == nil && !w.headerWritten {
+	// Buffer until we have enough data to decide. In streaming mode the
+	// decision is made on the first write so chunks are never held back.
+	if w.writer == nil {
 		w.buf = append(w.buf, b...)
 
 		// Check if we have enough to decide
-		if len(w.buf) >= w.minSize {
+		if w.streaming || len(w.buf) >= w.minSize {
 			w.initWriter()
 		}
 
 		return len(b), nil
 	}
 
-	if w.writer != nil {
-		return w.writer.Write(b)
-	}
-
-	return w.ResponseWriter.Write(b)
+	return w.writer.Write(b)
 }
 
 func (w *compressResponseWriter) WriteHeader(statusCode int) {
@@ -467,10 +555,19 @@ func (w *compressResponseWriter) WriteHeader(statusCode int) {
 		return
 	}
 
+	// Never compress a body the handler or an upstream proxy already encoded
+	if w.isAlreadyEncoded() {
+		w.skip(skipReasonAlreadyEncoded)
+		w.ResponseWriter.WriteHeader(statusCode)
+		w.headerWritten = true
+
+		return
+	}
+
 	// Check content type
 	ct := w.Header().Get("Content-Type")
 	if ct != "" && !w.isCompressible(ct) {
-		w.shouldSkip = true
+		w.skip(skipReasonNonCompressible)
 		w.ResponseWriter.WriteHeader(statusCode)
 		w.headerWritten = true
 
@@ -479,7 +576,7 @@ func (w *compressResponseWriter) WriteHeader(statusCode int) {
 
 	// For non-OK statuses that typically have no body, skip compression
 	if statusCode == http.StatusNoContent || statusCode == http.StatusNotModified {
-		w.shouldSkip = true
+		w.skip(skipReasonNonCompressible)
 		w.ResponseWriter.WriteHeader(statusCode)
 		w.headerWritten = true
 
@@ -494,10 +591,17 @@ func (w *compressResponseWriter) WriteHeader(statusCode int) {
 }
 
 func (w *compressResponseWriter) initWriter() {
+	if w.isAlreadyEncoded() {
+		w.skip(skipReasonAlreadyEncoded)
+		w.flushBuffer()
+
+		return
+	}
+
 	// Determine final content type
 	ct := w.Header().Get("Content-Type")
 	if ct != "" && !w.isCompressible(ct) {
-		w.shouldSkip = true
+		w.skip(skipReasonNonCompressible)
 		w.flushBuffer()
 
 		return
@@ -537,6 +641,11 @@ func (w *compressResponseWriter) initWriter() {
 		bw.Reset(w.ResponseWriter)
 
 		w.writer = &pooledBrotliWriter{Writer: bw, pool: &pools.brotli}
+	case "zstd":
+		zw := pools.zstd.Get().(*zstd.Encoder)
+		zw.Reset(w.ResponseWriter)
+
+		w.writer = &pooledZstdWriter{Encoder: zw, pool: &pools.zstd}
 	}
 
 	// Write buffered data
@@ -565,6 +674,19 @@ func (w *compressResponseWriter) flushBuffer() {
 	}
 }
 
+// skip marks the response as passed through uncompressed for the given reason.
+func (w *compressResponseWriter) skip(reason string) {
+	w.shouldSkip = true
+	w.skipReason = reason
+}
+
+// isAlreadyEncoded reports whether the response already carries a non-identity Content-Encoding.
+func (w *compressResponseWriter) isAlreadyEncoded() bool {
+	encoding := strings.TrimSpace(w.Header().Get("Content-Encoding"))
+
+	return encoding != "" && !strings.EqualFold(encoding, "identity")
+}
+
 func (w *compressResponseWriter) isCompressible(contentType string) bool {
 	// Extract media type without parameters
 	ct := contentType
@@ -584,8 +706,8 @@ func (w *compressResponseWriter) isCompressible(contentType string) bool {
 }
 
 func (w *compressResponseWriter) Close() error {
-	// If we never initiated compression, flush buffer
-	if w.writer == nil && len(w.buf) > 0 {
+	// If we never initiated compression, flush buffer and any pending status
+	if w.writer == nil && !w.shouldSkip && (len(w.buf) > 0 || w.headerWritten) {
 		w.flushBuffer()
 	}
 
@@ -598,16 +720,25 @@ func (w *compressResponseWriter) Close() error {
 
 // Implement http.Flusher
 func (w *compressResponseWriter) Flush() {
-	if f, ok := w.ResponseWriter.(http.Flusher); ok {
-		if w.writer != nil {
-			// Flush compression writer first
-			if flusher, ok := w.writer.(interface{ Flush() error }); ok {
-				_ = flusher.Flush()
-			}
-		}
+	f, ok := w.ResponseWriter.(http.Flusher)
+	if !ok {
+		return
+	}
+
+	// In streaming mode, commit to compression on the first flush so headers
+	// and any buffered chunk reach the client immediately.
+	if w.streaming && w.writer == nil && !w.shouldSkip {
+		w.initWriter()
+	}
 
-		f.Flush()
+	if w.writer != nil {
+		// Flush compression writer first
+		if flusher, ok := w.writer.(interface{ Flush() error }); ok {
+			_ = flusher.Flush()
+		}
 	}
+
+	f.Flush()
 }
 
 // Hijack Implement http.Hijacker
@@ -619,6 +750,10 @@ func (w *compressResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
 	return nil, nil, http.ErrNotSupported
 }
 
+func (w *compressResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
 // Pooled writers for memory efficiency
 type pooledGzipWriter struct {
 	*gzip.Writer
@@ -656,6 +791,18 @@ func (w *pooledBrotliWriter) Close() error {
 	return err
 }
 
+type pooledZstdWriter struct {
+	*zstd.Encoder
+	pool *sync.Pool
+}
+
+func (w *pooledZstdWriter) Close() error {
+	err := w.Encoder.Close()
+	w.pool.Put(w.Encoder)
+
+	return err
+}
+
 // compressResponseWriterWithMetrics extends compressResponseWriter with metrics tracking.
 type compressResponseWriterWithMetrics struct {
 	compressResponseWriter
@@ -674,15 +821,27 @@ func (w *compressResponseWriterWithMetrics) Write(b []byte) (int, error) {
 func (w *compressResponseWriterWithMetrics) Close() error {
 	// If we never initiated compression, flush buffer and record skip
 	if w.writer == nil && len(w.buf) > 0 {
+		reason := skipReasonBelowMinSize
+		if w.isAlreadyEncoded() {
+			reason = skipReasonAlreadyEncoded
+		}
+
+		w.flushBuffer()
+		recordCompressionSkipped(w.ctx, w.metricsClient, reason)
+
+		return nil
+	}
+
+	// Status was set but no body was written, send the pending status
+	if w.writer == nil && !w.shouldSkip && w.headerWritten {
 		w.flushBuffer()
-		recordCompressionSkipped(w.ctx, w.metricsClient, skipReasonBelowMinSize)
 
 		return nil
 	}
 
-	// If we skipped due to content type, record that
+	// If we skipped due to content type or an existing encoding, record that
 	if w.shouldSkip && w.writer == nil {
-		recordCompressionSkipped(w.ctx, w.metricsClient, skipReasonNonCompressible)
+		recordCompressionSkipped(w.ctx, w.metricsClient, w.skipReason)
 
 		return nil
 	}
@@ -0,0 +1,41 @@
+package middleware
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+// FuzzParseAcceptEncoding feeds arbitrary Accept-Encoding values through the parsing and
+// negotiation steps of CompressionMiddleware, which must never panic on client input.
+// Further seeds live in testdata/fuzz/FuzzParseAcceptEncoding.
+func FuzzParseAcceptEncoding(f *testing.F) {
+	seeds := []string{
+		"gzip",
+		"gzip, deflate, br, zstd",
+		"br;q=1.0, gzip;q=0.8, *;q=0.1",
+		"identity;q=0, *;q=0",
+		"gzip;q=",
+		";;;",
+		"gzip\r\nX-Injected: true",
+		strings.Repeat("gzip;q=0.5,", 1000),
+		"gzip;q=NaN, br;q=-Inf",
+		"bröt∂i;q=0.5, 压缩",
+		"gzip\x00;q=1",
+	}
+
+	for _, seed := range seeds {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, header string) {
+		encodings := parseAcceptEncoding(header)
+
+		encoding := selectEncoding(encodings)
+		if encoding != "" && !slices.Contains(serverPreferenceOrder, encoding) {
+			t.Fatalf("selected unsupported encoding %q for %q", encoding, header)
+		}
+
+		_ = rejectsIdentity(encodings)
+	})
+}
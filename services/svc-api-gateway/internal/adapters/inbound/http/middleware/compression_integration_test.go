@@ -0,0 +1,205 @@
+//go:build integration
+
+package middleware
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/andybalholm/brotli"
+	"github.com/stretchr/testify/require"
+)
+
+// writeChunkSize is how much of the body the test handler writes per call.
+const writeChunkSize = 32 * 1024
+
+// incompressibleJSON builds a JSON body of about size bytes whose device IDs are random,
+// so it still compresses to more than the server's chunking buffer. A repetitive body
+// would shrink below it, and the server would then set Content-Length itself.
+func incompressibleJSON(size int) []byte {
+	rng := rand.New(rand.NewPCG(1, 2))
+	id := make([]byte, 16)
+
+	var body bytes.Buffer
+	body.WriteString(`{"devices":[`)
+
+	for index := 0; body.Len() < size; index++ {
+		for i := range id {
+			id[i] = byte(rng.Uint32())
+		}
+
+		if index > 0 {
+			body.WriteByte(',')
+		}
+
+		_, _ = fmt.Fprintf(&body, `{"id":"%s","name":"device %d","brand":"Apple","state":"available"}`, hex.EncodeToString(id), index)
+	}
+
+	body.WriteString(`]}`)
+
+	return body.Bytes()
+}
+
+// newCompressionServer serves body through CompressionMiddleware over a real TCP listener.
+// The handler sets Content-Length to the uncompressed size, as a handler that knows
+// its body would.
+func newCompressionServer(t *testing.T, body []byte) *httptest.Server {
+	t.Helper()
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Length", fmt.Sprint(len(body)))
+
+		for rest := body; len(rest) > 0; {
+			n := min(writeChunkSize, len(rest))
+			_, _ = w.Write(rest[:n])
+			rest = rest[n:]
+		}
+	})
+
+	server := httptest.NewServer(CompressionMiddleware(defaultCompressionConfig(), testLogger())(handler))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+// newCompressionClient returns a client whose transport leaves compression enabled.
+func newCompressionClient() *http.Client {
+	return &http.Client{
+		Transport: &http.Transport{DisableCompression: false},
+	}
+}
+
+func decodeBody(t *testing.T, encoding string, body io.Reader) []byte {
+	t.Helper()
+
+	var reader io.Reader
+
+	switch encoding {
+	case "gzip":
+		gr, err := gzip.NewReader(body)
+		require.NoError(t, err)
+
+		defer func() { require.NoError(t, gr.Close()) }()
+
+		reader = gr
+	case "deflate":
+		fr := flate.NewReader(body)
+		defer func() { require.NoError(t, fr.Close()) }()
+
+		reader = fr
+	case "br":
+		reader = brotli.NewReader(body)
+	default:
+		t.Fatalf("unexpected encoding %q", encoding)
+	}
+
+	decoded, err := io.ReadAll(reader)
+	require.NoError(t, err)
+
+	return decoded
+}
+
+func TestCompressionMiddleware_Integration_Encodings(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		encoding string
+		size     int
+	}{
+		{name: "gzip", encoding: "gzip", size: 256 * 1024},
+		{name: "brotli", encoding: "br", size: 256 * 1024},
+		{name: "deflate", encoding: "deflate", size: 256 * 1024},
+		{name: "gzip 10MB", encoding: "gzip", size: 10 * 1024 * 1024},
+		{name: "brotli 10MB", encoding: "br", size: 10 * 1024 * 1024},
+		{name: "deflate 10MB", encoding: "deflate", size: 10 * 1024 * 1024},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			body := incompressibleJSON(tc.size)
+			server := newCompressionServer(t, body)
+
+			req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, server.URL+"/v1/devices", nil)
+			require.NoError(t, err)
+			req.Header.Set("Accept-Encoding", tc.encoding)
+
+			resp, err := newCompressionClient().Do(req)
+			require.NoError(t, err)
+
+			defer func() { require.NoError(t, resp.Body.Close()) }()
+
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+			require.Equal(t, tc.encoding, resp.Header.Get("Content-Encoding"))
+			require.Empty(t, resp.Header.Get("Content-Length"), "the uncompressed Content-Length must not survive compression")
+			require.EqualValues(t, -1, resp.ContentLength)
+			require.Equal(t, []string{"chunked"}, resp.TransferEncoding)
+			require.Contains(t, resp.Header.Values("Vary"), "Accept-Encoding")
+
+			compressed, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			require.Less(t, len(compressed), len(body))
+
+			require.Equal(t, body, decodeBody(t, tc.encoding, bytes.NewReader(compressed)))
+		})
+	}
+}
+
+func TestCompressionMiddleware_Integration_TransparentGzip(t *testing.T) {
+	t.Parallel()
+
+	body := incompressibleJSON(256 * 1024)
+	server := newCompressionServer(t, body)
+
+	// Without an explicit Accept-Encoding the transport asks for gzip and
+	// decompresses the response itself.
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, server.URL+"/v1/devices", nil)
+	require.NoError(t, err)
+
+	resp, err := newCompressionClient().Do(req)
+	require.NoError(t, err)
+
+	defer func() { require.NoError(t, resp.Body.Close()) }()
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.True(t, resp.Uncompressed)
+
+	decoded, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, body, decoded)
+}
+
+func TestCompressionMiddleware_Integration_SmallResponseKeepsContentLength(t *testing.T) {
+	t.Parallel()
+
+	body := []byte(smallJSON())
+	server := newCompressionServer(t, body)
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, server.URL+"/v1/devices", nil)
+	require.NoError(t, err)
+	req.Header.Set("Accept-Encoding", "gzip")
+
+	resp, err := newCompressionClient().Do(req)
+	require.NoError(t, err)
+
+	defer func() { require.NoError(t, resp.Body.Close()) }()
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Empty(t, resp.Header.Get("Content-Encoding"))
+	require.EqualValues(t, len(body), resp.ContentLength)
+
+	received, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, body, received)
+}
@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"bufio"
 	"bytes"
 	"compress/flate"
 	"compress/gzip"
@@ -8,14 +9,17 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/andybalholm/brotli"
 	"github.com/architeacher/devices/pkg/logger"
+	"github.com/architeacher/devices/pkg/metrics/recording"
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/config"
+	"github.com/klauspost/compress/zstd"
 	"github.com/stretchr/testify/require"
-	"go.opentelemetry.io/otel/attribute"
 )
 
 // testLogger returns a no-op logger for testing.
@@ -138,6 +142,91 @@ func TestCompressionMiddleware_BrotliCompression(t *testing.T) {
 	require.Equal(t, largeJSON(), string(decompressed))
 }
 
+func TestCompressionMiddleware_ZstdCompression(t *testing.T) {
+	t.Parallel()
+
+	cfg := defaultCompressionConfig()
+	handler := CompressionMiddleware(cfg, testLogger())(testHandler(largeJSON(), "application/json"))
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/devices", nil)
+	req.Header.Set("Accept-Encoding", "zstd")
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "zstd", rec.Header().Get("Content-Encoding"))
+
+	// Verify body is actually zstd-compressed
+	zr, err := zstd.NewReader(rec.Body)
+	require.NoError(t, err)
+
+	defer zr.Close()
+
+	decompressed, err := io.ReadAll(zr)
+	require.NoError(t, err)
+	require.Equal(t, largeJSON(), string(decompressed))
+}
+
+func TestCompressionMiddleware_ZstdPoolResetsBetweenRequests(t *testing.T) {
+	t.Parallel()
+
+	cfg := defaultCompressionConfig()
+	bodies := []string{
+		largeJSON(),
+		`{"items":["` + strings.Repeat("y", 2048) + `"]}`,
+		largeJSON(),
+	}
+
+	for _, body := range bodies {
+		handler := CompressionMiddleware(cfg, testLogger())(testHandler(body, "application/json"))
+
+		req := httptest.NewRequest(http.MethodGet, "/v1/devices", nil)
+		req.Header.Set("Accept-Encoding", "zstd")
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		require.Equal(t, "zstd", rec.Header().Get("Content-Encoding"))
+
+		zr, err := zstd.NewReader(rec.Body)
+		require.NoError(t, err)
+
+		decompressed, err := io.ReadAll(zr)
+		zr.Close()
+
+		require.NoError(t, err)
+		// A writer that was not reset would leak state from the previous response
+		require.Equal(t, body, string(decompressed))
+	}
+}
+
+func TestCompressionMiddleware_ZstdEncoderFailure_FallsBack(t *testing.T) {
+	// Not parallel: swaps the package-level writer pools.
+	original := pools
+	pools = newEncoderPool(zstd.WithEncoderConcurrency(0))
+
+	t.Cleanup(func() { pools = original })
+
+	cfg := defaultCompressionConfig()
+	handler := CompressionMiddleware(cfg, testLogger())(testHandler(largeJSON(), "application/json"))
+
+	for header, expected := range map[string]string{
+		"zstd, gzip;q=0.8": "gzip",
+		"*":                "gzip",
+		"zstd":             "",
+	} {
+		req := httptest.NewRequest(http.MethodGet, "/v1/devices", nil)
+		req.Header.Set("Accept-Encoding", header)
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		require.Equal(t, http.StatusOK, rec.Code, header)
+		require.Equal(t, expected, rec.Header().Get("Content-Encoding"), header)
+	}
+}
+
 func TestCompressionMiddleware_NoAcceptEncoding_NoCompression(t *testing.T) {
 	t.Parallel()
 
@@ -379,6 +468,42 @@ func TestCompressionMiddleware_SkipPaths(t *testing.T) {
 	}
 }
 
+func TestCompressionMiddleware_SkipPathPatterns(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name           string
+		path           string
+		shouldCompress bool
+	}{
+		{name: "device export", path: "/v1/devices/123/export", shouldCompress: false},
+		{name: "device collection", path: "/v1/devices", shouldCompress: true},
+		{name: "single device", path: "/v1/devices/123", shouldCompress: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := defaultCompressionConfig()
+			cfg.SkipPaths = []string{"/v1/devices/*/export"}
+			handler := CompressionMiddleware(cfg, testLogger())(testHandler(largeJSON(), "application/json"))
+
+			req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+			req.Header.Set("Accept-Encoding", "gzip")
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			if tc.shouldCompress {
+				require.Equal(t, "gzip", rec.Header().Get("Content-Encoding"))
+			} else {
+				require.Empty(t, rec.Header().Get("Content-Encoding"))
+			}
+		})
+	}
+}
+
 // --- User Story 3 Tests: Compression Algorithm Selection ---
 
 func TestCompressionMiddleware_QualityValues_PreferHigher(t *testing.T) {
@@ -405,7 +530,7 @@ func TestCompressionMiddleware_EqualQuality_ServerPreference(t *testing.T) {
 	handler := CompressionMiddleware(cfg, testLogger())(testHandler(largeJSON(), "application/json"))
 
 	req := httptest.NewRequest(http.MethodGet, "/v1/devices", nil)
-	// Equal quality values - server should use preference order: gzip > br > deflate
+	// Equal quality values - among these, server prefers gzip > br > deflate
 	req.Header.Set("Accept-Encoding", "br, gzip, deflate")
 
 	rec := httptest.NewRecorder()
@@ -416,6 +541,23 @@ func TestCompressionMiddleware_EqualQuality_ServerPreference(t *testing.T) {
 	require.Equal(t, "gzip", rec.Header().Get("Content-Encoding"))
 }
 
+func TestCompressionMiddleware_EqualQuality_PrefersZstd(t *testing.T) {
+	t.Parallel()
+
+	cfg := defaultCompressionConfig()
+	handler := CompressionMiddleware(cfg, testLogger())(testHandler(largeJSON(), "application/json"))
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/devices", nil)
+	// Equal quality values - server should use preference order: zstd > gzip > br > deflate
+	req.Header.Set("Accept-Encoding", "gzip, br, zstd")
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "zstd", rec.Header().Get("Content-Encoding"))
+}
+
 func TestCompressionMiddleware_MalformedHeader_GracefulDegradation(t *testing.T) {
 	t.Parallel()
 
@@ -441,7 +583,7 @@ func TestCompressionMiddleware_IdentityQZero_406Error(t *testing.T) {
 
 	req := httptest.NewRequest(http.MethodGet, "/v1/devices", nil)
 	// Client explicitly rejects uncompressed and only requests unsupported encoding
-	req.Header.Set("Accept-Encoding", "identity;q=0, zstd")
+	req.Header.Set("Accept-Encoding", "identity;q=0, compress")
 
 	rec := httptest.NewRecorder()
 	handler.ServeHTTP(rec, req)
@@ -463,8 +605,8 @@ func TestCompressionMiddleware_Wildcard_ServerPreference(t *testing.T) {
 	handler.ServeHTTP(rec, req)
 
 	require.Equal(t, http.StatusOK, rec.Code)
-	// Should use server's preferred encoding (gzip)
-	require.Equal(t, "gzip", rec.Header().Get("Content-Encoding"))
+	// Should use server's preferred encoding (zstd)
+	require.Equal(t, "zstd", rec.Header().Get("Content-Encoding"))
 }
 
 func TestCompressionMiddleware_UnsupportedEncoding_Fallback(t *testing.T) {
@@ -475,7 +617,7 @@ func TestCompressionMiddleware_UnsupportedEncoding_Fallback(t *testing.T) {
 
 	req := httptest.NewRequest(http.MethodGet, "/v1/devices", nil)
 	// Request only an unsupported encoding
-	req.Header.Set("Accept-Encoding", "zstd")
+	req.Header.Set("Accept-Encoding", "compress")
 
 	rec := httptest.NewRecorder()
 	handler.ServeHTTP(rec, req)
@@ -485,13 +627,261 @@ func TestCompressionMiddleware_UnsupportedEncoding_Fallback(t *testing.T) {
 	require.Empty(t, rec.Header().Get("Content-Encoding"))
 }
 
+// --- Streaming Tests ---
+
+func TestCompressionMiddleware_PreservesExplicitStatus(t *testing.T) {
+	t.Parallel()
+
+	cfg := defaultCompressionConfig()
+	handler := CompressionMiddleware(cfg, testLogger())(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(largeJSON()))
+	}))
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/devices", nil)
+	req.Header.Set("Accept-Encoding", "gzip")
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code)
+	require.Equal(t, "gzip", rec.Header().Get("Content-Encoding"))
+	require.Empty(t, rec.Header().Get("X-Pending-Status"))
+}
+
+func TestCompressionMiddleware_StreamingMode_FlushesEachChunk(t *testing.T) {
+	t.Parallel()
+
+	const chunks = 10
+
+	cfg := defaultCompressionConfig()
+	cfg.StreamingMode = true
+
+	ack := make(chan struct{})
+	done := make(chan struct{})
+
+	streamHandler := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		defer close(done)
+
+		w.Header().Set("Content-Type", "text/plain")
+		w.WriteHeader(http.StatusOK)
+
+		flusher, ok := w.(http.Flusher)
+		require.True(t, ok)
+
+		for index := range chunks {
+			_, _ = w.Write([]byte("event " + strconv.Itoa(index) + "\n"))
+			flusher.Flush()
+
+			// Block until the client has decoded this chunk
+			select {
+			case <-ack:
+			case <-time.After(5 * time.Second):
+				return
+			}
+		}
+	})
+
+	server := httptest.NewServer(CompressionMiddleware(cfg, testLogger())(streamHandler))
+	defer server.Close()
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL+"/v1/events", nil)
+	require.NoError(t, err)
+	req.Header.Set("Accept-Encoding", "gzip")
+
+	resp, err := http.DefaultTransport.RoundTrip(req)
+	require.NoError(t, err)
+
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	require.Equal(t, "gzip", resp.Header.Get("Content-Encoding"))
+
+	gr, err := gzip.NewReader(resp.Body)
+	require.NoError(t, err)
+
+	reader := bufio.NewReader(gr)
+
+	for index := range chunks {
+		line, err := reader.ReadString('\n')
+		require.NoError(t, err)
+		require.Equal(t, "event "+strconv.Itoa(index)+"\n", line)
+
+		select {
+		case <-done:
+			t.Fatalf("handler returned before chunk %d was acknowledged", index)
+		default:
+		}
+
+		ack <- struct{}{}
+	}
+
+	<-done
+}
+
+func TestCompressionMiddleware_StreamingMode_IgnoresMinSize(t *testing.T) {
+	t.Parallel()
+
+	cfg := defaultCompressionConfig()
+	cfg.StreamingMode = true
+	handler := CompressionMiddleware(cfg, testLogger())(testHandler(smallJSON(), "application/json"))
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/devices", nil)
+	req.Header.Set("Accept-Encoding", "gzip")
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	require.Equal(t, "gzip", rec.Header().Get("Content-Encoding"))
+
+	gr, err := gzip.NewReader(rec.Body)
+	require.NoError(t, err)
+
+	decompressed, err := io.ReadAll(gr)
+	require.NoError(t, err)
+	require.Equal(t, smallJSON(), string(decompressed))
+}
+
+// --- Already Encoded Tests ---
+
+func TestCompressionMiddleware_AlreadyEncoded_Passthrough(t *testing.T) {
+	t.Parallel()
+
+	var encoded bytes.Buffer
+
+	gw := gzip.NewWriter(&encoded)
+	_, err := gw.Write([]byte(largeJSON()))
+	require.NoError(t, err)
+	require.NoError(t, gw.Close())
+
+	rawBytes := encoded.Bytes()
+
+	cases := []struct {
+		name          string
+		explicitWrite bool
+	}{
+		{name: "with explicit WriteHeader", explicitWrite: true},
+		{name: "with implicit WriteHeader", explicitWrite: false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockMetrics := recording.NewMetricsClient()
+			cfg := defaultCompressionConfig()
+
+			encodedHandler := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.Header().Set("Content-Encoding", "gzip")
+
+				if tc.explicitWrite {
+					w.WriteHeader(http.StatusOK)
+				}
+
+				_, _ = w.Write(rawBytes)
+			})
+
+			handler := CompressionMiddlewareWithMetrics(cfg, testLogger(), mockMetrics)(encodedHandler)
+
+			req := httptest.NewRequest(http.MethodGet, "/v1/devices", nil)
+			req.Header.Set("Accept-Encoding", "br, zstd")
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			require.Equal(t, http.StatusOK, rec.Code)
+			require.Equal(t, "gzip", rec.Header().Get("Content-Encoding"))
+			require.Equal(t, rawBytes, rec.Body.Bytes())
+			require.True(t, mockMetrics.HasAttribute("http_compression_skipped_total", "compression.skip_reason", "already_encoded"))
+			require.False(t, mockMetrics.Has("http_compression_total"))
+		})
+	}
+}
+
+func TestCompressionMiddleware_IdentityEncoding_StillCompresses(t *testing.T) {
+	t.Parallel()
+
+	cfg := defaultCompressionConfig()
+	handler := CompressionMiddleware(cfg, testLogger())(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Encoding", "identity")
+		_, _ = w.Write([]byte(largeJSON()))
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/devices", nil)
+	req.Header.Set("Accept-Encoding", "gzip")
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	require.Equal(t, "gzip", rec.Header().Get("Content-Encoding"))
+}
+
+// --- CPU Guard Tests ---
+
+func TestCompressionMiddleware_CPUGuard_SkipsWhenOverloaded(t *testing.T) {
+	t.Parallel()
+
+	cfg := defaultCompressionConfig()
+	guard := NewCPUGuard(80, testLogger())
+	handler := CompressionMiddleware(cfg, testLogger(), WithCPUGuard(guard))(testHandler(largeJSON(), "application/json"))
+
+	serve := func() *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/v1/devices", nil)
+		req.Header.Set("Accept-Encoding", "gzip")
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		return rec
+	}
+
+	guard.SetOverloaded(true)
+
+	rec := serve()
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Empty(t, rec.Header().Get("Content-Encoding"))
+	require.Equal(t, largeJSON(), rec.Body.String())
+
+	guard.SetOverloaded(false)
+
+	rec = serve()
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "gzip", rec.Header().Get("Content-Encoding"))
+}
+
+func TestCompressionMiddleware_CPUGuard_MetricsSkipReason(t *testing.T) {
+	t.Parallel()
+
+	cfg := defaultCompressionConfig()
+	mockMetrics := recording.NewMetricsClient()
+	guard := NewCPUGuard(80, testLogger())
+	guard.SetOverloaded(true)
+
+	handler := CompressionMiddlewareWithMetrics(cfg, testLogger(), mockMetrics, WithCPUGuard(guard))(
+		testHandler(largeJSON(), "application/json"),
+	)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/devices", nil)
+	req.Header.Set("Accept-Encoding", "gzip")
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	require.Empty(t, rec.Header().Get("Content-Encoding"))
+	require.True(t, mockMetrics.HasAttribute("http_compression_skipped_total", "compression.skip_reason", "cpu_overloaded"))
+}
+
 // --- Observability Tests ---
 
 func TestCompressionMiddleware_MetricsEmitted(t *testing.T) {
 	t.Parallel()
 
 	cfg := defaultCompressionConfig()
-	mockMetrics := &mockMetricsClient{}
+	mockMetrics := recording.NewMetricsClient()
 	log := testLogger()
 
 	handler := CompressionMiddlewareWithMetrics(cfg, log, mockMetrics)(testHandler(largeJSON(), "application/json"))
@@ -506,10 +896,17 @@ func TestCompressionMiddleware_MetricsEmitted(t *testing.T) {
 	require.Equal(t, "gzip", rec.Header().Get("Content-Encoding"))
 
 	// Verify metrics were recorded
-	require.True(t, mockMetrics.HasMetric("http_compression_total"), "expected http_compression_total metric")
-	require.True(t, mockMetrics.HasMetric("http_compression_original_bytes"), "expected http_compression_original_bytes metric")
-	require.True(t, mockMetrics.HasMetric("http_compression_compressed_bytes"), "expected http_compression_compressed_bytes metric")
-	require.True(t, mockMetrics.HasMetric("http_compression_ratio"), "expected http_compression_ratio metric")
+	require.True(t, mockMetrics.Has("http_compression_total"), "expected http_compression_total metric")
+	require.True(t, mockMetrics.Has("http_compression_original_bytes"), "expected http_compression_original_bytes metric")
+	require.True(t, mockMetrics.Has("http_compression_compressed_bytes"), "expected http_compression_compressed_bytes metric")
+	ratios := mockMetrics.Records("http_compression_ratio")
+	require.Len(t, ratios, 1)
+	require.Equal(t, recording.KindHistogram, ratios[0].Kind, "ratio must be observed, not counted")
+
+	ratio, ok := ratios[0].Value.(float64)
+	require.True(t, ok)
+	require.Greater(t, ratio, 0.0)
+	require.LessOrEqual(t, ratio, 1.0)
 
 	// Verify algorithm attribute
 	require.True(t, mockMetrics.HasAttribute("http_compression_total", "compression.algorithm", "gzip"))
@@ -519,7 +916,7 @@ func TestCompressionMiddleware_MetricsSkipped(t *testing.T) {
 	t.Parallel()
 
 	cfg := defaultCompressionConfig()
-	mockMetrics := &mockMetricsClient{}
+	mockMetrics := recording.NewMetricsClient()
 	log := testLogger()
 
 	// Use small body that won't be compressed
@@ -535,7 +932,7 @@ func TestCompressionMiddleware_MetricsSkipped(t *testing.T) {
 	require.Empty(t, rec.Header().Get("Content-Encoding"))
 
 	// Verify skip metrics were recorded
-	require.True(t, mockMetrics.HasMetric("http_compression_skipped_total"), "expected http_compression_skipped_total metric")
+	require.True(t, mockMetrics.Has("http_compression_skipped_total"), "expected http_compression_skipped_total metric")
 	require.True(t, mockMetrics.HasAttribute("http_compression_skipped_total", "compression.skip_reason", "below_min_size"))
 }
 
@@ -545,7 +942,7 @@ func TestCompressionMiddleware_StructuredLogging(t *testing.T) {
 	// This test verifies the logger is called with structured fields
 	// The actual logging is tested via integration with the logger interface
 	cfg := defaultCompressionConfig()
-	mockMetrics := &mockMetricsClient{}
+	mockMetrics := recording.NewMetricsClient()
 	log := testLogger()
 
 	handler := CompressionMiddlewareWithMetrics(cfg, log, mockMetrics)(testHandler(largeJSON(), "application/json"))
@@ -560,71 +957,6 @@ func TestCompressionMiddleware_StructuredLogging(t *testing.T) {
 	// Logging is verified via the test logger's output in integration tests
 }
 
-// mockMetricsClient is a test double for metrics.Client.
-type mockMetricsClient struct {
-	metrics map[string][]mockMetricRecord
-}
-
-type mockMetricRecord struct {
-	value      any
-	attributes map[string]string
-}
-
-func (m *mockMetricsClient) Inc(_ context.Context, key string, value any, attrs ...attribute.KeyValue) {
-	if m.metrics == nil {
-		m.metrics = make(map[string][]mockMetricRecord)
-	}
-
-	attrMap := make(map[string]string)
-	for _, attr := range attrs {
-		attrMap[string(attr.Key)] = attr.Value.AsString()
-	}
-
-	m.metrics[key] = append(m.metrics[key], mockMetricRecord{
-		value:      value,
-		attributes: attrMap,
-	})
-}
-
-func (m *mockMetricsClient) Handler() http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
-		w.WriteHeader(http.StatusOK)
-	})
-}
-
-func (m *mockMetricsClient) Shutdown(_ context.Context) error {
-	return nil
-}
-
-func (m *mockMetricsClient) HasMetric(name string) bool {
-	if m.metrics == nil {
-		return false
-	}
-
-	_, ok := m.metrics[name]
-
-	return ok
-}
-
-func (m *mockMetricsClient) HasAttribute(metricName, attrKey, attrValue string) bool {
-	if m.metrics == nil {
-		return false
-	}
-
-	records, ok := m.metrics[metricName]
-	if !ok {
-		return false
-	}
-
-	for _, record := range records {
-		if v, found := record.attributes[attrKey]; found && v == attrValue {
-			return true
-		}
-	}
-
-	return false
-}
-
 // --- Config Validation Tests ---
 
 func TestCompression_Validate(t *testing.T) {
@@ -667,6 +999,26 @@ func TestCompression_Validate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "cpu threshold above 100",
+			cfg: config.Compression{
+				Enabled:             true,
+				Level:               5,
+				MinSize:             1024,
+				CPUThresholdPercent: 101,
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative cpu threshold",
+			cfg: config.Compression{
+				Enabled:             true,
+				Level:               5,
+				MinSize:             1024,
+				CPUThresholdPercent: -1,
+			},
+			wantErr: true,
+		},
 		{
 			name: "zero min size is valid",
 			cfg: config.Compression{
@@ -13,12 +13,15 @@ const (
 	headerIfNoneMatch = "If-None-Match"
 )
 
-// BufferedResponseWriter captures the response body for ETag generation.
+// BufferedResponseWriter captures the response body for ETag generation. A handler that
+// flushes is streaming its response, so the first flush sends what has been buffered and
+// every later write goes straight through.
 type BufferedResponseWriter struct {
 	http.ResponseWriter
 	statusCode  int
 	body        *bytes.Buffer
 	wroteHeader bool
+	streaming   bool
 	flusher     http.Flusher
 	hijacker    http.Hijacker
 }
@@ -43,7 +46,7 @@ func NewBufferedResponseWriter(w http.ResponseWriter) *BufferedResponseWriter {
 }
 
 func (w *BufferedResponseWriter) WriteHeader(code int) {
-	if w.wroteHeader {
+	if w.wroteHeader || w.streaming {
 		return
 	}
 
@@ -56,6 +59,10 @@ func (w *BufferedResponseWriter) Write(b []byte) (int, error) {
 		w.WriteHeader(http.StatusOK)
 	}
 
+	if w.streaming {
+		return w.ResponseWriter.Write(b)
+	}
+
 	return w.body.Write(b)
 }
 
@@ -67,7 +74,17 @@ func (w *BufferedResponseWriter) Body() []byte {
 	return w.body.Bytes()
 }
 
+// Streaming reports whether the response has been flushed and sent unbuffered.
+func (w *BufferedResponseWriter) Streaming() bool {
+	return w.streaming
+}
+
 func (w *BufferedResponseWriter) Flush() {
+	if !w.streaming {
+		_ = w.FlushToClient()
+		w.streaming = true
+	}
+
 	if w.flusher != nil {
 		w.flusher.Flush()
 	}
@@ -81,15 +98,27 @@ func (w *BufferedResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
 	return nil, nil, http.ErrNotSupported
 }
 
+func (w *BufferedResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// FlushToClient sends the buffered status and body, unless the response is already
+// streaming and has been sent.
 func (w *BufferedResponseWriter) FlushToClient() error {
+	if w.streaming {
+		return nil
+	}
+
 	w.ResponseWriter.WriteHeader(w.statusCode)
 	_, err := w.ResponseWriter.Write(w.body.Bytes())
+	w.body.Reset()
 
 	return err
 }
 
 // ConditionalGET returns a middleware that handles conditional GET requests.
 // It generates ETags for responses and returns 304 Not Modified when appropriate.
+// Responses the handler flushes, such as the device export, are streamed untouched.
 func ConditionalGET(generator *ETagGenerator) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -103,7 +132,14 @@ func ConditionalGET(generator *ETagGenerator) func(http.Handler) http.Handler {
 
 			next.ServeHTTP(brw, r)
 
-			if brw.StatusCode() >= 300 {
+			// A streamed response has already been sent without a validator.
+			if brw.Streaming() {
+				return
+			}
+
+			// Handlers that set their own validator have already answered the
+			// conditional request, so their ETag must not be replaced by a body hash.
+			if brw.StatusCode() >= 300 || brw.Header().Get(headerETag) != "" {
 				_ = brw.FlushToClient()
 
 				return
@@ -218,6 +218,58 @@ func TestConditionalGET_PreservesHeaders(t *testing.T) {
 	require.Equal(t, "private, max-age=60", rec.Header().Get("Cache-Control"))
 }
 
+func TestConditionalGET_KeepsHandlerETag(t *testing.T) {
+	t.Parallel()
+
+	generator := middleware.NewETagGenerator()
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", `W/"device-1700000000"`)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"test":"data"}`))
+	})
+
+	mw := middleware.ConditionalGET(generator)
+	req := httptest.NewRequest(http.MethodGet, "/test", nil)
+	rec := httptest.NewRecorder()
+
+	mw(handler).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, `W/"device-1700000000"`, rec.Header().Get("ETag"))
+	require.JSONEq(t, `{"test":"data"}`, rec.Body.String())
+}
+
+func TestConditionalGET_StreamsFlushedResponse(t *testing.T) {
+	t.Parallel()
+
+	generator := middleware.NewETagGenerator()
+
+	var flushedBeforeEnd bool
+
+	rec := httptest.NewRecorder()
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/csv")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("id,name\n"))
+
+		require.NoError(t, http.NewResponseController(w).Flush())
+
+		flushedBeforeEnd = rec.Body.String() == "id,name\n"
+
+		_, _ = w.Write([]byte("1,iPhone\n"))
+	})
+
+	middleware.ConditionalGET(generator)(handler).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/devices/export", nil))
+
+	require.True(t, flushedBeforeEnd, "the flushed part reaches the client while the handler runs")
+	require.True(t, rec.Flushed)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Empty(t, rec.Header().Get("ETag"))
+	require.Equal(t, "text/csv", rec.Header().Get("Content-Type"))
+	require.Equal(t, "id,name\n1,iPhone\n", rec.Body.String())
+}
+
 func TestBufferedResponseWriter(t *testing.T) {
 	t.Parallel()
 
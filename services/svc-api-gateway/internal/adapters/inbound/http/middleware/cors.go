@@ -1,8 +1,24 @@
 package middleware
 
-import "net/http"
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/config"
+)
+
+// CORSMiddleware adds Access-Control-* headers for allowed origins and answers
+// preflight OPTIONS requests with 204. Requests from other origins pass through
+// without CORS headers, leaving enforcement to the browser.
+// The configuration must be validated with config.CORS.Validate beforehand.
+func CORSMiddleware(cfg config.CORS) func(http.Handler) http.Handler {
+	allowAll := slices.Contains(cfg.AllowedOrigins, "*")
+	allowedMethods := strings.Join(cfg.AllowedMethods, ", ")
+	allowedHeaders := strings.Join(cfg.AllowedHeaders, ", ")
+	exposedHeaders := strings.Join(cfg.ExposedHeaders, ", ")
 
-func CORS(allowedOrigins []string) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			origin := r.Header.Get("Origin")
@@ -14,28 +30,49 @@ func CORS(allowedOrigins []string) func(http.Handler) http.Handler {
 				return
 			}
 
-			allowed := false
-			for _, allowedOrigin := range allowedOrigins {
-				if allowedOrigin == "*" || allowedOrigin == origin {
-					allowed = true
+			// The response varies by origin whenever the origin is echoed back
+			appendVary(w.Header(), "Origin")
+
+			if !allowAll && !slices.Contains(cfg.AllowedOrigins, origin) {
+				reqLogger := LoggerFromContext(r.Context())
+				reqLogger.Debug().
+					Str("origin", origin).
+					Str("path", r.URL.Path).
+					Msg("cross-origin request from disallowed origin")
+
+				next.ServeHTTP(w, r)
 
-					break
-				}
+				return
 			}
 
-			if allowed {
-				w.Header().Set("Access-Control-Allow-Origin", origin)
-				w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS, HEAD")
-				w.Header().Set("Access-Control-Allow-Headers", "Authorization, Content-Type, Request-Id, Correlation-Id, API-Version, If-Match, If-None-Match, traceparent, tracestate, Idempotency-Key, PASETO-Token")
-				w.Header().Set("Access-Control-Expose-Headers", "Request-Id, Correlation-Id, RateLimit-Limit, RateLimit-Remaining, RateLimit-Reset, ETag, Location")
-				w.Header().Set("Access-Control-Max-Age", "86400")
+			headers := w.Header()
+			headers.Set("Access-Control-Allow-Origin", origin)
+
+			if cfg.AllowCredentials {
+				headers.Set("Access-Control-Allow-Credentials", "true")
+			}
 
-				// Handle CORS preflight requests (OPTIONS with valid Origin header)
-				if r.Method == http.MethodOptions {
-					w.WriteHeader(http.StatusNoContent)
+			if allowedMethods != "" {
+				headers.Set("Access-Control-Allow-Methods", allowedMethods)
+			}
 
-					return
-				}
+			if allowedHeaders != "" {
+				headers.Set("Access-Control-Allow-Headers", allowedHeaders)
+			}
+
+			if exposedHeaders != "" {
+				headers.Set("Access-Control-Expose-Headers", exposedHeaders)
+			}
+
+			if cfg.MaxAge > 0 {
+				headers.Set("Access-Control-Max-Age", strconv.FormatUint(uint64(cfg.MaxAge), 10))
+			}
+
+			// Handle CORS preflight requests (OPTIONS with valid Origin header)
+			if r.Method == http.MethodOptions {
+				w.WriteHeader(http.StatusNoContent)
+
+				return
 			}
 
 			next.ServeHTTP(w, r)
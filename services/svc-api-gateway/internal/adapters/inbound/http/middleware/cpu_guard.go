@@ -0,0 +1,143 @@
+package middleware
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/architeacher/devices/pkg/logger"
+	"github.com/shirou/gopsutil/v4/cpu"
+)
+
+// defaultCPUSampleInterval is how often the CPU guard samples CPU utilisation.
+const defaultCPUSampleInterval = time.Second
+
+type (
+	// cpuSampler returns the current CPU utilisation as a percentage (0-100).
+	cpuSampler func(ctx context.Context) (float64, error)
+
+	// CPUGuard tracks whether CPU utilisation exceeds a threshold so that
+	// optional work, such as response compression, can be shed under load.
+	CPUGuard struct {
+		threshold  float64
+		interval   time.Duration
+		sample     cpuSampler
+		log        logger.Logger
+		overloaded atomic.Bool
+
+		stopOnce sync.Once
+		stop     chan struct{}
+		done     chan struct{}
+	}
+)
+
+// NewCPUGuard creates a guard that reports overload when CPU utilisation
+// exceeds thresholdPercent. Call Start to begin sampling.
+func NewCPUGuard(thresholdPercent float64, log logger.Logger) *CPUGuard {
+	return &CPUGuard{
+		threshold: thresholdPercent,
+		interval:  defaultCPUSampleInterval,
+		sample:    sampleCPUPercent,
+		log:       log,
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+}
+
+// Start launches the background sampling goroutine.
+func (g *CPUGuard) Start() {
+	go g.run()
+}
+
+// Stop terminates the background sampling goroutine and waits for it to exit.
+func (g *CPUGuard) Stop(ctx context.Context) error {
+	g.stopOnce.Do(func() {
+		close(g.stop)
+	})
+
+	select {
+	case <-g.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Overloaded reports whether CPU utilisation was above the threshold at the last sample.
+func (g *CPUGuard) Overloaded() bool {
+	return g.overloaded.Load()
+}
+
+// SetOverloaded forces the overload state. Intended for tests; the next
+// sample taken by a running guard overrides it.
+func (g *CPUGuard) SetOverloaded(overloaded bool) {
+	g.overloaded.Store(overloaded)
+}
+
+func (g *CPUGuard) run() {
+	defer close(g.done)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ticker := time.NewTicker(g.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-g.stop:
+			return
+		case <-ticker.C:
+			g.evaluate(ctx)
+		}
+	}
+}
+
+// evaluate takes one CPU sample and updates the overload state, logging
+// when the threshold is crossed in either direction.
+func (g *CPUGuard) evaluate(ctx context.Context) {
+	percent, err := g.sample(ctx)
+	if err != nil {
+		g.log.Debug().Err(err).Msg("failed to sample CPU utilisation")
+
+		return
+	}
+
+	overloaded := percent > g.threshold
+	if g.overloaded.Swap(overloaded) == overloaded {
+		return
+	}
+
+	var memStats runtime.MemStats
+	runtime.ReadMemStats(&memStats)
+
+	event := g.log.Warn().
+		Float64("cpu_percent", percent).
+		Float64("cpu_threshold_percent", g.threshold).
+		Uint64("heap_alloc_bytes", memStats.HeapAlloc).
+		Int("goroutines", runtime.NumGoroutine())
+
+	if overloaded {
+		event.Msg("CPU threshold exceeded, response compression disabled")
+
+		return
+	}
+
+	event.Msg("CPU back below threshold, response compression resumed")
+}
+
+// sampleCPUPercent returns the system-wide CPU utilisation since the previous call.
+func sampleCPUPercent(ctx context.Context) (float64, error) {
+	percents, err := cpu.PercentWithContext(ctx, 0, false)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(percents) == 0 {
+		return 0, nil
+	}
+
+	return percents[0], nil
+}
@@ -0,0 +1,102 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/architeacher/devices/pkg/logger"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCPUGuard_Evaluate(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name               string
+		initial            bool
+		sample             float64
+		sampleErr          error
+		expectedOverloaded bool
+		expectedLog        string
+	}{
+		{
+			name:               "crossing above threshold logs and overloads",
+			initial:            false,
+			sample:             95,
+			expectedOverloaded: true,
+			expectedLog:        "CPU threshold exceeded",
+		},
+		{
+			name:               "crossing below threshold logs and recovers",
+			initial:            true,
+			sample:             10,
+			expectedOverloaded: false,
+			expectedLog:        "CPU back below threshold",
+		},
+		{
+			name:               "staying below threshold does not log",
+			initial:            false,
+			sample:             10,
+			expectedOverloaded: false,
+		},
+		{
+			name:               "staying above threshold does not log",
+			initial:            true,
+			sample:             95,
+			expectedOverloaded: true,
+		},
+		{
+			name:               "sampling error keeps previous state",
+			initial:            true,
+			sampleErr:          errors.New("sampling failed"),
+			expectedOverloaded: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+
+			guard := NewCPUGuard(80, logger.NewBufferedTestLogger(&buf))
+			guard.sample = func(context.Context) (float64, error) {
+				return tc.sample, tc.sampleErr
+			}
+			guard.SetOverloaded(tc.initial)
+
+			guard.evaluate(context.Background())
+
+			require.Equal(t, tc.expectedOverloaded, guard.Overloaded())
+
+			if tc.expectedLog == "" {
+				require.NotContains(t, buf.String(), `"level":"warn"`)
+			} else {
+				require.Contains(t, buf.String(), tc.expectedLog)
+			}
+		})
+	}
+}
+
+func TestCPUGuard_StartStop(t *testing.T) {
+	t.Parallel()
+
+	guard := NewCPUGuard(50, logger.NewTestLogger())
+	guard.interval = 5 * time.Millisecond
+	guard.sample = func(context.Context) (float64, error) {
+		return 90, nil
+	}
+
+	guard.Start()
+
+	require.Eventually(t, guard.Overloaded, time.Second, 5*time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	require.NoError(t, guard.Stop(ctx))
+	require.NoError(t, guard.Stop(ctx))
+}
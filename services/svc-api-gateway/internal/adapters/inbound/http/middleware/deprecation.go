@@ -0,0 +1,115 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/architeacher/devices/pkg/logger"
+	"github.com/architeacher/devices/pkg/metrics"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/config"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const deprecationRequestsTotal = "deprecation_requests_total"
+
+type (
+	// DeprecationOption configures optional deprecation middleware behaviour.
+	DeprecationOption func(*deprecationOptions)
+
+	deprecationOptions struct {
+		metricsClient  metrics.Client
+		pathNormalizer PathNormalizer
+	}
+)
+
+// WithDeprecationMetrics counts requests served by the deprecated API as
+// deprecation_requests_total{path}, using DefaultPathNormalizer for the label.
+func WithDeprecationMetrics(metricsClient metrics.Client) DeprecationOption {
+	return func(o *deprecationOptions) {
+		o.metricsClient = metricsClient
+	}
+}
+
+// DeprecationMiddleware adds RFC 8594 compliant deprecation headers to responses.
+// When enabled, it adds:
+//   - Deprecation: true (indicates the API is deprecated)
+//   - Sunset: <date> (indicates when the API will be removed)
+//   - Link: <url>; rel="successor-version" (points to the new version)
+//
+// The sunset date is parsed once when the middleware is built; an unparsable or
+// already expired date is reported with a single warning instead of per request.
+func DeprecationMiddleware(cfg config.Deprecation, log logger.Logger, opts ...DeprecationOption) func(http.Handler) http.Handler {
+	options := deprecationOptions{
+		pathNormalizer: DefaultPathNormalizer,
+	}
+
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	var sunset, link string
+
+	if cfg.Enabled {
+		sunset = sunsetHeader(cfg.SunsetDate, log)
+
+		if cfg.SuccessorPath != "" {
+			link = fmt.Sprintf("<%s>; rel=\"successor-version\"", cfg.SuccessorPath)
+		}
+	}
+
+	return func(next http.Handler) http.Handler {
+		if !cfg.Enabled {
+			return next
+		}
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Deprecation", "true")
+
+			if sunset != "" {
+				w.Header().Set("Sunset", sunset)
+			}
+
+			if link != "" {
+				w.Header().Set("Link", link)
+			}
+
+			if options.metricsClient != nil {
+				options.metricsClient.Inc(
+					r.Context(),
+					deprecationRequestsTotal,
+					int64(1),
+					attribute.String("path", options.pathNormalizer(r.URL.Path)),
+				)
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// sunsetHeader formats the configured RFC 3339 sunset date as an HTTP date,
+// returning an empty string when no usable date is configured.
+func sunsetHeader(sunsetDate string, log logger.Logger) string {
+	if sunsetDate == "" {
+		return ""
+	}
+
+	sunsetTime, err := time.Parse(time.RFC3339, sunsetDate)
+	if err != nil {
+		log.Warn().
+			Err(err).
+			Str("sunset_date", sunsetDate).
+			Msg("ignoring invalid API sunset date")
+
+		return ""
+	}
+
+	if sunsetTime.Before(time.Now()) {
+		log.Warn().
+			Str("sunset_date", sunsetDate).
+			Msg("API sunset date has already passed")
+	}
+
+	return sunsetTime.UTC().Format(http.TimeFormat)
+}
@@ -0,0 +1,155 @@
+package middleware_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/architeacher/devices/pkg/logger"
+	"github.com/architeacher/devices/pkg/metrics/recording"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/adapters/inbound/http/middleware"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/config"
+	"github.com/stretchr/testify/suite"
+)
+
+type DeprecationMiddlewareSuite struct {
+	suite.Suite
+}
+
+func TestDeprecationMiddlewareSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(DeprecationMiddlewareSuite))
+}
+
+func (s *DeprecationMiddlewareSuite) TestSetsHeadersAndCountsRequests() {
+	s.T().Parallel()
+
+	cfg := config.Deprecation{
+		Enabled:       true,
+		SunsetDate:    time.Now().Add(30 * 24 * time.Hour).UTC().Format(time.RFC3339),
+		SuccessorPath: "/v2/devices",
+	}
+
+	var logBuffer bytes.Buffer
+
+	metricsClient := recording.NewMetricsClient()
+	handler := middleware.DeprecationMiddleware(
+		cfg,
+		logger.NewBufferedTestLogger(&logBuffer),
+		middleware.WithDeprecationMetrics(metricsClient),
+	)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/devices/0190a8c2-5b6f-7c3d-9e1a-2b3c4d5e6f70", nil)
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	sunsetTime, err := time.Parse(time.RFC3339, cfg.SunsetDate)
+	s.Require().NoError(err)
+
+	s.Require().Equal(http.StatusOK, rec.Code)
+	s.Require().Equal("true", rec.Header().Get("Deprecation"))
+	s.Require().Equal(sunsetTime.Format(http.TimeFormat), rec.Header().Get("Sunset"))
+	s.Require().Equal(`</v2/devices>; rel="successor-version"`, rec.Header().Get("Link"))
+	s.Require().Empty(logBuffer.String())
+
+	recorded := metricsClient.Records("deprecation_requests_total")
+	s.Require().Len(recorded, 1)
+	s.Require().Equal(int64(1), recorded[0].Value)
+	s.Require().Equal("/v1/devices/{id}", recorded[0].AttributeMap()["path"])
+}
+
+func (s *DeprecationMiddlewareSuite) TestDisabledLeavesResponseUntouched() {
+	s.T().Parallel()
+
+	metricsClient := recording.NewMetricsClient()
+	handler := middleware.DeprecationMiddleware(
+		config.Deprecation{Enabled: false, SunsetDate: "2020-01-01T00:00:00Z", SuccessorPath: "/v2/devices"},
+		logger.NewTestLogger(),
+		middleware.WithDeprecationMetrics(metricsClient),
+	)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/devices", nil))
+
+	s.Require().Equal(http.StatusOK, rec.Code)
+	s.Require().Empty(rec.Header().Get("Deprecation"))
+	s.Require().Empty(rec.Header().Get("Sunset"))
+	s.Require().Empty(rec.Header().Get("Link"))
+	s.Require().Empty(metricsClient.Records("deprecation_requests_total"))
+}
+
+func (s *DeprecationMiddlewareSuite) TestExpiredSunsetDateWarnsOnceAtStartup() {
+	s.T().Parallel()
+
+	var logBuffer bytes.Buffer
+
+	handler := middleware.DeprecationMiddleware(
+		config.Deprecation{Enabled: true, SunsetDate: "2020-01-01T00:00:00Z"},
+		logger.NewBufferedTestLogger(&logBuffer),
+	)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	for range 3 {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/devices", nil))
+
+		s.Require().Equal(http.StatusOK, rec.Code)
+		s.Require().Equal("Wed, 01 Jan 2020 00:00:00 GMT", rec.Header().Get("Sunset"))
+	}
+
+	lines := bytes.Split(bytes.TrimSpace(logBuffer.Bytes()), []byte("\n"))
+	s.Require().Len(lines, 1)
+
+	var entry map[string]any
+	s.Require().NoError(json.Unmarshal(lines[0], &entry))
+	s.Require().Equal("warn", entry["level"])
+	s.Require().Equal("API sunset date has already passed", entry["message"])
+	s.Require().Equal("2020-01-01T00:00:00Z", entry["sunset_date"])
+}
+
+func (s *DeprecationMiddlewareSuite) TestWithoutSuccessorPathOmitsLink() {
+	s.T().Parallel()
+
+	handler := middleware.DeprecationMiddleware(
+		config.Deprecation{Enabled: true, SunsetDate: "2099-06-30T00:00:00Z"},
+		logger.NewTestLogger(),
+	)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/devices", nil))
+
+	s.Require().Equal(http.StatusOK, rec.Code)
+	s.Require().Equal("true", rec.Header().Get("Deprecation"))
+	s.Require().Equal("Tue, 30 Jun 2099 00:00:00 GMT", rec.Header().Get("Sunset"))
+	s.Require().Empty(rec.Header().Get("Link"))
+}
+
+func (s *DeprecationMiddlewareSuite) TestInvalidSunsetDateOmitsSunset() {
+	s.T().Parallel()
+
+	handler := middleware.DeprecationMiddleware(
+		config.Deprecation{Enabled: true, SunsetDate: "invalid-date", SuccessorPath: "/v2/devices"},
+		logger.NewTestLogger(),
+	)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/devices", nil))
+
+	s.Require().Equal(http.StatusOK, rec.Code)
+	s.Require().Equal("true", rec.Header().Get("Deprecation"))
+	s.Require().Empty(rec.Header().Get("Sunset"))
+	s.Require().Equal(`</v2/devices>; rel="successor-version"`, rec.Header().Get("Link"))
+}
@@ -0,0 +1,87 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+const (
+	// FieldsQueryParam is the query parameter listing the device fields a client wants.
+	FieldsQueryParam = "fields"
+
+	// FieldSetKey holds the FieldSet requested through ?fields=.
+	FieldSetKey contextKey = "fieldSet"
+)
+
+// DeviceFields lists the device fields clients can select, by their JSON names.
+var DeviceFields = []string{"id", "name", "brand", "state", "createdAt", "updatedAt", "links"}
+
+// FieldSet is the set of device fields a client selected.
+type FieldSet map[string]struct{}
+
+// Has reports whether the field is selected. A nil set selects every field.
+func (s FieldSet) Has(field string) bool {
+	if s == nil {
+		return true
+	}
+
+	_, ok := s[field]
+
+	return ok
+}
+
+// FieldFilterMiddleware parses a comma-separated ?fields= list, e.g. fields=id,name,state,
+// into a FieldSet stored in the request context. Requests without the parameter, or with
+// an empty one, carry no set and are served every field. A request naming a field missing
+// from DeviceFields is answered with 400 Bad Request.
+func FieldFilterMiddleware() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			fields, err := parseFieldSet(r.URL.Query().Get(FieldsQueryParam))
+			if err != nil {
+				writeError(w, http.StatusBadRequest, "INVALID_FIELDS", err.Error())
+
+				return
+			}
+
+			if fields != nil {
+				r = r.WithContext(context.WithValue(r.Context(), FieldSetKey, fields))
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// GetFieldSet returns the fields the client selected, or nil when it selected none.
+func GetFieldSet(ctx context.Context) FieldSet {
+	fields, _ := ctx.Value(FieldSetKey).(FieldSet)
+
+	return fields
+}
+
+func parseFieldSet(value string) (FieldSet, error) {
+	var fields FieldSet
+
+	for field := range strings.SplitSeq(value, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+
+		if !slices.Contains(DeviceFields, field) {
+			return nil, fmt.Errorf("unknown field %q, supported fields are %s", field, strings.Join(DeviceFields, ", "))
+		}
+
+		if fields == nil {
+			fields = make(FieldSet, len(DeviceFields))
+		}
+
+		fields[field] = struct{}{}
+	}
+
+	return fields, nil
+}
@@ -0,0 +1,97 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/adapters/inbound/http/middleware"
+	"github.com/stretchr/testify/suite"
+)
+
+type FieldFilterMiddlewareSuite struct {
+	suite.Suite
+}
+
+func TestFieldFilterMiddlewareSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(FieldFilterMiddlewareSuite))
+}
+
+func (s *FieldFilterMiddlewareSuite) TestParsing() {
+	s.T().Parallel()
+
+	cases := []struct {
+		name           string
+		target         string
+		expectedStatus int
+		expectedFields middleware.FieldSet
+	}{
+		{
+			name:           "no query parameter",
+			target:         "/v1/devices",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "empty query parameter",
+			target:         "/v1/devices?fields=",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "selected fields",
+			target:         "/v1/devices?fields=id,name,state",
+			expectedStatus: http.StatusOK,
+			expectedFields: middleware.FieldSet{"id": {}, "name": {}, "state": {}},
+		},
+		{
+			name:           "spaces and empty entries",
+			target:         "/v1/devices?fields=+id+,,createdAt,",
+			expectedStatus: http.StatusOK,
+			expectedFields: middleware.FieldSet{"id": {}, "createdAt": {}},
+		},
+		{
+			name:           "unknown field",
+			target:         "/v1/devices?fields=id,price",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "field names are case-sensitive",
+			target:         "/v1/devices?fields=CreatedAt",
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			var (
+				called bool
+				fields middleware.FieldSet
+			)
+
+			handler := middleware.FieldFilterMiddleware()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				called = true
+				fields = middleware.GetFieldSet(r.Context())
+				w.WriteHeader(http.StatusOK)
+			}))
+
+			rec := httptest.NewRecorder()
+
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tc.target, nil))
+
+			s.Require().Equal(tc.expectedStatus, rec.Code)
+			s.Require().Equal(tc.expectedStatus == http.StatusOK, called)
+			s.Require().Equal(tc.expectedFields, fields)
+		})
+	}
+}
+
+func (s *FieldFilterMiddlewareSuite) TestFieldSetHas() {
+	s.T().Parallel()
+
+	var all middleware.FieldSet
+	s.Require().True(all.Has("brand"))
+
+	selected := middleware.FieldSet{"id": {}}
+	s.Require().True(selected.Has("id"))
+	s.Require().False(selected.Has("brand"))
+}
@@ -2,22 +2,32 @@ package middleware
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"io"
 	"net/http"
 	"slices"
 	"time"
 
 	"github.com/architeacher/devices/pkg/idempotency"
-	"github.com/architeacher/devices/pkg/logger"
+	"github.com/architeacher/devices/pkg/metrics"
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/config"
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/ports"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// Idempotency metrics constants.
+const (
+	idempotencyReplaysTotal   = "idempotency_replays_total"
+	idempotencyConflictsTotal = "idempotency_conflicts_total"
 )
 
 // IdempotencyMiddleware returns the HTTP middleware handler.
+// Replays and conflicting key reuse are counted via metricsClient, which may be nil.
 func IdempotencyMiddleware(
 	cache ports.IdempotencyCache,
 	cfg config.Idempotency,
-	log logger.Logger,
+	metricsClient metrics.Client,
 ) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -40,17 +50,53 @@ func IdempotencyMiddleware(
 				return
 			}
 
+			requestHash, err := hashRequestBody(r)
+			if err != nil {
+				writeError(w, http.StatusBadRequest, "INVALID_REQUEST_BODY", "failed to read request body")
+
+				return
+			}
+
 			cacheKey := idempotency.BuildCacheKey(r.Method, r.URL.Path, idempotencyKey)
 			ctx := r.Context()
 
 			cached, err := cache.Get(ctx, cacheKey)
 			if err != nil {
-				handleCacheError(w, r, next, cfg, log, err, "cache get failed")
+				handleCacheError(w, r, next, cfg, err, "cache get failed")
 
 				return
 			}
 
 			if cached != nil {
+				if cached.RequestHash != "" && cached.RequestHash != requestHash {
+					recordIdempotencyEvent(ctx, metricsClient, idempotencyConflictsTotal, r)
+
+					reqLogger := LoggerFromContext(r.Context())
+
+					reqLogger.Warn().
+						Str("idempotency_key", idempotencyKey).
+						Str("method", r.Method).
+						Str("path", r.URL.Path).
+						Int("cached_status", cached.StatusCode).
+						Msg("idempotency key reused with a different request body")
+
+					writeError(w, http.StatusUnprocessableEntity, "IDEMPOTENCY_KEY_REUSED",
+						"idempotency key was already used with a different request body")
+
+					return
+				}
+
+				recordIdempotencyEvent(ctx, metricsClient, idempotencyReplaysTotal, r)
+
+				reqLogger := LoggerFromContext(r.Context())
+
+				reqLogger.Debug().
+					Str("idempotency_key", idempotencyKey).
+					Str("method", r.Method).
+					Str("path", r.URL.Path).
+					Int("cached_status", cached.StatusCode).
+					Msg("replaying cached idempotent response")
+
 				writeCachedResponse(w, cfg, cached)
 
 				return
@@ -58,7 +104,7 @@ func IdempotencyMiddleware(
 
 			acquired, err := cache.SetLock(ctx, cacheKey, cfg.LockTTL)
 			if err != nil {
-				handleCacheError(w, r, next, cfg, log, err, "cache lock failed")
+				handleCacheError(w, r, next, cfg, err, "cache lock failed")
 
 				return
 			}
@@ -72,7 +118,8 @@ func IdempotencyMiddleware(
 
 			defer func() {
 				if releaseErr := cache.ReleaseLock(ctx, cacheKey); releaseErr != nil {
-					log.Warn().Err(releaseErr).
+					reqLogger := LoggerFromContext(r.Context())
+					reqLogger.Warn().Err(releaseErr).
 						Str("idempotency_key", idempotencyKey).
 						Msg("failed to release lock")
 				}
@@ -83,16 +130,18 @@ func IdempotencyMiddleware(
 			recorder := newResponseRecorder(w)
 			next.ServeHTTP(recorder, r.WithContext(ctx))
 
-			if recorder.statusCode >= http.StatusOK && recorder.statusCode < http.StatusMultipleChoices {
+			if !recorder.flushed && recorder.statusCode >= http.StatusOK && recorder.statusCode < http.StatusMultipleChoices {
 				response := &ports.CachedResponse{
-					StatusCode: recorder.statusCode,
-					Headers:    recorder.capturedHeaders(),
-					Body:       recorder.body.Bytes(),
-					CreatedAt:  time.Now().UTC(),
+					StatusCode:  recorder.statusCode,
+					Headers:     recorder.capturedHeaders(),
+					Body:        recorder.body.Bytes(),
+					CreatedAt:   time.Now().UTC(),
+					RequestHash: requestHash,
 				}
 
 				if cacheErr := cache.Set(ctx, cacheKey, response, cfg.CacheTTL); cacheErr != nil {
-					log.Warn().Err(cacheErr).
+					reqLogger := LoggerFromContext(r.Context())
+					reqLogger.Warn().Err(cacheErr).
 						Str("idempotency_key", idempotencyKey).
 						Msg("failed to cache response")
 				}
@@ -101,6 +150,41 @@ func IdempotencyMiddleware(
 	}
 }
 
+// hashRequestBody digests the request body in a single pass, teeing it into a buffer
+// that replaces the body so downstream handlers still see it in full.
+func hashRequestBody(r *http.Request) (string, error) {
+	if r.Body == nil || r.Body == http.NoBody {
+		return idempotency.HashBody(nil), nil
+	}
+
+	var body bytes.Buffer
+
+	hash, err := idempotency.HashReader(io.TeeReader(r.Body, &body))
+	if err != nil {
+		return "", err
+	}
+
+	_ = r.Body.Close()
+	r.Body = io.NopCloser(&body)
+
+	return hash, nil
+}
+
+// recordIdempotencyEvent increments the given idempotency counter for the request.
+func recordIdempotencyEvent(ctx context.Context, metricsClient metrics.Client, name string, r *http.Request) {
+	if metricsClient == nil {
+		return
+	}
+
+	metricsClient.Inc(
+		ctx,
+		name,
+		int64(1),
+		attribute.String(httpMethodKey, r.Method),
+		attribute.String(httpPathKey, r.URL.Path),
+	)
+}
+
 func writeCachedResponse(w http.ResponseWriter, cfg config.Idempotency, cached *ports.CachedResponse) {
 	for key, value := range cached.Headers {
 		w.Header().Set(key, value)
@@ -129,11 +213,11 @@ func handleCacheError(
 	r *http.Request,
 	next http.Handler,
 	cfg config.Idempotency,
-	log logger.Logger,
 	err error,
 	msg string,
 ) {
-	log.Warn().Err(err).Str("msg", msg).Send()
+	reqLogger := LoggerFromContext(r.Context())
+	reqLogger.Warn().Err(err).Str("msg", msg).Send()
 
 	if cfg.GracefulDegraded {
 		next.ServeHTTP(w, r)
@@ -145,11 +229,13 @@ func handleCacheError(
 		"idempotency service temporarily unavailable")
 }
 
-// responseRecorder captures the response for caching.
+// responseRecorder captures the response for caching. A flushed response is streaming,
+// so its body is no longer captured and it must not be cached.
 type responseRecorder struct {
 	http.ResponseWriter
 	statusCode int
 	body       *bytes.Buffer
+	flushed    bool
 }
 
 func newResponseRecorder(w http.ResponseWriter) *responseRecorder {
@@ -166,11 +252,24 @@ func (r *responseRecorder) WriteHeader(code int) {
 }
 
 func (r *responseRecorder) Write(b []byte) (int, error) {
-	r.body.Write(b)
+	if !r.flushed {
+		r.body.Write(b)
+	}
 
 	return r.ResponseWriter.Write(b)
 }
 
+func (r *responseRecorder) Flush() {
+	r.flushed = true
+	r.body.Reset()
+
+	_ = http.NewResponseController(r.ResponseWriter).Flush()
+}
+
+func (r *responseRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+
 func (r *responseRecorder) capturedHeaders() map[string]string {
 	headers := make(map[string]string)
 
@@ -2,6 +2,7 @@ package middleware_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -9,7 +10,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/architeacher/devices/pkg/idempotency"
 	"github.com/architeacher/devices/pkg/logger"
+	"github.com/architeacher/devices/pkg/metrics/recording"
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/adapters/inbound/http/middleware"
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/config"
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/mocks"
@@ -20,6 +23,8 @@ import (
 type IdempotencyMiddlewareTestSuite struct {
 	suite.Suite
 	mockCache *mocks.FakeIdempotencyCache
+	metrics   *recording.MetricsClient
+	logBuffer *bytes.Buffer
 	handler   func(http.Handler) http.Handler
 	log       logger.Logger
 	cfg       config.Idempotency
@@ -32,7 +37,9 @@ func TestIdempotencyMiddlewareSuite(t *testing.T) {
 
 func (s *IdempotencyMiddlewareTestSuite) SetupTest() {
 	s.mockCache = new(mocks.FakeIdempotencyCache)
-	s.log = logger.New("debug", "console")
+	s.metrics = recording.NewMetricsClient()
+	s.logBuffer = new(bytes.Buffer)
+	s.log = logger.NewWithWriter("debug", "json", s.logBuffer)
 	s.cfg = config.Idempotency{
 		Enabled:          true,
 		CacheTTL:         24 * time.Hour,
@@ -42,13 +49,18 @@ func (s *IdempotencyMiddlewareTestSuite) SetupTest() {
 		ReplayedHeader:   "Idempotent-Replayed",
 		GracefulDegraded: true,
 	}
-	s.handler = middleware.IdempotencyMiddleware(s.mockCache, s.cfg, s.log)
+
+	idempotency := middleware.IdempotencyMiddleware(s.mockCache, s.cfg, s.metrics)
+	s.handler = func(next http.Handler) http.Handler {
+		// The middleware logs through the request-scoped logger, as it does in the router.
+		return middleware.RequestLoggerMiddleware(s.log)(idempotency(next))
+	}
 }
 
 func (s *IdempotencyMiddlewareTestSuite) TestMiddleware_SkipsWhenDisabled() {
 	cfg := s.cfg
 	cfg.Enabled = false
-	handler := middleware.IdempotencyMiddleware(s.mockCache, cfg, s.log)
+	handler := middleware.IdempotencyMiddleware(s.mockCache, cfg, s.metrics)
 
 	handlerCalled := false
 	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -143,6 +155,87 @@ func (s *IdempotencyMiddlewareTestSuite) TestMiddleware_ReturnsCachedResponse()
 	s.Require().Equal("application/json", rec.Header().Get("Content-Type"))
 	s.Require().Equal("true", rec.Header().Get("Idempotent-Replayed"))
 	s.Require().Equal(`{"data":{"id":"123"}}`, rec.Body.String())
+
+	s.Require().Len(s.metrics.All(), 1)
+	s.Require().Equal("idempotency_replays_total", s.metrics.All()[0].Name)
+	s.Require().Equal(map[string]string{
+		"http.method": http.MethodPost,
+		"http.path":   "/v1/devices",
+	}, s.metrics.All()[0].AttributeMap())
+
+	entry := s.lastLogEntry()
+	s.Require().Equal("debug", entry["level"])
+	s.Require().Equal("550e8400-e29b-41d4-a716-446655440000", entry["idempotency_key"])
+	s.Require().Equal(http.MethodPost, entry["method"])
+	s.Require().Equal("/v1/devices", entry["path"])
+	s.Require().EqualValues(http.StatusCreated, entry["cached_status"])
+}
+
+func (s *IdempotencyMiddlewareTestSuite) TestMiddleware_ReplaysWhenRequestBodyMatches() {
+	body := []byte(`{"name":"test"}`)
+
+	s.mockCache.GetReturns(&ports.CachedResponse{
+		StatusCode:  http.StatusCreated,
+		Body:        []byte(`{"data":{"id":"123"}}`),
+		CreatedAt:   time.Now().UTC(),
+		RequestHash: idempotency.HashBody(body),
+	}, nil)
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.Fail("handler should not be called")
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/devices", bytes.NewReader(body))
+	req.Header.Set("Idempotency-Key", "550e8400-e29b-41d4-a716-446655440000")
+	rec := httptest.NewRecorder()
+
+	s.handler(next).ServeHTTP(rec, req)
+
+	s.Require().Equal(http.StatusCreated, rec.Code)
+	s.Require().Equal("true", rec.Header().Get("Idempotent-Replayed"))
+	s.Require().Len(s.metrics.All(), 1)
+	s.Require().Equal("idempotency_replays_total", s.metrics.All()[0].Name)
+}
+
+func (s *IdempotencyMiddlewareTestSuite) TestMiddleware_RejectsKeyReuseWithDifferentBody() {
+	s.mockCache.GetReturns(&ports.CachedResponse{
+		StatusCode:  http.StatusCreated,
+		Body:        []byte(`{"data":{"id":"123"}}`),
+		CreatedAt:   time.Now().UTC(),
+		RequestHash: idempotency.HashBody([]byte(`{"name":"original"}`)),
+	}, nil)
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.Fail("handler should not be called")
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/devices", bytes.NewReader([]byte(`{"name":"changed"}`)))
+	req.Header.Set("Idempotency-Key", "550e8400-e29b-41d4-a716-446655440000")
+	rec := httptest.NewRecorder()
+
+	s.handler(next).ServeHTTP(rec, req)
+
+	s.Require().Equal(http.StatusUnprocessableEntity, rec.Code)
+	s.Require().Empty(rec.Header().Get("Idempotent-Replayed"))
+
+	var errResp map[string]any
+	err := json.Unmarshal(rec.Body.Bytes(), &errResp)
+	s.Require().NoError(err)
+	s.Require().Equal("IDEMPOTENCY_KEY_REUSED", errResp["code"])
+
+	s.Require().Len(s.metrics.All(), 1)
+	s.Require().Equal("idempotency_conflicts_total", s.metrics.All()[0].Name)
+	s.Require().Equal(map[string]string{
+		"http.method": http.MethodPost,
+		"http.path":   "/v1/devices",
+	}, s.metrics.All()[0].AttributeMap())
+
+	entry := s.lastLogEntry()
+	s.Require().Equal("warn", entry["level"])
+	s.Require().Equal("550e8400-e29b-41d4-a716-446655440000", entry["idempotency_key"])
+	s.Require().Equal(http.MethodPost, entry["method"])
+	s.Require().Equal("/v1/devices", entry["path"])
+	s.Require().EqualValues(http.StatusCreated, entry["cached_status"])
 }
 
 func (s *IdempotencyMiddlewareTestSuite) TestMiddleware_ExecutesAndCachesOnMiss() {
@@ -172,7 +265,68 @@ func (s *IdempotencyMiddlewareTestSuite) TestMiddleware_ExecutesAndCachesOnMiss(
 	s.Require().NotEmpty(key)
 	s.Require().Equal(http.StatusCreated, response.StatusCode)
 	s.Require().Equal([]byte(`{"data":{"id":"new-id"}}`), response.Body)
+	s.Require().Equal(idempotency.HashBody([]byte(`{"name":"test"}`)), response.RequestHash)
 	s.Require().Equal(s.cfg.CacheTTL, ttl)
+	s.Require().Empty(s.metrics.All())
+}
+
+func (s *IdempotencyMiddlewareTestSuite) TestMiddleware_PreservesRequestBodyForHandler() {
+	s.mockCache.GetReturns(nil, nil)
+	s.mockCache.SetLockReturns(true, nil)
+
+	var received []byte
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/devices", bytes.NewReader([]byte(`{"name":"test"}`)))
+	req.Header.Set("Idempotency-Key", "550e8400-e29b-41d4-a716-446655440000")
+	rec := httptest.NewRecorder()
+
+	s.handler(next).ServeHTTP(rec, req)
+
+	s.Require().Equal(`{"name":"test"}`, string(received))
+}
+
+func (s *IdempotencyMiddlewareTestSuite) TestMiddleware_HandlesDifferentKeysIndependently() {
+	replayedKey := idempotency.BuildCacheKey(http.MethodPost, "/v1/devices", "550e8400-e29b-41d4-a716-446655440000")
+
+	s.mockCache.GetCalls(func(_ context.Context, key string) (*ports.CachedResponse, error) {
+		if key != replayedKey {
+			return nil, nil
+		}
+
+		return &ports.CachedResponse{
+			StatusCode:  http.StatusCreated,
+			Body:        []byte(`{"data":{"id":"123"}}`),
+			CreatedAt:   time.Now().UTC(),
+			RequestHash: idempotency.HashBody([]byte(`{"name":"original"}`)),
+		}, nil
+	})
+	s.mockCache.SetLockReturns(true, nil)
+
+	var received []byte
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/devices", bytes.NewReader([]byte(`{"name":"changed"}`)))
+	req.Header.Set("Idempotency-Key", "6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	rec := httptest.NewRecorder()
+
+	s.handler(next).ServeHTTP(rec, req)
+
+	s.Require().Equal(http.StatusCreated, rec.Code)
+	s.Require().Empty(rec.Header().Get("Idempotent-Replayed"))
+	s.Require().Equal(`{"name":"changed"}`, string(received))
+	s.Require().Equal(1, s.mockCache.SetCallCount())
+
+	_, key, response, _ := s.mockCache.SetArgsForCall(0)
+	s.Require().NotEqual(replayedKey, key)
+	s.Require().Equal(idempotency.HashBody([]byte(`{"name":"changed"}`)), response.RequestHash)
+	s.Require().Empty(s.metrics.All())
 }
 
 func (s *IdempotencyMiddlewareTestSuite) TestMiddleware_ReturnsConflictWhenLocked() {
@@ -234,3 +388,14 @@ func (s *IdempotencyMiddlewareTestSuite) TestMiddleware_GracefulDegradationOnCac
 	s.Require().True(handlerCalled)
 	s.Require().Equal(http.StatusCreated, rec.Code)
 }
+
+// lastLogEntry decodes the most recent JSON log line written by the middleware.
+func (s *IdempotencyMiddlewareTestSuite) lastLogEntry() map[string]any {
+	lines := bytes.Split(bytes.TrimSpace(s.logBuffer.Bytes()), []byte("\n"))
+	s.Require().NotEmpty(lines)
+
+	var entry map[string]any
+	s.Require().NoError(json.Unmarshal(lines[len(lines)-1], &entry))
+
+	return entry
+}
@@ -0,0 +1,185 @@
+package middleware
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/architeacher/devices/pkg/clock"
+	"github.com/architeacher/devices/pkg/logger"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/config"
+	lru "github.com/hashicorp/golang-lru/v2"
+)
+
+const (
+	cacheControlNoStore = "no-store"
+	cacheControlNoCache = "no-cache"
+)
+
+type (
+	// InMemoryCacheOption configures optional in-memory cache middleware behaviour.
+	InMemoryCacheOption func(*inMemoryCacheOptions)
+
+	inMemoryCacheOptions struct {
+		clock   clock.Clock
+		refresh func(r *http.Request, body []byte) []byte
+	}
+
+	// inMemoryCacheEntry is a response as it was written by the handlers downstream,
+	// holding only the headers they set.
+	inMemoryCacheEntry struct {
+		body       []byte
+		headers    http.Header
+		statusCode int
+		storedAt   time.Time
+	}
+)
+
+// WithInMemoryCacheClock sets the clock used to age cached entries.
+func WithInMemoryCacheClock(c clock.Clock) InMemoryCacheOption {
+	return func(o *inMemoryCacheOptions) {
+		o.clock = c
+	}
+}
+
+// WithInMemoryCacheRefresh sets a function that rewrites a cached body for the request it
+// is replayed to, such as the request ID carried in the response meta.
+func WithInMemoryCacheRefresh(refresh func(r *http.Request, body []byte) []byte) InMemoryCacheOption {
+	return func(o *inMemoryCacheOptions) {
+		o.refresh = refresh
+	}
+}
+
+// InMemoryCacheMiddleware serves GET requests to the configured paths from a
+// process-local LRU cache holding up to cfg.Size successful responses, each for
+// cfg.MaxAge. Entries are keyed by the request URI and the Accept header, which selects
+// the response version. A request sending Cache-Control: no-cache skips the cached
+// entry and refreshes it, while no-store, on the request or on the response, keeps the
+// response out of the cache. Headers set by the middlewares wrapping the cache, such as
+// the request IDs, are not stored, so every hit carries those of its own request.
+// Responses the handler flushes are streamed and never cached.
+func InMemoryCacheMiddleware(cfg config.InMemoryCache, log logger.Logger, opts ...InMemoryCacheOption) func(http.Handler) http.Handler {
+	if !cfg.Enabled {
+		return func(next http.Handler) http.Handler {
+			return next
+		}
+	}
+
+	options := &inMemoryCacheOptions{
+		clock: clock.RealClock{},
+	}
+
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	cache, err := lru.New[string, inMemoryCacheEntry](int(cfg.Size))
+	if err != nil {
+		log.Error().Err(err).Uint("size", cfg.Size).Msg("failed to create in-memory cache, responses will not be cached")
+
+		return func(next http.Handler) http.Handler {
+			return next
+		}
+	}
+
+	paths := NewPathMatcher(cfg.Paths)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet || !paths.Match(r.URL.Path) ||
+				hasCacheControlDirective(r.Header, cacheControlNoStore) {
+				next.ServeHTTP(w, r)
+
+				return
+			}
+
+			key := r.URL.RequestURI() + " " + r.Header.Get("Accept")
+
+			if !hasCacheControlDirective(r.Header, cacheControlNoCache) {
+				if entry, ok := cache.Get(key); ok {
+					if options.clock.Since(entry.storedAt) < cfg.MaxAge {
+						writeInMemoryCacheEntry(w, r, entry, options.refresh)
+
+						return
+					}
+
+					cache.Remove(key)
+				}
+			}
+
+			outerHeaders := w.Header().Clone()
+
+			recorder := newResponseRecorder(w)
+			next.ServeHTTP(recorder, r)
+
+			if recorder.flushed || recorder.statusCode < http.StatusOK || recorder.statusCode >= http.StatusMultipleChoices ||
+				hasCacheControlDirective(recorder.Header(), cacheControlNoStore) {
+				return
+			}
+
+			cache.Add(key, inMemoryCacheEntry{
+				body:       recorder.body.Bytes(),
+				headers:    handlerHeaders(outerHeaders, recorder.Header()),
+				statusCode: recorder.statusCode,
+				storedAt:   options.clock.Now(),
+			})
+
+			reqLogger := LoggerFromContext(r.Context())
+
+			reqLogger.Debug().
+				Str("path", r.URL.Path).
+				Int("cached_entries", cache.Len()).
+				Msg("response stored in the in-memory cache")
+		})
+	}
+}
+
+func writeInMemoryCacheEntry(
+	w http.ResponseWriter,
+	r *http.Request,
+	entry inMemoryCacheEntry,
+	refresh func(r *http.Request, body []byte) []byte,
+) {
+	for key, values := range entry.headers {
+		w.Header()[key] = slices.Clone(values)
+	}
+
+	body := entry.body
+	if refresh != nil {
+		body = refresh(r, body)
+		w.Header().Del("Content-Length")
+	}
+
+	w.WriteHeader(entry.statusCode)
+	_, _ = w.Write(body)
+}
+
+// handlerHeaders returns the headers the handlers downstream added or changed, leaving
+// out those already set by the middlewares wrapping the cache.
+func handlerHeaders(outer, written http.Header) http.Header {
+	headers := make(http.Header, len(written))
+
+	for key, values := range written {
+		if !slices.Equal(outer[key], values) {
+			headers[key] = slices.Clone(values)
+		}
+	}
+
+	return headers
+}
+
+// hasCacheControlDirective reports whether the Cache-Control header lists directive,
+// ignoring case and any directive arguments.
+func hasCacheControlDirective(header http.Header, directive string) bool {
+	for _, value := range header.Values("Cache-Control") {
+		for part := range strings.SplitSeq(value, ",") {
+			name, _, _ := strings.Cut(strings.TrimSpace(part), "=")
+			if strings.EqualFold(name, directive) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,286 @@
+package middleware_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/architeacher/devices/pkg/clock"
+	"github.com/architeacher/devices/pkg/logger"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/adapters/inbound/http/handlers/shared"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/adapters/inbound/http/middleware"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/config"
+	"github.com/stretchr/testify/suite"
+)
+
+type InMemoryCacheMiddlewareTestSuite struct {
+	suite.Suite
+	clock   *clock.MockClock
+	calls   int
+	cfg     config.InMemoryCache
+	handler http.Handler
+}
+
+func TestInMemoryCacheMiddlewareSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(InMemoryCacheMiddlewareTestSuite))
+}
+
+func (s *InMemoryCacheMiddlewareTestSuite) SetupTest() {
+	s.clock = clock.NewMockClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	s.calls = 0
+	s.cfg = config.InMemoryCache{
+		Enabled: true,
+		Size:    10,
+		MaxAge:  time.Minute,
+		Paths:   []string{"/v1/devices"},
+	}
+	s.handler = s.newHandler(s.cfg, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.calls++
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, `{"call":%d}`, s.calls)
+	}))
+}
+
+func (s *InMemoryCacheMiddlewareTestSuite) newHandler(cfg config.InMemoryCache, next http.Handler) http.Handler {
+	log := logger.NewWithWriter("debug", "json", io.Discard)
+
+	return middleware.InMemoryCacheMiddleware(cfg, log, middleware.WithInMemoryCacheClock(s.clock))(next)
+}
+
+func (s *InMemoryCacheMiddlewareTestSuite) get(target, cacheControl string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, target, nil)
+	if cacheControl != "" {
+		req.Header.Set("Cache-Control", cacheControl)
+	}
+
+	rec := httptest.NewRecorder()
+	s.handler.ServeHTTP(rec, req)
+
+	return rec
+}
+
+func (s *InMemoryCacheMiddlewareTestSuite) TestCacheHit_ServesStoredBody() {
+	first := s.get("/v1/devices?page=1", "")
+	second := s.get("/v1/devices?page=1", "")
+
+	s.Require().Equal(1, s.calls)
+	s.Require().Equal(http.StatusOK, second.Code)
+	s.Require().JSONEq(`{"call":1}`, second.Body.String())
+	s.Require().Equal(first.Body.String(), second.Body.String())
+	s.Require().Equal("application/json", second.Header().Get("Content-Type"))
+}
+
+func (s *InMemoryCacheMiddlewareTestSuite) TestCacheHit_CarriesItsOwnRequestIDs() {
+	log := logger.NewWithWriter("debug", "json", io.Discard)
+	cache := middleware.InMemoryCacheMiddleware(
+		s.cfg,
+		log,
+		middleware.WithInMemoryCacheClock(s.clock),
+		middleware.WithInMemoryCacheRefresh(shared.RefreshMeta),
+	)
+
+	remaining := 10
+	rateLimit := func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			remaining--
+			w.Header().Set("RateLimit-Remaining", fmt.Sprint(remaining))
+
+			next.ServeHTTP(w, r)
+		})
+	}
+
+	handler := middleware.RequestTracking()(rateLimit(cache(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.calls++
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(shared.EnvelopedResponse{
+			Data: map[string]string{"name": "iPhone"},
+			Meta: shared.NewMeta(r),
+		})
+	}))))
+
+	get := func(requestID string) (*httptest.ResponseRecorder, shared.EnvelopedResponse) {
+		req := httptest.NewRequest(http.MethodGet, "/v1/devices", nil)
+		req.Header.Set(middleware.XRequestIDHeader, requestID)
+		req.Header.Set("traceparent", "00-0af7651916cd43dd8448eb211c80319"+requestID[len(requestID)-1:]+"-b7ad6b7169203331-01")
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		var body shared.EnvelopedResponse
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &body))
+
+		return rec, body
+	}
+
+	first, firstBody := get("request-1")
+	second, secondBody := get("request-2")
+
+	s.Require().Equal(1, s.calls)
+
+	s.Require().Equal("request-1", first.Header().Get(middleware.RequestIDHeader))
+	s.Require().Equal("request-2", second.Header().Get(middleware.RequestIDHeader))
+	s.Require().Equal("request-2", second.Header().Get(middleware.XRequestIDHeader))
+	s.Require().NotEqual(
+		first.Header().Get(middleware.CorrelationIDHeader),
+		second.Header().Get(middleware.CorrelationIDHeader),
+	)
+	s.Require().Equal("8", second.Header().Get("RateLimit-Remaining"))
+	s.Require().Equal("application/json", second.Header().Get("Content-Type"))
+
+	s.Require().Equal("request-1", firstBody.Meta.RequestID)
+	s.Require().Equal("request-2", secondBody.Meta.RequestID)
+	s.Require().Equal("0af7651916cd43dd8448eb211c803192", secondBody.Meta.TraceID)
+	s.Require().Equal(firstBody.Data, secondBody.Data)
+}
+
+func (s *InMemoryCacheMiddlewareTestSuite) TestCacheKey_IncludesQueryAndAccept() {
+	s.get("/v1/devices?page=1", "")
+	s.get("/v1/devices?page=2", "")
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/devices?page=1", nil)
+	req.Header.Set("Accept", "application/vnd.devices.v2+json")
+	s.handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	s.Require().Equal(3, s.calls)
+}
+
+func (s *InMemoryCacheMiddlewareTestSuite) TestNoCache_BypassesCache() {
+	s.get("/v1/devices", "")
+
+	rec := s.get("/v1/devices", "no-cache")
+
+	s.Require().Equal(2, s.calls)
+	s.Require().JSONEq(`{"call":2}`, rec.Body.String())
+
+	rec = s.get("/v1/devices", "")
+
+	s.Require().Equal(2, s.calls, "the bypassing request refreshes the cached entry")
+	s.Require().JSONEq(`{"call":2}`, rec.Body.String())
+}
+
+func (s *InMemoryCacheMiddlewareTestSuite) TestNoStore_PreventsCaching() {
+	s.get("/v1/devices", "no-store")
+	s.get("/v1/devices", "")
+
+	s.Require().Equal(2, s.calls)
+}
+
+func (s *InMemoryCacheMiddlewareTestSuite) TestNoStoreResponse_IsNotCached() {
+	handler := s.newHandler(s.cfg, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.calls++
+
+		w.Header().Set("Cache-Control", "no-store")
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	for range 2 {
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/v1/devices", nil))
+	}
+
+	s.Require().Equal(2, s.calls)
+}
+
+func (s *InMemoryCacheMiddlewareTestSuite) TestFlushedResponse_IsNotCached() {
+	handler := s.newHandler(s.cfg, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.calls++
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("first page\n"))
+		_ = http.NewResponseController(w).Flush()
+		_, _ = w.Write([]byte("second page\n"))
+	}))
+
+	for range 2 {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/devices", nil))
+
+		s.Require().True(rec.Flushed)
+		s.Require().Equal("first page\nsecond page\n", rec.Body.String())
+	}
+
+	s.Require().Equal(2, s.calls)
+}
+
+func (s *InMemoryCacheMiddlewareTestSuite) TestExpiredEntry_IsNotServed() {
+	s.get("/v1/devices", "")
+
+	s.clock.Advance(s.cfg.MaxAge - time.Second)
+	s.get("/v1/devices", "")
+	s.Require().Equal(1, s.calls)
+
+	s.clock.Advance(time.Second)
+	rec := s.get("/v1/devices", "")
+
+	s.Require().Equal(2, s.calls)
+	s.Require().JSONEq(`{"call":2}`, rec.Body.String())
+}
+
+func (s *InMemoryCacheMiddlewareTestSuite) TestErrorResponses_AreNotCached() {
+	handler := s.newHandler(s.cfg, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.calls++
+
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+
+	for range 2 {
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/v1/devices", nil))
+	}
+
+	s.Require().Equal(2, s.calls)
+}
+
+func (s *InMemoryCacheMiddlewareTestSuite) TestUncachedRequests() {
+	cases := []struct {
+		name   string
+		method string
+		target string
+	}{
+		{
+			name:   "path outside the configured paths",
+			method: http.MethodGet,
+			target: "/v1/health",
+		},
+		{
+			name:   "non-GET method",
+			method: http.MethodPost,
+			target: "/v1/devices",
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			s.calls = 0
+
+			for range 2 {
+				s.handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(tc.method, tc.target, nil))
+			}
+
+			s.Require().Equal(2, s.calls)
+		})
+	}
+}
+
+func (s *InMemoryCacheMiddlewareTestSuite) TestDisabled_PassesThrough() {
+	cfg := s.cfg
+	cfg.Enabled = false
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.calls++
+	})
+	handler := s.newHandler(cfg, next)
+
+	for range 2 {
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/v1/devices", nil))
+	}
+
+	s.Require().Equal(2, s.calls)
+}
@@ -1,21 +1,40 @@
 package middleware
 
 import (
-	"context"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/architeacher/devices/pkg/logger"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/config"
 )
 
-const (
-	skipAccessLogKey contextKey = "skip_access_log"
-)
+const redactedHeaderValue = "[REDACTED]"
+
+var defaultHealthEndpoints = []string{
+	"/health",
+	"/healthz",
+	"/liveness",
+	"/readiness",
+	"/ready",
+	"/live",
+	"/v1/health",
+	"/v1/liveness",
+	"/v1/readiness",
+}
+
+// AccessLogMiddleware writes one structured log entry per request, mirroring the
+// gRPC AccessLogInterceptor. Health check paths are suppressed unless
+// cfg.LogHealthChecks is set, and headers listed in cfg.RedactHeaders are masked.
+func AccessLogMiddleware(cfg config.AccessLog, log logger.Logger) func(http.Handler) http.Handler {
+	redacted := make(map[string]struct{}, len(cfg.RedactHeaders))
+	for _, header := range cfg.RedactHeaders {
+		redacted[http.CanonicalHeaderKey(strings.TrimSpace(header))] = struct{}{}
+	}
 
-func AccessLogger(log logger.Logger, includeQueryParams bool) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			if shouldSkipAccessLog(r.Context()) {
+			if !cfg.Enabled || (!cfg.LogHealthChecks && isHealthEndpoint(r.URL.Path, defaultHealthEndpoints)) {
 				next.ServeHTTP(w, r)
 
 				return
@@ -44,6 +63,7 @@ func AccessLogger(log logger.Logger, includeQueryParams bool) func(http.Handler)
 				Str("method", r.Method).
 				Str("path", r.URL.Path).
 				Str("remote_addr", r.RemoteAddr).
+				Str("client_ip", extractIP(r.RemoteAddr)).
 				Str("user_agent", r.UserAgent()).
 				Str("proto", r.Proto).
 				Str("host", r.Host).
@@ -51,7 +71,21 @@ func AccessLogger(log logger.Logger, includeQueryParams bool) func(http.Handler)
 				Uint64("bytes", wrapped.BytesWritten()).
 				Int64("duration_ms", duration.Milliseconds())
 
-			if includeQueryParams && r.URL.RawQuery != "" {
+			// logger.WithContext adds the IDs stored by RequestTracking; when it runs further
+			// inside the chain they are only available from the headers.
+			if GetRequestID(r.Context()) == "" {
+				if requestID := trackingHeader(r, w, RequestIDHeader); requestID != "" {
+					event.Str("request_id", requestID)
+				}
+			}
+
+			if GetCorrelationID(r.Context()) == "" {
+				if correlationID := trackingHeader(r, w, CorrelationIDHeader); correlationID != "" {
+					event.Str("correlation_id", correlationID)
+				}
+			}
+
+			if cfg.IncludeQueryParams && r.URL.RawQuery != "" {
 				event.Str("query", r.URL.RawQuery)
 			}
 
@@ -59,13 +93,50 @@ func AccessLogger(log logger.Logger, includeQueryParams bool) func(http.Handler)
 				event.Str("referer", referer)
 			}
 
-			event.Send()
+			if cfg.IncludeHeaders {
+				event.
+					Any("request_headers", sanitizeHeaders(r.Header, redacted)).
+					Any("response_headers", sanitizeHeaders(w.Header(), redacted))
+			}
+
+			event.Msg("HTTP request completed")
 		})
 	}
 }
 
-func shouldSkipAccessLog(ctx context.Context) bool {
-	skip, ok := ctx.Value(skipAccessLogKey).(bool)
+// trackingHeader resolves a request or correlation ID from the response headers set by
+// RequestTracking, falling back to the request headers.
+func trackingHeader(r *http.Request, w http.ResponseWriter, header string) string {
+	if id := w.Header().Get(header); id != "" {
+		return id
+	}
+
+	return r.Header.Get(header)
+}
+
+// sanitizeHeaders flattens headers for logging, masking the values of redacted ones.
+func sanitizeHeaders(headers http.Header, redacted map[string]struct{}) map[string]string {
+	sanitized := make(map[string]string, len(headers))
+
+	for key, values := range headers {
+		if _, ok := redacted[http.CanonicalHeaderKey(key)]; ok {
+			sanitized[key] = redactedHeaderValue
+
+			continue
+		}
+
+		sanitized[key] = strings.Join(values, ", ")
+	}
+
+	return sanitized
+}
+
+func isHealthEndpoint(path string, healthEndpoints []string) bool {
+	for _, endpoint := range healthEndpoints {
+		if strings.HasPrefix(path, endpoint) {
+			return true
+		}
+	}
 
-	return ok && skip
+	return false
 }
@@ -0,0 +1,178 @@
+package middleware_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/architeacher/devices/pkg/logger"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/adapters/inbound/http/middleware"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/config"
+	"github.com/stretchr/testify/suite"
+)
+
+type AccessLogMiddlewareSuite struct {
+	suite.Suite
+}
+
+func TestAccessLogMiddlewareSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(AccessLogMiddlewareSuite))
+}
+
+func defaultAccessLogConfig() config.AccessLog {
+	return config.AccessLog{
+		Enabled:            true,
+		LogHealthChecks:    false,
+		IncludeQueryParams: true,
+		IncludeHeaders:     true,
+		RedactHeaders:      []string{"Authorization", "cookie", "Set-Cookie"},
+	}
+}
+
+// serveAccessLog runs a single request through the middleware and returns the decoded log entries.
+func (s *AccessLogMiddlewareSuite) serveAccessLog(cfg config.AccessLog, req *http.Request, status int) []map[string]any {
+	var logBuffer bytes.Buffer
+
+	handler := middleware.AccessLogMiddleware(cfg, logger.NewBufferedTestLogger(&logBuffer))(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Set-Cookie", "session=secret")
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte(`{"status":"ok"}`))
+		}),
+	)
+
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	var entries []map[string]any
+
+	for _, line := range bytes.Split(bytes.TrimSpace(logBuffer.Bytes()), []byte("\n")) {
+		if len(line) == 0 {
+			continue
+		}
+
+		var entry map[string]any
+		s.Require().NoError(json.Unmarshal(line, &entry))
+
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
+
+func (s *AccessLogMiddlewareSuite) TestLogsAllFields() {
+	s.T().Parallel()
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/devices?page=2&size=10", nil)
+	req.RemoteAddr = "203.0.113.7:54321"
+	req.Header.Set("User-Agent", "devices-client/1.0")
+	req.Header.Set(middleware.RequestIDHeader, "req-123")
+	req.Header.Set(middleware.CorrelationIDHeader, "corr-456")
+
+	entries := s.serveAccessLog(defaultAccessLogConfig(), req, http.StatusOK)
+	s.Require().Len(entries, 1)
+
+	entry := entries[0]
+	s.Require().Equal("info", entry["level"])
+	s.Require().Equal("http", entry["component"])
+	s.Require().Equal(http.MethodGet, entry["method"])
+	s.Require().Equal("/v1/devices", entry["path"])
+	s.Require().Equal("page=2&size=10", entry["query"])
+	s.Require().EqualValues(http.StatusOK, entry["status"])
+	s.Require().EqualValues(len(`{"status":"ok"}`), entry["bytes"])
+	s.Require().Contains(entry, "duration_ms")
+	s.Require().Equal("req-123", entry["request_id"])
+	s.Require().Equal("corr-456", entry["correlation_id"])
+	s.Require().Equal("devices-client/1.0", entry["user_agent"])
+	s.Require().Equal("203.0.113.7", entry["client_ip"])
+	s.Require().Equal("HTTP request completed", entry["message"])
+}
+
+func (s *AccessLogMiddlewareSuite) TestOmitsQueryWhenDisabled() {
+	s.T().Parallel()
+
+	cfg := defaultAccessLogConfig()
+	cfg.IncludeQueryParams = false
+
+	entries := s.serveAccessLog(cfg, httptest.NewRequest(http.MethodGet, "/v1/devices?token=abc", nil), http.StatusOK)
+	s.Require().Len(entries, 1)
+	s.Require().NotContains(entries[0], "query")
+}
+
+func (s *AccessLogMiddlewareSuite) TestLevelFollowsStatus() {
+	s.T().Parallel()
+
+	cases := []struct {
+		status        int
+		expectedLevel string
+	}{
+		{status: http.StatusCreated, expectedLevel: "info"},
+		{status: http.StatusNotFound, expectedLevel: "warn"},
+		{status: http.StatusBadGateway, expectedLevel: "error"},
+	}
+
+	for _, tc := range cases {
+		s.Run(http.StatusText(tc.status), func() {
+			entries := s.serveAccessLog(defaultAccessLogConfig(), httptest.NewRequest(http.MethodGet, "/v1/devices", nil), tc.status)
+			s.Require().Len(entries, 1)
+			s.Require().Equal(tc.expectedLevel, entries[0]["level"])
+		})
+	}
+}
+
+func (s *AccessLogMiddlewareSuite) TestHealthChecks() {
+	s.T().Parallel()
+
+	cfg := defaultAccessLogConfig()
+
+	for _, path := range []string{"/v1/health", "/v1/liveness", "/v1/readiness"} {
+		entries := s.serveAccessLog(cfg, httptest.NewRequest(http.MethodGet, path, nil), http.StatusOK)
+		s.Require().Empty(entries, "health path %s should not be logged", path)
+	}
+
+	cfg.LogHealthChecks = true
+
+	entries := s.serveAccessLog(cfg, httptest.NewRequest(http.MethodGet, "/v1/health", nil), http.StatusOK)
+	s.Require().Len(entries, 1)
+	s.Require().Equal("/v1/health", entries[0]["path"])
+}
+
+func (s *AccessLogMiddlewareSuite) TestDisabledWritesNothing() {
+	s.T().Parallel()
+
+	cfg := defaultAccessLogConfig()
+	cfg.Enabled = false
+
+	entries := s.serveAccessLog(cfg, httptest.NewRequest(http.MethodGet, "/v1/devices", nil), http.StatusOK)
+	s.Require().Empty(entries)
+}
+
+func (s *AccessLogMiddlewareSuite) TestRedactsSensitiveHeaders() {
+	s.T().Parallel()
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/devices", nil)
+	req.Header.Set("Authorization", "Bearer v4.public.secret")
+	req.Header.Set("Cookie", "session=secret")
+	req.Header.Set("Accept", "application/json")
+
+	entries := s.serveAccessLog(defaultAccessLogConfig(), req, http.StatusOK)
+	s.Require().Len(entries, 1)
+
+	requestHeaders, ok := entries[0]["request_headers"].(map[string]any)
+	s.Require().True(ok)
+	s.Require().Equal("[REDACTED]", requestHeaders["Authorization"])
+	s.Require().Equal("[REDACTED]", requestHeaders["Cookie"])
+	s.Require().Equal("application/json", requestHeaders["Accept"])
+
+	responseHeaders, ok := entries[0]["response_headers"].(map[string]any)
+	s.Require().True(ok)
+	s.Require().Equal("[REDACTED]", responseHeaders["Set-Cookie"])
+	s.Require().Equal("application/json", responseHeaders["Content-Type"])
+
+	raw, err := json.Marshal(entries[0])
+	s.Require().NoError(err)
+	s.Require().NotContains(string(raw), "secret")
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"regexp"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/architeacher/devices/pkg/metrics"
@@ -15,15 +18,132 @@ const (
 	httpPathKey       = "http.path"
 	httpStatusCodeKey = "http.status_code"
 
-	httpRequestTotal    = "http_requests_total"
-	httpRequestDuration = "http_request_duration_seconds"
-	httpRequestSize     = "http_request_size_bytes"
-	httpResponseSize    = "http_response_size_bytes"
+	httpRequestTotal     = "http_requests_total"
+	httpRequestDuration  = "http_request_duration_seconds"
+	httpRequestsInFlight = "http_requests_in_flight"
+	httpRequestSize      = "http_request_size_bytes"
+	httpResponseSize     = "http_response_size_bytes"
+
+	normalizedIDSegment = "{id}"
+
+	// OverflowLabelValue replaces the label values a CardinalityGuard no longer admits.
+	OverflowLabelValue = "_overflow"
+)
+
+var uuidPattern = regexp.MustCompile(`[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}`)
+
+type (
+	// PathNormalizer maps a request path to the value used as the metrics path label.
+	PathNormalizer func(path string) string
+
+	// LabelSanitizer maps the value of a label recorded on a metric to the value exported.
+	LabelSanitizer func(metric, label, value string) string
+
+	// MetricsOption configures optional HTTP metrics middleware behaviour.
+	MetricsOption func(*metricsOptions)
+
+	metricsOptions struct {
+		pathNormalizer PathNormalizer
+		labelSanitizer LabelSanitizer
+	}
+
+	// labelKey identifies one label of one metric.
+	labelKey struct {
+		metric string
+		label  string
+	}
+
+	// labelValues holds the distinct values admitted for one label of one metric.
+	labelValues struct {
+		seen  sync.Map
+		count atomic.Uint64
+	}
 )
 
-func MetricsMiddleware(metricsClient metrics.Client) func(http.Handler) http.Handler {
+// WithPathNormalizer overrides how request paths are turned into metric labels.
+func WithPathNormalizer(normalizer PathNormalizer) MetricsOption {
+	return func(o *metricsOptions) {
+		o.pathNormalizer = normalizer
+	}
+}
+
+// WithLabelSanitizer passes the method and path labels of every metric through sanitizer.
+func WithLabelSanitizer(sanitizer LabelSanitizer) MetricsOption {
+	return func(o *metricsOptions) {
+		o.labelSanitizer = sanitizer
+	}
+}
+
+// CardinalityGuard returns a LabelSanitizer admitting up to maxCardinality distinct
+// values per label of each metric. Values already admitted keep being exported as is,
+// later ones are exported as OverflowLabelValue, so that unbounded inputs such as
+// unnormalized paths cannot grow the number of series past the cap.
+func CardinalityGuard(maxCardinality uint) LabelSanitizer {
+	var metricLabels sync.Map
+
+	return func(metric, label, value string) string {
+		key := labelKey{metric: metric, label: label}
+
+		entry, ok := metricLabels.Load(key)
+		if !ok {
+			entry, _ = metricLabels.LoadOrStore(key, &labelValues{})
+		}
+
+		values := entry.(*labelValues)
+
+		if _, ok := values.seen.Load(value); ok {
+			return value
+		}
+
+		for {
+			count := values.count.Load()
+			if count >= uint64(maxCardinality) {
+				return OverflowLabelValue
+			}
+
+			if values.count.CompareAndSwap(count, count+1) {
+				break
+			}
+		}
+
+		if _, loaded := values.seen.LoadOrStore(value, struct{}{}); loaded {
+			// A concurrent request admitted the same value first; give the slot back.
+			values.count.Add(^uint64(0))
+		}
+
+		return value
+	}
+}
+
+// DefaultPathNormalizer replaces UUIDs in the path with "{id}" so that per-resource
+// paths collapse into a single label value.
+func DefaultPathNormalizer(path string) string {
+	return uuidPattern.ReplaceAllString(path, normalizedIDSegment)
+}
+
+// PrometheusMetricsMiddleware records request count, latency, in-flight requests and
+// payload sizes through the metrics client. Latency, in seconds, and payload sizes are
+// histograms bucketed by the client's default boundaries; in-flight requests are an
+// up/down counter.
+func PrometheusMetricsMiddleware(metricsClient metrics.Client, opts ...MetricsOption) func(http.Handler) http.Handler {
+	options := metricsOptions{
+		pathNormalizer: DefaultPathNormalizer,
+	}
+
+	for _, opt := range opts {
+		opt(&options)
+	}
+
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			path := options.pathNormalizer(r.URL.Path)
+
+			inFlightAttrs := options.requestLabels(httpRequestsInFlight, r.Method, path)
+
+			metricsClient.Add(ctx, httpRequestsInFlight, 1, inFlightAttrs...)
+			defer metricsClient.Add(ctx, httpRequestsInFlight, -1, inFlightAttrs...)
+
 			startTime := time.Now()
 
 			wrapped := NewFlushableResponseWriter(w)
@@ -33,20 +153,19 @@ func MetricsMiddleware(metricsClient metrics.Client) func(http.Handler) http.Han
 			duration := time.Since(startTime)
 
 			requestSize := clampToUint64(r.ContentLength)
-			responseSize := uint64(wrapped.BytesWritten())
+			responseSize := wrapped.BytesWritten()
 
 			recordHTTPRequest(
-				r.Context(),
+				ctx,
 				metricsClient,
+				options,
 				r.Method,
-				r.URL.Path,
+				path,
 				uint(wrapped.StatusCode()),
 				duration,
 				requestSize,
 				responseSize,
 			)
-
-			next.ServeHTTP(w, r)
 		})
 	}
 }
@@ -54,29 +173,55 @@ func MetricsMiddleware(metricsClient metrics.Client) func(http.Handler) http.Han
 func recordHTTPRequest(
 	ctx context.Context,
 	metricsClient metrics.Client,
+	options metricsOptions,
 	method, path string,
 	statusCode uint,
 	duration time.Duration,
 	requestSize, responseSize uint64,
 ) {
-	attrs := []attribute.KeyValue{
-		attribute.String(httpMethodKey, method),
-		attribute.String(httpPathKey, path),
-		attribute.String(httpStatusCodeKey, fmt.Sprintf("%d", statusCode)),
-	}
-
-	metricsClient.Inc(ctx, httpRequestTotal, int64(1), attrs...)
-	metricsClient.Inc(ctx, httpRequestDuration, duration.Seconds(), attrs...)
+	status := attribute.String(httpStatusCodeKey, fmt.Sprintf("%d", statusCode))
 
 	metricsClient.Inc(
+		ctx,
+		httpRequestTotal,
+		int64(1),
+		append(options.requestLabels(httpRequestTotal, method, path), status)...,
+	)
+
+	_ = metricsClient.Observe(
+		ctx,
+		httpRequestDuration,
+		duration.Seconds(),
+		options.requestLabels(httpRequestDuration, method, path)...,
+	)
+
+	_ = metricsClient.Observe(
 		ctx,
 		httpRequestSize,
-		int64(requestSize),
-		attribute.String(httpMethodKey, method),
-		attribute.String(httpPathKey, path),
+		float64(requestSize),
+		options.requestLabels(httpRequestSize, method, path)...,
 	)
 
-	metricsClient.Inc(ctx, httpResponseSize, int64(responseSize), attrs...)
+	_ = metricsClient.Observe(
+		ctx,
+		httpResponseSize,
+		float64(responseSize),
+		append(options.requestLabels(httpResponseSize, method, path), status)...,
+	)
+}
+
+// requestLabels returns the method and path labels of metric, sanitized when a
+// LabelSanitizer is set.
+func (o metricsOptions) requestLabels(metric, method, path string) []attribute.KeyValue {
+	if o.labelSanitizer != nil {
+		method = o.labelSanitizer(metric, httpMethodKey, method)
+		path = o.labelSanitizer(metric, httpPathKey, path)
+	}
+
+	return []attribute.KeyValue{
+		attribute.String(httpMethodKey, method),
+		attribute.String(httpPathKey, path),
+	}
 }
 
 func clampToUint64(value int64) uint64 {
@@ -0,0 +1,264 @@
+package middleware_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/architeacher/devices/pkg/metrics/recording"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/adapters/inbound/http/middleware"
+	"github.com/stretchr/testify/suite"
+)
+
+type PrometheusMetricsMiddlewareSuite struct {
+	suite.Suite
+}
+
+func TestPrometheusMetricsMiddlewareSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(PrometheusMetricsMiddlewareSuite))
+}
+
+func (s *PrometheusMetricsMiddlewareSuite) TestRecordsRequestMetrics() {
+	s.T().Parallel()
+
+	metricsClient := recording.NewMetricsClient()
+	calls := 0
+
+	handler := middleware.PrometheusMetricsMiddleware(metricsClient)(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id":"1"}`))
+		}),
+	)
+
+	for range 2 {
+		req := httptest.NewRequest(http.MethodPost, "/v1/devices", nil)
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	s.Require().Equal(2, calls, "handler must run exactly once per request")
+	s.Require().Equal(float64(2), metricsClient.Sum("http_requests_total"))
+
+	total := metricsClient.Records("http_requests_total")[0]
+	s.Require().Equal(map[string]string{
+		"http.method":      http.MethodPost,
+		"http.path":        "/v1/devices",
+		"http.status_code": "201",
+	}, total.AttributeMap())
+
+	s.Require().Equal(recording.KindCounter, total.Kind)
+
+	durations := metricsClient.Records("http_request_duration_seconds")
+	s.Require().Len(durations, 2)
+	s.Require().Equal(recording.KindHistogram, durations[0].Kind)
+	s.Require().IsType(float64(0), durations[0].Value)
+	s.Require().Equal(map[string]string{
+		"http.method": http.MethodPost,
+		"http.path":   "/v1/devices",
+	}, durations[0].AttributeMap())
+
+	responseSizes := metricsClient.Records("http_response_size_bytes")
+	s.Require().Len(responseSizes, 2)
+	s.Require().Equal(recording.KindHistogram, responseSizes[0].Kind)
+	s.Require().Equal(float64(len(`{"id":"1"}`)), responseSizes[0].Value)
+	s.Require().Equal(float64(2*len(`{"id":"1"}`)), metricsClient.Sum("http_response_size_bytes"))
+	s.Require().Equal(recording.KindHistogram, metricsClient.Records("http_request_size_bytes")[0].Kind)
+}
+
+func (s *PrometheusMetricsMiddlewareSuite) TestInFlightGauge() {
+	s.T().Parallel()
+
+	metricsClient := recording.NewMetricsClient()
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	handler := middleware.PrometheusMetricsMiddleware(metricsClient)(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			close(started)
+			<-release
+			w.WriteHeader(http.StatusOK)
+		}),
+	)
+
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/v1/devices", nil))
+	}()
+
+	select {
+	case <-started:
+	case <-time.After(time.Second):
+		s.FailNow("handler did not start")
+	}
+
+	s.Require().Equal(float64(1), metricsClient.Sum("http_requests_in_flight"))
+
+	close(release)
+	<-done
+
+	s.Require().Zero(metricsClient.Sum("http_requests_in_flight"))
+
+	for _, record := range metricsClient.Records("http_requests_in_flight") {
+		s.Require().Equal(recording.KindUpDownCounter, record.Kind)
+	}
+
+	s.Require().Equal(map[string]string{
+		"http.method": http.MethodGet,
+		"http.path":   "/v1/devices",
+	}, metricsClient.Records("http_requests_in_flight")[0].AttributeMap())
+}
+
+func (s *PrometheusMetricsMiddlewareSuite) TestNormalizesPaths() {
+	s.T().Parallel()
+
+	cases := []struct {
+		name         string
+		path         string
+		expectedPath string
+	}{
+		{
+			name:         "uuid segment",
+			path:         "/v1/devices/550e8400-e29b-41d4-a716-446655440000",
+			expectedPath: "/v1/devices/{id}",
+		},
+		{
+			name:         "uppercase uuid with trailing segment",
+			path:         "/v1/devices/550E8400-E29B-41D4-A716-446655440000/state",
+			expectedPath: "/v1/devices/{id}/state",
+		},
+		{
+			name:         "path without identifiers",
+			path:         "/v1/devices",
+			expectedPath: "/v1/devices",
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			metricsClient := recording.NewMetricsClient()
+
+			handler := middleware.PrometheusMetricsMiddleware(metricsClient)(
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusOK)
+				}),
+			)
+
+			handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, tc.path, nil))
+
+			for _, record := range metricsClient.All() {
+				s.Require().Equal(tc.expectedPath, record.AttributeMap()["http.path"], record.Name)
+			}
+		})
+	}
+}
+
+func (s *PrometheusMetricsMiddlewareSuite) TestCustomPathNormalizer() {
+	s.T().Parallel()
+
+	metricsClient := recording.NewMetricsClient()
+
+	handler := middleware.PrometheusMetricsMiddleware(
+		metricsClient,
+		middleware.WithPathNormalizer(func(string) string { return "/normalized" }),
+	)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/v1/devices/abc", nil))
+
+	s.Require().Equal("/normalized", metricsClient.Records("http_requests_total")[0].AttributeMap()["http.path"])
+}
+
+func (s *PrometheusMetricsMiddlewareSuite) TestCardinalityGuard() {
+	s.T().Parallel()
+
+	const maxCardinality = 3
+
+	metricsClient := recording.NewMetricsClient()
+
+	handler := middleware.PrometheusMetricsMiddleware(
+		metricsClient,
+		middleware.WithLabelSanitizer(middleware.CardinalityGuard(maxCardinality)),
+	)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	paths := make([]string, 0, maxCardinality+3)
+	for index := range cap(paths) {
+		paths = append(paths, fmt.Sprintf("/v1/unknown-%d", index))
+	}
+
+	for _, path := range paths {
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	// A path admitted before the cap was reached keeps its own label.
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, paths[0], nil))
+
+	for _, name := range []string{"http_requests_total", "http_request_duration_seconds", "http_request_size_bytes", "http_response_size_bytes"} {
+		records := metricsClient.Records(name)
+		s.Require().Len(records, len(paths)+1, name)
+
+		for index, record := range records[:len(paths)] {
+			expectedPath := paths[index]
+			if index >= maxCardinality {
+				expectedPath = middleware.OverflowLabelValue
+			}
+
+			s.Require().Equal(expectedPath, record.AttributeMap()["http.path"], name)
+			s.Require().Equal(http.MethodGet, record.AttributeMap()["http.method"], name)
+		}
+
+		s.Require().Equal(paths[0], records[len(paths)].AttributeMap()["http.path"], name)
+	}
+
+	// The gauge goes up and down once per request, with the same labels both times.
+	inFlight := metricsClient.Records("http_requests_in_flight")
+	s.Require().Len(inFlight, 2*(len(paths)+1))
+
+	lastOverflowed := 2 * (len(paths) - 1)
+	s.Require().Equal(middleware.OverflowLabelValue, inFlight[lastOverflowed].AttributeMap()["http.path"])
+	s.Require().Equal(inFlight[lastOverflowed].Attributes, inFlight[lastOverflowed+1].Attributes)
+}
+
+func (s *PrometheusMetricsMiddlewareSuite) TestCardinalityGuard_ConcurrentValues() {
+	s.T().Parallel()
+
+	const maxCardinality = 10
+
+	guard := middleware.CardinalityGuard(maxCardinality)
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		admitted = make(map[string]struct{})
+	)
+
+	for index := range 100 {
+		wg.Go(func() {
+			value := guard("http_requests_total", "http.path", fmt.Sprintf("/v1/unknown-%d", index))
+			if value == middleware.OverflowLabelValue {
+				return
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			admitted[value] = struct{}{}
+		})
+	}
+
+	wg.Wait()
+
+	s.Require().Len(admitted, maxCardinality)
+	s.Require().Equal("/v1/other", guard("http_request_duration_seconds", "http.path", "/v1/other"),
+		"every metric has its own cap")
+}
@@ -1,12 +1,17 @@
 package middleware_test
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
+	"github.com/architeacher/devices/pkg/logger"
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/adapters/inbound/http/middleware"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/config"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -69,9 +74,9 @@ func (s *SecurityHeadersTestSuite) TestSecurityHeaders() {
 		},
 	}
 
-	handler := middleware.SecurityHeaders("v1")(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	handler := middleware.APIVersion("v1")(middleware.SecurityHeadersMiddleware(defaultSecurityHeaders())(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
-	}))
+	})))
 
 	for _, tc := range cases {
 		s.Run(tc.name, func() {
@@ -85,6 +90,134 @@ func (s *SecurityHeadersTestSuite) TestSecurityHeaders() {
 	}
 }
 
+func (s *SecurityHeadersTestSuite) TestSecurityHeaders_Configurable() {
+	s.T().Parallel()
+
+	cfg := defaultSecurityHeaders()
+	cfg.FrameOptions = "SAMEORIGIN"
+	cfg.XSSProtection = ""
+	cfg.HSTSMaxAge = 600
+	cfg.HSTSIncludeSubDomains = false
+
+	handler := middleware.SecurityHeadersMiddleware(cfg)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/devices", nil)
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	s.Require().Equal("SAMEORIGIN", rec.Header().Get("X-Frame-Options"))
+	s.Require().Equal("max-age=600", rec.Header().Get("Strict-Transport-Security"))
+	s.Require().NotContains(rec.Header(), "X-Xss-Protection")
+
+	cfg.HSTSMaxAge = 0
+	handler = middleware.SecurityHeadersMiddleware(cfg)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	s.Require().NotContains(rec.Header(), "Strict-Transport-Security")
+}
+
+func (s *SecurityHeadersTestSuite) TestSecurityHeaders_SkipPaths() {
+	s.T().Parallel()
+
+	cfg := defaultSecurityHeaders()
+	cfg.SkipPaths = []string{"/v1/health", "/metrics/**"}
+
+	handler := middleware.SecurityHeadersMiddleware(cfg)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	for _, path := range []string{"/v1/health", "/metrics/prometheus"} {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		rec := httptest.NewRecorder()
+
+		handler.ServeHTTP(rec, req)
+
+		for _, header := range []string{
+			"X-Content-Type-Options", "X-Frame-Options", "X-XSS-Protection", "Referrer-Policy",
+			"Content-Security-Policy", "Strict-Transport-Security", "Permissions-Policy",
+		} {
+			s.Require().Empty(rec.Header().Get(header), "path %s header %s", path, header)
+		}
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/devices", nil)
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	s.Require().Equal("nosniff", rec.Header().Get("X-Content-Type-Options"))
+}
+
+func (s *SecurityHeadersTestSuite) TestSecurityHeaders_PreservesVaryWithCompression() {
+	s.T().Parallel()
+
+	compressionCfg := config.Compression{
+		Enabled:          true,
+		Level:            5,
+		MinSize:          10,
+		GracefulDegraded: true,
+	}
+
+	body := bytes.Repeat([]byte(`{"name":"device"}`), 100)
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Vary", "Origin")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(body)
+	})
+
+	securityHeaders := middleware.SecurityHeadersMiddleware(defaultSecurityHeaders())
+	compression := middleware.CompressionMiddleware(compressionCfg, logger.NewTestLogger())
+
+	cases := []struct {
+		name    string
+		handler http.Handler
+	}{
+		{
+			name:    "security headers outside compression",
+			handler: securityHeaders(compression(next)),
+		},
+		{
+			name:    "compression outside security headers",
+			handler: compression(securityHeaders(next)),
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			req := httptest.NewRequest(http.MethodGet, "/v1/devices", nil)
+			req.Header.Set("Accept-Encoding", "gzip")
+			rec := httptest.NewRecorder()
+
+			tc.handler.ServeHTTP(rec, req)
+
+			s.Require().Equal("gzip", rec.Header().Get("Content-Encoding"))
+			s.Require().ElementsMatch([]string{"Accept-Encoding", "Origin"}, rec.Header().Values("Vary"))
+			s.Require().Equal("nosniff", rec.Header().Get("X-Content-Type-Options"))
+		})
+	}
+}
+
+func defaultSecurityHeaders() config.SecurityHeaders {
+	return config.SecurityHeaders{
+		ContentTypeOptions:    "nosniff",
+		FrameOptions:          "DENY",
+		XSSProtection:         "1; mode=block",
+		ReferrerPolicy:        "strict-origin-when-cross-origin",
+		ContentSecurityPolicy: "default-src 'self'; connect-src 'self'",
+		PermissionsPolicy:     "camera=(), microphone=(), geolocation=()",
+		HSTSMaxAge:            31536000,
+		HSTSIncludeSubDomains: true,
+	}
+}
+
 type CORSTestSuite struct {
 	suite.Suite
 }
@@ -94,10 +227,20 @@ func TestCORSTestSuite(t *testing.T) {
 	suite.Run(t, new(CORSTestSuite))
 }
 
+func corsConfig(origins ...string) config.CORS {
+	return config.CORS{
+		AllowedOrigins: origins,
+		AllowedMethods: []string{http.MethodGet, http.MethodPost, http.MethodOptions},
+		AllowedHeaders: []string{"Authorization", "Content-Type"},
+		ExposedHeaders: []string{"Request-Id", "ETag"},
+		MaxAge:         600,
+	}
+}
+
 func (s *CORSTestSuite) TestCORS_AllowAll() {
 	s.T().Parallel()
 
-	handler := middleware.CORS([]string{"*"})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	handler := middleware.CORSMiddleware(corsConfig("*"))(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
 	}))
 
@@ -115,7 +258,7 @@ func (s *CORSTestSuite) TestCORS_AllowAll() {
 func (s *CORSTestSuite) TestCORS_SpecificOrigin() {
 	s.T().Parallel()
 
-	handler := middleware.CORS([]string{"https://allowed.com"})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	handler := middleware.CORSMiddleware(corsConfig("https://allowed.com"))(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
 	}))
 
@@ -157,7 +300,7 @@ func (s *CORSTestSuite) TestCORS_Preflight() {
 	s.T().Parallel()
 
 	handlerCalled := false
-	handler := middleware.CORS([]string{"*"})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	handler := middleware.CORSMiddleware(corsConfig("*"))(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		handlerCalled = true
 		w.WriteHeader(http.StatusOK)
 	}))
@@ -170,6 +313,72 @@ func (s *CORSTestSuite) TestCORS_Preflight() {
 
 	s.Require().Equal(http.StatusNoContent, rec.Code)
 	s.Require().False(handlerCalled)
+	s.Require().Equal("https://example.com", rec.Header().Get("Access-Control-Allow-Origin"))
+	s.Require().Equal("GET, POST, OPTIONS", rec.Header().Get("Access-Control-Allow-Methods"))
+	s.Require().Equal("Authorization, Content-Type", rec.Header().Get("Access-Control-Allow-Headers"))
+	s.Require().Equal("600", rec.Header().Get("Access-Control-Max-Age"))
+	s.Require().Empty(rec.Header().Get("Access-Control-Allow-Credentials"))
+}
+
+func (s *CORSTestSuite) TestCORS_SimpleCrossOriginRequest() {
+	s.T().Parallel()
+
+	cfg := corsConfig("https://app.example.com")
+	cfg.AllowCredentials = true
+
+	handler := middleware.CORSMiddleware(cfg)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/devices", nil)
+	req.Header.Set("Origin", "https://app.example.com")
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	s.Require().Equal(http.StatusOK, rec.Code)
+	s.Require().Equal("https://app.example.com", rec.Header().Get("Access-Control-Allow-Origin"))
+	s.Require().Equal("true", rec.Header().Get("Access-Control-Allow-Credentials"))
+	s.Require().Equal("Request-Id, ETag", rec.Header().Get("Access-Control-Expose-Headers"))
+	s.Require().Equal("Origin", rec.Header().Get("Vary"))
+}
+
+func (s *CORSTestSuite) TestCORS_DisallowedOriginPreflight() {
+	s.T().Parallel()
+
+	handlerCalled := false
+	handler := middleware.CORSMiddleware(corsConfig("https://allowed.com"))(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handlerCalled = true
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}))
+
+	req := httptest.NewRequest(http.MethodOptions, "/v1/devices", nil)
+	req.Header.Set("Origin", "https://evil.com")
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	s.Require().True(handlerCalled)
+	s.Require().Equal(http.StatusMethodNotAllowed, rec.Code)
+	s.Require().Empty(rec.Header().Get("Access-Control-Allow-Origin"))
+	s.Require().Empty(rec.Header().Get("Access-Control-Allow-Methods"))
+	s.Require().Empty(rec.Header().Get("Access-Control-Max-Age"))
+}
+
+func (s *CORSTestSuite) TestCORS_NoOriginSkipsHeaders() {
+	s.T().Parallel()
+
+	handler := middleware.CORSMiddleware(corsConfig("*"))(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/devices", nil)
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	s.Require().Empty(rec.Header().Get("Access-Control-Allow-Origin"))
+	s.Require().Empty(rec.Header().Get("Vary"))
 }
 
 type RequestTrackingTestSuite struct {
@@ -220,6 +429,73 @@ func (s *RequestTrackingTestSuite) TestRequestTracking_UsesExistingID() {
 	s.Require().Equal(existingID, middleware.GetRequestID(capturedCtx))
 }
 
+func (s *RequestTrackingTestSuite) TestRequestTracking_GeneratesUUIDv7() {
+	s.T().Parallel()
+
+	handler := middleware.RequestTracking()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	for _, header := range []string{middleware.RequestIDHeader, middleware.CorrelationIDHeader} {
+		id, err := uuid.Parse(rec.Header().Get(header))
+		s.Require().NoError(err, header)
+		s.Require().Equal(uuid.Version(7), id.Version(), header)
+	}
+
+	s.Require().Empty(rec.Header().Get(middleware.XRequestIDHeader), "X-Request-Id is only echoed when sent")
+}
+
+func (s *RequestTrackingTestSuite) TestRequestTracking_AcceptsXRequestID() {
+	s.T().Parallel()
+
+	existingID := "existing-request-id-123"
+	var capturedCtx context.Context
+	handler := middleware.RequestTracking()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		capturedCtx = r.Context()
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("X-Request-ID", existingID)
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	s.Require().Equal(existingID, rec.Header().Get("X-Request-ID"))
+	s.Require().Equal(existingID, rec.Header().Get(middleware.RequestIDHeader))
+	s.Require().Equal(existingID, middleware.GetRequestID(capturedCtx))
+}
+
+func (s *RequestTrackingTestSuite) TestRequestTracking_IDsReachMiddlewareLogs() {
+	s.T().Parallel()
+
+	logBuffer := new(bytes.Buffer)
+	log := logger.NewWithWriter("debug", "json", logBuffer)
+
+	handler := middleware.RequestTracking()(middleware.RequestLoggerMiddleware(log)(
+		middleware.Recovery()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			panic("boom")
+		})),
+	))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set(middleware.CorrelationIDHeader, "corr-123")
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	var entry map[string]any
+	s.Require().NoError(json.Unmarshal(logBuffer.Bytes(), &entry))
+	s.Require().Equal("panic recovered", entry["message"])
+	s.Require().Equal(rec.Header().Get(middleware.RequestIDHeader), entry["request_id"])
+	s.Require().Equal("corr-123", entry["correlation_id"])
+}
+
 func (s *RequestTrackingTestSuite) TestGetRequestID_EmptyContext() {
 	s.T().Parallel()
 
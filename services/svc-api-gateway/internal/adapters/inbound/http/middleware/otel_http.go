@@ -0,0 +1,70 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// OtelHTTPMiddleware starts a server span for every request, continuing the trace from
+// inbound W3C traceparent/tracestate headers when they are valid and starting a new
+// root span otherwise. The span is named after the method and the matched chi route
+// pattern, or the method alone when no route matched. The span context is stored in the request context and written
+// back as response headers so callers can correlate their request with the trace.
+func OtelHTTPMiddleware(tp trace.TracerProvider, serviceName string) func(http.Handler) http.Handler {
+	tracer := tp.Tracer(serviceName)
+	propagator := propagation.TraceContext{}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := propagator.Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+
+			ctx, span := tracer.Start(
+				ctx,
+				r.Method,
+				trace.WithSpanKind(trace.SpanKindServer),
+				trace.WithAttributes(
+					semconv.HTTPRequestMethodKey.String(r.Method),
+					semconv.URLPath(r.URL.Path),
+					semconv.ServerAddress(r.Host),
+					semconv.UserAgentOriginal(r.UserAgent()),
+				),
+			)
+			defer span.End()
+
+			propagator.Inject(ctx, propagation.HeaderCarrier(w.Header()))
+
+			wrapped := NewFlushableResponseWriter(w)
+			next.ServeHTTP(wrapped, r.WithContext(ctx))
+
+			// The route is only known once chi has matched the request, and naming the
+			// span after it rather than the path keeps span names low-cardinality.
+			if route := routePattern(r); route != "" {
+				span.SetName(r.Method + " " + route)
+				span.SetAttributes(semconv.HTTPRoute(route))
+			}
+
+			statusCode := wrapped.StatusCode()
+			span.SetAttributes(semconv.HTTPResponseStatusCode(statusCode))
+
+			if statusCode >= http.StatusInternalServerError {
+				span.SetStatus(codes.Error, http.StatusText(statusCode))
+			}
+		})
+	}
+}
+
+// routePattern returns the chi route pattern matched by r, e.g. /v1/devices/{id}, or ""
+// when r was not routed by chi or matched no route.
+func routePattern(r *http.Request) string {
+	rctx := chi.RouteContext(r.Context())
+	if rctx == nil {
+		return ""
+	}
+
+	return rctx.RoutePattern()
+}
@@ -0,0 +1,168 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/adapters/inbound/http/middleware"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/suite"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	testTraceID      = "4bf92f3577b34da6a3ce929d0e0e4736"
+	testParentSpanID = "00f067aa0ba902b7"
+	testTraceparent  = "00-" + testTraceID + "-" + testParentSpanID + "-01"
+)
+
+type OtelHTTPMiddlewareSuite struct {
+	suite.Suite
+}
+
+func TestOtelHTTPMiddlewareSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(OtelHTTPMiddlewareSuite))
+}
+
+// serveTraced runs the request through the middleware and returns the recorded spans,
+// the span context seen by the handler and the response.
+func (s *OtelHTTPMiddlewareSuite) serveTraced(
+	req *http.Request,
+	status int,
+) ([]sdktrace.ReadOnlySpan, trace.SpanContext, *httptest.ResponseRecorder) {
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	var handlerSpanContext trace.SpanContext
+
+	handler := middleware.OtelHTTPMiddleware(tp, "svc-api-gateway")(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			handlerSpanContext = trace.SpanContextFromContext(r.Context())
+			w.WriteHeader(status)
+		}),
+	)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	return recorder.Ended(), handlerSpanContext, rec
+}
+
+func (s *OtelHTTPMiddlewareSuite) TestCreatesServerSpan() {
+	s.T().Parallel()
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/devices", nil)
+
+	spans, handlerSpanContext, rec := s.serveTraced(req, http.StatusOK)
+	s.Require().Len(spans, 1)
+
+	span := spans[0]
+	s.Require().Equal("GET", span.Name(), "unrouted requests are named after the method only")
+	s.Require().Equal(trace.SpanKindServer, span.SpanKind())
+	s.Require().Equal("svc-api-gateway", span.InstrumentationScope().Name)
+	s.Require().Contains(span.Attributes(), attribute.Int("http.response.status_code", http.StatusOK))
+	s.Require().Contains(span.Attributes(), attribute.String("http.request.method", http.MethodGet))
+	s.Require().Equal(codes.Unset, span.Status().Code)
+
+	// The handler sees the server span, and callers receive it as traceparent
+	s.Require().Equal(span.SpanContext().SpanID(), handlerSpanContext.SpanID())
+	s.Require().Contains(rec.Header().Get("traceparent"), span.SpanContext().TraceID().String())
+}
+
+func (s *OtelHTTPMiddlewareSuite) TestNamesSpanAfterRoutePattern() {
+	s.T().Parallel()
+
+	cases := []struct {
+		name          string
+		path          string
+		expectedName  string
+		expectedRoute string
+	}{
+		{
+			name:          "route with a parameter",
+			path:          "/v1/devices/0190a0d6-8f4e-7c1a-9b2d-3e4f5a6b7c8d",
+			expectedName:  "GET /v1/devices/{id}",
+			expectedRoute: "/v1/devices/{id}",
+		},
+		{
+			name:         "unmatched route",
+			path:         "/v1/unknown/42",
+			expectedName: "GET",
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			recorder := tracetest.NewSpanRecorder()
+			tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+			router := chi.NewRouter()
+			router.Use(middleware.OtelHTTPMiddleware(tp, "svc-api-gateway"))
+			router.Get("/v1/devices/{id}", func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			})
+
+			router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, tc.path, nil))
+
+			spans := recorder.Ended()
+			s.Require().Len(spans, 1)
+			s.Require().Equal(tc.expectedName, spans[0].Name())
+
+			if tc.expectedRoute == "" {
+				for _, attr := range spans[0].Attributes() {
+					s.Require().NotEqual(attribute.Key("http.route"), attr.Key)
+				}
+
+				return
+			}
+
+			s.Require().Contains(spans[0].Attributes(), attribute.String("http.route", tc.expectedRoute))
+		})
+	}
+}
+
+func (s *OtelHTTPMiddlewareSuite) TestPropagatesValidTraceparent() {
+	s.T().Parallel()
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/devices", nil)
+	req.Header.Set("traceparent", testTraceparent)
+
+	spans, handlerSpanContext, _ := s.serveTraced(req, http.StatusCreated)
+	s.Require().Len(spans, 1)
+
+	span := spans[0]
+	s.Require().Equal(testTraceID, span.SpanContext().TraceID().String())
+	s.Require().Equal(testParentSpanID, span.Parent().SpanID().String())
+	s.Require().True(span.Parent().IsRemote())
+	s.Require().Equal(testTraceID, handlerSpanContext.TraceID().String())
+}
+
+func (s *OtelHTTPMiddlewareSuite) TestInvalidTraceparentStartsRootSpan() {
+	s.T().Parallel()
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/devices", nil)
+	req.Header.Set("traceparent", "00-not-a-valid-header-01")
+
+	spans, _, _ := s.serveTraced(req, http.StatusOK)
+	s.Require().Len(spans, 1)
+
+	span := spans[0]
+	s.Require().False(span.Parent().IsValid())
+	s.Require().True(span.SpanContext().IsValid())
+	s.Require().NotEqual(testTraceID, span.SpanContext().TraceID().String())
+}
+
+func (s *OtelHTTPMiddlewareSuite) TestServerErrorMarksSpan() {
+	s.T().Parallel()
+
+	spans, _, _ := s.serveTraced(httptest.NewRequest(http.MethodGet, "/v1/devices", nil), http.StatusBadGateway)
+	s.Require().Len(spans, 1)
+	s.Require().Equal(codes.Error, spans[0].Status().Code)
+	s.Require().Contains(spans[0].Attributes(), attribute.Int("http.response.status_code", http.StatusBadGateway))
+}
@@ -0,0 +1,110 @@
+package middleware
+
+import (
+	"path"
+	"strings"
+)
+
+const (
+	singleSegmentWildcard = "*"
+	multiSegmentWildcard  = "**"
+)
+
+type (
+	// PathMatcher matches request paths against a set of glob patterns.
+	//
+	// Patterns without wildcards are treated as prefix matches for backward
+	// compatibility with plain skip-path lists. Patterns containing wildcards
+	// must match the whole path, segment by segment:
+	//   - "*" matches exactly one path segment (and may be combined with other
+	//     characters inside a segment, e.g. "*.csv")
+	//   - "**" matches zero or more path segments
+	PathMatcher struct {
+		prefixes []string
+		globs    [][]string
+	}
+)
+
+// NewPathMatcher creates a PathMatcher for the given patterns.
+// Empty patterns are ignored.
+func NewPathMatcher(patterns []string) PathMatcher {
+	matcher := PathMatcher{}
+
+	for _, pattern := range patterns {
+		pattern = strings.TrimSpace(pattern)
+		if pattern == "" {
+			continue
+		}
+
+		if !strings.Contains(pattern, singleSegmentWildcard) {
+			matcher.prefixes = append(matcher.prefixes, pattern)
+
+			continue
+		}
+
+		matcher.globs = append(matcher.globs, splitPath(pattern))
+	}
+
+	return matcher
+}
+
+// Match reports whether the given path matches any of the configured patterns.
+func (m PathMatcher) Match(requestPath string) bool {
+	for _, prefix := range m.prefixes {
+		if strings.HasPrefix(requestPath, prefix) {
+			return true
+		}
+	}
+
+	if len(m.globs) == 0 {
+		return false
+	}
+
+	segments := splitPath(requestPath)
+
+	for _, glob := range m.globs {
+		if matchSegments(glob, segments) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchSegments matches path segments against pattern segments, expanding "**"
+// to any number of segments.
+func matchSegments(pattern, segments []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == multiSegmentWildcard {
+			rest := pattern[1:]
+
+			for index := 0; index <= len(segments); index++ {
+				if matchSegments(rest, segments[index:]) {
+					return true
+				}
+			}
+
+			return false
+		}
+
+		if len(segments) == 0 {
+			return false
+		}
+
+		if matched, err := path.Match(pattern[0], segments[0]); err != nil || !matched {
+			return false
+		}
+
+		pattern = pattern[1:]
+		segments = segments[1:]
+	}
+
+	return len(segments) == 0
+}
+
+// splitPath splits a slash-separated path into its non-empty segments.
+func splitPath(value string) []string {
+	return strings.FieldsFunc(value, func(r rune) bool {
+		return r == '/'
+	})
+}
@@ -0,0 +1,138 @@
+package middleware_test
+
+import (
+	"testing"
+
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/adapters/inbound/http/middleware"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPathMatcher_Match(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		patterns []string
+		path     string
+		expected bool
+	}{
+		{
+			name:     "exact match",
+			patterns: []string{"/v1/health"},
+			path:     "/v1/health",
+			expected: true,
+		},
+		{
+			name:     "plain pattern keeps prefix semantics",
+			patterns: []string{"/v1/health"},
+			path:     "/v1/health/details",
+			expected: true,
+		},
+		{
+			name:     "plain pattern no match",
+			patterns: []string{"/v1/health"},
+			path:     "/v1/devices",
+			expected: false,
+		},
+		{
+			name:     "single-segment wildcard match",
+			patterns: []string{"/v1/devices/*/export"},
+			path:     "/v1/devices/123e4567-e89b-12d3-a456-426614174000/export",
+			expected: true,
+		},
+		{
+			name:     "single-segment wildcard does not match collection",
+			patterns: []string{"/v1/devices/*/export"},
+			path:     "/v1/devices",
+			expected: false,
+		},
+		{
+			name:     "single-segment wildcard does not span segments",
+			patterns: []string{"/v1/devices/*/export"},
+			path:     "/v1/devices/a/b/export",
+			expected: false,
+		},
+		{
+			name:     "single-segment wildcard requires full match",
+			patterns: []string{"/v1/devices/*"},
+			path:     "/v1/devices/123/export",
+			expected: false,
+		},
+		{
+			name:     "partial segment wildcard",
+			patterns: []string{"/v1/exports/*.csv"},
+			path:     "/v1/exports/devices.csv",
+			expected: true,
+		},
+		{
+			name:     "partial segment wildcard no match",
+			patterns: []string{"/v1/exports/*.csv"},
+			path:     "/v1/exports/devices.json",
+			expected: false,
+		},
+		{
+			name:     "multi-segment wildcard matches many segments",
+			patterns: []string{"/v1/static/**"},
+			path:     "/v1/static/js/vendor/app.js",
+			expected: true,
+		},
+		{
+			name:     "multi-segment wildcard matches zero segments",
+			patterns: []string{"/v1/static/**"},
+			path:     "/v1/static",
+			expected: true,
+		},
+		{
+			name:     "multi-segment wildcard in the middle",
+			patterns: []string{"/v1/**/export"},
+			path:     "/v1/devices/123/export",
+			expected: true,
+		},
+		{
+			name:     "multi-segment wildcard in the middle no match",
+			patterns: []string{"/v1/**/export"},
+			path:     "/v1/devices/123/import",
+			expected: false,
+		},
+		{
+			name:     "leading multi-segment wildcard",
+			patterns: []string{"**/export"},
+			path:     "/v2/devices/123/export",
+			expected: true,
+		},
+		{
+			name:     "any pattern matches",
+			patterns: []string{"/v1/health", "/v1/devices/*/export"},
+			path:     "/v1/devices/abc/export",
+			expected: true,
+		},
+		{
+			name:     "no patterns",
+			patterns: nil,
+			path:     "/v1/devices",
+			expected: false,
+		},
+		{
+			name:     "empty pattern is ignored",
+			patterns: []string{""},
+			path:     "/v1/devices",
+			expected: false,
+		},
+		{
+			name:     "malformed glob never matches",
+			patterns: []string{"/v1/[*"},
+			path:     "/v1/devices",
+			expected: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			matcher := middleware.NewPathMatcher(tc.patterns)
+
+			require.Equal(t, tc.expected, matcher.Match(tc.path))
+		})
+	}
+}
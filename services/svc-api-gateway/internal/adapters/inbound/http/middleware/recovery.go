@@ -4,12 +4,10 @@ import (
 	"fmt"
 	"net/http"
 	"runtime/debug"
-
-	"github.com/architeacher/devices/pkg/logger"
 )
 
 // Recovery returns a middleware that recovers from panics.
-func Recovery(log logger.Logger) func(http.Handler) http.Handler {
+func Recovery() func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			defer func() {
@@ -30,7 +28,9 @@ func Recovery(log logger.Logger) func(http.Handler) http.Handler {
 						errMsg = fmt.Sprintf("%v", v)
 					}
 
-					log.Error().
+					reqLogger := LoggerFromContext(r.Context())
+
+					reqLogger.Error().
 						Str("error", errMsg).
 						Str("stack", string(debug.Stack())).
 						Str("path", r.URL.Path).
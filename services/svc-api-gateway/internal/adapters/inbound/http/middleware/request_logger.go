@@ -0,0 +1,41 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/architeacher/devices/pkg/logger"
+)
+
+// requestLoggerKey holds the request-scoped logger stored by RequestLoggerMiddleware.
+const requestLoggerKey contextKey = "requestLogger"
+
+// fallbackLogger is returned by LoggerFromContext for requests that did not go
+// through RequestLoggerMiddleware.
+var fallbackLogger = logger.New(logger.LogLevelInfo, logger.JSONLoggingFormat)
+
+// RequestLoggerMiddleware stores a logger with the request_id and correlation_id fields,
+// and the trace_id and span_id of the current span, already attached in the request
+// context, so handlers and middleware further down the chain can log through
+// LoggerFromContext without rebuilding it. It must run inside RequestTracking, which
+// sets the IDs, and inside the tracing middleware, which starts the span.
+func RequestLoggerMiddleware(log logger.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			reqLogger := logger.Logger{Logger: log.WithContext(r.Context())}
+
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), requestLoggerKey, reqLogger)))
+		})
+	}
+}
+
+// LoggerFromContext returns the request-scoped logger stored by RequestLoggerMiddleware.
+// Without one, it falls back to a JSON logger at info level enriched with whatever
+// request and correlation IDs the context carries.
+func LoggerFromContext(ctx context.Context) logger.Logger {
+	if reqLogger, ok := ctx.Value(requestLoggerKey).(logger.Logger); ok {
+		return reqLogger
+	}
+
+	return logger.Logger{Logger: fallbackLogger.WithContext(ctx)}
+}
@@ -0,0 +1,167 @@
+package middleware_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/architeacher/devices/pkg/logger"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/adapters/inbound/http/middleware"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/suite"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type RequestLoggerMiddlewareSuite struct {
+	suite.Suite
+}
+
+func TestRequestLoggerMiddlewareSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(RequestLoggerMiddlewareSuite))
+}
+
+// lockedBuffer serialises writes from loggers shared by concurrent requests.
+type lockedBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *lockedBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.buf.Write(p)
+}
+
+func (b *lockedBuffer) entries() []map[string]any {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var entries []map[string]any
+
+	for _, line := range bytes.Split(bytes.TrimSpace(b.buf.Bytes()), []byte("\n")) {
+		if len(line) == 0 {
+			continue
+		}
+
+		var entry map[string]any
+		if err := json.Unmarshal(line, &entry); err == nil {
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries
+}
+
+// newRequestLoggerHandler chains RequestTracking, RequestLoggerMiddleware and a handler
+// logging one entry through LoggerFromContext, as the router does.
+func newRequestLoggerHandler(buf *lockedBuffer) http.Handler {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reqLogger := middleware.LoggerFromContext(r.Context())
+		reqLogger.Info().Msg("handled")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	return middleware.RequestTracking()(
+		middleware.RequestLoggerMiddleware(logger.NewBufferedTestLogger(buf))(handler),
+	)
+}
+
+func (s *RequestLoggerMiddlewareSuite) TestFieldsAttached() {
+	s.T().Parallel()
+
+	buf := &lockedBuffer{}
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/devices", nil)
+	req.Header.Set(middleware.RequestIDHeader, "req-1")
+	req.Header.Set(middleware.CorrelationIDHeader, "corr-1")
+
+	rec := httptest.NewRecorder()
+	newRequestLoggerHandler(buf).ServeHTTP(rec, req)
+
+	s.Require().Equal(http.StatusNoContent, rec.Code)
+
+	entries := buf.entries()
+	s.Require().Len(entries, 1)
+	s.Require().Equal("handled", entries[0]["message"])
+	s.Require().Equal("req-1", entries[0]["request_id"])
+	s.Require().Equal("corr-1", entries[0]["correlation_id"])
+}
+
+func (s *RequestLoggerMiddlewareSuite) TestSpanAttached() {
+	s.T().Parallel()
+
+	buf := &lockedBuffer{}
+
+	spanContext := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{1},
+		SpanID:  trace.SpanID{2},
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/devices", nil)
+	req = req.WithContext(trace.ContextWithSpanContext(req.Context(), spanContext))
+
+	newRequestLoggerHandler(buf).ServeHTTP(httptest.NewRecorder(), req)
+
+	entries := buf.entries()
+	s.Require().Len(entries, 1)
+	s.Require().Equal(spanContext.TraceID().String(), entries[0]["trace_id"])
+	s.Require().Equal(spanContext.SpanID().String(), entries[0]["span_id"])
+}
+
+func (s *RequestLoggerMiddlewareSuite) TestFallbackWithoutMiddleware() {
+	s.T().Parallel()
+
+	var reqLogger logger.Logger
+
+	handler := middleware.RequestTracking()(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		reqLogger = middleware.LoggerFromContext(r.Context())
+	}))
+
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/v1/devices", nil))
+
+	s.Require().Equal(zerolog.InfoLevel, reqLogger.GetLevel())
+
+	contextless := middleware.LoggerFromContext(s.T().Context())
+	s.Require().Equal(zerolog.InfoLevel, contextless.GetLevel())
+}
+
+func (s *RequestLoggerMiddlewareSuite) TestConcurrentRequestsAreIndependent() {
+	s.T().Parallel()
+
+	const requests = 2
+
+	buf := &lockedBuffer{}
+	handler := newRequestLoggerHandler(buf)
+
+	requestIDs := make([]string, requests)
+
+	var wg sync.WaitGroup
+
+	for i := range requests {
+		wg.Go(func() {
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/devices", nil))
+
+			requestIDs[i] = rec.Header().Get(middleware.RequestIDHeader)
+		})
+	}
+
+	wg.Wait()
+
+	s.Require().NotEqual(requestIDs[0], requestIDs[1])
+
+	logged := make([]string, 0, requests)
+	for _, entry := range buf.entries() {
+		requestID, ok := entry["request_id"].(string)
+		s.Require().True(ok)
+
+		logged = append(logged, requestID)
+	}
+
+	s.Require().ElementsMatch(requestIDs, logged)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/http"
 
+	"github.com/architeacher/devices/pkg/logger"
 	"github.com/google/uuid"
 )
 
@@ -13,21 +14,33 @@ const (
 	RequestIDHeader     = "Request-Id"
 	CorrelationIDHeader = "Correlation-Id"
 
-	RequestIDKey     contextKey = "requestID"
-	CorrelationIDKey contextKey = "correlationID"
+	// XRequestIDHeader is accepted as an alias of RequestIDHeader for clients and
+	// proxies that use the de facto X- header, and is echoed back when they do.
+	XRequestIDHeader = "X-Request-Id"
+
+	// The IDs are stored under the logger's keys so that logger.WithContext picks them up.
+	RequestIDKey     = logger.ContextKeyRequestID
+	CorrelationIDKey = logger.ContextKeyCorrelationID
 )
 
+// RequestTracking reads the request and correlation IDs from the inbound headers,
+// generating a UUIDv7 for any that is missing, stores them in the request context
+// and echoes them in the response headers.
 func RequestTracking() func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			correlationID := r.Header.Get(CorrelationIDHeader)
 			if correlationID == "" {
-				correlationID = uuid.New().String()
+				correlationID = newTrackingID()
 			}
 
 			requestID := r.Header.Get(RequestIDHeader)
 			if requestID == "" {
-				requestID = uuid.New().String()
+				requestID = r.Header.Get(XRequestIDHeader)
+			}
+
+			if requestID == "" {
+				requestID = newTrackingID()
 			}
 
 			ctx := context.WithValue(r.Context(), CorrelationIDKey, correlationID)
@@ -36,6 +49,10 @@ func RequestTracking() func(http.Handler) http.Handler {
 			w.Header().Set(CorrelationIDHeader, correlationID)
 			w.Header().Set(RequestIDHeader, requestID)
 
+			if r.Header.Get(XRequestIDHeader) != "" {
+				w.Header().Set(XRequestIDHeader, requestID)
+			}
+
 			next.ServeHTTP(w, r.WithContext(ctx))
 		})
 	}
@@ -56,3 +73,14 @@ func GetCorrelationID(ctx context.Context) string {
 
 	return ""
 }
+
+// newTrackingID returns a time-ordered UUIDv7, falling back to a random UUIDv4
+// should the clock or random source fail.
+func newTrackingID() string {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return uuid.New().String()
+	}
+
+	return id.String()
+}
@@ -0,0 +1,86 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+const (
+	ResponseVersionV1 = "v1"
+	ResponseVersionV2 = "v2"
+
+	// ResponseVersionKey holds the response version negotiated from the Accept header.
+	ResponseVersionKey contextKey = "responseVersion"
+
+	vendorMediaTypePrefix = "application/vnd.devices."
+	vendorMediaTypeSuffix = "+json"
+)
+
+// SupportedResponseVersions lists the response shapes clients can request.
+var SupportedResponseVersions = []string{ResponseVersionV1, ResponseVersionV2}
+
+// ResponseVersionMiddleware negotiates the response version from vendor media types in
+// the Accept header, such as application/vnd.devices.v2+json, and stores it in the request
+// context. Requests without a vendor media type are served unchanged. A request that only
+// accepts unsupported versions is answered with 406 Not Acceptable.
+func ResponseVersionMiddleware() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			appendVary(w.Header(), "Accept")
+
+			version, acceptable := negotiateResponseVersion(r.Header.Values("Accept"))
+			if !acceptable {
+				writeError(w, http.StatusNotAcceptable, "NOT_ACCEPTABLE",
+					"unsupported response version, supported versions are "+strings.Join(SupportedResponseVersions, ", "))
+
+				return
+			}
+
+			if version != "" {
+				r = r.WithContext(context.WithValue(r.Context(), ResponseVersionKey, version))
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// GetResponseVersion returns the negotiated response version, or an empty string when
+// the client did not request one.
+func GetResponseVersion(ctx context.Context) string {
+	if version, ok := ctx.Value(ResponseVersionKey).(string); ok {
+		return version
+	}
+
+	return ""
+}
+
+// negotiateResponseVersion returns the first supported version among the vendor media
+// types, in the order listed. The request is not acceptable when it names vendor media
+// types but none is supported and no generic JSON media range is accepted either.
+func negotiateResponseVersion(accept []string) (string, bool) {
+	var vendorRequested, genericAccepted bool
+
+	for _, header := range accept {
+		for mediaRange := range strings.SplitSeq(header, ",") {
+			mediaType, _, _ := strings.Cut(mediaRange, ";")
+			mediaType = strings.ToLower(strings.TrimSpace(mediaType))
+
+			switch {
+			case strings.HasPrefix(mediaType, vendorMediaTypePrefix) && strings.HasSuffix(mediaType, vendorMediaTypeSuffix):
+				version := strings.TrimSuffix(strings.TrimPrefix(mediaType, vendorMediaTypePrefix), vendorMediaTypeSuffix)
+				if slices.Contains(SupportedResponseVersions, version) {
+					return version, true
+				}
+
+				vendorRequested = true
+			case mediaType == "application/json" || mediaType == "application/*" || mediaType == "*/*":
+				genericAccepted = true
+			}
+		}
+	}
+
+	return "", !vendorRequested || genericAccepted
+}
@@ -0,0 +1,102 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/adapters/inbound/http/middleware"
+	"github.com/stretchr/testify/suite"
+)
+
+type ResponseVersionMiddlewareSuite struct {
+	suite.Suite
+}
+
+func TestResponseVersionMiddlewareSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(ResponseVersionMiddlewareSuite))
+}
+
+func (s *ResponseVersionMiddlewareSuite) TestNegotiation() {
+	s.T().Parallel()
+
+	cases := []struct {
+		name            string
+		accept          []string
+		expectedStatus  int
+		expectedVersion string
+	}{
+		{
+			name:           "no accept header",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "generic json",
+			accept:         []string{"application/json"},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:            "v1",
+			accept:          []string{"application/vnd.devices.v1+json"},
+			expectedStatus:  http.StatusOK,
+			expectedVersion: middleware.ResponseVersionV1,
+		},
+		{
+			name:            "v2 with parameters and mixed case",
+			accept:          []string{"Application/VND.Devices.V2+JSON; charset=utf-8"},
+			expectedStatus:  http.StatusOK,
+			expectedVersion: middleware.ResponseVersionV2,
+		},
+		{
+			name:            "first supported version wins",
+			accept:          []string{"application/vnd.devices.v3+json, application/vnd.devices.v2+json, application/vnd.devices.v1+json"},
+			expectedStatus:  http.StatusOK,
+			expectedVersion: middleware.ResponseVersionV2,
+		},
+		{
+			name:            "versions across several headers",
+			accept:          []string{"application/vnd.devices.v3+json", "application/vnd.devices.v1+json"},
+			expectedStatus:  http.StatusOK,
+			expectedVersion: middleware.ResponseVersionV1,
+		},
+		{
+			name:           "unsupported version with generic fallback",
+			accept:         []string{"application/vnd.devices.v3+json, application/json;q=0.5"},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "unsupported version with wildcard fallback",
+			accept:         []string{"application/vnd.devices.v3+json, */*;q=0.1"},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "unsupported version only",
+			accept:         []string{"application/vnd.devices.v3+json"},
+			expectedStatus: http.StatusNotAcceptable,
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			var version string
+			handler := middleware.ResponseVersionMiddleware()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				version = middleware.GetResponseVersion(r.Context())
+				w.WriteHeader(http.StatusOK)
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/v1/devices", nil)
+			for _, accept := range tc.accept {
+				req.Header.Add("Accept", accept)
+			}
+
+			rec := httptest.NewRecorder()
+
+			handler.ServeHTTP(rec, req)
+
+			s.Require().Equal(tc.expectedStatus, rec.Code)
+			s.Require().Equal(tc.expectedVersion, version)
+			s.Require().Contains(rec.Header().Values("Vary"), "Accept")
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"net"
 	"net/http"
+	"strings"
 )
 
 type FlushableResponseWriter struct {
@@ -91,3 +92,17 @@ func (w *FlushableResponseWriter) Push(target string, opts *http.PushOptions) er
 func (w *FlushableResponseWriter) Unwrap() http.ResponseWriter {
 	return w.ResponseWriter
 }
+
+// appendVary adds value to the Vary header unless it is already listed,
+// preserving entries set by other middlewares.
+func appendVary(h http.Header, value string) {
+	for _, existing := range h.Values("Vary") {
+		for _, field := range strings.Split(existing, ",") {
+			if strings.EqualFold(strings.TrimSpace(field), value) {
+				return
+			}
+		}
+	}
+
+	h.Add("Vary", value)
+}
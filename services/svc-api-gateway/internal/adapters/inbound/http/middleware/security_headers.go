@@ -1,18 +1,59 @@
 package middleware
 
-import "net/http"
+import (
+	"net/http"
+	"strconv"
 
-func SecurityHeaders(apiVersion string) func(http.Handler) http.Handler {
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/config"
+)
+
+// SecurityHeadersMiddleware adds the configured hardening headers to every response,
+// except for requests whose path matches cfg.SkipPaths. Empty values are omitted.
+func SecurityHeadersMiddleware(cfg config.SecurityHeaders) func(http.Handler) http.Handler {
+	skipMatcher := NewPathMatcher(cfg.SkipPaths)
+
+	headers := map[string]string{
+		"X-Content-Type-Options":  cfg.ContentTypeOptions,
+		"X-Frame-Options":         cfg.FrameOptions,
+		"X-XSS-Protection":        cfg.XSSProtection,
+		"Referrer-Policy":         cfg.ReferrerPolicy,
+		"Content-Security-Policy": cfg.ContentSecurityPolicy,
+		"Permissions-Policy":      cfg.PermissionsPolicy,
+	}
+
+	if cfg.HSTSMaxAge > 0 {
+		hsts := "max-age=" + strconv.FormatUint(uint64(cfg.HSTSMaxAge), 10)
+		if cfg.HSTSIncludeSubDomains {
+			hsts += "; includeSubDomains"
+		}
+
+		headers["Strict-Transport-Security"] = hsts
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if skipMatcher.Match(r.URL.Path) {
+				next.ServeHTTP(w, r)
+
+				return
+			}
+
+			for name, value := range headers {
+				if value != "" {
+					w.Header().Set(name, value)
+				}
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// APIVersion advertises the served API version on every response.
+func APIVersion(version string) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			w.Header().Set("X-Content-Type-Options", "nosniff")
-			w.Header().Set("X-Frame-Options", "DENY")
-			w.Header().Set("X-XSS-Protection", "1; mode=block")
-			w.Header().Set("Strict-Transport-Security", "max-age=31536000; includeSubDomains")
-			w.Header().Set("Content-Security-Policy", "default-src 'self'; connect-src 'self'")
-			w.Header().Set("Referrer-Policy", "strict-origin-when-cross-origin")
-			w.Header().Set("Permissions-Policy", "camera=(), microphone=(), geolocation=()")
-			w.Header().Set("API-Version", apiVersion)
+			w.Header().Set("API-Version", version)
 
 			next.ServeHTTP(w, r)
 		})
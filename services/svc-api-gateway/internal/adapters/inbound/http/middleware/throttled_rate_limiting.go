@@ -1,14 +1,19 @@
 package middleware
 
 import (
+	"bytes"
 	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/architeacher/devices/pkg/clock"
 	appLogger "github.com/architeacher/devices/pkg/logger"
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/config"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/domain/model"
 	"github.com/throttled/throttled/v2"
 )
 
@@ -16,16 +21,131 @@ const (
 	RateLimitLimitHeader     = "RateLimit-Limit"
 	RateLimitRemainingHeader = "RateLimit-Remaining"
 	RateLimitResetHeader     = "RateLimit-Reset"
+	RateLimitPolicyHeader    = "RateLimit-Policy"
 	RetryAfterHeader         = "Retry-After"
 
 	globalRateLimitKey = "global"
 )
 
+type (
+	// RateLimitingOption configures optional rate limiting middleware behaviour.
+	RateLimitingOption func(*rateLimitingOptions)
+
+	rateLimitingOptions struct {
+		clock    clock.Clock
+		quantity func(*http.Request) int
+		key      func(*http.Request) string
+	}
+)
+
+// WithRateLimitClock sets the clock used for reset headers, response timestamps and
+// the adaptive burst history. The GCRA store keeps its own time.
+func WithRateLimitClock(c clock.Clock) RateLimitingOption {
+	return func(o *rateLimitingOptions) {
+		o.clock = c
+	}
+}
+
+// WithRequestQuantity sets how many requests a request counts as, so that batch
+// endpoints consume one request per item. Values below 1 count as 1.
+func WithRequestQuantity(quantity func(*http.Request) int) RateLimitingOption {
+	return func(o *rateLimitingOptions) {
+		o.quantity = quantity
+	}
+}
+
+// JSONArrayItemCount returns a request quantity counting the items of the JSON array
+// sent as the body of a POST to path. The body is buffered and restored for the
+// handlers downstream. Other requests, bodies that are not a JSON array and arrays of
+// more than maxItems, which the handler rejects as a whole, count as 1. A maxItems of
+// 0 counts arrays of any size.
+func JSONArrayItemCount(path string, maxItems uint) func(*http.Request) int {
+	return func(r *http.Request) int {
+		if r.Method != http.MethodPost || r.URL.Path != path || r.Body == nil || r.Body == http.NoBody {
+			return 1
+		}
+
+		body, err := io.ReadAll(r.Body)
+		r.Body = readCloser{Reader: io.MultiReader(bytes.NewReader(body), errReader{err: err}), Closer: r.Body}
+
+		if err != nil {
+			return 1
+		}
+
+		count := countJSONArrayItems(body)
+		if maxItems > 0 && uint(count) > maxItems {
+			return 1
+		}
+
+		return count
+	}
+}
+
+func countJSONArrayItems(body []byte) int {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+
+	if token, err := decoder.Token(); err != nil || token != json.Delim('[') {
+		return 1
+	}
+
+	count := 0
+	for decoder.More() {
+		var item json.RawMessage
+		if err := decoder.Decode(&item); err != nil {
+			break
+		}
+
+		count++
+	}
+
+	return max(count, 1)
+}
+
+type (
+	readCloser struct {
+		io.Reader
+		io.Closer
+	}
+
+	// errReader replays a read error once the buffered part of a body is consumed,
+	// so that a body limit hit while counting still reaches the handler.
+	errReader struct {
+		err error
+	}
+)
+
+func (r errReader) Read([]byte) (int, error) {
+	if r.err != nil {
+		return 0, r.err
+	}
+
+	return 0, io.EOF
+}
+
+// WithRateLimitKey sets how requests are grouped into rate limit buckets, replacing the
+// IP, user and tenant keys derived from the configuration.
+func WithRateLimitKey(key func(*http.Request) string) RateLimitingOption {
+	return func(o *rateLimitingOptions) {
+		o.key = key
+	}
+}
+
 func ThrottledRateLimitingMiddleware(
 	cfg config.ThrottledRateLimiting,
 	store throttled.GCRAStoreCtx,
 	logger appLogger.Logger,
+	opts ...RateLimitingOption,
 ) func(http.Handler) http.Handler {
+	options := rateLimitingOptions{
+		clock:    clock.RealClock{},
+		quantity: func(*http.Request) int { return 1 },
+		key:      func(r *http.Request) string { return generateRateLimitKey(r, cfg) },
+	}
+
+	for _, opt := range opts {
+		opt(&options)
+	}
+
 	quota := throttled.RateQuota{
 		MaxRate:  throttled.PerSec(int(cfg.RequestsPerSecond)),
 		MaxBurst: int(cfg.BurstSize),
@@ -36,11 +156,38 @@ func ThrottledRateLimitingMiddleware(
 		logger.Fatal().Err(err).Msg("failed to create rate limiter")
 	}
 
+	// Keys with a clean history are served by a limiter with double the burst.
+	// Both limiters share the store since GCRA state does not depend on burst size.
+	var (
+		adaptiveLimiter *throttled.GCRARateLimiterCtx
+		history         *throttleHistory
+	)
+
+	if cfg.AdaptiveBurst {
+		adaptiveQuota := quota
+		adaptiveQuota.MaxBurst = quota.MaxBurst * 2
+
+		adaptiveLimiter, err = throttled.NewGCRARateLimiterCtx(store, adaptiveQuota)
+		if err != nil {
+			logger.Fatal().Err(err).Msg("failed to create adaptive rate limiter")
+		}
+
+		history, err = newThrottleHistory(cfg.AdaptivePeriod, int(cfg.MaxKeys))
+		if err != nil {
+			logger.Fatal().Err(err).Msg("failed to create adaptive burst history")
+		}
+
+		history.now = options.clock.Now
+	}
+
 	skipPathsSet := make(map[string]struct{}, len(cfg.SkipPaths))
 	for _, path := range cfg.SkipPaths {
 		skipPathsSet[path] = struct{}{}
 	}
 
+	policy := rateLimitPolicy(cfg, quota.MaxBurst)
+	adaptivePolicy := rateLimitPolicy(cfg, quota.MaxBurst*2)
+
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			if shouldSkipRateLimit(r.URL.Path, cfg.SkipPaths, skipPathsSet) {
@@ -49,19 +196,30 @@ func ThrottledRateLimitingMiddleware(
 				return
 			}
 
-			key := generateRateLimitKey(r, cfg)
+			key := options.key(r)
+
+			limiter, limiterPolicy := rateLimiter, policy
+			if history != nil && history.IsClean(key) {
+				limiter, limiterPolicy = adaptiveLimiter, adaptivePolicy
+			}
+
+			w.Header().Set(RateLimitPolicyHeader, limiterPolicy)
 
-			limited, result, err := rateLimiter.RateLimitCtx(r.Context(), key, 1)
+			limited, result, err := limiter.RateLimitCtx(r.Context(), key, max(options.quantity(r), 1))
 			if err != nil {
-				handleRateLimitError(w, r, next, cfg, logger, err)
+				handleRateLimitError(w, r, next, cfg, options.clock, err)
 
 				return
 			}
 
-			setRateLimitHeaders(w, result)
+			setRateLimitHeaders(w, result, options.clock)
 
 			if limited {
-				writeRateLimitedResponse(w, result.RetryAfter)
+				if history != nil {
+					history.Record(key)
+				}
+
+				writeRateLimitedResponse(w, result.RetryAfter, options.clock)
 
 				return
 			}
@@ -86,6 +244,12 @@ func shouldSkipRateLimit(path string, skipPaths []string, skipPathsSet map[strin
 }
 
 func generateRateLimitKey(r *http.Request, cfg config.ThrottledRateLimiting) string {
+	if cfg.EnableTenantLimiting {
+		if claims := GetClaims(r.Context()); claims != nil && claims.TenantID != "" {
+			return generateTenantRateLimitKey(r, cfg, claims)
+		}
+	}
+
 	var parts []string
 
 	if cfg.EnableIPLimiting {
@@ -106,6 +270,30 @@ func generateRateLimitKey(r *http.Request, cfg config.ThrottledRateLimiting) str
 	return strings.Join(parts, "|")
 }
 
+// generateTenantRateLimitKey scopes the rate limit key to the tenant, keyed by
+// user when user limiting is enabled and by client IP otherwise.
+func generateTenantRateLimitKey(r *http.Request, cfg config.ThrottledRateLimiting, claims *model.PasetoClaims) string {
+	identity := extractIP(r.RemoteAddr)
+
+	if cfg.EnableUserLimiting && claims.Subject != "" {
+		identity = claims.Subject
+	}
+
+	return "tenant:" + claims.TenantID + ":" + identity
+}
+
+// rateLimitPolicy formats the RateLimit-Policy header value as
+// "{limit};w={windowSeconds};burst={burstSize}", where burst is the one the key is
+// served with.
+func rateLimitPolicy(cfg config.ThrottledRateLimiting, burst int) string {
+	return fmt.Sprintf(
+		"%d;w=%d;burst=%d",
+		cfg.RequestsPerSecond,
+		int64(cfg.WindowDuration.Seconds()),
+		burst,
+	)
+}
+
 func extractIP(remoteAddr string) string {
 	if idx := strings.LastIndex(remoteAddr, ":"); idx != -1 {
 		return remoteAddr[:idx]
@@ -114,13 +302,13 @@ func extractIP(remoteAddr string) string {
 	return remoteAddr
 }
 
-func setRateLimitHeaders(w http.ResponseWriter, result throttled.RateLimitResult) {
+func setRateLimitHeaders(w http.ResponseWriter, result throttled.RateLimitResult, clk clock.Clock) {
 	w.Header().Set(RateLimitLimitHeader, strconv.Itoa(result.Limit))
 	w.Header().Set(RateLimitRemainingHeader, strconv.Itoa(result.Remaining))
-	w.Header().Set(RateLimitResetHeader, strconv.FormatInt(time.Now().Add(result.ResetAfter).Unix(), 10))
+	w.Header().Set(RateLimitResetHeader, strconv.FormatInt(clk.Now().Add(result.ResetAfter).Unix(), 10))
 }
 
-func writeRateLimitedResponse(w http.ResponseWriter, retryAfter time.Duration) {
+func writeRateLimitedResponse(w http.ResponseWriter, retryAfter time.Duration, clk clock.Clock) {
 	w.Header().Set(RetryAfterHeader, strconv.Itoa(int(retryAfter.Seconds())))
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusTooManyRequests)
@@ -128,7 +316,7 @@ func writeRateLimitedResponse(w http.ResponseWriter, retryAfter time.Duration) {
 	response := map[string]any{
 		"code":      "RATE_LIMIT_EXCEEDED",
 		"message":   "too many requests, please try again later",
-		"timestamp": time.Now().UTC().Format(time.RFC3339),
+		"timestamp": clk.Now().UTC().Format(time.RFC3339),
 	}
 
 	_ = json.NewEncoder(w).Encode(response)
@@ -139,10 +327,11 @@ func handleRateLimitError(
 	r *http.Request,
 	next http.Handler,
 	cfg config.ThrottledRateLimiting,
-	logger appLogger.Logger,
+	clk clock.Clock,
 	err error,
 ) {
-	logger.Warn().Err(err).Msg("rate limiter store error")
+	reqLogger := LoggerFromContext(r.Context())
+	reqLogger.Warn().Err(err).Msg("rate limiter store error")
 
 	if cfg.GracefulDegraded {
 		next.ServeHTTP(w, r)
@@ -156,7 +345,7 @@ func handleRateLimitError(
 	response := map[string]any{
 		"code":      "RATE_LIMITER_UNAVAILABLE",
 		"message":   "rate limiting service temporarily unavailable",
-		"timestamp": time.Now().UTC().Format(time.RFC3339),
+		"timestamp": clk.Now().UTC().Format(time.RFC3339),
 	}
 
 	_ = json.NewEncoder(w).Encode(response)
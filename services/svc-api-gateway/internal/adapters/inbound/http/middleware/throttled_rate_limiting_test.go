@@ -2,13 +2,17 @@ package middleware_test
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/architeacher/devices/pkg/clock"
 	"github.com/architeacher/devices/pkg/logger"
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/adapters/inbound/http/middleware"
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/config"
@@ -145,6 +149,126 @@ func (s *RateLimitingTestSuite) TestRFCHeadersAreSet() {
 	s.Require().NotEmpty(rec.Header().Get(middleware.RateLimitResetHeader), "RateLimit-Reset header should be set")
 }
 
+func (s *RateLimitingTestSuite) TestRateLimitClockDrivesResetAndTimestamp() {
+	s.T().Parallel()
+
+	cfg := s.config
+	cfg.RequestsPerSecond = 1
+	cfg.BurstSize = 0
+
+	store, err := memstore.NewCtx(100)
+	s.Require().NoError(err)
+
+	now := time.Date(2030, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	handler := middleware.ThrottledRateLimitingMiddleware(cfg, store, s.log, middleware.WithRateLimitClock(clock.NewMockClock(now)))(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}),
+	)
+
+	var rec *httptest.ResponseRecorder
+
+	for range 2 {
+		req := httptest.NewRequest(http.MethodGet, "/api/devices", nil)
+		req.RemoteAddr = "192.168.1.120:12345"
+		rec = httptest.NewRecorder()
+
+		handler.ServeHTTP(rec, req)
+	}
+
+	s.Require().Equal(http.StatusTooManyRequests, rec.Code)
+
+	reset, err := strconv.ParseInt(rec.Header().Get(middleware.RateLimitResetHeader), 10, 64)
+	s.Require().NoError(err)
+	s.Require().InDelta(now.Unix(), reset, 1, "reset is relative to the injected clock")
+
+	var body map[string]any
+	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&body))
+	s.Require().Equal(now.Format(time.RFC3339), body["timestamp"])
+}
+
+func (s *RateLimitingTestSuite) TestRateLimitPolicyHeader() {
+	s.T().Parallel()
+
+	cfg := s.config
+	cfg.RequestsPerSecond = 1
+	cfg.BurstSize = 0
+	cfg.WindowDuration = 30 * time.Second
+	cfg.SkipPaths = []string{"/health"}
+
+	store, err := memstore.NewCtx(100)
+	s.Require().NoError(err)
+
+	handler := middleware.ThrottledRateLimitingMiddleware(cfg, store, s.log)(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}),
+	)
+
+	cases := []struct {
+		name           string
+		path           string
+		expectedStatus int
+		expectedPolicy string
+	}{
+		{
+			name:           "allowed response",
+			path:           "/api/devices",
+			expectedStatus: http.StatusOK,
+			expectedPolicy: "1;w=30;burst=0",
+		},
+		{
+			name:           "rate limited response",
+			path:           "/api/devices",
+			expectedStatus: http.StatusTooManyRequests,
+			expectedPolicy: "1;w=30;burst=0",
+		},
+		{
+			name:           "skip path response",
+			path:           "/health",
+			expectedStatus: http.StatusOK,
+			expectedPolicy: "",
+		},
+	}
+
+	for _, tc := range cases {
+		req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+		req.RemoteAddr = "192.168.1.30:12345"
+		rec := httptest.NewRecorder()
+
+		handler.ServeHTTP(rec, req)
+
+		s.Require().Equal(tc.expectedStatus, rec.Code, tc.name)
+		s.Require().Equal(tc.expectedPolicy, rec.Header().Get(middleware.RateLimitPolicyHeader), tc.name)
+	}
+}
+
+func (s *RateLimitingTestSuite) TestRateLimitPolicyHeaderOnStoreError() {
+	s.T().Parallel()
+
+	cfg := s.config
+	cfg.RequestsPerSecond = 10
+	cfg.BurstSize = 5
+	cfg.WindowDuration = time.Second
+	cfg.GracefulDegraded = true
+
+	handler := middleware.ThrottledRateLimitingMiddleware(cfg, &errorStore{}, s.log)(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}),
+	)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/devices", nil)
+	req.RemoteAddr = "192.168.1.31:12345"
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	s.Require().Equal(http.StatusOK, rec.Code)
+	s.Require().Equal("10;w=1;burst=5", rec.Header().Get(middleware.RateLimitPolicyHeader))
+}
+
 func (s *RateLimitingTestSuite) TestRetryAfterHeaderOnRateLimited() {
 	s.T().Parallel()
 
@@ -315,6 +439,226 @@ func (s *RateLimitingTestSuite) TestCombinedIPAndUserKeyGeneration() {
 	s.Require().Equal(http.StatusOK, rec3.Code)
 }
 
+func (s *RateLimitingTestSuite) TestTenantKeyGeneration() {
+	s.T().Parallel()
+
+	newRequest := func(subject, tenantID, ip string) *http.Request {
+		claims := &model.PasetoClaims{
+			Subject:  subject,
+			TenantID: tenantID,
+		}
+		ctx := context.WithValue(context.Background(), middleware.ClaimsKey, claims)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/devices", nil).WithContext(ctx)
+		req.RemoteAddr = ip
+
+		return req
+	}
+
+	cases := []struct {
+		name               string
+		enableUserLimiting bool
+		requests           []*http.Request
+		expectedStatuses   []int
+	}{
+		{
+			name:               "users sharing a tenant and IP share a quota",
+			enableUserLimiting: false,
+			requests: []*http.Request{
+				newRequest("user-a", "tenant-1", "192.168.3.1:12345"),
+				newRequest("user-b", "tenant-1", "192.168.3.1:12345"),
+			},
+			expectedStatuses: []int{http.StatusOK, http.StatusTooManyRequests},
+		},
+		{
+			name:               "users in different tenants do not interfere",
+			enableUserLimiting: false,
+			requests: []*http.Request{
+				newRequest("user-a", "tenant-1", "192.168.3.2:12345"),
+				newRequest("user-b", "tenant-2", "192.168.3.2:12345"),
+				newRequest("user-a", "tenant-1", "192.168.3.2:12345"),
+			},
+			expectedStatuses: []int{http.StatusOK, http.StatusOK, http.StatusTooManyRequests},
+		},
+		{
+			name:               "same user in different tenants is throttled per tenant",
+			enableUserLimiting: true,
+			requests: []*http.Request{
+				newRequest("user-a", "tenant-1", "192.168.3.3:12345"),
+				newRequest("user-a", "tenant-2", "192.168.3.4:12345"),
+				newRequest("user-a", "tenant-1", "192.168.3.5:12345"),
+			},
+			expectedStatuses: []int{http.StatusOK, http.StatusOK, http.StatusTooManyRequests},
+		},
+		{
+			name:               "tenant user key ignores client IP",
+			enableUserLimiting: true,
+			requests: []*http.Request{
+				newRequest("user-a", "tenant-1", "192.168.3.6:12345"),
+				newRequest("user-a", "tenant-1", "192.168.3.7:12345"),
+			},
+			expectedStatuses: []int{http.StatusOK, http.StatusTooManyRequests},
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			store, err := memstore.NewCtx(100)
+			s.Require().NoError(err)
+
+			cfg := s.config
+			cfg.RequestsPerSecond = 1
+			cfg.BurstSize = 0
+			cfg.EnableTenantLimiting = true
+			cfg.EnableUserLimiting = tc.enableUserLimiting
+
+			handler := middleware.ThrottledRateLimitingMiddleware(cfg, store, s.log)(
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusOK)
+				}),
+			)
+
+			for index, req := range tc.requests {
+				rec := httptest.NewRecorder()
+				handler.ServeHTTP(rec, req)
+				s.Require().Equal(tc.expectedStatuses[index], rec.Code, "request %d", index+1)
+			}
+		})
+	}
+}
+
+func (s *RateLimitingTestSuite) TestTenantLimitingDisabledIgnoresTenant() {
+	s.T().Parallel()
+
+	store, err := memstore.NewCtx(100)
+	s.Require().NoError(err)
+
+	cfg := s.config
+	cfg.RequestsPerSecond = 1
+	cfg.BurstSize = 0
+	cfg.EnableIPLimiting = false
+	cfg.EnableUserLimiting = true
+	cfg.EnableTenantLimiting = false
+
+	handler := middleware.ThrottledRateLimitingMiddleware(cfg, store, s.log)(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}),
+	)
+
+	// The same subject in two tenants shares the user quota when tenant limiting is off
+	for index, tenantID := range []string{"tenant-1", "tenant-2"} {
+		claims := &model.PasetoClaims{Subject: "user-shared", TenantID: tenantID}
+		ctx := context.WithValue(context.Background(), middleware.ClaimsKey, claims)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/devices", nil).WithContext(ctx)
+		req.RemoteAddr = "192.168.3.10:12345"
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		if index == 0 {
+			s.Require().Equal(http.StatusOK, rec.Code)
+		} else {
+			s.Require().Equal(http.StatusTooManyRequests, rec.Code)
+		}
+	}
+}
+
+func (s *RateLimitingTestSuite) TestAdaptiveBurst() {
+	s.T().Parallel()
+
+	cfg := s.config
+	cfg.RequestsPerSecond = 1
+	cfg.BurstSize = 1
+	cfg.EnableUserLimiting = false
+	cfg.AdaptiveBurst = true
+	cfg.AdaptivePeriod = 200 * time.Millisecond
+
+	store, err := memstore.NewCtx(100)
+	s.Require().NoError(err)
+
+	handler := middleware.ThrottledRateLimitingMiddleware(cfg, store, s.log)(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}),
+	)
+
+	serve := func(ip string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/api/devices", nil)
+		req.RemoteAddr = ip
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		return rec
+	}
+
+	limitOf := func(rec *httptest.ResponseRecorder) uint {
+		limit, err := strconv.Atoi(rec.Header().Get(middleware.RateLimitLimitHeader))
+		s.Require().NoError(err)
+
+		return uint(limit)
+	}
+
+	standardLimit := cfg.BurstSize + 1
+	doubledLimit := cfg.BurstSize*2 + 1
+	standardPolicy := "1;w=60;burst=1"
+	doubledPolicy := "1;w=60;burst=2"
+
+	// A fresh key gets the doubled burst: three immediate requests succeed
+	for index := range doubledLimit {
+		rec := serve("192.168.4.1:12345")
+		s.Require().Equal(http.StatusOK, rec.Code, "request %d", index+1)
+		s.Require().Equal(doubledLimit, limitOf(rec))
+		s.Require().Equal(doubledPolicy, rec.Header().Get(middleware.RateLimitPolicyHeader))
+	}
+
+	rec := serve("192.168.4.1:12345")
+	s.Require().Equal(http.StatusTooManyRequests, rec.Code)
+	s.Require().Equal(doubledPolicy, rec.Header().Get(middleware.RateLimitPolicyHeader))
+
+	// Once throttled, the key reverts to the standard burst
+	rec = serve("192.168.4.1:12345")
+	s.Require().Equal(standardLimit, limitOf(rec))
+	s.Require().Equal(standardPolicy, rec.Header().Get(middleware.RateLimitPolicyHeader))
+
+	// Other keys are unaffected
+	rec = serve("192.168.4.2:12345")
+	s.Require().Equal(http.StatusOK, rec.Code)
+	s.Require().Equal(doubledLimit, limitOf(rec))
+	s.Require().Equal(doubledPolicy, rec.Header().Get(middleware.RateLimitPolicyHeader))
+
+	// After the adaptive period without further 429s, the doubled burst is restored
+	time.Sleep(cfg.AdaptivePeriod + 50*time.Millisecond)
+
+	rec = serve("192.168.4.1:12345")
+	s.Require().Equal(doubledLimit, limitOf(rec))
+	s.Require().Equal(doubledPolicy, rec.Header().Get(middleware.RateLimitPolicyHeader))
+}
+
+func (s *RateLimitingTestSuite) TestAdaptiveBurstDisabledUsesStandardBurst() {
+	s.T().Parallel()
+
+	cfg := s.config
+	cfg.BurstSize = 3
+	cfg.AdaptiveBurst = false
+
+	store, err := memstore.NewCtx(100)
+	s.Require().NoError(err)
+
+	handler := middleware.ThrottledRateLimitingMiddleware(cfg, store, s.log)(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}),
+	)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/devices", nil)
+	req.RemoteAddr = "192.168.4.3:12345"
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	s.Require().Equal(strconv.Itoa(int(cfg.BurstSize+1)), rec.Header().Get(middleware.RateLimitLimitHeader))
+}
+
 func (s *RateLimitingTestSuite) TestRateLimitHeaderValues() {
 	s.T().Parallel()
 
@@ -439,6 +783,87 @@ func (s *RateLimitingTestSuite) TestHandlerCalledOnlyOnce() {
 	s.Require().Equal(1, callCount, "handler should be called exactly once")
 }
 
+func (s *RateLimitingTestSuite) TestRequestQuantityCountsBulkItems() {
+	s.T().Parallel()
+
+	store, err := memstore.NewCtx(100)
+	s.Require().NoError(err)
+
+	cfg := s.config
+	cfg.RequestsPerSecond = 1
+	cfg.BurstSize = 5
+
+	var receivedBodies []string
+	handler := middleware.ThrottledRateLimitingMiddleware(cfg, store, s.log,
+		middleware.WithRequestQuantity(middleware.JSONArrayItemCount("/v1/devices/bulk", 4)),
+	)(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			s.Require().NoError(err)
+
+			receivedBodies = append(receivedBodies, string(body))
+			w.WriteHeader(http.StatusOK)
+		}),
+	)
+
+	send := func(method, path, body string) int {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.RemoteAddr = "192.168.3.1:12345"
+		rec := httptest.NewRecorder()
+
+		handler.ServeHTTP(rec, req)
+
+		return rec.Code
+	}
+
+	// The burst allows 6 requests at once.
+	bulkOfFour := `[{"name":"a"},{"name":"b"},{"name":"c"},{"name":"d"}]`
+	s.Require().Equal(http.StatusOK, send(http.MethodPost, "/v1/devices/bulk", bulkOfFour))
+	s.Require().Equal([]string{bulkOfFour}, receivedBodies, "the counted body reaches the handler intact")
+
+	tooLarge := `[{},{},{},{},{}]`
+	s.Require().Equal(http.StatusOK, send(http.MethodPost, "/v1/devices/bulk", tooLarge),
+		"a batch over the item limit counts as one request")
+
+	s.Require().Equal(http.StatusTooManyRequests, send(http.MethodPost, "/v1/devices/bulk", `[{},{}]`),
+		"a batch of two does not fit in the one request left")
+	s.Require().Equal(http.StatusOK, send(http.MethodPost, "/v1/devices", `[{},{}]`),
+		"other paths count as one request")
+}
+
+func (s *RateLimitingTestSuite) TestJSONArrayItemCount() {
+	s.T().Parallel()
+
+	quantity := middleware.JSONArrayItemCount("/v1/devices/bulk", 0)
+
+	cases := []struct {
+		name     string
+		method   string
+		path     string
+		body     string
+		expected int
+	}{
+		{name: "array items", method: http.MethodPost, path: "/v1/devices/bulk", body: `[1, {"a": [2, 3]}, "x"]`, expected: 3},
+		{name: "empty array", method: http.MethodPost, path: "/v1/devices/bulk", body: `[]`, expected: 1},
+		{name: "object body", method: http.MethodPost, path: "/v1/devices/bulk", body: `{"name": "a"}`, expected: 1},
+		{name: "malformed array counts the items read", method: http.MethodPost, path: "/v1/devices/bulk", body: `[{}, {}, {`, expected: 2},
+		{name: "other method", method: http.MethodPut, path: "/v1/devices/bulk", body: `[{}, {}]`, expected: 1},
+		{name: "other path", method: http.MethodPost, path: "/v1/devices", body: `[{}, {}]`, expected: 1},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			req := httptest.NewRequest(tc.method, tc.path, strings.NewReader(tc.body))
+
+			s.Require().Equal(tc.expected, quantity(req))
+
+			body, err := io.ReadAll(req.Body)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.body, string(body))
+		})
+	}
+}
+
 func (s *RateLimitingTestSuite) TestSkipPathsWithPrefix() {
 	s.T().Parallel()
 
@@ -0,0 +1,166 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/config"
+)
+
+const requestTimeoutBody = `{"code":"REQUEST_TIMEOUT","message":"request processing exceeded its deadline"}`
+
+// errRequestTimeout is the cause of a request context cancelled by TimeoutMiddleware, which
+// tells its own deadline apart from a client disconnect or an outer deadline.
+var errRequestTimeout = errors.New("request exceeded its deadline")
+
+// pathTimeout pairs a path prefix with the deadline of the requests it matches.
+type pathTimeout struct {
+	prefix  string
+	timeout time.Duration
+}
+
+// TimeoutMiddleware bounds handler execution with a request context deadline, picking it
+// from the longest matching path prefix in cfg.Paths and falling back to cfg.Default.
+// The handler runs on the request goroutine and writes straight through, so streamed
+// responses are flushed to the client as they are produced. A handler that has not
+// started its response by the deadline is answered with a 503 JSON error instead.
+func TimeoutMiddleware(cfg config.TimeoutConfig) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if !cfg.Enabled {
+			return next
+		}
+
+		overrides := make([]pathTimeout, 0, len(cfg.Paths))
+		for prefix, timeout := range cfg.Paths {
+			overrides = append(overrides, pathTimeout{
+				prefix:  prefix,
+				timeout: timeout,
+			})
+		}
+
+		// Longest prefixes first so the most specific override wins
+		slices.SortFunc(overrides, func(a, b pathTimeout) int {
+			return len(b.prefix) - len(a.prefix)
+		})
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			timeout := cfg.Default
+			for _, override := range overrides {
+				if strings.HasPrefix(r.URL.Path, override.prefix) {
+					timeout = override.timeout
+
+					break
+				}
+			}
+
+			if timeout <= 0 {
+				next.ServeHTTP(w, r)
+
+				return
+			}
+
+			ctx, cancel := context.WithTimeoutCause(r.Context(), timeout, errRequestTimeout)
+			defer cancel()
+
+			tw := &timeoutResponseWriter{ResponseWriter: w, ctx: ctx}
+
+			next.ServeHTTP(tw, r.WithContext(ctx))
+
+			if !tw.wroteHeader && context.Cause(ctx) == errRequestTimeout {
+				tw.writeRequestTimeout()
+			}
+
+			switch {
+			case r.Context().Err() != nil:
+				reqLogger := LoggerFromContext(r.Context())
+				reqLogger.Debug().
+					Err(r.Context().Err()).
+					Str("method", r.Method).
+					Str("path", r.URL.Path).
+					Msg("request context cancelled before completion")
+			case tw.replaced:
+				reqLogger := LoggerFromContext(r.Context())
+				reqLogger.Warn().
+					Str("method", r.Method).
+					Str("path", r.URL.Path).
+					Dur("timeout", timeout).
+					Msg("request exceeded its deadline")
+			}
+		})
+	}
+}
+
+func writeRequestTimeout(w http.ResponseWriter) {
+	w.Header().Del("Content-Length")
+	w.Header().Del("Content-Encoding")
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusServiceUnavailable)
+	_, _ = w.Write([]byte(requestTimeoutBody))
+}
+
+// timeoutResponseWriter swaps the handler's response for a 503 when the handler starts it
+// only after the deadline. A response started in time is passed through untouched, even
+// if the deadline expires while it is still being streamed.
+type timeoutResponseWriter struct {
+	http.ResponseWriter
+	ctx         context.Context
+	wroteHeader bool
+	replaced    bool
+}
+
+func (w *timeoutResponseWriter) WriteHeader(code int) {
+	if w.wroteHeader {
+		return
+	}
+
+	if context.Cause(w.ctx) == errRequestTimeout {
+		w.writeRequestTimeout()
+
+		return
+	}
+
+	w.wroteHeader = true
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *timeoutResponseWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+
+	if w.replaced {
+		// Discard the handler's output; the 503 body has already been written.
+		return len(b), nil
+	}
+
+	return w.ResponseWriter.Write(b)
+}
+
+// Flush commits the status code before flushing, so a flush issued by the handler ahead
+// of its first write still goes through the deadline check.
+func (w *timeoutResponseWriter) Flush() {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+
+	if w.replaced {
+		return
+	}
+
+	_ = http.NewResponseController(w.ResponseWriter).Flush()
+}
+
+func (w *timeoutResponseWriter) writeRequestTimeout() {
+	w.wroteHeader = true
+	w.replaced = true
+
+	writeRequestTimeout(w.ResponseWriter)
+}
+
+func (w *timeoutResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
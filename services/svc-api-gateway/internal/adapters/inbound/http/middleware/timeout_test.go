@@ -0,0 +1,261 @@
+package middleware_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/architeacher/devices/pkg/logger"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/adapters/inbound/http/middleware"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/config"
+	"github.com/stretchr/testify/suite"
+)
+
+type TimeoutMiddlewareSuite struct {
+	suite.Suite
+}
+
+func TestTimeoutMiddlewareSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(TimeoutMiddlewareSuite))
+}
+
+// blockingHandler waits for the given delay or until the request context is done.
+func blockingHandler(delay time.Duration) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(delay):
+			w.Header().Set("Content-Type", "text/plain")
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte("done"))
+		case <-r.Context().Done():
+		}
+	})
+}
+
+func (s *TimeoutMiddlewareSuite) TestFastHandlerIsNotAffected() {
+	s.T().Parallel()
+
+	cfg := config.TimeoutConfig{
+		Enabled: true,
+		Default: time.Second,
+	}
+
+	handler := middleware.TimeoutMiddleware(cfg)(blockingHandler(0))
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/devices", nil)
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	s.Require().Equal(http.StatusOK, rec.Code)
+	s.Require().Equal("text/plain", rec.Header().Get("Content-Type"))
+	s.Require().Equal("done", rec.Body.String())
+}
+
+func (s *TimeoutMiddlewareSuite) TestSlowHandlerTimesOut() {
+	s.T().Parallel()
+
+	cfg := config.TimeoutConfig{
+		Enabled: true,
+		Default: 50 * time.Millisecond,
+	}
+
+	handler := middleware.TimeoutMiddleware(cfg)(blockingHandler(time.Second))
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/devices", nil)
+	rec := httptest.NewRecorder()
+
+	start := time.Now()
+	handler.ServeHTTP(rec, req)
+	elapsed := time.Since(start)
+
+	s.Require().Equal(http.StatusServiceUnavailable, rec.Code)
+	s.Require().Equal("application/json", rec.Header().Get("Content-Type"))
+	s.Require().JSONEq(`{"code":"REQUEST_TIMEOUT","message":"request processing exceeded its deadline"}`, rec.Body.String())
+	s.Require().GreaterOrEqual(elapsed, cfg.Default)
+	s.Require().Less(elapsed, 500*time.Millisecond)
+}
+
+func (s *TimeoutMiddlewareSuite) TestLateResponseIsReplaced() {
+	s.T().Parallel()
+
+	cfg := config.TimeoutConfig{
+		Enabled: true,
+		Default: 20 * time.Millisecond,
+	}
+
+	// The handler ignores its context and answers only after the deadline.
+	handler := middleware.TimeoutMiddleware(cfg)(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			time.Sleep(50 * time.Millisecond)
+
+			w.Header().Set("Content-Type", "text/plain")
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte("late"))
+		},
+	))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/devices", nil))
+
+	s.Require().Equal(http.StatusServiceUnavailable, rec.Code)
+	s.Require().Equal("application/json", rec.Header().Get("Content-Type"))
+	s.Require().JSONEq(`{"code":"REQUEST_TIMEOUT","message":"request processing exceeded its deadline"}`, rec.Body.String())
+}
+
+func (s *TimeoutMiddlewareSuite) TestStreamedResponseIsFlushedPastTheDeadline() {
+	s.T().Parallel()
+
+	cfg := config.TimeoutConfig{
+		Enabled: true,
+		Default: 20 * time.Millisecond,
+	}
+
+	// The handler holds the response open until the client has read the first line, which
+	// it only can if that line was flushed through the middleware.
+	firstLineRead := make(chan struct{})
+
+	handler := middleware.TimeoutMiddleware(cfg)(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			controller := http.NewResponseController(w)
+
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte("first\n"))
+			_ = controller.Flush()
+
+			select {
+			case <-firstLineRead:
+			case <-time.After(time.Second):
+				return
+			}
+
+			_, _ = w.Write([]byte("second\n"))
+		},
+	))
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	resp, err := server.Client().Get(server.URL + "/v1/devices/export")
+	s.Require().NoError(err)
+	defer resp.Body.Close()
+
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+
+	reader := bufio.NewReader(resp.Body)
+
+	line, err := reader.ReadString('\n')
+	s.Require().NoError(err)
+	s.Require().Equal("first\n", line)
+
+	time.Sleep(2 * cfg.Default)
+	close(firstLineRead)
+
+	rest, err := io.ReadAll(reader)
+	s.Require().NoError(err)
+	s.Require().Equal("second\n", string(rest), "a response started in time outlives the deadline")
+}
+
+func (s *TimeoutMiddlewareSuite) TestPathOverrides() {
+	s.T().Parallel()
+
+	cfg := config.TimeoutConfig{
+		Enabled: true,
+		Default: 50 * time.Millisecond,
+		Paths: map[string]time.Duration{
+			"/v1/devices":        time.Second,
+			"/v1/devices/export": 50 * time.Millisecond,
+			"/v1/health":         0,
+		},
+	}
+
+	cases := []struct {
+		name           string
+		path           string
+		expectedStatus int
+	}{
+		{
+			name:           "override extends the default deadline",
+			path:           "/v1/devices",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "longest prefix wins",
+			path:           "/v1/devices/export",
+			expectedStatus: http.StatusServiceUnavailable,
+		},
+		{
+			name:           "zero override disables the deadline",
+			path:           "/v1/health",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "unmatched path uses the default",
+			path:           "/v1/other",
+			expectedStatus: http.StatusServiceUnavailable,
+		},
+	}
+
+	handler := middleware.TimeoutMiddleware(cfg)(blockingHandler(150 * time.Millisecond))
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+			rec := httptest.NewRecorder()
+
+			handler.ServeHTTP(rec, req)
+
+			s.Require().Equal(tc.expectedStatus, rec.Code)
+		})
+	}
+}
+
+func (s *TimeoutMiddlewareSuite) TestDisabledPassesThrough() {
+	s.T().Parallel()
+
+	cfg := config.TimeoutConfig{
+		Enabled: false,
+		Default: time.Millisecond,
+	}
+
+	handler := middleware.TimeoutMiddleware(cfg)(blockingHandler(20 * time.Millisecond))
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/devices", nil)
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	s.Require().Equal(http.StatusOK, rec.Code)
+}
+
+func (s *TimeoutMiddlewareSuite) TestClientDisconnectIsLoggedAtDebug() {
+	s.T().Parallel()
+
+	cfg := config.TimeoutConfig{
+		Enabled: true,
+		Default: time.Second,
+	}
+
+	var logBuffer bytes.Buffer
+
+	handler := middleware.RequestLoggerMiddleware(logger.NewWithWriter("debug", "json", &logBuffer))(
+		middleware.TimeoutMiddleware(cfg)(blockingHandler(time.Second)),
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	req := httptest.NewRequest(http.MethodGet, "/v1/devices", nil).WithContext(ctx)
+	rec := httptest.NewRecorder()
+
+	time.AfterFunc(20*time.Millisecond, cancel)
+	handler.ServeHTTP(rec, req)
+
+	s.Require().Contains(logBuffer.String(), `"level":"debug"`)
+	s.Require().Contains(logBuffer.String(), "request context cancelled before completion")
+	s.Require().NotContains(logBuffer.String(), "request exceeded its deadline")
+}
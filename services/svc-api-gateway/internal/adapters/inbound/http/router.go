@@ -1,11 +1,15 @@
 package http
 
 import (
+	"maps"
 	"net/http"
+	"time"
 
 	"github.com/architeacher/devices/pkg/logger"
 	"github.com/architeacher/devices/pkg/metrics"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/adapters/inbound/http/handlers/graphql"
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/adapters/inbound/http/handlers/public"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/adapters/inbound/http/handlers/shared"
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/adapters/inbound/http/middleware"
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/config"
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/ports"
@@ -15,10 +19,13 @@ import (
 	"github.com/go-chi/chi/v5"
 	chimiddleware "github.com/go-chi/chi/v5/middleware"
 	"github.com/throttled/throttled/v2"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
-	baseURL = "/v1"
+	baseURL     = "/v1"
+	graphQLPath = "/graphql"
 )
 
 type RouterConfig struct {
@@ -28,6 +35,8 @@ type RouterConfig struct {
 	RateLimitStore  throttled.GCRAStoreCtx
 	Logger          logger.Logger
 	MetricsClient   metrics.Client
+	TracerProvider  trace.TracerProvider
+	CPUGuard        *middleware.CPUGuard
 }
 
 func NewRouter(cfg RouterConfig) http.Handler {
@@ -42,7 +51,16 @@ func NewRouter(cfg RouterConfig) http.Handler {
 		ListStaleRevalidate:  cfg.ServiceConfig.DevicesCache.ListStaleRevalidate,
 	}
 
-	handler := public.NewDeviceHandler(cfg.App, public.WithHTTPCacheConfig(cacheConfig))
+	handler := public.NewDeviceHandler(
+		cfg.App,
+		public.WithHTTPCacheConfig(cacheConfig),
+		public.WithBulkMaxItems(cfg.ServiceConfig.PublicHTTPServer.BulkMaxItems),
+		public.WithExportWriteTimeout(cfg.ServiceConfig.PublicHTTPServer.WriteTimeout),
+		public.WithResponseVersion(cfg.ServiceConfig.PublicHTTPServer.ResponseVersion),
+		public.WithBareResponseAllowed(cfg.ServiceConfig.PublicHTTPServer.BareResponseAllowed),
+	)
+
+	mountGraphQL(router, cfg)
 
 	// Spin up automatic generated routes.
 	return public.HandlerWithOptions(handler, public.ChiServerOptions{
@@ -80,12 +98,22 @@ func initMiddlewares(cfg RouterConfig) []public.MiddlewareFunc {
 
 	middlewares := []public.MiddlewareFunc{
 		chimiddleware.RealIP,
-		chimiddleware.Timeout(cfg.ServiceConfig.PublicHTTPServer.WriteTimeout),
-		middleware.RequestTracking(),
-		middleware.SecurityHeaders(cfg.ServiceConfig.App.APIVersion),
-		middleware.CORS([]string{"*"}),
-		middleware.Recovery(cfg.Logger),
+		middleware.APIVersion(cfg.ServiceConfig.App.APIVersion),
+		middleware.ResponseVersionMiddleware(),
+		middleware.BareResponseMiddleware(),
+		middleware.FieldFilterMiddleware(),
+		middleware.SecurityHeadersMiddleware(cfg.ServiceConfig.SecurityHeaders),
+		middleware.CORSMiddleware(cfg.ServiceConfig.CORS),
+		middleware.Recovery(),
+		// Inside the request validator, so cached responses are still served only to
+		// authenticated requests.
+		middleware.InMemoryCacheMiddleware(
+			cfg.ServiceConfig.InMemoryCache,
+			cfg.Logger,
+			middleware.WithInMemoryCacheRefresh(shared.RefreshMeta),
+		),
 		requestValidator,
+		middleware.TimeoutMiddleware(exportWithoutTimeout(cfg.ServiceConfig.Timeout)),
 	}
 
 	if cfg.ServiceConfig.Auth.Enabled {
@@ -97,6 +125,11 @@ func initMiddlewares(cfg RouterConfig) []public.MiddlewareFunc {
 			cfg.ServiceConfig.ThrottledRateLimiting,
 			cfg.RateLimitStore,
 			cfg.Logger,
+			// A bulk create counts as one request per device.
+			middleware.WithRequestQuantity(middleware.JSONArrayItemCount(
+				baseURL+"/devices/bulk",
+				cfg.ServiceConfig.PublicHTTPServer.BulkMaxItems,
+			)),
 		)
 		middlewares = append(middlewares, rateLimitMiddleware)
 
@@ -107,15 +140,30 @@ func initMiddlewares(cfg RouterConfig) []public.MiddlewareFunc {
 		idempotencyMiddleware := middleware.IdempotencyMiddleware(
 			cfg.IdempotencyRepo,
 			cfg.ServiceConfig.Idempotency,
-			cfg.Logger,
+			cfg.MetricsClient,
 		)
 		middlewares = append(middlewares, idempotencyMiddleware)
 
 		cfg.Logger.Info().Msg("idempotency middleware enabled")
 	}
 
+	// Middlewares listed later wrap earlier ones, so the body limit must come after
+	// every middleware that reads the request body (validator, idempotency).
+	if cfg.ServiceConfig.PublicHTTPServer.BodyLimit > 0 {
+		middlewares = append(middlewares, middleware.BodyLimitMiddleware(cfg.ServiceConfig.PublicHTTPServer.BodyLimit))
+	}
+
 	if cfg.ServiceConfig.Deprecation.Enabled {
-		middlewares = append(middlewares, middleware.Sunset(cfg.ServiceConfig.Deprecation))
+		var deprecationOpts []middleware.DeprecationOption
+		if cfg.MetricsClient != nil && cfg.ServiceConfig.Telemetry.Metrics.Enabled {
+			deprecationOpts = append(deprecationOpts, middleware.WithDeprecationMetrics(cfg.MetricsClient))
+		}
+
+		middlewares = append(middlewares, middleware.DeprecationMiddleware(
+			cfg.ServiceConfig.Deprecation,
+			cfg.Logger,
+			deprecationOpts...,
+		))
 
 		cfg.Logger.Info().
 			Str("sunset_date", cfg.ServiceConfig.Deprecation.SunsetDate).
@@ -126,17 +174,24 @@ func initMiddlewares(cfg RouterConfig) []public.MiddlewareFunc {
 	if cfg.ServiceConfig.Compression.Enabled {
 		var compressionMiddleware func(http.Handler) http.Handler
 
+		var compressionOpts []middleware.CompressionOption
+		if cfg.CPUGuard != nil {
+			compressionOpts = append(compressionOpts, middleware.WithCPUGuard(cfg.CPUGuard))
+		}
+
 		// Use metrics-enabled middleware when the metrics client is available
 		if cfg.MetricsClient != nil && cfg.ServiceConfig.Telemetry.Metrics.Enabled {
 			compressionMiddleware = middleware.CompressionMiddlewareWithMetrics(
 				cfg.ServiceConfig.Compression,
 				cfg.Logger,
 				cfg.MetricsClient,
+				compressionOpts...,
 			)
 		} else {
 			compressionMiddleware = middleware.CompressionMiddleware(
 				cfg.ServiceConfig.Compression,
 				cfg.Logger,
+				compressionOpts...,
 			)
 		}
 
@@ -145,6 +200,7 @@ func initMiddlewares(cfg RouterConfig) []public.MiddlewareFunc {
 		cfg.Logger.Info().
 			Int("level", cfg.ServiceConfig.Compression.Level).
 			Int("min_size", cfg.ServiceConfig.Compression.MinSize).
+			Float64("cpu_threshold_percent", cfg.ServiceConfig.Compression.CPUThresholdPercent).
 			Bool("metrics_enabled", cfg.MetricsClient != nil && cfg.ServiceConfig.Telemetry.Metrics.Enabled).
 			Msg("response compression enabled")
 	}
@@ -166,29 +222,98 @@ func initMiddlewares(cfg RouterConfig) []public.MiddlewareFunc {
 	// Access logging with health check filtering.
 	if cfg.ServiceConfig.Logging.AccessLog.Enabled {
 		accessLogCfg := cfg.ServiceConfig.Logging.AccessLog
-		healthFilter := middleware.HealthCheckFilter(accessLogCfg.LogHealthChecks)
-		accessLogger := middleware.AccessLogger(cfg.Logger, accessLogCfg.IncludeQueryParams)
-
-		middlewares = append(middlewares, healthFilter, accessLogger)
+		middlewares = append(middlewares, middleware.AccessLogMiddleware(accessLogCfg, cfg.Logger))
 
 		cfg.Logger.Info().
 			Bool("log_health_checks", accessLogCfg.LogHealthChecks).
 			Bool("include_query_params", accessLogCfg.IncludeQueryParams).
+			Bool("include_headers", accessLogCfg.IncludeHeaders).
 			Msg("structured access logging enabled")
 	}
 
 	if cfg.ServiceConfig.Telemetry.Metrics.Enabled {
-		metricsMiddleware := middleware.MetricsMiddleware(cfg.MetricsClient)
+		var metricsOpts []middleware.MetricsOption
+		if maxCardinality := cfg.ServiceConfig.Telemetry.Metrics.MaxLabelCardinality; maxCardinality > 0 {
+			metricsOpts = append(metricsOpts, middleware.WithLabelSanitizer(middleware.CardinalityGuard(maxCardinality)))
+		}
+
+		metricsMiddleware := middleware.PrometheusMetricsMiddleware(cfg.MetricsClient, metricsOpts...)
 		middlewares = append(middlewares, metricsMiddleware)
 
-		cfg.Logger.Info().Msg("HTTP metrics collection enabled")
+		cfg.Logger.Info().
+			Uint("max_label_cardinality", cfg.ServiceConfig.Telemetry.Metrics.MaxLabelCardinality).
+			Msg("HTTP metrics collection enabled")
 	}
 
+	// Inside the tracing middleware, so the request-scoped logger carries the span IDs.
+	middlewares = append(middlewares, middleware.RequestLoggerMiddleware(cfg.Logger))
+
 	if cfg.ServiceConfig.Telemetry.Traces.Enabled {
-		middlewares = append(middlewares, middleware.Tracer())
+		tracerProvider := cfg.TracerProvider
+		if tracerProvider == nil {
+			tracerProvider = otel.GetTracerProvider()
+		}
+
+		middlewares = append(middlewares, middleware.OtelHTTPMiddleware(tracerProvider, cfg.ServiceConfig.App.ServiceName))
 
 		cfg.Logger.Info().Msg("distributed tracing enabled")
 	}
 
+	// Middlewares appended later wrap the earlier ones, so request tracking goes last to
+	// make the request and correlation IDs available to every other middleware's logs,
+	// including the request-scoped logger.
+	middlewares = append(middlewares, middleware.RequestTracking())
+
 	return middlewares
 }
+
+// mountGraphQL serves the GraphQL endpoint at /graphql, authenticated like the REST API,
+// and outside production the embedded playground at /graphql/playground. The GraphQL
+// routes are not part of the OpenAPI spec, so they get their own middleware stack.
+func mountGraphQL(router chi.Router, cfg RouterConfig) {
+	production := cfg.ServiceConfig.IsProduction()
+
+	router.Group(func(r chi.Router) {
+		r.Use(
+			middleware.RequestTracking(),
+			middleware.RequestLoggerMiddleware(cfg.Logger),
+			middleware.Recovery(),
+		)
+
+		endpoint := r.With(
+			middleware.Authentication(cfg.ServiceConfig.Auth.Enabled, cfg.ServiceConfig.Auth.SkipPaths),
+			middleware.TimeoutMiddleware(cfg.ServiceConfig.Timeout),
+		)
+
+		if limit := cfg.ServiceConfig.PublicHTTPServer.BodyLimit; limit > 0 {
+			endpoint = endpoint.With(middleware.BodyLimitMiddleware(limit))
+		}
+
+		endpoint.Handle(graphQLPath, graphql.NewHandler(cfg.App, !production))
+
+		if !production {
+			playground := graphql.PlaygroundHandler(graphQLPath+"/playground", graphQLPath)
+
+			r.Method(http.MethodGet, graphQLPath+"/playground", playground)
+			r.Method(http.MethodGet, graphQLPath+"/playground/*", playground)
+		}
+	})
+}
+
+// exportWithoutTimeout lifts the request deadline off the device export, which streams
+// every device page by page and can outlast the default deadline. An override set for
+// the export in REQUEST_TIMEOUT_PATHS is kept.
+func exportWithoutTimeout(cfg config.TimeoutConfig) config.TimeoutConfig {
+	exportPath := baseURL + "/devices/export"
+	if _, ok := cfg.Paths[exportPath]; ok {
+		return cfg
+	}
+
+	paths := make(map[string]time.Duration, len(cfg.Paths)+1)
+	maps.Copy(paths, cfg.Paths)
+	paths[exportPath] = 0
+
+	cfg.Paths = paths
+
+	return cfg
+}
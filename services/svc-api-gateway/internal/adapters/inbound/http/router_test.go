@@ -1,22 +1,42 @@
 package http_test
 
 import (
+	"bufio"
+	"context"
 	"encoding/json"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/architeacher/devices/pkg/logger"
+	"github.com/architeacher/devices/pkg/metrics/noop"
 	inboundhttp "github.com/architeacher/devices/services/svc-api-gateway/internal/adapters/inbound/http"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/config"
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/domain/model"
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/mocks"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/usecases"
+	"github.com/go-chi/chi/v5"
 	"github.com/stretchr/testify/suite"
+	otelNoop "go.opentelemetry.io/otel/trace/noop"
 )
 
+const adminToken = "s3cret-admin-token"
+
 type AdminRouterTestSuite struct {
 	suite.Suite
 }
 
+// adminRequest returns a request to the admin router carrying the admin token.
+func adminRequest(method, path string, body io.Reader) *http.Request {
+	req := httptest.NewRequest(method, path, body)
+	req.Header.Set("X-Admin-Token", adminToken)
+
+	return req
+}
+
 func TestAdminRouterTestSuite(t *testing.T) {
 	t.Parallel()
 	suite.Run(t, new(AdminRouterTestSuite))
@@ -32,6 +52,7 @@ func (s *AdminRouterTestSuite) TestNewAdminRouter_RoutesRegistered() {
 	router := inboundhttp.NewAdminRouter(inboundhttp.AdminRouterConfig{
 		DevicesCache: cache,
 		Logger:       log,
+		AdminToken:   adminToken,
 	})
 
 	deviceID := model.NewDeviceID()
@@ -86,7 +107,7 @@ func (s *AdminRouterTestSuite) TestNewAdminRouter_RoutesRegistered() {
 		s.Run(tc.name, func() {
 			tc.setupCache()
 
-			req := httptest.NewRequest(tc.method, tc.path, nil)
+			req := adminRequest(tc.method, tc.path, nil)
 			rec := httptest.NewRecorder()
 
 			router.ServeHTTP(rec, req)
@@ -104,6 +125,7 @@ func (s *AdminRouterTestSuite) TestNewAdminRouter_NilCache_Returns503() {
 	router := inboundhttp.NewAdminRouter(inboundhttp.AdminRouterConfig{
 		DevicesCache: nil,
 		Logger:       log,
+		AdminToken:   adminToken,
 	})
 
 	cases := []struct {
@@ -134,7 +156,7 @@ func (s *AdminRouterTestSuite) TestNewAdminRouter_NilCache_Returns503() {
 
 	for _, tc := range cases {
 		s.Run(tc.name, func() {
-			req := httptest.NewRequest(tc.method, tc.path, nil)
+			req := adminRequest(tc.method, tc.path, nil)
 			rec := httptest.NewRecorder()
 
 			router.ServeHTTP(rec, req)
@@ -149,6 +171,92 @@ func (s *AdminRouterTestSuite) TestNewAdminRouter_NilCache_Returns503() {
 	}
 }
 
+func (s *AdminRouterTestSuite) TestNewAdminRouter_AdminToken() {
+	s.T().Parallel()
+
+	cache := &mocks.FakeDevicesCache{}
+	cache.IsHealthyReturns(true)
+
+	router := inboundhttp.NewAdminRouter(inboundhttp.AdminRouterConfig{
+		DevicesCache: cache,
+		Logger:       logger.New("debug", "console"),
+		AdminToken:   adminToken,
+	})
+
+	deviceID := model.NewDeviceID()
+
+	cases := []struct {
+		name           string
+		method         string
+		path           string
+		body           string
+		header         string
+		expectedStatus int
+	}{
+		{
+			name:           "cache health without token",
+			method:         http.MethodGet,
+			path:           "/admin/cache/health",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "force state without token",
+			method:         http.MethodPost,
+			path:           "/admin/devices/" + deviceID.String() + "/state",
+			body:           `{"state":"available"}`,
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "force state with wrong token",
+			method:         http.MethodPost,
+			path:           "/admin/devices/" + deviceID.String() + "/state",
+			body:           `{"state":"available"}`,
+			header:         "guess",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "cache health with token",
+			method:         http.MethodGet,
+			path:           "/admin/cache/health",
+			header:         adminToken,
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			req := httptest.NewRequest(tc.method, tc.path, strings.NewReader(tc.body))
+			if tc.header != "" {
+				req.Header.Set("X-Admin-Token", tc.header)
+			}
+
+			rec := httptest.NewRecorder()
+
+			router.ServeHTTP(rec, req)
+
+			s.Require().Equal(tc.expectedStatus, rec.Code)
+		})
+	}
+}
+
+func (s *AdminRouterTestSuite) TestNewAdminRouter_WithoutAdminToken_Returns503() {
+	s.T().Parallel()
+
+	cache := &mocks.FakeDevicesCache{}
+	cache.IsHealthyReturns(true)
+
+	router := inboundhttp.NewAdminRouter(inboundhttp.AdminRouterConfig{
+		DevicesCache: cache,
+		Logger:       logger.NewTestLogger(),
+	})
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, adminRequest(http.MethodDelete, "/admin/cache/devices", nil))
+
+	s.Require().Equal(http.StatusServiceUnavailable, rec.Code)
+	s.Require().Zero(cache.PurgeAllCallCount(), "the purge must not run")
+}
+
 func (s *AdminRouterTestSuite) TestNewAdminRouter_UnknownRoute_Returns404() {
 	s.T().Parallel()
 
@@ -158,12 +266,602 @@ func (s *AdminRouterTestSuite) TestNewAdminRouter_UnknownRoute_Returns404() {
 	router := inboundhttp.NewAdminRouter(inboundhttp.AdminRouterConfig{
 		DevicesCache: cache,
 		Logger:       log,
+		AdminToken:   adminToken,
 	})
 
-	req := httptest.NewRequest(http.MethodGet, "/admin/unknown", nil)
+	req := adminRequest(http.MethodGet, "/admin/unknown", nil)
 	rec := httptest.NewRecorder()
 
 	router.ServeHTTP(rec, req)
 
 	s.Require().Equal(http.StatusNotFound, rec.Code)
 }
+
+func (s *AdminRouterTestSuite) TestNewAdminRouter_Playground() {
+	s.T().Parallel()
+
+	cases := []struct {
+		name           string
+		environment    int
+		expectedStatus int
+		registered     bool
+	}{
+		{
+			name:           "served in development",
+			environment:    config.Development,
+			expectedStatus: http.StatusOK,
+			registered:     true,
+		},
+		{
+			name:           "served in staging",
+			environment:    config.Staging,
+			expectedStatus: http.StatusOK,
+			registered:     true,
+		},
+		{
+			name:           "not registered in production",
+			environment:    config.Production,
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			router := inboundhttp.NewAdminRouter(inboundhttp.AdminRouterConfig{
+				DevicesCache: &mocks.FakeDevicesCache{},
+				Logger:       logger.NewTestLogger(),
+				AdminToken:   adminToken,
+				Environment:  tc.environment,
+			})
+
+			routes, ok := router.(chi.Routes)
+			s.Require().True(ok)
+			s.Require().Equal(tc.registered, routes.Match(chi.NewRouteContext(), http.MethodGet, "/admin/playground"))
+
+			req := adminRequest(http.MethodGet, "/admin/playground", nil)
+			rec := httptest.NewRecorder()
+
+			router.ServeHTTP(rec, req)
+
+			s.Require().Equal(tc.expectedStatus, rec.Code)
+
+			if !tc.registered {
+				return
+			}
+
+			s.Require().True(strings.HasPrefix(rec.Header().Get("Content-Type"), "text/html"))
+			s.Require().Contains(rec.Body.String(), `<meta name="graphql-endpoint" content="/admin/graphql">`)
+			s.Require().Contains(rec.Body.String(), `<script src="/admin/playground/playground.js">`)
+
+			rec = httptest.NewRecorder()
+			router.ServeHTTP(rec, adminRequest(http.MethodGet, "/admin/playground/playground.js", nil))
+
+			s.Require().Equal(http.StatusOK, rec.Code)
+			s.Require().True(strings.HasPrefix(rec.Header().Get("Content-Type"), "text/javascript"))
+
+			rec = httptest.NewRecorder()
+			router.ServeHTTP(rec, adminRequest(http.MethodGet, "/admin/playground/playground.html", nil))
+
+			s.Require().Equal(http.StatusNotFound, rec.Code)
+		})
+	}
+}
+
+func (s *AdminRouterTestSuite) TestNewAdminRouter_GraphQL() {
+	s.T().Parallel()
+
+	cases := []struct {
+		name           string
+		environment    int
+		expectedStatus int
+	}{
+		{
+			name:           "served in development",
+			environment:    config.Development,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "not served in production",
+			environment:    config.Production,
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			router := inboundhttp.NewAdminRouter(inboundhttp.AdminRouterConfig{
+				DevicesCache: &mocks.FakeDevicesCache{},
+				Logger:       logger.NewTestLogger(),
+				AdminToken:   adminToken,
+				Environment:  tc.environment,
+			})
+
+			req := adminRequest(http.MethodPost, "/admin/graphql", strings.NewReader(`{"query":"{ __schema { queryType { name } } }"}`))
+			req.Header.Set("Content-Type", "application/json")
+
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			s.Require().Equal(tc.expectedStatus, rec.Code)
+
+			if tc.expectedStatus == http.StatusOK {
+				s.Require().JSONEq(`{"data":{"__schema":{"queryType":{"name":"Query"}}}}`, rec.Body.String())
+			}
+		})
+	}
+}
+
+func (s *AdminRouterTestSuite) TestNewAdminRouter_PurgeRateLimit() {
+	s.T().Parallel()
+
+	cache := &mocks.FakeDevicesCache{}
+	cache.IsHealthyReturns(true)
+
+	router := inboundhttp.NewAdminRouter(inboundhttp.AdminRouterConfig{
+		DevicesCache:   cache,
+		Logger:         logger.NewTestLogger(),
+		PurgeRateLimit: config.AdminRateLimit{PurgeRPS: 1},
+		AdminToken:     adminToken,
+	})
+
+	send := func(method, path, remoteAddr, token string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, nil)
+		req.RemoteAddr = remoteAddr
+		req.Header.Set("X-Admin-Token", token)
+
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+
+		return rec
+	}
+
+	for range 3 {
+		rejected := send(http.MethodDelete, "/admin/cache/devices", "10.0.0.1:4000", "guess")
+		s.Require().Equal(http.StatusUnauthorized, rejected.Code)
+	}
+
+	first := send(http.MethodDelete, "/admin/cache/devices", "10.0.0.1:4000", adminToken)
+	s.Require().Equal(http.StatusOK, first.Code, "rejected requests don't spend the quota")
+
+	second := send(http.MethodDelete, "/admin/cache/devices", "10.0.0.1:4001", adminToken)
+	s.Require().Equal(http.StatusTooManyRequests, second.Code)
+	s.Require().NotEmpty(second.Header().Get("Retry-After"))
+
+	lists := send(http.MethodDelete, "/admin/cache/devices/lists", "10.0.0.1:4002", adminToken)
+	s.Require().Equal(http.StatusTooManyRequests, lists.Code, "every purge endpoint shares the quota")
+
+	health := send(http.MethodGet, "/admin/cache/health", "10.0.0.1:4003", adminToken)
+	s.Require().Equal(http.StatusOK, health.Code, "non-purge admin requests are not throttled")
+	s.Require().Empty(health.Header().Get("RateLimit-Policy"))
+
+	other := send(http.MethodDelete, "/admin/cache/devices", "10.0.0.2:4000", adminToken)
+	s.Require().Equal(http.StatusTooManyRequests, other.Code, "the quota is kept per admin token, not per client IP")
+}
+
+func (s *AdminRouterTestSuite) TestNewAdminRouter_PurgeRateLimitDisabled() {
+	s.T().Parallel()
+
+	router := inboundhttp.NewAdminRouter(inboundhttp.AdminRouterConfig{
+		DevicesCache: &mocks.FakeDevicesCache{},
+		Logger:       logger.NewTestLogger(),
+		AdminToken:   adminToken,
+	})
+
+	for range 3 {
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, adminRequest(http.MethodDelete, "/admin/cache/devices", nil))
+
+		s.Require().Equal(http.StatusOK, rec.Code)
+	}
+}
+
+type RouterTestSuite struct {
+	suite.Suite
+}
+
+func TestRouterTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(RouterTestSuite))
+}
+
+func (s *RouterTestSuite) newRouter(deviceSvc *mocks.FakeDevicesService, timeout config.TimeoutConfig) http.Handler {
+	return s.newRouterWithConfig(deviceSvc, &config.ServiceConfig{
+		App:              config.App{APIVersion: "v1"},
+		PublicHTTPServer: config.PublicHTTPServer{WriteTimeout: 15 * time.Second},
+		Timeout:          timeout,
+	})
+}
+
+func (s *RouterTestSuite) newRouterWithConfig(deviceSvc *mocks.FakeDevicesService, cfg *config.ServiceConfig) http.Handler {
+	log := logger.NewTestLogger()
+
+	return inboundhttp.NewRouter(inboundhttp.RouterConfig{
+		App: usecases.NewWebApplication(
+			deviceSvc, &mocks.FakeHealthChecker{}, nil, nil, log,
+			noop.NewMetricsClient(), otelNoop.NewTracerProvider(),
+		),
+		Logger:        log,
+		MetricsClient: noop.NewMetricsClient(),
+		ServiceConfig: cfg,
+	})
+}
+
+func (s *RouterTestSuite) TestExport_StreamsPastTheRequestTimeout() {
+	s.T().Parallel()
+
+	timeout := config.TimeoutConfig{
+		Enabled: true,
+		Default: 20 * time.Millisecond,
+	}
+
+	first := &model.Device{ID: model.NewDeviceID(), Name: "iPhone", Brand: "Apple", State: model.StateAvailable}
+	second := &model.Device{ID: model.NewDeviceID(), Name: "Pixel", Brand: "Google", State: model.StateAvailable}
+
+	// The second page arrives after the request deadline, and like the gRPC client the
+	// fake gives up once its context is done.
+	deviceSvc := &mocks.FakeDevicesService{}
+	deviceSvc.ListDevicesStub = func(ctx context.Context, filter model.DeviceFilter) (*model.DeviceList, error) {
+		if filter.Cursor == "" {
+			return &model.DeviceList{
+				Devices:    []*model.Device{first},
+				Pagination: model.Pagination{HasNext: true, NextCursor: "cursor-1"},
+			}, nil
+		}
+
+		select {
+		case <-time.After(3 * timeout.Default):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+
+		return &model.DeviceList{Devices: []*model.Device{second}}, nil
+	}
+
+	server := httptest.NewServer(s.newRouter(deviceSvc, timeout))
+	defer server.Close()
+
+	req, err := http.NewRequestWithContext(s.T().Context(), http.MethodGet, server.URL+"/v1/devices/export?format=ndjson", nil)
+	s.Require().NoError(err)
+	req.Header.Set("Authorization", "Bearer v4.public.token")
+
+	resp, err := server.Client().Do(req)
+	s.Require().NoError(err)
+	defer resp.Body.Close()
+
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+
+	body, err := io.ReadAll(resp.Body)
+	s.Require().NoError(err)
+
+	lines := strings.Split(strings.TrimSpace(string(body)), "\n")
+	s.Require().Len(lines, 2, "every page is exported although the export outlasts the request timeout")
+	s.Require().Contains(lines[1], second.ID.String())
+	s.Require().Equal(2, deviceSvc.ListDevicesCallCount())
+}
+
+func (s *RouterTestSuite) TestExport_OutlastsTheWriteTimeout() {
+	s.T().Parallel()
+
+	const writeTimeout = 50 * time.Millisecond
+
+	first := &model.Device{ID: model.NewDeviceID(), Name: "iPhone", Brand: "Apple", State: model.StateAvailable}
+	second := &model.Device{ID: model.NewDeviceID(), Name: "Pixel", Brand: "Google", State: model.StateAvailable}
+
+	// The second page arrives after the write timeout, and like the gRPC client the fake
+	// gives up once its context is done.
+	deviceSvc := &mocks.FakeDevicesService{}
+	deviceSvc.ListDevicesStub = func(ctx context.Context, filter model.DeviceFilter) (*model.DeviceList, error) {
+		if filter.Cursor == "" {
+			return &model.DeviceList{
+				Devices:    []*model.Device{first},
+				Pagination: model.Pagination{HasNext: true, NextCursor: "cursor-1"},
+			}, nil
+		}
+
+		select {
+		case <-time.After(3 * writeTimeout):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+
+		return &model.DeviceList{Devices: []*model.Device{second}}, nil
+	}
+
+	server := httptest.NewUnstartedServer(s.newRouterWithConfig(deviceSvc, &config.ServiceConfig{
+		App:              config.App{APIVersion: "v1"},
+		PublicHTTPServer: config.PublicHTTPServer{WriteTimeout: writeTimeout},
+		Timeout:          config.TimeoutConfig{Enabled: true, Default: time.Second},
+	}))
+	server.Config.WriteTimeout = writeTimeout
+	server.Start()
+	defer server.Close()
+
+	req, err := http.NewRequestWithContext(s.T().Context(), http.MethodGet, server.URL+"/v1/devices/export?format=ndjson", nil)
+	s.Require().NoError(err)
+	req.Header.Set("Authorization", "Bearer v4.public.token")
+
+	resp, err := server.Client().Do(req)
+	s.Require().NoError(err)
+	defer resp.Body.Close()
+
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+
+	body, err := io.ReadAll(resp.Body)
+	s.Require().NoError(err)
+
+	lines := strings.Split(strings.TrimSpace(string(body)), "\n")
+	s.Require().Len(lines, 2, "the write timeout does not cancel the export")
+	s.Require().Contains(lines[1], second.ID.String())
+}
+
+func (s *RouterTestSuite) TestExport_StreamsThroughHTTPCaching() {
+	s.T().Parallel()
+
+	const writeTimeout = 100 * time.Millisecond
+
+	devices := []*model.Device{
+		{ID: model.NewDeviceID(), Name: "iPhone", Brand: "Apple", State: model.StateAvailable},
+		{ID: model.NewDeviceID(), Name: "Pixel", Brand: "Google", State: model.StateAvailable},
+		{ID: model.NewDeviceID(), Name: "Galaxy", Brand: "Samsung", State: model.StateAvailable},
+	}
+
+	// The second page is held back until the client has read the first one, and the last
+	// page arrives after the server's write timeout has passed.
+	release := make(chan struct{})
+
+	deviceSvc := &mocks.FakeDevicesService{}
+	deviceSvc.ListDevicesStub = func(ctx context.Context, filter model.DeviceFilter) (*model.DeviceList, error) {
+		switch filter.Cursor {
+		case "":
+			return &model.DeviceList{
+				Devices:    devices[:1],
+				Pagination: model.Pagination{HasNext: true, NextCursor: "cursor-1"},
+			}, nil
+		case "cursor-1":
+			select {
+			case <-release:
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+
+			return &model.DeviceList{
+				Devices:    devices[1:2],
+				Pagination: model.Pagination{HasNext: true, NextCursor: "cursor-2"},
+			}, nil
+		default:
+			time.Sleep(2 * writeTimeout)
+
+			return &model.DeviceList{Devices: devices[2:]}, nil
+		}
+	}
+
+	server := httptest.NewUnstartedServer(s.newRouterWithConfig(deviceSvc, &config.ServiceConfig{
+		App:              config.App{APIVersion: "v1"},
+		PublicHTTPServer: config.PublicHTTPServer{WriteTimeout: writeTimeout},
+		DevicesCache:     config.DevicesCache{HTTPCachingEnabled: true},
+	}))
+	server.Config.WriteTimeout = writeTimeout
+	server.Start()
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/v1/devices/export?format=ndjson", nil)
+	s.Require().NoError(err)
+	req.Header.Set("Authorization", "Bearer v4.public.token")
+
+	resp, err := server.Client().Do(req)
+	s.Require().NoError(err)
+	defer resp.Body.Close()
+
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	s.Require().Empty(resp.Header.Get("ETag"), "a streamed export is not buffered to compute an ETag")
+
+	reader := bufio.NewReader(resp.Body)
+
+	line, err := reader.ReadString('\n')
+	s.Require().NoError(err, "the first page reaches the client before the next one is fetched")
+	s.Require().Contains(line, devices[0].ID.String())
+
+	close(release)
+
+	rest, err := io.ReadAll(reader)
+	s.Require().NoError(err)
+
+	lines := strings.Split(strings.TrimSpace(string(rest)), "\n")
+	s.Require().Len(lines, 2, "the export outlasts the server's write timeout")
+	s.Require().Contains(lines[0], devices[1].ID.String())
+	s.Require().Contains(lines[1], devices[2].ID.String())
+}
+
+func (s *RouterTestSuite) TestExport_KeepsAConfiguredTimeout() {
+	s.T().Parallel()
+
+	timeout := config.TimeoutConfig{
+		Enabled: true,
+		Default: time.Second,
+		Paths:   map[string]time.Duration{"/v1/devices/export": 20 * time.Millisecond},
+	}
+
+	deviceSvc := &mocks.FakeDevicesService{}
+	deviceSvc.ListDevicesStub = func(ctx context.Context, _ model.DeviceFilter) (*model.DeviceList, error) {
+		<-ctx.Done()
+
+		return nil, ctx.Err()
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/devices/export", nil)
+	req.Header.Set("Authorization", "Bearer v4.public.token")
+
+	rec := httptest.NewRecorder()
+	s.newRouter(deviceSvc, timeout).ServeHTTP(rec, req)
+
+	s.Require().Equal(http.StatusServiceUnavailable, rec.Code)
+	s.Require().Contains(rec.Body.String(), "REQUEST_TIMEOUT")
+}
+
+func (s *RouterTestSuite) TestGraphQL_ServesQueriesAndMutations() {
+	s.T().Parallel()
+
+	now := time.Date(2026, 1, 15, 10, 0, 0, 0, time.UTC)
+	device := &model.Device{
+		ID:        model.NewDeviceID(),
+		Name:      "iPhone 15",
+		Brand:     "Apple",
+		State:     model.StateInUse,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	deviceSvc := &mocks.FakeDevicesService{}
+	deviceSvc.ListDevicesReturns(&model.DeviceList{
+		Devices:    []*model.Device{device},
+		Pagination: model.Pagination{Page: 1, Size: 20, TotalItems: 1, TotalPages: 1},
+	}, nil)
+	deviceSvc.CreateDeviceReturns(device, nil)
+
+	server := httptest.NewServer(s.newRouter(deviceSvc, config.TimeoutConfig{}))
+	defer server.Close()
+
+	post := func(body string) map[string]any {
+		req, err := http.NewRequestWithContext(s.T().Context(), http.MethodPost, server.URL+"/graphql", strings.NewReader(body))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := server.Client().Do(req)
+		s.Require().NoError(err)
+		defer func() { s.Require().NoError(resp.Body.Close()) }()
+
+		s.Require().Equal(http.StatusOK, resp.StatusCode)
+		s.Require().NotEmpty(resp.Header.Get("Request-Id"))
+
+		var response map[string]any
+		s.Require().NoError(json.NewDecoder(resp.Body).Decode(&response))
+		s.Require().Nil(response["errors"])
+
+		return response
+	}
+
+	list := post(`{"query":"{ devices(filter: {brands: [\"Apple\"], states: [IN_USE]}) { devices { id name state createdAt } pageInfo { totalItems } } }"}`)
+	s.Require().Equal(map[string]any{
+		"data": map[string]any{
+			"devices": map[string]any{
+				"devices": []any{
+					map[string]any{
+						"id":        device.ID.String(),
+						"name":      "iPhone 15",
+						"state":     "IN_USE",
+						"createdAt": "2026-01-15T10:00:00Z",
+					},
+				},
+				"pageInfo": map[string]any{"totalItems": float64(1)},
+			},
+		},
+	}, list)
+
+	s.Require().Equal(1, deviceSvc.ListDevicesCallCount())
+	_, filter := deviceSvc.ListDevicesArgsForCall(0)
+	s.Require().Equal([]string{"Apple"}, filter.Brands)
+	s.Require().Equal([]model.State{model.StateInUse}, filter.States)
+
+	created := post(`{"query":"mutation { createDevice(input: {name: \"iPhone 15\", brand: \"Apple\"}) { id state } }"}`)
+	s.Require().Equal(map[string]any{
+		"data": map[string]any{
+			"createDevice": map[string]any{"id": device.ID.String(), "state": "IN_USE"},
+		},
+	}, created)
+
+	s.Require().Equal(1, deviceSvc.CreateDeviceCallCount())
+	_, name, brand, state := deviceSvc.CreateDeviceArgsForCall(0)
+	s.Require().Equal("iPhone 15", name)
+	s.Require().Equal("Apple", brand)
+	s.Require().Equal(model.StateAvailable, state)
+}
+
+func (s *RouterTestSuite) TestGraphQL_RequiresAuthentication() {
+	s.T().Parallel()
+
+	router := s.newRouterWithConfig(&mocks.FakeDevicesService{}, &config.ServiceConfig{
+		App:  config.App{APIVersion: "v1"},
+		Auth: config.Auth{Enabled: true},
+	})
+
+	cases := []struct {
+		name           string
+		authorization  string
+		expectedStatus int
+	}{
+		{
+			name:           "without a token",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "with a PASETO token",
+			authorization:  "Bearer v4.public.token",
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(`{"query":"{ __typename }"}`))
+			req.Header.Set("Content-Type", "application/json")
+			if tc.authorization != "" {
+				req.Header.Set("Authorization", tc.authorization)
+			}
+
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			s.Require().Equal(tc.expectedStatus, rec.Code)
+		})
+	}
+}
+
+func (s *RouterTestSuite) TestGraphQL_Playground() {
+	s.T().Parallel()
+
+	cases := []struct {
+		name           string
+		environment    string
+		expectedStatus int
+	}{
+		{
+			name:           "served in development",
+			environment:    "development",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "not served in production",
+			environment:    "production",
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			router := s.newRouterWithConfig(&mocks.FakeDevicesService{}, &config.ServiceConfig{
+				App: config.App{APIVersion: "v1", Env: config.Environment{Name: tc.environment}},
+			})
+
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/graphql/playground", nil))
+
+			s.Require().Equal(tc.expectedStatus, rec.Code)
+
+			if tc.expectedStatus != http.StatusOK {
+				return
+			}
+
+			s.Require().True(strings.HasPrefix(rec.Header().Get("Content-Type"), "text/html"))
+			s.Require().Contains(rec.Body.String(), `<meta name="graphql-endpoint" content="/graphql">`)
+
+			rec = httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/graphql/playground/playground.css", nil))
+
+			s.Require().Equal(http.StatusOK, rec.Code)
+			s.Require().True(strings.HasPrefix(rec.Header().Get("Content-Type"), "text/css"))
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package grpc
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/architeacher/devices/pkg/circuitbreaker"
+	"github.com/architeacher/devices/pkg/logger"
+	"github.com/architeacher/devices/pkg/metrics/recording"
+	devicev1 "github.com/architeacher/devices/pkg/proto/device/v1"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// flakyDeviceServer serves GetDevice successfully until failing is set.
+type flakyDeviceServer struct {
+	devicev1.UnimplementedDeviceServiceServer
+	failing atomic.Bool
+}
+
+func (s *flakyDeviceServer) GetDevice(_ context.Context, req *devicev1.GetDeviceRequest) (*devicev1.GetDeviceResponse, error) {
+	if s.failing.Load() {
+		return nil, status.Error(codes.Unavailable, "devices service unavailable")
+	}
+
+	return &devicev1.GetDeviceResponse{Device: &devicev1.Device{Id: req.GetId()}}, nil
+}
+
+// startTestServer runs an in-memory gRPC server and returns a connection to it.
+func startTestServer(t *testing.T, register func(*grpc.Server)) *grpc.ClientConn {
+	t.Helper()
+
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	register(server)
+
+	go func() {
+		_ = server.Serve(listener)
+	}()
+
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	return conn
+}
+
+func TestClient_CircuitBreakerStateChange(t *testing.T) {
+	t.Parallel()
+
+	srv := &flakyDeviceServer{}
+	conn := startTestServer(t, func(server *grpc.Server) {
+		devicev1.RegisterDeviceServiceServer(server, srv)
+	})
+
+	cfg := testConfig()
+	cfg.DevicesGRPCClient.CircuitBreaker.Enabled = true
+	cfg.DevicesGRPCClient.CircuitBreaker.MaxRequests = 1
+	cfg.DevicesGRPCClient.CircuitBreaker.Interval = time.Minute
+	cfg.DevicesGRPCClient.CircuitBreaker.Timeout = time.Minute
+	cfg.DevicesGRPCClient.CircuitBreaker.FailureThreshold = 3
+
+	var logBuffer bytes.Buffer
+	recorder := recording.NewMetricsClient()
+
+	client := NewClient(conn, cfg,
+		WithLogger(logger.NewBufferedTestLogger(&logBuffer)),
+		WithMetricsClient(recorder),
+	)
+
+	req := &devicev1.GetDeviceRequest{Id: "123e4567-e89b-12d3-a456-426614174000"}
+
+	_, err := client.GetDevice(t.Context(), req)
+	require.NoError(t, err)
+	require.Empty(t, recorder.Records(circuitBreakerTransitionsTotal))
+
+	srv.failing.Store(true)
+
+	for range 3 {
+		_, err = client.GetDevice(t.Context(), req)
+		require.Equal(t, codes.Unavailable, status.Code(err))
+	}
+
+	_, err = client.GetDevice(t.Context(), req)
+	require.ErrorIs(t, err, circuitbreaker.ErrCircuitOpen)
+
+	transitions := recorder.Records(circuitBreakerTransitionsTotal)
+	require.Len(t, transitions, 1)
+	require.Equal(t, int64(1), transitions[0].Value)
+	require.ElementsMatch(t, []attribute.KeyValue{
+		attribute.String("from", "closed"),
+		attribute.String("to", "open"),
+	}, transitions[0].Attributes)
+
+	logOutput := logBuffer.String()
+	require.Contains(t, logOutput, `"level":"warn"`)
+	require.Contains(t, logOutput, `"name":"svc-devices"`)
+	require.Contains(t, logOutput, `"from_state":"closed"`)
+	require.Contains(t, logOutput, `"to_state":"open"`)
+}
@@ -2,22 +2,42 @@ package grpc
 
 import (
 	"context"
+	"sync/atomic"
+	"time"
 
 	"github.com/architeacher/devices/pkg/circuitbreaker"
+	"github.com/architeacher/devices/pkg/logger"
+	"github.com/architeacher/devices/pkg/metrics"
 	devicev1 "github.com/architeacher/devices/pkg/proto/device/v1"
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/config"
+	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
+const (
+	circuitBreakerTransitionsTotal = "circuit_breaker_transitions_total"
+	devicesServiceHealth           = "devices_service_health"
+)
+
+// Health watch states; the zero value means no probe has completed yet.
+const (
+	healthUnknown int32 = iota
+	healthUp
+	healthDown
+)
+
 // Client is a thin gRPC adapter that wraps a connection and makes protocol calls.
 // Domain mapping and error handling are done by the service layer.
 type Client struct {
-	conn         *grpc.ClientConn
-	deviceClient devicev1.DeviceServiceClient
-	healthClient devicev1.HealthServiceClient
-	cb           *circuitbreaker.CircuitBreaker[any]
-	config       *config.ServiceConfig
+	conn          *grpc.ClientConn
+	deviceClient  devicev1.DeviceServiceClient
+	healthClient  devicev1.HealthServiceClient
+	cb            *circuitbreaker.CircuitBreaker[any]
+	config        *config.ServiceConfig
+	logger        logger.Logger
+	metricsClient metrics.Client
+	health        atomic.Int32
 }
 
 // NewClient creates a new gRPC client wrapping the provided connection.
@@ -49,12 +69,33 @@ func NewClient(conn *grpc.ClientConn, cfg *config.ServiceConfig, opts ...Option)
 			Interval:         cfg.DevicesGRPCClient.CircuitBreaker.Interval,
 			Timeout:          cfg.DevicesGRPCClient.CircuitBreaker.Timeout,
 			FailureThreshold: cfg.DevicesGRPCClient.CircuitBreaker.FailureThreshold,
+			OnStateChange:    client.onCircuitBreakerStateChange,
 		})
 	}
 
 	return client
 }
 
+// onCircuitBreakerStateChange reports circuit breaker transitions through the
+// configured logger and metrics client; either may be unset.
+func (c *Client) onCircuitBreakerStateChange(name, from, to string) {
+	c.logger.Warn().
+		Str("name", name).
+		Str("from_state", from).
+		Str("to_state", to).
+		Msg("circuit breaker state changed")
+
+	if c.metricsClient != nil {
+		c.metricsClient.Inc(
+			context.Background(),
+			circuitBreakerTransitionsTotal,
+			int64(1),
+			attribute.String("from", from),
+			attribute.String("to", to),
+		)
+	}
+}
+
 // Config returns the service configuration.
 func (c *Client) Config() *config.ServiceConfig {
 	return c.config
@@ -140,3 +181,82 @@ func (c *Client) DeleteDevice(ctx context.Context, req *devicev1.DeleteDeviceReq
 func (c *Client) CheckHealth(ctx context.Context, req *devicev1.HealthCheckRequest) (*devicev1.HealthCheckResponse, error) {
 	return c.healthClient.Check(ctx, req)
 }
+
+// StartHealthWatch checks the health of svc-devices right away and then every
+// interval in a background goroutine until ctx is done, logging status changes and
+// reporting the current status on the devices_service_health gauge. The latest result
+// is available through IsHealthy without issuing a gRPC call.
+func (c *Client) StartHealthWatch(ctx context.Context, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		c.probeHealth(ctx, interval)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				c.probeHealth(ctx, interval)
+			}
+		}
+	}()
+}
+
+// IsHealthy reports whether the last health watch probe found svc-devices serving.
+func (c *Client) IsHealthy() bool {
+	return c.health.Load() == healthUp
+}
+
+func (c *Client) probeHealth(ctx context.Context, timeout time.Duration) {
+	checkCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	resp, err := c.healthClient.Check(checkCtx, &devicev1.HealthCheckRequest{})
+	if ctx.Err() != nil {
+		return
+	}
+
+	current := healthDown
+	if err == nil && resp.GetStatus() == devicev1.HealthCheckResponse_SERVING_STATUS_SERVING {
+		current = healthUp
+	}
+
+	previous := c.health.Swap(current)
+	if previous == current {
+		return
+	}
+
+	event := c.logger.Info()
+	if current == healthDown {
+		event = c.logger.Warn()
+	}
+
+	event.
+		Str("from_status", healthStatusLabel(previous)).
+		Str("to_status", healthStatusLabel(current)).
+		Msg("devices service health changed")
+
+	if c.metricsClient == nil {
+		return
+	}
+
+	// The gauge holds 1 for the current status and 0 for the previous one.
+	if previous != healthUnknown {
+		c.metricsClient.Add(ctx, devicesServiceHealth, -1, attribute.String("status", healthStatusLabel(previous)))
+	}
+
+	c.metricsClient.Add(ctx, devicesServiceHealth, 1, attribute.String("status", healthStatusLabel(current)))
+}
+
+func healthStatusLabel(health int32) string {
+	switch health {
+	case healthUp:
+		return "up"
+	case healthDown:
+		return "down"
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,101 @@
+package grpc
+
+import (
+	"bytes"
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/architeacher/devices/pkg/logger"
+	"github.com/architeacher/devices/pkg/metrics/recording"
+	devicev1 "github.com/architeacher/devices/pkg/proto/device/v1"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc"
+)
+
+// toggleHealthServer reports SERVING or NOT_SERVING depending on serving.
+type toggleHealthServer struct {
+	devicev1.UnimplementedHealthServiceServer
+	serving atomic.Bool
+}
+
+func (s *toggleHealthServer) Check(context.Context, *devicev1.HealthCheckRequest) (*devicev1.HealthCheckResponse, error) {
+	if s.serving.Load() {
+		return &devicev1.HealthCheckResponse{Status: devicev1.HealthCheckResponse_SERVING_STATUS_SERVING}, nil
+	}
+
+	return &devicev1.HealthCheckResponse{Status: devicev1.HealthCheckResponse_SERVING_STATUS_NOT_SERVING}, nil
+}
+
+func TestClient_HealthWatch(t *testing.T) {
+	t.Parallel()
+
+	srv := &toggleHealthServer{}
+	srv.serving.Store(true)
+
+	conn := startTestServer(t, func(server *grpc.Server) {
+		devicev1.RegisterHealthServiceServer(server, srv)
+	})
+
+	var logBuffer bytes.Buffer
+	recorder := recording.NewMetricsClient()
+
+	client := NewClient(conn, testConfig(),
+		WithLogger(logger.NewBufferedTestLogger(&logBuffer)),
+		WithMetricsClient(recorder),
+	)
+	require.False(t, client.IsHealthy())
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	client.StartHealthWatch(ctx, 10*time.Millisecond)
+
+	require.Eventually(t, client.IsHealthy, time.Second, 5*time.Millisecond)
+
+	srv.serving.Store(false)
+	require.Eventually(t, func() bool { return !client.IsHealthy() }, time.Second, 5*time.Millisecond)
+
+	srv.serving.Store(true)
+	require.Eventually(t, client.IsHealthy, time.Second, 5*time.Millisecond)
+
+	cancel()
+
+	up := attribute.String("status", "up")
+	down := attribute.String("status", "down")
+
+	record := func(value int64, status attribute.KeyValue) recording.Record {
+		return recording.Record{
+			Kind:       recording.KindUpDownCounter,
+			Name:       devicesServiceHealth,
+			Value:      value,
+			Attributes: []attribute.KeyValue{status},
+		}
+	}
+
+	require.Equal(t, []recording.Record{
+		record(1, up),
+		record(-1, up),
+		record(1, down),
+		record(-1, down),
+		record(1, up),
+	}, recorder.Records(devicesServiceHealth))
+}
+
+func TestClient_HealthWatchMarksUnreachableServiceDown(t *testing.T) {
+	t.Parallel()
+
+	conn := startTestServer(t, func(*grpc.Server) {})
+
+	client := NewClient(conn, testConfig())
+	client.health.Store(healthUp)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	client.StartHealthWatch(ctx, 10*time.Millisecond)
+
+	require.Eventually(t, func() bool { return client.health.Load() == healthDown }, time.Second, 5*time.Millisecond)
+}
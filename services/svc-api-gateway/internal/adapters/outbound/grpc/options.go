@@ -2,6 +2,8 @@ package grpc
 
 import (
 	"github.com/architeacher/devices/pkg/circuitbreaker"
+	"github.com/architeacher/devices/pkg/logger"
+	"github.com/architeacher/devices/pkg/metrics"
 	devicev1 "github.com/architeacher/devices/pkg/proto/device/v1"
 )
 
@@ -28,3 +30,17 @@ func WithCircuitBreaker(cb *circuitbreaker.CircuitBreaker[any]) Option {
 		c.cb = cb
 	}
 }
+
+// WithLogger sets the logger used to report circuit breaker state changes.
+func WithLogger(log logger.Logger) Option {
+	return func(c *Client) {
+		c.logger = log
+	}
+}
+
+// WithMetricsClient sets the metrics client used to count circuit breaker state changes.
+func WithMetricsClient(mc metrics.Client) Option {
+	return func(c *Client) {
+		c.metricsClient = mc
+	}
+}
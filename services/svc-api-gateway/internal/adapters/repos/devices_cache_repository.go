@@ -7,15 +7,21 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/url"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/architeacher/devices/pkg/clock"
+	"github.com/architeacher/devices/pkg/events"
 	"github.com/architeacher/devices/pkg/logger"
+	"github.com/architeacher/devices/pkg/metrics"
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/domain/model"
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/infrastructure"
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/ports"
 	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 const (
@@ -24,6 +30,28 @@ const (
 	deviceListPrefix   = "devices:list:" + deviceCacheVersion + ":"
 )
 
+// keyMissingTTL is what the TTL command reports for a key that does not exist.
+const keyMissingTTL time.Duration = -2
+
+// Cache metrics constants.
+const (
+	cacheOperationKey = "op"
+
+	cacheHitsTotal   = "cache_hits_total"
+	cacheMissesTotal = "cache_misses_total"
+	cacheLatencyMs   = "cache_latency_ms"
+)
+
+// Cache operation types used as the op attribute value.
+const (
+	cacheOpGetDevice  = "get_device"
+	cacheOpGetList    = "get_list"
+	cacheOpSetDevice  = "set_device"
+	cacheOpSetDevices = "set_devices"
+	cacheOpSetList    = "set_list"
+	cacheOpInvalidate = "invalidate"
+)
+
 type (
 	// cachedDevice represents a device in JSON format for caching.
 	cachedDevice struct {
@@ -37,22 +65,48 @@ type (
 
 	// cachedDeviceList represents a device list in JSON format for caching.
 	cachedDeviceList struct {
-		Devices    []cachedDevice  `json:"devices"`
+		Devices    []cachedDevice   `json:"devices"`
 		Pagination model.Pagination `json:"pagination"`
 	}
 
 	// DevicesCacheRepository implements the DevicesCache interface using KeyDB/Redis.
 	DevicesCacheRepository struct {
-		client *infrastructure.KeydbClient
-		logger logger.Logger
+		client        *infrastructure.KeydbClient
+		logger        logger.Logger
+		metricsClient metrics.Client
+		clock         clock.Clock
 	}
+
+	// DevicesCacheOption configures the DevicesCacheRepository.
+	DevicesCacheOption func(*DevicesCacheRepository)
 )
 
 // NewDevicesCacheRepository creates a new devices cache repository.
-func NewDevicesCacheRepository(client *infrastructure.KeydbClient, log logger.Logger) *DevicesCacheRepository {
-	return &DevicesCacheRepository{
-		client: client,
-		logger: log,
+// A nil metricsClient disables cache metrics.
+func NewDevicesCacheRepository(
+	client *infrastructure.KeydbClient,
+	log logger.Logger,
+	metricsClient metrics.Client,
+	opts ...DevicesCacheOption,
+) *DevicesCacheRepository {
+	r := &DevicesCacheRepository{
+		client:        client,
+		logger:        log,
+		metricsClient: metricsClient,
+		clock:         clock.RealClock{},
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+// WithCacheClock sets the clock used for cache timestamps and latency metrics.
+func WithCacheClock(c clock.Clock) DevicesCacheOption {
+	return func(r *DevicesCacheRepository) {
+		r.clock = c
 	}
 }
 
@@ -60,9 +114,14 @@ func NewDevicesCacheRepository(client *infrastructure.KeydbClient, log logger.Lo
 func (r *DevicesCacheRepository) GetDevice(ctx context.Context, id model.DeviceID) (*ports.CacheResult[*model.Device], error) {
 	key := r.deviceKey(id)
 
+	startTime := r.clock.Now()
 	data, err := r.client.Get(ctx, key)
+	r.recordLatency(ctx, cacheOpGetDevice, startTime)
+
 	if err != nil {
 		if errors.Is(err, redis.Nil) {
+			r.recordMiss(ctx, cacheOpGetDevice)
+
 			return &ports.CacheResult[*model.Device]{
 				Hit: false,
 				Key: key,
@@ -82,6 +141,8 @@ func (r *DevicesCacheRepository) GetDevice(ctx context.Context, id model.DeviceI
 		return nil, fmt.Errorf("converting cached device: %w", err)
 	}
 
+	r.recordHit(ctx, cacheOpGetDevice)
+
 	ttl := r.client.TTL(ctx, key)
 
 	return &ports.CacheResult[*model.Device]{
@@ -97,24 +158,101 @@ func (r *DevicesCacheRepository) GetDevice(ctx context.Context, id model.DeviceI
 func (r *DevicesCacheRepository) SetDevice(ctx context.Context, device *model.Device, ttl time.Duration) error {
 	key := r.deviceKey(device.ID)
 
-	cached := r.toCachedDevice(device)
+	// Encode a snapshot so a caller mutating the device concurrently cannot tear the entry.
+	cached := r.toCachedDevice(device.Clone())
 	data, err := json.Marshal(cached)
 	if err != nil {
 		return fmt.Errorf("marshalling device: %w", err)
 	}
 
-	if err := r.client.Set(ctx, key, data, ttl); err != nil {
+	startTime := r.clock.Now()
+	err = r.client.Set(ctx, key, data, ttl)
+	r.recordLatency(ctx, cacheOpSetDevice, startTime)
+
+	if err != nil {
 		return fmt.Errorf("setting cached device: %w", err)
 	}
 
 	return nil
 }
 
+// SetDevices stores every device with the given TTL in a single pipelined round trip,
+// which is what preloading many devices needs. The writes are not transactional, so on
+// failure some devices may already be cached; each entry is complete on its own.
+func (r *DevicesCacheRepository) SetDevices(ctx context.Context, devices []*model.Device, ttl time.Duration) error {
+	entries := make(map[string][]byte, len(devices))
+
+	for _, device := range devices {
+		data, err := json.Marshal(r.toCachedDevice(device.Clone()))
+		if err != nil {
+			return fmt.Errorf("marshalling device %s: %w", device.ID, err)
+		}
+
+		entries[r.deviceKey(device.ID)] = data
+	}
+
+	startTime := r.clock.Now()
+	err := r.client.SetMany(ctx, entries, ttl)
+	r.recordLatency(ctx, cacheOpSetDevices, startTime)
+
+	if err != nil {
+		return fmt.Errorf("setting cached devices: %w", err)
+	}
+
+	return nil
+}
+
+// MultiplexSet stores a device with the given TTL and, when listsToPurge is set, removes
+// every device list in the same transaction, so readers never see the new device next
+// to lists cached before it changed. The list keys are collected with SCAN beforehand,
+// as SCAN cannot run inside a transaction. Should the transaction fail, the device
+// entry is dropped too, leaving readers to fetch the device from the service.
+func (r *DevicesCacheRepository) MultiplexSet(ctx context.Context, device *model.Device, deviceTTL time.Duration, listsToPurge bool) error {
+	key := r.deviceKey(device.ID)
+
+	// Encode a snapshot so a caller mutating the device concurrently cannot tear the entry.
+	cached := r.toCachedDevice(device.Clone())
+	data, err := json.Marshal(cached)
+	if err != nil {
+		return fmt.Errorf("marshalling device: %w", err)
+	}
+
+	var listKeys []string
+	if listsToPurge {
+		listKeys, err = r.scanKeys(ctx, fmt.Sprintf("%s*", deviceListPrefix))
+		if err != nil {
+			return fmt.Errorf("collecting device lists: %w", err)
+		}
+	}
+
+	startTime := r.clock.Now()
+	err = r.client.SetAndDelete(ctx, key, data, deviceTTL, listKeys...)
+	r.recordLatency(ctx, cacheOpSetDevice, startTime)
+
+	if listsToPurge {
+		r.recordLatency(ctx, cacheOpInvalidate, startTime)
+	}
+
+	if err != nil {
+		if delErr := r.client.Delete(ctx, key); delErr != nil && !errors.Is(delErr, redis.Nil) {
+			r.logger.Warn().Str("key", key).Err(delErr).Msg("failed to drop device after a failed cache transaction")
+		}
+
+		return fmt.Errorf("setting cached device and purging lists: %w", err)
+	}
+
+	return nil
+}
+
 // InvalidateDevice removes a device from the cache.
 func (r *DevicesCacheRepository) InvalidateDevice(ctx context.Context, id model.DeviceID) error {
 	key := r.deviceKey(id)
 
-	if err := r.client.Delete(ctx, key); err != nil && !errors.Is(err, redis.Nil) {
+	startTime := r.clock.Now()
+	err := r.client.Delete(ctx, key)
+	r.recordLatency(ctx, cacheOpInvalidate, startTime)
+
+	if err != nil && !errors.Is(err, redis.Nil) {
 		return fmt.Errorf("invalidating cached device: %w", err)
 	}
 
@@ -125,9 +263,14 @@ func (r *DevicesCacheRepository) InvalidateDevice(ctx context.Context, id model.
 func (r *DevicesCacheRepository) GetDeviceList(ctx context.Context, filter model.DeviceFilter) (*ports.CacheResult[*model.DeviceList], error) {
 	key := r.deviceListKey(filter)
 
+	startTime := r.clock.Now()
 	data, err := r.client.Get(ctx, key)
+	r.recordLatency(ctx, cacheOpGetList, startTime)
+
 	if err != nil {
 		if errors.Is(err, redis.Nil) {
+			r.recordMiss(ctx, cacheOpGetList)
+
 			return &ports.CacheResult[*model.DeviceList]{
 				Hit: false,
 				Key: key,
@@ -147,6 +290,8 @@ func (r *DevicesCacheRepository) GetDeviceList(ctx context.Context, filter model
 		return nil, fmt.Errorf("converting cached device list: %w", err)
 	}
 
+	r.recordHit(ctx, cacheOpGetList)
+
 	ttl := r.client.TTL(ctx, key)
 
 	return &ports.CacheResult[*model.DeviceList]{
@@ -154,7 +299,7 @@ func (r *DevicesCacheRepository) GetDeviceList(ctx context.Context, filter model
 		Hit:      true,
 		Key:      key,
 		TTL:      ttl,
-		CachedAt: time.Now().UTC(),
+		CachedAt: r.clock.Now().UTC(),
 	}, nil
 }
 
@@ -162,13 +307,18 @@ func (r *DevicesCacheRepository) GetDeviceList(ctx context.Context, filter model
 func (r *DevicesCacheRepository) SetDeviceList(ctx context.Context, list *model.DeviceList, filter model.DeviceFilter, ttl time.Duration) error {
 	key := r.deviceListKey(filter)
 
-	cached := r.toCachedDeviceList(list)
+	// Encode a snapshot so a caller mutating the list concurrently cannot tear the entry.
+	cached := r.toCachedDeviceList(list.Clone())
 	data, err := json.Marshal(cached)
 	if err != nil {
 		return fmt.Errorf("marshalling device list: %w", err)
 	}
 
-	if err := r.client.Set(ctx, key, data, ttl); err != nil {
+	startTime := r.clock.Now()
+	err = r.client.Set(ctx, key, data, ttl)
+	r.recordLatency(ctx, cacheOpSetList, startTime)
+
+	if err != nil {
 		return fmt.Errorf("setting cached device list: %w", err)
 	}
 
@@ -185,6 +335,36 @@ func (r *DevicesCacheRepository) InvalidateAllLists(ctx context.Context) error {
 	return nil
 }
 
+// SubscribeInvalidation keeps the cache in step with device changes published on
+// bus: a created device invalidates the lists, while an updated or deleted one
+// also drops its own entry. The returned function removes the subscriptions.
+func (r *DevicesCacheRepository) SubscribeInvalidation(bus events.EventBus) events.CancelFunc {
+	cancels := []events.CancelFunc{
+		bus.Subscribe(model.DeviceCreatedEventType, r.invalidateOnDeviceChange),
+		bus.Subscribe(model.DeviceUpdatedEventType, r.invalidateOnDeviceChange),
+		bus.Subscribe(model.DeviceDeletedEventType, r.invalidateOnDeviceChange),
+	}
+
+	return func() {
+		for _, cancel := range cancels {
+			cancel()
+		}
+	}
+}
+
+func (r *DevicesCacheRepository) invalidateOnDeviceChange(ctx context.Context, event events.Event) error {
+	var deviceErr error
+
+	switch e := event.(type) {
+	case model.DeviceUpdatedEvent:
+		deviceErr = r.InvalidateDevice(ctx, e.ID)
+	case model.DeviceDeletedEvent:
+		deviceErr = r.InvalidateDevice(ctx, e.ID)
+	}
+
+	return errors.Join(deviceErr, r.InvalidateAllLists(ctx))
+}
+
 // PurgeAll removes all device-related caches.
 func (r *DevicesCacheRepository) PurgeAll(ctx context.Context) error {
 	patterns := []string{
@@ -201,6 +381,29 @@ func (r *DevicesCacheRepository) PurgeAll(ctx context.Context) error {
 	return nil
 }
 
+// PurgeBrand removes the device lists whose results may contain devices of brand:
+// lists filtered by brand, alone or with others, and lists without a brand filter.
+// It returns the number of lists removed.
+func (r *DevicesCacheRepository) PurgeBrand(ctx context.Context, brand string) (int64, error) {
+	patterns := []string{
+		fmt.Sprintf("%sbrands=*,%s,*", deviceListPrefix, escapeBrand(brand)),
+		fmt.Sprintf("%sbrands=:*", deviceListPrefix),
+	}
+
+	var total int64
+
+	for _, pattern := range patterns {
+		deleted, err := r.purgeByPattern(ctx, pattern)
+		total += deleted
+
+		if err != nil {
+			return total, fmt.Errorf("purging lists of brand %s: %w", brand, err)
+		}
+	}
+
+	return total, nil
+}
+
 // PurgeByPattern removes caches matching the given pattern.
 func (r *DevicesCacheRepository) PurgeByPattern(ctx context.Context, pattern string) (int64, error) {
 	return r.purgeByPattern(ctx, pattern)
@@ -211,12 +414,73 @@ func (r *DevicesCacheRepository) IsHealthy(ctx context.Context) bool {
 	return r.client.IsHealthy(ctx)
 }
 
+// Stats reports server-wide cache statistics. It reads the default INFO sections,
+// which include both the stats and memory sections, together with DBSIZE.
+func (r *DevicesCacheRepository) Stats(ctx context.Context) (*ports.CacheStats, error) {
+	info, err := r.client.Info(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	keyCount, err := r.client.DBSize(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return newCacheStats(parseInfo(info), keyCount), nil
+}
+
+// DeviceCacheTTL reports whether a device is cached and its remaining TTL; the TTL is
+// negative when the entry never expires.
+func (r *DevicesCacheRepository) DeviceCacheTTL(ctx context.Context, id model.DeviceID) (time.Duration, bool, error) {
+	ttl, err := r.client.RemainingTTL(ctx, r.deviceKey(id))
+	if err != nil {
+		return 0, false, err
+	}
+
+	if ttl == keyMissingTTL {
+		return 0, false, nil
+	}
+
+	return ttl, true, nil
+}
+
+// DeviceKey returns the cache key a device is stored under.
+func (r *DevicesCacheRepository) DeviceKey(id model.DeviceID) string {
+	return r.deviceKey(id)
+}
+
 func (r *DevicesCacheRepository) deviceKey(id model.DeviceID) string {
 	return fmt.Sprintf("%s%s", deviceKeyPrefix, id.String())
 }
 
+// deviceListKey puts the brands of the filter in front of its hash, so that the
+// lists of a brand can be found with SCAN. Brands are sorted, escaped and wrapped
+// in commas: a list filtered by Apple and Samsung is stored under
+// devices:list:v1:brands=,Apple,Samsung,:<hash>, and one without a brand filter
+// under devices:list:v1:brands=:<hash>.
 func (r *DevicesCacheRepository) deviceListKey(filter model.DeviceFilter) string {
-	return fmt.Sprintf("%s%s", deviceListPrefix, r.hashFilter(filter))
+	return fmt.Sprintf("%sbrands=%s:%s", deviceListPrefix, brandsKeySegment(filter.Brands), r.hashFilter(filter))
+}
+
+func brandsKeySegment(brands []string) string {
+	if len(brands) == 0 {
+		return ""
+	}
+
+	escaped := make([]string, len(brands))
+	for index, brand := range brands {
+		escaped[index] = escapeBrand(brand)
+	}
+	sort.Strings(escaped)
+
+	return "," + strings.Join(escaped, ",") + ","
+}
+
+// escapeBrand query-escapes brand, which leaves none of the separators used in
+// list keys nor any glob character of SCAN MATCH unescaped.
+func escapeBrand(brand string) string {
+	return url.QueryEscape(brand)
 }
 
 func (r *DevicesCacheRepository) hashFilter(filter model.DeviceFilter) string {
@@ -250,6 +514,55 @@ func (r *DevicesCacheRepository) hashFilter(filter model.DeviceFilter) string {
 	return hex.EncodeToString(hash[:16])
 }
 
+// recordHit increments the cache hit counter for the given operation.
+func (r *DevicesCacheRepository) recordHit(ctx context.Context, op string) {
+	if r.metricsClient == nil {
+		return
+	}
+
+	r.metricsClient.Inc(ctx, cacheHitsTotal, int64(1), attribute.String(cacheOperationKey, op))
+}
+
+// recordMiss increments the cache miss counter for the given operation.
+func (r *DevicesCacheRepository) recordMiss(ctx context.Context, op string) {
+	if r.metricsClient == nil {
+		return
+	}
+
+	r.metricsClient.Inc(ctx, cacheMissesTotal, int64(1), attribute.String(cacheOperationKey, op))
+}
+
+// recordLatency observes the elapsed time since startTime in milliseconds for the given operation.
+func (r *DevicesCacheRepository) recordLatency(ctx context.Context, op string, startTime time.Time) {
+	if r.metricsClient == nil {
+		return
+	}
+
+	latency := float64(r.clock.Since(startTime)) / float64(time.Millisecond)
+
+	_ = r.metricsClient.Observe(ctx, cacheLatencyMs, latency, attribute.String(cacheOperationKey, op))
+}
+
+// scanKeys returns every key matching pattern.
+func (r *DevicesCacheRepository) scanKeys(ctx context.Context, pattern string) ([]string, error) {
+	var cursor uint64
+	var matched []string
+
+	for {
+		keys, nextCursor, err := r.client.Scan(ctx, cursor, pattern, 100)
+		if err != nil {
+			return nil, fmt.Errorf("scanning keys: %w", err)
+		}
+
+		matched = append(matched, keys...)
+
+		cursor = nextCursor
+		if cursor == 0 {
+			return matched, nil
+		}
+	}
+}
+
 func (r *DevicesCacheRepository) purgeByPattern(ctx context.Context, pattern string) (int64, error) {
 	var cursor uint64
 	var totalDeleted int64
@@ -339,3 +652,46 @@ func (r *DevicesCacheRepository) toDomainDeviceList(cached cachedDeviceList, fil
 		Filters:    filter,
 	}, nil
 }
+
+// parseInfo turns INFO output into a field/value map, skipping section headers.
+func parseInfo(info string) map[string]string {
+	fields := make(map[string]string)
+
+	for line := range strings.Lines(info) {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if key, value, ok := strings.Cut(line, ":"); ok {
+			fields[key] = value
+		}
+	}
+
+	return fields
+}
+
+// newCacheStats builds CacheStats from parsed INFO fields; missing fields count as zero.
+func newCacheStats(info map[string]string, keyCount int64) *ports.CacheStats {
+	stats := &ports.CacheStats{
+		HitCount:        infoInt64(info, "keyspace_hits"),
+		MissCount:       infoInt64(info, "keyspace_misses"),
+		KeyCount:        keyCount,
+		UsedMemoryBytes: infoInt64(info, "used_memory"),
+	}
+
+	if lookups := stats.HitCount + stats.MissCount; lookups > 0 {
+		stats.HitRatio = float64(stats.HitCount) / float64(lookups)
+	}
+
+	return stats
+}
+
+func infoInt64(info map[string]string, key string) int64 {
+	value, err := strconv.ParseInt(info[key], 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	return value
+}
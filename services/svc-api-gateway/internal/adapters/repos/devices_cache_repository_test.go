@@ -2,11 +2,16 @@ package repos_test
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/alicebob/miniredis/v2"
+	"github.com/alicebob/miniredis/v2/server"
+	"github.com/architeacher/devices/pkg/clock"
+	"github.com/architeacher/devices/pkg/events"
 	"github.com/architeacher/devices/pkg/logger"
+	"github.com/architeacher/devices/pkg/metrics/recording"
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/adapters/repos"
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/config"
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/domain/model"
@@ -18,6 +23,7 @@ type DevicesCacheRepositoryTestSuite struct {
 	suite.Suite
 	miniRedis   *miniredis.Miniredis
 	keydbClient *infrastructure.KeydbClient
+	metrics     *recording.MetricsClient
 	repo        *repos.DevicesCacheRepository
 }
 
@@ -42,7 +48,8 @@ func (s *DevicesCacheRepositoryTestSuite) SetupTest() {
 	}
 
 	s.keydbClient = infrastructure.NewKeyDBClient(cfg, logger.NewTestLogger())
-	s.repo = repos.NewDevicesCacheRepository(s.keydbClient, logger.NewTestLogger())
+	s.metrics = recording.NewMetricsClient()
+	s.repo = repos.NewDevicesCacheRepository(s.keydbClient, logger.NewTestLogger(), s.metrics)
 }
 
 func (s *DevicesCacheRepositoryTestSuite) TearDownTest() {
@@ -107,6 +114,29 @@ func (s *DevicesCacheRepositoryTestSuite) TestSetDevice_AllStates() {
 	}
 }
 
+func (s *DevicesCacheRepositoryTestSuite) TestSetDevices() {
+	ctx := context.Background()
+	devices := []*model.Device{
+		model.NewDevice("iPhone", "Apple", model.StateAvailable),
+		model.NewDevice("Galaxy", "Samsung", model.StateInUse),
+	}
+
+	s.Require().NoError(s.repo.SetDevices(ctx, devices, time.Hour))
+
+	for _, device := range devices {
+		result, err := s.repo.GetDevice(ctx, device.ID)
+		s.Require().NoError(err)
+		s.Require().True(result.Hit)
+		s.Require().Equal(device.Name, result.Data.Name)
+		s.Require().Equal(time.Hour, s.miniRedis.TTL(result.Key))
+	}
+}
+
+func (s *DevicesCacheRepositoryTestSuite) TestSetDevices_Empty() {
+	s.Require().NoError(s.repo.SetDevices(context.Background(), nil, time.Hour))
+	s.Require().Empty(s.miniRedis.Keys())
+}
+
 func (s *DevicesCacheRepositoryTestSuite) TestInvalidateDevice() {
 	ctx := context.Background()
 	device := model.NewDevice("Test Device", "Test Brand", model.StateAvailable)
@@ -126,6 +156,30 @@ func (s *DevicesCacheRepositoryTestSuite) TestInvalidateDevice() {
 	s.Require().False(result.Hit)
 }
 
+func (s *DevicesCacheRepositoryTestSuite) TestDeviceCacheTTL() {
+	ctx := context.Background()
+	device := model.NewDevice("Test Device", "Test Brand", model.StateAvailable)
+
+	ttl, cached, err := s.repo.DeviceCacheTTL(ctx, device.ID)
+	s.Require().NoError(err)
+	s.Require().False(cached)
+	s.Require().Zero(ttl)
+
+	s.Require().NoError(s.repo.SetDevice(ctx, device, 5*time.Minute))
+
+	ttl, cached, err = s.repo.DeviceCacheTTL(ctx, device.ID)
+	s.Require().NoError(err)
+	s.Require().True(cached)
+	s.Require().Equal(5*time.Minute, ttl)
+
+	s.Require().NoError(s.repo.SetDevice(ctx, device, 0))
+
+	ttl, cached, err = s.repo.DeviceCacheTTL(ctx, device.ID)
+	s.Require().NoError(err)
+	s.Require().True(cached)
+	s.Require().Negative(ttl)
+}
+
 func (s *DevicesCacheRepositoryTestSuite) TestInvalidateDevice_NonExistent() {
 	ctx := context.Background()
 	id := model.NewDeviceID()
@@ -282,6 +336,74 @@ func (s *DevicesCacheRepositoryTestSuite) TestInvalidateAllLists() {
 	s.Require().False(result2.Hit)
 }
 
+func (s *DevicesCacheRepositoryTestSuite) TestSubscribeInvalidation() {
+	ctx := context.Background()
+	filter := model.DeviceFilter{Page: 1, Size: 10}
+
+	cases := []struct {
+		name                string
+		event               func(device *model.Device) events.Event
+		expectDeviceEvicted bool
+	}{
+		{
+			name: "created device invalidates the lists",
+			event: func(*model.Device) events.Event {
+				return model.DeviceCreatedEvent{Device: model.NewDevice("Pixel", "Google", model.StateAvailable)}
+			},
+		},
+		{
+			name: "updated device invalidates the device and the lists",
+			event: func(device *model.Device) events.Event {
+				return model.DeviceUpdatedEvent{ID: device.ID, Device: device}
+			},
+			expectDeviceEvicted: true,
+		},
+		{
+			name:                "deleted device invalidates the device and the lists",
+			event:               func(device *model.Device) events.Event { return model.DeviceDeletedEvent{ID: device.ID} },
+			expectDeviceEvicted: true,
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			bus := events.NewInMemoryEventBus()
+			unsubscribe := s.repo.SubscribeInvalidation(bus)
+			defer unsubscribe()
+
+			device := model.NewDevice("iPhone", "Apple", model.StateAvailable)
+			s.Require().NoError(s.repo.SetDevice(ctx, device, time.Hour))
+			s.Require().NoError(s.repo.SetDeviceList(ctx, &model.DeviceList{Devices: []*model.Device{device}}, filter, time.Hour))
+
+			s.Require().NoError(bus.Publish(ctx, tc.event(device)))
+
+			list, err := s.repo.GetDeviceList(ctx, filter)
+			s.Require().NoError(err)
+			s.Require().False(list.Hit)
+
+			cached, err := s.repo.GetDevice(ctx, device.ID)
+			s.Require().NoError(err)
+			s.Require().Equal(!tc.expectDeviceEvicted, cached.Hit)
+		})
+	}
+}
+
+func (s *DevicesCacheRepositoryTestSuite) TestSubscribeInvalidation_Unsubscribe() {
+	ctx := context.Background()
+	bus := events.NewInMemoryEventBus()
+
+	device := model.NewDevice("iPhone", "Apple", model.StateAvailable)
+	s.Require().NoError(s.repo.SetDevice(ctx, device, time.Hour))
+
+	s.repo.SubscribeInvalidation(bus)()
+
+	s.Require().NoError(bus.Publish(ctx, model.DeviceUpdatedEvent{ID: device.ID, Device: device}))
+
+	cached, err := s.repo.GetDevice(ctx, device.ID)
+	s.Require().NoError(err)
+	s.Require().True(cached.Hit)
+}
+
 func (s *DevicesCacheRepositoryTestSuite) TestInvalidateAllLists_PreservesDeviceCache() {
 	ctx := context.Background()
 
@@ -309,6 +431,83 @@ func (s *DevicesCacheRepositoryTestSuite) TestInvalidateAllLists_PreservesDevice
 	s.Require().True(deviceResult.Hit)
 }
 
+func (s *DevicesCacheRepositoryTestSuite) TestMultiplexSet() {
+	ctx := context.Background()
+	filter := model.DefaultDeviceFilter()
+
+	cases := []struct {
+		name              string
+		listsToPurge      bool
+		expectListsPurged bool
+	}{
+		{name: "stores the device and keeps the lists"},
+		{name: "stores the device and purges the lists", listsToPurge: true, expectListsPurged: true},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			s.metrics = recording.NewMetricsClient()
+			repo := repos.NewDevicesCacheRepository(s.keydbClient, logger.NewTestLogger(), s.metrics)
+
+			device := model.NewDevice("iPhone", "Apple", model.StateAvailable)
+			s.Require().NoError(repo.SetDeviceList(ctx, &model.DeviceList{Devices: []*model.Device{device}}, filter, time.Hour))
+
+			s.Require().NoError(repo.MultiplexSet(ctx, device, time.Hour, tc.listsToPurge))
+
+			cached, err := repo.GetDevice(ctx, device.ID)
+			s.Require().NoError(err)
+			s.Require().True(cached.Hit)
+			s.Require().Equal(device.Name, cached.Data.Name)
+			s.Require().Equal(time.Hour, s.miniRedis.TTL(cached.Key))
+
+			list, err := repo.GetDeviceList(ctx, filter)
+			s.Require().NoError(err)
+			s.Require().Equal(!tc.expectListsPurged, list.Hit)
+
+			s.Require().True(s.metrics.HasAttribute("cache_latency_ms", "op", "set_device"))
+			s.Require().Equal(tc.listsToPurge, s.metrics.HasAttribute("cache_latency_ms", "op", "invalidate"))
+		})
+	}
+}
+
+func (s *DevicesCacheRepositoryTestSuite) TestMultiplexSet_FailedPurgeLeavesCacheConsistent() {
+	ctx := context.Background()
+	filter := model.DefaultDeviceFilter()
+
+	previous := model.NewDevice("iPhone", "Apple", model.StateAvailable)
+	s.Require().NoError(s.repo.SetDevice(ctx, previous, time.Hour))
+	s.Require().NoError(s.repo.SetDeviceList(ctx, &model.DeviceList{Devices: []*model.Device{previous}}, filter, time.Hour))
+
+	// Reject the DEL of the device lists the way Redis rejects a malformed command
+	// queued in a transaction, which makes EXEC discard the whole transaction.
+	srv := s.miniRedis.Server()
+	srv.SetPreHook(func(peer *server.Peer, cmd string, args ...string) bool {
+		if cmd != "DEL" || len(args) == 0 || !strings.HasPrefix(args[0], "devices:list:") {
+			return false
+		}
+
+		srv.Dispatch(peer, []string{"DEL"})
+
+		return true
+	})
+	defer srv.SetPreHook(nil)
+
+	updated := previous.Clone()
+	updated.Name = "iPhone 17"
+
+	err := s.repo.MultiplexSet(ctx, updated, time.Hour, true)
+	s.Require().ErrorContains(err, "EXECABORT")
+
+	cached, err := s.repo.GetDevice(ctx, previous.ID)
+	s.Require().NoError(err)
+	s.Require().False(cached.Hit, "the device is dropped rather than kept beside lists that predate it")
+
+	list, err := s.repo.GetDeviceList(ctx, filter)
+	s.Require().NoError(err)
+	s.Require().True(list.Hit, "the discarded transaction purged no list")
+	s.Require().Equal("iPhone", list.Data.Devices[0].Name)
+}
+
 func (s *DevicesCacheRepositoryTestSuite) TestPurgeAll() {
 	ctx := context.Background()
 
@@ -369,6 +568,79 @@ func (s *DevicesCacheRepositoryTestSuite) TestPurgeByPattern() {
 	s.Require().False(listResult.Hit)
 }
 
+func (s *DevicesCacheRepositoryTestSuite) TestPurgeBrand() {
+	ctx := context.Background()
+
+	list := &model.DeviceList{Pagination: model.Pagination{TotalItems: 0}}
+	filterWithBrands := func(brands ...string) model.DeviceFilter {
+		filter := model.DefaultDeviceFilter()
+		filter.Brands = brands
+
+		return filter
+	}
+
+	apple := filterWithBrands("Apple")
+	appleAndSamsung := filterWithBrands("Samsung", "Apple")
+	samsung := filterWithBrands("Samsung")
+	similarBrand := filterWithBrands("Apple Inc")
+	unfiltered := model.DefaultDeviceFilter()
+
+	for _, filter := range []model.DeviceFilter{apple, appleAndSamsung, samsung, similarBrand, unfiltered} {
+		s.Require().NoError(s.repo.SetDeviceList(ctx, list, filter, time.Hour))
+	}
+
+	count, err := s.repo.PurgeBrand(ctx, "Apple")
+	s.Require().NoError(err)
+	s.Require().Equal(int64(3), count)
+
+	cases := []struct {
+		name     string
+		filter   model.DeviceFilter
+		expected bool
+	}{
+		{name: "list of the brand is purged", filter: apple, expected: false},
+		{name: "list of several brands including it is purged", filter: appleAndSamsung, expected: false},
+		{name: "list without a brand filter is purged", filter: unfiltered, expected: false},
+		{name: "list of another brand is kept", filter: samsung, expected: true},
+		{name: "list of a brand sharing its prefix is kept", filter: similarBrand, expected: true},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			result, err := s.repo.GetDeviceList(ctx, tc.filter)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expected, result.Hit)
+		})
+	}
+}
+
+func (s *DevicesCacheRepositoryTestSuite) TestPurgeBrand_EscapesSpecialCharacters() {
+	ctx := context.Background()
+
+	list := &model.DeviceList{Pagination: model.Pagination{TotalItems: 0}}
+
+	special := model.DefaultDeviceFilter()
+	special.Brands = []string{"A*,C"}
+
+	globMatch := model.DefaultDeviceFilter()
+	globMatch.Brands = []string{"AB", "C"}
+
+	s.Require().NoError(s.repo.SetDeviceList(ctx, list, special, time.Hour))
+	s.Require().NoError(s.repo.SetDeviceList(ctx, list, globMatch, time.Hour))
+
+	count, err := s.repo.PurgeBrand(ctx, "A*,C")
+	s.Require().NoError(err)
+	s.Require().Equal(int64(1), count)
+
+	result, err := s.repo.GetDeviceList(ctx, special)
+	s.Require().NoError(err)
+	s.Require().False(result.Hit)
+
+	result, err = s.repo.GetDeviceList(ctx, globMatch)
+	s.Require().NoError(err)
+	s.Require().True(result.Hit, "the brand must be matched literally, not as a glob pattern or a list of brands")
+}
+
 func (s *DevicesCacheRepositoryTestSuite) TestIsHealthy() {
 	ctx := context.Background()
 
@@ -387,6 +659,31 @@ func (s *DevicesCacheRepositoryTestSuite) TestIsHealthy_AfterClose() {
 	s.keydbClient = nil
 }
 
+func (s *DevicesCacheRepositoryTestSuite) TestStats() {
+	ctx := context.Background()
+
+	s.Require().NoError(s.repo.SetDevice(ctx, model.NewDevice("Device 1", "Brand", model.StateAvailable), time.Hour))
+	s.Require().NoError(s.repo.SetDevice(ctx, model.NewDevice("Device 2", "Brand", model.StateInUse), time.Hour))
+
+	stats, err := s.repo.Stats(ctx)
+
+	s.Require().NoError(err)
+	s.Require().NotNil(stats)
+	s.Require().Equal(int64(2), stats.KeyCount)
+}
+
+func (s *DevicesCacheRepositoryTestSuite) TestStats_AfterClose() {
+	ctx := context.Background()
+
+	err := s.keydbClient.Close()
+	s.Require().NoError(err)
+
+	stats, err := s.repo.Stats(ctx)
+	s.Require().Error(err)
+	s.Require().Nil(stats)
+	s.keydbClient = nil
+}
+
 func (s *DevicesCacheRepositoryTestSuite) TestDeviceListExpiration() {
 	ctx := context.Background()
 	filter := model.DefaultDeviceFilter()
@@ -444,3 +741,107 @@ func (s *DevicesCacheRepositoryTestSuite) TestCacheKeyConsistency() {
 	s.Require().NoError(err)
 	s.Require().True(result.Hit, "Cache should hit for same filter with different array order")
 }
+
+func (s *DevicesCacheRepositoryTestSuite) TestMetrics_GetDeviceMiss() {
+	ctx := context.Background()
+
+	_, err := s.repo.GetDevice(ctx, model.NewDeviceID())
+	s.Require().NoError(err)
+
+	s.Require().True(s.metrics.HasAttribute("cache_misses_total", "op", "get_device"))
+	s.Require().True(s.metrics.HasAttribute("cache_latency_ms", "op", "get_device"))
+	s.Require().False(s.metrics.Has("cache_hits_total"))
+}
+
+func (s *DevicesCacheRepositoryTestSuite) TestMetrics_GetDeviceHit() {
+	ctx := context.Background()
+	device := model.NewDevice("Test Device", "Test Brand", model.StateAvailable)
+
+	err := s.repo.SetDevice(ctx, device, time.Hour)
+	s.Require().NoError(err)
+	s.Require().True(s.metrics.HasAttribute("cache_latency_ms", "op", "set_device"))
+
+	_, err = s.repo.GetDevice(ctx, device.ID)
+	s.Require().NoError(err)
+
+	s.Require().True(s.metrics.HasAttribute("cache_hits_total", "op", "get_device"))
+	s.Require().False(s.metrics.Has("cache_misses_total"))
+}
+
+func (s *DevicesCacheRepositoryTestSuite) TestMetrics_DeviceListOperations() {
+	ctx := context.Background()
+	filter := model.DefaultDeviceFilter()
+	list := &model.DeviceList{
+		Devices:    []*model.Device{model.NewDevice("Device", "Brand", model.StateAvailable)},
+		Pagination: model.Pagination{TotalItems: 1},
+	}
+
+	_, err := s.repo.GetDeviceList(ctx, filter)
+	s.Require().NoError(err)
+	s.Require().True(s.metrics.HasAttribute("cache_misses_total", "op", "get_list"))
+
+	err = s.repo.SetDeviceList(ctx, list, filter, time.Hour)
+	s.Require().NoError(err)
+	s.Require().True(s.metrics.HasAttribute("cache_latency_ms", "op", "set_list"))
+
+	_, err = s.repo.GetDeviceList(ctx, filter)
+	s.Require().NoError(err)
+	s.Require().True(s.metrics.HasAttribute("cache_hits_total", "op", "get_list"))
+}
+
+func (s *DevicesCacheRepositoryTestSuite) TestMetrics_Invalidate() {
+	ctx := context.Background()
+
+	err := s.repo.InvalidateDevice(ctx, model.NewDeviceID())
+	s.Require().NoError(err)
+
+	s.Require().True(s.metrics.HasAttribute("cache_latency_ms", "op", "invalidate"))
+}
+
+func (s *DevicesCacheRepositoryTestSuite) TestWithCacheClock() {
+	ctx := context.Background()
+	now := time.Date(2026, 1, 13, 1, 0, 0, 0, time.UTC)
+	mockClock := clock.NewMockClock(now)
+	metricsClient := recording.NewMetricsClient()
+
+	repo := repos.NewDevicesCacheRepository(s.keydbClient, logger.NewTestLogger(), metricsClient, repos.WithCacheClock(mockClock))
+
+	filter := model.DefaultDeviceFilter()
+	list := &model.DeviceList{
+		Devices:    []*model.Device{model.NewDevice("Device", "Brand", model.StateAvailable)},
+		Pagination: model.Pagination{TotalItems: 1},
+	}
+
+	s.Require().NoError(repo.SetDeviceList(ctx, list, filter, time.Hour))
+
+	mockClock.Advance(30 * time.Second)
+
+	result, err := repo.GetDeviceList(ctx, filter)
+	s.Require().NoError(err)
+	s.Require().True(result.Hit)
+	s.Require().Equal(now.Add(30*time.Second), result.CachedAt)
+
+	// A clock that does not move between the start and end of an operation
+	// records zero latency.
+	latencies := metricsClient.Records("cache_latency_ms")
+	s.Require().NotEmpty(latencies)
+
+	for _, record := range latencies {
+		s.Require().Equal(recording.KindHistogram, record.Kind)
+		s.Require().Zero(record.Value)
+	}
+}
+
+func (s *DevicesCacheRepositoryTestSuite) TestMetrics_NilClient() {
+	ctx := context.Background()
+	repo := repos.NewDevicesCacheRepository(s.keydbClient, logger.NewTestLogger(), nil)
+	device := model.NewDevice("Test Device", "Test Brand", model.StateAvailable)
+
+	s.Require().NoError(repo.SetDevice(ctx, device, time.Hour))
+
+	result, err := repo.GetDevice(ctx, device.ID)
+	s.Require().NoError(err)
+	s.Require().True(result.Hit)
+
+	s.Require().NoError(repo.InvalidateDevice(ctx, device.ID))
+}
@@ -0,0 +1,63 @@
+package repos
+
+import (
+	"testing"
+
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/ports"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCacheStats(t *testing.T) {
+	t.Parallel()
+
+	const info = "# Memory\r\n" +
+		"used_memory:1048576\r\n" +
+		"used_memory_human:1.00M\r\n" +
+		"\r\n" +
+		"# Stats\r\n" +
+		"keyspace_hits:75\r\n" +
+		"keyspace_misses:25\r\n"
+
+	cases := []struct {
+		name     string
+		info     string
+		keyCount int64
+		expected ports.CacheStats
+	}{
+		{
+			name:     "full info",
+			info:     info,
+			keyCount: 42,
+			expected: ports.CacheStats{HitCount: 75, MissCount: 25, KeyCount: 42, UsedMemoryBytes: 1048576, HitRatio: 0.75},
+		},
+		{
+			name:     "no lookups yields zero ratio",
+			info:     "# Stats\r\nkeyspace_hits:0\r\nkeyspace_misses:0\r\n",
+			keyCount: 3,
+			expected: ports.CacheStats{KeyCount: 3},
+		},
+		{
+			name:     "missing sections count as zero",
+			info:     "# Clients\nconnected_clients:1\r\n",
+			expected: ports.CacheStats{},
+		},
+		{
+			name:     "only misses",
+			info:     "keyspace_hits:0\nkeyspace_misses:10\n",
+			expected: ports.CacheStats{MissCount: 10},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			stats := newCacheStats(parseInfo(tc.info), tc.keyCount)
+
+			require.InDelta(t, tc.expected.HitRatio, stats.HitRatio, 1e-9)
+
+			stats.HitRatio = tc.expected.HitRatio
+			require.Equal(t, tc.expected, *stats)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"fmt"
 	"strings"
 	"time"
 
@@ -12,6 +13,8 @@ import (
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/ports"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const devicesServiceName = "svc-devices"
@@ -20,6 +23,7 @@ const devicesServiceName = "svc-devices"
 // It handles domain mapping and error translation.
 type DevicesService struct {
 	client *grpcclient.Client
+	health *HealthAggregator
 }
 
 var (
@@ -28,11 +32,30 @@ var (
 )
 
 // NewDevicesService creates a new service that coordinates with the gRPC outbound adapter.
-// The client lifecycle is managed by the caller.
+// The client lifecycle is managed by the caller. The svc-devices health check is
+// registered up front; further dependencies are added with RegisterHealthCheck.
 func NewDevicesService(client *grpcclient.Client) *DevicesService {
-	return &DevicesService{
+	s := &DevicesService{
 		client: client,
+		health: NewHealthAggregator(client.Config().HealthCheck.AggregationTimeout),
 	}
+
+	s.health.Register(devicesServiceName, s.checkDevicesService)
+
+	return s
+}
+
+// StartHealthWatch starts the client's svc-devices health watch and answers the
+// svc-devices check of the readiness and health reports from its latest result,
+// instead of issuing a gRPC call for every report.
+func (s *DevicesService) StartHealthWatch(ctx context.Context, interval time.Duration) {
+	s.client.StartHealthWatch(ctx, interval)
+	s.health.Register(devicesServiceName, s.checkWatchedDevicesService)
+}
+
+// RegisterHealthCheck adds a dependency to the readiness and health reports.
+func (s *DevicesService) RegisterHealthCheck(name string, fn CheckFunc) {
+	s.health.Register(name, fn)
 }
 
 // CreateDevice creates a new device.
@@ -110,6 +133,25 @@ func (s *DevicesService) PatchDevice(ctx context.Context, id model.DeviceID, upd
 	return toDomainDevice(resp.GetDevice()), nil
 }
 
+// PatchDeviceIfUnmodified partially updates a device only if it was last updated at
+// lastUpdatedAt.
+func (s *DevicesService) PatchDeviceIfUnmodified(
+	ctx context.Context,
+	id model.DeviceID,
+	updates map[string]any,
+	lastUpdatedAt time.Time,
+) (*model.Device, error) {
+	req := toProtoPatchRequest(id, updates)
+	req.ExpectedUpdatedAt = timestamppb.New(lastUpdatedAt)
+
+	resp, err := s.client.PatchDevice(ctx, req)
+	if err != nil {
+		return nil, mapGRPCError(err)
+	}
+
+	return toDomainDevice(resp.GetDevice()), nil
+}
+
 // DeleteDevice deletes a device by ID.
 func (s *DevicesService) DeleteDevice(ctx context.Context, id model.DeviceID) error {
 	req := &devicev1.DeleteDeviceRequest{
@@ -149,44 +191,11 @@ func (s *DevicesService) Liveness(ctx context.Context) (*model.LivenessReport, e
 
 // Readiness returns the readiness status including dependency checks.
 func (s *DevicesService) Readiness(ctx context.Context) (*model.ReadinessReport, error) {
-	checks := make(map[string]model.DependencyCheck)
-	now := time.Now().UTC()
-
-	resp, err := s.client.CheckHealth(ctx, &devicev1.HealthCheckRequest{})
-	if err != nil {
-		checks[devicesServiceName] = model.DependencyCheck{
-			Status:      model.DependencyStatusDown,
-			Message:     err.Error(),
-			LastChecked: now,
-		}
-
-		return &model.ReadinessReport{
-			Status:    model.HealthStatusDown,
-			Timestamp: now,
-			Version:   config.ServiceVersion,
-			Checks:    checks,
-		}, nil
-	}
-
-	depStatus := model.DependencyStatusUp
-	if resp.GetStatus() != devicev1.HealthCheckResponse_SERVING_STATUS_SERVING {
-		depStatus = model.DependencyStatusDown
-	}
-
-	checks[devicesServiceName] = model.DependencyCheck{
-		Status:      depStatus,
-		Message:     "ok",
-		LastChecked: now,
-	}
-
-	overallStatus := model.HealthStatusOK
-	if depStatus == model.DependencyStatusDown {
-		overallStatus = model.HealthStatusDown
-	}
+	status, checks := s.health.Check(ctx)
 
 	return &model.ReadinessReport{
-		Status:    overallStatus,
-		Timestamp: now,
+		Status:    status,
+		Timestamp: time.Now().UTC(),
 		Version:   config.ServiceVersion,
 		Checks:    checks,
 	}, nil
@@ -194,54 +203,41 @@ func (s *DevicesService) Readiness(ctx context.Context) (*model.ReadinessReport,
 
 // Health returns a comprehensive health report.
 func (s *DevicesService) Health(ctx context.Context) (*model.HealthReport, error) {
-	checks := make(map[string]model.DependencyCheck)
-	now := time.Now().UTC()
-	cfg := s.client.Config()
+	status, checks := s.health.Check(ctx)
+
+	return &model.HealthReport{
+		Status:    status,
+		Timestamp: time.Now().UTC(),
+		Version: model.VersionInfo{
+			API:   s.client.Config().App.APIVersion,
+			Build: config.CommitSHA,
+		},
+		Checks: checks,
+	}, nil
+}
 
+// checkDevicesService reports svc-devices as healthy only when it is serving.
+func (s *DevicesService) checkDevicesService(ctx context.Context) error {
 	resp, err := s.client.CheckHealth(ctx, &devicev1.HealthCheckRequest{})
 	if err != nil {
-		checks[devicesServiceName] = model.DependencyCheck{
-			Status:      model.DependencyStatusDown,
-			Message:     err.Error(),
-			LastChecked: now,
-		}
-
-		return &model.HealthReport{
-			Status:    model.HealthStatusDown,
-			Timestamp: now,
-			Version: model.VersionInfo{
-				API:   cfg.App.APIVersion,
-				Build: config.CommitSHA,
-			},
-			Checks: checks,
-		}, nil
+		return err
 	}
 
-	depStatus := model.DependencyStatusUp
 	if resp.GetStatus() != devicev1.HealthCheckResponse_SERVING_STATUS_SERVING {
-		depStatus = model.DependencyStatusDown
+		return fmt.Errorf("%s is %s", devicesServiceName, resp.GetStatus())
 	}
 
-	checks[devicesServiceName] = model.DependencyCheck{
-		Status:      depStatus,
-		Message:     "ok",
-		LastChecked: now,
-	}
+	return nil
+}
 
-	overallStatus := model.HealthStatusOK
-	if depStatus == model.DependencyStatusDown {
-		overallStatus = model.HealthStatusDown
+// checkWatchedDevicesService reports svc-devices as healthy when the health watch last
+// found it serving.
+func (s *DevicesService) checkWatchedDevicesService(context.Context) error {
+	if !s.client.IsHealthy() {
+		return fmt.Errorf("%s is not serving", devicesServiceName)
 	}
 
-	return &model.HealthReport{
-		Status:    overallStatus,
-		Timestamp: now,
-		Version: model.VersionInfo{
-			API:   cfg.App.APIVersion,
-			Build: config.CommitSHA,
-		},
-		Checks: checks,
-	}, nil
+	return nil
 }
 
 func toProtoState(s model.State) devicev1.DeviceState {
@@ -343,17 +339,22 @@ func toProtoListRequest(filter model.DeviceFilter) *devicev1.ListDevicesRequest
 	return req
 }
 
+// toProtoPatchRequest names every field present in updates in the update mask, as
+// svc-devices only patches the masked fields.
 func toProtoPatchRequest(id model.DeviceID, updates map[string]any) *devicev1.PatchDeviceRequest {
 	req := &devicev1.PatchDeviceRequest{
-		Id: id.String(),
+		Id:         id.String(),
+		UpdateMask: &fieldmaskpb.FieldMask{},
 	}
 
 	if name, ok := updates["name"].(string); ok {
 		req.Name = &name
+		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "name")
 	}
 
 	if brand, ok := updates["brand"].(string); ok {
 		req.Brand = &brand
+		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "brand")
 	}
 
 	if stateStr, ok := updates["state"].(string); ok {
@@ -368,6 +369,10 @@ func toProtoPatchRequest(id model.DeviceID, updates map[string]any) *devicev1.Pa
 		req.State = &protoState
 	}
 
+	if req.State != nil {
+		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "state")
+	}
+
 	return req
 }
 
@@ -385,6 +390,9 @@ func mapGRPCError(err error) error {
 	case codes.NotFound:
 		return model.ErrDeviceNotFound
 
+	case codes.AlreadyExists:
+		return model.ErrDeviceAlreadyExists
+
 	case codes.FailedPrecondition:
 		msg := st.Message()
 		if strings.Contains(msg, "cannot update") {
@@ -393,6 +401,9 @@ func mapGRPCError(err error) error {
 		if strings.Contains(msg, "cannot delete") {
 			return model.ErrCannotDeleteInUseDevice
 		}
+		if strings.Contains(msg, "modified concurrently") {
+			return model.ErrDeviceModified
+		}
 
 		return err
 
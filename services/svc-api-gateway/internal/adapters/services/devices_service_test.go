@@ -117,6 +117,15 @@ func TestDevicesService_CreateDevice(t *testing.T) {
 			wantErr: true,
 			errIs:   model.ErrServiceUnavailable,
 		},
+		{
+			name: "maps gRPC AlreadyExists error to domain error",
+			setupMock: func(fake *mocks.FakeDeviceServiceClient) {
+				fake.CreateDeviceReturns(nil, status.Error(codes.AlreadyExists, "device already exists"))
+			},
+			device:  struct{ name, brand string; state model.State }{"Test Device", "Test Brand", model.StateAvailable},
+			wantErr: true,
+			errIs:   model.ErrDeviceAlreadyExists,
+		},
 	}
 
 	for _, tc := range cases {
@@ -283,6 +292,68 @@ func TestDevicesService_DeleteDevice(t *testing.T) {
 	}
 }
 
+func TestDevicesService_PatchDeviceIfUnmodified(t *testing.T) {
+	t.Parallel()
+
+	deviceID := model.NewDeviceID()
+	lastUpdatedAt := time.Date(2026, 1, 2, 3, 4, 5, 6000, time.UTC)
+
+	cases := []struct {
+		name      string
+		setupMock func(*mocks.FakeDeviceServiceClient)
+		wantErr   bool
+		errIs     error
+	}{
+		{
+			name: "sends the expected update time",
+			setupMock: func(fake *mocks.FakeDeviceServiceClient) {
+				fake.PatchDeviceReturns(&devicev1.PatchDeviceResponse{
+					Device: &devicev1.Device{Id: deviceID.String(), State: devicev1.DeviceState_DEVICE_STATE_IN_USE},
+				}, nil)
+			},
+		},
+		{
+			name: "maps gRPC FailedPrecondition for a concurrently modified device",
+			setupMock: func(fake *mocks.FakeDeviceServiceClient) {
+				fake.PatchDeviceReturns(nil, status.Error(codes.FailedPrecondition, "device was modified concurrently"))
+			},
+			wantErr: true,
+			errIs:   model.ErrDeviceModified,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			fake := &mocks.FakeDeviceServiceClient{}
+			tc.setupMock(fake)
+
+			client := grpcclient.NewClient(nil, testConfig(),
+				grpcclient.WithDeviceClient(fake),
+			)
+			svc := NewDevicesService(client)
+
+			device, err := svc.PatchDeviceIfUnmodified(t.Context(), deviceID, map[string]any{"state": "in-use"}, lastUpdatedAt)
+
+			require.Equal(t, 1, fake.PatchDeviceCallCount())
+
+			_, req, _ := fake.PatchDeviceArgsForCall(0)
+			require.Equal(t, []string{"state"}, req.GetUpdateMask().GetPaths())
+			require.True(t, lastUpdatedAt.Equal(req.GetExpectedUpdatedAt().AsTime()))
+
+			if tc.wantErr {
+				require.ErrorIs(t, err, tc.errIs)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, model.StateInUse, device.State)
+		})
+	}
+}
+
 func TestDevicesService_Health(t *testing.T) {
 	t.Parallel()
 
@@ -338,3 +409,100 @@ func TestDevicesService_Health(t *testing.T) {
 		})
 	}
 }
+
+func TestDevicesService_ReadinessUsesTheHealthWatch(t *testing.T) {
+	t.Parallel()
+
+	fake := &mocks.FakeHealthServiceClient{}
+	fake.CheckReturns(&devicev1.HealthCheckResponse{
+		Status: devicev1.HealthCheckResponse_SERVING_STATUS_SERVING,
+	}, nil)
+
+	client := grpcclient.NewClient(nil, testConfig(),
+		grpcclient.WithHealthClient(fake),
+	)
+	svc := NewDevicesService(client)
+
+	// A long interval leaves the watch at its initial probe.
+	svc.StartHealthWatch(t.Context(), time.Hour)
+
+	require.Eventually(t, client.IsHealthy, time.Second, 5*time.Millisecond)
+
+	for range 3 {
+		report, err := svc.Readiness(t.Context())
+
+		require.NoError(t, err)
+		require.Equal(t, model.HealthStatusOK, report.Status)
+		require.Equal(t, model.DependencyStatusUp, report.Checks[devicesServiceName].Status)
+	}
+
+	require.Equal(t, 1, fake.CheckCallCount(), "readiness reads the watch result instead of calling svc-devices")
+}
+
+func TestDevicesService_ReadinessIsDownUntilTheHealthWatchSeesServing(t *testing.T) {
+	t.Parallel()
+
+	fake := &mocks.FakeHealthServiceClient{}
+	fake.CheckReturns(&devicev1.HealthCheckResponse{
+		Status: devicev1.HealthCheckResponse_SERVING_STATUS_NOT_SERVING,
+	}, nil)
+
+	client := grpcclient.NewClient(nil, testConfig(),
+		grpcclient.WithHealthClient(fake),
+	)
+	svc := NewDevicesService(client)
+
+	svc.StartHealthWatch(t.Context(), time.Hour)
+
+	require.Eventually(t, func() bool { return fake.CheckCallCount() == 1 }, time.Second, 5*time.Millisecond)
+
+	report, err := svc.Readiness(t.Context())
+
+	require.NoError(t, err)
+	require.Equal(t, model.HealthStatusDown, report.Status)
+}
+
+func TestToProtoPatchRequest(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name          string
+		updates       map[string]any
+		expectedPaths []string
+	}{
+		{
+			name:          "single field",
+			updates:       map[string]any{"brand": "Samsung"},
+			expectedPaths: []string{"brand"},
+		},
+		{
+			name:          "every field",
+			updates:       map[string]any{"state": "inactive", "name": "Galaxy S24", "brand": "Samsung"},
+			expectedPaths: []string{"name", "brand", "state"},
+		},
+		{
+			name:          "empty name is still masked",
+			updates:       map[string]any{"name": ""},
+			expectedPaths: []string{"name"},
+		},
+		{
+			name:          "domain state",
+			updates:       map[string]any{"state": model.StateInUse},
+			expectedPaths: []string{"state"},
+		},
+		{
+			name:    "unknown state is dropped",
+			updates: map[string]any{"state": "broken"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := toProtoPatchRequest(model.NewDeviceID(), tc.updates)
+
+			require.Equal(t, tc.expectedPaths, req.GetUpdateMask().GetPaths())
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/domain/model"
+	"golang.org/x/sync/errgroup"
+)
+
+type (
+	// CheckFunc probes a single dependency, returning nil when it is healthy.
+	CheckFunc func(ctx context.Context) error
+
+	// HealthAggregator runs registered dependency checks concurrently and caps the
+	// whole round at the aggregation timeout, so one slow dependency cannot hold up
+	// readiness and health reports.
+	HealthAggregator struct {
+		timeout time.Duration
+
+		mu     sync.RWMutex
+		checks map[string]CheckFunc
+	}
+)
+
+// NewHealthAggregator creates an aggregator whose rounds are bounded by timeout.
+// A zero timeout leaves the round bounded only by the caller's context.
+func NewHealthAggregator(timeout time.Duration) *HealthAggregator {
+	return &HealthAggregator{
+		timeout: timeout,
+		checks:  make(map[string]CheckFunc),
+	}
+}
+
+// Register adds or replaces the check reported under name.
+func (a *HealthAggregator) Register(name string, fn CheckFunc) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.checks[name] = fn
+}
+
+// Check runs every registered check concurrently and returns the per-dependency results.
+// The aggregated status is ok only when every dependency is up; checks that do not
+// finish within the aggregation timeout are cancelled and reported as down.
+func (a *HealthAggregator) Check(ctx context.Context) (model.HealthStatus, map[string]model.DependencyCheck) {
+	a.mu.RLock()
+	checks := make(map[string]CheckFunc, len(a.checks))
+	for name, fn := range a.checks {
+		checks[name] = fn
+	}
+	a.mu.RUnlock()
+
+	if a.timeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, a.timeout)
+		defer cancel()
+	}
+
+	var mu sync.Mutex
+
+	results := make(map[string]model.DependencyCheck, len(checks))
+
+	group, groupCtx := errgroup.WithContext(ctx)
+
+	for name, fn := range checks {
+		group.Go(func() error {
+			result := runCheck(groupCtx, fn)
+
+			mu.Lock()
+			results[name] = result
+			mu.Unlock()
+
+			return nil
+		})
+	}
+
+	_ = group.Wait()
+
+	status := model.HealthStatusOK
+
+	for _, result := range results {
+		if result.Status != model.DependencyStatusUp {
+			status = model.HealthStatusDown
+
+			break
+		}
+	}
+
+	return status, results
+}
+
+// runCheck executes fn and stops waiting for it once ctx is done, so a check
+// that ignores cancellation still cannot delay the aggregated report.
+func runCheck(ctx context.Context, fn CheckFunc) model.DependencyCheck {
+	start := time.Now()
+	done := make(chan error, 1)
+
+	go func() {
+		done <- fn(ctx)
+	}()
+
+	var err error
+
+	select {
+	case err = <-done:
+	case <-ctx.Done():
+		err = fmt.Errorf("check cancelled: %w", ctx.Err())
+	}
+
+	result := model.DependencyCheck{
+		Status:      model.DependencyStatusUp,
+		LatencyMs:   uint64(time.Since(start).Milliseconds()),
+		Message:     "ok",
+		LastChecked: time.Now().UTC(),
+	}
+
+	if err != nil {
+		result.Status = model.DependencyStatusDown
+		result.Message = err.Error()
+	}
+
+	return result
+}
@@ -0,0 +1,110 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/domain/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHealthAggregator_AllChecksPass(t *testing.T) {
+	t.Parallel()
+
+	aggregator := NewHealthAggregator(time.Second)
+	aggregator.Register("svc-devices", func(context.Context) error { return nil })
+	aggregator.Register("keydb", func(context.Context) error { return nil })
+
+	status, checks := aggregator.Check(t.Context())
+
+	require.Equal(t, model.HealthStatusOK, status)
+	require.Len(t, checks, 2)
+
+	for name, check := range checks {
+		require.Equal(t, model.DependencyStatusUp, check.Status, name)
+		require.Equal(t, "ok", check.Message, name)
+		require.False(t, check.LastChecked.IsZero(), name)
+	}
+}
+
+func TestHealthAggregator_FailingCheckMarksDown(t *testing.T) {
+	t.Parallel()
+
+	aggregator := NewHealthAggregator(time.Second)
+	aggregator.Register("svc-devices", func(context.Context) error { return nil })
+	aggregator.Register("keydb", func(context.Context) error { return errors.New("connection refused") })
+
+	status, checks := aggregator.Check(t.Context())
+
+	require.Equal(t, model.HealthStatusDown, status)
+	require.Equal(t, model.DependencyStatusUp, checks["svc-devices"].Status)
+	require.Equal(t, model.DependencyStatusDown, checks["keydb"].Status)
+	require.Equal(t, "connection refused", checks["keydb"].Message)
+}
+
+func TestHealthAggregator_SlowCheckIsCancelled(t *testing.T) {
+	t.Parallel()
+
+	const timeout = 50 * time.Millisecond
+
+	cancelled := make(chan struct{})
+	release := make(chan struct{})
+	defer close(release)
+
+	aggregator := NewHealthAggregator(timeout)
+	aggregator.Register("svc-devices", func(context.Context) error { return nil })
+	aggregator.Register("slow", func(ctx context.Context) error {
+		<-ctx.Done()
+		close(cancelled)
+
+		return ctx.Err()
+	})
+	aggregator.Register("stuck", func(context.Context) error {
+		// Ignores cancellation entirely; the aggregator must not wait for it.
+		<-release
+
+		return nil
+	})
+
+	start := time.Now()
+	status, checks := aggregator.Check(t.Context())
+	elapsed := time.Since(start)
+
+	require.Less(t, elapsed, 10*timeout)
+	require.Equal(t, model.HealthStatusDown, status)
+	require.Equal(t, model.DependencyStatusUp, checks["svc-devices"].Status)
+	require.Equal(t, model.DependencyStatusDown, checks["slow"].Status)
+	require.Equal(t, model.DependencyStatusDown, checks["stuck"].Status)
+	require.Contains(t, checks["stuck"].Message, context.DeadlineExceeded.Error())
+
+	select {
+	case <-cancelled:
+	case <-time.After(time.Second):
+		t.Fatal("slow check context was not cancelled")
+	}
+}
+
+func TestHealthAggregator_ChecksRunConcurrently(t *testing.T) {
+	t.Parallel()
+
+	const checkDuration = 50 * time.Millisecond
+
+	aggregator := NewHealthAggregator(time.Second)
+
+	for _, name := range []string{"a", "b", "c", "d"} {
+		aggregator.Register(name, func(context.Context) error {
+			time.Sleep(checkDuration)
+
+			return nil
+		})
+	}
+
+	start := time.Now()
+	status, checks := aggregator.Check(t.Context())
+
+	require.Less(t, time.Since(start), 3*checkDuration)
+	require.Equal(t, model.HealthStatusOK, status)
+	require.Len(t, checks, 4)
+}
@@ -2,8 +2,11 @@ package config
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
 )
 
 func TestInit(t *testing.T) {
@@ -37,6 +40,10 @@ func TestInit_DefaultValues(t *testing.T) {
 	assert.Equal(t, "0.0.0.0", cfg.PublicHTTPServer.Host)
 	assert.Equal(t, uint(8088), cfg.PublicHTTPServer.Port)
 
+	// AdminHTTPServer purge rate limit defaults
+	assert.Equal(t, uint(1), cfg.AdminHTTPServer.RateLimit.PurgeRPS)
+	assert.Equal(t, uint(5), cfg.AdminHTTPServer.RateLimit.PurgeBurst)
+
 	// Auth defaults
 	assert.True(t, cfg.Auth.Enabled)
 	assert.Contains(t, cfg.Auth.ValidIssuers, "svc-api-gateway")
@@ -50,6 +57,255 @@ func TestInit_DefaultValues(t *testing.T) {
 
 	// DevicesGRPCClient defaults
 	assert.Equal(t, uint(4194304), cfg.DevicesGRPCClient.MaxMessageSize) // 4 MiB
+	assert.Equal(t, 30*time.Second, cfg.DevicesGRPCClient.Keepalive.Time)
+	assert.Equal(t, 10*time.Second, cfg.DevicesGRPCClient.Keepalive.Timeout)
+	assert.True(t, cfg.DevicesGRPCClient.Keepalive.PermitWithoutStream)
+}
+
+func TestInit_TimeoutPaths(t *testing.T) {
+	t.Setenv("REQUEST_TIMEOUT_DEFAULT", "5s")
+	t.Setenv("REQUEST_TIMEOUT_PATHS", "/v1/devices:2s,/v1/devices/export:1m")
+
+	cfg, err := Init()
+	assert.NoError(t, err)
+
+	assert.Equal(t, 5*time.Second, cfg.Timeout.Default)
+	assert.Equal(t, map[string]time.Duration{
+		"/v1/devices":        2 * time.Second,
+		"/v1/devices/export": time.Minute,
+	}, cfg.Timeout.Paths)
+}
+
+func TestInit_RetryPolicy(t *testing.T) {
+	t.Setenv("DEVICES_RETRY_POLICY", "unavailable:3, NOT_FOUND:1")
+
+	cfg, err := Init()
+	assert.NoError(t, err)
+
+	assert.Equal(t, RetryPolicy{
+		codes.Unavailable: {MaxAttempts: 3},
+		codes.NotFound:    {MaxAttempts: 1},
+	}, cfg.DevicesGRPCClient.RetryPolicy)
+}
+
+func TestRetryPolicy_Decode(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name        string
+		value       string
+		expected    RetryPolicy
+		expectError bool
+	}{
+		{
+			name:     "empty value yields an empty policy",
+			value:    "",
+			expected: RetryPolicy{},
+		},
+		{
+			name:  "parses multiple entries",
+			value: "UNAVAILABLE:3,RESOURCE_EXHAUSTED:2",
+			expected: RetryPolicy{
+				codes.Unavailable:       {MaxAttempts: 3},
+				codes.ResourceExhausted: {MaxAttempts: 2},
+			},
+		},
+		{
+			name:        "rejects unknown code",
+			value:       "NOT_A_CODE:3",
+			expectError: true,
+		},
+		{
+			name:        "rejects missing attempts",
+			value:       "UNAVAILABLE",
+			expectError: true,
+		},
+		{
+			name:        "rejects invalid attempts",
+			value:       "UNAVAILABLE:many",
+			expectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var policy RetryPolicy
+
+			err := policy.Decode(tc.value)
+			if tc.expectError {
+				assert.Error(t, err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, policy)
+		})
+	}
+}
+
+func TestCORS_Validate(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name        string
+		cfg         CORS
+		expectError bool
+	}{
+		{
+			name: "wildcard without credentials",
+			cfg:  CORS{AllowedOrigins: []string{"*"}},
+		},
+		{
+			name: "explicit origins with credentials",
+			cfg:  CORS{AllowedOrigins: []string{"https://app.example.com"}, AllowCredentials: true},
+		},
+		{
+			name:        "wildcard with credentials",
+			cfg:         CORS{AllowedOrigins: []string{"https://app.example.com", "*"}, AllowCredentials: true},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.cfg.Validate()
+			if tc.expectError {
+				assert.Error(t, err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestServiceConfig_Validate(t *testing.T) {
+	t.Setenv("AUTH_SECRET_KEY", "test-secret-key")
+
+	cases := []struct {
+		name          string
+		mutate        func(cfg *ServiceConfig)
+		expectedError string
+	}{
+		{
+			name:   "defaults with a secret key",
+			mutate: func(cfg *ServiceConfig) {},
+		},
+		{
+			name:          "auth enabled without secret key",
+			mutate:        func(cfg *ServiceConfig) { cfg.Auth.SecretKey = "" },
+			expectedError: "auth secret_key must be set when auth is enabled",
+		},
+		{
+			name: "auth disabled without secret key",
+			mutate: func(cfg *ServiceConfig) {
+				cfg.Auth.Enabled = false
+				cfg.Auth.SecretKey = ""
+			},
+		},
+		{
+			name:          "devices grpc client without address",
+			mutate:        func(cfg *ServiceConfig) { cfg.DevicesGRPCClient.Address = "" },
+			expectedError: "devices grpc address must be set",
+		},
+		{
+			name:          "devices grpc client with zero timeout",
+			mutate:        func(cfg *ServiceConfig) { cfg.DevicesGRPCClient.Timeout = 0 },
+			expectedError: "devices grpc timeout must be positive, got 0s",
+		},
+		{
+			name:          "cache with empty pool",
+			mutate:        func(cfg *ServiceConfig) { cfg.Cache.PoolSize = 0 },
+			expectedError: "cache pool_size must be positive",
+		},
+		{
+			name:          "cache without default expiry",
+			mutate:        func(cfg *ServiceConfig) { cfg.Cache.DefaultExpiry = 0 },
+			expectedError: "cache default_expiry must be positive, got 0s",
+		},
+		{
+			name: "in-memory cache without paths",
+			mutate: func(cfg *ServiceConfig) {
+				cfg.InMemoryCache.Enabled = true
+				cfg.InMemoryCache.Paths = nil
+			},
+			expectedError: "in-memory cache paths must list at least one path when the cache is enabled",
+		},
+		{
+			name:          "rate limiting without rate",
+			mutate:        func(cfg *ServiceConfig) { cfg.ThrottledRateLimiting.RequestsPerSecond = 0 },
+			expectedError: "rate limiting requests_per_second must be positive",
+		},
+		{
+			name: "adaptive burst without key cap",
+			mutate: func(cfg *ServiceConfig) {
+				cfg.ThrottledRateLimiting.AdaptiveBurst = true
+				cfg.ThrottledRateLimiting.MaxKeys = 0
+			},
+			expectedError: "rate limiting max_keys must be positive when adaptive_burst is enabled",
+		},
+		{
+			name: "disabled rate limiting is not checked",
+			mutate: func(cfg *ServiceConfig) {
+				cfg.ThrottledRateLimiting.Enabled = false
+				cfg.ThrottledRateLimiting.RequestsPerSecond = 0
+			},
+		},
+		{
+			name:          "idempotency without lock ttl",
+			mutate:        func(cfg *ServiceConfig) { cfg.Idempotency.LockTTL = 0 },
+			expectedError: "idempotency lock_ttl must be positive, got 0s",
+		},
+		{
+			name: "cors wildcard with credentials",
+			mutate: func(cfg *ServiceConfig) {
+				cfg.CORS.AllowedOrigins = []string{"*"}
+				cfg.CORS.AllowCredentials = true
+			},
+			expectedError: "cors allowed_origins must not contain a wildcard when allow_credentials is enabled",
+		},
+		{
+			name:          "compression level out of range",
+			mutate:        func(cfg *ServiceConfig) { cfg.Compression.Level = 10 },
+			expectedError: "compression level must be between 1 and 9, got 10",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg, err := Init()
+			require.NoError(t, err)
+
+			tc.mutate(cfg)
+
+			errs := cfg.Validate()
+			if tc.expectedError == "" {
+				require.Empty(t, errs)
+
+				return
+			}
+
+			require.Len(t, errs, 1)
+			require.EqualError(t, errs[0], tc.expectedError)
+		})
+	}
+
+	t.Run("reports every invalid sub-config", func(t *testing.T) {
+		cfg, err := Init()
+		require.NoError(t, err)
+
+		cfg.Auth.SecretKey = ""
+		cfg.Cache.PoolSize = 0
+		cfg.Idempotency.HeaderName = ""
+
+		require.Len(t, cfg.Validate(), 3)
+	})
 }
 
 func TestGetEnvironment(t *testing.T) {
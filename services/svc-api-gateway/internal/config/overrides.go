@@ -0,0 +1,159 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"reflect"
+
+	"github.com/kelseyhightower/envconfig"
+	"github.com/mitchellh/mapstructure"
+	"gopkg.in/yaml.v3"
+)
+
+// configDir holds base.yaml and the per-environment overlays, relative to the working directory.
+const configDir = "config"
+
+// LoadConfig builds the configuration in layers: the built-in defaults, then
+// config/base.yaml, then config/<env>.yaml, then any environment variable that is
+// set. Both files are optional and only the keys they contain are applied, so an
+// overlay lists just what differs from the base. An empty env selects the overlay
+// named by APP_ENVIRONMENT.
+func LoadConfig(env string) (*ServiceConfig, error) {
+	return loadConfig(os.DirFS(configDir), env)
+}
+
+func loadConfig(files fs.FS, env string) (*ServiceConfig, error) {
+	fromEnv, err := Init()
+	if err != nil {
+		return nil, err
+	}
+
+	if env == "" {
+		env = fromEnv.App.Env.Name
+	}
+
+	cfg := *fromEnv
+
+	for _, name := range []string{"base.yaml", env + ".yaml"} {
+		if err := applyConfigFile(files, name, &cfg); err != nil {
+			return nil, err
+		}
+	}
+
+	restoreEnvironment(reflect.ValueOf(&cfg).Elem(), reflect.ValueOf(fromEnv).Elem())
+
+	return &cfg, nil
+}
+
+// Merge returns a copy of c with every non-zero field of overlay applied on top.
+// Zero values in overlay cannot be told apart from unset ones, so they never
+// override c; use a config file to switch a setting off.
+func (c *ServiceConfig) Merge(overlay *ServiceConfig) *ServiceConfig {
+	merged := *c
+
+	if overlay != nil {
+		mergeValue(reflect.ValueOf(&merged).Elem(), reflect.ValueOf(overlay).Elem())
+	}
+
+	return &merged
+}
+
+func applyConfigFile(files fs.FS, name string, cfg *ServiceConfig) error {
+	content, err := fs.ReadFile(files, name)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading config file %s: %w", name, err)
+	}
+
+	var values map[string]any
+	if err := yaml.Unmarshal(content, &values); err != nil {
+		return fmt.Errorf("parsing config file %s: %w", name, err)
+	}
+
+	// Lists and maps from the file replace the current ones instead of being
+	// merged element by element, and misspelt keys are reported.
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		TagName:     "json",
+		ZeroFields:  true,
+		ErrorUnused: true,
+		Result:      cfg,
+		DecodeHook: mapstructure.ComposeDecodeHookFunc(
+			envconfigDecoderHook,
+			mapstructure.StringToTimeDurationHookFunc(),
+			mapstructure.StringToSliceHookFunc(","),
+		),
+	})
+	if err != nil {
+		return fmt.Errorf("creating decoder for config file %s: %w", name, err)
+	}
+
+	if err := decoder.Decode(values); err != nil {
+		return fmt.Errorf("applying config file %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// envconfigDecoderHook lets string values reuse the envconfig.Decoder parsing of
+// types such as RetryPolicy, so files accept the same syntax as the environment.
+func envconfigDecoderHook(from, to reflect.Type, data any) (any, error) {
+	value, ok := data.(string)
+	if !ok || from.Kind() != reflect.String {
+		return data, nil
+	}
+
+	target := reflect.New(to)
+
+	decoder, ok := target.Interface().(envconfig.Decoder)
+	if !ok {
+		return data, nil
+	}
+
+	if err := decoder.Decode(value); err != nil {
+		return nil, err
+	}
+
+	return target.Elem().Interface(), nil
+}
+
+// restoreEnvironment copies back from fromEnv every field whose environment
+// variable is set, so the environment wins over config files.
+func restoreEnvironment(cfg, fromEnv reflect.Value) {
+	for i := range cfg.NumField() {
+		field := cfg.Type().Field(i)
+
+		key, tagged := field.Tag.Lookup("envconfig")
+		if !tagged {
+			if field.Type.Kind() == reflect.Struct {
+				restoreEnvironment(cfg.Field(i), fromEnv.Field(i))
+			}
+
+			continue
+		}
+
+		if _, set := os.LookupEnv(key); set {
+			cfg.Field(i).Set(fromEnv.Field(i))
+		}
+	}
+}
+
+func mergeValue(dst, src reflect.Value) {
+	for i := range dst.NumField() {
+		srcField := src.Field(i)
+
+		if srcField.Kind() == reflect.Struct {
+			mergeValue(dst.Field(i), srcField)
+
+			continue
+		}
+
+		if !srcField.IsZero() {
+			dst.Field(i).Set(srcField)
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package config
+
+import (
+	"os"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func TestLoadConfig_Precedence(t *testing.T) {
+	files := fstest.MapFS{
+		"base.yaml":       {Data: []byte("auth:\n  enabled: false\n  skip_paths: [/v1/health]\n")},
+		"production.yaml": {Data: []byte("auth:\n  enabled: true\n")},
+	}
+
+	cases := []struct {
+		name            string
+		env             string
+		authEnabled     string
+		expectedEnabled bool
+	}{
+		{
+			name:            "base overrides the default",
+			env:             "development",
+			expectedEnabled: false,
+		},
+		{
+			name:            "overlay overrides the base",
+			env:             "production",
+			expectedEnabled: true,
+		},
+		{
+			name:            "environment variable overrides the overlay",
+			env:             "production",
+			authEnabled:     "false",
+			expectedEnabled: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.authEnabled != "" {
+				t.Setenv("AUTH_ENABLED", tc.authEnabled)
+			}
+
+			cfg, err := loadConfig(files, tc.env)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.expectedEnabled, cfg.Auth.Enabled)
+			require.Equal(t, []string{"/v1/health"}, cfg.Auth.SkipPaths, "lists from a file replace the default")
+			require.Contains(t, cfg.Auth.ValidIssuers, "svc-api-gateway", "keys missing from the files keep their default")
+		})
+	}
+}
+
+func TestLoadConfig_SelectsOverlayFromEnvironment(t *testing.T) {
+	t.Setenv("APP_ENVIRONMENT", "staging")
+
+	files := fstest.MapFS{
+		"staging.yaml": {Data: []byte("logging:\n  level: warn\n")},
+	}
+
+	cfg, err := loadConfig(files, "")
+	require.NoError(t, err)
+	require.Equal(t, "warn", cfg.Logging.Level)
+}
+
+func TestLoadConfig_DecodesFileValues(t *testing.T) {
+	files := fstest.MapFS{
+		"base.yaml": {Data: []byte(`
+cache:
+  default_expiry: 1h
+devices_grpc_client:
+  retry_policy: "UNAVAILABLE:4"
+timeout:
+  paths:
+    /v1/devices/export: 30s
+cors:
+  allowed_origins: https://a.example.com,https://b.example.com
+`)},
+	}
+
+	cfg, err := loadConfig(files, "development")
+	require.NoError(t, err)
+
+	require.Equal(t, time.Hour, cfg.Cache.DefaultExpiry)
+	require.Equal(t, uint(4), cfg.DevicesGRPCClient.RetryPolicy[codes.Unavailable].MaxAttempts)
+	require.Equal(t, map[string]time.Duration{"/v1/devices/export": 30 * time.Second}, cfg.Timeout.Paths)
+	require.Equal(t, []string{"https://a.example.com", "https://b.example.com"}, cfg.CORS.AllowedOrigins)
+}
+
+func TestLoadConfig_Errors(t *testing.T) {
+	cases := []struct {
+		name          string
+		content       string
+		expectedError string
+	}{
+		{
+			name:          "unknown key",
+			content:       "auth:\n  enabeld: false\n",
+			expectedError: "applying config file base.yaml",
+		},
+		{
+			name:          "malformed yaml",
+			content:       "auth: [\n",
+			expectedError: "parsing config file base.yaml",
+		},
+		{
+			name:          "invalid duration",
+			content:       "cache:\n  default_expiry: soon\n",
+			expectedError: "applying config file base.yaml",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := loadConfig(fstest.MapFS{"base.yaml": {Data: []byte(tc.content)}}, "development")
+			require.ErrorContains(t, err, tc.expectedError)
+		})
+	}
+}
+
+func TestLoadConfig_WithoutFiles(t *testing.T) {
+	expected, err := Init()
+	require.NoError(t, err)
+
+	cfg, err := loadConfig(fstest.MapFS{}, "production")
+	require.NoError(t, err)
+	require.Equal(t, expected, cfg)
+}
+
+func TestServiceConfig_Merge(t *testing.T) {
+	t.Parallel()
+
+	base := &ServiceConfig{
+		Auth:    Auth{Enabled: true, SecretKey: "base-key", TokenExpiry: time.Hour},
+		Logging: Logging{Level: "info", Format: "json"},
+	}
+
+	overlay := &ServiceConfig{
+		Auth:    Auth{SecretKey: "overlay-key"},
+		Logging: Logging{Level: "debug", AccessLog: AccessLog{Enabled: true}},
+	}
+
+	merged := base.Merge(overlay)
+
+	require.Equal(t, "overlay-key", merged.Auth.SecretKey)
+	require.True(t, merged.Auth.Enabled, "zero values in the overlay keep the base value")
+	require.Equal(t, time.Hour, merged.Auth.TokenExpiry)
+	require.Equal(t, "debug", merged.Logging.Level)
+	require.Equal(t, "json", merged.Logging.Format)
+	require.True(t, merged.Logging.AccessLog.Enabled)
+
+	require.Equal(t, "base-key", base.Auth.SecretKey, "the receiver is left untouched")
+	require.Equal(t, base, base.Merge(nil))
+}
+
+func TestLoadConfig_ShippedFiles(t *testing.T) {
+	for _, env := range []string{"development", "production"} {
+		t.Run(env, func(t *testing.T) {
+			_, err := loadConfig(os.DirFS("../../config"), env)
+			require.NoError(t, err)
+		})
+	}
+}
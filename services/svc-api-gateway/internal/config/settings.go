@@ -1,8 +1,14 @@
 package config
 
 import (
+	"errors"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
+
+	"google.golang.org/grpc/codes"
 )
 
 // Compile time variables are set by -ldflags.
@@ -29,12 +35,17 @@ type (
 		Backoff               Backoff               `json:"backoff"`
 		Cache                 Cache                 `json:"cache"`
 		DevicesCache          DevicesCache          `json:"devices_cache"`
+		InMemoryCache         InMemoryCache         `json:"in_memory_cache"`
 		ThrottledRateLimiting ThrottledRateLimiting `json:"throttled_rate_limiting"`
 		Idempotency           Idempotency           `json:"idempotency"`
 		Deprecation           Deprecation           `json:"deprecation"`
+		Timeout               TimeoutConfig         `json:"timeout"`
+		CORS                  CORS                  `json:"cors"`
+		SecurityHeaders       SecurityHeaders       `json:"security_headers"`
 		Compression           Compression           `json:"compression"`
 		Logging               Logging               `json:"logging"`
 		Telemetry             Telemetry             `json:"telemetry"`
+		HealthCheck           HealthCheck           `json:"health_check"`
 	}
 
 	App struct {
@@ -69,6 +80,17 @@ type (
 		WriteTimeout    time.Duration `envconfig:"HTTP_WRITE_TIMEOUT" default:"15s" json:"write_timeout"`
 		IdleTimeout     time.Duration `envconfig:"HTTP_IDLE_TIMEOUT" default:"60s" json:"idle_timeout"`
 		ShutdownTimeout time.Duration `envconfig:"HTTP_SHUTDOWN_TIMEOUT" default:"30s" json:"shutdown_timeout"`
+		// BodyLimit is the maximum request body size in bytes; 0 disables the limit.
+		BodyLimit int64 `envconfig:"HTTP_BODY_LIMIT" default:"1048576" json:"body_limit"`
+		// BulkMaxItems is the maximum number of devices in one bulk create request; 0 disables the limit.
+		// Bulk requests count as one request per item for rate limiting, so batches larger than the
+		// rate limiting burst size are always throttled.
+		BulkMaxItems uint `envconfig:"HTTP_BULK_MAX_ITEMS" default:"20" json:"bulk_max_items"`
+		// ResponseVersion is the device response shape (v1 or v2) served to clients that do not
+		// request one with an application/vnd.devices.{version}+json Accept header.
+		ResponseVersion string `envconfig:"HTTP_RESPONSE_VERSION" default:"v1" json:"response_version"`
+		// BareResponseAllowed lets clients drop the response envelope with ?envelope=false.
+		BareResponseAllowed bool `envconfig:"HTTP_BARE_RESPONSE_ALLOWED" default:"false" json:"bare_response_allowed"`
 	}
 
 	AdminHTTPServer struct {
@@ -79,6 +101,17 @@ type (
 		WriteTimeout    time.Duration `envconfig:"ADMIN_HTTP_WRITE_TIMEOUT" default:"15s" json:"write_timeout"`
 		IdleTimeout     time.Duration `envconfig:"ADMIN_HTTP_IDLE_TIMEOUT" default:"60s" json:"idle_timeout"`
 		ShutdownTimeout time.Duration `envconfig:"ADMIN_HTTP_SHUTDOWN_TIMEOUT" default:"30s" json:"shutdown_timeout"`
+		// Token is required in the X-Admin-Token header of /admin/ requests; without it they answer 503.
+		Token     string         `envconfig:"ADMIN_HTTP_SERVER_TOKEN" default:"" json:"-"`
+		RateLimit AdminRateLimit `json:"rate_limit"`
+	}
+
+	// AdminRateLimit throttles the DELETE /admin/cache/ purge endpoints per admin token, so
+	// operator tooling purging in a loop cannot stampede the devices service.
+	AdminRateLimit struct {
+		// PurgeRPS is the sustained purge rate allowed per admin token; 0 disables the limit.
+		PurgeRPS   uint `envconfig:"ADMIN_HTTP_PURGE_RPS" default:"1" json:"purge_rps"`
+		PurgeBurst uint `envconfig:"ADMIN_HTTP_PURGE_BURST" default:"5" json:"purge_burst"`
 	}
 
 	Auth struct {
@@ -92,12 +125,26 @@ type (
 	}
 
 	DevicesGRPCClient struct {
-		Address        string               `envconfig:"DEVICES_GRPC_ADDRESS" default:"svc-devices:9090" json:"address"`
-		Timeout        time.Duration        `envconfig:"DEVICES_TIMEOUT" default:"30s" json:"timeout"`
-		MaxRetries     uint                 `envconfig:"DEVICES_MAX_RETRIES" default:"3" json:"max_retries"`
-		MaxMessageSize uint                 `envconfig:"DEVICES_MAX_MESSAGE_SIZE" default:"4194304" json:"max_message_size"`
-		CircuitBreaker CircuitBreakerConfig `json:"circuit_breaker"`
-		TLS            TLSConfig            `json:"tls"`
+		Address           string               `envconfig:"DEVICES_GRPC_ADDRESS" default:"svc-devices:9090" json:"address"`
+		Timeout           time.Duration        `envconfig:"DEVICES_TIMEOUT" default:"30s" json:"timeout"`
+		MaxRetries        uint                 `envconfig:"DEVICES_MAX_RETRIES" default:"3" json:"max_retries"`
+		RetryPolicy       RetryPolicy          `envconfig:"DEVICES_RETRY_POLICY" default:"" json:"retry_policy,omitempty"`
+		MaxMessageSize    uint                 `envconfig:"DEVICES_MAX_MESSAGE_SIZE" default:"4194304" json:"max_message_size"`
+		HealthInterval    time.Duration        `envconfig:"DEVICES_HEALTH_WATCH_INTERVAL" default:"10s" json:"health_interval"`
+		CompressTransport bool                 `envconfig:"DEVICES_COMPRESS_TRANSPORT" default:"false" json:"compress_transport"`
+		CircuitBreaker    CircuitBreakerConfig `json:"circuit_breaker"`
+		ClientLogging     ClientLogging        `json:"client_logging"`
+		TLS               TLSConfig            `json:"tls"`
+		Keepalive         GRPCKeepalive        `json:"keepalive"`
+	}
+
+	// GRPCKeepalive pings idle connections to svc-devices so broken ones are replaced
+	// before a request hits them. Time must not be lower than the server's
+	// GRPC_KEEPALIVE_MIN_TIME, otherwise the server closes the connection.
+	GRPCKeepalive struct {
+		Time                time.Duration `envconfig:"DEVICES_KEEPALIVE_TIME" default:"30s" json:"time"`
+		Timeout             time.Duration `envconfig:"DEVICES_KEEPALIVE_TIMEOUT" default:"10s" json:"timeout"`
+		PermitWithoutStream bool          `envconfig:"DEVICES_KEEPALIVE_PERMIT_WITHOUT_STREAM" default:"true" json:"permit_without_stream"`
 	}
 
 	TLSConfig struct {
@@ -106,6 +153,16 @@ type (
 		CAFile   string `envconfig:"DEVICES_TLS_CA_FILE" default:"" json:"ca_file,omitempty"`
 	}
 
+	// ClientLogging controls debug logging of the messages exchanged with svc-devices.
+	ClientLogging struct {
+		LogRequests  bool `envconfig:"DEVICES_LOG_REQUESTS" default:"false" json:"log_requests"`
+		LogResponses bool `envconfig:"DEVICES_LOG_RESPONSES" default:"false" json:"log_responses"`
+
+		// SanitizedFields lists message fields whose values are replaced with [REDACTED].
+		// Matching ignores case and underscores, so "serial_number" also matches "serialNumber".
+		SanitizedFields []string `envconfig:"DEVICES_LOG_SANITIZED_FIELDS" default:"" json:"sanitized_fields"`
+	}
+
 	CircuitBreakerConfig struct {
 		Enabled          bool          `envconfig:"DEVICES_CB_ENABLED" default:"true" json:"enabled"`
 		MaxRequests      uint          `envconfig:"DEVICES_CB_MAX_REQUESTS" default:"5" json:"max_requests"`
@@ -114,6 +171,18 @@ type (
 		FailureThreshold uint          `envconfig:"DEVICES_CB_FAILURE_THRESHOLD" default:"5" json:"failure_threshold"`
 	}
 
+	// RetryPolicy maps a gRPC status code to how calls failing with it are retried.
+	// Codes without an entry are never retried.
+	RetryPolicy map[codes.Code]RetryConfig
+
+	RetryConfig struct {
+		// MaxAttempts is the total number of attempts, including the first call.
+		MaxAttempts uint `json:"max_attempts"`
+		// Backoff shapes the delay between attempts; a zero value falls back to the
+		// service-wide Backoff settings.
+		Backoff Backoff `json:"backoff"`
+	}
+
 	Backoff struct {
 		BaseDelay  time.Duration `envconfig:"BACKOFF_BASE_DELAY" default:"1s" json:"base_delay"`
 		Multiplier float64       `envconfig:"BACKOFF_MULTIPLIER" default:"1.5" json:"multiplier"`
@@ -139,11 +208,26 @@ type (
 		Enabled              bool          `envconfig:"DEVICES_CACHE_ENABLED" default:"true" json:"enabled"`
 		HTTPCachingEnabled   bool          `envconfig:"DEVICES_CACHE_HTTP_ENABLED" default:"true" json:"http_caching_enabled"`
 		DeviceTTL            time.Duration `envconfig:"DEVICES_CACHE_DEVICE_TTL" default:"5m" json:"device_ttl"`
+		DeviceStaleTTL       time.Duration `envconfig:"DEVICES_CACHE_DEVICE_STALE_TTL" default:"10m" json:"device_stale_ttl"`
 		ListTTL              time.Duration `envconfig:"DEVICES_CACHE_LIST_TTL" default:"1m" json:"list_ttl"`
 		MaxAge               uint          `envconfig:"DEVICES_CACHE_MAX_AGE" default:"60" json:"max_age"`
 		StaleWhileRevalidate uint          `envconfig:"DEVICES_CACHE_STALE_REVALIDATE" default:"30" json:"stale_while_revalidate"`
 		ListMaxAge           uint          `envconfig:"DEVICES_CACHE_LIST_MAX_AGE" default:"30" json:"list_max_age"`
 		ListStaleRevalidate  uint          `envconfig:"DEVICES_CACHE_LIST_STALE_REVALIDATE" default:"15" json:"list_stale_while_revalidate"`
+
+		// PreloadOnStartup warms the cache with the first PreloadSize devices once the
+		// service starts, so the first reads after a deployment do not all miss.
+		PreloadOnStartup bool `envconfig:"DEVICES_CACHE_PRELOAD_ON_STARTUP" default:"false" json:"preload_on_startup"`
+		PreloadSize      uint `envconfig:"DEVICES_CACHE_PRELOAD_SIZE" default:"100" json:"preload_size"`
+	}
+
+	// InMemoryCache keeps successful GET responses of the matching paths in a
+	// process-local LRU cache, sparing KeyDB for read-heavy, slowly-changing responses.
+	InMemoryCache struct {
+		Enabled bool          `envconfig:"IN_MEMORY_CACHE_ENABLED" default:"false" json:"enabled"`
+		Size    uint          `envconfig:"IN_MEMORY_CACHE_SIZE" default:"1000" json:"size"`
+		MaxAge  time.Duration `envconfig:"IN_MEMORY_CACHE_MAX_AGE" default:"30s" json:"max_age"`
+		Paths   []string      `envconfig:"IN_MEMORY_CACHE_PATHS" default:"" json:"paths"`
 	}
 
 	ThrottledRateLimiting struct {
@@ -157,6 +241,15 @@ type (
 		MaxKeys            uint          `envconfig:"RATE_LIMITING_MAX_KEYS" default:"1000" json:"max_keys"`
 		SkipPaths          []string      `envconfig:"RATE_LIMITING_SKIP_PATHS" default:"/v1/health,/v1/liveness,/v1/readiness" json:"skip_paths"`
 		GracefulDegraded   bool          `envconfig:"RATE_LIMITING_GRACEFUL_DEGRADED" default:"true" json:"graceful_degraded"`
+
+		// EnableTenantLimiting scopes rate limit keys to the tenant claim so that
+		// tenants are throttled independently of each other.
+		EnableTenantLimiting bool `envconfig:"RATE_LIMITING_ENABLE_TENANT_LIMITING" default:"false" json:"enable_tenant_limiting"`
+
+		// AdaptiveBurst doubles the burst size for keys that have not been
+		// throttled within AdaptivePeriod.
+		AdaptiveBurst  bool          `envconfig:"RATE_LIMITING_ADAPTIVE_BURST" default:"false" json:"adaptive_burst"`
+		AdaptivePeriod time.Duration `envconfig:"RATE_LIMITING_ADAPTIVE_PERIOD" default:"1h" json:"adaptive_period"`
 	}
 
 	Idempotency struct {
@@ -169,12 +262,62 @@ type (
 		GracefulDegraded bool          `envconfig:"IDEMPOTENCY_GRACEFUL_DEGRADED" default:"true" json:"graceful_degraded"`
 	}
 
+	// HealthCheck bounds the concurrent dependency checks behind readiness and health reports.
+	HealthCheck struct {
+		AggregationTimeout time.Duration `envconfig:"HEALTH_AGGREGATION_TIMEOUT" default:"2s" json:"aggregation_timeout"`
+	}
+
 	Deprecation struct {
 		Enabled       bool   `envconfig:"API_DEPRECATION_ENABLED" default:"false" json:"enabled"`
 		SunsetDate    string `envconfig:"API_SUNSET_DATE" default:"" json:"sunset_date"`
 		SuccessorPath string `envconfig:"API_SUCCESSOR_PATH" default:"" json:"successor_path"`
 	}
 
+	// TimeoutConfig holds the per-request handler deadlines enforced by the timeout middleware.
+	TimeoutConfig struct {
+		Enabled bool `envconfig:"REQUEST_TIMEOUT_ENABLED" default:"true" json:"enabled"`
+
+		// Default is the deadline for paths without an override; 0 leaves them unbounded.
+		Default time.Duration `envconfig:"REQUEST_TIMEOUT_DEFAULT" default:"10s" json:"default"`
+
+		// Paths maps path prefixes to deadlines (e.g. "/v1/devices:5s,/v1/devices/export:30s").
+		// The longest matching prefix wins.
+		Paths map[string]time.Duration `envconfig:"REQUEST_TIMEOUT_PATHS" json:"paths"`
+	}
+
+	// CORS holds the cross-origin resource sharing policy for browser-based consumers.
+	CORS struct {
+		AllowedOrigins []string `envconfig:"CORS_ALLOWED_ORIGINS" default:"*" json:"allowed_origins"`
+		AllowedMethods []string `envconfig:"CORS_ALLOWED_METHODS" default:"GET,POST,PUT,PATCH,DELETE,OPTIONS,HEAD" json:"allowed_methods"`
+		AllowedHeaders []string `envconfig:"CORS_ALLOWED_HEADERS" default:"Authorization,Content-Type,Request-Id,Correlation-Id,API-Version,If-Match,If-None-Match,traceparent,tracestate,Idempotency-Key,PASETO-Token" json:"allowed_headers"`
+		ExposedHeaders []string `envconfig:"CORS_EXPOSED_HEADERS" default:"Request-Id,Correlation-Id,RateLimit-Limit,RateLimit-Remaining,RateLimit-Reset,RateLimit-Policy,ETag,Location,traceparent" json:"exposed_headers"`
+
+		// AllowCredentials cannot be combined with a wildcard origin.
+		AllowCredentials bool `envconfig:"CORS_ALLOW_CREDENTIALS" default:"false" json:"allow_credentials"`
+
+		// MaxAge is the preflight cache lifetime in seconds; 0 omits the header.
+		MaxAge uint `envconfig:"CORS_MAX_AGE" default:"86400" json:"max_age"`
+	}
+
+	// SecurityHeaders holds the hardening headers added to every response.
+	// An empty value omits the corresponding header.
+	SecurityHeaders struct {
+		ContentTypeOptions    string `envconfig:"SECURITY_HEADERS_CONTENT_TYPE_OPTIONS" default:"nosniff" json:"content_type_options"`
+		FrameOptions          string `envconfig:"SECURITY_HEADERS_FRAME_OPTIONS" default:"DENY" json:"frame_options"`
+		XSSProtection         string `envconfig:"SECURITY_HEADERS_XSS_PROTECTION" default:"1; mode=block" json:"xss_protection"`
+		ReferrerPolicy        string `envconfig:"SECURITY_HEADERS_REFERRER_POLICY" default:"strict-origin-when-cross-origin" json:"referrer_policy"`
+		ContentSecurityPolicy string `envconfig:"SECURITY_HEADERS_CONTENT_SECURITY_POLICY" default:"default-src 'self'; connect-src 'self'" json:"content_security_policy"`
+		PermissionsPolicy     string `envconfig:"SECURITY_HEADERS_PERMISSIONS_POLICY" default:"camera=(), microphone=(), geolocation=()" json:"permissions_policy"`
+
+		// HSTSMaxAge is the Strict-Transport-Security max-age in seconds; 0 omits the header.
+		HSTSMaxAge            uint `envconfig:"SECURITY_HEADERS_HSTS_MAX_AGE" default:"31536000" json:"hsts_max_age"`
+		HSTSIncludeSubDomains bool `envconfig:"SECURITY_HEADERS_HSTS_INCLUDE_SUBDOMAINS" default:"true" json:"hsts_include_subdomains"`
+
+		// SkipPaths lists URL path patterns that receive no security headers.
+		// Supports the same glob syntax as Compression.SkipPaths.
+		SkipPaths []string `envconfig:"SECURITY_HEADERS_SKIP_PATHS" default:"" json:"skip_paths"`
+	}
+
 	// Compression holds the configuration for HTTP response compression middleware.
 	Compression struct {
 		// Enabled controls whether compression middleware is active.
@@ -195,13 +338,24 @@ type (
 		// If empty, uses sensible defaults for text-based types.
 		ContentTypes []string `envconfig:"COMPRESSION_CONTENT_TYPES" json:"content_types"`
 
-		// SkipPaths lists URL paths that should skip compression.
-		// Useful for health checks or binary endpoints.
+		// SkipPaths lists URL path patterns that should skip compression.
+		// Useful for health checks or binary endpoints. Entries are globs where
+		// "*" matches one segment and "**" any number of segments; entries
+		// without wildcards are matched as prefixes.
 		SkipPaths []string `envconfig:"COMPRESSION_SKIP_PATHS" default:"/v1/health,/v1/liveness,/v1/readiness" json:"skip_paths"`
 
 		// GracefulDegraded when true serves uncompressed on errors.
 		// When false, returns 500 on compression failures.
 		GracefulDegraded bool `envconfig:"COMPRESSION_GRACEFUL_DEGRADED" default:"true" json:"graceful_degraded"`
+
+		// StreamingMode when true compresses eligible responses immediately instead of
+		// buffering up to MinSize, and flushes the encoder on every Flush call.
+		// Required for Server-Sent Events and other chunked streaming endpoints.
+		StreamingMode bool `envconfig:"COMPRESSION_STREAMING_MODE" default:"false" json:"streaming_mode"`
+
+		// CPUThresholdPercent disables compression while system CPU utilisation
+		// is above this percentage (0-100). Zero disables the check.
+		CPUThresholdPercent float64 `envconfig:"COMPRESSION_CPU_THRESHOLD_PERCENT" default:"0" json:"cpu_threshold_percent"`
 	}
 
 	Logging struct {
@@ -214,6 +368,11 @@ type (
 		Enabled            bool `envconfig:"ACCESS_LOG_ENABLED" default:"true" json:"enabled"`
 		LogHealthChecks    bool `envconfig:"ACCESS_LOG_HEALTH_CHECKS" default:"false" json:"log_health_checks"`
 		IncludeQueryParams bool `envconfig:"ACCESS_LOG_INCLUDE_QUERY_PARAMS" default:"true" json:"include_query_params"`
+		IncludeHeaders     bool `envconfig:"ACCESS_LOG_INCLUDE_HEADERS" default:"false" json:"include_headers"`
+
+		// RedactHeaders lists request and response headers whose values are replaced
+		// with [REDACTED] when IncludeHeaders is enabled. Matching is case-insensitive.
+		RedactHeaders []string `envconfig:"ACCESS_LOG_REDACT_HEADERS" default:"Authorization,Cookie,Set-Cookie,PASETO-Token,API-Key" json:"redact_headers"`
 	}
 
 	Telemetry struct {
@@ -232,6 +391,10 @@ type (
 
 	Metrics struct {
 		Enabled bool `envconfig:"METRICS_ENABLED" default:"false" json:"enabled"`
+
+		// MaxLabelCardinality caps the distinct method and path label values of each
+		// HTTP metric; later values are exported as "_overflow". Zero disables the cap.
+		MaxLabelCardinality uint `envconfig:"METRICS_MAX_LABEL_CARDINALITY" default:"500" json:"max_label_cardinality"`
 	}
 
 	Traces struct {
@@ -257,6 +420,157 @@ func (c *ServiceConfig) IsProduction() bool {
 	return c.GetEnvironment() == Production
 }
 
+// Validate checks every sub-config and returns all problems found, so a
+// misconfigured service can report them together before it starts.
+func (c *ServiceConfig) Validate() []error {
+	validators := []interface{ Validate() error }{
+		&c.Auth,
+		&c.DevicesGRPCClient,
+		&c.Cache,
+		&c.InMemoryCache,
+		&c.ThrottledRateLimiting,
+		&c.Idempotency,
+		&c.CORS,
+		&c.Compression,
+	}
+
+	var errs []error
+
+	for _, v := range validators {
+		if err := v.Validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errs
+}
+
+// Validate validates the Auth configuration.
+func (c *Auth) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if c.SecretKey == "" {
+		return errors.New("auth secret_key must be set when auth is enabled")
+	}
+
+	if c.TokenExpiry <= 0 {
+		return fmt.Errorf("auth token_expiry must be positive, got %s", c.TokenExpiry)
+	}
+
+	return nil
+}
+
+// Validate validates the DevicesGRPCClient configuration.
+func (c *DevicesGRPCClient) Validate() error {
+	if c.Address == "" {
+		return errors.New("devices grpc address must be set")
+	}
+
+	if c.Timeout <= 0 {
+		return fmt.Errorf("devices grpc timeout must be positive, got %s", c.Timeout)
+	}
+
+	if c.MaxMessageSize == 0 {
+		return errors.New("devices grpc max_message_size must be positive")
+	}
+
+	if c.CircuitBreaker.Enabled && c.CircuitBreaker.FailureThreshold == 0 {
+		return errors.New("devices grpc circuit_breaker failure_threshold must be positive when the breaker is enabled")
+	}
+
+	return nil
+}
+
+// Validate validates the Cache configuration.
+func (c *Cache) Validate() error {
+	if c.Address == "" {
+		return errors.New("cache address must be set")
+	}
+
+	if c.PoolSize == 0 {
+		return errors.New("cache pool_size must be positive")
+	}
+
+	if c.MinIdleConns > c.PoolSize {
+		return fmt.Errorf("cache min_idle_conns must not exceed pool_size %d, got %d", c.PoolSize, c.MinIdleConns)
+	}
+
+	if c.DefaultExpiry <= 0 {
+		return fmt.Errorf("cache default_expiry must be positive, got %s", c.DefaultExpiry)
+	}
+
+	return nil
+}
+
+// Validate validates the InMemoryCache configuration.
+func (c *InMemoryCache) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if c.Size == 0 {
+		return errors.New("in-memory cache size must be positive")
+	}
+
+	if c.MaxAge <= 0 {
+		return fmt.Errorf("in-memory cache max_age must be positive, got %s", c.MaxAge)
+	}
+
+	if len(c.Paths) == 0 {
+		return errors.New("in-memory cache paths must list at least one path when the cache is enabled")
+	}
+
+	return nil
+}
+
+// Validate validates the ThrottledRateLimiting configuration.
+func (c *ThrottledRateLimiting) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if c.RequestsPerSecond == 0 {
+		return errors.New("rate limiting requests_per_second must be positive")
+	}
+
+	if c.WindowDuration <= 0 {
+		return fmt.Errorf("rate limiting window_duration must be positive, got %s", c.WindowDuration)
+	}
+
+	if c.AdaptiveBurst && c.AdaptivePeriod <= 0 {
+		return fmt.Errorf("rate limiting adaptive_period must be positive when adaptive_burst is enabled, got %s", c.AdaptivePeriod)
+	}
+
+	if c.AdaptiveBurst && c.MaxKeys == 0 {
+		return errors.New("rate limiting max_keys must be positive when adaptive_burst is enabled")
+	}
+
+	return nil
+}
+
+// Validate validates the Idempotency configuration.
+func (c *Idempotency) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if c.HeaderName == "" {
+		return errors.New("idempotency header_name must be set")
+	}
+
+	if c.CacheTTL <= 0 {
+		return fmt.Errorf("idempotency cache_ttl must be positive, got %s", c.CacheTTL)
+	}
+
+	if c.LockTTL <= 0 {
+		return fmt.Errorf("idempotency lock_ttl must be positive, got %s", c.LockTTL)
+	}
+
+	return nil
+}
+
 // Validate validates the Compression configuration.
 func (c *Compression) Validate() error {
 	if c.Level < 1 || c.Level > 9 {
@@ -267,5 +581,53 @@ func (c *Compression) Validate() error {
 		return fmt.Errorf("compression min_size must be non-negative, got %d", c.MinSize)
 	}
 
+	if c.CPUThresholdPercent < 0 || c.CPUThresholdPercent > 100 {
+		return fmt.Errorf("compression cpu_threshold_percent must be between 0 and 100, got %.2f", c.CPUThresholdPercent)
+	}
+
+	return nil
+}
+
+// Validate validates the CORS configuration.
+func (c *CORS) Validate() error {
+	if c.AllowCredentials && slices.Contains(c.AllowedOrigins, "*") {
+		return errors.New("cors allowed_origins must not contain a wildcard when allow_credentials is enabled")
+	}
+
+	return nil
+}
+
+// Decode parses a retry policy from a comma-separated list of CODE:MAX_ATTEMPTS
+// pairs, e.g. "UNAVAILABLE:3,ABORTED:2". Code names follow the gRPC status code
+// names and are case-insensitive.
+func (p *RetryPolicy) Decode(value string) error {
+	policy := make(RetryPolicy)
+
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		name, attempts, found := strings.Cut(entry, ":")
+		if !found {
+			return fmt.Errorf("retry policy entry %q must be in CODE:MAX_ATTEMPTS form", entry)
+		}
+
+		var code codes.Code
+		if err := code.UnmarshalJSON([]byte(strconv.Quote(strings.ToUpper(strings.TrimSpace(name))))); err != nil {
+			return fmt.Errorf("retry policy entry %q: %w", entry, err)
+		}
+
+		maxAttempts, err := strconv.ParseUint(strings.TrimSpace(attempts), 10, 32)
+		if err != nil {
+			return fmt.Errorf("retry policy entry %q: invalid max attempts: %w", entry, err)
+		}
+
+		policy[code] = RetryConfig{MaxAttempts: uint(maxAttempts)}
+	}
+
+	*p = policy
+
 	return nil
 }
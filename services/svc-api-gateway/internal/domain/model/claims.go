@@ -10,6 +10,7 @@ type PasetoClaims struct {
 	IssuedAt   time.Time `json:"iat"`
 	NotBefore  time.Time `json:"nbf"`
 	TokenID    string    `json:"jti"`
+	TenantID   string    `json:"tenant_id,omitempty"`
 	Roles      []string  `json:"roles,omitempty"`
 }
 
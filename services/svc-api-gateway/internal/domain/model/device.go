@@ -1,11 +1,17 @@
 package model
 
 import (
+	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+// urnUUIDPrefix is the RFC 4122 URN namespace some external systems prepend to IDs.
+const urnUUIDPrefix = "urn:uuid:"
+
 type DeviceID struct {
 	uuid.UUID
 }
@@ -14,7 +20,12 @@ func NewDeviceID() DeviceID {
 	return DeviceID{UUID: uuid.Must(uuid.NewV7())}
 }
 
+// ParseDeviceID parses a plain UUID or one in URN form (urn:uuid:...), ignoring case.
 func ParseDeviceID(s string) (DeviceID, error) {
+	if len(s) > len(urnUUIDPrefix) && strings.EqualFold(s[:len(urnUUIDPrefix)], urnUUIDPrefix) {
+		s = s[len(urnUUIDPrefix):]
+	}
+
 	id, err := uuid.Parse(s)
 	if err != nil {
 		return DeviceID{}, err
@@ -31,6 +42,23 @@ func (d DeviceID) IsZero() bool {
 	return d.UUID == uuid.Nil
 }
 
+// MarshalText implements encoding.TextMarshaler using the canonical UUID form.
+func (d DeviceID) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, accepting anything ParseDeviceID does.
+func (d *DeviceID) UnmarshalText(text []byte) error {
+	id, err := ParseDeviceID(string(text))
+	if err != nil {
+		return err
+	}
+
+	*d = id
+
+	return nil
+}
+
 type Device struct {
 	ID        DeviceID
 	Name      string
@@ -74,14 +102,45 @@ func (d *Device) Update(name, brand string, state State) error {
 	return nil
 }
 
+// TransitionTo moves the device to the target state when the state machine allows it.
+func (d *Device) TransitionTo(state State) error {
+	if !d.State.CanTransitionTo(state) {
+		return ErrInvalidStateTransition.WithCause(fmt.Errorf("cannot transition device from %s to %s", d.State, state))
+	}
+
+	d.State = state
+	d.UpdatedAt = time.Now().UTC()
+
+	return nil
+}
+
+// Clone returns a deep copy of the device, so callers holding the original can
+// mutate it without affecting the copy and vice versa. A nil device clones to nil.
+func (d *Device) Clone() *Device {
+	if d == nil {
+		return nil
+	}
+
+	clone := *d
+
+	return &clone
+}
+
+// MaxPageSize is the largest page a device list may request.
+const MaxPageSize uint = 500
+
+// SortableFields lists the fields a device list may be sorted by, each optionally
+// prefixed with "-" for descending order.
+var SortableFields = []string{"id", "name", "brand", "state", "createdAt", "updatedAt"}
+
 type DeviceFilter struct {
 	Keyword string
 	Brands  []string
 	States  []State
-	Sort    []string
-	Page    uint
-	Size    uint
-	Cursor  string
+	Page    uint     `validate:"min=1"`
+	Size    uint     `validate:"pagesize"`
+	Sort    []string `validate:"dive,devicesort"`
+	Cursor  string   `validate:"pagecursor"`
 }
 
 func DefaultDeviceFilter() DeviceFilter {
@@ -92,6 +151,12 @@ func DefaultDeviceFilter() DeviceFilter {
 	}
 }
 
+// Validate reports every out-of-range or unsupported field in the filter, so callers
+// can reject the request instead of silently clamping it.
+func (f *DeviceFilter) Validate() error {
+	return ValidateStruct(f)
+}
+
 type Pagination struct {
 	Page           uint
 	Size           uint
@@ -108,3 +173,29 @@ type DeviceList struct {
 	Pagination Pagination
 	Filters    DeviceFilter
 }
+
+// Clone returns a deep copy of the list, including every device and the filter slices.
+// A nil list clones to nil.
+func (l *DeviceList) Clone() *DeviceList {
+	if l == nil {
+		return nil
+	}
+
+	clone := &DeviceList{
+		Pagination: l.Pagination,
+		Filters:    l.Filters,
+	}
+
+	if l.Devices != nil {
+		clone.Devices = make([]*Device, len(l.Devices))
+		for index, device := range l.Devices {
+			clone.Devices[index] = device.Clone()
+		}
+	}
+
+	clone.Filters.Brands = slices.Clone(l.Filters.Brands)
+	clone.Filters.States = slices.Clone(l.Filters.States)
+	clone.Filters.Sort = slices.Clone(l.Filters.Sort)
+
+	return clone
+}
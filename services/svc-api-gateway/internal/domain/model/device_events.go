@@ -0,0 +1,33 @@
+package model
+
+// Device event types, as returned by EventType.
+const (
+	DeviceCreatedEventType = "device.created"
+	DeviceUpdatedEventType = "device.updated"
+	DeviceDeletedEventType = "device.deleted"
+)
+
+type (
+	// DeviceCreatedEvent is published once a device has been created.
+	DeviceCreatedEvent struct {
+		Device *Device
+	}
+
+	// DeviceUpdatedEvent is published once any field of a device, its state
+	// included, has been changed.
+	DeviceUpdatedEvent struct {
+		ID     DeviceID
+		Device *Device
+	}
+
+	// DeviceDeletedEvent is published once a device has been deleted.
+	DeviceDeletedEvent struct {
+		ID DeviceID
+	}
+)
+
+func (DeviceCreatedEvent) EventType() string { return DeviceCreatedEventType }
+
+func (DeviceUpdatedEvent) EventType() string { return DeviceUpdatedEventType }
+
+func (DeviceDeletedEvent) EventType() string { return DeviceDeletedEventType }
@@ -1,9 +1,12 @@
 package model_test
 
 import (
+	"encoding/json"
+	"fmt"
 	"testing"
 	"time"
 
+	"github.com/architeacher/devices/pkg/cursor"
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/domain/model"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -31,20 +34,46 @@ func (s *DeviceTestSuite) TestParseDeviceID() {
 	s.T().Parallel()
 
 	cases := []struct {
-		name    string
-		input   string
-		wantErr bool
+		name     string
+		input    string
+		expected string
+		wantErr  bool
 	}{
 		{
-			name:    "valid UUID",
-			input:   "01234567-89ab-cdef-0123-456789abcdef",
-			wantErr: false,
+			name:     "valid UUID",
+			input:    "01234567-89ab-cdef-0123-456789abcdef",
+			expected: "01234567-89ab-cdef-0123-456789abcdef",
+		},
+		{
+			name:     "URN-prefixed UUID",
+			input:    "urn:uuid:01234567-89ab-cdef-0123-456789abcdef",
+			expected: "01234567-89ab-cdef-0123-456789abcdef",
+		},
+		{
+			name:     "uppercase URN-prefixed UUID",
+			input:    "URN:UUID:01234567-89AB-CDEF-0123-456789ABCDEF",
+			expected: "01234567-89ab-cdef-0123-456789abcdef",
+		},
+		{
+			name:     "uppercase UUID",
+			input:    "01234567-89AB-CDEF-0123-456789ABCDEF",
+			expected: "01234567-89ab-cdef-0123-456789abcdef",
+		},
+		{
+			name:     "nil UUID",
+			input:    "00000000-0000-0000-0000-000000000000",
+			expected: "00000000-0000-0000-0000-000000000000",
 		},
 		{
 			name:    "invalid UUID",
 			input:   "invalid",
 			wantErr: true,
 		},
+		{
+			name:    "URN prefix without UUID",
+			input:   "urn:uuid:invalid",
+			wantErr: true,
+		},
 		{
 			name:    "empty string",
 			input:   "",
@@ -63,11 +92,43 @@ func (s *DeviceTestSuite) TestParseDeviceID() {
 			}
 
 			s.Require().NoError(err)
-			s.Require().Equal(tc.input, id.String())
+			s.Require().Equal(tc.expected, id.String())
 		})
 	}
 }
 
+func (s *DeviceTestSuite) TestDeviceID_TextMarshaling() {
+	s.T().Parallel()
+
+	type payload struct {
+		ID model.DeviceID `json:"id"`
+	}
+
+	s.Run("marshals to canonical UUID", func() {
+		id, err := model.ParseDeviceID("urn:uuid:01234567-89ab-cdef-0123-456789abcdef")
+		s.Require().NoError(err)
+
+		data, err := json.Marshal(payload{ID: id})
+		s.Require().NoError(err)
+		s.Require().JSONEq(`{"id":"01234567-89ab-cdef-0123-456789abcdef"}`, string(data))
+	})
+
+	s.Run("unmarshals URN-prefixed UUID", func() {
+		var p payload
+
+		err := json.Unmarshal([]byte(`{"id":"urn:uuid:01234567-89ab-cdef-0123-456789abcdef"}`), &p)
+		s.Require().NoError(err)
+		s.Require().Equal("01234567-89ab-cdef-0123-456789abcdef", p.ID.String())
+	})
+
+	s.Run("rejects invalid UUID", func() {
+		var id model.DeviceID
+
+		s.Require().Error(id.UnmarshalText([]byte("invalid")))
+		s.Require().True(id.IsZero())
+	})
+}
+
 func (s *DeviceTestSuite) TestDeviceID_IsZero() {
 	s.T().Parallel()
 
@@ -100,6 +161,172 @@ func (s *DeviceTestSuite) TestNewDevice() {
 	s.Require().False(device.UpdatedAt.IsZero())
 }
 
+func (s *DeviceTestSuite) TestDeviceFilter_Validate() {
+	s.T().Parallel()
+
+	s.Run("default filter is valid", func() {
+		filter := model.DefaultDeviceFilter()
+
+		s.Require().NoError(filter.Validate())
+	})
+
+	s.Run("every sortable field is valid in both directions", func() {
+		filter := model.DefaultDeviceFilter()
+		filter.Size = model.MaxPageSize
+		filter.Sort = []string{"-id", "name", "-brand", "state", "-createdAt", "updatedAt"}
+
+		s.Require().NoError(filter.Validate())
+	})
+
+	s.Run("cursor issued by svc-devices is valid", func() {
+		token, err := cursor.NewCursor([]byte("svc-devices-secret")).EncodeValue(map[string]string{"f": "-createdAt"})
+		s.Require().NoError(err)
+
+		filter := model.DefaultDeviceFilter()
+		filter.Cursor = token
+
+		s.Require().NoError(filter.Validate())
+	})
+
+	cases := []struct {
+		name          string
+		mutate        func(*model.DeviceFilter)
+		expectedField string
+		expectedCode  string
+	}{
+		{
+			name:          "page zero",
+			mutate:        func(f *model.DeviceFilter) { f.Page = 0 },
+			expectedField: "page",
+			expectedCode:  model.ValidationCodeOutOfRange,
+		},
+		{
+			name:          "size zero",
+			mutate:        func(f *model.DeviceFilter) { f.Size = 0 },
+			expectedField: "size",
+			expectedCode:  model.ValidationCodeOutOfRange,
+		},
+		{
+			name:          "size above maximum",
+			mutate:        func(f *model.DeviceFilter) { f.Size = model.MaxPageSize + 1 },
+			expectedField: "size",
+			expectedCode:  model.ValidationCodeOutOfRange,
+		},
+		{
+			name:          "unsupported sort field",
+			mutate:        func(f *model.DeviceFilter) { f.Sort = []string{"-createdAt", "price"} },
+			expectedField: "sort",
+			expectedCode:  model.ValidationCodeInvalidEnum,
+		},
+		{
+			name:          "malformed cursor",
+			mutate:        func(f *model.DeviceFilter) { f.Cursor = "not a cursor" },
+			expectedField: "cursor",
+			expectedCode:  model.ValidationCodeInvalid,
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			filter := model.DefaultDeviceFilter()
+			tc.mutate(&filter)
+
+			var validationErrs *model.ValidationErrors
+			s.Require().ErrorAs(filter.Validate(), &validationErrs)
+			s.Require().Len(validationErrs.Errors, 1)
+			s.Require().Equal(tc.expectedField, validationErrs.Errors[0].Field)
+			s.Require().Equal(tc.expectedCode, validationErrs.Errors[0].Code)
+		})
+	}
+}
+
+func (s *DeviceTestSuite) TestDevice_Clone() {
+	s.T().Parallel()
+
+	s.Run("clone is independent of the original", func() {
+		original := model.NewDevice("iPhone", "Apple", model.StateAvailable)
+
+		clone := original.Clone()
+		s.Require().Equal(original, clone)
+		s.Require().NotSame(original, clone)
+
+		original.Name = "Pixel"
+		original.State = model.StateInUse
+		original.UpdatedAt = original.UpdatedAt.Add(time.Hour)
+
+		s.Require().Equal("iPhone", clone.Name)
+		s.Require().Equal(model.StateAvailable, clone.State)
+		s.Require().Equal(clone.CreatedAt, clone.UpdatedAt)
+
+		clone.Brand = "Google"
+		s.Require().Equal("Apple", original.Brand)
+	})
+
+	s.Run("nil device clones to nil", func() {
+		var device *model.Device
+
+		s.Require().Nil(device.Clone())
+	})
+}
+
+func (s *DeviceTestSuite) TestDeviceList_Clone() {
+	s.T().Parallel()
+
+	newList := func() *model.DeviceList {
+		return &model.DeviceList{
+			Devices: []*model.Device{
+				model.NewDevice("iPhone", "Apple", model.StateAvailable),
+				model.NewDevice("Pixel", "Google", model.StateInUse),
+			},
+			Pagination: model.Pagination{Page: 1, Size: 20, TotalItems: 2, TotalPages: 1},
+			Filters: model.DeviceFilter{
+				Brands: []string{"Apple", "Google"},
+				States: []model.State{model.StateAvailable},
+				Sort:   []string{"-createdAt"},
+			},
+		}
+	}
+
+	s.Run("mutating the original does not affect the clone", func() {
+		original := newList()
+		clone := original.Clone()
+		s.Require().Equal(original, clone)
+
+		original.Devices[0].Name = "Changed"
+		original.Devices[1] = model.NewDevice("Galaxy", "Samsung", model.StateInactive)
+		original.Devices = append(original.Devices, model.NewDevice("Nokia", "Nokia", model.StateAvailable))
+		original.Pagination.TotalItems = 3
+		original.Filters.Brands[0] = "Changed"
+		original.Filters.States[0] = model.StateInactive
+		original.Filters.Sort[0] = "name"
+
+		s.Require().Len(clone.Devices, 2)
+		s.Require().Equal("iPhone", clone.Devices[0].Name)
+		s.Require().Equal("Pixel", clone.Devices[1].Name)
+		s.Require().Equal(uint(2), clone.Pagination.TotalItems)
+		s.Require().Equal([]string{"Apple", "Google"}, clone.Filters.Brands)
+		s.Require().Equal([]model.State{model.StateAvailable}, clone.Filters.States)
+		s.Require().Equal([]string{"-createdAt"}, clone.Filters.Sort)
+	})
+
+	s.Run("mutating the clone does not affect the original", func() {
+		original := newList()
+		clone := original.Clone()
+
+		clone.Devices[0].State = model.StateInactive
+		clone.Filters.Brands[1] = "Changed"
+
+		s.Require().Equal(model.StateAvailable, original.Devices[0].State)
+		s.Require().Equal("Google", original.Filters.Brands[1])
+	})
+
+	s.Run("nil list clones to nil", func() {
+		var list *model.DeviceList
+
+		s.Require().Nil(list.Clone())
+	})
+}
+
 func (s *DeviceTestSuite) TestDevice_CanUpdateNameAndBrand() {
 	s.T().Parallel()
 
@@ -222,6 +449,28 @@ func (s *DeviceTestSuite) TestDefaultDeviceFilter() {
 	s.Require().Empty(filter.States)
 }
 
+func (s *DeviceTestSuite) TestDevice_TransitionTo() {
+	s.T().Parallel()
+
+	s.Run("allowed transition updates state", func() {
+		device := model.NewDevice("iPhone", "Apple", model.StateAvailable)
+		updatedAt := device.UpdatedAt
+
+		s.Require().NoError(device.TransitionTo(model.StateInUse))
+		s.Require().Equal(model.StateInUse, device.State)
+		s.Require().False(device.UpdatedAt.Before(updatedAt))
+	})
+
+	s.Run("rejected transition keeps state", func() {
+		device := model.NewDevice("iPhone", "Apple", model.StateInUse)
+
+		err := device.TransitionTo(model.StateInactive)
+		s.Require().ErrorIs(err, model.ErrInvalidStateTransition)
+		s.Require().EqualError(err, "invalid state transition: cannot transition device from in-use to inactive")
+		s.Require().Equal(model.StateInUse, device.State)
+	})
+}
+
 type StateTestSuite struct {
 	suite.Suite
 }
@@ -340,6 +589,34 @@ func (s *StateTestSuite) TestAllStates() {
 	s.Require().Contains(states, model.StateInactive)
 }
 
+func (s *StateTestSuite) TestState_CanTransitionTo() {
+	s.T().Parallel()
+
+	cases := []struct {
+		from     model.State
+		to       model.State
+		expected bool
+	}{
+		{model.StateAvailable, model.StateInUse, true},
+		{model.StateAvailable, model.StateInactive, true},
+		{model.StateInUse, model.StateAvailable, true},
+		{model.StateInactive, model.StateAvailable, true},
+		{model.StateAvailable, model.StateAvailable, false},
+		{model.StateInUse, model.StateInUse, false},
+		{model.StateInUse, model.StateInactive, false},
+		{model.StateInactive, model.StateInactive, false},
+		{model.StateInactive, model.StateInUse, false},
+		{model.StateAvailable, model.State("invalid"), false},
+		{model.State("invalid"), model.StateAvailable, false},
+	}
+
+	for _, tc := range cases {
+		s.Run(fmt.Sprintf("%s to %s", tc.from, tc.to), func() {
+			s.Require().Equal(tc.expected, tc.from.CanTransitionTo(tc.to))
+		})
+	}
+}
+
 func TestDeviceID_Parallel(t *testing.T) {
 	t.Parallel()
 
@@ -1,13 +1,103 @@
 package model
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/architeacher/devices/pkg/validator"
+	"google.golang.org/grpc/codes"
+)
+
+// Codes carried by DomainError, as returned in API error responses.
+const (
+	ErrorCodeNotFound           = "NOT_FOUND"
+	ErrorCodeConflict           = "CONFLICT"
+	ErrorCodeServiceUnavailable = "SERVICE_UNAVAILABLE"
+	ErrorCodeTimeout            = "TIMEOUT"
+)
 
 var (
-	ErrDeviceNotFound          = errors.New("device not found")
-	ErrCannotUpdateInUseDevice = errors.New("cannot update name or brand of in-use device")
-	ErrCannotDeleteInUseDevice = errors.New("cannot delete in-use device")
-	ErrServiceUnavailable      = errors.New("service unavailable")
-	ErrTimeout                 = errors.New("request timeout")
+	ErrDeviceNotFound          = newDomainError(ErrorCodeNotFound, http.StatusNotFound, codes.NotFound, "device not found")
+	ErrDeviceAlreadyExists     = newDomainError(ErrorCodeConflict, http.StatusConflict, codes.AlreadyExists, "device already exists")
+	ErrCannotUpdateInUseDevice = newDomainError(ErrorCodeConflict, http.StatusConflict, codes.FailedPrecondition, "cannot update name or brand of in-use device")
+	ErrCannotDeleteInUseDevice = newDomainError(ErrorCodeConflict, http.StatusConflict, codes.FailedPrecondition, "cannot delete in-use device")
+	ErrInvalidStateTransition  = newDomainError(ErrorCodeConflict, http.StatusConflict, codes.FailedPrecondition, "invalid state transition")
+	ErrDeviceModified          = newDomainError(ErrorCodeConflict, http.StatusConflict, codes.FailedPrecondition, "device was modified concurrently")
+	ErrServiceUnavailable      = newDomainError(ErrorCodeServiceUnavailable, http.StatusServiceUnavailable, codes.Unavailable, "service unavailable")
+	ErrTimeout                 = newDomainError(ErrorCodeTimeout, http.StatusGatewayTimeout, codes.DeadlineExceeded, "request timeout")
+)
+
+// DomainError is a domain failure carrying the HTTP status and gRPC code it is answered
+// with, so transports map it without knowing every error.
+type DomainError struct {
+	Code       string
+	HTTPStatus int
+	GRPCCode   codes.Code
+	Message    string
+	Cause      error
+
+	// kind is the sentinel a copy made by WithCause was derived from.
+	kind *DomainError
+}
+
+func newDomainError(code string, httpStatus int, grpcCode codes.Code, message string) *DomainError {
+	return &DomainError{Code: code, HTTPStatus: httpStatus, GRPCCode: grpcCode, Message: message}
+}
+
+func (e *DomainError) Error() string {
+	if e.Cause == nil {
+		return e.Message
+	}
+
+	return e.Message + ": " + e.Cause.Error()
+}
+
+func (e *DomainError) Unwrap() error {
+	return e.Cause
+}
+
+// Is reports whether target is the sentinel e is, or was derived from.
+func (e *DomainError) Is(target error) bool {
+	sentinel, ok := target.(*DomainError)
+
+	return ok && sentinel.sentinel() == e.sentinel()
+}
+
+// WithCause returns a copy of e wrapping cause, which still matches e with errors.Is.
+func (e *DomainError) WithCause(cause error) *DomainError {
+	wrapped := *e
+	wrapped.Cause = cause
+	wrapped.kind = e.sentinel()
+
+	return &wrapped
+}
+
+func (e *DomainError) sentinel() *DomainError {
+	if e.kind != nil {
+		return e.kind
+	}
+
+	return e
+}
+
+// IsDomainError returns the first DomainError in err's chain.
+func IsDomainError(err error) (*DomainError, bool) {
+	var domainErr *DomainError
+	if errors.As(err, &domainErr) {
+		return domainErr, true
+	}
+
+	return nil, false
+}
+
+// Machine-readable codes attached to validation errors.
+const (
+	ValidationCodeRequired    = "REQUIRED"
+	ValidationCodeOutOfRange  = "OUT_OF_RANGE"
+	ValidationCodeInvalidEnum = "INVALID_ENUM_VALUE"
+	ValidationCodeInvalid     = "INVALID_VALUE"
 )
 
 type ValidationError struct {
@@ -39,3 +129,52 @@ func (v *ValidationErrors) Add(field, message, code string) {
 func (v *ValidationErrors) HasErrors() bool {
 	return len(v.Errors) > 0
 }
+
+// Validate tag aliases built from the model, which struct tag literals cannot reference.
+const (
+	tagPageSize   = "pagesize"
+	tagDeviceSort = "devicesort"
+)
+
+// structValidator checks validate tags against the model's states, page size limit and
+// sortable fields. Cursors are only checked for shape, as svc-devices holds the secret
+// they are signed with.
+var structValidator = validator.New(
+	validator.WithDeviceStates(stateNames()...),
+	validator.WithAlias(tagPageSize, fmt.Sprintf("min=1,max=%d", MaxPageSize)),
+	validator.WithAlias(tagDeviceSort, validator.TagSortField+"="+strings.Join(SortableFields, " ")),
+)
+
+// ValidateStruct checks the validate tags of s, returning *ValidationErrors listing every
+// invalid field, reported under its name without any slice index.
+func ValidateStruct(s any) error {
+	fieldErrs, err := structValidator.Validate(s)
+	if err != nil {
+		return err
+	}
+
+	if len(fieldErrs) == 0 {
+		return nil
+	}
+
+	errs := &ValidationErrors{}
+	for _, fieldErr := range fieldErrs {
+		errs.Add(fieldErr.BaseField(), fieldErr.Message, validationCode(fieldErr.Tag))
+	}
+
+	return errs
+}
+
+// validationCode maps a failed validation tag to its machine-readable code.
+func validationCode(tag string) string {
+	switch tag {
+	case "required", validator.TagNotBlank:
+		return ValidationCodeRequired
+	case "min", "max", "gte", "lte", "len":
+		return ValidationCodeOutOfRange
+	case "oneof", validator.TagDeviceState, validator.TagSortField:
+		return ValidationCodeInvalidEnum
+	default:
+		return ValidationCodeInvalid
+	}
+}
@@ -0,0 +1,113 @@
+package model_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/domain/model"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+)
+
+type DomainErrorTestSuite struct {
+	suite.Suite
+}
+
+func TestDomainErrorTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(DomainErrorTestSuite))
+}
+
+func (s *DomainErrorTestSuite) TestSentinelCodes() {
+	s.T().Parallel()
+
+	cases := []struct {
+		name       string
+		err        *model.DomainError
+		code       string
+		httpStatus int
+		grpcCode   codes.Code
+	}{
+		{"device not found", model.ErrDeviceNotFound, model.ErrorCodeNotFound, http.StatusNotFound, codes.NotFound},
+		{"device already exists", model.ErrDeviceAlreadyExists, model.ErrorCodeConflict, http.StatusConflict, codes.AlreadyExists},
+		{"cannot update in-use device", model.ErrCannotUpdateInUseDevice, model.ErrorCodeConflict, http.StatusConflict, codes.FailedPrecondition},
+		{"cannot delete in-use device", model.ErrCannotDeleteInUseDevice, model.ErrorCodeConflict, http.StatusConflict, codes.FailedPrecondition},
+		{"invalid state transition", model.ErrInvalidStateTransition, model.ErrorCodeConflict, http.StatusConflict, codes.FailedPrecondition},
+		{"device modified", model.ErrDeviceModified, model.ErrorCodeConflict, http.StatusConflict, codes.FailedPrecondition},
+		{"service unavailable", model.ErrServiceUnavailable, model.ErrorCodeServiceUnavailable, http.StatusServiceUnavailable, codes.Unavailable},
+		{"timeout", model.ErrTimeout, model.ErrorCodeTimeout, http.StatusGatewayTimeout, codes.DeadlineExceeded},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			s.Require().Equal(tc.code, tc.err.Code)
+			s.Require().Equal(tc.httpStatus, tc.err.HTTPStatus)
+			s.Require().Equal(tc.grpcCode, tc.err.GRPCCode)
+			s.Require().Equal(tc.err.Message, tc.err.Error())
+
+			domainErr, ok := model.IsDomainError(fmt.Errorf("get device: %w", tc.err))
+			s.Require().True(ok)
+			s.Require().Same(tc.err, domainErr)
+		})
+	}
+}
+
+func (s *DomainErrorTestSuite) TestWithCause() {
+	s.T().Parallel()
+
+	cause := errors.New("cannot transition device from available to retired")
+	err := fmt.Errorf("transition: %w", model.ErrInvalidStateTransition.WithCause(cause))
+
+	s.Require().ErrorIs(err, model.ErrInvalidStateTransition)
+	s.Require().ErrorIs(err, cause)
+	s.Require().NotErrorIs(err, model.ErrCannotUpdateInUseDevice, "sentinels sharing a code stay distinct")
+	s.Require().Equal("transition: invalid state transition: cannot transition device from available to retired", err.Error())
+
+	domainErr, ok := model.IsDomainError(err)
+	s.Require().True(ok)
+	s.Require().Equal(http.StatusConflict, domainErr.HTTPStatus)
+	s.Require().Nil(model.ErrInvalidStateTransition.Cause, "the sentinel is left untouched")
+}
+
+func (s *DomainErrorTestSuite) TestIsDomainError_OtherErrors() {
+	s.T().Parallel()
+
+	_, ok := model.IsDomainError(errors.New("connection reset"))
+	s.Require().False(ok)
+
+	_, ok = model.IsDomainError(nil)
+	s.Require().False(ok)
+}
+
+func (s *DomainErrorTestSuite) TestValidateStruct() {
+	s.T().Parallel()
+
+	type payload struct {
+		ID    string   `json:"id" validate:"deviceid"`
+		Name  string   `json:"name" validate:"notblank,max=5"`
+		State string   `json:"state" validate:"devicestate"`
+		Sort  []string `json:"sort" validate:"dive,sortfield=name"`
+	}
+
+	s.Require().NoError(model.ValidateStruct(payload{
+		ID:    "9f6a1d2e-3b4c-4d5e-8f90-a1b2c3d4e5f6",
+		Name:  "Pixel",
+		State: "available",
+		Sort:  []string{"-name"},
+	}))
+
+	err := model.ValidateStruct(payload{ID: "42", Name: " ", State: "retired", Sort: []string{"name", "price"}})
+
+	var validationErrs *model.ValidationErrors
+	s.Require().ErrorAs(err, &validationErrs)
+	s.Require().Equal([]model.ValidationError{
+		{Field: "id", Message: "id must be a valid UUID", Code: model.ValidationCodeInvalid},
+		{Field: "name", Message: "name is required", Code: model.ValidationCodeRequired},
+		{Field: "state", Message: "state must be one of available, in-use, inactive", Code: model.ValidationCodeInvalidEnum},
+		{Field: "sort", Message: `unsupported sort field "price": must be one of name`, Code: model.ValidationCodeInvalidEnum},
+	}, validationErrs.Errors)
+
+	s.Require().Error(model.ValidateStruct("not a struct"))
+}
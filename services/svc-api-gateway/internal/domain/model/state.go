@@ -2,6 +2,7 @@ package model
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 )
 
@@ -35,6 +36,33 @@ func ParseState(s string) (State, error) {
 	return state, nil
 }
 
+// stateTransitions lists, per state, the states a device may move to directly.
+// Taking a device out of service requires releasing it first, and an inactive
+// device has to be made available again before it can be used.
+var stateTransitions = map[State][]State{
+	StateAvailable: {StateInUse, StateInactive},
+	StateInUse:     {StateAvailable},
+	StateInactive:  {StateAvailable},
+}
+
+// CanTransitionTo reports whether the state machine allows moving from s to target.
+// Transitions to the current state are not allowed.
+func (s State) CanTransitionTo(target State) bool {
+	return slices.Contains(stateTransitions[s], target)
+}
+
 func AllStates() []State {
 	return []State{StateAvailable, StateInUse, StateInactive}
 }
+
+// stateNames returns the names of AllStates.
+func stateNames() []string {
+	states := AllStates()
+	names := make([]string, len(states))
+
+	for i, state := range states {
+		names[i] = state.String()
+	}
+
+	return names
+}
@@ -4,13 +4,23 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"net"
 	"time"
 
 	appLogger "github.com/architeacher/devices/pkg/logger"
+	"github.com/architeacher/devices/pkg/retry"
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/config"
 	"github.com/redis/go-redis/v9"
 )
 
+// Commands failing on the connection are retried with the same backoff go-redis uses
+// by default.
+const (
+	cacheRetryBackoffBase = 8 * time.Millisecond
+	cacheRetryMaxDelay    = 512 * time.Millisecond
+)
+
 type KeydbClient struct {
 	client *redis.Client
 	logger appLogger.Logger
@@ -28,10 +38,12 @@ func NewKeyDBClient(config config.Cache, logger appLogger.Logger) *KeydbClient {
 		ReadTimeout:  config.ReadTimeout,
 		WriteTimeout: config.WriteTimeout,
 		PoolTimeout:  config.PoolTimeout,
-		MaxRetries:   int(config.MaxRetries),
+		// Retries are left to the retry hook, so they share pkg/retry with the gRPC client.
+		MaxRetries: -1,
 	}
 
 	client := redis.NewClient(opts)
+	client.AddHook(retryHook{policy: cacheRetryPolicy(config)})
 
 	return &KeydbClient{
 		client: client,
@@ -99,6 +111,77 @@ func (c *KeydbClient) Set(ctx context.Context, key string, value []byte, ttl tim
 	return err
 }
 
+// SetAndDelete sets key and deletes deleteKeys in a single MULTI/EXEC transaction, so
+// either every command is applied or, when one is rejected, none is.
+func (c *KeydbClient) SetAndDelete(ctx context.Context, key string, value []byte, ttl time.Duration, deleteKeys ...string) error {
+	if ttl == 0 {
+		ttl = c.config.DefaultExpiry
+	}
+
+	startTime := time.Now()
+	var err error
+
+	defer func() {
+		duration := time.Since(startTime)
+
+		c.logger.Debug().
+			Str("key", key).
+			Str("expiry", ttl.String()).
+			Int("deleted_keys", len(deleteKeys)).
+			Int64("duration_ms", duration.Milliseconds()).
+			Bool("success", err == nil).
+			Msg("keydb set and delete transaction")
+	}()
+
+	_, err = c.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, key, value, ttl)
+
+		if len(deleteKeys) > 0 {
+			pipe.Del(ctx, deleteKeys...)
+		}
+
+		return nil
+	})
+
+	return err
+}
+
+// SetMany stores every entry with the same ttl in a single pipelined round trip. The
+// writes are not transactional, so a failure may leave some of the entries stored.
+func (c *KeydbClient) SetMany(ctx context.Context, entries map[string][]byte, ttl time.Duration) error {
+	if ttl == 0 {
+		ttl = c.config.DefaultExpiry
+	}
+
+	startTime := time.Now()
+	var err error
+
+	defer func() {
+		duration := time.Since(startTime)
+
+		c.logger.Debug().
+			Int("keys", len(entries)).
+			Str("expiry", ttl.String()).
+			Int64("duration_ms", duration.Milliseconds()).
+			Bool("success", err == nil).
+			Msg("keydb pipelined set operation")
+	}()
+
+	if len(entries) == 0 {
+		return nil
+	}
+
+	_, err = c.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for key, value := range entries {
+			pipe.Set(ctx, key, value, ttl)
+		}
+
+		return nil
+	})
+
+	return err
+}
+
 func (c *KeydbClient) Lock(ctx context.Context, key string, value any, ttl time.Duration) (bool, error) {
 	startTime := time.Now()
 	var err error
@@ -165,6 +248,26 @@ func (c *KeydbClient) GetStats(ctx context.Context) (map[string]any, error) {
 	return stats, nil
 }
 
+// Info returns the raw INFO output for the given sections, or the default sections when none are given.
+func (c *KeydbClient) Info(ctx context.Context, sections ...string) (string, error) {
+	info, err := c.client.Info(ctx, sections...).Result()
+	if err != nil {
+		return "", fmt.Errorf("reading server info: %w", err)
+	}
+
+	return info, nil
+}
+
+// DBSize returns the number of keys in the selected database.
+func (c *KeydbClient) DBSize(ctx context.Context) (int64, error) {
+	size, err := c.client.DBSize(ctx).Result()
+	if err != nil {
+		return 0, fmt.Errorf("reading database size: %w", err)
+	}
+
+	return size, nil
+}
+
 // IsHealthy checks if the cache is available.
 func (c *KeydbClient) IsHealthy(ctx context.Context) bool {
 	ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
@@ -213,9 +316,9 @@ func (c *KeydbClient) CompareAndSwapInt64(ctx context.Context, key string, old,
 	return result == 1, nil
 }
 
-// TTL returns the remaining time-to-live of a key.
+// TTL returns the remaining time-to-live of a key, or 0 when it cannot be read.
 func (c *KeydbClient) TTL(ctx context.Context, key string) time.Duration {
-	result, err := c.client.TTL(ctx, key).Result()
+	result, err := c.RemainingTTL(ctx, key)
 	if err != nil {
 		c.logger.Warn().Err(err).Str("key", key).Msg("failed to get TTL")
 
@@ -225,6 +328,17 @@ func (c *KeydbClient) TTL(ctx context.Context, key string) time.Duration {
 	return result
 }
 
+// RemainingTTL returns the remaining time-to-live of a key. As with the TTL command,
+// it is -1 for a key without expiry and -2 for a missing key.
+func (c *KeydbClient) RemainingTTL(ctx context.Context, key string) (time.Duration, error) {
+	result, err := c.client.TTL(ctx, key).Result()
+	if err != nil {
+		return 0, fmt.Errorf("reading TTL of %s: %w", key, err)
+	}
+
+	return result, nil
+}
+
 // Scan iterates over keys matching a pattern.
 func (c *KeydbClient) Scan(ctx context.Context, cursor uint64, pattern string, count int64) ([]string, uint64, error) {
 	keys, nextCursor, err := c.client.Scan(ctx, cursor, pattern, count).Result()
@@ -234,3 +348,54 @@ func (c *KeydbClient) Scan(ctx context.Context, cursor uint64, pattern string, c
 
 	return keys, nextCursor, nil
 }
+
+// cacheRetryPolicy retries a command up to MaxRetries times after its first attempt.
+func cacheRetryPolicy(config config.Cache) retry.Policy {
+	return retry.ExponentialBackoff(retry.Policy{
+		MaxAttempts: config.MaxRetries + 1,
+		BackoffBase: cacheRetryBackoffBase,
+		MaxDelay:    cacheRetryMaxDelay,
+		Retryable:   isRetryableCacheError,
+	})
+}
+
+// isRetryableCacheError reports whether err came from the connection rather than from
+// the command, so sending the command again may succeed.
+func isRetryableCacheError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, redis.ErrPoolTimeout) {
+		return true
+	}
+
+	var netErr net.Error
+
+	return errors.As(err, &netErr)
+}
+
+// retryHook retries commands and pipelines failing on the connection according to policy.
+type retryHook struct {
+	policy retry.Policy
+}
+
+func (h retryHook) DialHook(next redis.DialHook) redis.DialHook {
+	return next
+}
+
+func (h retryHook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		return retry.Do(ctx, h.policy, func() error {
+			return next(ctx, cmd)
+		})
+	}
+}
+
+func (h retryHook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return func(ctx context.Context, cmds []redis.Cmder) error {
+		return retry.Do(ctx, h.policy, func() error {
+			return next(ctx, cmds)
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/config"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsRetryableCacheError(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{name: "closed connection", err: io.EOF, expected: true},
+		{name: "wrapped unexpected EOF", err: fmt.Errorf("reading reply: %w", io.ErrUnexpectedEOF), expected: true},
+		{name: "pool timeout", err: redis.ErrPoolTimeout, expected: true},
+		{name: "network error", err: &net.OpError{Op: "dial", Err: errors.New("connection refused")}, expected: true},
+		{name: "missing key", err: redis.Nil, expected: false},
+		{name: "cancelled context", err: context.Canceled, expected: false},
+		{name: "deadline exceeded", err: context.DeadlineExceeded, expected: false},
+		{name: "command error", err: errors.New("WRONGTYPE Operation against a key holding the wrong kind of value"), expected: false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tc.expected, isRetryableCacheError(tc.err))
+		})
+	}
+}
+
+func TestRetryHook(t *testing.T) {
+	t.Parallel()
+
+	policy := cacheRetryPolicy(config.Cache{MaxRetries: 2})
+	policy.BackoffBase = time.Millisecond
+
+	cases := []struct {
+		name          string
+		results       []error
+		expectedErr   error
+		expectedCalls int
+	}{
+		{
+			name:          "retries connection failures until the command succeeds",
+			results:       []error{io.EOF, io.EOF, nil},
+			expectedCalls: 3,
+		},
+		{
+			name:          "gives up after MaxRetries retries",
+			results:       []error{io.EOF, io.EOF, io.EOF, nil},
+			expectedErr:   io.EOF,
+			expectedCalls: 3,
+		},
+		{
+			name:          "does not retry a missing key",
+			results:       []error{redis.Nil, nil},
+			expectedErr:   redis.Nil,
+			expectedCalls: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			hook := retryHook{policy: policy}
+
+			t.Run("command", func(t *testing.T) {
+				calls := 0
+
+				process := hook.ProcessHook(func(context.Context, redis.Cmder) error {
+					result := tc.results[calls]
+					calls++
+
+					return result
+				})
+
+				err := process(t.Context(), redis.NewStringCmd(t.Context(), "get", "key"))
+
+				require.ErrorIs(t, err, tc.expectedErr)
+				require.Equal(t, tc.expectedCalls, calls)
+			})
+
+			t.Run("pipeline", func(t *testing.T) {
+				calls := 0
+
+				process := hook.ProcessPipelineHook(func(context.Context, []redis.Cmder) error {
+					result := tc.results[calls]
+					calls++
+
+					return result
+				})
+
+				err := process(t.Context(), []redis.Cmder{redis.NewStatusCmd(t.Context(), "set", "key", "value")})
+
+				require.ErrorIs(t, err, tc.expectedErr)
+				require.Equal(t, tc.expectedCalls, calls)
+			})
+		})
+	}
+}
@@ -4,40 +4,66 @@ import (
 	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/architeacher/devices/pkg/idempotency"
+	"github.com/architeacher/devices/pkg/logger"
+	"github.com/architeacher/devices/pkg/retry"
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/adapters/inbound/http/middleware"
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/config"
-	"github.com/cenkalti/backoff/v5"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/shared/backoff"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 )
 
 const (
 	MetadataKeyRequestID     = "request-id"
 	MetadataKeyCorrelationID = "correlation-id"
 	MetadataKeyIdempotency   = "idempotency-key"
+	MetadataKeyActor         = "actor"
 	maxIDLength              = 128
+
+	redactedValue = "[REDACTED]"
 )
 
+// defaultRetryableCodes are retried up to MaxRetries times when no retry policy is configured.
+var defaultRetryableCodes = []codes.Code{codes.Unavailable, codes.ResourceExhausted, codes.Aborted}
+
 // NewGRPCConnection creates a new gRPC client connection with the configured options.
 // The connection lifecycle is managed by the caller.
-func NewGRPCConnection(cfg *config.ServiceConfig) (*grpc.ClientConn, error) {
+func NewGRPCConnection(cfg *config.ServiceConfig, log logger.Logger) (*grpc.ClientConn, error) {
 	grpcClientConfig := cfg.DevicesGRPCClient
 
+	callOpts := []grpc.CallOption{
+		grpc.MaxCallRecvMsgSize(int(grpcClientConfig.MaxMessageSize)),
+		grpc.MaxCallSendMsgSize(int(grpcClientConfig.MaxMessageSize)),
+	}
+
+	if grpcClientConfig.CompressTransport {
+		callOpts = append(callOpts, grpc.UseCompressor(gzip.Name))
+	}
+
 	dialOpts := []grpc.DialOption{
-		grpc.WithDefaultCallOptions(
-			grpc.MaxCallRecvMsgSize(int(grpcClientConfig.MaxMessageSize)),
-			grpc.MaxCallSendMsgSize(int(grpcClientConfig.MaxMessageSize)),
-		),
+		grpc.WithDefaultCallOptions(callOpts...),
+		grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                grpcClientConfig.Keepalive.Time,
+			Timeout:             grpcClientConfig.Keepalive.Timeout,
+			PermitWithoutStream: grpcClientConfig.Keepalive.PermitWithoutStream,
+		}),
 	}
 
 	if grpcClientConfig.TLS.Enabled {
@@ -56,8 +82,13 @@ func NewGRPCConnection(cfg *config.ServiceConfig) (*grpc.ClientConn, error) {
 			correlationIDInterceptor(),
 			requestIDInterceptor(),
 			idempotencyInterceptor(),
+			actorInterceptor(),
 			timeoutInterceptor(grpcClientConfig.Timeout),
-			retryInterceptor(grpcClientConfig.MaxRetries, cfg.Backoff),
+			retryInterceptor(retryPolicy(cfg), fullJitterStrategy),
+			ClientLoggingInterceptor(log, grpcClientConfig.ClientLogging),
+		),
+		grpc.WithChainStreamInterceptor(
+			correlationIDStreamInterceptor(),
 		),
 	)
 
@@ -111,17 +142,36 @@ func correlationIDInterceptor() grpc.UnaryClientInterceptor {
 		invoker grpc.UnaryInvoker,
 		opts ...grpc.CallOption,
 	) error {
-		correlationID := middleware.GetCorrelationID(ctx)
-		if correlationID != "" {
-			if len(correlationID) > maxIDLength {
-				correlationID = correlationID[:maxIDLength]
-			}
+		return invoker(withOutgoingCorrelationID(ctx), method, req, reply, cc, opts...)
+	}
+}
 
-			ctx = metadata.AppendToOutgoingContext(ctx, MetadataKeyCorrelationID, correlationID)
-		}
+// correlationIDStreamInterceptor is the streaming counterpart of
+// correlationIDInterceptor, attaching the correlation ID when a stream is opened.
+func correlationIDStreamInterceptor() grpc.StreamClientInterceptor {
+	return func(
+		ctx context.Context,
+		desc *grpc.StreamDesc,
+		cc *grpc.ClientConn,
+		method string,
+		streamer grpc.Streamer,
+		opts ...grpc.CallOption,
+	) (grpc.ClientStream, error) {
+		return streamer(withOutgoingCorrelationID(ctx), desc, cc, method, opts...)
+	}
+}
 
-		return invoker(ctx, method, req, reply, cc, opts...)
+func withOutgoingCorrelationID(ctx context.Context) context.Context {
+	correlationID := middleware.GetCorrelationID(ctx)
+	if correlationID == "" {
+		return ctx
+	}
+
+	if len(correlationID) > maxIDLength {
+		correlationID = correlationID[:maxIDLength]
 	}
+
+	return metadata.AppendToOutgoingContext(ctx, MetadataKeyCorrelationID, correlationID)
 }
 
 func requestIDInterceptor() grpc.UnaryClientInterceptor {
@@ -168,7 +218,9 @@ func idempotencyInterceptor() grpc.UnaryClientInterceptor {
 	}
 }
 
-func timeoutInterceptor(timeout time.Duration) grpc.UnaryClientInterceptor {
+// actorInterceptor forwards the subject of the authenticated token, so svc-devices can
+// attribute the mutations it records in its audit log.
+func actorInterceptor() grpc.UnaryClientInterceptor {
 	return func(
 		ctx context.Context,
 		method string,
@@ -177,17 +229,29 @@ func timeoutInterceptor(timeout time.Duration) grpc.UnaryClientInterceptor {
 		invoker grpc.UnaryInvoker,
 		opts ...grpc.CallOption,
 	) error {
-		if timeout > 0 {
-			var cancel context.CancelFunc
-			ctx, cancel = context.WithTimeout(ctx, timeout)
-			defer cancel()
+		if claims := middleware.GetClaims(ctx); claims != nil && claims.Subject != "" {
+			actor := claims.Subject
+			if len(actor) > maxIDLength {
+				actor = actor[:maxIDLength]
+			}
+
+			ctx = metadata.AppendToOutgoingContext(ctx, MetadataKeyActor, actor)
 		}
 
 		return invoker(ctx, method, req, reply, cc, opts...)
 	}
 }
 
-func retryInterceptor(maxRetries uint, cfg config.Backoff) grpc.UnaryClientInterceptor {
+// ClientLoggingInterceptor logs outbound requests and their responses or errors at
+// DEBUG level as JSON, replacing the values of cfg.SanitizedFields with [REDACTED].
+func ClientLoggingInterceptor(log logger.Logger, cfg config.ClientLogging) grpc.UnaryClientInterceptor {
+	sanitized := make(map[string]struct{}, len(cfg.SanitizedFields))
+	for _, field := range cfg.SanitizedFields {
+		if field = normalizeFieldName(field); field != "" {
+			sanitized[field] = struct{}{}
+		}
+	}
+
 	return func(
 		ctx context.Context,
 		method string,
@@ -196,50 +260,197 @@ func retryInterceptor(maxRetries uint, cfg config.Backoff) grpc.UnaryClientInter
 		invoker grpc.UnaryInvoker,
 		opts ...grpc.CallOption,
 	) error {
-		if maxRetries == 0 {
+		if !cfg.LogRequests && !cfg.LogResponses {
 			return invoker(ctx, method, req, reply, cc, opts...)
 		}
 
-		expBackoff := backoff.NewExponentialBackOff()
-		expBackoff.InitialInterval = cfg.BaseDelay
-		expBackoff.Multiplier = cfg.Multiplier
-		expBackoff.RandomizationFactor = cfg.Jitter
-		expBackoff.MaxInterval = cfg.MaxDelay
+		if cfg.LogRequests {
+			log.Debug().
+				Str("method", method).
+				RawJSON("request", sanitizedMessageJSON(req, sanitized)).
+				Msg("gRPC client request")
+		}
 
-		operation := func() (struct{}, error) {
-			err := invoker(ctx, method, req, reply, cc, opts...)
-			if err == nil {
-				return struct{}{}, nil
-			}
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		duration := time.Since(start)
 
-			if isRetryable(err) {
-				return struct{}{}, err
-			}
+		if !cfg.LogResponses {
+			return err
+		}
+
+		if err != nil {
+			st := status.Convert(err)
+
+			log.Debug().
+				Str("method", method).
+				Str("grpc_code", st.Code().String()).
+				Str("error", st.Message()).
+				Dur("duration", duration).
+				Msg("gRPC client request failed")
 
-			return struct{}{}, backoff.Permanent(err)
+			return err
 		}
 
-		_, err := backoff.Retry(
-			ctx,
-			operation,
-			backoff.WithMaxTries(maxRetries+1),
-			backoff.WithBackOff(expBackoff),
-		)
+		log.Debug().
+			Str("method", method).
+			RawJSON("response", sanitizedMessageJSON(reply, sanitized)).
+			Dur("duration", duration).
+			Msg("gRPC client response")
 
-		return err
+		return nil
 	}
 }
 
-func isRetryable(err error) bool {
-	st, ok := status.FromError(err)
+// sanitizedMessageJSON renders a proto message as JSON with sanitized fields redacted.
+func sanitizedMessageJSON(msg any, sanitized map[string]struct{}) []byte {
+	protoMsg, ok := msg.(proto.Message)
 	if !ok {
-		return false
+		return []byte("null")
+	}
+
+	raw, err := protojson.Marshal(protoMsg)
+	if err != nil {
+		return []byte("null")
+	}
+
+	if len(sanitized) == 0 {
+		return raw
+	}
+
+	var decoded any
+	if err := json.Unmarshal(raw, &decoded); err != nil {
+		return []byte("null")
+	}
+
+	redacted, err := json.Marshal(redactFields(decoded, sanitized))
+	if err != nil {
+		return []byte("null")
+	}
+
+	return redacted
+}
+
+func redactFields(value any, sanitized map[string]struct{}) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, nested := range v {
+			if _, ok := sanitized[normalizeFieldName(key)]; ok {
+				v[key] = redactedValue
+
+				continue
+			}
+
+			v[key] = redactFields(nested, sanitized)
+		}
+	case []any:
+		for i, nested := range v {
+			v[i] = redactFields(nested, sanitized)
+		}
 	}
 
-	switch st.Code() {
-	case codes.Unavailable, codes.ResourceExhausted, codes.Aborted:
-		return true
-	default:
-		return false
+	return value
+}
+
+// normalizeFieldName lets proto field names and their JSON names match each other.
+func normalizeFieldName(name string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(name), "_", ""))
+}
+
+func timeoutInterceptor(timeout time.Duration) grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply any,
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		if timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+
+		return invoker(ctx, method, req, reply, cc, opts...)
 	}
 }
+
+// retryPolicy returns the configured per-code retry policy. When none is configured
+// it falls back to MaxRetries for the codes that were historically retried. Entries
+// without their own backoff settings inherit the service-wide ones.
+func retryPolicy(cfg *config.ServiceConfig) config.RetryPolicy {
+	configured := cfg.DevicesGRPCClient.RetryPolicy
+	if len(configured) == 0 {
+		configured = make(config.RetryPolicy, len(defaultRetryableCodes))
+
+		for _, code := range defaultRetryableCodes {
+			configured[code] = config.RetryConfig{MaxAttempts: cfg.DevicesGRPCClient.MaxRetries + 1}
+		}
+	}
+
+	policy := make(config.RetryPolicy, len(configured))
+
+	for code, retryConfig := range configured {
+		if retryConfig.Backoff == (config.Backoff{}) {
+			retryConfig.Backoff = cfg.Backoff
+		}
+
+		policy[code] = retryConfig
+	}
+
+	return policy
+}
+
+// retryInterceptor retries failed calls according to the policy entry for the
+// returned status code, waiting between attempts as decided by the code's strategy.
+// Calls failing with a code that has no policy entry are not retried.
+func retryInterceptor(
+	policy config.RetryPolicy,
+	newStrategy func(config.Backoff) backoff.Strategy,
+) grpc.UnaryClientInterceptor {
+	strategies := make(map[codes.Code]backoff.Strategy, len(policy))
+	maxAttempts := uint(1)
+
+	for code, retryConfig := range policy {
+		strategies[code] = newStrategy(retryConfig.Backoff)
+		maxAttempts = max(maxAttempts, retryConfig.MaxAttempts)
+	}
+
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply any,
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		attempts := uint(0)
+
+		err := retry.Do(ctx, retry.Policy{
+			MaxAttempts: maxAttempts,
+			Retryable: func(err error) bool {
+				retryConfig, ok := policy[status.Code(err)]
+
+				return ok && attempts < retryConfig.MaxAttempts
+			},
+			Backoff: func(failures uint, err error) time.Duration {
+				return strategies[status.Code(err)].Backoff(int(failures - 1))
+			},
+		}, func() error {
+			attempts++
+
+			return invoker(ctx, method, req, reply, cc, opts...)
+		})
+
+		if ctxErr := ctx.Err(); ctxErr != nil && errors.Is(err, ctxErr) {
+			return status.FromContextError(ctxErr).Err()
+		}
+
+		return err
+	}
+}
+
+func fullJitterStrategy(cfg config.Backoff) backoff.Strategy {
+	return backoff.NewFullJitterStrategy(cfg)
+}
@@ -1,11 +1,27 @@
 package infrastructure
 
 import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/architeacher/devices/pkg/logger"
+	devicev1 "github.com/architeacher/devices/pkg/proto/device/v1"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/adapters/inbound/http/middleware"
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/config"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/domain/model"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/shared/backoff"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 func testConfig() *config.ServiceConfig {
@@ -73,7 +89,7 @@ func TestNewGRPCConnection(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			conn, err := NewGRPCConnection(tc.cfg)
+			conn, err := NewGRPCConnection(tc.cfg, logger.NewTestLogger())
 
 			if tc.wantErr {
 				require.Error(t, err)
@@ -93,10 +109,484 @@ func TestNewGRPCConnection(t *testing.T) {
 func TestNewGRPCConnection_Close(t *testing.T) {
 	t.Parallel()
 
-	conn, err := NewGRPCConnection(testConfig())
+	conn, err := NewGRPCConnection(testConfig(), logger.NewTestLogger())
 	require.NoError(t, err)
 	require.NotNil(t, conn)
 
 	err = conn.Close()
 	require.NoError(t, err)
 }
+
+type fixedStrategy time.Duration
+
+func (f fixedStrategy) Backoff(int) time.Duration {
+	return time.Duration(f)
+}
+
+// failingInvoker fails with the given codes in order and then succeeds, recording call times.
+func failingInvoker(failures []codes.Code, calls *[]time.Time) grpc.UnaryInvoker {
+	return func(context.Context, string, any, any, *grpc.ClientConn, ...grpc.CallOption) error {
+		*calls = append(*calls, time.Now())
+
+		if attempt := len(*calls) - 1; attempt < len(failures) {
+			return status.Error(failures[attempt], "call failed")
+		}
+
+		return nil
+	}
+}
+
+func TestRetryInterceptor(t *testing.T) {
+	t.Parallel()
+
+	const delay = 20 * time.Millisecond
+
+	policy := config.RetryPolicy{
+		codes.Unavailable: {MaxAttempts: 3},
+		codes.NotFound:    {MaxAttempts: 1},
+	}
+
+	newStrategy := func(config.Backoff) backoff.Strategy {
+		return fixedStrategy(delay)
+	}
+
+	cases := []struct {
+		name          string
+		failures      []codes.Code
+		expectedCode  codes.Code
+		expectedCalls int
+	}{
+		{
+			name:          "retries unavailable until it succeeds",
+			failures:      []codes.Code{codes.Unavailable, codes.Unavailable},
+			expectedCode:  codes.OK,
+			expectedCalls: 3,
+		},
+		{
+			name:          "gives up after max attempts",
+			failures:      []codes.Code{codes.Unavailable, codes.Unavailable, codes.Unavailable, codes.Unavailable},
+			expectedCode:  codes.Unavailable,
+			expectedCalls: 3,
+		},
+		{
+			name:          "never retries not found",
+			failures:      []codes.Code{codes.NotFound},
+			expectedCode:  codes.NotFound,
+			expectedCalls: 1,
+		},
+		{
+			name:          "does not retry codes without a policy entry",
+			failures:      []codes.Code{codes.Internal},
+			expectedCode:  codes.Internal,
+			expectedCalls: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls []time.Time
+
+			interceptor := retryInterceptor(policy, newStrategy)
+			err := interceptor(t.Context(), "/device.v1.DeviceService/GetDevice", nil, nil, nil, failingInvoker(tc.failures, &calls))
+
+			require.Equal(t, tc.expectedCode, status.Code(err))
+			require.Len(t, calls, tc.expectedCalls)
+
+			for i := 1; i < len(calls); i++ {
+				require.GreaterOrEqual(t, calls[i].Sub(calls[i-1]), delay)
+			}
+		})
+	}
+}
+
+func TestRetryInterceptor_StopsWhenContextEnds(t *testing.T) {
+	t.Parallel()
+
+	policy := config.RetryPolicy{codes.Unavailable: {MaxAttempts: 5}}
+	newStrategy := func(config.Backoff) backoff.Strategy {
+		return fixedStrategy(time.Minute)
+	}
+
+	ctx, cancel := context.WithTimeout(t.Context(), 50*time.Millisecond)
+	defer cancel()
+
+	var calls []time.Time
+
+	err := retryInterceptor(policy, newStrategy)(ctx, "/device.v1.DeviceService/GetDevice", nil, nil, nil,
+		failingInvoker([]codes.Code{codes.Unavailable, codes.Unavailable}, &calls))
+
+	require.Equal(t, codes.DeadlineExceeded, status.Code(err))
+	require.Len(t, calls, 1)
+}
+
+func TestActorInterceptor(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		claims   *model.PasetoClaims
+		expected []string
+	}{
+		{
+			name:     "forwards the token subject",
+			claims:   &model.PasetoClaims{Subject: "user-42"},
+			expected: []string{"user-42"},
+		},
+		{
+			name:     "truncates an oversized subject",
+			claims:   &model.PasetoClaims{Subject: strings.Repeat("s", maxIDLength+10)},
+			expected: []string{strings.Repeat("s", maxIDLength)},
+		},
+		{
+			name:   "omits the key without a subject",
+			claims: &model.PasetoClaims{},
+		},
+		{
+			name: "omits the key for unauthenticated calls",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+			if tc.claims != nil {
+				ctx = context.WithValue(ctx, middleware.ClaimsKey, tc.claims)
+			}
+
+			var outgoing metadata.MD
+			invoker := func(ctx context.Context, _ string, _, _ any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+				outgoing, _ = metadata.FromOutgoingContext(ctx)
+
+				return nil
+			}
+
+			require.NoError(t, actorInterceptor()(ctx, "/device.v1.DeviceService/CreateDevice", nil, nil, nil, invoker))
+			require.Equal(t, tc.expected, outgoing.Get(MetadataKeyActor))
+		})
+	}
+}
+
+func TestRetryPolicy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("falls back to max retries for the default codes", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := testConfig()
+
+		policy := retryPolicy(cfg)
+		require.Len(t, policy, 3)
+
+		for _, code := range []codes.Code{codes.Unavailable, codes.ResourceExhausted, codes.Aborted} {
+			require.Equal(t, uint(4), policy[code].MaxAttempts)
+			require.Equal(t, cfg.Backoff, policy[code].Backoff)
+		}
+	})
+
+	t.Run("uses the configured policy and inherits missing backoff", func(t *testing.T) {
+		t.Parallel()
+
+		customBackoff := config.Backoff{BaseDelay: time.Millisecond, Multiplier: 2, MaxDelay: time.Second}
+
+		cfg := testConfig()
+		cfg.DevicesGRPCClient.RetryPolicy = config.RetryPolicy{
+			codes.Unavailable: {MaxAttempts: 3},
+			codes.Aborted:     {MaxAttempts: 2, Backoff: customBackoff},
+		}
+
+		policy := retryPolicy(cfg)
+		require.Len(t, policy, 2)
+		require.Equal(t, cfg.Backoff, policy[codes.Unavailable].Backoff)
+		require.Equal(t, customBackoff, policy[codes.Aborted].Backoff)
+		require.NotContains(t, policy, codes.NotFound)
+	})
+}
+
+func TestClientLoggingInterceptor(t *testing.T) {
+	t.Parallel()
+
+	const method = "/device.v1.DeviceService/CreateDevice"
+
+	req := &devicev1.CreateDeviceRequest{
+		Name:  "iPhone 15",
+		Brand: "Apple",
+		State: devicev1.DeviceState_DEVICE_STATE_AVAILABLE,
+	}
+
+	successInvoker := func(_ context.Context, _ string, _, reply any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+		resp := reply.(*devicev1.CreateDeviceResponse)
+		resp.Device = &devicev1.Device{Id: "device-1", Name: "iPhone 15", Brand: "Apple"}
+
+		return nil
+	}
+
+	failingInvoker := func(context.Context, string, any, any, *grpc.ClientConn, ...grpc.CallOption) error {
+		return status.Error(codes.AlreadyExists, "device already exists")
+	}
+
+	cases := []struct {
+		name     string
+		cfg      config.ClientLogging
+		invoker  grpc.UnaryInvoker
+		expected []string
+		excluded []string
+		empty    bool
+	}{
+		{
+			name:     "logs request at debug",
+			cfg:      config.ClientLogging{LogRequests: true},
+			invoker:  successInvoker,
+			expected: []string{`"level":"debug"`, `"method":"` + method + `"`, `"brand":"Apple"`, "gRPC client request"},
+			excluded: []string{"gRPC client response"},
+		},
+		{
+			name:     "redacts sanitized fields",
+			cfg:      config.ClientLogging{LogRequests: true, LogResponses: true, SanitizedFields: []string{"NAME"}},
+			invoker:  successInvoker,
+			expected: []string{`"name":"[REDACTED]"`, `"brand":"Apple"`},
+			excluded: []string{"iPhone 15"},
+		},
+		{
+			name:     "logs response on success",
+			cfg:      config.ClientLogging{LogResponses: true},
+			invoker:  successInvoker,
+			expected: []string{"gRPC client response", `"id":"device-1"`, "duration"},
+			excluded: []string{"gRPC client request\""},
+		},
+		{
+			name:     "logs error with grpc code on failure",
+			cfg:      config.ClientLogging{LogResponses: true},
+			invoker:  failingInvoker,
+			expected: []string{"gRPC client request failed", `"grpc_code":"AlreadyExists"`, "device already exists"},
+		},
+		{
+			name:    "logs nothing when both flags are off",
+			cfg:     config.ClientLogging{SanitizedFields: []string{"name"}},
+			invoker: successInvoker,
+			empty:   true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+
+			interceptor := ClientLoggingInterceptor(logger.NewBufferedTestLogger(&buf), tc.cfg)
+			_ = interceptor(t.Context(), method, req, &devicev1.CreateDeviceResponse{}, nil, tc.invoker)
+
+			logOutput := buf.String()
+
+			if tc.empty {
+				require.Empty(t, logOutput)
+
+				return
+			}
+
+			for _, fragment := range tc.expected {
+				require.Contains(t, logOutput, fragment)
+			}
+
+			for _, fragment := range tc.excluded {
+				require.NotContains(t, logOutput, fragment)
+			}
+		})
+	}
+}
+
+// listDevicesServer answers every ListDevices call with a large, repetitive page.
+type listDevicesServer struct {
+	devicev1.UnimplementedDeviceServiceServer
+}
+
+func (listDevicesServer) ListDevices(context.Context, *devicev1.ListDevicesRequest) (*devicev1.ListDevicesResponse, error) {
+	devices := make([]*devicev1.Device, 0, 500)
+	for i := range 500 {
+		devices = append(devices, &devicev1.Device{
+			Id:    fmt.Sprintf("00000000-0000-0000-0000-%012d", i),
+			Name:  "iPhone 15 Pro Max",
+			Brand: "Apple",
+			State: devicev1.DeviceState_DEVICE_STATE_AVAILABLE,
+		})
+	}
+
+	return &devicev1.ListDevicesResponse{Devices: devices}, nil
+}
+
+// countingProxy forwards TCP connections to target and counts the bytes sent back to clients.
+type countingProxy struct {
+	listener   net.Listener
+	target     string
+	downstream atomic.Int64
+}
+
+func startCountingProxy(t *testing.T, target string) *countingProxy {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	proxy := &countingProxy{listener: listener, target: target}
+
+	go proxy.serve()
+
+	t.Cleanup(func() {
+		_ = listener.Close()
+	})
+
+	return proxy
+}
+
+func (p *countingProxy) serve() {
+	for {
+		clientConn, err := p.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		serverConn, err := net.Dial("tcp", p.target)
+		if err != nil {
+			_ = clientConn.Close()
+
+			continue
+		}
+
+		go func() {
+			_, _ = io.Copy(serverConn, clientConn)
+			_ = serverConn.Close()
+		}()
+
+		go func() {
+			n, _ := io.Copy(clientConn, serverConn)
+			p.downstream.Add(n)
+			_ = clientConn.Close()
+		}()
+	}
+}
+
+func TestNewGRPCConnection_CompressTransport(t *testing.T) {
+	t.Parallel()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := grpc.NewServer()
+	devicev1.RegisterDeviceServiceServer(server, listDevicesServer{})
+
+	go func() {
+		_ = server.Serve(listener)
+	}()
+
+	t.Cleanup(server.Stop)
+
+	wireBytes := func(compress bool) int64 {
+		proxy := startCountingProxy(t, listener.Addr().String())
+
+		cfg := testConfig()
+		cfg.DevicesGRPCClient.Address = proxy.listener.Addr().String()
+		cfg.DevicesGRPCClient.CompressTransport = compress
+
+		conn, err := NewGRPCConnection(cfg, logger.NewTestLogger())
+		require.NoError(t, err)
+
+		resp, err := devicev1.NewDeviceServiceClient(conn).ListDevices(t.Context(), &devicev1.ListDevicesRequest{})
+		require.NoError(t, err)
+		require.Len(t, resp.GetDevices(), 500)
+
+		require.NoError(t, conn.Close())
+		require.Eventually(t, func() bool { return proxy.downstream.Load() > 0 }, time.Second, 5*time.Millisecond)
+
+		return proxy.downstream.Load()
+	}
+
+	plain := wireBytes(false)
+	compressed := wireBytes(true)
+
+	require.Less(t, compressed, plain/2, "compressed %d bytes, plain %d bytes", compressed, plain)
+}
+
+// watchDeviceServer records the metadata each WatchDevice stream is opened with.
+type watchDeviceServer struct {
+	devicev1.UnimplementedDeviceServiceServer
+	received chan metadata.MD
+}
+
+func (s watchDeviceServer) WatchDevice(_ *devicev1.WatchDeviceRequest, stream grpc.ServerStreamingServer[devicev1.WatchDeviceResponse]) error {
+	md, _ := metadata.FromIncomingContext(stream.Context())
+	s.received <- md
+
+	return nil
+}
+
+func TestCorrelationIDStreamInterceptor(t *testing.T) {
+	t.Parallel()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	received := make(chan metadata.MD, 1)
+
+	server := grpc.NewServer()
+	devicev1.RegisterDeviceServiceServer(server, watchDeviceServer{received: received})
+
+	go func() {
+		_ = server.Serve(listener)
+	}()
+
+	t.Cleanup(server.Stop)
+
+	cfg := testConfig()
+	cfg.DevicesGRPCClient.Address = listener.Addr().String()
+
+	conn, err := NewGRPCConnection(cfg, logger.NewTestLogger())
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	client := devicev1.NewDeviceServiceClient(conn)
+
+	cases := []struct {
+		name          string
+		correlationID string
+		expected      []string
+	}{
+		{
+			name:          "propagates the correlation ID",
+			correlationID: "corr-123",
+			expected:      []string{"corr-123"},
+		},
+		{
+			name:          "truncates an oversized correlation ID",
+			correlationID: strings.Repeat("c", maxIDLength+10),
+			expected:      []string{strings.Repeat("c", maxIDLength)},
+		},
+		{
+			name: "omits the key without a correlation ID",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := t.Context()
+			if tc.correlationID != "" {
+				ctx = context.WithValue(ctx, middleware.CorrelationIDKey, tc.correlationID)
+			}
+
+			stream, err := client.WatchDevice(ctx, &devicev1.WatchDeviceRequest{Id: "00000000-0000-0000-0000-000000000001"})
+			require.NoError(t, err)
+
+			_, err = stream.Recv()
+			require.ErrorIs(t, err, io.EOF)
+
+			md := <-received
+			require.Equal(t, tc.expected, md.Get(MetadataKeyCorrelationID))
+		})
+	}
+}
@@ -11,10 +11,11 @@ import (
 
 // CachedResponse represents a cached HTTP response.
 type CachedResponse struct {
-	StatusCode int               `json:"status_code"`
-	Headers    map[string]string `json:"headers"`
-	Body       []byte            `json:"body"`
-	CreatedAt  time.Time         `json:"created_at"`
+	StatusCode  int               `json:"status_code"`
+	Headers     map[string]string `json:"headers"`
+	Body        []byte            `json:"body"`
+	CreatedAt   time.Time         `json:"created_at"`
+	RequestHash string            `json:"request_hash,omitempty"`
 }
 
 // IdempotencyCache defines the interface for idempotency caching operations.
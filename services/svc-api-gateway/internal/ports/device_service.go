@@ -6,6 +6,7 @@ package ports
 
 import (
 	"context"
+	"time"
 
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/domain/model"
 )
@@ -27,6 +28,10 @@ type DevicesService interface {
 	// PatchDevice partially updates a device.
 	PatchDevice(ctx context.Context, id model.DeviceID, updates map[string]any) (*model.Device, error)
 
+	// PatchDeviceIfUnmodified partially updates a device only if it was last updated at
+	// lastUpdatedAt, failing with ErrDeviceModified otherwise.
+	PatchDeviceIfUnmodified(ctx context.Context, id model.DeviceID, updates map[string]any, lastUpdatedAt time.Time) (*model.Device, error)
+
 	// DeleteDevice deletes a device by ID.
 	DeleteDevice(ctx context.Context, id model.DeviceID) error
 }
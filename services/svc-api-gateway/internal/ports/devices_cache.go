@@ -23,6 +23,16 @@ type (
 		TTL      time.Duration
 		CachedAt time.Time
 	}
+
+	// CacheStats holds server-wide cache statistics.
+	CacheStats struct {
+		HitCount        int64
+		MissCount       int64
+		KeyCount        int64
+		UsedMemoryBytes int64
+		// HitRatio is HitCount over all lookups, or 0 when there were none.
+		HitRatio float64
+	}
 )
 
 const (
@@ -41,9 +51,23 @@ type DevicesCache interface {
 	// SetDevice stores a device in the cache with the given TTL.
 	SetDevice(ctx context.Context, device *model.Device, ttl time.Duration) error
 
+	// SetDevices stores every device with the given TTL in a single pipelined round trip.
+	SetDevices(ctx context.Context, devices []*model.Device, ttl time.Duration) error
+
+	// MultiplexSet stores a device with the given TTL and, when listsToPurge is set,
+	// removes every device list in the same transaction.
+	MultiplexSet(ctx context.Context, device *model.Device, deviceTTL time.Duration, listsToPurge bool) error
+
 	// InvalidateDevice removes a device from the cache.
 	InvalidateDevice(ctx context.Context, id model.DeviceID) error
 
+	// DeviceCacheTTL reports whether a device is cached and its remaining TTL.
+	// A cached device without expiry has a negative TTL.
+	DeviceCacheTTL(ctx context.Context, id model.DeviceID) (time.Duration, bool, error)
+
+	// DeviceKey returns the cache key a device is stored under.
+	DeviceKey(id model.DeviceID) string
+
 	// GetDeviceList retrieves a device list from the cache based on filter.
 	// Returns a CacheResult with Hit=false if the list is not cached.
 	GetDeviceList(ctx context.Context, filter model.DeviceFilter) (*CacheResult[*model.DeviceList], error)
@@ -57,10 +81,17 @@ type DevicesCache interface {
 	// PurgeAll removes all device-related caches.
 	PurgeAll(ctx context.Context) error
 
+	// PurgeBrand removes the device lists that may contain devices of brand.
+	// Returns the number of lists deleted.
+	PurgeBrand(ctx context.Context, brand string) (int64, error)
+
 	// PurgeByPattern removes caches matching the given pattern.
 	// Returns the number of keys deleted.
 	PurgeByPattern(ctx context.Context, pattern string) (int64, error)
 
 	// IsHealthy checks if the cache is available.
 	IsHealthy(ctx context.Context) bool
+
+	// Stats returns hit/miss counters, key count and memory usage of the cache server.
+	Stats(ctx context.Context) (*CacheStats, error)
 }
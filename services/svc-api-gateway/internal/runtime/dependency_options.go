@@ -2,20 +2,25 @@ package runtime
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"net/http"
+	"os"
 
 	"github.com/architeacher/devices/pkg/decorator"
+	"github.com/architeacher/devices/pkg/events"
 	"github.com/architeacher/devices/pkg/logger"
 	"github.com/architeacher/devices/pkg/metrics/noop"
 	inboundhttp "github.com/architeacher/devices/services/svc-api-gateway/internal/adapters/inbound/http"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/adapters/inbound/http/middleware"
 	grpcclient "github.com/architeacher/devices/services/svc-api-gateway/internal/adapters/outbound/grpc"
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/adapters/repos"
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/adapters/services"
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/config"
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/infrastructure"
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/usecases"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/usecases/queries"
 	"github.com/hashicorp/vault/api"
 )
 
@@ -25,20 +30,22 @@ func defaultOptions(ctx context.Context) []DependencyOption {
 		WithConfigLoader(ctx),
 		WithSecretsRepository(),
 		WithLogger(),
+		WithConfigValidation(),
+		WithMetrics(),
+		WithTracing(),
 		WithCache(ctx),
+		WithEventBus(),
 		WithDataRepositories(),
 		WithServices(),
 		WithApplication(),
 		WithPublicHTTPServer(),
 		WithAdminHTTPServer(),
-		WithMetrics(),
-		WithTracing(),
 	}
 }
 
 func WithConfig() DependencyOption {
 	return func(d *dependencies) error {
-		cfg, err := config.Init()
+		cfg, err := config.LoadConfig(os.Getenv("APP_ENVIRONMENT"))
 		if err != nil {
 			return fmt.Errorf("initializing configuration: %w", err)
 		}
@@ -130,6 +137,16 @@ func WithCache(ctx context.Context) DependencyOption {
 	}
 }
 
+// WithEventBus creates the in-process bus carrying device events from the
+// command handlers to their subscribers, such as cache invalidation.
+func WithEventBus() DependencyOption {
+	return func(d *dependencies) error {
+		d.infra.eventBus = events.NewInMemoryEventBus()
+
+		return nil
+	}
+}
+
 func WithDataRepositories() DependencyOption {
 	return func(d *dependencies) error {
 		if d.config.Idempotency.Enabled && d.infra.cacheClient != nil {
@@ -155,8 +172,20 @@ func WithDataRepositories() DependencyOption {
 		}
 
 		if d.config.DevicesCache.Enabled && d.infra.cacheClient != nil {
-			d.repos.devicesCache = repos.NewDevicesCacheRepository(d.infra.cacheClient, d.infra.logger)
+			devicesCache := repos.NewDevicesCacheRepository(
+				d.infra.cacheClient,
+				d.infra.logger,
+				d.infra.metricsClient,
+			)
+			d.repos.devicesCache = devicesCache
 			d.infra.logger.Info().Msg("devices cache repository initialized")
+
+			unsubscribe := devicesCache.SubscribeInvalidation(d.infra.eventBus)
+			d.cleanupFuncs["devices cache invalidation"] = func(ctx context.Context) error {
+				unsubscribe()
+
+				return nil
+			}
 		}
 
 		return nil
@@ -165,14 +194,21 @@ func WithDataRepositories() DependencyOption {
 
 func WithServices() DependencyOption {
 	return func(d *dependencies) error {
-		conn, err := infrastructure.NewGRPCConnection(d.config)
+		conn, err := infrastructure.NewGRPCConnection(d.config, d.infra.logger)
 		if err != nil {
 			return fmt.Errorf("creating gRPC connection: %w", err)
 		}
 
-		client := grpcclient.NewClient(conn, d.config)
+		client := grpcclient.NewClient(conn, d.config,
+			grpcclient.WithLogger(d.infra.logger),
+			grpcclient.WithMetricsClient(d.infra.metricsClient),
+		)
 		svc := services.NewDevicesService(client)
 
+		if d.infra.cacheClient != nil {
+			svc.RegisterHealthCheck("keydb", d.infra.cacheClient.Ping)
+		}
+
 		d.services = servicesDep{
 			devices:       svc,
 			healthChecker: svc,
@@ -182,6 +218,17 @@ func WithServices() DependencyOption {
 			return conn.Close()
 		}
 
+		if interval := d.config.DevicesGRPCClient.HealthInterval; interval > 0 {
+			watchCtx, stopWatch := context.WithCancel(context.Background())
+			svc.StartHealthWatch(watchCtx, interval)
+
+			d.cleanupFuncs["devices health watch"] = func(context.Context) error {
+				stopWatch()
+
+				return nil
+			}
+		}
+
 		return nil
 	}
 }
@@ -193,9 +240,10 @@ func WithApplication() DependencyOption {
 		if d.repos.devicesCache != nil {
 			cacheOpts = &usecases.CacheOptions{
 				Cache: d.repos.devicesCache,
-				GetDeviceConfig: decorator.CacheConfig{
-					Enabled: d.config.DevicesCache.Enabled,
-					TTL:     d.config.DevicesCache.DeviceTTL,
+				GetDeviceConfig: queries.GetDeviceCacheConfig{
+					Enabled:  d.config.DevicesCache.Enabled,
+					TTL:      d.config.DevicesCache.DeviceTTL,
+					StaleTTL: d.config.DevicesCache.DeviceStaleTTL,
 				},
 				ListDeviceConfig: decorator.CacheConfig{
 					Enabled: d.config.DevicesCache.Enabled,
@@ -207,6 +255,7 @@ func WithApplication() DependencyOption {
 		webApp := usecases.NewWebApplication(
 			d.services.devices,
 			d.services.healthChecker,
+			d.infra.eventBus,
 			cacheOpts,
 			d.infra.logger,
 			d.infra.metricsClient,
@@ -225,6 +274,15 @@ func WithPublicHTTPServer() DependencyOption {
 	return func(d *dependencies) error {
 		cfg := d.config.PublicHTTPServer
 
+		var cpuGuard *middleware.CPUGuard
+
+		if d.config.Compression.Enabled && d.config.Compression.CPUThresholdPercent > 0 {
+			cpuGuard = middleware.NewCPUGuard(d.config.Compression.CPUThresholdPercent, d.infra.logger)
+			cpuGuard.Start()
+
+			d.cleanupFuncs["compression CPU guard"] = cpuGuard.Stop
+		}
+
 		router := inboundhttp.NewRouter(inboundhttp.RouterConfig{
 			App:             d.apps.webApp,
 			IdempotencyRepo: d.repos.idempotencyRepo,
@@ -232,6 +290,8 @@ func WithPublicHTTPServer() DependencyOption {
 			ServiceConfig:   d.config,
 			Logger:          d.infra.logger,
 			MetricsClient:   d.infra.metricsClient,
+			TracerProvider:  d.infra.tracerProvider,
+			CPUGuard:        cpuGuard,
 		})
 
 		d.infra.logger.Info().Msg("creating public HTTP server...")
@@ -263,8 +323,12 @@ func WithAdminHTTPServer() DependencyOption {
 		}
 
 		router := inboundhttp.NewAdminRouter(inboundhttp.AdminRouterConfig{
-			DevicesCache: d.repos.devicesCache,
-			Logger:       d.infra.logger,
+			App:            d.apps.webApp,
+			DevicesCache:   d.repos.devicesCache,
+			Logger:         d.infra.logger,
+			AdminToken:     cfg.Token,
+			Environment:    d.config.GetEnvironment(),
+			PurgeRateLimit: cfg.RateLimit,
 		})
 
 		d.infra.logger.Info().Msg("creating admin HTTP server...")
@@ -293,6 +357,23 @@ func WithLogger() DependencyOption {
 	}
 }
 
+// WithConfigValidation logs every configuration problem before failing, so one
+// restart is enough to see all of them.
+func WithConfigValidation() DependencyOption {
+	return func(d *dependencies) error {
+		errs := d.config.Validate()
+		if len(errs) == 0 {
+			return nil
+		}
+
+		for _, err := range errs {
+			d.infra.logger.Error().Err(err).Msg("invalid configuration")
+		}
+
+		return fmt.Errorf("validating configuration: %w", errors.Join(errs...))
+	}
+}
+
 func WithMetrics() DependencyOption {
 	return func(d *dependencies) error {
 		d.infra.metricsClient = noop.NewMetricsClient()
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/architeacher/devices/pkg/events"
 	"github.com/architeacher/devices/pkg/logger"
 	"github.com/architeacher/devices/pkg/metrics"
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/config"
@@ -20,6 +21,7 @@ type (
 		publicHttpServer *http.Server
 		adminHttpServer  *http.Server
 		cacheClient      *infrastructure.KeydbClient
+		eventBus         events.EventBus
 		logger           logger.Logger
 		metricsClient    metrics.Client
 		tracerProvider   otelTrace.TracerProvider
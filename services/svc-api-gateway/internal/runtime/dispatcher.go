@@ -10,6 +10,9 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/domain/model"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/usecases/commands"
 )
 
 type ServiceCtx struct {
@@ -38,6 +41,7 @@ func (c *ServiceCtx) Run() {
 	}
 
 	c.startService()
+	c.preloadDevicesCache()
 	c.shutdownHook()
 	c.monitorConfigChanges()
 
@@ -116,6 +120,37 @@ func (c *ServiceCtx) startAdminServer() {
 	}()
 }
 
+// preloadDevicesCache warms the devices cache in the background when enabled, so a
+// slow or unavailable svc-devices does not hold up serving. A failed preload is only
+// logged, as reads fall back to the service.
+func (c *ServiceCtx) preloadDevicesCache() {
+	cfg := c.deps.config.DevicesCache
+	preload := c.deps.apps.webApp.Commands.PreloadDevicesCache
+
+	if !cfg.Enabled || !cfg.PreloadOnStartup || preload == nil {
+		return
+	}
+
+	go func() {
+		filter := model.DefaultDeviceFilter()
+		filter.Size = min(cfg.PreloadSize, model.MaxPageSize)
+
+		preloaded, err := preload.Handle(c.serverCtx, commands.PreloadDevicesCacheCommand{Filter: filter})
+		if err != nil {
+			c.deps.infra.logger.Warn().
+				Err(err).
+				Int("preloaded", preloaded).
+				Msg("failed to preload the devices cache")
+
+			return
+		}
+
+		c.deps.infra.logger.Info().
+			Int("preloaded", preloaded).
+			Msg("devices cache preloaded")
+	}()
+}
+
 func (c *ServiceCtx) monitorConfigChanges() {
 	if c.deps.configLoader == nil {
 		return
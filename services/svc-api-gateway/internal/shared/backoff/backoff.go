@@ -1,6 +1,7 @@
 package backoff
 
 import (
+	"math"
 	"math/rand"
 	"time"
 
@@ -21,6 +22,12 @@ type (
 		// config contains all options to configure the backoff algorithm.
 		config config.Backoff
 	}
+
+	// FullJitter implements exponential backoff with "full jitter", picking the
+	// delay uniformly between zero and the capped exponential delay.
+	FullJitter struct {
+		config config.Backoff
+	}
 )
 
 func NewExponentialStrategy(cfg config.Backoff) Exponential {
@@ -52,3 +59,24 @@ func (bc Exponential) Backoff(retries int) time.Duration {
 
 	return time.Duration(backoff)
 }
+
+func NewFullJitterStrategy(cfg config.Backoff) FullJitter {
+	return FullJitter{
+		config: cfg,
+	}
+}
+
+// Backoff returns random(0, min(MaxDelay, BaseDelay * Multiplier^retries)).
+func (fj FullJitter) Backoff(retries int) time.Duration {
+	ceiling := float64(fj.config.BaseDelay) * math.Pow(fj.config.Multiplier, float64(retries))
+
+	if maxDelay := float64(fj.config.MaxDelay); maxDelay > 0 && ceiling > maxDelay {
+		ceiling = maxDelay
+	}
+
+	if ceiling <= 0 {
+		return 0
+	}
+
+	return time.Duration(rand.Float64() * ceiling)
+}
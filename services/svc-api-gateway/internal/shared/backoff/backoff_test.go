@@ -0,0 +1,48 @@
+package backoff
+
+import (
+	"testing"
+	"time"
+
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFullJitter_Backoff(t *testing.T) {
+	t.Parallel()
+
+	strategy := NewFullJitterStrategy(config.Backoff{
+		BaseDelay:  100 * time.Millisecond,
+		Multiplier: 2,
+		MaxDelay:   time.Second,
+	})
+
+	cases := []struct {
+		name    string
+		retries int
+		ceiling time.Duration
+	}{
+		{name: "first retry is bounded by the base delay", retries: 0, ceiling: 100 * time.Millisecond},
+		{name: "grows exponentially", retries: 2, ceiling: 400 * time.Millisecond},
+		{name: "is capped by the max delay", retries: 10, ceiling: time.Second},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			for range 100 {
+				delay := strategy.Backoff(tc.retries)
+				require.GreaterOrEqual(t, delay, time.Duration(0))
+				require.Less(t, delay, tc.ceiling)
+			}
+		})
+	}
+}
+
+func TestFullJitter_ZeroBaseDelay(t *testing.T) {
+	t.Parallel()
+
+	strategy := NewFullJitterStrategy(config.Backoff{Multiplier: 2, MaxDelay: time.Second})
+	require.Zero(t, strategy.Backoff(3))
+}
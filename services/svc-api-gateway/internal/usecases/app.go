@@ -2,9 +2,9 @@ package usecases
 
 import (
 	"github.com/architeacher/devices/pkg/decorator"
+	"github.com/architeacher/devices/pkg/events"
 	"github.com/architeacher/devices/pkg/logger"
 	"github.com/architeacher/devices/pkg/metrics"
-	"github.com/architeacher/devices/services/svc-api-gateway/internal/adapters/repos"
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/ports"
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/usecases/commands"
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/usecases/queries"
@@ -15,23 +15,29 @@ type (
 	// CacheOptions holds cache configuration for the web application.
 	CacheOptions struct {
 		Cache            ports.DevicesCache
-		GetDeviceConfig  decorator.CacheConfig
+		GetDeviceConfig  queries.GetDeviceCacheConfig
 		ListDeviceConfig decorator.CacheConfig
 	}
 
 	Commands struct {
-		CreateDevice commands.CreateDeviceCommandHandler
-		UpdateDevice commands.UpdateDeviceCommandHandler
-		PatchDevice  commands.PatchDeviceCommandHandler
-		DeleteDevice commands.DeleteDeviceCommandHandler
+		CreateDevice          commands.CreateDeviceCommandHandler
+		UpdateDevice          commands.UpdateDeviceCommandHandler
+		PatchDevice           commands.PatchDeviceCommandHandler
+		TransitionDeviceState commands.TransitionDeviceStateCommandHandler
+		ForceState            commands.ForceStateCommandHandler
+		DeleteDevice          commands.DeleteDeviceCommandHandler
+		// PreloadDevicesCache is nil when the cache is disabled.
+		PreloadDevicesCache commands.PreloadDevicesCacheCommandHandler
 	}
 
 	Queries struct {
-		GetDevice         queries.GetDeviceQueryHandler
-		ListDevices       queries.ListDevicesQueryHandler
-		FetchLiveness     queries.FetchLivenessQueryHandler
-		FetchReadiness    queries.FetchReadinessQueryHandler
-		FetchHealthReport queries.FetchHealthReportQueryHandler
+		GetDevice          queries.GetDeviceQueryHandler
+		GetDeviceWithCache queries.GetDeviceWithCacheQueryHandler
+		ListDevices        queries.ListDevicesQueryHandler
+		SimilarDevices     queries.SimilarDevicesQueryHandler
+		FetchLiveness      queries.FetchLivenessQueryHandler
+		FetchReadiness     queries.FetchReadinessQueryHandler
+		FetchHealthReport  queries.FetchHealthReportQueryHandler
 	}
 
 	WebApplication struct {
@@ -43,38 +49,59 @@ type (
 func NewWebApplication(
 	deviceSvc ports.DevicesService,
 	healthChecker ports.HealthChecker,
+	eventBus events.EventBus,
 	cacheOpts *CacheOptions,
 	log logger.Logger,
 	metricsClient metrics.Client,
 	tracerProvider otelTrace.TracerProvider,
 ) *WebApplication {
-	return &WebApplication{
-		Commands: buildCommands(deviceSvc, cacheOpts, log, metricsClient, tracerProvider),
+	app := &WebApplication{
+		Commands: buildCommands(deviceSvc, eventBus, log, metricsClient, tracerProvider),
 		Queries:  buildQueries(deviceSvc, healthChecker, cacheOpts, log, metricsClient, tracerProvider),
 	}
+
+	if cacheOpts != nil && cacheOpts.Cache != nil {
+		app.Commands.PreloadDevicesCache = commands.NewPreloadDevicesCacheCommandHandler(
+			deviceSvc,
+			cacheOpts.Cache,
+			commands.PreloadDevicesCacheConfig{
+				DeviceTTL: cacheOpts.GetDeviceConfig.TTL + cacheOpts.GetDeviceConfig.StaleTTL,
+				ListTTL:   cacheOpts.ListDeviceConfig.TTL,
+			},
+			log,
+			metricsClient,
+			tracerProvider,
+		)
+	}
+
+	return app
 }
 
 func buildCommands(
 	deviceSvc ports.DevicesService,
-	cacheOpts *CacheOptions,
+	eventBus events.EventBus,
 	log logger.Logger,
 	metricsClient metrics.Client,
 	tracerProvider otelTrace.TracerProvider,
 ) Commands {
-	if cacheOpts != nil && cacheOpts.Cache != nil {
+	if eventBus != nil {
 		return Commands{
-			CreateDevice: commands.NewCreateDeviceCommandHandlerWithCache(deviceSvc, cacheOpts.Cache, log, metricsClient, tracerProvider),
-			UpdateDevice: commands.NewUpdateDeviceCommandHandlerWithCache(deviceSvc, cacheOpts.Cache, log, metricsClient, tracerProvider),
-			PatchDevice:  commands.NewPatchDeviceCommandHandlerWithCache(deviceSvc, cacheOpts.Cache, log, metricsClient, tracerProvider),
-			DeleteDevice: commands.NewDeleteDeviceCommandHandlerWithCache(deviceSvc, cacheOpts.Cache, log, metricsClient, tracerProvider),
+			CreateDevice:          commands.NewCreateDeviceCommandHandlerWithEvents(deviceSvc, eventBus, log, metricsClient, tracerProvider),
+			UpdateDevice:          commands.NewUpdateDeviceCommandHandlerWithEvents(deviceSvc, eventBus, log, metricsClient, tracerProvider),
+			PatchDevice:           commands.NewPatchDeviceCommandHandlerWithEvents(deviceSvc, eventBus, log, metricsClient, tracerProvider),
+			TransitionDeviceState: commands.NewTransitionDeviceStateCommandHandlerWithEvents(deviceSvc, eventBus, log, metricsClient, tracerProvider),
+			ForceState:            commands.NewForceStateCommandHandlerWithEvents(deviceSvc, eventBus, log, metricsClient, tracerProvider),
+			DeleteDevice:          commands.NewDeleteDeviceCommandHandlerWithEvents(deviceSvc, eventBus, log, metricsClient, tracerProvider),
 		}
 	}
 
 	return Commands{
-		CreateDevice: commands.NewCreateDeviceCommandHandler(deviceSvc, log, metricsClient, tracerProvider),
-		UpdateDevice: commands.NewUpdateDeviceCommandHandler(deviceSvc, log, metricsClient, tracerProvider),
-		PatchDevice:  commands.NewPatchDeviceCommandHandler(deviceSvc, log, metricsClient, tracerProvider),
-		DeleteDevice: commands.NewDeleteDeviceCommandHandler(deviceSvc, log, metricsClient, tracerProvider),
+		CreateDevice:          commands.NewCreateDeviceCommandHandler(deviceSvc, log, metricsClient, tracerProvider),
+		UpdateDevice:          commands.NewUpdateDeviceCommandHandler(deviceSvc, log, metricsClient, tracerProvider),
+		PatchDevice:           commands.NewPatchDeviceCommandHandler(deviceSvc, log, metricsClient, tracerProvider),
+		TransitionDeviceState: commands.NewTransitionDeviceStateCommandHandler(deviceSvc, log, metricsClient, tracerProvider),
+		ForceState:            commands.NewForceStateCommandHandler(deviceSvc, log, metricsClient, tracerProvider),
+		DeleteDevice:          commands.NewDeleteDeviceCommandHandler(deviceSvc, log, metricsClient, tracerProvider),
 	}
 }
 
@@ -87,15 +114,17 @@ func buildQueries(
 	tracerProvider otelTrace.TracerProvider,
 ) Queries {
 	q := Queries{
+		GetDevice:         queries.NewGetDeviceQueryHandler(deviceSvc, log, metricsClient, tracerProvider),
+		SimilarDevices:    queries.NewSimilarDevicesQueryHandler(deviceSvc, log, metricsClient, tracerProvider),
 		FetchLiveness:     queries.NewFetchLivenessQueryHandler(healthChecker, log, metricsClient, tracerProvider),
 		FetchReadiness:    queries.NewFetchReadinessQueryHandler(healthChecker, log, metricsClient, tracerProvider),
 		FetchHealthReport: queries.NewFetchHealthReportQueryHandler(healthChecker, log, metricsClient, tracerProvider),
 	}
 
 	if cacheOpts != nil && cacheOpts.Cache != nil {
-		q.GetDevice = queries.NewGetDeviceQueryHandlerWithCache(
+		q.GetDeviceWithCache = queries.NewGetDeviceWithCacheQueryHandler(
 			deviceSvc,
-			repos.NewGetDeviceCacheAdapter(cacheOpts.Cache),
+			cacheOpts.Cache,
 			cacheOpts.GetDeviceConfig,
 			log,
 			metricsClient,
@@ -103,14 +132,21 @@ func buildQueries(
 		)
 		q.ListDevices = queries.NewListDevicesQueryHandlerWithCache(
 			deviceSvc,
-			repos.NewListDevicesCacheAdapter(cacheOpts.Cache),
+			cacheOpts.Cache,
 			cacheOpts.ListDeviceConfig,
 			log,
 			metricsClient,
 			tracerProvider,
 		)
 	} else {
-		q.GetDevice = queries.NewGetDeviceQueryHandler(deviceSvc, log, metricsClient, tracerProvider)
+		q.GetDeviceWithCache = queries.NewGetDeviceWithCacheQueryHandler(
+			deviceSvc,
+			nil,
+			queries.GetDeviceCacheConfig{},
+			log,
+			metricsClient,
+			tracerProvider,
+		)
 		q.ListDevices = queries.NewListDevicesQueryHandler(deviceSvc, log, metricsClient, tracerProvider)
 	}
 
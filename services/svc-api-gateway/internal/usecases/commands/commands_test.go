@@ -3,8 +3,11 @@ package commands_test
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/architeacher/devices/pkg/events"
 	"github.com/architeacher/devices/pkg/logger"
 	"github.com/architeacher/devices/pkg/metrics/noop"
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/domain/model"
@@ -95,6 +98,139 @@ func TestCreateDeviceCommandHandler(t *testing.T) {
 	}
 }
 
+func TestDeviceCommands_Validate(t *testing.T) {
+	t.Parallel()
+
+	longText := strings.Repeat("x", 256)
+
+	cases := []struct {
+		name           string
+		deviceName     string
+		brand          string
+		state          model.State
+		expectedFields []string
+		expectedCodes  []string
+	}{
+		{
+			name:       "valid fields",
+			deviceName: "iPhone",
+			brand:      "Apple",
+			state:      model.StateInUse,
+		},
+		{
+			name:       "255 multi-byte characters are accepted",
+			deviceName: strings.Repeat("é", 255),
+			brand:      "Apple",
+			state:      model.StateAvailable,
+		},
+		{
+			name:           "empty name",
+			brand:          "Apple",
+			state:          model.StateAvailable,
+			expectedFields: []string{"name"},
+			expectedCodes:  []string{model.ValidationCodeRequired},
+		},
+		{
+			name:           "blank brand",
+			deviceName:     "iPhone",
+			brand:          "   ",
+			state:          model.StateAvailable,
+			expectedFields: []string{"brand"},
+			expectedCodes:  []string{model.ValidationCodeRequired},
+		},
+		{
+			name:           "name too long",
+			deviceName:     longText,
+			brand:          "Apple",
+			state:          model.StateAvailable,
+			expectedFields: []string{"name"},
+			expectedCodes:  []string{model.ValidationCodeOutOfRange},
+		},
+		{
+			name:           "brand too long",
+			deviceName:     "iPhone",
+			brand:          longText,
+			state:          model.StateAvailable,
+			expectedFields: []string{"brand"},
+			expectedCodes:  []string{model.ValidationCodeOutOfRange},
+		},
+		{
+			name:           "unknown state",
+			deviceName:     "iPhone",
+			brand:          "Apple",
+			state:          model.State("broken"),
+			expectedFields: []string{"state"},
+			expectedCodes:  []string{model.ValidationCodeInvalidEnum},
+		},
+		{
+			name:           "every field invalid at once",
+			brand:          longText,
+			expectedFields: []string{"name", "brand", "state"},
+			expectedCodes:  []string{model.ValidationCodeRequired, model.ValidationCodeOutOfRange, model.ValidationCodeInvalidEnum},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			validators := map[string]interface{ Validate() error }{
+				"create": commands.CreateDeviceCommand{Name: tc.deviceName, Brand: tc.brand, State: tc.state},
+				"update": commands.UpdateDeviceCommand{ID: model.NewDeviceID(), Name: tc.deviceName, Brand: tc.brand, State: tc.state},
+			}
+
+			for kind, cmd := range validators {
+				err := cmd.Validate()
+
+				if len(tc.expectedFields) == 0 {
+					require.NoError(t, err, kind)
+
+					continue
+				}
+
+				var validationErrs *model.ValidationErrors
+				require.ErrorAs(t, err, &validationErrs, kind)
+
+				fields := make([]string, 0, len(validationErrs.Errors))
+				codes := make([]string, 0, len(validationErrs.Errors))
+
+				for _, validationErr := range validationErrs.Errors {
+					require.NotEmpty(t, validationErr.Message)
+
+					fields = append(fields, validationErr.Field)
+					codes = append(codes, validationErr.Code)
+				}
+
+				require.Equal(t, tc.expectedFields, fields, kind)
+				require.Equal(t, tc.expectedCodes, codes, kind)
+			}
+		})
+	}
+}
+
+func TestDeviceCommandHandlers_RejectInvalidCommands(t *testing.T) {
+	t.Parallel()
+
+	log := logger.NewTestLogger()
+	tp := otelNoop.NewTracerProvider()
+	mc := noop.NewMetricsClient()
+
+	svc := &mocks.FakeDevicesService{}
+
+	_, err := commands.NewCreateDeviceCommandHandler(svc, log, mc, tp).
+		Handle(t.Context(), commands.CreateDeviceCommand{Brand: "Apple", State: model.StateAvailable})
+
+	var validationErrs *model.ValidationErrors
+	require.ErrorAs(t, err, &validationErrs)
+	require.Zero(t, svc.CreateDeviceCallCount())
+
+	_, err = commands.NewUpdateDeviceCommandHandler(svc, log, mc, tp).
+		Handle(t.Context(), commands.UpdateDeviceCommand{ID: model.NewDeviceID(), Name: "iPhone", Brand: "Apple"})
+
+	require.ErrorAs(t, err, &validationErrs)
+	require.Zero(t, svc.UpdateDeviceCallCount())
+}
+
 func TestUpdateDeviceCommandHandler(t *testing.T) {
 	t.Parallel()
 
@@ -281,6 +417,165 @@ func TestPatchDeviceCommandHandler(t *testing.T) {
 	}
 }
 
+func TestTransitionDeviceStateCommandHandler(t *testing.T) {
+	t.Parallel()
+
+	log := logger.NewTestLogger()
+	tp := otelNoop.NewTracerProvider()
+	mc := noop.NewMetricsClient()
+
+	cases := []struct {
+		name          string
+		current       model.State
+		target        model.State
+		getErr        error
+		patchErr      error
+		expectedErr   error
+		expectPatched bool
+	}{
+		{
+			name:          "allowed transition patches state only",
+			current:       model.StateAvailable,
+			target:        model.StateInUse,
+			expectPatched: true,
+		},
+		{
+			name:          "device modified since it was read",
+			current:       model.StateAvailable,
+			target:        model.StateInUse,
+			patchErr:      model.ErrDeviceModified,
+			expectedErr:   model.ErrDeviceModified,
+			expectPatched: true,
+		},
+		{
+			name:        "invalid transition is rejected before patching",
+			current:     model.StateInUse,
+			target:      model.StateInactive,
+			expectedErr: model.ErrInvalidStateTransition,
+		},
+		{
+			name:        "device not found",
+			target:      model.StateInUse,
+			getErr:      model.ErrDeviceNotFound,
+			expectedErr: model.ErrDeviceNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			id := model.NewDeviceID()
+			svc := &mocks.FakeDevicesService{}
+
+			updatedAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+			if tc.getErr != nil {
+				svc.GetDeviceReturns(nil, tc.getErr)
+			} else {
+				svc.GetDeviceReturns(&model.Device{ID: id, Name: "iPhone", Brand: "Apple", State: tc.current, UpdatedAt: updatedAt}, nil)
+			}
+
+			if tc.patchErr != nil {
+				svc.PatchDeviceIfUnmodifiedReturns(nil, tc.patchErr)
+			} else {
+				svc.PatchDeviceIfUnmodifiedReturns(&model.Device{ID: id, Name: "iPhone", Brand: "Apple", State: tc.target}, nil)
+			}
+
+			handler := commands.NewTransitionDeviceStateCommandHandler(svc, log, mc, tp)
+
+			device, err := handler.Handle(t.Context(), commands.TransitionDeviceStateCommand{ID: id, State: tc.target})
+
+			require.Zero(t, svc.PatchDeviceCallCount(), "the state is never patched unconditionally")
+
+			if !tc.expectPatched {
+				require.Zero(t, svc.PatchDeviceIfUnmodifiedCallCount())
+			} else {
+				require.Equal(t, 1, svc.PatchDeviceIfUnmodifiedCallCount())
+
+				_, patchedID, updates, lastUpdatedAt := svc.PatchDeviceIfUnmodifiedArgsForCall(0)
+				require.Equal(t, id, patchedID)
+				require.Equal(t, map[string]any{"state": tc.target.String()}, updates)
+				require.Equal(t, updatedAt, lastUpdatedAt, "the patch is conditional on the version read")
+			}
+
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.target, device.State)
+		})
+	}
+}
+
+func TestForceStateCommandHandler(t *testing.T) {
+	t.Parallel()
+
+	log := logger.NewTestLogger()
+	tp := otelNoop.NewTracerProvider()
+	mc := noop.NewMetricsClient()
+
+	cases := []struct {
+		name        string
+		target      model.State
+		patchErr    error
+		expectedErr error
+	}{
+		{
+			name:   "in-use device is released",
+			target: model.StateAvailable,
+		},
+		{
+			name:   "transition rejected by the state machine is forced",
+			target: model.StateInactive,
+		},
+		{
+			name:        "device not found",
+			target:      model.StateAvailable,
+			patchErr:    model.ErrDeviceNotFound,
+			expectedErr: model.ErrDeviceNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			id := model.NewDeviceID()
+			svc := &mocks.FakeDevicesService{}
+			svc.GetDeviceReturns(&model.Device{ID: id, Name: "iPhone", Brand: "Apple", State: model.StateInUse}, nil)
+
+			if tc.patchErr != nil {
+				svc.PatchDeviceReturns(nil, tc.patchErr)
+			} else {
+				svc.PatchDeviceReturns(&model.Device{ID: id, Name: "iPhone", Brand: "Apple", State: tc.target}, nil)
+			}
+
+			handler := commands.NewForceStateCommandHandler(svc, log, mc, tp)
+
+			device, err := handler.Handle(t.Context(), commands.ForceStateCommand{ID: id, State: tc.target})
+
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.target, device.State)
+			require.Zero(t, svc.GetDeviceCallCount(), "force must not consult the current state")
+			require.Equal(t, 1, svc.PatchDeviceCallCount())
+
+			_, patchedID, updates := svc.PatchDeviceArgsForCall(0)
+			require.Equal(t, id, patchedID)
+			require.Equal(t, map[string]any{"state": tc.target.String()}, updates)
+		})
+	}
+}
+
 func TestDeleteDeviceCommandHandler(t *testing.T) {
 	t.Parallel()
 
@@ -342,3 +637,238 @@ func TestDeleteDeviceCommandHandler(t *testing.T) {
 		})
 	}
 }
+
+func TestPreloadDevicesCacheCommandHandler(t *testing.T) {
+	t.Parallel()
+
+	log := logger.NewTestLogger()
+	tp := otelNoop.NewTracerProvider()
+	mc := noop.NewMetricsClient()
+
+	config := commands.PreloadDevicesCacheConfig{DeviceTTL: 15 * time.Minute, ListTTL: time.Minute}
+	filter := model.DeviceFilter{Brands: []string{"Apple"}, Page: 1, Size: 20}
+
+	devices := []*model.Device{
+		model.NewDevice("iPhone", "Apple", model.StateAvailable),
+		model.NewDevice("iPad", "Apple", model.StateInUse),
+		model.NewDevice("MacBook", "Apple", model.StateInactive),
+	}
+
+	cases := []struct {
+		name              string
+		filter            model.DeviceFilter
+		listErr           error
+		setDevicesErr     error
+		setListErr        error
+		expectedPreloaded int
+		expectedSetCalls  int
+		expectListCached  bool
+		expectError       bool
+		expectedErr       error
+	}{
+		{
+			name:              "caches every device and the list",
+			filter:            filter,
+			expectedPreloaded: 3,
+			expectedSetCalls:  1,
+			expectListCached:  true,
+		},
+		{
+			name:        "invalid filter reads nothing",
+			filter:      model.DeviceFilter{Page: 0, Size: 20},
+			expectError: true,
+		},
+		{
+			name:        "list failure caches nothing",
+			filter:      filter,
+			listErr:     model.ErrServiceUnavailable,
+			expectError: true,
+			expectedErr: model.ErrServiceUnavailable,
+		},
+		{
+			name:             "device write failure stops the preload",
+			filter:           filter,
+			setDevicesErr:    errors.New("connection reset"),
+			expectedSetCalls: 1,
+			expectError:      true,
+		},
+		{
+			name:              "list write failure keeps the devices",
+			filter:            filter,
+			setListErr:        errors.New("connection reset"),
+			expectedPreloaded: 3,
+			expectedSetCalls:  1,
+			expectListCached:  true,
+			expectError:       true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := &mocks.FakeDevicesService{}
+			svc.ListDevicesReturns(&model.DeviceList{Devices: devices, Filters: tc.filter}, tc.listErr)
+
+			cache := &mocks.FakeDevicesCache{}
+			cache.SetDevicesReturns(tc.setDevicesErr)
+			cache.SetDeviceListReturns(tc.setListErr)
+
+			handler := commands.NewPreloadDevicesCacheCommandHandler(svc, cache, config, log, mc, tp)
+
+			preloaded, err := handler.Handle(t.Context(), commands.PreloadDevicesCacheCommand{Filter: tc.filter})
+
+			if tc.expectError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+			}
+
+			require.Equal(t, tc.expectedPreloaded, preloaded)
+			require.Equal(t, tc.expectedSetCalls, cache.SetDevicesCallCount())
+			require.Zero(t, cache.SetDeviceCallCount())
+
+			if cache.SetDevicesCallCount() > 0 {
+				_, cached, ttl := cache.SetDevicesArgsForCall(0)
+				require.Equal(t, devices, cached)
+				require.Equal(t, config.DeviceTTL, ttl)
+			}
+
+			if !tc.expectListCached {
+				require.Zero(t, cache.SetDeviceListCallCount())
+
+				return
+			}
+
+			require.Equal(t, 1, cache.SetDeviceListCallCount())
+
+			_, list, listFilter, ttl := cache.SetDeviceListArgsForCall(0)
+			require.Len(t, list.Devices, len(devices))
+			require.Equal(t, tc.filter, listFilter)
+			require.Equal(t, config.ListTTL, ttl)
+		})
+	}
+}
+
+func TestDeviceCommandHandlers_PublishEvents(t *testing.T) {
+	t.Parallel()
+
+	log := logger.NewTestLogger()
+	tp := otelNoop.NewTracerProvider()
+	mc := noop.NewMetricsClient()
+
+	device := model.NewDevice("iPhone", "Apple", model.StateAvailable)
+
+	cases := []struct {
+		name          string
+		handle        func(ctx context.Context, svc *mocks.FakeDevicesService, bus events.EventBus) error
+		expectedEvent events.Event
+	}{
+		{
+			name: "create publishes DeviceCreatedEvent",
+			handle: func(ctx context.Context, svc *mocks.FakeDevicesService, bus events.EventBus) error {
+				_, err := commands.NewCreateDeviceCommandHandlerWithEvents(svc, bus, log, mc, tp).
+					Handle(ctx, commands.CreateDeviceCommand{Name: "iPhone", Brand: "Apple", State: model.StateAvailable})
+
+				return err
+			},
+			expectedEvent: model.DeviceCreatedEvent{Device: device},
+		},
+		{
+			name: "update publishes DeviceUpdatedEvent",
+			handle: func(ctx context.Context, svc *mocks.FakeDevicesService, bus events.EventBus) error {
+				_, err := commands.NewUpdateDeviceCommandHandlerWithEvents(svc, bus, log, mc, tp).
+					Handle(ctx, commands.UpdateDeviceCommand{ID: device.ID, Name: "iPhone", Brand: "Apple", State: model.StateAvailable})
+
+				return err
+			},
+			expectedEvent: model.DeviceUpdatedEvent{ID: device.ID, Device: device},
+		},
+		{
+			name: "patch publishes DeviceUpdatedEvent",
+			handle: func(ctx context.Context, svc *mocks.FakeDevicesService, bus events.EventBus) error {
+				_, err := commands.NewPatchDeviceCommandHandlerWithEvents(svc, bus, log, mc, tp).
+					Handle(ctx, commands.PatchDeviceCommand{ID: device.ID, Updates: map[string]any{"name": "iPhone"}})
+
+				return err
+			},
+			expectedEvent: model.DeviceUpdatedEvent{ID: device.ID, Device: device},
+		},
+		{
+			name: "force state publishes DeviceUpdatedEvent",
+			handle: func(ctx context.Context, svc *mocks.FakeDevicesService, bus events.EventBus) error {
+				_, err := commands.NewForceStateCommandHandlerWithEvents(svc, bus, log, mc, tp).
+					Handle(ctx, commands.ForceStateCommand{ID: device.ID, State: model.StateAvailable})
+
+				return err
+			},
+			expectedEvent: model.DeviceUpdatedEvent{ID: device.ID, Device: device},
+		},
+		{
+			name: "delete publishes DeviceDeletedEvent",
+			handle: func(ctx context.Context, svc *mocks.FakeDevicesService, bus events.EventBus) error {
+				_, err := commands.NewDeleteDeviceCommandHandlerWithEvents(svc, bus, log, mc, tp).
+					Handle(ctx, commands.DeleteDeviceCommand{ID: device.ID})
+
+				return err
+			},
+			expectedEvent: model.DeviceDeletedEvent{ID: device.ID},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := &mocks.FakeDevicesService{}
+			svc.CreateDeviceReturns(device, nil)
+			svc.UpdateDeviceReturns(device, nil)
+			svc.PatchDeviceReturns(device, nil)
+
+			published := make(chan events.Event, 1)
+
+			bus := events.NewInMemoryEventBus()
+			bus.Subscribe(tc.expectedEvent.EventType(), func(_ context.Context, event events.Event) error {
+				published <- event
+
+				return nil
+			})
+
+			require.NoError(t, tc.handle(t.Context(), svc, bus))
+
+			select {
+			case event := <-published:
+				require.Equal(t, tc.expectedEvent, event)
+			case <-time.After(time.Second):
+				t.Fatal("no event published")
+			}
+		})
+	}
+}
+
+func TestDeviceCommandHandlers_FailedCommandPublishesNothing(t *testing.T) {
+	t.Parallel()
+
+	svc := &mocks.FakeDevicesService{}
+	svc.DeleteDeviceReturns(model.ErrDeviceNotFound)
+
+	published := make(chan events.Event, 1)
+
+	bus := events.NewInMemoryEventBus()
+	bus.Subscribe(model.DeviceDeletedEventType, func(_ context.Context, event events.Event) error {
+		published <- event
+
+		return nil
+	})
+
+	handler := commands.NewDeleteDeviceCommandHandlerWithEvents(svc, bus, logger.NewTestLogger(), noop.NewMetricsClient(), otelNoop.NewTracerProvider())
+
+	_, err := handler.Handle(t.Context(), commands.DeleteDeviceCommand{ID: model.NewDeviceID()})
+	require.ErrorIs(t, err, model.ErrDeviceNotFound)
+
+	require.Never(t, func() bool { return len(published) > 0 }, 50*time.Millisecond, 10*time.Millisecond)
+}
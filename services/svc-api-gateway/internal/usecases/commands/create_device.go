@@ -2,8 +2,10 @@ package commands
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/architeacher/devices/pkg/decorator"
+	"github.com/architeacher/devices/pkg/events"
 	"github.com/architeacher/devices/pkg/logger"
 	"github.com/architeacher/devices/pkg/metrics"
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/domain/model"
@@ -13,16 +15,16 @@ import (
 
 type (
 	CreateDeviceCommand struct {
-		Name  string
-		Brand string
-		State model.State
+		Name  string      `validate:"notblank,max=255"`
+		Brand string      `validate:"notblank,max=255"`
+		State model.State `validate:"devicestate"`
 	}
 
 	CreateDeviceCommandHandler = decorator.CommandHandler[CreateDeviceCommand, *model.Device]
 
 	createDeviceCommandHandler struct {
 		devicesService ports.DevicesService
-		cache          ports.DevicesCache
+		eventBus       events.EventBus
 	}
 )
 
@@ -40,33 +42,39 @@ func NewCreateDeviceCommandHandler(
 	)
 }
 
-// NewCreateDeviceCommandHandlerWithCache creates a command handler with cache invalidation.
-func NewCreateDeviceCommandHandlerWithCache(
+// NewCreateDeviceCommandHandlerWithEvents creates a command handler that publishes device events to eventBus.
+func NewCreateDeviceCommandHandlerWithEvents(
 	svc ports.DevicesService,
-	cache ports.DevicesCache,
+	eventBus events.EventBus,
 	log logger.Logger,
 	metricsClient metrics.Client,
 	tracerProvider otelTrace.TracerProvider,
 ) CreateDeviceCommandHandler {
 	return decorator.ApplyCommandDecorators[CreateDeviceCommand, *model.Device](
-		createDeviceCommandHandler{devicesService: svc, cache: cache},
+		createDeviceCommandHandler{devicesService: svc, eventBus: eventBus},
 		log,
 		metricsClient,
 		tracerProvider,
 	)
 }
 
+// Validate checks the name, brand and state, returning *model.ValidationErrors listing
+// every invalid field.
+func (c CreateDeviceCommand) Validate() error {
+	return model.ValidateStruct(c)
+}
+
 func (h createDeviceCommandHandler) Handle(ctx context.Context, cmd CreateDeviceCommand) (*model.Device, error) {
+	if err := cmd.Validate(); err != nil {
+		return nil, fmt.Errorf("validating create device command: %w", err)
+	}
+
 	device, err := h.devicesService.CreateDevice(ctx, cmd.Name, cmd.Brand, cmd.State)
 	if err != nil {
 		return nil, err
 	}
 
-	if h.cache != nil {
-		go func() {
-			_ = h.cache.InvalidateAllLists(context.Background())
-		}()
-	}
+	publishEvent(h.eventBus, model.DeviceCreatedEvent{Device: device})
 
 	return device, nil
 }
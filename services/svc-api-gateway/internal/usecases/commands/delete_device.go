@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/architeacher/devices/pkg/decorator"
+	"github.com/architeacher/devices/pkg/events"
 	"github.com/architeacher/devices/pkg/logger"
 	"github.com/architeacher/devices/pkg/metrics"
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/domain/model"
@@ -24,7 +25,7 @@ type (
 
 	deleteDeviceCommandHandler struct {
 		deviceService ports.DevicesService
-		cache         ports.DevicesCache
+		eventBus      events.EventBus
 	}
 )
 
@@ -42,16 +43,16 @@ func NewDeleteDeviceCommandHandler(
 	)
 }
 
-// NewDeleteDeviceCommandHandlerWithCache creates a command handler with cache invalidation.
-func NewDeleteDeviceCommandHandlerWithCache(
+// NewDeleteDeviceCommandHandlerWithEvents creates a command handler that publishes device events to eventBus.
+func NewDeleteDeviceCommandHandlerWithEvents(
 	svc ports.DevicesService,
-	cache ports.DevicesCache,
+	eventBus events.EventBus,
 	log logger.Logger,
 	metricsClient metrics.Client,
 	tracerProvider otelTrace.TracerProvider,
 ) DeleteDeviceCommandHandler {
 	return decorator.ApplyCommandDecorators[DeleteDeviceCommand, DeleteDeviceResult](
-		deleteDeviceCommandHandler{deviceService: svc, cache: cache},
+		deleteDeviceCommandHandler{deviceService: svc, eventBus: eventBus},
 		log,
 		metricsClient,
 		tracerProvider,
@@ -63,13 +64,7 @@ func (h deleteDeviceCommandHandler) Handle(ctx context.Context, cmd DeleteDevice
 		return DeleteDeviceResult{Success: false}, err
 	}
 
-	if h.cache != nil {
-		go func() {
-			bgCtx := context.Background()
-			_ = h.cache.InvalidateDevice(bgCtx, cmd.ID)
-			_ = h.cache.InvalidateAllLists(bgCtx)
-		}()
-	}
+	publishEvent(h.eventBus, model.DeviceDeletedEvent{ID: cmd.ID})
 
 	return DeleteDeviceResult{Success: true}, nil
 }
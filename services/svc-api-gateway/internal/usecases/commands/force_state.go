@@ -0,0 +1,73 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/architeacher/devices/pkg/decorator"
+	"github.com/architeacher/devices/pkg/events"
+	"github.com/architeacher/devices/pkg/logger"
+	"github.com/architeacher/devices/pkg/metrics"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/domain/model"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/ports"
+	otelTrace "go.opentelemetry.io/otel/trace"
+)
+
+type (
+	// ForceStateCommand sets a device's state without consulting the state machine.
+	// It is meant for operators, e.g. to release a device stuck in use.
+	ForceStateCommand struct {
+		ID    model.DeviceID
+		State model.State
+	}
+
+	ForceStateCommandHandler = decorator.CommandHandler[ForceStateCommand, *model.Device]
+
+	forceStateCommandHandler struct {
+		deviceService ports.DevicesService
+		eventBus      events.EventBus
+	}
+)
+
+func NewForceStateCommandHandler(
+	svc ports.DevicesService,
+	log logger.Logger,
+	metricsClient metrics.Client,
+	tracerProvider otelTrace.TracerProvider,
+) ForceStateCommandHandler {
+	return decorator.ApplyCommandDecorators[ForceStateCommand, *model.Device](
+		forceStateCommandHandler{deviceService: svc},
+		log,
+		metricsClient,
+		tracerProvider,
+	)
+}
+
+// NewForceStateCommandHandlerWithEvents creates a command handler that publishes device events to eventBus.
+func NewForceStateCommandHandlerWithEvents(
+	svc ports.DevicesService,
+	eventBus events.EventBus,
+	log logger.Logger,
+	metricsClient metrics.Client,
+	tracerProvider otelTrace.TracerProvider,
+) ForceStateCommandHandler {
+	return decorator.ApplyCommandDecorators[ForceStateCommand, *model.Device](
+		forceStateCommandHandler{deviceService: svc, eventBus: eventBus},
+		log,
+		metricsClient,
+		tracerProvider,
+	)
+}
+
+// Handle patches only the state field and skips Device.TransitionTo, so any valid state
+// can be reached from any other. Name and brand are untouched, which keeps the in-use
+// update guard out of the way.
+func (h forceStateCommandHandler) Handle(ctx context.Context, cmd ForceStateCommand) (*model.Device, error) {
+	device, err := h.deviceService.PatchDevice(ctx, cmd.ID, map[string]any{"state": cmd.State.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	publishEvent(h.eventBus, model.DeviceUpdatedEvent{ID: cmd.ID, Device: device})
+
+	return device, nil
+}
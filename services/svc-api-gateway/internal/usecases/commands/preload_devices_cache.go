@@ -0,0 +1,80 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/architeacher/devices/pkg/decorator"
+	"github.com/architeacher/devices/pkg/logger"
+	"github.com/architeacher/devices/pkg/metrics"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/domain/model"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/ports"
+	otelTrace "go.opentelemetry.io/otel/trace"
+)
+
+type (
+	// PreloadDevicesCacheCommand warms the cache with the devices matching Filter, e.g.
+	// the devices of a brand or in a state, after a cold start or a cache flush.
+	PreloadDevicesCacheCommand struct {
+		Filter model.DeviceFilter
+	}
+
+	// PreloadDevicesCacheConfig holds the TTLs preloaded entries are stored with.
+	PreloadDevicesCacheConfig struct {
+		// DeviceTTL should match what reads store devices for, including any stale period.
+		DeviceTTL time.Duration
+		ListTTL   time.Duration
+	}
+
+	// PreloadDevicesCacheCommandHandler returns the number of devices preloaded.
+	PreloadDevicesCacheCommandHandler = decorator.CommandHandler[PreloadDevicesCacheCommand, int]
+
+	preloadDevicesCacheCommandHandler struct {
+		deviceService ports.DevicesService
+		cache         ports.DevicesCache
+		config        PreloadDevicesCacheConfig
+	}
+)
+
+// NewPreloadDevicesCacheCommandHandler creates a command handler that reads devices from
+// svc and stores them in cache. It orchestrates both ports, so neither depends on the other.
+func NewPreloadDevicesCacheCommandHandler(
+	svc ports.DevicesService,
+	cache ports.DevicesCache,
+	config PreloadDevicesCacheConfig,
+	log logger.Logger,
+	metricsClient metrics.Client,
+	tracerProvider otelTrace.TracerProvider,
+) PreloadDevicesCacheCommandHandler {
+	return decorator.ApplyCommandDecorators[PreloadDevicesCacheCommand, int](
+		preloadDevicesCacheCommandHandler{deviceService: svc, cache: cache, config: config},
+		log,
+		metricsClient,
+		tracerProvider,
+	)
+}
+
+// Handle caches every device of the page matching the filter in one pipelined write,
+// then the page itself, so both single-device and list reads hit. It returns the number
+// of devices preloaded, which is zero when the devices could not be written.
+func (h preloadDevicesCacheCommandHandler) Handle(ctx context.Context, cmd PreloadDevicesCacheCommand) (int, error) {
+	if err := cmd.Filter.Validate(); err != nil {
+		return 0, err
+	}
+
+	list, err := h.deviceService.ListDevices(ctx, cmd.Filter)
+	if err != nil {
+		return 0, fmt.Errorf("listing devices to preload: %w", err)
+	}
+
+	if err := h.cache.SetDevices(ctx, list.Devices, h.config.DeviceTTL); err != nil {
+		return 0, fmt.Errorf("preloading devices: %w", err)
+	}
+
+	if err := h.cache.SetDeviceList(ctx, list, cmd.Filter, h.config.ListTTL); err != nil {
+		return len(list.Devices), fmt.Errorf("preloading device list: %w", err)
+	}
+
+	return len(list.Devices), nil
+}
@@ -0,0 +1,19 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/architeacher/devices/pkg/events"
+)
+
+// publishEvent hands the event to the bus in the background, so subscribers such
+// as cache invalidation neither delay nor fail the command. A nil bus is a no-op.
+func publishEvent(bus events.EventBus, event events.Event) {
+	if bus == nil {
+		return
+	}
+
+	go func() {
+		_ = bus.Publish(context.Background(), event)
+	}()
+}
@@ -0,0 +1,88 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/architeacher/devices/pkg/decorator"
+	"github.com/architeacher/devices/pkg/events"
+	"github.com/architeacher/devices/pkg/logger"
+	"github.com/architeacher/devices/pkg/metrics"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/domain/model"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/ports"
+	otelTrace "go.opentelemetry.io/otel/trace"
+)
+
+type (
+	TransitionDeviceStateCommand struct {
+		ID    model.DeviceID
+		State model.State
+	}
+
+	TransitionDeviceStateCommandHandler = decorator.CommandHandler[TransitionDeviceStateCommand, *model.Device]
+
+	transitionDeviceStateCommandHandler struct {
+		deviceService ports.DevicesService
+		eventBus      events.EventBus
+	}
+)
+
+func NewTransitionDeviceStateCommandHandler(
+	svc ports.DevicesService,
+	log logger.Logger,
+	metricsClient metrics.Client,
+	tracerProvider otelTrace.TracerProvider,
+) TransitionDeviceStateCommandHandler {
+	return decorator.ApplyCommandDecorators[TransitionDeviceStateCommand, *model.Device](
+		transitionDeviceStateCommandHandler{deviceService: svc},
+		log,
+		metricsClient,
+		tracerProvider,
+	)
+}
+
+// NewTransitionDeviceStateCommandHandlerWithEvents creates a command handler that publishes device events to eventBus.
+func NewTransitionDeviceStateCommandHandlerWithEvents(
+	svc ports.DevicesService,
+	eventBus events.EventBus,
+	log logger.Logger,
+	metricsClient metrics.Client,
+	tracerProvider otelTrace.TracerProvider,
+) TransitionDeviceStateCommandHandler {
+	return decorator.ApplyCommandDecorators[TransitionDeviceStateCommand, *model.Device](
+		transitionDeviceStateCommandHandler{deviceService: svc, eventBus: eventBus},
+		log,
+		metricsClient,
+		tracerProvider,
+	)
+}
+
+// Handle validates the transition against the device's current state and then patches
+// only the state field, so name and brand can never change through this command. The
+// patch is conditional on the device being unmodified since it was read, so a transition
+// checked against a state that has since changed fails with ErrDeviceModified.
+func (h transitionDeviceStateCommandHandler) Handle(ctx context.Context, cmd TransitionDeviceStateCommand) (*model.Device, error) {
+	device, err := h.deviceService.GetDevice(ctx, cmd.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	lastUpdatedAt := device.UpdatedAt
+
+	if err := device.TransitionTo(cmd.State); err != nil {
+		return nil, err
+	}
+
+	device, err = h.deviceService.PatchDeviceIfUnmodified(
+		ctx,
+		cmd.ID,
+		map[string]any{"state": cmd.State.String()},
+		lastUpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	publishEvent(h.eventBus, model.DeviceUpdatedEvent{ID: cmd.ID, Device: device})
+
+	return device, nil
+}
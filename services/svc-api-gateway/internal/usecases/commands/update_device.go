@@ -2,8 +2,10 @@ package commands
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/architeacher/devices/pkg/decorator"
+	"github.com/architeacher/devices/pkg/events"
 	"github.com/architeacher/devices/pkg/logger"
 	"github.com/architeacher/devices/pkg/metrics"
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/domain/model"
@@ -14,16 +16,16 @@ import (
 type (
 	UpdateDeviceCommand struct {
 		ID    model.DeviceID
-		Name  string
-		Brand string
-		State model.State
+		Name  string      `validate:"notblank,max=255"`
+		Brand string      `validate:"notblank,max=255"`
+		State model.State `validate:"devicestate"`
 	}
 
 	UpdateDeviceCommandHandler = decorator.CommandHandler[UpdateDeviceCommand, *model.Device]
 
 	updateDeviceCommandHandler struct {
 		deviceService ports.DevicesService
-		cache         ports.DevicesCache
+		eventBus      events.EventBus
 	}
 )
 
@@ -41,35 +43,39 @@ func NewUpdateDeviceCommandHandler(
 	)
 }
 
-// NewUpdateDeviceCommandHandlerWithCache creates a command handler with cache invalidation.
-func NewUpdateDeviceCommandHandlerWithCache(
+// NewUpdateDeviceCommandHandlerWithEvents creates a command handler that publishes device events to eventBus.
+func NewUpdateDeviceCommandHandlerWithEvents(
 	svc ports.DevicesService,
-	cache ports.DevicesCache,
+	eventBus events.EventBus,
 	log logger.Logger,
 	metricsClient metrics.Client,
 	tracerProvider otelTrace.TracerProvider,
 ) UpdateDeviceCommandHandler {
 	return decorator.ApplyCommandDecorators[UpdateDeviceCommand, *model.Device](
-		updateDeviceCommandHandler{deviceService: svc, cache: cache},
+		updateDeviceCommandHandler{deviceService: svc, eventBus: eventBus},
 		log,
 		metricsClient,
 		tracerProvider,
 	)
 }
 
+// Validate checks the name, brand and state, returning *model.ValidationErrors listing
+// every invalid field.
+func (c UpdateDeviceCommand) Validate() error {
+	return model.ValidateStruct(c)
+}
+
 func (h updateDeviceCommandHandler) Handle(ctx context.Context, cmd UpdateDeviceCommand) (*model.Device, error) {
+	if err := cmd.Validate(); err != nil {
+		return nil, fmt.Errorf("validating update device command: %w", err)
+	}
+
 	device, err := h.deviceService.UpdateDevice(ctx, cmd.ID, cmd.Name, cmd.Brand, cmd.State)
 	if err != nil {
 		return nil, err
 	}
 
-	if h.cache != nil {
-		go func() {
-			bgCtx := context.Background()
-			_ = h.cache.InvalidateDevice(bgCtx, cmd.ID)
-			_ = h.cache.InvalidateAllLists(bgCtx)
-		}()
-	}
+	publishEvent(h.eventBus, model.DeviceUpdatedEvent{ID: cmd.ID, Device: device})
 
 	return device, nil
 }
@@ -84,7 +90,7 @@ type (
 
 	patchDeviceCommandHandler struct {
 		deviceService ports.DevicesService
-		cache         ports.DevicesCache
+		eventBus      events.EventBus
 	}
 )
 
@@ -102,16 +108,16 @@ func NewPatchDeviceCommandHandler(
 	)
 }
 
-// NewPatchDeviceCommandHandlerWithCache creates a command handler with cache invalidation.
-func NewPatchDeviceCommandHandlerWithCache(
+// NewPatchDeviceCommandHandlerWithEvents creates a command handler that publishes device events to eventBus.
+func NewPatchDeviceCommandHandlerWithEvents(
 	svc ports.DevicesService,
-	cache ports.DevicesCache,
+	eventBus events.EventBus,
 	log logger.Logger,
 	metricsClient metrics.Client,
 	tracerProvider otelTrace.TracerProvider,
 ) PatchDeviceCommandHandler {
 	return decorator.ApplyCommandDecorators[PatchDeviceCommand, *model.Device](
-		patchDeviceCommandHandler{deviceService: svc, cache: cache},
+		patchDeviceCommandHandler{deviceService: svc, eventBus: eventBus},
 		log,
 		metricsClient,
 		tracerProvider,
@@ -124,13 +130,7 @@ func (h patchDeviceCommandHandler) Handle(ctx context.Context, cmd PatchDeviceCo
 		return nil, err
 	}
 
-	if h.cache != nil {
-		go func() {
-			bgCtx := context.Background()
-			_ = h.cache.InvalidateDevice(bgCtx, cmd.ID)
-			_ = h.cache.InvalidateAllLists(bgCtx)
-		}()
-	}
+	publishEvent(h.eventBus, model.DeviceUpdatedEvent{ID: cmd.ID, Device: device})
 
 	return device, nil
 }
@@ -12,9 +12,6 @@ import (
 )
 
 type (
-	// GetDeviceCache is the cache interface for GetDeviceQuery.
-	GetDeviceCache = decorator.Cache[GetDeviceQuery, *model.Device]
-
 	GetDeviceQuery struct {
 		ID model.DeviceID
 	}
@@ -40,25 +37,6 @@ func NewGetDeviceQueryHandler(
 	)
 }
 
-// NewGetDeviceQueryHandlerWithCache creates a query handler with caching support.
-func NewGetDeviceQueryHandlerWithCache(
-	svc ports.DevicesService,
-	cacheAdapter GetDeviceCache,
-	cacheConfig decorator.CacheConfig,
-	log logger.Logger,
-	metricsClient metrics.Client,
-	tracerProvider otelTrace.TracerProvider,
-) GetDeviceQueryHandler {
-	return decorator.ApplyQueryDecoratorsWithCache[GetDeviceQuery, *model.Device](
-		getDeviceQueryHandler{deviceService: svc},
-		cacheAdapter,
-		cacheConfig,
-		log,
-		metricsClient,
-		tracerProvider,
-	)
-}
-
 func (h getDeviceQueryHandler) Execute(ctx context.Context, query GetDeviceQuery) (*model.Device, error) {
 	return h.deviceService.GetDevice(ctx, query.ID)
 }
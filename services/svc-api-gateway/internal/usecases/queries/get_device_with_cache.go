@@ -0,0 +1,109 @@
+package queries
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/architeacher/devices/pkg/decorator"
+	"github.com/architeacher/devices/pkg/logger"
+	"github.com/architeacher/devices/pkg/metrics"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/domain/model"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/ports"
+	otelTrace "go.opentelemetry.io/otel/trace"
+)
+
+type (
+	GetDeviceWithCacheQuery struct {
+		ID model.DeviceID
+	}
+
+	// GetDeviceCacheConfig controls how long cached devices are served.
+	GetDeviceCacheConfig struct {
+		Enabled bool
+		// TTL is how long a cached device is served without asking svc-devices.
+		TTL time.Duration
+		// StaleTTL keeps an entry this much longer, to be served only when
+		// svc-devices fails to answer. Zero disables stale-on-error.
+		StaleTTL time.Duration
+	}
+
+	GetDeviceWithCacheQueryHandler = decorator.QueryHandler[GetDeviceWithCacheQuery, *model.Device]
+
+	getDeviceWithCacheQueryHandler struct {
+		deviceService ports.DevicesService
+		cache         ports.DevicesCache
+		config        GetDeviceCacheConfig
+	}
+)
+
+// NewGetDeviceWithCacheQueryHandler creates a cache-aside query handler: fresh cached
+// devices are answered from the cache, and anything else is read from svc-devices
+// and written back. A nil cache or a disabled config reads svc-devices every time.
+func NewGetDeviceWithCacheQueryHandler(
+	svc ports.DevicesService,
+	cache ports.DevicesCache,
+	cacheConfig GetDeviceCacheConfig,
+	log logger.Logger,
+	metricsClient metrics.Client,
+	tracerProvider otelTrace.TracerProvider,
+) GetDeviceWithCacheQueryHandler {
+	return decorator.ApplyQueryDecorators[GetDeviceWithCacheQuery, *model.Device](
+		getDeviceWithCacheQueryHandler{deviceService: svc, cache: cache, config: cacheConfig},
+		log,
+		metricsClient,
+		tracerProvider,
+	)
+}
+
+func (h getDeviceWithCacheQueryHandler) Execute(ctx context.Context, query GetDeviceWithCacheQuery) (*model.Device, error) {
+	if !h.config.Enabled || h.cache == nil {
+		return h.deviceService.GetDevice(ctx, query.ID)
+	}
+
+	// A failing cache must not fail the read, so errors are treated as a miss.
+	cached, err := h.cache.GetDevice(ctx, query.ID)
+	if err != nil || !cached.Hit {
+		cached = nil
+	}
+
+	if cached != nil && h.isFresh(cached) {
+		return cached.Data, nil
+	}
+
+	device, err := h.deviceService.GetDevice(ctx, query.ID)
+	if err != nil {
+		if errors.Is(err, model.ErrDeviceNotFound) {
+			if cached != nil {
+				go func() {
+					_ = h.cache.InvalidateDevice(context.Background(), query.ID)
+				}()
+			}
+
+			return nil, err
+		}
+
+		if cached != nil {
+			return cached.Data, nil
+		}
+
+		return nil, err
+	}
+
+	// A stale entry that no longer matches the service means cached lists hold the old
+	// device too, so they are purged along with the refresh.
+	listsToPurge := cached != nil && !cached.Data.UpdatedAt.Equal(device.UpdatedAt)
+
+	go func() {
+		_ = h.cache.MultiplexSet(context.Background(), device, h.config.TTL+h.config.StaleTTL, listsToPurge)
+	}()
+
+	return device, nil
+}
+
+// isFresh reports whether the entry is still within TTL. Entries are stored for
+// TTL+StaleTTL, so one with no more than StaleTTL left has outlived TTL. A negative
+// TTL means the entry never expires.
+func (h getDeviceWithCacheQueryHandler) isFresh(cached *ports.CacheResult[*model.Device]) bool {
+	return cached.TTL < 0 || cached.TTL > h.config.StaleTTL
+}
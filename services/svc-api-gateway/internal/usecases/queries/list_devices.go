@@ -12,17 +12,19 @@ import (
 )
 
 type (
-	// ListDevicesCache is the cache interface for ListDevicesQuery.
-	ListDevicesCache = decorator.Cache[ListDevicesQuery, *model.DeviceList]
-
 	ListDevicesQuery struct {
 		Filter model.DeviceFilter
+		// BypassCache skips the cache lookup and reads svc-devices, still refreshing
+		// the cached page with the result.
+		BypassCache bool
 	}
 
 	ListDevicesQueryHandler = decorator.QueryHandler[ListDevicesQuery, *model.DeviceList]
 
 	listDevicesQueryHandler struct {
 		deviceService ports.DevicesService
+		cache         ports.DevicesCache
+		cacheConfig   decorator.CacheConfig
 	}
 )
 
@@ -40,19 +42,19 @@ func NewListDevicesQueryHandler(
 	)
 }
 
-// NewListDevicesQueryHandlerWithCache creates a query handler with caching support.
+// NewListDevicesQueryHandlerWithCache creates a cache-aside query handler: pages are
+// answered from the cache, keyed by the whole filter, and misses are read from
+// svc-devices and written back.
 func NewListDevicesQueryHandlerWithCache(
 	svc ports.DevicesService,
-	cacheAdapter ListDevicesCache,
+	cache ports.DevicesCache,
 	cacheConfig decorator.CacheConfig,
 	log logger.Logger,
 	metricsClient metrics.Client,
 	tracerProvider otelTrace.TracerProvider,
 ) ListDevicesQueryHandler {
-	return decorator.ApplyQueryDecoratorsWithCache[ListDevicesQuery, *model.DeviceList](
-		listDevicesQueryHandler{deviceService: svc},
-		cacheAdapter,
-		cacheConfig,
+	return decorator.ApplyQueryDecorators[ListDevicesQuery, *model.DeviceList](
+		listDevicesQueryHandler{deviceService: svc, cache: cache, cacheConfig: cacheConfig},
 		log,
 		metricsClient,
 		tracerProvider,
@@ -60,5 +62,26 @@ func NewListDevicesQueryHandlerWithCache(
 }
 
 func (h listDevicesQueryHandler) Execute(ctx context.Context, query ListDevicesQuery) (*model.DeviceList, error) {
-	return h.deviceService.ListDevices(ctx, query.Filter)
+	if !h.cacheConfig.Enabled || h.cache == nil {
+		return h.deviceService.ListDevices(ctx, query.Filter)
+	}
+
+	if !query.BypassCache {
+		// A failing cache must not fail the read, so errors are treated as a miss.
+		cached, err := h.cache.GetDeviceList(ctx, query.Filter)
+		if err == nil && cached.Hit {
+			return cached.Data, nil
+		}
+	}
+
+	list, err := h.deviceService.ListDevices(ctx, query.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		_ = h.cache.SetDeviceList(context.Background(), list, query.Filter, h.cacheConfig.TTL)
+	}()
+
+	return list, nil
 }
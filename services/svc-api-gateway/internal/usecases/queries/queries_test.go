@@ -2,16 +2,20 @@ package queries_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
+	"github.com/architeacher/devices/pkg/decorator"
 	"github.com/architeacher/devices/pkg/logger"
 	"github.com/architeacher/devices/pkg/metrics/noop"
+	"github.com/architeacher/devices/pkg/trace"
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/domain/model"
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/mocks"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/ports"
 	"github.com/architeacher/devices/services/svc-api-gateway/internal/usecases/queries"
 	"github.com/stretchr/testify/require"
-	otelNoop "go.opentelemetry.io/otel/trace/noop"
+	"go.opentelemetry.io/otel/codes"
 )
 
 func TestGetDeviceQueryHandler(t *testing.T) {
@@ -19,7 +23,6 @@ func TestGetDeviceQueryHandler(t *testing.T) {
 
 	log := logger.NewTestLogger()
 	mc := noop.NewMetricsClient()
-	tp := otelNoop.NewTracerProvider()
 
 	cases := []struct {
 		name        string
@@ -63,10 +66,12 @@ func TestGetDeviceQueryHandler(t *testing.T) {
 
 			svc, deviceID := tc.setupSvc()
 
+			tp := trace.NewRecordingTracerProvider()
 			handler := queries.NewGetDeviceQueryHandler(svc, log, mc, tp)
 			query := queries.GetDeviceQuery{ID: deviceID}
 
 			result, err := handler.Execute(t.Context(), query)
+			requireHandleSpan(t, tp, "queries.getdevicequery", err)
 
 			if tc.expectError {
 				require.Error(t, err)
@@ -88,7 +93,6 @@ func TestListDevicesQueryHandler(t *testing.T) {
 
 	log := logger.NewTestLogger()
 	mc := noop.NewMetricsClient()
-	tp := otelNoop.NewTracerProvider()
 
 	brand := "Apple"
 	state := model.StateAvailable
@@ -163,10 +167,12 @@ func TestListDevicesQueryHandler(t *testing.T) {
 
 			svc := tc.setupSvc()
 
+			tp := trace.NewRecordingTracerProvider()
 			handler := queries.NewListDevicesQueryHandler(svc, log, mc, tp)
 			query := queries.ListDevicesQuery{Filter: tc.filter}
 
 			result, err := handler.Execute(t.Context(), query)
+			requireHandleSpan(t, tp, "queries.listdevicesquery", err)
 
 			require.NoError(t, err)
 			tc.validate(t, result)
@@ -179,7 +185,6 @@ func TestFetchLivenessQueryHandler(t *testing.T) {
 
 	log := logger.NewTestLogger()
 	mc := noop.NewMetricsClient()
-	tp := otelNoop.NewTracerProvider()
 
 	cases := []struct {
 		name     string
@@ -213,10 +218,12 @@ func TestFetchLivenessQueryHandler(t *testing.T) {
 
 			healthChecker := tc.setupSvc()
 
+			tp := trace.NewRecordingTracerProvider()
 			handler := queries.NewFetchLivenessQueryHandler(healthChecker, log, mc, tp)
 			query := queries.FetchLivenessQuery{}
 
 			result, err := handler.Execute(t.Context(), query)
+			requireHandleSpan(t, tp, "queries.fetchlivenessquery", err)
 
 			require.NoError(t, err)
 			tc.validate(t, result)
@@ -229,7 +236,6 @@ func TestFetchReadinessQueryHandler(t *testing.T) {
 
 	log := logger.NewTestLogger()
 	mc := noop.NewMetricsClient()
-	tp := otelNoop.NewTracerProvider()
 
 	cases := []struct {
 		name     string
@@ -299,10 +305,12 @@ func TestFetchReadinessQueryHandler(t *testing.T) {
 
 			healthChecker := tc.setupSvc()
 
+			tp := trace.NewRecordingTracerProvider()
 			handler := queries.NewFetchReadinessQueryHandler(healthChecker, log, mc, tp)
 			query := queries.FetchReadinessQuery{}
 
 			result, err := handler.Execute(t.Context(), query)
+			requireHandleSpan(t, tp, "queries.fetchreadinessquery", err)
 
 			require.NoError(t, err)
 			tc.validate(t, result)
@@ -315,7 +323,6 @@ func TestFetchHealthReportQueryHandler(t *testing.T) {
 
 	log := logger.NewTestLogger()
 	mc := noop.NewMetricsClient()
-	tp := otelNoop.NewTracerProvider()
 
 	cases := []struct {
 		name     string
@@ -403,13 +410,366 @@ func TestFetchHealthReportQueryHandler(t *testing.T) {
 
 			healthChecker := tc.setupSvc()
 
+			tp := trace.NewRecordingTracerProvider()
 			handler := queries.NewFetchHealthReportQueryHandler(healthChecker, log, mc, tp)
 			query := queries.FetchHealthReportQuery{}
 
 			result, err := handler.Execute(t.Context(), query)
+			requireHandleSpan(t, tp, "queries.fetchhealthreportquery", err)
 
 			require.NoError(t, err)
 			tc.validate(t, result)
 		})
 	}
 }
+
+func TestGetDeviceWithCacheQueryHandler(t *testing.T) {
+	t.Parallel()
+
+	log := logger.NewTestLogger()
+	mc := noop.NewMetricsClient()
+
+	cacheConfig := queries.GetDeviceCacheConfig{
+		Enabled:  true,
+		TTL:      5 * time.Minute,
+		StaleTTL: 10 * time.Minute,
+	}
+
+	cachedDevice := &model.Device{ID: model.NewDeviceID(), Name: "cached", Brand: "Apple", State: model.StateAvailable}
+	freshDevice := &model.Device{ID: cachedDevice.ID, Name: "fresh", Brand: "Apple", State: model.StateInUse, UpdatedAt: time.Now()}
+
+	hit := func(ttl time.Duration) func(*mocks.FakeDevicesCache) {
+		return func(cache *mocks.FakeDevicesCache) {
+			cache.GetDeviceReturns(&ports.CacheResult[*model.Device]{Data: cachedDevice, Hit: true, TTL: ttl}, nil)
+		}
+	}
+
+	miss := func(cache *mocks.FakeDevicesCache) {
+		cache.GetDeviceReturns(&ports.CacheResult[*model.Device]{Hit: false}, nil)
+	}
+
+	cases := []struct {
+		name              string
+		config            queries.GetDeviceCacheConfig
+		setupCache        func(*mocks.FakeDevicesCache)
+		serviceErr        error
+		expected          *model.Device
+		expectedErr       error
+		expectedSvcCalls  int
+		expectedSetTTL    time.Duration
+		expectListsPurged bool
+		expectInvalidated bool
+	}{
+		{
+			name:       "fresh hit bypasses the service",
+			config:     cacheConfig,
+			setupCache: hit(12 * time.Minute),
+			expected:   cachedDevice,
+		},
+		{
+			name:       "hit without expiry is fresh",
+			config:     cacheConfig,
+			setupCache: hit(-1),
+			expected:   cachedDevice,
+		},
+		{
+			name:             "miss reads the service and populates the cache",
+			config:           cacheConfig,
+			setupCache:       miss,
+			expected:         freshDevice,
+			expectedSvcCalls: 1,
+			expectedSetTTL:   15 * time.Minute,
+		},
+		{
+			name:   "cache error is treated as a miss",
+			config: cacheConfig,
+			setupCache: func(cache *mocks.FakeDevicesCache) {
+				cache.GetDeviceReturns(nil, errors.New("connection refused"))
+			},
+			expected:         freshDevice,
+			expectedSvcCalls: 1,
+			expectedSetTTL:   15 * time.Minute,
+		},
+		{
+			name:              "stale hit is refreshed from the service",
+			config:            cacheConfig,
+			setupCache:        hit(3 * time.Minute),
+			expected:          freshDevice,
+			expectedSvcCalls:  1,
+			expectedSetTTL:    15 * time.Minute,
+			expectListsPurged: true,
+		},
+		{
+			name:   "stale hit still matching the service keeps the lists",
+			config: cacheConfig,
+			setupCache: func(cache *mocks.FakeDevicesCache) {
+				cache.GetDeviceReturns(&ports.CacheResult[*model.Device]{Data: freshDevice, Hit: true, TTL: 3 * time.Minute}, nil)
+			},
+			expected:         freshDevice,
+			expectedSvcCalls: 1,
+			expectedSetTTL:   15 * time.Minute,
+		},
+		{
+			name:             "downstream timeout with stale cache returns the stale device",
+			config:           cacheConfig,
+			setupCache:       hit(3 * time.Minute),
+			serviceErr:       model.ErrTimeout,
+			expected:         cachedDevice,
+			expectedSvcCalls: 1,
+		},
+		{
+			name:             "downstream timeout without cache returns the error",
+			config:           cacheConfig,
+			setupCache:       miss,
+			serviceErr:       model.ErrTimeout,
+			expectedErr:      model.ErrTimeout,
+			expectedSvcCalls: 1,
+		},
+		{
+			name:             "not found on a miss stores nothing",
+			config:           cacheConfig,
+			setupCache:       miss,
+			serviceErr:       model.ErrDeviceNotFound,
+			expectedErr:      model.ErrDeviceNotFound,
+			expectedSvcCalls: 1,
+		},
+		{
+			name:              "not found on a stale hit drops the entry",
+			config:            cacheConfig,
+			setupCache:        hit(3 * time.Minute),
+			serviceErr:        model.ErrDeviceNotFound,
+			expectedErr:       model.ErrDeviceNotFound,
+			expectedSvcCalls:  1,
+			expectInvalidated: true,
+		},
+		{
+			name:             "disabled cache always reads the service",
+			config:           queries.GetDeviceCacheConfig{},
+			setupCache:       hit(12 * time.Minute),
+			expected:         freshDevice,
+			expectedSvcCalls: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := &mocks.FakeDevicesService{}
+			if tc.serviceErr != nil {
+				svc.GetDeviceReturns(nil, tc.serviceErr)
+			} else {
+				svc.GetDeviceReturns(freshDevice, nil)
+			}
+
+			cache := &mocks.FakeDevicesCache{}
+			tc.setupCache(cache)
+
+			tp := trace.NewRecordingTracerProvider()
+			handler := queries.NewGetDeviceWithCacheQueryHandler(svc, cache, tc.config, log, mc, tp)
+
+			result, err := handler.Execute(t.Context(), queries.GetDeviceWithCacheQuery{ID: cachedDevice.ID})
+			requireHandleSpan(t, tp, "queries.getdevicewithcachequery", err)
+
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				require.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.Same(t, tc.expected, result)
+			}
+
+			require.Equal(t, tc.expectedSvcCalls, svc.GetDeviceCallCount())
+
+			// Cache writes happen in the background.
+			if tc.expectedSetTTL > 0 {
+				require.Eventually(t, func() bool { return cache.MultiplexSetCallCount() == 1 }, time.Second, 5*time.Millisecond)
+
+				_, stored, ttl, listsPurged := cache.MultiplexSetArgsForCall(0)
+				require.Same(t, freshDevice, stored)
+				require.Equal(t, tc.expectedSetTTL, ttl)
+				require.Equal(t, tc.expectListsPurged, listsPurged)
+			} else {
+				require.Never(t, func() bool { return cache.MultiplexSetCallCount() > 0 }, 50*time.Millisecond, 5*time.Millisecond)
+			}
+
+			if tc.expectInvalidated {
+				require.Eventually(t, func() bool { return cache.InvalidateDeviceCallCount() == 1 }, time.Second, 5*time.Millisecond)
+			}
+		})
+	}
+}
+
+func TestListDevicesQueryHandlerWithCache(t *testing.T) {
+	t.Parallel()
+
+	log := logger.NewTestLogger()
+	mc := noop.NewMetricsClient()
+
+	cacheConfig := decorator.CacheConfig{Enabled: true, TTL: time.Minute}
+
+	filter := model.DeviceFilter{
+		Keyword: "phone",
+		Brands:  []string{"Apple"},
+		States:  []model.State{model.StateAvailable},
+		Sort:    []string{"-createdAt"},
+		Page:    2,
+		Size:    10,
+	}
+
+	cachedList := &model.DeviceList{Devices: []*model.Device{{ID: model.NewDeviceID(), Name: "cached"}}}
+	freshList := &model.DeviceList{Devices: []*model.Device{{ID: model.NewDeviceID(), Name: "fresh"}}}
+
+	warm := func(cache *mocks.FakeDevicesCache) {
+		cache.GetDeviceListReturns(&ports.CacheResult[*model.DeviceList]{Data: cachedList, Hit: true}, nil)
+	}
+
+	cases := []struct {
+		name               string
+		config             decorator.CacheConfig
+		bypassCache        bool
+		setupCache         func(*mocks.FakeDevicesCache)
+		serviceErr         error
+		expected           *model.DeviceList
+		expectedErr        error
+		expectedGetCalls   int
+		expectedSvcCalls   int
+		expectWriteThrough bool
+	}{
+		{
+			name:             "hit returns the cached list",
+			config:           cacheConfig,
+			setupCache:       warm,
+			expected:         cachedList,
+			expectedGetCalls: 1,
+		},
+		{
+			name:   "miss reads the service and writes the list through",
+			config: cacheConfig,
+			setupCache: func(cache *mocks.FakeDevicesCache) {
+				cache.GetDeviceListReturns(&ports.CacheResult[*model.DeviceList]{Hit: false}, nil)
+			},
+			expected:           freshList,
+			expectedGetCalls:   1,
+			expectedSvcCalls:   1,
+			expectWriteThrough: true,
+		},
+		{
+			name:   "cache error is treated as a miss",
+			config: cacheConfig,
+			setupCache: func(cache *mocks.FakeDevicesCache) {
+				cache.GetDeviceListReturns(nil, errors.New("connection refused"))
+			},
+			expected:           freshList,
+			expectedGetCalls:   1,
+			expectedSvcCalls:   1,
+			expectWriteThrough: true,
+		},
+		{
+			name:               "bypass reads the service on a warm cache and refreshes it",
+			config:             cacheConfig,
+			bypassCache:        true,
+			setupCache:         warm,
+			expected:           freshList,
+			expectedSvcCalls:   1,
+			expectWriteThrough: true,
+		},
+		{
+			name:   "service error stores nothing",
+			config: cacheConfig,
+			setupCache: func(cache *mocks.FakeDevicesCache) {
+				cache.GetDeviceListReturns(&ports.CacheResult[*model.DeviceList]{Hit: false}, nil)
+			},
+			serviceErr:       model.ErrTimeout,
+			expectedErr:      model.ErrTimeout,
+			expectedGetCalls: 1,
+			expectedSvcCalls: 1,
+		},
+		{
+			name:             "disabled cache always reads the service",
+			config:           decorator.CacheConfig{},
+			setupCache:       warm,
+			expected:         freshList,
+			expectedSvcCalls: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := &mocks.FakeDevicesService{}
+			if tc.serviceErr != nil {
+				svc.ListDevicesReturns(nil, tc.serviceErr)
+			} else {
+				svc.ListDevicesReturns(freshList, nil)
+			}
+
+			cache := &mocks.FakeDevicesCache{}
+			tc.setupCache(cache)
+
+			tp := trace.NewRecordingTracerProvider()
+			handler := queries.NewListDevicesQueryHandlerWithCache(svc, cache, tc.config, log, mc, tp)
+
+			result, err := handler.Execute(t.Context(), queries.ListDevicesQuery{Filter: filter, BypassCache: tc.bypassCache})
+			requireHandleSpan(t, tp, "queries.listdevicesquery", err)
+
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				require.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.Same(t, tc.expected, result)
+			}
+
+			require.Equal(t, tc.expectedGetCalls, cache.GetDeviceListCallCount())
+			require.Equal(t, tc.expectedSvcCalls, svc.ListDevicesCallCount())
+
+			if tc.expectedGetCalls > 0 {
+				_, lookedUp := cache.GetDeviceListArgsForCall(0)
+				require.Equal(t, filter, lookedUp)
+			}
+
+			// Cache writes happen in the background.
+			if tc.expectWriteThrough {
+				require.Eventually(t, func() bool { return cache.SetDeviceListCallCount() == 1 }, time.Second, 5*time.Millisecond)
+
+				_, stored, storedFilter, ttl := cache.SetDeviceListArgsForCall(0)
+				require.Same(t, freshList, stored)
+				require.Equal(t, filter, storedFilter)
+				require.Equal(t, cacheConfig.TTL, ttl)
+			} else {
+				require.Never(t, func() bool { return cache.SetDeviceListCallCount() > 0 }, 50*time.Millisecond, 5*time.Millisecond)
+			}
+		})
+	}
+}
+
+// requireHandleSpan asserts the tracing decorator recorded one Handle span for the query,
+// with a status matching the handler outcome.
+func requireHandleSpan(t *testing.T, tp *trace.RecordingTracerProvider, scope string, err error) {
+	t.Helper()
+
+	spans := tp.SpansByName("Handle")
+	require.Len(t, spans, 1)
+	require.Equal(t, scope, spans[0].InstrumentationScope().Name)
+
+	attributes := make(map[string]bool)
+	for _, attr := range spans[0].Attributes() {
+		attributes[string(attr.Key)] = true
+	}
+
+	require.True(t, attributes["duration"], "span is missing the duration attribute")
+
+	if err != nil {
+		require.Equal(t, codes.Error, spans[0].Status().Code)
+		require.Equal(t, scope+".failure", spans[0].Status().Description)
+		require.Len(t, spans[0].Events(), 2, "the recorded error and the failure event")
+
+		return
+	}
+
+	require.Equal(t, codes.Ok, spans[0].Status().Code)
+	require.Len(t, spans[0].Events(), 1)
+	require.Equal(t, scope+".success", spans[0].Events()[0].Name)
+}
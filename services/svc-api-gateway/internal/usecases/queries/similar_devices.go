@@ -0,0 +1,78 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/architeacher/devices/pkg/decorator"
+	"github.com/architeacher/devices/pkg/logger"
+	"github.com/architeacher/devices/pkg/metrics"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/domain/model"
+	"github.com/architeacher/devices/services/svc-api-gateway/internal/ports"
+	otelTrace "go.opentelemetry.io/otel/trace"
+)
+
+// MaxSimilarDevices caps the number of devices a SimilarDevicesQuery returns.
+const MaxSimilarDevices = 5
+
+type (
+	// SimilarDevicesQuery looks up the available devices sharing the brand of the device ID.
+	SimilarDevicesQuery struct {
+		ID model.DeviceID
+	}
+
+	SimilarDevicesQueryHandler = decorator.QueryHandler[SimilarDevicesQuery, []*model.Device]
+
+	similarDevicesQueryHandler struct {
+		deviceService ports.DevicesService
+	}
+)
+
+func NewSimilarDevicesQueryHandler(
+	svc ports.DevicesService,
+	log logger.Logger,
+	metricsClient metrics.Client,
+	tracerProvider otelTrace.TracerProvider,
+) SimilarDevicesQueryHandler {
+	return decorator.ApplyQueryDecorators[SimilarDevicesQuery, []*model.Device](
+		similarDevicesQueryHandler{deviceService: svc},
+		log,
+		metricsClient,
+		tracerProvider,
+	)
+}
+
+// Execute returns up to MaxSimilarDevices available devices of the source device's brand,
+// newest first, never including the source device itself. It fails with
+// model.ErrDeviceNotFound when the source device does not exist.
+func (h similarDevicesQueryHandler) Execute(ctx context.Context, query SimilarDevicesQuery) ([]*model.Device, error) {
+	source, err := h.deviceService.GetDevice(ctx, query.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := model.DefaultDeviceFilter()
+	filter.Brands = []string{source.Brand}
+	filter.States = []model.State{model.StateAvailable}
+	// One more than the cap, so the page still fills up when the source is among them.
+	filter.Size = MaxSimilarDevices + 1
+
+	list, err := h.deviceService.ListDevices(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("listing devices similar to %s: %w", source.ID, err)
+	}
+
+	similar := make([]*model.Device, 0, MaxSimilarDevices)
+	for _, device := range list.Devices {
+		if device.ID == source.ID {
+			continue
+		}
+
+		similar = append(similar, device)
+		if len(similar) == MaxSimilarDevices {
+			break
+		}
+	}
+
+	return similar, nil
+}
@@ -133,7 +133,7 @@ func NewIntegrationTestServer(ctx context.Context) (*IntegrationTestServer, erro
 	metricsClient := noop.NewMetricsClient()
 	tracerProvider := otelNoop.NewTracerProvider()
 
-	apiApp := usecases.NewWebApplication(grpcClient, grpcClient, nil, log, metricsClient, tracerProvider)
+	apiApp := usecases.NewWebApplication(grpcClient, grpcClient, nil, nil, log, metricsClient, tracerProvider)
 
 	cfg := &apiconfig.ServiceConfig{
 		App: apiconfig.App{
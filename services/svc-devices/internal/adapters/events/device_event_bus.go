@@ -0,0 +1,108 @@
+package events
+
+import (
+	"sync"
+
+	"github.com/architeacher/devices/services/svc-devices/internal/domain/model"
+	"github.com/architeacher/devices/services/svc-devices/internal/ports"
+)
+
+// subscriberBuffer is how many unread changes a subscriber may fall behind by.
+const subscriberBuffer = 16
+
+// DeviceEventBus is an in-process ports.DeviceEventBus. Publishing never waits for
+// subscribers: when a subscriber's buffer is full its oldest pending change is
+// dropped, so a slow watcher skips intermediate versions but still ends on the latest.
+type DeviceEventBus struct {
+	mu          sync.Mutex
+	subscribers map[model.DeviceID]map[*subscriber]struct{}
+}
+
+var _ ports.DeviceEventBus = (*DeviceEventBus)(nil)
+
+type subscriber struct {
+	updates chan *model.Device
+}
+
+func NewDeviceEventBus() *DeviceEventBus {
+	return &DeviceEventBus{
+		subscribers: make(map[model.DeviceID]map[*subscriber]struct{}),
+	}
+}
+
+func (b *DeviceEventBus) Subscribe(id model.DeviceID) (<-chan *model.Device, ports.CancelFunc) {
+	sub := &subscriber{updates: make(chan *model.Device, subscriberBuffer)}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.subscribers[id] == nil {
+		b.subscribers[id] = make(map[*subscriber]struct{})
+	}
+
+	b.subscribers[id][sub] = struct{}{}
+
+	return sub.updates, func() { b.unsubscribe(id, sub) }
+}
+
+// Publish hands subscribers a copy of the device, so later changes made by the
+// publisher do not leak into versions that are still queued.
+func (b *DeviceEventBus) Publish(device *model.Device) {
+	snapshot := *device
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for sub := range b.subscribers[device.ID] {
+		sub.send(&snapshot)
+	}
+}
+
+func (b *DeviceEventBus) Close(id model.DeviceID) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for sub := range b.subscribers[id] {
+		close(sub.updates)
+	}
+
+	delete(b.subscribers, id)
+}
+
+func (b *DeviceEventBus) unsubscribe(id model.DeviceID, sub *subscriber) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	subs, ok := b.subscribers[id]
+	if !ok {
+		return
+	}
+
+	if _, ok := subs[sub]; !ok {
+		return
+	}
+
+	close(sub.updates)
+	delete(subs, sub)
+
+	if len(subs) == 0 {
+		delete(b.subscribers, id)
+	}
+}
+
+// send must be called with the bus lock held, which keeps publishers from
+// interleaving and so preserves the publishing order.
+func (s *subscriber) send(device *model.Device) {
+	for {
+		select {
+		case s.updates <- device:
+			return
+		default:
+		}
+
+		select {
+		case <-s.updates:
+		default:
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package events_test
+
+import (
+	"testing"
+
+	"github.com/architeacher/devices/services/svc-devices/internal/adapters/events"
+	"github.com/architeacher/devices/services/svc-devices/internal/domain/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeviceEventBus(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		run  func(t *testing.T, bus *events.DeviceEventBus, device *model.Device)
+	}{
+		{
+			name: "delivers published versions in order",
+			run: func(t *testing.T, bus *events.DeviceEventBus, device *model.Device) {
+				updates, cancel := bus.Subscribe(device.ID)
+				defer cancel()
+
+				for _, name := range []string{"first", "second", "third"} {
+					device.Name = name
+					bus.Publish(device)
+				}
+
+				require.Equal(t, "first", (<-updates).Name)
+				require.Equal(t, "second", (<-updates).Name)
+				require.Equal(t, "third", (<-updates).Name)
+			},
+		},
+		{
+			name: "ignores changes to other devices",
+			run: func(t *testing.T, bus *events.DeviceEventBus, device *model.Device) {
+				updates, cancel := bus.Subscribe(device.ID)
+				defer cancel()
+
+				bus.Publish(model.NewDevice("Pixel", "Google", model.StateAvailable))
+
+				require.Empty(t, updates)
+			},
+		},
+		{
+			name: "publishes a snapshot of the device",
+			run: func(t *testing.T, bus *events.DeviceEventBus, device *model.Device) {
+				updates, cancel := bus.Subscribe(device.ID)
+				defer cancel()
+
+				bus.Publish(device)
+				device.Name = "changed afterwards"
+
+				require.Equal(t, "iPhone", (<-updates).Name)
+			},
+		},
+		{
+			name: "drops the oldest versions for a slow subscriber",
+			run: func(t *testing.T, bus *events.DeviceEventBus, device *model.Device) {
+				updates, cancel := bus.Subscribe(device.ID)
+				defer cancel()
+
+				const published = 100
+				for i := range published {
+					device.Name = string(rune('A' + i%26))
+					bus.Publish(device)
+				}
+
+				var last *model.Device
+				for len(updates) > 0 {
+					last = <-updates
+				}
+
+				require.Equal(t, device.Name, last.Name, "the latest version is kept")
+			},
+		},
+		{
+			name: "close ends every subscription to the device",
+			run: func(t *testing.T, bus *events.DeviceEventBus, device *model.Device) {
+				first, cancelFirst := bus.Subscribe(device.ID)
+				second, cancelSecond := bus.Subscribe(device.ID)
+
+				bus.Close(device.ID)
+
+				_, open := <-first
+				require.False(t, open)
+
+				_, open = <-second
+				require.False(t, open)
+
+				// Cancelling after the device is gone must not close the channels again.
+				cancelFirst()
+				cancelSecond()
+			},
+		},
+		{
+			name: "cancel ends only its own subscription",
+			run: func(t *testing.T, bus *events.DeviceEventBus, device *model.Device) {
+				cancelled, cancel := bus.Subscribe(device.ID)
+				kept, keep := bus.Subscribe(device.ID)
+				defer keep()
+
+				cancel()
+				cancel()
+
+				bus.Publish(device)
+
+				_, open := <-cancelled
+				require.False(t, open)
+				require.Equal(t, device.ID, (<-kept).ID)
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			tc.run(t, events.NewDeviceEventBus(), model.NewDevice("iPhone", "Apple", model.StateAvailable))
+		})
+	}
+}
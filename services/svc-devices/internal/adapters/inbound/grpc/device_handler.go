@@ -3,7 +3,9 @@ package grpc
 import (
 	"context"
 	"errors"
+	"io"
 
+	"github.com/architeacher/devices/pkg/grpcutil"
 	"github.com/architeacher/devices/pkg/proto/device/v1"
 	"github.com/architeacher/devices/services/svc-devices/internal/domain/model"
 	"github.com/architeacher/devices/services/svc-devices/internal/usecases"
@@ -14,6 +16,9 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
+// patchableFields lists the update_mask paths PatchDevice accepts.
+var patchableFields = []string{"name", "brand", "state"}
+
 type DevicesHandler struct {
 	devicev1.UnimplementedDeviceServiceServer
 	app *usecases.Application
@@ -32,10 +37,15 @@ func (h *DevicesHandler) CreateDevice(ctx context.Context, req *devicev1.CreateD
 		return nil, status.Error(codes.InvalidArgument, "brand is required")
 	}
 
+	state := toDomainState(req.State)
+	if err := validateState(state); err != nil {
+		return nil, err
+	}
+
 	cmd := commands.CreateDeviceCommand{
 		Name:  req.Name,
 		Brand: req.Brand,
-		State: toDomainState(req.State),
+		State: state,
 	}
 
 	device, err := h.app.Commands.CreateDevice.Handle(ctx, cmd)
@@ -73,6 +83,16 @@ func (h *DevicesHandler) GetDevice(ctx context.Context, req *devicev1.GetDeviceR
 func (h *DevicesHandler) ListDevices(ctx context.Context, req *devicev1.ListDevicesRequest) (*devicev1.ListDevicesResponse, error) {
 	filter := toDomainFilter(req)
 
+	for _, state := range filter.States {
+		if err := validateState(state); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	query := queries.ListDevicesQuery{Filter: filter}
 
 	list, err := h.app.Queries.ListDevices.Execute(ctx, query)
@@ -101,11 +121,16 @@ func (h *DevicesHandler) UpdateDevice(ctx context.Context, req *devicev1.UpdateD
 		return nil, status.Error(codes.InvalidArgument, "invalid device ID")
 	}
 
+	state := toDomainState(req.State)
+	if err := validateState(state); err != nil {
+		return nil, err
+	}
+
 	cmd := commands.UpdateDeviceCommand{
 		ID:    id,
 		Name:  req.Name,
 		Brand: req.Brand,
-		State: toDomainState(req.State),
+		State: state,
 	}
 
 	device, err := h.app.Commands.UpdateDevice.Handle(ctx, cmd)
@@ -118,6 +143,10 @@ func (h *DevicesHandler) UpdateDevice(ctx context.Context, req *devicev1.UpdateD
 	}, nil
 }
 
+// PatchDevice updates only the fields named in update_mask, so a field can be told apart
+// from an absent one even when it holds its zero value. Fields set in the request but
+// missing from the mask are left unchanged. When expected_updated_at is set, the patch
+// is applied only if the device has not been updated since.
 func (h *DevicesHandler) PatchDevice(ctx context.Context, req *devicev1.PatchDeviceRequest) (*devicev1.PatchDeviceResponse, error) {
 	if req.Id == "" {
 		return nil, status.Error(codes.InvalidArgument, "id is required")
@@ -128,18 +157,34 @@ func (h *DevicesHandler) PatchDevice(ctx context.Context, req *devicev1.PatchDev
 		return nil, status.Error(codes.InvalidArgument, "invalid device ID")
 	}
 
-	updates := make(map[string]any)
-
-	if req.Name != nil {
-		updates["name"] = *req.Name
+	if err := grpcutil.ValidateFieldMask(req.GetUpdateMask(), patchableFields); err != nil {
+		return nil, err
 	}
 
-	if req.Brand != nil {
-		updates["brand"] = *req.Brand
-	}
-
-	if req.State != nil {
-		updates["state"] = toDomainState(*req.State).String()
+	updates := make(map[string]any, len(req.GetUpdateMask().GetPaths()))
+
+	for _, path := range req.GetUpdateMask().GetPaths() {
+		switch path {
+		case "name":
+			if req.GetName() == "" {
+				return nil, status.Error(codes.InvalidArgument, "name is required")
+			}
+
+			updates["name"] = req.GetName()
+		case "brand":
+			if req.GetBrand() == "" {
+				return nil, status.Error(codes.InvalidArgument, "brand is required")
+			}
+
+			updates["brand"] = req.GetBrand()
+		case "state":
+			state := toDomainState(req.GetState())
+			if err := validateState(state); err != nil {
+				return nil, err
+			}
+
+			updates["state"] = state.String()
+		}
 	}
 
 	cmd := commands.PatchDeviceCommand{
@@ -147,6 +192,14 @@ func (h *DevicesHandler) PatchDevice(ctx context.Context, req *devicev1.PatchDev
 		Updates: updates,
 	}
 
+	if expected := req.GetExpectedUpdatedAt(); expected != nil {
+		if err := expected.CheckValid(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid expected_updated_at")
+		}
+
+		cmd.LastUpdatedAt = expected.AsTime()
+	}
+
 	device, err := h.app.Commands.PatchDevice.Handle(ctx, cmd)
 	if err != nil {
 		return nil, toGRPCError(err)
@@ -177,21 +230,94 @@ func (h *DevicesHandler) DeleteDevice(ctx context.Context, req *devicev1.DeleteD
 	return &emptypb.Empty{}, nil
 }
 
-func toGRPCError(err error) error {
-	switch {
-	case errors.Is(err, model.ErrDeviceNotFound):
-		return status.Error(codes.NotFound, "device not found")
-	case errors.Is(err, model.ErrCannotUpdateInUseDevice):
-		return status.Error(codes.FailedPrecondition, "cannot update name or brand of in-use device")
-	case errors.Is(err, model.ErrCannotDeleteInUseDevice):
-		return status.Error(codes.FailedPrecondition, "cannot delete in-use device")
-	case errors.Is(err, model.ErrDuplicateDevice):
-		return status.Error(codes.AlreadyExists, "device already exists")
-	case errors.Is(err, model.ErrInvalidState):
-		return status.Error(codes.InvalidArgument, "invalid device state")
-	case errors.Is(err, model.ErrInvalidDeviceID):
+// BulkCreateDevices creates the streamed devices one at a time, in the order they
+// arrive, and answers each request with its own result. A failed item is reported in
+// its response and does not abort the remaining ones.
+func (h *DevicesHandler) BulkCreateDevices(stream devicev1.DeviceService_BulkCreateDevicesServer) error {
+	ctx := stream.Context()
+
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		result := &devicev1.BulkCreateDeviceResponse{}
+
+		resp, err := h.CreateDevice(ctx, req)
+		if err != nil {
+			result.ErrorCode = status.Code(err).String()
+		} else {
+			result.DeviceId = resp.GetDevice().GetId()
+			result.Success = true
+		}
+
+		if err := stream.Send(result); err != nil {
+			return err
+		}
+	}
+}
+
+// WatchDevice sends the device's current version and then every later change, until
+// the client goes away or the device is deleted, which ends the stream cleanly.
+func (h *DevicesHandler) WatchDevice(req *devicev1.WatchDeviceRequest, stream devicev1.DeviceService_WatchDeviceServer) error {
+	if req.Id == "" {
+		return status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	id, err := model.ParseDeviceID(req.Id)
+	if err != nil {
 		return status.Error(codes.InvalidArgument, "invalid device ID")
-	default:
+	}
+
+	ctx := stream.Context()
+
+	subscription, err := h.app.Queries.WatchDevice.Execute(ctx, queries.WatchDeviceQuery{ID: id})
+	if err != nil {
+		return toGRPCError(err)
+	}
+	defer subscription.Cancel()
+
+	if err := stream.Send(&devicev1.WatchDeviceResponse{Device: toProtoDevice(subscription.Current)}); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case device, ok := <-subscription.Updates:
+			if !ok {
+				return nil
+			}
+
+			if err := stream.Send(&devicev1.WatchDeviceResponse{Device: toProtoDevice(device)}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// validateState rejects states outside the canonical set with InvalidArgument.
+func validateState(state model.State) error {
+	if err := state.Validate(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return nil
+}
+
+// toGRPCError answers err with the code its model.DomainError carries. Internal failures,
+// and errors outside the domain, are reported without their details.
+func toGRPCError(err error) error {
+	domainErr, ok := model.IsDomainError(err)
+	if !ok || domainErr.GRPCCode == codes.Internal {
 		return status.Error(codes.Internal, "internal error")
 	}
+
+	return status.Error(domainErr.GRPCCode, domainErr.Message)
 }
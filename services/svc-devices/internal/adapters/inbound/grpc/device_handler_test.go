@@ -2,19 +2,28 @@ package grpc_test
 
 import (
 	"context"
+	"io"
 	"testing"
+	"time"
 
 	"github.com/architeacher/devices/pkg/logger"
 	"github.com/architeacher/devices/pkg/metrics/noop"
 	devicev1 "github.com/architeacher/devices/pkg/proto/device/v1"
+	"github.com/architeacher/devices/services/svc-devices/internal/adapters/events"
 	inboundgrpc "github.com/architeacher/devices/services/svc-devices/internal/adapters/inbound/grpc"
+	"github.com/architeacher/devices/services/svc-devices/internal/adapters/services"
 	"github.com/architeacher/devices/services/svc-devices/internal/domain/model"
 	"github.com/architeacher/devices/services/svc-devices/internal/infrastructure"
 	"github.com/architeacher/devices/services/svc-devices/internal/mocks"
 	"github.com/architeacher/devices/services/svc-devices/internal/usecases"
+	"github.com/architeacher/devices/services/svc-devices/internal/usecases/commands"
+	"github.com/architeacher/devices/services/svc-devices/internal/usecases/queries"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func createTestApp(svc *mocks.FakeDevicesService, dbChecker *mocks.FakeDatabaseHealthChecker) *usecases.Application {
@@ -22,7 +31,7 @@ func createTestApp(svc *mocks.FakeDevicesService, dbChecker *mocks.FakeDatabaseH
 	tp := infrastructure.NewNoopTracerProvider()
 	mc := noop.NewMetricsClient()
 
-	return usecases.NewApplication(svc, dbChecker, log, tp, mc)
+	return usecases.NewApplication(svc, dbChecker, queries.PoolLimits{}, log, tp, mc)
 }
 
 func TestDeviceHandler_CreateDevice(t *testing.T) {
@@ -74,6 +83,18 @@ func TestDeviceHandler_CreateDevice(t *testing.T) {
 			expectedCode: codes.InvalidArgument,
 			expectError:  true,
 		},
+		{
+			name: "unknown state returns invalid argument",
+			setupSvc: func(_ *mocks.FakeDevicesService) {
+			},
+			request: &devicev1.CreateDeviceRequest{
+				Name:  "Test Device",
+				Brand: "Test Brand",
+				State: devicev1.DeviceState(99),
+			},
+			expectedCode: codes.InvalidArgument,
+			expectError:  true,
+		},
 	}
 
 	for _, tc := range cases {
@@ -269,6 +290,45 @@ func TestDeviceHandler_ListDevices(t *testing.T) {
 	}
 }
 
+func TestDeviceHandler_ListDevices_InvalidFilter(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		request *devicev1.ListDevicesRequest
+		message string
+	}{
+		{
+			name:    "size above maximum",
+			request: &devicev1.ListDevicesRequest{Size: 501},
+			message: "size must be between 1 and 500",
+		},
+		{
+			name:    "unsupported sort field",
+			request: &devicev1.ListDevicesRequest{Sort: []string{"-price"}},
+			message: `unsupported sort field "-price"`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := &mocks.FakeDevicesService{}
+			dbChecker := &mocks.FakeDatabaseHealthChecker{}
+			handler := inboundgrpc.NewDevicesHandler(createTestApp(svc, dbChecker))
+
+			_, err := handler.ListDevices(t.Context(), tc.request)
+
+			st, ok := status.FromError(err)
+			require.True(t, ok)
+			require.Equal(t, codes.InvalidArgument, st.Code())
+			require.Contains(t, st.Message(), tc.message)
+			require.Zero(t, svc.ListDevicesCallCount())
+		})
+	}
+}
+
 func TestDeviceHandler_UpdateDevice(t *testing.T) {
 	t.Parallel()
 
@@ -342,6 +402,22 @@ func TestDeviceHandler_UpdateDevice(t *testing.T) {
 			expectedCode: codes.NotFound,
 			expectError:  true,
 		},
+		{
+			name: "unknown state returns invalid argument",
+			setupSvc: func(_ *mocks.FakeDevicesService) string {
+				return model.NewDeviceID().String()
+			},
+			request: func(id string) *devicev1.UpdateDeviceRequest {
+				return &devicev1.UpdateDeviceRequest{
+					Id:    id,
+					Name:  "Name",
+					Brand: "Brand",
+					State: devicev1.DeviceState(99),
+				}
+			},
+			expectedCode: codes.InvalidArgument,
+			expectError:  true,
+		},
 	}
 
 	for _, tc := range cases {
@@ -371,6 +447,295 @@ func TestDeviceHandler_UpdateDevice(t *testing.T) {
 	}
 }
 
+func TestDeviceHandler_PatchDevice_StateValidation(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name         string
+		state        devicev1.DeviceState
+		expectedCode codes.Code
+	}{
+		{
+			name:         "canonical state is patched",
+			state:        devicev1.DeviceState_DEVICE_STATE_INACTIVE,
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "unknown state returns invalid argument",
+			state:        devicev1.DeviceState(99),
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := &mocks.FakeDevicesService{}
+			svc.PatchDeviceStub = func(_ context.Context, id model.DeviceID, updates map[string]any) (*model.Device, error) {
+				return &model.Device{ID: id, State: model.State(updates["state"].(string))}, nil
+			}
+			dbChecker := &mocks.FakeDatabaseHealthChecker{}
+			dbChecker.PingReturns(nil)
+			handler := inboundgrpc.NewDevicesHandler(createTestApp(svc, dbChecker))
+
+			state := tc.state
+			resp, err := handler.PatchDevice(t.Context(), &devicev1.PatchDeviceRequest{
+				Id:         model.NewDeviceID().String(),
+				State:      &state,
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"state"}},
+			})
+
+			require.Equal(t, tc.expectedCode, status.Code(err))
+
+			if tc.expectedCode != codes.OK {
+				require.Zero(t, svc.PatchDeviceCallCount())
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, devicev1.DeviceState_DEVICE_STATE_INACTIVE, resp.Device.State)
+		})
+	}
+}
+
+// newStoredDevicesService serves a single stored device, applying patches and updates
+// to it as svc-devices does.
+func newStoredDevicesService(device *model.Device) *mocks.FakeDevicesService {
+	svc := &mocks.FakeDevicesService{}
+	svc.PatchDeviceStub = func(_ context.Context, _ model.DeviceID, updates map[string]any) (*model.Device, error) {
+		if err := device.Patch(updates); err != nil {
+			return nil, err
+		}
+
+		return device, nil
+	}
+	svc.UpdateDeviceStub = func(_ context.Context, _ model.DeviceID, name, brand string, state model.State) (*model.Device, error) {
+		if err := device.Update(name, brand, state); err != nil {
+			return nil, err
+		}
+
+		return device, nil
+	}
+
+	return svc
+}
+
+func TestDeviceHandler_PatchDevice_FieldMask(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name            string
+		request         func(id string) *devicev1.PatchDeviceRequest
+		expectedCode    codes.Code
+		expectedUpdates map[string]any
+		expectedDevice  *devicev1.Device
+	}{
+		{
+			name: "only masked fields are updated",
+			request: func(id string) *devicev1.PatchDeviceRequest {
+				return &devicev1.PatchDeviceRequest{
+					Id:         id,
+					Name:       proto.String("iPhone 16"),
+					Brand:      proto.String("Samsung"),
+					UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name"}},
+				}
+			},
+			expectedCode:    codes.OK,
+			expectedUpdates: map[string]any{"name": "iPhone 16"},
+			expectedDevice: &devicev1.Device{
+				Name:  "iPhone 16",
+				Brand: "Apple",
+				State: devicev1.DeviceState_DEVICE_STATE_AVAILABLE,
+			},
+		},
+		{
+			name: "every masked field is updated",
+			request: func(id string) *devicev1.PatchDeviceRequest {
+				return &devicev1.PatchDeviceRequest{
+					Id:         id,
+					Name:       proto.String("Galaxy S24"),
+					Brand:      proto.String("Samsung"),
+					State:      devicev1.DeviceState_DEVICE_STATE_INACTIVE.Enum(),
+					UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name", "brand", "state"}},
+				}
+			},
+			expectedCode:    codes.OK,
+			expectedUpdates: map[string]any{"name": "Galaxy S24", "brand": "Samsung", "state": "inactive"},
+			expectedDevice: &devicev1.Device{
+				Name:  "Galaxy S24",
+				Brand: "Samsung",
+				State: devicev1.DeviceState_DEVICE_STATE_INACTIVE,
+			},
+		},
+		{
+			name: "missing mask returns invalid argument",
+			request: func(id string) *devicev1.PatchDeviceRequest {
+				return &devicev1.PatchDeviceRequest{Id: id, Name: proto.String("iPhone 16")}
+			},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name: "empty mask returns invalid argument",
+			request: func(id string) *devicev1.PatchDeviceRequest {
+				return &devicev1.PatchDeviceRequest{
+					Id:         id,
+					Name:       proto.String("iPhone 16"),
+					UpdateMask: &fieldmaskpb.FieldMask{},
+				}
+			},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name: "unknown path returns invalid argument",
+			request: func(id string) *devicev1.PatchDeviceRequest {
+				return &devicev1.PatchDeviceRequest{
+					Id:         id,
+					Name:       proto.String("iPhone 16"),
+					UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name", "created_at"}},
+				}
+			},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name: "masked field cleared returns invalid argument",
+			request: func(id string) *devicev1.PatchDeviceRequest {
+				return &devicev1.PatchDeviceRequest{
+					Id:         id,
+					UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"brand"}},
+				}
+			},
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			device := model.NewDevice("iPhone 15", "Apple", model.StateAvailable)
+			svc := newStoredDevicesService(device)
+			handler := inboundgrpc.NewDevicesHandler(createTestApp(svc, &mocks.FakeDatabaseHealthChecker{}))
+
+			resp, err := handler.PatchDevice(t.Context(), tc.request(device.ID.String()))
+
+			require.Equal(t, tc.expectedCode, status.Code(err))
+
+			if tc.expectedCode != codes.OK {
+				require.Zero(t, svc.PatchDeviceCallCount())
+
+				return
+			}
+
+			_, _, updates := svc.PatchDeviceArgsForCall(0)
+			require.Equal(t, tc.expectedUpdates, updates)
+
+			require.Equal(t, tc.expectedDevice.Name, resp.Device.Name)
+			require.Equal(t, tc.expectedDevice.Brand, resp.Device.Brand)
+			require.Equal(t, tc.expectedDevice.State, resp.Device.State)
+		})
+	}
+}
+
+func TestDeviceHandler_PatchDevice_ExpectedUpdatedAt(t *testing.T) {
+	t.Parallel()
+
+	lastUpdatedAt := time.Date(2026, 1, 2, 3, 4, 5, 6000, time.UTC)
+
+	cases := []struct {
+		name         string
+		expected     *timestamppb.Timestamp
+		patchErr     error
+		expectedCode codes.Code
+	}{
+		{
+			name:         "unmodified device is patched",
+			expected:     timestamppb.New(lastUpdatedAt),
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "modified device returns failed precondition",
+			expected:     timestamppb.New(lastUpdatedAt),
+			patchErr:     model.ErrDeviceModified,
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:         "invalid timestamp returns invalid argument",
+			expected:     &timestamppb.Timestamp{Nanos: -1},
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := &mocks.FakeDevicesService{}
+			svc.PatchDeviceIfUnmodifiedStub = func(_ context.Context, id model.DeviceID, updates map[string]any, _ time.Time) (*model.Device, error) {
+				if tc.patchErr != nil {
+					return nil, tc.patchErr
+				}
+
+				return &model.Device{ID: id, State: model.State(updates["state"].(string))}, nil
+			}
+			handler := inboundgrpc.NewDevicesHandler(createTestApp(svc, &mocks.FakeDatabaseHealthChecker{}))
+
+			_, err := handler.PatchDevice(t.Context(), &devicev1.PatchDeviceRequest{
+				Id:                model.NewDeviceID().String(),
+				State:             devicev1.DeviceState_DEVICE_STATE_IN_USE.Enum(),
+				UpdateMask:        &fieldmaskpb.FieldMask{Paths: []string{"state"}},
+				ExpectedUpdatedAt: tc.expected,
+			})
+
+			require.Equal(t, tc.expectedCode, status.Code(err))
+			require.Zero(t, svc.PatchDeviceCallCount(), "a conditional patch never falls back to an unconditional one")
+
+			if tc.expectedCode == codes.InvalidArgument {
+				require.Zero(t, svc.PatchDeviceIfUnmodifiedCallCount())
+
+				return
+			}
+
+			_, _, updates, since := svc.PatchDeviceIfUnmodifiedArgsForCall(0)
+			require.Equal(t, map[string]any{"state": "in-use"}, updates)
+			require.True(t, lastUpdatedAt.Equal(since))
+		})
+	}
+}
+
+func TestDeviceHandler_PatchDevice_FullMaskMatchesUpdate(t *testing.T) {
+	t.Parallel()
+
+	patched := model.NewDevice("iPhone 15", "Apple", model.StateAvailable)
+	updated := *patched
+
+	patchHandler := inboundgrpc.NewDevicesHandler(createTestApp(newStoredDevicesService(patched), &mocks.FakeDatabaseHealthChecker{}))
+	updateHandler := inboundgrpc.NewDevicesHandler(createTestApp(newStoredDevicesService(&updated), &mocks.FakeDatabaseHealthChecker{}))
+
+	patchResp, err := patchHandler.PatchDevice(t.Context(), &devicev1.PatchDeviceRequest{
+		Id:         patched.ID.String(),
+		Name:       proto.String("Galaxy S24"),
+		Brand:      proto.String("Samsung"),
+		State:      devicev1.DeviceState_DEVICE_STATE_IN_USE.Enum(),
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name", "brand", "state"}},
+	})
+	require.NoError(t, err)
+
+	updateResp, err := updateHandler.UpdateDevice(t.Context(), &devicev1.UpdateDeviceRequest{
+		Id:    updated.ID.String(),
+		Name:  "Galaxy S24",
+		Brand: "Samsung",
+		State: devicev1.DeviceState_DEVICE_STATE_IN_USE,
+	})
+	require.NoError(t, err)
+
+	// Both stamp the device with their own time, so updated_at is left out.
+	patchResp.Device.UpdatedAt = nil
+	updateResp.Device.UpdatedAt = nil
+	require.True(t, proto.Equal(updateResp.Device, patchResp.Device), "patch: %v, update: %v", patchResp.Device, updateResp.Device)
+}
+
 func TestDeviceHandler_DeleteDevice(t *testing.T) {
 	t.Parallel()
 
@@ -443,3 +808,258 @@ func TestDeviceHandler_DeleteDevice(t *testing.T) {
 func strPtr(s string) *string {
 	return &s
 }
+
+// bulkCreateStream feeds requests to BulkCreateDevices and collects its responses.
+type bulkCreateStream struct {
+	mockServerStream
+	requests  []*devicev1.CreateDeviceRequest
+	responses []*devicev1.BulkCreateDeviceResponse
+	recvErr   error
+}
+
+func (s *bulkCreateStream) Recv() (*devicev1.CreateDeviceRequest, error) {
+	if len(s.requests) == 0 {
+		if s.recvErr != nil {
+			return nil, s.recvErr
+		}
+
+		return nil, io.EOF
+	}
+
+	req := s.requests[0]
+	s.requests = s.requests[1:]
+
+	return req, nil
+}
+
+func (s *bulkCreateStream) Send(resp *devicev1.BulkCreateDeviceResponse) error {
+	s.responses = append(s.responses, resp)
+
+	return nil
+}
+
+func TestDeviceHandler_BulkCreateDevices(t *testing.T) {
+	t.Parallel()
+
+	newRequest := func(name string) *devicev1.CreateDeviceRequest {
+		return &devicev1.CreateDeviceRequest{
+			Name:  name,
+			Brand: "Apple",
+			State: devicev1.DeviceState_DEVICE_STATE_AVAILABLE,
+		}
+	}
+
+	cases := []struct {
+		name              string
+		requests          []*devicev1.CreateDeviceRequest
+		recvErr           error
+		expectedSuccesses []bool
+		expectedCodes     []string
+		expectedErr       error
+	}{
+		{
+			name:              "streams a result for every created device",
+			requests:          []*devicev1.CreateDeviceRequest{newRequest("iPhone"), newRequest("iPad"), newRequest("Mac")},
+			expectedSuccesses: []bool{true, true, true},
+			expectedCodes:     []string{"", "", ""},
+		},
+		{
+			name:              "duplicate mid-stream does not abort the following items",
+			requests:          []*devicev1.CreateDeviceRequest{newRequest("iPhone"), newRequest("duplicate"), newRequest("Mac")},
+			expectedSuccesses: []bool{true, false, true},
+			expectedCodes:     []string{"", codes.AlreadyExists.String(), ""},
+		},
+		{
+			name:              "invalid item is reported without calling the service",
+			requests:          []*devicev1.CreateDeviceRequest{newRequest(""), newRequest("iPad")},
+			expectedSuccesses: []bool{false, true},
+			expectedCodes:     []string{codes.InvalidArgument.String(), ""},
+		},
+		{
+			name:              "closes cleanly on an empty stream",
+			expectedSuccesses: []bool{},
+			expectedCodes:     []string{},
+		},
+		{
+			name:              "returns receive errors",
+			requests:          []*devicev1.CreateDeviceRequest{newRequest("iPhone")},
+			recvErr:           status.Error(codes.Canceled, "client went away"),
+			expectedSuccesses: []bool{true},
+			expectedCodes:     []string{""},
+			expectedErr:       status.Error(codes.Canceled, "client went away"),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := &mocks.FakeDevicesService{}
+			svc.CreateDeviceStub = func(_ context.Context, name, brand string, state model.State) (*model.Device, error) {
+				if name == "duplicate" {
+					return nil, model.ErrDuplicateDevice
+				}
+
+				return model.NewDevice(name, brand, state), nil
+			}
+
+			dbChecker := &mocks.FakeDatabaseHealthChecker{}
+			handler := inboundgrpc.NewDevicesHandler(createTestApp(svc, dbChecker))
+
+			stream := &bulkCreateStream{
+				mockServerStream: mockServerStream{ctx: t.Context()},
+				requests:         tc.requests,
+				recvErr:          tc.recvErr,
+			}
+
+			err := handler.BulkCreateDevices(stream)
+			if tc.expectedErr != nil {
+				require.Equal(t, status.Code(tc.expectedErr), status.Code(err))
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Len(t, stream.responses, len(tc.expectedSuccesses))
+
+			for i, resp := range stream.responses {
+				require.Equal(t, tc.expectedSuccesses[i], resp.GetSuccess(), "item %d", i)
+				require.Equal(t, tc.expectedCodes[i], resp.GetErrorCode(), "item %d", i)
+
+				if resp.GetSuccess() {
+					require.NotEmpty(t, resp.GetDeviceId())
+				} else {
+					require.Empty(t, resp.GetDeviceId())
+				}
+			}
+		})
+	}
+}
+
+// watchStream hands every WatchDevice response to the consuming goroutine.
+type watchStream struct {
+	mockServerStream
+	responses chan *devicev1.WatchDeviceResponse
+}
+
+func (s *watchStream) Send(resp *devicev1.WatchDeviceResponse) error {
+	s.responses <- resp
+
+	return nil
+}
+
+func TestDeviceHandler_WatchDevice_StreamsChangesInOrder(t *testing.T) {
+	t.Parallel()
+
+	stored := model.NewDevice("iPhone", "Apple", model.StateAvailable)
+
+	repo := &mocks.FakeDeviceRepository{}
+	fetch := func(context.Context, model.DeviceID) (*model.Device, error) {
+		snapshot := *stored
+
+		return &snapshot, nil
+	}
+	repo.FetchByIDStub = fetch
+	repo.FetchByIDForUpdateStub = fetch
+	repo.UpdateStub = func(_ context.Context, device *model.Device) error {
+		snapshot := *device
+		stored = &snapshot
+
+		return nil
+	}
+
+	svc := services.NewDevicesService(repo, events.NewDeviceEventBus())
+	app := usecases.NewApplication(svc, &mocks.FakeDatabaseHealthChecker{}, queries.PoolLimits{},
+		logger.New("debug", "console"), infrastructure.NewNoopTracerProvider(), noop.NewMetricsClient())
+	handler := inboundgrpc.NewDevicesHandler(app)
+
+	stream := &watchStream{
+		mockServerStream: mockServerStream{ctx: t.Context()},
+		responses:        make(chan *devicev1.WatchDeviceResponse),
+	}
+
+	watchErr := make(chan error, 1)
+	go func() {
+		watchErr <- handler.WatchDevice(&devicev1.WatchDeviceRequest{Id: stored.ID.String()}, stream)
+		close(stream.responses)
+	}()
+
+	// The current version arrives first, so the subscription is in place before any update.
+	initial := <-stream.responses
+	require.Equal(t, "iPhone", initial.GetDevice().GetName())
+
+	names := []string{"iPhone 15", "iPhone 16", "iPhone 17"}
+
+	updateErr := make(chan error, 1)
+	go func() {
+		for _, name := range names {
+			_, err := app.Commands.PatchDevice.Handle(t.Context(), commands.PatchDeviceCommand{
+				ID:      stored.ID,
+				Updates: map[string]any{"name": name},
+			})
+			if err != nil {
+				updateErr <- err
+
+				return
+			}
+		}
+
+		_, err := app.Commands.DeleteDevice.Handle(t.Context(), commands.DeleteDeviceCommand{ID: stored.ID})
+		updateErr <- err
+	}()
+
+	received := make([]string, 0, len(names))
+	for resp := range stream.responses {
+		received = append(received, resp.GetDevice().GetName())
+	}
+
+	require.NoError(t, <-updateErr)
+	require.NoError(t, <-watchErr, "deleting the device ends the stream cleanly")
+	require.Equal(t, names, received)
+}
+
+func TestDeviceHandler_WatchDevice_Errors(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name         string
+		id           string
+		fetchErr     error
+		expectedCode codes.Code
+	}{
+		{
+			name:         "missing id",
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "invalid id",
+			id:           "not-a-uuid",
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "unknown device",
+			id:           model.NewDeviceID().String(),
+			fetchErr:     model.ErrDeviceNotFound,
+			expectedCode: codes.NotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := &mocks.FakeDevicesService{}
+			svc.WatchDeviceReturns(nil, tc.fetchErr)
+
+			handler := inboundgrpc.NewDevicesHandler(createTestApp(svc, &mocks.FakeDatabaseHealthChecker{}))
+
+			stream := &watchStream{
+				mockServerStream: mockServerStream{ctx: t.Context()},
+				responses:        make(chan *devicev1.WatchDeviceResponse, 1),
+			}
+
+			err := handler.WatchDevice(&devicev1.WatchDeviceRequest{Id: tc.id}, stream)
+			require.Equal(t, tc.expectedCode, status.Code(err))
+			require.Empty(t, stream.responses)
+		})
+	}
+}
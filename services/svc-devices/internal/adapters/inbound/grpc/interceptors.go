@@ -5,10 +5,13 @@ import (
 	"strings"
 	"time"
 
+	"github.com/architeacher/devices/pkg/grpcutil"
 	"github.com/architeacher/devices/pkg/logger"
 	"github.com/architeacher/devices/services/svc-devices/internal/config"
+	"github.com/architeacher/devices/services/svc-devices/internal/domain/model"
 	"github.com/google/uuid"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
@@ -19,6 +22,7 @@ const (
 	MetadataKeyRequestID     = "request-id"
 	MetadataKeyCorrelationID = "correlation-id"
 	MetadataKeyIdempotency   = "idempotency-key"
+	MetadataKeyActor         = "actor"
 
 	ContextKeyRequestID     contextKey = "requestID"
 	ContextKeyCorrelationID contextKey = "correlationID"
@@ -48,6 +52,10 @@ func ContextExtractorInterceptor() grpc.UnaryServerInterceptor {
 			if idempotencyKeys := md.Get(MetadataKeyIdempotency); len(idempotencyKeys) > 0 {
 				ctx = context.WithValue(ctx, ContextKeyIdempotency, idempotencyKeys[0])
 			}
+
+			if actors := md.Get(MetadataKeyActor); len(actors) > 0 {
+				ctx = model.WithActor(ctx, actors[0])
+			}
 		}
 
 		if requestID == "" {
@@ -59,6 +67,50 @@ func ContextExtractorInterceptor() grpc.UnaryServerInterceptor {
 	}
 }
 
+// RequireMetadataInterceptor rejects unary calls that do not carry every one of the
+// required metadata keys with codes.InvalidArgument. Keys are matched
+// case-insensitively, and it must run before ContextExtractorInterceptor, which
+// would otherwise generate a request ID for callers that omitted one.
+func RequireMetadataInterceptor(requiredKeys ...string) grpc.UnaryServerInterceptor {
+	keys := make([]string, 0, len(requiredKeys))
+	for _, key := range requiredKeys {
+		if key = strings.ToLower(strings.TrimSpace(key)); key != "" {
+			keys = append(keys, key)
+		}
+	}
+
+	return func(
+		ctx context.Context,
+		req any,
+		_ *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		if len(keys) == 0 {
+			return handler(ctx, req)
+		}
+
+		md, _ := metadata.FromIncomingContext(ctx)
+
+		for _, key := range keys {
+			if !hasMetadataValue(md, key) {
+				return nil, status.Error(codes.InvalidArgument, "missing required metadata: "+key)
+			}
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func hasMetadataValue(md metadata.MD, key string) bool {
+	for _, value := range md.Get(key) {
+		if strings.TrimSpace(value) != "" {
+			return true
+		}
+	}
+
+	return false
+}
+
 func GetRequestID(ctx context.Context) string {
 	if id, ok := ctx.Value(ContextKeyRequestID).(string); ok {
 		return id
@@ -122,6 +174,103 @@ func AccessLogInterceptor(log logger.Logger, cfg config.AccessLog) grpc.UnarySer
 	}
 }
 
+// StreamAccessLogInterceptor is the streaming counterpart of AccessLogInterceptor.
+// It logs when a stream opens and, once the handler returns, logs the number of
+// messages and bytes sent and received together with the stream duration.
+func StreamAccessLogInterceptor(log logger.Logger, cfg config.AccessLog) grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if !cfg.Enabled {
+			return handler(srv, ss)
+		}
+
+		if !cfg.LogHealthChecks && isHealthCheck(info.FullMethod) {
+			return handler(srv, ss)
+		}
+
+		ctx := ss.Context()
+		requestID := streamRequestID(ctx)
+
+		openEvent := log.Info().
+			Str("method", info.FullMethod).
+			Str("request_id", requestID).
+			Bool("client_stream", info.IsClientStream).
+			Bool("server_stream", info.IsServerStream)
+
+		if cfg.IncludeMetadata {
+			if md, ok := metadata.FromIncomingContext(ctx); ok {
+				openEvent = openEvent.Any("metadata", sanitizeMetadata(md))
+			}
+		}
+
+		openEvent.Msg("gRPC stream opened")
+
+		start := time.Now()
+		stream := grpcutil.NewObservableServerStream(ss)
+		err := handler(srv, stream)
+		duration := time.Since(start)
+
+		logEvent := log.Info().
+			Str("method", info.FullMethod).
+			Str("request_id", requestID).
+			Uint64("messages_sent", stream.MessagesSent.Load()).
+			Uint64("messages_received", stream.MessagesReceived.Load()).
+			Uint64("bytes_sent", stream.BytesSent.Load()).
+			Uint64("bytes_received", stream.BytesReceived.Load()).
+			Dur("duration", duration)
+
+		if correlationID := streamCorrelationID(ctx); correlationID != "" {
+			logEvent = logEvent.Str("correlation_id", correlationID)
+		}
+
+		if err != nil {
+			st, _ := status.FromError(err)
+			logEvent.Str("grpc_code", st.Code().String()).
+				Str("error", st.Message()).
+				Msg("gRPC stream failed")
+		} else {
+			logEvent.Msg("gRPC stream closed")
+		}
+
+		return err
+	}
+}
+
+// streamRequestID returns the request ID from the context, falling back to the
+// incoming metadata since ContextExtractorInterceptor only runs for unary calls.
+func streamRequestID(ctx context.Context) string {
+	if requestID := GetRequestID(ctx); requestID != "" {
+		return requestID
+	}
+
+	return firstMetadataValue(ctx, MetadataKeyRequestID)
+}
+
+func streamCorrelationID(ctx context.Context) string {
+	if correlationID := GetCorrelationID(ctx); correlationID != "" {
+		return correlationID
+	}
+
+	return firstMetadataValue(ctx, MetadataKeyCorrelationID)
+}
+
+func firstMetadataValue(ctx context.Context, key string) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+
+	return ""
+}
+
 func isHealthCheck(fullMethod string) bool {
 	return strings.Contains(fullMethod, healthServicePrefix)
 }
@@ -3,14 +3,19 @@ package grpc_test
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	"io"
 	"testing"
 
 	"github.com/architeacher/devices/pkg/logger"
 	inboundgrpc "github.com/architeacher/devices/services/svc-devices/internal/adapters/inbound/grpc"
 	"github.com/architeacher/devices/services/svc-devices/internal/config"
+	"github.com/architeacher/devices/services/svc-devices/internal/domain/model"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 func TestContextExtractorInterceptor_ExtractsRequestID(t *testing.T) {
@@ -126,6 +131,47 @@ func TestContextExtractorInterceptor_ExtractsCorrelationID(t *testing.T) {
 	}
 }
 
+func TestContextExtractorInterceptor_ExtractsActor(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name          string
+		metadata      metadata.MD
+		expectedActor string
+	}{
+		{
+			name:          "extracts actor from metadata",
+			metadata:      metadata.Pairs(inboundgrpc.MetadataKeyActor, "user-42"),
+			expectedActor: "user-42",
+		},
+		{
+			name:          "handles missing actor",
+			metadata:      metadata.MD{},
+			expectedActor: "",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			interceptor := inboundgrpc.ContextExtractorInterceptor()
+
+			var capturedCtx context.Context
+			mockHandler := func(ctx context.Context, req any) (any, error) {
+				capturedCtx = ctx
+
+				return "response", nil
+			}
+
+			_, err := interceptor(metadata.NewIncomingContext(t.Context(), tc.metadata), nil, &grpc.UnaryServerInfo{}, mockHandler)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.expectedActor, model.ActorFromContext(capturedCtx))
+		})
+	}
+}
+
 func TestContextExtractorInterceptor_GeneratesRequestIDWhenMissing(t *testing.T) {
 	t.Parallel()
 
@@ -410,3 +456,304 @@ func TestAccessLogInterceptor_IncludesCorrelationID(t *testing.T) {
 	logOutput := buf.String()
 	require.Contains(t, logOutput, "test-correlation-id")
 }
+
+type mockServerStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	sent     []any
+	incoming []any
+}
+
+func (m *mockServerStream) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockServerStream) SendMsg(msg any) error {
+	m.sent = append(m.sent, msg)
+
+	return nil
+}
+
+func (m *mockServerStream) RecvMsg(_ any) error {
+	if len(m.incoming) == 0 {
+		return io.EOF
+	}
+
+	m.incoming = m.incoming[1:]
+
+	return nil
+}
+
+func (m *mockServerStream) SetHeader(metadata.MD) error  { return nil }
+func (m *mockServerStream) SendHeader(metadata.MD) error { return nil }
+func (m *mockServerStream) SetTrailer(metadata.MD)       {}
+
+func decodeLogLines(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	t.Helper()
+
+	var entries []map[string]any
+
+	for _, line := range bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n")) {
+		if len(line) == 0 {
+			continue
+		}
+
+		var entry map[string]any
+		require.NoError(t, json.Unmarshal(line, &entry))
+
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
+
+func TestStreamAccessLogInterceptor(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name           string
+		config         config.AccessLog
+		fullMethod     string
+		handlerErr     error
+		expectLog      bool
+		expectErrorLog bool
+	}{
+		{
+			name: "logs stream open and close when enabled",
+			config: config.AccessLog{
+				Enabled:         true,
+				LogHealthChecks: true,
+			},
+			fullMethod: "/device.v1.DeviceService/StreamDevices",
+			expectLog:  true,
+		},
+		{
+			name: "skips logging when disabled",
+			config: config.AccessLog{
+				Enabled: false,
+			},
+			fullMethod: "/device.v1.DeviceService/StreamDevices",
+			expectLog:  false,
+		},
+		{
+			name: "skips health stream when LogHealthChecks is false",
+			config: config.AccessLog{
+				Enabled:         true,
+				LogHealthChecks: false,
+			},
+			fullMethod: "/device.v1.HealthService/Watch",
+			expectLog:  false,
+		},
+		{
+			name: "logs health stream when LogHealthChecks is true",
+			config: config.AccessLog{
+				Enabled:         true,
+				LogHealthChecks: true,
+			},
+			fullMethod: "/device.v1.HealthService/Watch",
+			expectLog:  true,
+		},
+		{
+			name: "logs failure when handler returns error",
+			config: config.AccessLog{
+				Enabled:         true,
+				LogHealthChecks: true,
+			},
+			fullMethod:     "/device.v1.DeviceService/StreamDevices",
+			handlerErr:     grpc.ErrServerStopped,
+			expectLog:      true,
+			expectErrorLog: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			log := logger.NewWithWriter("info", "json", &buf)
+
+			interceptor := inboundgrpc.StreamAccessLogInterceptor(log, tc.config)
+
+			ctx := context.WithValue(t.Context(), inboundgrpc.ContextKeyRequestID, "test-request-id")
+			stream := &mockServerStream{ctx: ctx, incoming: []any{"first", "second"}}
+
+			handler := func(_ any, ss grpc.ServerStream) error {
+				for {
+					var msg string
+					if err := ss.RecvMsg(&msg); err != nil {
+						break
+					}
+				}
+
+				for range 3 {
+					require.NoError(t, ss.SendMsg("update"))
+				}
+
+				return tc.handlerErr
+			}
+
+			info := &grpc.StreamServerInfo{
+				FullMethod:     tc.fullMethod,
+				IsClientStream: true,
+				IsServerStream: true,
+			}
+			err := interceptor(nil, stream, info, handler)
+
+			if tc.handlerErr != nil {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Len(t, stream.sent, 3)
+
+			entries := decodeLogLines(t, &buf)
+
+			if !tc.expectLog {
+				require.Empty(t, entries)
+
+				return
+			}
+
+			require.Len(t, entries, 2)
+
+			opened := entries[0]
+			require.Equal(t, "gRPC stream opened", opened["message"])
+			require.Equal(t, tc.fullMethod, opened["method"])
+			require.Equal(t, "test-request-id", opened["request_id"])
+
+			closed := entries[1]
+			require.Equal(t, tc.fullMethod, closed["method"])
+			require.Equal(t, "test-request-id", closed["request_id"])
+			require.EqualValues(t, 3, closed["messages_sent"])
+			require.EqualValues(t, 2, closed["messages_received"])
+			require.Contains(t, closed, "bytes_sent")
+			require.Contains(t, closed, "bytes_received")
+			require.Contains(t, closed, "duration")
+
+			if tc.expectErrorLog {
+				require.Equal(t, "gRPC stream failed", closed["message"])
+				require.Contains(t, closed, "grpc_code")
+			} else {
+				require.Equal(t, "gRPC stream closed", closed["message"])
+			}
+		})
+	}
+}
+
+func TestStreamAccessLogInterceptor_RequestIDFromMetadata(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	log := logger.NewWithWriter("info", "json", &buf)
+
+	cfg := config.AccessLog{
+		Enabled:         true,
+		LogHealthChecks: true,
+	}
+
+	interceptor := inboundgrpc.StreamAccessLogInterceptor(log, cfg)
+
+	ctx := metadata.NewIncomingContext(t.Context(), metadata.Pairs(
+		inboundgrpc.MetadataKeyRequestID, "metadata-request-id",
+		inboundgrpc.MetadataKeyCorrelationID, "metadata-correlation-id",
+	))
+
+	handler := func(_ any, _ grpc.ServerStream) error {
+		return nil
+	}
+
+	info := &grpc.StreamServerInfo{FullMethod: "/device.v1.DeviceService/StreamDevices", IsServerStream: true}
+	require.NoError(t, interceptor(nil, &mockServerStream{ctx: ctx}, info, handler))
+
+	entries := decodeLogLines(t, &buf)
+	require.Len(t, entries, 2)
+	require.Equal(t, "metadata-request-id", entries[0]["request_id"])
+	require.Equal(t, "metadata-request-id", entries[1]["request_id"])
+	require.Equal(t, "metadata-correlation-id", entries[1]["correlation_id"])
+}
+
+func TestRequireMetadataInterceptor(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name          string
+		requiredKeys  []string
+		metadata      metadata.MD
+		expectedCode  codes.Code
+		expectHandler bool
+	}{
+		{
+			name:          "passes through when no keys are required",
+			metadata:      nil,
+			expectedCode:  codes.OK,
+			expectHandler: true,
+		},
+		{
+			name:          "rejects call without metadata",
+			requiredKeys:  []string{"x-request-id"},
+			metadata:      nil,
+			expectedCode:  codes.InvalidArgument,
+			expectHandler: false,
+		},
+		{
+			name:          "rejects call missing a required key",
+			requiredKeys:  []string{"x-request-id", "correlation-id"},
+			metadata:      metadata.Pairs("x-request-id", "req-1"),
+			expectedCode:  codes.InvalidArgument,
+			expectHandler: false,
+		},
+		{
+			name:          "rejects empty value",
+			requiredKeys:  []string{"x-request-id"},
+			metadata:      metadata.Pairs("x-request-id", ""),
+			expectedCode:  codes.InvalidArgument,
+			expectHandler: false,
+		},
+		{
+			name:          "proceeds when key is present",
+			requiredKeys:  []string{"x-request-id"},
+			metadata:      metadata.Pairs("x-request-id", "req-1"),
+			expectedCode:  codes.OK,
+			expectHandler: true,
+		},
+		{
+			name:          "matches keys case-insensitively",
+			requiredKeys:  []string{"X-Request-ID"},
+			metadata:      metadata.Pairs("X-REQUEST-ID", "req-1"),
+			expectedCode:  codes.OK,
+			expectHandler: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			interceptor := inboundgrpc.RequireMetadataInterceptor(tc.requiredKeys...)
+
+			ctx := t.Context()
+			if tc.metadata != nil {
+				ctx = metadata.NewIncomingContext(ctx, tc.metadata)
+			}
+
+			handlerCalled := false
+			mockHandler := func(ctx context.Context, req any) (any, error) {
+				handlerCalled = true
+
+				return "response", nil
+			}
+
+			info := &grpc.UnaryServerInfo{FullMethod: "/device.v1.DeviceService/GetDevice"}
+			_, err := interceptor(ctx, nil, info, mockHandler)
+
+			require.Equal(t, tc.expectedCode, status.Code(err))
+			require.Equal(t, tc.expectHandler, handlerCalled)
+
+			if tc.expectedCode == codes.InvalidArgument {
+				require.Contains(t, status.Convert(err).Message(), "missing required metadata: ")
+			}
+		})
+	}
+}
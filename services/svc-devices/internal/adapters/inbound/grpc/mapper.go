@@ -38,8 +38,11 @@ func toDomainState(s devicev1.DeviceState) model.State {
 		return model.StateInUse
 	case devicev1.DeviceState_DEVICE_STATE_INACTIVE:
 		return model.StateInactive
-	default:
+	case devicev1.DeviceState_DEVICE_STATE_UNSPECIFIED:
 		return model.StateAvailable
+	default:
+		// Unknown enum values are kept as-is so State.Validate rejects them.
+		return model.State(s.String())
 	}
 }
 
@@ -0,0 +1,41 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/architeacher/devices/pkg/metrics"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const grpcRequestSizeExceededTotal = "grpc_request_size_exceeded_total"
+
+// MaxMessageSizeInterceptor rejects unary requests whose serialized size exceeds
+// maxBytes with codes.ResourceExhausted before the handler runs.
+//
+// The size is computed with proto.Size on the already-decoded message, so it is an
+// approximation of the bytes received: field ordering, unknown fields and encoder
+// differences can make it diverge slightly from the original wire payload. The
+// transport-level grpc.MaxRecvMsgSize remains the hard limit on what gets decoded.
+func MaxMessageSizeInterceptor(maxBytes int, mc metrics.Client) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		msg, ok := req.(proto.Message)
+		if !ok || maxBytes <= 0 || proto.Size(msg) <= maxBytes {
+			return handler(ctx, req)
+		}
+
+		if mc != nil {
+			mc.Inc(ctx, grpcRequestSizeExceededTotal, int64(1), attribute.String(grpcMethodKey, info.FullMethod))
+		}
+
+		return nil, status.Error(codes.ResourceExhausted, "request too large")
+	}
+}
@@ -0,0 +1,93 @@
+package grpc_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/architeacher/devices/pkg/metrics/recording"
+	devicev1 "github.com/architeacher/devices/pkg/proto/device/v1"
+	inboundgrpc "github.com/architeacher/devices/services/svc-devices/internal/adapters/inbound/grpc"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMaxMessageSizeInterceptor(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name          string
+		maxBytes      int
+		request       any
+		expectedCode  codes.Code
+		expectHandler bool
+		expectMetric  int64
+	}{
+		{
+			name:     "rejects request above the limit",
+			maxBytes: 64,
+			request: &devicev1.CreateDeviceRequest{
+				Name:  strings.Repeat("a", 1024),
+				Brand: "Apple",
+			},
+			expectedCode:  codes.ResourceExhausted,
+			expectHandler: false,
+			expectMetric:  1,
+		},
+		{
+			name:     "accepts request within the limit",
+			maxBytes: 64,
+			request: &devicev1.CreateDeviceRequest{
+				Name:  "iPhone",
+				Brand: "Apple",
+			},
+			expectedCode:  codes.OK,
+			expectHandler: true,
+		},
+		{
+			name:     "zero limit disables the check",
+			maxBytes: 0,
+			request: &devicev1.CreateDeviceRequest{
+				Name: strings.Repeat("a", 1024),
+			},
+			expectedCode:  codes.OK,
+			expectHandler: true,
+		},
+		{
+			name:          "passes through non proto requests",
+			maxBytes:      1,
+			request:       strings.Repeat("a", 1024),
+			expectedCode:  codes.OK,
+			expectHandler: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := recording.NewMetricsClient()
+			interceptor := inboundgrpc.MaxMessageSizeInterceptor(tc.maxBytes, mc)
+
+			handlerCalled := false
+			mockHandler := func(ctx context.Context, req any) (any, error) {
+				handlerCalled = true
+
+				return "response", nil
+			}
+
+			info := &grpc.UnaryServerInfo{FullMethod: "/device.v1.DeviceService/CreateDevice"}
+			_, err := interceptor(t.Context(), tc.request, info, mockHandler)
+
+			require.Equal(t, tc.expectedCode, status.Code(err))
+			require.Equal(t, tc.expectHandler, handlerCalled)
+			require.Equal(t, float64(tc.expectMetric), mc.Sum("grpc_request_size_exceeded_total"))
+
+			if tc.expectedCode == codes.ResourceExhausted {
+				require.Equal(t, "request too large", status.Convert(err).Message())
+			}
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	unknownPeer = "unknown"
+
+	// peerLimiterSweepInterval is how often idle peer limiters are dropped.
+	peerLimiterSweepInterval = time.Minute
+)
+
+type (
+	// peerLimiters hands out one token bucket per peer IP. Buckets of peers with no
+	// open stream are dropped once they have refilled, since a full bucket behaves
+	// like a fresh one, so the set only holds peers that are active or were recently.
+	peerLimiters struct {
+		mu        sync.Mutex
+		limiters  map[string]*peerLimiter
+		limit     rate.Limit
+		burst     int
+		now       func() time.Time
+		lastSweep time.Time
+	}
+
+	peerLimiter struct {
+		limiter *rate.Limiter
+		streams int
+	}
+)
+
+func newPeerLimiters(limit rate.Limit, burst int) *peerLimiters {
+	return &peerLimiters{
+		limiters:  make(map[string]*peerLimiter),
+		limit:     limit,
+		burst:     burst,
+		now:       time.Now,
+		lastSweep: time.Now(),
+	}
+}
+
+// acquire returns the peer's limiter and counts a stream against it until release.
+func (p *peerLimiters) acquire(key string) *rate.Limiter {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if now := p.now(); now.Sub(p.lastSweep) >= peerLimiterSweepInterval {
+		p.sweep(now)
+	}
+
+	entry, ok := p.limiters[key]
+	if !ok {
+		entry = &peerLimiter{limiter: rate.NewLimiter(p.limit, p.burst)}
+		p.limiters[key] = entry
+	}
+
+	entry.streams++
+
+	return entry.limiter
+}
+
+func (p *peerLimiters) release(key string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if entry, ok := p.limiters[key]; ok {
+		entry.streams--
+	}
+}
+
+func (p *peerLimiters) sweep(now time.Time) {
+	p.lastSweep = now
+
+	for key, entry := range p.limiters {
+		if entry.streams == 0 && entry.limiter.TokensAt(now) >= float64(p.burst) {
+			delete(p.limiters, key)
+		}
+	}
+}
+
+// StreamRateLimitInterceptor throttles the messages a server stream sends to each
+// peer IP to rps messages per second, allowing bursts of up to burst messages.
+// Sends block until a token is available so the stream is slowed rather than
+// aborted; the stream only fails when its context ends while waiting.
+func StreamRateLimitInterceptor(rps uint, burst uint) grpc.StreamServerInterceptor {
+	limiters := newPeerLimiters(rate.Limit(rps), int(max(burst, 1)))
+
+	return func(
+		srv any,
+		ss grpc.ServerStream,
+		_ *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		key := peerIP(ss.Context())
+
+		limiter := limiters.acquire(key)
+		defer limiters.release(key)
+
+		return handler(srv, &rateLimitedServerStream{ServerStream: ss, limiter: limiter})
+	}
+}
+
+// rateLimitedServerStream consumes one token from the peer's limiter per sent message.
+type rateLimitedServerStream struct {
+	grpc.ServerStream
+	limiter *rate.Limiter
+}
+
+func (s *rateLimitedServerStream) SendMsg(m any) error {
+	ctx := s.Context()
+
+	if err := s.limiter.WaitN(ctx, 1); err != nil {
+		if errors.Is(ctx.Err(), context.Canceled) {
+			return status.Error(codes.Canceled, "stream canceled while waiting for rate limit")
+		}
+
+		return status.Error(codes.ResourceExhausted, "stream rate limit exceeded before deadline")
+	}
+
+	return s.ServerStream.SendMsg(m)
+}
+
+// peerIP returns the IP of the remote peer stored in the context, without the port.
+func peerIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return unknownPeer
+	}
+
+	addr := p.Addr.String()
+
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return addr
+	}
+
+	return host
+}
@@ -0,0 +1,41 @@
+package grpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+)
+
+func TestPeerLimiters_DropsIdlePeers(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	limiters := newPeerLimiters(rate.Limit(1), 2)
+	limiters.now = func() time.Time { return now }
+	limiters.lastSweep = now
+
+	idle := limiters.acquire("10.0.0.1")
+	require.True(t, idle.AllowN(now, 2))
+	limiters.release("10.0.0.1")
+
+	streaming := limiters.acquire("10.0.0.2")
+	require.True(t, streaming.AllowN(now, 2))
+
+	refilling := limiters.acquire("10.0.0.3")
+	limiters.release("10.0.0.3")
+
+	// The sweep runs on the first acquire once the interval has passed.
+	now = now.Add(peerLimiterSweepInterval)
+	require.True(t, refilling.AllowN(now, 2))
+
+	limiters.acquire("10.0.0.4")
+
+	require.NotContains(t, limiters.limiters, "10.0.0.1", "idle peer with a full bucket should be dropped")
+	require.Contains(t, limiters.limiters, "10.0.0.2", "peer with an open stream should be kept")
+	require.Contains(t, limiters.limiters, "10.0.0.3", "peer whose bucket has not refilled should be kept")
+	require.Contains(t, limiters.limiters, "10.0.0.4")
+	require.Same(t, streaming, limiters.acquire("10.0.0.2"))
+}
@@ -0,0 +1,124 @@
+package grpc_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	inboundgrpc "github.com/architeacher/devices/services/svc-devices/internal/adapters/inbound/grpc"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func peerContext(ctx context.Context, ip string) context.Context {
+	return peer.NewContext(ctx, &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 50051},
+	})
+}
+
+// sendMessages runs a server-streaming handler that sends count messages through the interceptor.
+func sendMessages(ctx context.Context, interceptor grpc.StreamServerInterceptor, count int) (*mockServerStream, error) {
+	stream := &mockServerStream{ctx: ctx}
+
+	handler := func(_ any, ss grpc.ServerStream) error {
+		for range count {
+			if err := ss.SendMsg("update"); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	info := &grpc.StreamServerInfo{FullMethod: "/device.v1.DeviceService/StreamDevices", IsServerStream: true}
+
+	return stream, interceptor(nil, stream, info, handler)
+}
+
+func TestStreamRateLimitInterceptor_SlowsFastStreams(t *testing.T) {
+	t.Parallel()
+
+	interceptor := inboundgrpc.StreamRateLimitInterceptor(20, 1)
+	ctx := peerContext(t.Context(), "203.0.113.10")
+
+	start := time.Now()
+	stream, err := sendMessages(ctx, interceptor, 6)
+	elapsed := time.Since(start)
+
+	require.NoError(t, err)
+	require.Len(t, stream.sent, 6)
+	// The first message uses the burst token; the remaining five wait 50ms each
+	require.GreaterOrEqual(t, elapsed, 200*time.Millisecond)
+	require.Less(t, elapsed, time.Second)
+}
+
+func TestStreamRateLimitInterceptor_IndependentPeers(t *testing.T) {
+	t.Parallel()
+
+	interceptor := inboundgrpc.StreamRateLimitInterceptor(1, 3)
+
+	_, err := sendMessages(peerContext(t.Context(), "203.0.113.10"), interceptor, 3)
+	require.NoError(t, err)
+
+	// A different peer still has its full burst available
+	start := time.Now()
+	stream, err := sendMessages(peerContext(t.Context(), "203.0.113.20"), interceptor, 3)
+	require.NoError(t, err)
+	require.Len(t, stream.sent, 3)
+	require.Less(t, time.Since(start), 100*time.Millisecond)
+
+	// The exhausted peer has to wait for a new token
+	ctx, cancel := context.WithTimeout(peerContext(t.Context(), "203.0.113.10"), 50*time.Millisecond)
+	defer cancel()
+
+	_, err = sendMessages(ctx, interceptor, 1)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestStreamRateLimitInterceptor_ContextErrors(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name         string
+		withContext  func(context.Context) (context.Context, context.CancelFunc)
+		expectedCode codes.Code
+	}{
+		{
+			name: "deadline exceeded while waiting",
+			withContext: func(ctx context.Context) (context.Context, context.CancelFunc) {
+				return context.WithTimeout(ctx, 100*time.Millisecond)
+			},
+			expectedCode: codes.ResourceExhausted,
+		},
+		{
+			name: "canceled while waiting",
+			withContext: func(ctx context.Context) (context.Context, context.CancelFunc) {
+				ctx, cancel := context.WithCancel(ctx)
+				time.AfterFunc(50*time.Millisecond, cancel)
+
+				return ctx, cancel
+			},
+			expectedCode: codes.Canceled,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			interceptor := inboundgrpc.StreamRateLimitInterceptor(1, 1)
+
+			ctx, cancel := tc.withContext(peerContext(t.Context(), "203.0.113.30"))
+			defer cancel()
+
+			stream, err := sendMessages(ctx, interceptor, 2)
+			require.Error(t, err)
+			require.Equal(t, tc.expectedCode, status.Code(err))
+			require.Len(t, stream.sent, 1)
+		})
+	}
+}
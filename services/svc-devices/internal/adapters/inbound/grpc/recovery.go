@@ -0,0 +1,70 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+
+	"github.com/architeacher/devices/pkg/logger"
+	"github.com/architeacher/devices/pkg/metrics"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	grpcPanicsTotal = "grpc_panics_total"
+	grpcMethodKey   = "grpc.method"
+)
+
+// RecoveryInterceptor recovers from panics raised by unary handlers, logs the panic
+// with its stack trace and answers with codes.Internal without leaking the panic value.
+func RecoveryInterceptor(log logger.Logger, mc metrics.Client) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (resp any, err error) {
+		defer func() {
+			if rvr := recover(); rvr != nil {
+				err = recoverPanic(ctx, log, mc, info.FullMethod, rvr)
+			}
+		}()
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamRecoveryInterceptor is the streaming counterpart of RecoveryInterceptor.
+func StreamRecoveryInterceptor(log logger.Logger, mc metrics.Client) grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) (err error) {
+		defer func() {
+			if rvr := recover(); rvr != nil {
+				err = recoverPanic(ss.Context(), log, mc, info.FullMethod, rvr)
+			}
+		}()
+
+		return handler(srv, ss)
+	}
+}
+
+func recoverPanic(ctx context.Context, log logger.Logger, mc metrics.Client, fullMethod string, rvr any) error {
+	log.Error().
+		Str("panic_value", fmt.Sprintf("%v", rvr)).
+		Str("stack_trace", string(debug.Stack())).
+		Str("method", fullMethod).
+		Msg("gRPC handler panic recovered")
+
+	if mc != nil {
+		mc.Inc(ctx, grpcPanicsTotal, int64(1), attribute.String(grpcMethodKey, fullMethod))
+	}
+
+	return status.Error(codes.Internal, "internal error")
+}
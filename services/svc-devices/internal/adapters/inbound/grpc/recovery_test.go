@@ -0,0 +1,117 @@
+package grpc_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/architeacher/devices/pkg/logger"
+	"github.com/architeacher/devices/pkg/metrics/recording"
+	inboundgrpc "github.com/architeacher/devices/services/svc-devices/internal/adapters/inbound/grpc"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRecoveryInterceptor(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name       string
+		panicValue any
+		expected   string
+	}{
+		{
+			name:       "string panic",
+			panicValue: "device cache corrupted",
+			expected:   "device cache corrupted",
+		},
+		{
+			name:       "error panic",
+			panicValue: context.DeadlineExceeded,
+			expected:   context.DeadlineExceeded.Error(),
+		},
+		{
+			name:       "arbitrary value panic",
+			panicValue: 42,
+			expected:   "42",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			mc := recording.NewMetricsClient()
+
+			interceptor := inboundgrpc.RecoveryInterceptor(logger.NewWithWriter("info", "json", &buf), mc)
+
+			handler := func(context.Context, any) (any, error) {
+				panic(tc.panicValue)
+			}
+
+			info := &grpc.UnaryServerInfo{FullMethod: "/device.v1.DeviceService/GetDevice"}
+			resp, err := interceptor(t.Context(), nil, info, handler)
+
+			require.Nil(t, resp)
+			require.Equal(t, codes.Internal, status.Code(err))
+			require.Equal(t, "internal error", status.Convert(err).Message())
+			require.NotContains(t, err.Error(), tc.expected)
+
+			require.Equal(t, float64(1), mc.Sum("grpc_panics_total"))
+			require.True(t, mc.HasAttribute("grpc_panics_total", "grpc.method", info.FullMethod))
+
+			logOutput := buf.String()
+			require.Contains(t, logOutput, `"level":"error"`)
+			require.Contains(t, logOutput, `"panic_value":"`+tc.expected+`"`)
+			require.Contains(t, logOutput, "stack_trace")
+			require.Contains(t, logOutput, info.FullMethod)
+		})
+	}
+}
+
+func TestRecoveryInterceptor_PassesThroughWithoutPanic(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	mc := recording.NewMetricsClient()
+
+	interceptor := inboundgrpc.RecoveryInterceptor(logger.NewWithWriter("info", "json", &buf), mc)
+
+	handler := func(context.Context, any) (any, error) {
+		return "response", nil
+	}
+
+	resp, err := interceptor(t.Context(), nil, &grpc.UnaryServerInfo{FullMethod: "/device.v1.DeviceService/GetDevice"}, handler)
+	require.NoError(t, err)
+	require.Equal(t, "response", resp)
+	require.Zero(t, mc.Sum("grpc_panics_total"))
+	require.Empty(t, buf.String())
+}
+
+func TestStreamRecoveryInterceptor(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	mc := recording.NewMetricsClient()
+
+	interceptor := inboundgrpc.StreamRecoveryInterceptor(logger.NewWithWriter("info", "json", &buf), mc)
+
+	handler := func(any, grpc.ServerStream) error {
+		panic("stream exploded")
+	}
+
+	info := &grpc.StreamServerInfo{FullMethod: "/device.v1.DeviceService/StreamDevices", IsServerStream: true}
+	err := interceptor(nil, &mockServerStream{ctx: t.Context()}, info, handler)
+
+	require.Equal(t, codes.Internal, status.Code(err))
+	require.NotContains(t, err.Error(), "stream exploded")
+	require.Equal(t, float64(1), mc.Sum("grpc_panics_total"))
+
+	logOutput := buf.String()
+	require.Contains(t, logOutput, `"panic_value":"stream exploded"`)
+	require.Contains(t, logOutput, "stack_trace")
+	require.Contains(t, logOutput, info.FullMethod)
+}
@@ -0,0 +1,104 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/architeacher/devices/pkg/logger"
+	"github.com/architeacher/devices/services/svc-devices/internal/config"
+	"github.com/architeacher/devices/services/svc-devices/internal/domain/model"
+	"github.com/architeacher/devices/services/svc-devices/internal/usecases/commands"
+)
+
+// DeviceRecoveryActor identifies the job as the actor of the releases it performs.
+const DeviceRecoveryActor = "system:device-recovery"
+
+// DeviceRecoveryJob releases devices that stayed in use for longer than RecoveryTimeout,
+// which happens when the application holding them crashes without releasing them.
+type DeviceRecoveryJob struct {
+	// RecoveryTimeout is how long a device may stay in use without being updated.
+	RecoveryTimeout time.Duration
+	// BatchSize caps the devices released per statement. Zero releases them all at once.
+	BatchSize uint
+	// Interval is the pause between two runs started by Start.
+	Interval time.Duration
+
+	handler commands.RecoverStaleDevicesCommandHandler
+	logger  logger.Logger
+}
+
+func NewDeviceRecoveryJob(
+	handler commands.RecoverStaleDevicesCommandHandler,
+	cfg config.DeviceRecovery,
+	log logger.Logger,
+) *DeviceRecoveryJob {
+	return &DeviceRecoveryJob{
+		RecoveryTimeout: cfg.RecoveryTimeout,
+		BatchSize:       cfg.BatchSize,
+		Interval:        cfg.Interval,
+		handler:         handler,
+		logger:          log,
+	}
+}
+
+// Run releases the stale devices batch by batch, until a batch comes back short.
+func (j *DeviceRecoveryJob) Run(ctx context.Context) error {
+	ctx = model.WithActor(ctx, DeviceRecoveryActor)
+
+	for ctx.Err() == nil {
+		devices, err := j.handler.Handle(ctx, commands.RecoverStaleDevicesCommand{
+			IdleFor:   j.RecoveryTimeout,
+			BatchSize: j.BatchSize,
+		})
+		if err != nil {
+			return fmt.Errorf("recovering stale devices: %w", err)
+		}
+
+		if len(devices) > 0 {
+			ids := make([]string, 0, len(devices))
+			for _, device := range devices {
+				ids = append(ids, device.ID.String())
+			}
+
+			j.logger.Info().
+				Strs("device_ids", ids).
+				Dur("recovery_timeout", j.RecoveryTimeout).
+				Msg("recovered stale in-use devices")
+		}
+
+		if j.BatchSize == 0 || uint(len(devices)) < j.BatchSize {
+			return nil
+		}
+	}
+
+	return ctx.Err()
+}
+
+// Start runs the job every Interval until ctx is done. A failed run is logged and
+// retried on the next tick.
+func (j *DeviceRecoveryJob) Start(ctx context.Context) {
+	if j.Interval <= 0 {
+		j.logger.Warn().
+			Dur("interval", j.Interval).
+			Msg("device recovery disabled, the interval must be positive")
+
+		return
+	}
+
+	ticker := time.NewTicker(j.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := j.Run(ctx); err != nil && ctx.Err() == nil {
+				j.logger.Error().
+					Err(err).
+					Msg("device recovery failed")
+			}
+		}
+	}
+}
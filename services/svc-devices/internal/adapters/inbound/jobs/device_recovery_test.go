@@ -0,0 +1,225 @@
+package jobs_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/architeacher/devices/pkg/logger"
+	"github.com/architeacher/devices/services/svc-devices/internal/adapters/inbound/jobs"
+	"github.com/architeacher/devices/services/svc-devices/internal/config"
+	"github.com/architeacher/devices/services/svc-devices/internal/domain/model"
+	"github.com/architeacher/devices/services/svc-devices/internal/usecases/commands"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRecoverHandler answers each call with the next batch and records the commands.
+type fakeRecoverHandler struct {
+	mu      sync.Mutex
+	batches [][]*model.Device
+	err     error
+	calls   []commands.RecoverStaleDevicesCommand
+}
+
+func (h *fakeRecoverHandler) Handle(_ context.Context, cmd commands.RecoverStaleDevicesCommand) ([]*model.Device, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.calls = append(h.calls, cmd)
+	if h.err != nil {
+		return nil, h.err
+	}
+
+	if len(h.batches) == 0 {
+		return nil, nil
+	}
+
+	batch := h.batches[0]
+	h.batches = h.batches[1:]
+
+	return batch, nil
+}
+
+func (h *fakeRecoverHandler) callCount() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return len(h.calls)
+}
+
+func newDevices(count int) []*model.Device {
+	devices := make([]*model.Device, 0, count)
+	for range count {
+		devices = append(devices, model.NewDevice("iPhone", "Apple", model.StateAvailable))
+	}
+
+	return devices
+}
+
+func TestDeviceRecoveryJob_Run(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name          string
+		batchSize     uint
+		batches       [][]*model.Device
+		handlerErr    error
+		expectedCalls int
+		expectedLogs  int
+	}{
+		{
+			name:          "nothing to recover",
+			batchSize:     2,
+			expectedCalls: 1,
+		},
+		{
+			name:          "stops after a short batch",
+			batchSize:     2,
+			batches:       [][]*model.Device{newDevices(2), newDevices(1), newDevices(2)},
+			expectedCalls: 2,
+			expectedLogs:  2,
+		},
+		{
+			name:          "a full batch is followed by an empty one",
+			batchSize:     2,
+			batches:       [][]*model.Device{newDevices(2)},
+			expectedCalls: 2,
+			expectedLogs:  1,
+		},
+		{
+			name:          "zero batch size recovers in one statement",
+			batches:       [][]*model.Device{newDevices(3), newDevices(3)},
+			expectedCalls: 1,
+			expectedLogs:  1,
+		},
+		{
+			name:          "handler error",
+			batchSize:     2,
+			handlerErr:    model.ErrDatabaseQuery,
+			expectedCalls: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			logBuffer := &bytes.Buffer{}
+			handler := &fakeRecoverHandler{batches: tc.batches, err: tc.handlerErr}
+
+			job := jobs.NewDeviceRecoveryJob(handler, config.DeviceRecovery{
+				RecoveryTimeout: 30 * time.Minute,
+				BatchSize:       tc.batchSize,
+			}, logger.NewBufferedTestLogger(logBuffer))
+
+			var firstBatch []*model.Device
+			if len(tc.batches) > 0 {
+				firstBatch = tc.batches[0]
+			}
+
+			err := job.Run(t.Context())
+
+			require.Equal(t, tc.expectedCalls, handler.callCount())
+			for _, cmd := range handler.calls {
+				require.Equal(t, 30*time.Minute, cmd.IdleFor)
+				require.Equal(t, tc.batchSize, cmd.BatchSize)
+			}
+
+			if tc.handlerErr != nil {
+				require.ErrorIs(t, err, tc.handlerErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedLogs, bytes.Count(logBuffer.Bytes(), []byte("recovered stale in-use devices")))
+
+			for _, device := range firstBatch {
+				require.Contains(t, logBuffer.String(), device.ID.String(), "the recovered IDs are logged")
+			}
+		})
+	}
+}
+
+func TestDeviceRecoveryJob_Start(t *testing.T) {
+	t.Parallel()
+
+	t.Run("runs on every tick until cancelled", func(t *testing.T) {
+		t.Parallel()
+
+		handler := &fakeRecoverHandler{}
+		job := jobs.NewDeviceRecoveryJob(handler, config.DeviceRecovery{
+			RecoveryTimeout: time.Minute,
+			BatchSize:       10,
+			Interval:        5 * time.Millisecond,
+		}, logger.NewTestLogger())
+
+		ctx, cancel := context.WithCancel(t.Context())
+		done := make(chan struct{})
+
+		go func() {
+			defer close(done)
+			job.Start(ctx)
+		}()
+
+		require.Eventually(t, func() bool { return handler.callCount() >= 2 }, time.Second, time.Millisecond)
+
+		cancel()
+		<-done
+	})
+
+	t.Run("keeps running after a failed run", func(t *testing.T) {
+		t.Parallel()
+
+		logBuffer := &bytes.Buffer{}
+		var logMu sync.Mutex
+
+		handler := &fakeRecoverHandler{err: errors.New("connection refused")}
+		job := jobs.NewDeviceRecoveryJob(handler, config.DeviceRecovery{
+			Interval: 5 * time.Millisecond,
+		}, logger.NewBufferedTestLogger(&lockedWriter{mu: &logMu, w: logBuffer}))
+
+		ctx, cancel := context.WithCancel(t.Context())
+		done := make(chan struct{})
+
+		go func() {
+			defer close(done)
+			job.Start(ctx)
+		}()
+
+		require.Eventually(t, func() bool { return handler.callCount() >= 2 }, time.Second, time.Millisecond)
+
+		cancel()
+		<-done
+
+		logMu.Lock()
+		defer logMu.Unlock()
+		require.Contains(t, logBuffer.String(), "device recovery failed")
+	})
+
+	t.Run("non-positive interval returns immediately", func(t *testing.T) {
+		t.Parallel()
+
+		handler := &fakeRecoverHandler{}
+		job := jobs.NewDeviceRecoveryJob(handler, config.DeviceRecovery{}, logger.NewTestLogger())
+
+		job.Start(t.Context())
+
+		require.Zero(t, handler.callCount())
+	})
+}
+
+type lockedWriter struct {
+	mu *sync.Mutex
+	w  *bytes.Buffer
+}
+
+func (w *lockedWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.w.Write(p)
+}
@@ -0,0 +1,84 @@
+package repos
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/architeacher/devices/services/svc-devices/internal/domain/model"
+)
+
+const auditLogTable = "device_audit_log"
+
+type (
+	// PostgresAuditLogger appends audit entries to the device_audit_log table, which
+	// rejects updates and deletes.
+	PostgresAuditLogger struct {
+		pool PoolOps
+	}
+
+	// deviceSnapshot is the JSON shape of a device stored in the audit log.
+	deviceSnapshot struct {
+		ID        string         `json:"id"`
+		Name      string         `json:"name"`
+		Brand     string         `json:"brand"`
+		State     string         `json:"state"`
+		CreatedAt time.Time      `json:"createdAt"`
+		UpdatedAt time.Time      `json:"updatedAt"`
+		Metadata  map[string]any `json:"metadata,omitempty"`
+	}
+)
+
+func NewPostgresAuditLogger(pool PoolOps) *PostgresAuditLogger {
+	return &PostgresAuditLogger{pool: pool}
+}
+
+func (l *PostgresAuditLogger) Log(ctx context.Context, entry model.AuditEntry) error {
+	before, err := marshalSnapshot(entry.Before)
+	if err != nil {
+		return fmt.Errorf("failed to encode the before snapshot: %w", err)
+	}
+
+	after, err := marshalSnapshot(entry.After)
+	if err != nil {
+		return fmt.Errorf("failed to encode the after snapshot: %w", err)
+	}
+
+	var actor *string
+	if entry.Actor != "" {
+		actor = &entry.Actor
+	}
+
+	query, args, err := psql.Insert(auditLogTable).
+		Columns("occurred_at", "operation", "device_id", "actor", "before", "after").
+		Values(entry.Timestamp, entry.Operation, entry.DeviceID.String(), actor, before, after).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build insert query: %w", err)
+	}
+
+	if _, err := l.pool.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("%w: %v", model.ErrDatabaseQuery, err)
+	}
+
+	return nil
+}
+
+// marshalSnapshot encodes the device as JSON, or returns nil for a missing device so
+// the column is stored as NULL.
+func marshalSnapshot(device *model.Device) ([]byte, error) {
+	if device == nil {
+		return nil, nil
+	}
+
+	return json.Marshal(deviceSnapshot{
+		ID:        device.ID.String(),
+		Name:      device.Name,
+		Brand:     device.Brand,
+		State:     device.State.String(),
+		CreatedAt: device.CreatedAt,
+		UpdatedAt: device.UpdatedAt,
+		Metadata:  device.Metadata,
+	})
+}
@@ -0,0 +1,102 @@
+package repos_test
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/architeacher/devices/services/svc-devices/internal/adapters/repos"
+	"github.com/architeacher/devices/services/svc-devices/internal/domain/model"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresAuditLogger_Log(t *testing.T) {
+	t.Parallel()
+
+	query := regexp.QuoteMeta(
+		`INSERT INTO device_audit_log (occurred_at,operation,device_id,actor,before,after) VALUES ($1,$2,$3,$4,$5,$6)`,
+	)
+
+	createdAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	device := &model.Device{
+		ID:        model.NewDeviceID(),
+		Name:      "iPhone",
+		Brand:     "Apple",
+		State:     model.StateAvailable,
+		CreatedAt: createdAt,
+		UpdatedAt: createdAt,
+	}
+	snapshot := []byte(`{"id":"` + device.ID.String() + `","name":"iPhone","brand":"Apple","state":"available",` +
+		`"createdAt":"2026-01-02T03:04:05Z","updatedAt":"2026-01-02T03:04:05Z"}`)
+
+	actor := "user-42"
+
+	cases := []struct {
+		name         string
+		entry        model.AuditEntry
+		expectedArgs []any
+		execErr      error
+	}{
+		{
+			name: "create stores a null before snapshot",
+			entry: model.AuditEntry{
+				Timestamp: createdAt,
+				Operation: model.AuditOperationCreate,
+				DeviceID:  device.ID,
+				Actor:     actor,
+				After:     device,
+			},
+			expectedArgs: []any{createdAt, "create", device.ID.String(), &actor, []byte(nil), snapshot},
+		},
+		{
+			name: "delete without an actor stores nulls",
+			entry: model.AuditEntry{
+				Timestamp: createdAt,
+				Operation: model.AuditOperationDelete,
+				DeviceID:  device.ID,
+				Before:    device,
+			},
+			expectedArgs: []any{createdAt, "delete", device.ID.String(), (*string)(nil), snapshot, []byte(nil)},
+		},
+		{
+			name: "database error returns wrapped ErrDatabaseQuery",
+			entry: model.AuditEntry{
+				Timestamp: createdAt,
+				Operation: model.AuditOperationCreate,
+				DeviceID:  device.ID,
+				After:     device,
+			},
+			expectedArgs: []any{createdAt, "create", device.ID.String(), (*string)(nil), []byte(nil), snapshot},
+			execErr:      errors.New("connection error"),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			expectation := mock.ExpectExec(query).WithArgs(tc.expectedArgs...)
+			if tc.execErr != nil {
+				expectation.WillReturnError(tc.execErr)
+			} else {
+				expectation.WillReturnResult(pgxmock.NewResult("INSERT", 1))
+			}
+
+			err = repos.NewPostgresAuditLogger(mock).Log(t.Context(), tc.entry)
+
+			if tc.execErr != nil {
+				require.ErrorIs(t, err, model.ErrDatabaseQuery)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,178 @@
+package repos
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/architeacher/devices/services/svc-devices/internal/domain/model"
+	"github.com/google/uuid"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const deviceHistoryTable = "device_history"
+
+type deviceHistoryRow struct {
+	EventID   string    `db:"event_id"`
+	DeviceID  string    `db:"device_id"`
+	Name      string    `db:"name"`
+	Brand     string    `db:"brand"`
+	State     string    `db:"state"`
+	Operation string    `db:"operation"`
+	Version   int       `db:"version"`
+	ChangedAt time.Time `db:"changed_at"`
+	ChangedBy *string   `db:"changed_by"`
+}
+
+// insertHistory stores a snapshot of each device as its next version, attributed to the
+// actor in ctx. It runs in the transaction that changed the devices, so a snapshot is
+// stored if and only if its change is. That change holds the device row lock until it
+// commits, so concurrent changes of a device number their snapshots one after another.
+// Every row of the statement reads the versions stored before it, so a device listed
+// more than once takes consecutive versions in the order it is listed.
+func (r *DevicesRepository) insertHistory(
+	ctx context.Context,
+	exec execer,
+	operation model.HistoryOperation,
+	devices ...*model.Device,
+) (err error) {
+	ctx, span := r.startHistorySpan(ctx, "record_history", "INSERT")
+	defer func() { endSpan(span, err) }()
+
+	var actor *string
+	if changedBy := model.ActorFromContext(ctx); changedBy != "" {
+		actor = &changedBy
+	}
+
+	changedAt := time.Now().UTC()
+
+	insert := psql.Insert(deviceHistoryTable).
+		Columns("event_id", "device_id", "name", "brand", "state", "operation", "version", "changed_at", "changed_by")
+
+	// occurrences counts the snapshots of each device already added to the statement.
+	occurrences := make(map[model.DeviceID]int, len(devices))
+
+	for _, device := range devices {
+		occurrences[device.ID]++
+
+		insert = insert.Values(
+			uuid.Must(uuid.NewV7()).String(),
+			device.ID.String(),
+			device.Name,
+			device.Brand,
+			device.State.String(),
+			operation.String(),
+			sq.Expr(
+				"(SELECT COALESCE(MAX(version), 0) + ? FROM "+deviceHistoryTable+" WHERE device_id = ?)",
+				occurrences[device.ID],
+				device.ID.String(),
+			),
+			changedAt,
+			actor,
+		)
+	}
+
+	query, args, err := insert.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build history insert query: %w", err)
+	}
+
+	recordStatement(ctx, query)
+
+	if _, err := exec.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("%w: %v", model.ErrDatabaseQuery, err)
+	}
+
+	return nil
+}
+
+// GetHistory returns the snapshots of the device, oldest version first. Snapshots outlive
+// the device, so a deleted device still has its history; an unknown one has none.
+func (r *DevicesRepository) GetHistory(ctx context.Context, deviceID model.DeviceID) (_ []*model.DeviceHistoryEntry, err error) {
+	ctx, span := r.startHistorySpan(ctx, "get_history", "SELECT")
+	defer func() { endSpan(span, err) }()
+
+	query, args, err := psql.Select("event_id", "device_id", "name", "brand", "state", "operation", "version", "changed_at", "changed_by").
+		From(deviceHistoryTable).
+		Where(sq.Eq{"device_id": deviceID.String()}).
+		OrderBy("version ASC").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	recordStatement(ctx, query)
+
+	rows, err := r.reader().Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", model.ErrDatabaseQuery, err)
+	}
+	defer rows.Close()
+
+	var historyRows []deviceHistoryRow
+	if err := r.scanner.ScanAll(&historyRows, rows); err != nil {
+		return nil, fmt.Errorf("%w: %v", model.ErrDatabaseQuery, err)
+	}
+
+	entries := make([]*model.DeviceHistoryEntry, 0, len(historyRows))
+	for index := range historyRows {
+		entry, err := convertRowToHistoryEntry(historyRows[index])
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", model.ErrDatabaseQuery, err)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// startHistorySpan starts the span of a history operation, recorded against the
+// device_history table.
+func (r *DevicesRepository) startHistorySpan(ctx context.Context, operation, sqlOperation string) (context.Context, trace.Span) {
+	ctx, span := r.startSpan(ctx, operation, sqlOperation)
+	span.SetAttributes(semconv.DBSQLTable(deviceHistoryTable))
+
+	return ctx, span
+}
+
+func convertRowToHistoryEntry(row deviceHistoryRow) (*model.DeviceHistoryEntry, error) {
+	eventID, err := uuid.Parse(row.EventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse history event ID: %w", err)
+	}
+
+	deviceID, err := model.ParseDeviceID(row.DeviceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse device ID: %w", err)
+	}
+
+	state, err := model.ParseState(row.State)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse device state: %w", err)
+	}
+
+	operation, err := model.ParseHistoryOperation(row.Operation)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse history operation: %w", err)
+	}
+
+	entry := &model.DeviceHistoryEntry{
+		EventID:   eventID,
+		DeviceID:  deviceID,
+		Name:      row.Name,
+		Brand:     row.Brand,
+		State:     state,
+		Operation: operation,
+		Version:   row.Version,
+		ChangedAt: row.ChangedAt,
+	}
+
+	if row.ChangedBy != nil {
+		entry.ChangedBy = *row.ChangedBy
+	}
+
+	return entry, nil
+}
@@ -0,0 +1,225 @@
+package repos_test
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/architeacher/devices/services/svc-devices/internal/adapters/repos"
+	"github.com/architeacher/devices/services/svc-devices/internal/domain/model"
+	"github.com/google/uuid"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/require"
+)
+
+// historyInsertQuery is the statement storing the snapshots of count devices.
+func historyInsertQuery(count int) string {
+	values := make([]string, count)
+	for index := range values {
+		offset := index * 10
+		values[index] = fmt.Sprintf(
+			"($%d,$%d,$%d,$%d,$%d,$%d,(SELECT COALESCE(MAX(version), 0) + $%d FROM device_history WHERE device_id = $%d),$%d,$%d)",
+			offset+1, offset+2, offset+3, offset+4, offset+5, offset+6, offset+7, offset+8, offset+9, offset+10,
+		)
+	}
+
+	return `INSERT INTO device_history (event_id,device_id,name,brand,state,operation,version,changed_at,changed_by) VALUES ` +
+		strings.Join(values, ",")
+}
+
+// expectHistoryInsert expects the snapshots of devices to be stored as operation, changed
+// by changedBy, which is nil for an unknown caller.
+func expectHistoryInsert(
+	mock pgxmock.PgxPoolIface,
+	operation model.HistoryOperation,
+	changedBy *string,
+	devices ...*model.Device,
+) *pgxmock.ExpectedExec {
+	occurrences := make(map[model.DeviceID]int, len(devices))
+
+	args := make([]any, 0, 10*len(devices))
+	for _, device := range devices {
+		occurrences[device.ID]++
+
+		args = append(args,
+			pgxmock.AnyArg(),
+			device.ID.String(),
+			device.Name,
+			device.Brand,
+			device.State.String(),
+			operation.String(),
+			occurrences[device.ID],
+			device.ID.String(),
+			pgxmock.AnyArg(),
+			changedBy,
+		)
+	}
+
+	return mock.ExpectExec(regexp.QuoteMeta(historyInsertQuery(len(devices)))).WithArgs(args...)
+}
+
+func TestDevicesRepository_UpdateRecordsHistory(t *testing.T) {
+	t.Parallel()
+
+	updateQuery := `UPDATE devices SET name = $1, brand = $2, state = $3, updated_at = $4, metadata = $5 WHERE id = $6`
+	actor := "user-42"
+
+	cases := []struct {
+		name        string
+		changedBy   string
+		expectedBy  *string
+		execErr     error
+		expectError bool
+	}{
+		{
+			name:       "stores the snapshot as the next version in the same transaction",
+			changedBy:  actor,
+			expectedBy: &actor,
+		},
+		{
+			name: "unknown caller is stored as null",
+		},
+		{
+			name:        "failed snapshot rolls the update back",
+			changedBy:   actor,
+			expectedBy:  &actor,
+			execErr:     errors.New("duplicate key value violates unique constraint"),
+			expectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		device := model.NewDevice("iPhone", "Apple", model.StateInUse)
+
+		t.Run(tc.name, func(t *testing.T) {
+			runRepoTest(t, func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(updateQuery)).
+					WithArgs(device.Name, device.Brand, device.State.String(), device.UpdatedAt, map[string]any{}, device.ID.String()).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+				insert := expectHistoryInsert(mock, model.HistoryOperationUpdate, tc.expectedBy, device)
+				if tc.execErr != nil {
+					insert.WillReturnError(tc.execErr)
+					mock.ExpectRollback()
+
+					return
+				}
+
+				insert.WillReturnResult(pgxmock.NewResult("INSERT", 1))
+				mock.ExpectCommit()
+			}, func(t *testing.T, repo *repos.DevicesRepository) {
+				ctx := t.Context()
+				if tc.changedBy != "" {
+					ctx = model.WithActor(ctx, tc.changedBy)
+				}
+
+				err := repo.Update(ctx, device)
+
+				if tc.expectError {
+					require.ErrorIs(t, err, model.ErrDatabaseQuery)
+
+					return
+				}
+
+				require.NoError(t, err)
+			})
+		})
+	}
+}
+
+func TestDevicesRepository_GetHistory(t *testing.T) {
+	t.Parallel()
+
+	deviceID := model.NewDeviceID()
+	changedAt := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	columns := []string{
+		"event_id", "device_id", "name", "brand", "state", "operation", "version", "changed_at", "changed_by",
+	}
+	selectQuery := `SELECT event_id, device_id, name, brand, state, operation, version, changed_at, changed_by ` +
+		`FROM device_history WHERE device_id = $1 ORDER BY version ASC`
+
+	actor := "user-42"
+	firstEvent, secondEvent := uuid.New(), uuid.New()
+
+	cases := []struct {
+		name        string
+		setupMock   func(mock pgxmock.PgxPoolIface)
+		expectError bool
+		expected    []*model.DeviceHistoryEntry
+	}{
+		{
+			name: "returns the snapshots oldest version first",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				rows := pgxmock.NewRows(columns).
+					AddRow(firstEvent.String(), deviceID.String(), "iPhone", "Apple", "available", "update", 1, changedAt, &actor).
+					AddRow(secondEvent.String(), deviceID.String(), "iPhone 15", "Apple", "in-use", "delete", 2, changedAt.Add(time.Minute), nil)
+				mock.ExpectQuery(regexp.QuoteMeta(selectQuery)).
+					WithArgs(deviceID.String()).
+					WillReturnRows(rows)
+			},
+			expected: []*model.DeviceHistoryEntry{
+				{
+					EventID:   firstEvent,
+					DeviceID:  deviceID,
+					Name:      "iPhone",
+					Brand:     "Apple",
+					State:     model.StateAvailable,
+					Operation: model.HistoryOperationUpdate,
+					Version:   1,
+					ChangedAt: changedAt,
+					ChangedBy: actor,
+				},
+				{
+					EventID:   secondEvent,
+					DeviceID:  deviceID,
+					Name:      "iPhone 15",
+					Brand:     "Apple",
+					State:     model.StateInUse,
+					Operation: model.HistoryOperationDelete,
+					Version:   2,
+					ChangedAt: changedAt.Add(time.Minute),
+				},
+			},
+		},
+		{
+			name: "no history",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(regexp.QuoteMeta(selectQuery)).
+					WithArgs(deviceID.String()).
+					WillReturnRows(pgxmock.NewRows(columns))
+			},
+			expected: []*model.DeviceHistoryEntry{},
+		},
+		{
+			name: "query error returns error",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(regexp.QuoteMeta(selectQuery)).
+					WithArgs(deviceID.String()).
+					WillReturnError(errors.New("connection error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			runRepoTest(t, tc.setupMock, func(t *testing.T, repo *repos.DevicesRepository) {
+				entries, err := repo.GetHistory(t.Context(), deviceID)
+
+				if tc.expectError {
+					require.ErrorIs(t, err, model.ErrDatabaseQuery)
+					require.Nil(t, entries)
+
+					return
+				}
+
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, entries)
+			})
+		})
+	}
+}
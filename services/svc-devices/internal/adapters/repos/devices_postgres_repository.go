@@ -2,19 +2,33 @@ package repos
 
 import (
 	"context"
+	"crypto/rand"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/architeacher/devices/pkg/logger"
 	"github.com/architeacher/devices/services/svc-devices/internal/domain/model"
+	"github.com/architeacher/devices/services/svc-devices/internal/ports"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
 )
 
 const devicesTable = "devices"
 
+// devicesTracerName names the tracer of the repository spans.
+const devicesTracerName = "repos.devices"
+
+// likeEscaper escapes the LIKE wildcards of user input, so it only matches literally.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
 var psql = sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
 
 type (
@@ -24,24 +38,43 @@ type (
 		QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
 		Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
 		Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+		Begin(ctx context.Context) (pgx.Tx, error)
 		Ping(ctx context.Context) error
+		Stat() *pgxpool.Stat
+	}
+
+	// execer runs a statement on the pool or within a transaction.
+	execer interface {
+		Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	}
+
+	// querier runs a query on the primary or the read replica pool.
+	querier interface {
+		Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
 	}
 
 	// DevicesRepository handles device persistence operations.
 	DevicesRepository struct {
 		pool       PoolOps
+		readPool   PoolOps
 		scanner    Scanner
 		logger     logger.Logger
 		translator *CriteriaTranslator
+		tracer     trace.Tracer
+		cursors    model.CursorCodec
 	}
 
+	// DevicesRepositoryOption configures the DevicesRepository.
+	DevicesRepositoryOption func(*DevicesRepository)
+
 	deviceRow struct {
-		ID        string    `db:"id"`
-		Name      string    `db:"name"`
-		Brand     string    `db:"brand"`
-		State     string    `db:"state"`
-		CreatedAt time.Time `db:"created_at"`
-		UpdatedAt time.Time `db:"updated_at"`
+		ID        string         `db:"id"`
+		Name      string         `db:"name"`
+		Brand     string         `db:"brand"`
+		State     string         `db:"state"`
+		CreatedAt time.Time      `db:"created_at"`
+		UpdatedAt time.Time      `db:"updated_at"`
+		Metadata  map[string]any `db:"metadata"`
 	}
 
 	deviceRowWithCount struct {
@@ -50,24 +83,63 @@ type (
 	}
 )
 
+// WithTracerProvider makes the repository record a span for each database
+// operation. Without it no spans are recorded.
+func WithTracerProvider(tp trace.TracerProvider) DevicesRepositoryOption {
+	return func(r *DevicesRepository) {
+		r.tracer = tp.Tracer(devicesTracerName)
+	}
+}
+
+// WithCursorCodec signs and verifies the list cursors with codec. Without it cursors
+// are signed with a random secret, so they only resolve on the instance that issued
+// them, until it restarts.
+func WithCursorCodec(codec model.CursorCodec) DevicesRepositoryOption {
+	return func(r *DevicesRepository) {
+		r.cursors = codec
+	}
+}
+
+// WithReadReplica sends the read-only queries to pool, typically connected to a read
+// replica of the primary. Writes, transactions and RecoverStale, which updates the rows
+// it returns, stay on the primary pool. Reads may lag behind the latest writes by the
+// replication delay.
+func WithReadReplica(pool PoolOps) DevicesRepositoryOption {
+	return func(r *DevicesRepository) {
+		r.readPool = pool
+	}
+}
+
 // NewDevicesRepository creates a new DevicesRepository with the given dependencies.
 func NewDevicesRepository(
 	pool PoolOps,
 	scanner Scanner,
 	translator *CriteriaTranslator,
 	log logger.Logger,
+	opts ...DevicesRepositoryOption,
 ) *DevicesRepository {
-	return &DevicesRepository{
+	repo := &DevicesRepository{
 		pool:       pool,
 		scanner:    scanner,
 		translator: translator,
 		logger:     log,
+		tracer:     noop.NewTracerProvider().Tracer(devicesTracerName),
+		cursors:    model.NewCursorCodec([]byte(rand.Text())),
 	}
+
+	for _, opt := range opts {
+		opt(repo)
+	}
+
+	return repo
 }
 
-func (r *DevicesRepository) Create(ctx context.Context, device *model.Device) error {
+func (r *DevicesRepository) Create(ctx context.Context, device *model.Device) (err error) {
+	ctx, span := r.startSpan(ctx, "create", "INSERT")
+	defer func() { endSpan(span, err) }()
+
 	query, args, err := psql.Insert(devicesTable).
-		Columns("id", "name", "brand", "state", "created_at", "updated_at").
+		Columns("id", "name", "brand", "state", "created_at", "updated_at", "metadata").
 		Values(
 			device.ID.String(),
 			device.Name,
@@ -75,12 +147,15 @@ func (r *DevicesRepository) Create(ctx context.Context, device *model.Device) er
 			device.State.String(),
 			device.CreatedAt,
 			device.UpdatedAt,
+			deviceMetadata(device),
 		).
 		ToSql()
 	if err != nil {
 		return fmt.Errorf("failed to build insert query: %w", err)
 	}
 
+	recordStatement(ctx, query)
+
 	_, err = r.pool.Exec(ctx, query, args...)
 	if err != nil {
 		if isDuplicateKeyError(err) {
@@ -93,19 +168,130 @@ func (r *DevicesRepository) Create(ctx context.Context, device *model.Device) er
 	return nil
 }
 
-func (r *DevicesRepository) FetchByID(ctx context.Context, id model.DeviceID) (*model.Device, error) {
+func (r *DevicesRepository) FetchByID(ctx context.Context, id model.DeviceID) (device *model.Device, err error) {
+	ctx, span := r.startSpan(ctx, "fetch_by_id", "SELECT")
+	defer func() { endSpan(span, err) }()
+
 	return r.findByCriteria(
 		ctx,
+		r.reader(),
 		sq.Eq{"id": id.String()},
 		fmt.Sprintf("device with ID %s not found", id.String()),
 	)
 }
 
-func (r *DevicesRepository) List(ctx context.Context, filter model.DeviceFilter) (*model.DeviceList, error) {
-	criteria := model.FromDeviceFilter(filter)
+func (r *DevicesRepository) FetchByIDForUpdate(ctx context.Context, id model.DeviceID) (device *model.Device, err error) {
+	ctx, span := r.startSpan(ctx, "fetch_by_id_for_update", "SELECT")
+	defer func() { endSpan(span, err) }()
+
+	return r.findByCriteria(
+		ctx,
+		r.pool,
+		sq.Eq{"id": id.String()},
+		fmt.Sprintf("device with ID %s not found", id.String()),
+	)
+}
+
+func (r *DevicesRepository) ListByIDs(ctx context.Context, ids []model.DeviceID) (_ []*model.Device, err error) {
+	ctx, span := r.startSpan(ctx, "list_by_ids", "SELECT")
+	defer func() { endSpan(span, err) }()
+
+	result := make([]*model.Device, len(ids))
+	if len(ids) == 0 {
+		return result, nil
+	}
+
+	idStrings := make([]string, 0, len(ids))
+	for _, id := range ids {
+		idStrings = append(idStrings, id.String())
+	}
+
+	devices, err := r.queryDevices(
+		ctx,
+		r.reader(),
+		psql.Select("id", "name", "brand", "state", "created_at", "updated_at", "metadata").
+			From(devicesTable).
+			Where(sq.Expr("id = ANY(?::uuid[])", idStrings)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[model.DeviceID]*model.Device, len(devices))
+	for _, device := range devices {
+		byID[device.ID] = device
+	}
+
+	for index, id := range ids {
+		result[index] = byID[id]
+	}
+
+	return result, nil
+}
+
+// ListChangedSince returns up to limit devices changed after the change at (since,
+// sinceID), oldest change first, for consumers polling a change feed: passing the
+// UpdatedAt and ID of the last device received resumes the feed. Devices updated in the
+// same instant are ordered by ID, so a page boundary falling between them skips none.
+// A zero sinceID returns the devices updated after since. No deletion filter applies,
+// so soft-deleted devices will be returned too. A zero limit returns every change.
+func (r *DevicesRepository) ListChangedSince(
+	ctx context.Context,
+	since time.Time,
+	sinceID model.DeviceID,
+	limit uint,
+) (_ []*model.Device, err error) {
+	ctx, span := r.startSpan(ctx, "list_changed_since", "SELECT")
+	defer func() { endSpan(span, err) }()
+
+	var after sq.Sqlizer = sq.Gt{"updated_at": since}
+	if !sinceID.IsZero() {
+		// A row comparison, served by the (updated_at, id) index.
+		after = sq.Expr("(updated_at, id) > (?, ?::uuid)", since, sinceID.String())
+	}
+
+	selectBuilder := psql.Select("id", "name", "brand", "state", "created_at", "updated_at", "metadata").
+		From(devicesTable).
+		Where(after).
+		OrderBy("updated_at ASC", "id ASC")
+	if limit > 0 {
+		selectBuilder = selectBuilder.Limit(uint64(limit))
+	}
+
+	return r.queryDevices(ctx, r.reader(), selectBuilder)
+}
+
+// GetByMetadataField returns the devices whose metadata holds value under key, newest
+// first. The containment match is served by the GIN index on the metadata column.
+func (r *DevicesRepository) GetByMetadataField(ctx context.Context, key, value string) (_ []*model.Device, err error) {
+	ctx, span := r.startSpan(ctx, "get_by_metadata_field", "SELECT")
+	defer func() { endSpan(span, err) }()
+
+	return r.queryDevices(
+		ctx,
+		r.reader(),
+		psql.Select("id", "name", "brand", "state", "created_at", "updated_at", "metadata").
+			From(devicesTable).
+			Where(sq.Expr("metadata @> ?::jsonb", map[string]string{key: value})).
+			OrderBy("created_at DESC"),
+	)
+}
+
+func (r *DevicesRepository) List(ctx context.Context, filter model.DeviceFilter) (_ *model.DeviceList, err error) {
+	ctx, span := r.startSpan(ctx, "list", "SELECT")
+	defer func() { endSpan(span, err) }()
+
+	sortField := r.getPrimarySortField(filter)
+
+	cursor, err := r.decodeCursor(filter.Cursor, sortField)
+	if err != nil {
+		return nil, err
+	}
+
+	criteria := model.FromDeviceFilter(filter, cursor)
 
 	selectBuilder := psql.Select(
-		"id", "name", "brand", "state", "created_at", "updated_at",
+		"id", "name", "brand", "state", "created_at", "updated_at", "metadata",
 		"COUNT(*) OVER() as total_count",
 	).From(devicesTable)
 
@@ -116,22 +302,66 @@ func (r *DevicesRepository) List(ctx context.Context, filter model.DeviceFilter)
 		return nil, err
 	}
 
-	totalPages := totalItems / criteria.Size()
-	if totalItems%criteria.Size() != 0 {
-		totalPages++
+	var pagination model.Pagination
+	pagination.Compute(criteria.Page(), criteria.Size(), totalItems)
+
+	pagination = r.generateCursors(devices, pagination, sortField)
+
+	return &model.DeviceList{
+		Devices:    devices,
+		Pagination: pagination,
+		Filters:    filter,
+	}, nil
+}
+
+// Search returns the devices whose name or brand match the query, best matches first.
+// Matching runs on the indexed search_vector column, ranked by ts_rank. The english
+// parser keeps compound words such as "iPhone" as a single lexeme, so names and brands
+// containing the query are matched as well, ranking below full-text matches. The
+// filter's brands, states and page apply as in List; its keyword and cursor are
+// ignored, and its sort order only breaks ties between equally ranked devices.
+func (r *DevicesRepository) Search(ctx context.Context, query string, filter model.DeviceFilter) (_ *model.DeviceList, err error) {
+	filter.Keyword = strings.TrimSpace(query)
+	filter.Cursor = ""
+
+	if filter.Keyword == "" {
+		return r.List(ctx, filter)
 	}
 
-	pagination := model.Pagination{
-		Page:        criteria.Page(),
-		Size:        criteria.Size(),
-		TotalItems:  totalItems,
-		TotalPages:  totalPages,
-		HasNext:     criteria.Page() < totalPages,
-		HasPrevious: criteria.Page() > 1,
+	ctx, span := r.startSpan(ctx, "search", "SELECT")
+	defer func() { endSpan(span, err) }()
+
+	criteria := model.FromDeviceFilter(model.DeviceFilter{
+		Brands: filter.Brands,
+		States: filter.States,
+		Sort:   filter.Sort,
+		Page:   filter.Page,
+		Size:   filter.Size,
+	}, nil)
+
+	pattern := "%" + likeEscaper.Replace(filter.Keyword) + "%"
+
+	selectBuilder := psql.Select(
+		"id", "name", "brand", "state", "created_at", "updated_at", "metadata",
+		"COUNT(*) OVER() as total_count",
+	).
+		From(devicesTable).
+		Where(sq.Or{
+			sq.Expr("search_vector @@ plainto_tsquery('english', ?)", filter.Keyword),
+			sq.ILike{"name": pattern},
+			sq.ILike{"brand": pattern},
+		}).
+		OrderByClause("ts_rank(search_vector, plainto_tsquery('english', ?)) DESC", filter.Keyword)
+
+	selectBuilder = r.translator.ApplyToSelect(selectBuilder, criteria)
+
+	devices, totalItems, err := r.queryDevicesWithCount(ctx, selectBuilder)
+	if err != nil {
+		return nil, err
 	}
 
-	sortField := r.getPrimarySortField(filter)
-	pagination = r.generateCursors(devices, pagination, sortField)
+	var pagination model.Pagination
+	pagination.Compute(criteria.Page(), criteria.Size(), totalItems)
 
 	return &model.DeviceList{
 		Devices:    devices,
@@ -148,6 +378,26 @@ func (r *DevicesRepository) getPrimarySortField(filter model.DeviceFilter) strin
 	return "-createdAt"
 }
 
+// decodeCursor verifies token and returns the position it holds, or nil for the first
+// page. A cursor issued for another sort order is rejected like a tampered one, as its
+// position means nothing in this order.
+func (r *DevicesRepository) decodeCursor(token, sortField string) (*model.Cursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	cursor, err := r.cursors.Decode(token)
+	if err != nil {
+		return nil, err
+	}
+
+	if cursor.Field != sortField {
+		return nil, model.ErrInvalidCursor.WithCause(fmt.Errorf("issued for sort %q, not %q", cursor.Field, sortField))
+	}
+
+	return &cursor, nil
+}
+
 func (r *DevicesRepository) generateCursors(
 	devices []*model.Device,
 	pagination model.Pagination,
@@ -160,7 +410,7 @@ func (r *DevicesRepository) generateCursors(
 	lastDevice := devices[len(devices)-1]
 	if pagination.HasNext {
 		cursor := model.NewCursorFromDevice(lastDevice, sortField, model.CursorDirectionNext)
-		if encoded, err := model.EncodeCursor(cursor); err == nil {
+		if encoded, err := r.cursors.Encode(cursor); err == nil {
 			pagination.NextCursor = encoded
 		}
 	}
@@ -168,7 +418,7 @@ func (r *DevicesRepository) generateCursors(
 	firstDevice := devices[0]
 	if pagination.HasPrevious {
 		cursor := model.NewCursorFromDevice(firstDevice, sortField, model.CursorDirectionPrev)
-		if encoded, err := model.EncodeCursor(cursor); err == nil {
+		if encoded, err := r.cursors.Encode(cursor); err == nil {
 			pagination.PreviousCursor = encoded
 		}
 	}
@@ -176,49 +426,236 @@ func (r *DevicesRepository) generateCursors(
 	return pagination
 }
 
-func (r *DevicesRepository) Update(ctx context.Context, device *model.Device) error {
-	return r.updateByCriteria(
-		ctx,
-		psql.Update(devicesTable).
-			Set("name", device.Name).
-			Set("brand", device.Brand).
-			Set("state", device.State.String()).
-			Set("updated_at", device.UpdatedAt).
-			Where(sq.Eq{"id": device.ID.String()}),
-		"failed to update device",
-	)
+// Update stores the device and records its snapshot in the device history, in a single
+// transaction.
+func (r *DevicesRepository) Update(ctx context.Context, device *model.Device) (err error) {
+	ctx, span := r.startSpan(ctx, "update", "UPDATE")
+	defer func() { endSpan(span, err) }()
+
+	return r.inTx(ctx, func(tx pgx.Tx) error {
+		if err := r.updateDevice(ctx, tx, device); err != nil {
+			return err
+		}
+
+		return r.insertHistory(ctx, tx, model.HistoryOperationUpdate, device)
+	})
 }
 
-func (r *DevicesRepository) Delete(ctx context.Context, id model.DeviceID) error {
-	query, args, err := psql.Delete(devicesTable).
-		Where(sq.Eq{"id": id.String()}).
-		ToSql()
-	if err != nil {
-		return fmt.Errorf("failed to build delete query: %w", err)
+// UpdateIfUnmodified updates the device and records the change in its history only if
+// its stored updated_at still equals lastUpdatedAt, so a change made since the device
+// was read is not overwritten. A device changed or deleted since fails with
+// ErrDeviceModified.
+func (r *DevicesRepository) UpdateIfUnmodified(ctx context.Context, device *model.Device, lastUpdatedAt time.Time) (err error) {
+	ctx, span := r.startSpan(ctx, "update_if_unmodified", "UPDATE")
+	defer func() { endSpan(span, err) }()
+
+	return r.inTx(ctx, func(tx pgx.Tx) error {
+		err := r.updateByCriteria(
+			ctx,
+			tx,
+			deviceUpdate(device).Where(sq.Eq{"updated_at": lastUpdatedAt}),
+			"failed to update device",
+		)
+		if errors.Is(err, model.ErrDeviceNotFound) {
+			return model.ErrDeviceModified
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return r.insertHistory(ctx, tx, model.HistoryOperationUpdate, device)
+	})
+}
+
+// UpdateBatch updates every device in a single transaction, so either all updates are
+// applied or none is. It returns one error per device, in order. When an update fails,
+// the transaction is rolled back: the devices before it get nil, the failing one gets
+// its error and the ones after it, which are not attempted, get ErrBatchAborted. A
+// failure of the connection itself, including on begin or commit, is reported for
+// every device as ErrDatabaseConnection. The snapshots of the updated devices are
+// recorded in the device history before the commit; a failure there fails every device.
+func (r *DevicesRepository) UpdateBatch(ctx context.Context, updates []*model.Device) []error {
+	var err error
+
+	ctx, span := r.startSpan(ctx, "update_batch", "UPDATE")
+	defer func() { endSpan(span, err) }()
+
+	errs := make([]error, len(updates))
+	if len(updates) == 0 {
+		return errs
 	}
 
-	result, err := r.pool.Exec(ctx, query, args...)
+	tx, err := r.pool.Begin(ctx)
 	if err != nil {
-		return fmt.Errorf("%w: %v", model.ErrDatabaseQuery, err)
+		return fillErrors(errs, model.ErrDatabaseConnection.WithCause(err))
 	}
 
-	if result.RowsAffected() == 0 {
-		return model.ErrDeviceNotFound
+	for index, device := range updates {
+		if err = r.updateDevice(ctx, tx, device); err == nil {
+			continue
+		}
+
+		if rollbackErr := tx.Rollback(ctx); rollbackErr != nil {
+			r.logger.Warn().Err(rollbackErr).Msg("failed to roll back device batch update")
+		}
+
+		if isConnectionError(err) {
+			return fillErrors(errs, model.ErrDatabaseConnection.WithCause(err))
+		}
+
+		errs[index] = err
+		fillErrors(errs[index+1:], model.ErrBatchAborted)
+
+		return errs
 	}
 
-	return nil
+	if err = r.insertHistory(ctx, tx, model.HistoryOperationUpdate, updates...); err != nil {
+		if rollbackErr := tx.Rollback(ctx); rollbackErr != nil {
+			r.logger.Warn().Err(rollbackErr).Msg("failed to roll back device batch update")
+		}
+
+		return fillErrors(errs, err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fillErrors(errs, model.ErrDatabaseConnection.WithCause(err))
+	}
+
+	return errs
+}
+
+// RecoverStale releases up to limit in-use devices whose last update is older than
+// staleBefore, oldest first, and returns them as available. Rows locked by a concurrent
+// recovery are skipped rather than waited on. A zero limit recovers every stale device.
+// Each release is recorded in the device history in the same transaction.
+func (r *DevicesRepository) RecoverStale(
+	ctx context.Context,
+	staleBefore, recoveredAt time.Time,
+	limit uint,
+) (_ []*model.Device, err error) {
+	ctx, span := r.startSpan(ctx, "recover_stale", "UPDATE")
+	defer func() { endSpan(span, err) }()
+
+	staleIDs := sq.Select("id").
+		From(devicesTable).
+		Where(sq.Eq{"state": model.StateInUse.String()}).
+		Where(sq.Lt{"updated_at": staleBefore}).
+		OrderBy("updated_at").
+		Suffix("FOR UPDATE SKIP LOCKED")
+	if limit > 0 {
+		staleIDs = staleIDs.Limit(uint64(limit))
+	}
+
+	var recovered []*model.Device
+
+	err = r.inTx(ctx, func(tx pgx.Tx) error {
+		devices, err := r.queryDevices(
+			ctx,
+			tx,
+			psql.Update(devicesTable).
+				Set("state", model.StateAvailable.String()).
+				Set("updated_at", recoveredAt).
+				Where(sq.Expr("id IN (?)", staleIDs)).
+				Suffix("RETURNING id, name, brand, state, created_at, updated_at, metadata"),
+		)
+		if err != nil || len(devices) == 0 {
+			return err
+		}
+
+		recovered = devices
+
+		return r.insertHistory(ctx, tx, model.HistoryOperationRecover, devices...)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return recovered, nil
+}
+
+// Delete removes the device and records its last state in the device history, in a
+// single transaction.
+func (r *DevicesRepository) Delete(ctx context.Context, id model.DeviceID) (err error) {
+	ctx, span := r.startSpan(ctx, "delete", "DELETE")
+	defer func() { endSpan(span, err) }()
+
+	return r.inTx(ctx, func(tx pgx.Tx) error {
+		deleted, err := r.queryDevices(
+			ctx,
+			tx,
+			psql.Delete(devicesTable).
+				Where(sq.Eq{"id": id.String()}).
+				Suffix("RETURNING id, name, brand, state, created_at, updated_at, metadata"),
+		)
+		if err != nil {
+			return err
+		}
+
+		if len(deleted) == 0 {
+			return model.ErrDeviceNotFound
+		}
+
+		return r.insertHistory(ctx, tx, model.HistoryOperationDelete, deleted...)
+	})
 }
 
 func (r *DevicesRepository) Ping(ctx context.Context) error {
 	return r.pool.Ping(ctx)
 }
 
+func (r *DevicesRepository) PoolStats(_ context.Context) (ports.PoolStats, error) {
+	stat := r.pool.Stat()
+
+	return ports.PoolStats{
+		TotalConns:    stat.TotalConns(),
+		IdleConns:     stat.IdleConns(),
+		AcquiredConns: stat.AcquiredConns(),
+		MaxConns:      stat.MaxConns(),
+	}, nil
+}
+
+// inTx runs fn in a transaction on the primary, committing it when fn succeeds and
+// rolling it back otherwise. Failing to begin or commit is reported as
+// ErrDatabaseConnection.
+func (r *DevicesRepository) inTx(ctx context.Context, fn func(tx pgx.Tx) error) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return model.ErrDatabaseConnection.WithCause(err)
+	}
+
+	if err := fn(tx); err != nil {
+		if rollbackErr := tx.Rollback(ctx); rollbackErr != nil {
+			r.logger.Warn().Err(rollbackErr).Msg("failed to roll back device transaction")
+		}
+
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return model.ErrDatabaseConnection.WithCause(err)
+	}
+
+	return nil
+}
+
+// reader returns the pool serving read-only queries: the read replica when one is set,
+// the primary otherwise.
+func (r *DevicesRepository) reader() PoolOps {
+	if r.readPool != nil {
+		return r.readPool
+	}
+
+	return r.pool
+}
+
 func (r *DevicesRepository) findByCriteria(
 	ctx context.Context,
+	q querier,
 	criteria sq.Sqlizer,
 	errorContext string,
 ) (*model.Device, error) {
-	query, args, err := psql.Select("id", "name", "brand", "state", "created_at", "updated_at").
+	query, args, err := psql.Select("id", "name", "brand", "state", "created_at", "updated_at", "metadata").
 		From(devicesTable).
 		Where(criteria).
 		Limit(1).
@@ -227,7 +664,9 @@ func (r *DevicesRepository) findByCriteria(
 		return nil, fmt.Errorf("failed to build select query: %w", err)
 	}
 
-	rows, err := r.pool.Query(ctx, query, args...)
+	recordStatement(ctx, query)
+
+	rows, err := q.Query(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %v", model.ErrDatabaseQuery, err)
 	}
@@ -245,8 +684,24 @@ func (r *DevicesRepository) findByCriteria(
 	return r.convertRowToDevice(row)
 }
 
+func (r *DevicesRepository) updateDevice(ctx context.Context, exec execer, device *model.Device) error {
+	return r.updateByCriteria(ctx, exec, deviceUpdate(device), "failed to update device")
+}
+
+// deviceUpdate builds the statement writing every mutable column of the device.
+func deviceUpdate(device *model.Device) sq.UpdateBuilder {
+	return psql.Update(devicesTable).
+		Set("name", device.Name).
+		Set("brand", device.Brand).
+		Set("state", device.State.String()).
+		Set("updated_at", device.UpdatedAt).
+		Set("metadata", deviceMetadata(device)).
+		Where(sq.Eq{"id": device.ID.String()})
+}
+
 func (r *DevicesRepository) updateByCriteria(
 	ctx context.Context,
+	exec execer,
 	updateBuilder sq.UpdateBuilder,
 	errorContext string,
 ) error {
@@ -255,7 +710,9 @@ func (r *DevicesRepository) updateByCriteria(
 		return fmt.Errorf("failed to build update query: %w", err)
 	}
 
-	result, err := r.pool.Exec(ctx, query, args...)
+	recordStatement(ctx, query)
+
+	result, err := exec.Exec(ctx, query, args...)
 	if err != nil {
 		return fmt.Errorf("%s: %w", errorContext, err)
 	}
@@ -267,13 +724,15 @@ func (r *DevicesRepository) updateByCriteria(
 	return nil
 }
 
-func (r *DevicesRepository) queryDevices(ctx context.Context, builder sq.SelectBuilder) ([]*model.Device, error) {
+func (r *DevicesRepository) queryDevices(ctx context.Context, q querier, builder sq.Sqlizer) ([]*model.Device, error) {
 	query, args, err := builder.ToSql()
 	if err != nil {
-		return nil, fmt.Errorf("failed to build select query: %w", err)
+		return nil, fmt.Errorf("failed to build query: %w", err)
 	}
 
-	rows, err := r.pool.Query(ctx, query, args...)
+	recordStatement(ctx, query)
+
+	rows, err := q.Query(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %v", model.ErrDatabaseQuery, err)
 	}
@@ -302,7 +761,9 @@ func (r *DevicesRepository) queryDevicesWithCount(ctx context.Context, builder s
 		return nil, 0, fmt.Errorf("failed to build select query: %w", err)
 	}
 
-	rows, err := r.pool.Query(ctx, query, args...)
+	recordStatement(ctx, query)
+
+	rows, err := r.reader().Query(ctx, query, args...)
 	if err != nil {
 		return nil, 0, fmt.Errorf("%w: %v", model.ErrDatabaseQuery, err)
 	}
@@ -349,9 +810,73 @@ func (r *DevicesRepository) convertRowToDevice(row deviceRow) (*model.Device, er
 		State:     state,
 		CreatedAt: row.CreatedAt,
 		UpdatedAt: row.UpdatedAt,
+		Metadata:  row.Metadata,
 	}, nil
 }
 
+// deviceMetadata returns the metadata to store for the device, an empty object when it has none.
+func deviceMetadata(device *model.Device) map[string]any {
+	if device.Metadata == nil {
+		return map[string]any{}
+	}
+
+	return device.Metadata
+}
+
+// startSpan starts the span of a repository operation, named db.devices.<operation>.
+func (r *DevicesRepository) startSpan(ctx context.Context, operation, sqlOperation string) (context.Context, trace.Span) {
+	return r.tracer.Start(ctx, "db.devices."+operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemPostgreSQL,
+			semconv.DBOperation(sqlOperation),
+			semconv.DBSQLTable(devicesTable),
+		),
+	)
+}
+
+// recordStatement adds the SQL about to run to the current span. Statements use
+// placeholders, so no parameter values end up in the trace.
+func recordStatement(ctx context.Context, query string) {
+	trace.SpanFromContext(ctx).SetAttributes(semconv.DBStatement(query))
+}
+
+// endSpan marks the span as failed when err is set and ends it. A missing
+// device is an expected outcome rather than a failure of the database call.
+func endSpan(span trace.Span, err error) {
+	if err != nil && !errors.Is(err, model.ErrDeviceNotFound) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
+
+// fillErrors sets every element of errs to err and returns errs.
+func fillErrors(errs []error, err error) []error {
+	for index := range errs {
+		errs[index] = err
+	}
+
+	return errs
+}
+
+// isConnectionError reports whether err is a failure of the connection rather than of
+// the statement: anything the server did not answer with a statement-level error, and
+// the fatal errors it sends before closing the connection.
+func isConnectionError(err error) bool {
+	if errors.Is(err, model.ErrDeviceNotFound) {
+		return false
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.Severity == "FATAL" || pgErr.Severity == "PANIC" || strings.HasPrefix(pgErr.Code, "08")
+	}
+
+	return true
+}
+
 func isDuplicateKeyError(err error) bool {
 	return err != nil && (errors.Is(err, pgx.ErrNoRows) == false) &&
 		(err.Error() != "" && len(err.Error()) > 0 &&
@@ -2,16 +2,24 @@ package repos_test
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"regexp"
+	"slices"
 	"testing"
 	"time"
 
 	"github.com/architeacher/devices/pkg/logger"
 	"github.com/architeacher/devices/services/svc-devices/internal/adapters/repos"
 	"github.com/architeacher/devices/services/svc-devices/internal/domain/model"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/pashagolub/pgxmock/v4"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func runRepoTest(
@@ -61,7 +69,7 @@ func TestDevicesRepository_Create(t *testing.T) {
 			device: model.NewDevice("Test Device", "Test Brand", model.StateAvailable),
 			setupMock: func(mock pgxmock.PgxPoolIface, device *model.Device) {
 				mock.ExpectExec(regexp.QuoteMeta(
-					`INSERT INTO devices (id,name,brand,state,created_at,updated_at) VALUES ($1,$2,$3,$4,$5,$6)`,
+					`INSERT INTO devices (id,name,brand,state,created_at,updated_at,metadata) VALUES ($1,$2,$3,$4,$5,$6,$7)`,
 				)).
 					WithArgs(
 						device.ID.String(),
@@ -70,6 +78,7 @@ func TestDevicesRepository_Create(t *testing.T) {
 						device.State.String(),
 						device.CreatedAt,
 						device.UpdatedAt,
+						map[string]any{},
 					).
 					WillReturnResult(pgxmock.NewResult("INSERT", 1))
 			},
@@ -80,7 +89,7 @@ func TestDevicesRepository_Create(t *testing.T) {
 			device: model.NewDevice("Duplicate", "Brand", model.StateAvailable),
 			setupMock: func(mock pgxmock.PgxPoolIface, device *model.Device) {
 				mock.ExpectExec(regexp.QuoteMeta(
-					`INSERT INTO devices (id,name,brand,state,created_at,updated_at) VALUES ($1,$2,$3,$4,$5,$6)`,
+					`INSERT INTO devices (id,name,brand,state,created_at,updated_at,metadata) VALUES ($1,$2,$3,$4,$5,$6,$7)`,
 				)).
 					WithArgs(
 						device.ID.String(),
@@ -89,6 +98,7 @@ func TestDevicesRepository_Create(t *testing.T) {
 						device.State.String(),
 						device.CreatedAt,
 						device.UpdatedAt,
+						map[string]any{},
 					).
 					WillReturnError(errors.New("duplicate key value violates unique constraint"))
 			},
@@ -100,7 +110,7 @@ func TestDevicesRepository_Create(t *testing.T) {
 			device: model.NewDevice("Duplicate", "Brand", model.StateAvailable),
 			setupMock: func(mock pgxmock.PgxPoolIface, device *model.Device) {
 				mock.ExpectExec(regexp.QuoteMeta(
-					`INSERT INTO devices (id,name,brand,state,created_at,updated_at) VALUES ($1,$2,$3,$4,$5,$6)`,
+					`INSERT INTO devices (id,name,brand,state,created_at,updated_at,metadata) VALUES ($1,$2,$3,$4,$5,$6,$7)`,
 				)).
 					WithArgs(
 						device.ID.String(),
@@ -109,6 +119,7 @@ func TestDevicesRepository_Create(t *testing.T) {
 						device.State.String(),
 						device.CreatedAt,
 						device.UpdatedAt,
+						map[string]any{},
 					).
 					WillReturnError(errors.New("unique constraint violation"))
 			},
@@ -120,7 +131,7 @@ func TestDevicesRepository_Create(t *testing.T) {
 			device: model.NewDevice("Error Device", "Brand", model.StateAvailable),
 			setupMock: func(mock pgxmock.PgxPoolIface, device *model.Device) {
 				mock.ExpectExec(regexp.QuoteMeta(
-					`INSERT INTO devices (id,name,brand,state,created_at,updated_at) VALUES ($1,$2,$3,$4,$5,$6)`,
+					`INSERT INTO devices (id,name,brand,state,created_at,updated_at,metadata) VALUES ($1,$2,$3,$4,$5,$6,$7)`,
 				)).
 					WithArgs(
 						device.ID.String(),
@@ -129,6 +140,7 @@ func TestDevicesRepository_Create(t *testing.T) {
 						device.State.String(),
 						device.CreatedAt,
 						device.UpdatedAt,
+						map[string]any{},
 					).
 					WillReturnError(errors.New("connection refused"))
 			},
@@ -179,7 +191,7 @@ func TestDevicesRepository_GetByID(t *testing.T) {
 				rows := pgxmock.NewRows([]string{"id", "name", "brand", "state", "created_at", "updated_at"}).
 					AddRow(testID.String(), "Test Device", "Test Brand", "available", now, now)
 				mock.ExpectQuery(regexp.QuoteMeta(
-					`SELECT id, name, brand, state, created_at, updated_at FROM devices WHERE id = $1 LIMIT 1`,
+					`SELECT id, name, brand, state, created_at, updated_at, metadata FROM devices WHERE id = $1 LIMIT 1`,
 				)).
 					WithArgs(testID.String()).
 					WillReturnRows(rows)
@@ -200,7 +212,7 @@ func TestDevicesRepository_GetByID(t *testing.T) {
 			setupMock: func(mock pgxmock.PgxPoolIface) {
 				emptyRows := pgxmock.NewRows([]string{"id", "name", "brand", "state", "created_at", "updated_at"})
 				mock.ExpectQuery(regexp.QuoteMeta(
-					`SELECT id, name, brand, state, created_at, updated_at FROM devices WHERE id = $1 LIMIT 1`,
+					`SELECT id, name, brand, state, created_at, updated_at, metadata FROM devices WHERE id = $1 LIMIT 1`,
 				)).
 					WithArgs(testID.String()).
 					WillReturnRows(emptyRows)
@@ -213,7 +225,7 @@ func TestDevicesRepository_GetByID(t *testing.T) {
 			deviceID: testID,
 			setupMock: func(mock pgxmock.PgxPoolIface) {
 				mock.ExpectQuery(regexp.QuoteMeta(
-					`SELECT id, name, brand, state, created_at, updated_at FROM devices WHERE id = $1 LIMIT 1`,
+					`SELECT id, name, brand, state, created_at, updated_at, metadata FROM devices WHERE id = $1 LIMIT 1`,
 				)).
 					WithArgs(testID.String()).
 					WillReturnError(errors.New("connection error"))
@@ -247,6 +259,294 @@ func TestDevicesRepository_GetByID(t *testing.T) {
 	}
 }
 
+func TestDevicesRepository_ListChangedSince(t *testing.T) {
+	t.Parallel()
+
+	since := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	columns := []string{"id", "name", "brand", "state", "created_at", "updated_at"}
+	selectQuery := `SELECT id, name, brand, state, created_at, updated_at, metadata FROM devices WHERE updated_at > $1 ORDER BY updated_at ASC, id ASC`
+	resumeQuery := `SELECT id, name, brand, state, created_at, updated_at, metadata FROM devices WHERE (updated_at, id) > ($1, $2::uuid) ORDER BY updated_at ASC, id ASC`
+
+	first, second := model.NewDeviceID(), model.NewDeviceID()
+
+	cases := []struct {
+		name          string
+		sinceID       model.DeviceID
+		limit         uint
+		setupMock     func(mock pgxmock.PgxPoolIface)
+		expectError   bool
+		expectedNames []string
+	}{
+		{
+			name:  "returns the changes in order, up to the limit",
+			limit: 2,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				rows := pgxmock.NewRows(columns).
+					AddRow(first.String(), "first", "Apple", "available", since, since.Add(time.Minute)).
+					AddRow(second.String(), "second", "Google", "in-use", since, since.Add(2*time.Minute))
+				mock.ExpectQuery(regexp.QuoteMeta(selectQuery + ` LIMIT 2`)).
+					WithArgs(since).
+					WillReturnRows(rows)
+			},
+			expectedNames: []string{"first", "second"},
+		},
+		{
+			name:    "resumes after the last change seen, ties included",
+			sinceID: first,
+			limit:   2,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				rows := pgxmock.NewRows(columns).
+					AddRow(second.String(), "second", "Google", "in-use", since, since)
+				mock.ExpectQuery(regexp.QuoteMeta(resumeQuery+` LIMIT 2`)).
+					WithArgs(since, first.String()).
+					WillReturnRows(rows)
+			},
+			expectedNames: []string{"second"},
+		},
+		{
+			name:  "zero limit returns every change",
+			limit: 0,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				rows := pgxmock.NewRows(columns).
+					AddRow(first.String(), "first", "Apple", "available", since, since.Add(time.Minute))
+				mock.ExpectQuery(regexp.QuoteMeta(selectQuery) + `$`).
+					WithArgs(since).
+					WillReturnRows(rows)
+			},
+			expectedNames: []string{"first"},
+		},
+		{
+			name:  "no changes",
+			limit: 10,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(regexp.QuoteMeta(selectQuery + ` LIMIT 10`)).
+					WithArgs(since).
+					WillReturnRows(pgxmock.NewRows(columns))
+			},
+			expectedNames: []string{},
+		},
+		{
+			name:  "query error returns error",
+			limit: 10,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(regexp.QuoteMeta(selectQuery + ` LIMIT 10`)).
+					WithArgs(since).
+					WillReturnError(errors.New("connection error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			runRepoTest(t, tc.setupMock, func(t *testing.T, repo *repos.DevicesRepository) {
+				devices, err := repo.ListChangedSince(t.Context(), since, tc.sinceID, tc.limit)
+
+				if tc.expectError {
+					require.ErrorIs(t, err, model.ErrDatabaseQuery)
+					require.Nil(t, devices)
+
+					return
+				}
+				require.NoError(t, err)
+
+				names := make([]string, 0, len(devices))
+				for _, device := range devices {
+					names = append(names, device.Name)
+				}
+
+				require.Equal(t, tc.expectedNames, names)
+			})
+		})
+	}
+}
+
+func TestDevicesRepository_GetByMetadataField(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+
+	columns := []string{"id", "name", "brand", "state", "created_at", "updated_at", "metadata"}
+	query := regexp.QuoteMeta(
+		`SELECT id, name, brand, state, created_at, updated_at, metadata FROM devices WHERE metadata @> $1::jsonb ORDER BY created_at DESC`,
+	)
+
+	first, second := model.NewDeviceID(), model.NewDeviceID()
+
+	cases := []struct {
+		name             string
+		setupMock        func(mock pgxmock.PgxPoolIface)
+		expectError      bool
+		expectedIDs      []model.DeviceID
+		expectedMetadata []map[string]any
+	}{
+		{
+			name: "returns the matching devices with their metadata",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				rows := pgxmock.NewRows(columns).
+					AddRow(first.String(), "Sensor", "Bosch", "available", now, now,
+						map[string]any{"color": "black", "interval": float64(30)}).
+					AddRow(second.String(), "Watch", "Garmin", "in-use", now, now,
+						map[string]any{"color": "black"})
+				mock.ExpectQuery(query).
+					WithArgs(map[string]string{"color": "black"}).
+					WillReturnRows(rows)
+			},
+			expectedIDs: []model.DeviceID{first, second},
+			expectedMetadata: []map[string]any{
+				{"color": "black", "interval": float64(30)},
+				{"color": "black"},
+			},
+		},
+		{
+			name: "no match returns an empty list",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(query).
+					WithArgs(map[string]string{"color": "black"}).
+					WillReturnRows(pgxmock.NewRows(columns))
+			},
+			expectedIDs:      []model.DeviceID{},
+			expectedMetadata: []map[string]any{},
+		},
+		{
+			name: "query error returns error",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(query).
+					WithArgs(map[string]string{"color": "black"}).
+					WillReturnError(errors.New("connection error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			runRepoTest(t, tc.setupMock, func(t *testing.T, repo *repos.DevicesRepository) {
+				devices, err := repo.GetByMetadataField(t.Context(), "color", "black")
+
+				if tc.expectError {
+					require.ErrorIs(t, err, model.ErrDatabaseQuery)
+					require.Nil(t, devices)
+
+					return
+				}
+				require.NoError(t, err)
+
+				ids := make([]model.DeviceID, 0, len(devices))
+				metadata := make([]map[string]any, 0, len(devices))
+
+				for _, device := range devices {
+					ids = append(ids, device.ID)
+					metadata = append(metadata, device.Metadata)
+				}
+
+				require.Equal(t, tc.expectedIDs, ids)
+				require.Equal(t, tc.expectedMetadata, metadata)
+			})
+		})
+	}
+}
+
+func TestDevicesRepository_ListByIDs(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+
+	columns := []string{"id", "name", "brand", "state", "created_at", "updated_at"}
+	query := regexp.QuoteMeta(`SELECT id, name, brand, state, created_at, updated_at, metadata FROM devices WHERE id = ANY($1::uuid[])`)
+
+	first, second, missing := model.NewDeviceID(), model.NewDeviceID(), model.NewDeviceID()
+
+	cases := []struct {
+		name          string
+		ids           []model.DeviceID
+		setupMock     func(mock pgxmock.PgxPoolIface)
+		expectError   bool
+		expectedNames []string
+	}{
+		{
+			name: "keeps the order of the requested IDs",
+			ids:  []model.DeviceID{second, first},
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				rows := pgxmock.NewRows(columns).
+					AddRow(first.String(), "first", "Apple", "available", now, now).
+					AddRow(second.String(), "second", "Google", "in-use", now, now)
+				mock.ExpectQuery(query).
+					WithArgs([]string{second.String(), first.String()}).
+					WillReturnRows(rows)
+			},
+			expectedNames: []string{"second", "first"},
+		},
+		{
+			name: "missing devices leave nil placeholders",
+			ids:  []model.DeviceID{first, missing, second},
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				rows := pgxmock.NewRows(columns).
+					AddRow(second.String(), "second", "Google", "in-use", now, now).
+					AddRow(first.String(), "first", "Apple", "available", now, now)
+				mock.ExpectQuery(query).
+					WithArgs([]string{first.String(), missing.String(), second.String()}).
+					WillReturnRows(rows)
+			},
+			expectedNames: []string{"first", "", "second"},
+		},
+		{
+			name: "all devices missing",
+			ids:  []model.DeviceID{missing, first},
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(query).
+					WithArgs([]string{missing.String(), first.String()}).
+					WillReturnRows(pgxmock.NewRows(columns))
+			},
+			expectedNames: []string{"", ""},
+		},
+		{
+			name:          "no IDs skips the query",
+			ids:           nil,
+			setupMock:     func(pgxmock.PgxPoolIface) {},
+			expectedNames: []string{},
+		},
+		{
+			name: "query error returns error",
+			ids:  []model.DeviceID{first},
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(query).
+					WithArgs([]string{first.String()}).
+					WillReturnError(errors.New("connection error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			runRepoTest(t, tc.setupMock, func(t *testing.T, repo *repos.DevicesRepository) {
+				devices, err := repo.ListByIDs(t.Context(), tc.ids)
+
+				if tc.expectError {
+					require.ErrorIs(t, err, model.ErrDatabaseQuery)
+					require.Nil(t, devices)
+
+					return
+				}
+				require.NoError(t, err)
+				require.Len(t, devices, len(tc.expectedNames))
+
+				for index, name := range tc.expectedNames {
+					if name == "" {
+						require.Nil(t, devices[index], "position %d", index)
+
+						continue
+					}
+
+					require.Equal(t, tc.ids[index], devices[index].ID)
+					require.Equal(t, name, devices[index].Name)
+				}
+			})
+		})
+	}
+}
+
 func TestDevicesRepository_List(t *testing.T) {
 	t.Parallel()
 
@@ -268,7 +568,7 @@ func TestDevicesRepository_List(t *testing.T) {
 					AddRow(model.NewDeviceID().String(), "Device 1", "Brand A", "available", now, now, uint(2)).
 					AddRow(model.NewDeviceID().String(), "Device 2", "Brand B", "in-use", now, now, uint(2))
 				mock.ExpectQuery(regexp.QuoteMeta(
-					`SELECT id, name, brand, state, created_at, updated_at, COUNT(*) OVER() as total_count FROM devices ORDER BY created_at DESC LIMIT 20 OFFSET 0`,
+					`SELECT id, name, brand, state, created_at, updated_at, metadata, COUNT(*) OVER() as total_count FROM devices ORDER BY created_at DESC LIMIT 20 OFFSET 0`,
 				)).
 					WillReturnRows(rows)
 			},
@@ -295,7 +595,7 @@ func TestDevicesRepository_List(t *testing.T) {
 				rows := pgxmock.NewRows([]string{"id", "name", "brand", "state", "created_at", "updated_at", "total_count"}).
 					AddRow(model.NewDeviceID().String(), "iPhone", "Apple", "available", now, now, uint(1))
 				mock.ExpectQuery(regexp.QuoteMeta(
-					`SELECT id, name, brand, state, created_at, updated_at, COUNT(*) OVER() as total_count FROM devices WHERE brand IN ($1) ORDER BY created_at DESC LIMIT 10 OFFSET 0`,
+					`SELECT id, name, brand, state, created_at, updated_at, metadata, COUNT(*) OVER() as total_count FROM devices WHERE brand IN ($1) ORDER BY created_at DESC LIMIT 10 OFFSET 0`,
 				)).
 					WithArgs("Apple").
 					WillReturnRows(rows)
@@ -315,7 +615,7 @@ func TestDevicesRepository_List(t *testing.T) {
 				rows := pgxmock.NewRows([]string{"id", "name", "brand", "state", "created_at", "updated_at", "total_count"}).
 					AddRow(model.NewDeviceID().String(), "Device", "Brand", "in-use", now, now, uint(1))
 				mock.ExpectQuery(regexp.QuoteMeta(
-					`SELECT id, name, brand, state, created_at, updated_at, COUNT(*) OVER() as total_count FROM devices WHERE state IN ($1) ORDER BY created_at DESC LIMIT 10 OFFSET 0`,
+					`SELECT id, name, brand, state, created_at, updated_at, metadata, COUNT(*) OVER() as total_count FROM devices WHERE state IN ($1) ORDER BY created_at DESC LIMIT 10 OFFSET 0`,
 				)).
 					WithArgs("in-use").
 					WillReturnRows(rows)
@@ -336,7 +636,7 @@ func TestDevicesRepository_List(t *testing.T) {
 				rows := pgxmock.NewRows([]string{"id", "name", "brand", "state", "created_at", "updated_at", "total_count"}).
 					AddRow(model.NewDeviceID().String(), "iPhone", "Apple", "available", now, now, uint(1))
 				mock.ExpectQuery(regexp.QuoteMeta(
-					`SELECT id, name, brand, state, created_at, updated_at, COUNT(*) OVER() as total_count FROM devices WHERE (brand IN ($1) AND state IN ($2)) ORDER BY created_at DESC LIMIT 10 OFFSET 0`,
+					`SELECT id, name, brand, state, created_at, updated_at, metadata, COUNT(*) OVER() as total_count FROM devices WHERE (brand IN ($1) AND state IN ($2)) ORDER BY created_at DESC LIMIT 10 OFFSET 0`,
 				)).
 					WithArgs("Apple", "available").
 					WillReturnRows(rows)
@@ -357,7 +657,7 @@ func TestDevicesRepository_List(t *testing.T) {
 					AddRow(model.NewDeviceID().String(), "iPhone", "Apple", "available", now, now, uint(2)).
 					AddRow(model.NewDeviceID().String(), "Galaxy", "Samsung", "available", now, now, uint(2))
 				mock.ExpectQuery(regexp.QuoteMeta(
-					`SELECT id, name, brand, state, created_at, updated_at, COUNT(*) OVER() as total_count FROM devices WHERE brand IN ($1,$2) ORDER BY created_at DESC LIMIT 10 OFFSET 0`,
+					`SELECT id, name, brand, state, created_at, updated_at, metadata, COUNT(*) OVER() as total_count FROM devices WHERE brand IN ($1,$2) ORDER BY created_at DESC LIMIT 10 OFFSET 0`,
 				)).
 					WithArgs("Apple", "Samsung").
 					WillReturnRows(rows)
@@ -386,7 +686,7 @@ func TestDevicesRepository_List(t *testing.T) {
 					AddRow(model.NewDeviceID().String(), "Device 1", "Brand", "available", now, now, uint(2)).
 					AddRow(model.NewDeviceID().String(), "Device 2", "Brand", "inactive", now, now, uint(2))
 				mock.ExpectQuery(regexp.QuoteMeta(
-					`SELECT id, name, brand, state, created_at, updated_at, COUNT(*) OVER() as total_count FROM devices WHERE state IN ($1,$2) ORDER BY created_at DESC LIMIT 10 OFFSET 0`,
+					`SELECT id, name, brand, state, created_at, updated_at, metadata, COUNT(*) OVER() as total_count FROM devices WHERE state IN ($1,$2) ORDER BY created_at DESC LIMIT 10 OFFSET 0`,
 				)).
 					WithArgs("available", "inactive").
 					WillReturnRows(rows)
@@ -416,7 +716,7 @@ func TestDevicesRepository_List(t *testing.T) {
 					AddRow(model.NewDeviceID().String(), "iPhone", "Apple", "available", now, now, uint(2)).
 					AddRow(model.NewDeviceID().String(), "Galaxy", "Samsung", "available", now, now, uint(2))
 				mock.ExpectQuery(regexp.QuoteMeta(
-					`SELECT id, name, brand, state, created_at, updated_at, COUNT(*) OVER() as total_count FROM devices WHERE (brand IN ($1,$2) AND state IN ($3)) ORDER BY created_at DESC LIMIT 10 OFFSET 0`,
+					`SELECT id, name, brand, state, created_at, updated_at, metadata, COUNT(*) OVER() as total_count FROM devices WHERE (brand IN ($1,$2) AND state IN ($3)) ORDER BY created_at DESC LIMIT 10 OFFSET 0`,
 				)).
 					WithArgs("Apple", "Samsung", "available").
 					WillReturnRows(rows)
@@ -442,7 +742,7 @@ func TestDevicesRepository_List(t *testing.T) {
 					AddRow(model.NewDeviceID().String(), "Alpha", "Brand", "available", now, now, uint(2)).
 					AddRow(model.NewDeviceID().String(), "Bravo", "Brand", "available", now, now, uint(2))
 				mock.ExpectQuery(regexp.QuoteMeta(
-					`SELECT id, name, brand, state, created_at, updated_at, COUNT(*) OVER() as total_count FROM devices ORDER BY name ASC LIMIT 10 OFFSET 0`,
+					`SELECT id, name, brand, state, created_at, updated_at, metadata, COUNT(*) OVER() as total_count FROM devices ORDER BY name ASC LIMIT 10 OFFSET 0`,
 				)).
 					WillReturnRows(rows)
 			},
@@ -465,7 +765,7 @@ func TestDevicesRepository_List(t *testing.T) {
 					AddRow(model.NewDeviceID().String(), "Zulu", "Brand", "available", now, now, uint(2)).
 					AddRow(model.NewDeviceID().String(), "Alpha", "Brand", "available", now, now, uint(2))
 				mock.ExpectQuery(regexp.QuoteMeta(
-					`SELECT id, name, brand, state, created_at, updated_at, COUNT(*) OVER() as total_count FROM devices ORDER BY name DESC LIMIT 10 OFFSET 0`,
+					`SELECT id, name, brand, state, created_at, updated_at, metadata, COUNT(*) OVER() as total_count FROM devices ORDER BY name DESC LIMIT 10 OFFSET 0`,
 				)).
 					WillReturnRows(rows)
 			},
@@ -488,7 +788,7 @@ func TestDevicesRepository_List(t *testing.T) {
 					AddRow(model.NewDeviceID().String(), "Device", "Samsung", "available", now, now, uint(2)).
 					AddRow(model.NewDeviceID().String(), "Device", "Apple", "available", now, now, uint(2))
 				mock.ExpectQuery(regexp.QuoteMeta(
-					`SELECT id, name, brand, state, created_at, updated_at, COUNT(*) OVER() as total_count FROM devices ORDER BY brand DESC LIMIT 10 OFFSET 0`,
+					`SELECT id, name, brand, state, created_at, updated_at, metadata, COUNT(*) OVER() as total_count FROM devices ORDER BY brand DESC LIMIT 10 OFFSET 0`,
 				)).
 					WillReturnRows(rows)
 			},
@@ -511,7 +811,7 @@ func TestDevicesRepository_List(t *testing.T) {
 					AddRow(model.NewDeviceID().String(), "Device", "Brand", "inactive", now, now, uint(2)).
 					AddRow(model.NewDeviceID().String(), "Device", "Brand", "available", now, now, uint(2))
 				mock.ExpectQuery(regexp.QuoteMeta(
-					`SELECT id, name, brand, state, created_at, updated_at, COUNT(*) OVER() as total_count FROM devices ORDER BY state DESC LIMIT 10 OFFSET 0`,
+					`SELECT id, name, brand, state, created_at, updated_at, metadata, COUNT(*) OVER() as total_count FROM devices ORDER BY state DESC LIMIT 10 OFFSET 0`,
 				)).
 					WillReturnRows(rows)
 			},
@@ -536,7 +836,7 @@ func TestDevicesRepository_List(t *testing.T) {
 					AddRow(model.NewDeviceID().String(), "Old Device", "Brand", "available", now, oldTime, uint(2)).
 					AddRow(model.NewDeviceID().String(), "New Device", "Brand", "available", now, newTime, uint(2))
 				mock.ExpectQuery(regexp.QuoteMeta(
-					`SELECT id, name, brand, state, created_at, updated_at, COUNT(*) OVER() as total_count FROM devices ORDER BY updated_at ASC LIMIT 10 OFFSET 0`,
+					`SELECT id, name, brand, state, created_at, updated_at, metadata, COUNT(*) OVER() as total_count FROM devices ORDER BY updated_at ASC LIMIT 10 OFFSET 0`,
 				)).
 					WillReturnRows(rows)
 			},
@@ -561,7 +861,7 @@ func TestDevicesRepository_List(t *testing.T) {
 					AddRow(model.NewDeviceID().String(), "New Device", "Brand", "available", now, newTime, uint(2)).
 					AddRow(model.NewDeviceID().String(), "Old Device", "Brand", "available", now, oldTime, uint(2))
 				mock.ExpectQuery(regexp.QuoteMeta(
-					`SELECT id, name, brand, state, created_at, updated_at, COUNT(*) OVER() as total_count FROM devices ORDER BY updated_at DESC LIMIT 10 OFFSET 0`,
+					`SELECT id, name, brand, state, created_at, updated_at, metadata, COUNT(*) OVER() as total_count FROM devices ORDER BY updated_at DESC LIMIT 10 OFFSET 0`,
 				)).
 					WillReturnRows(rows)
 			},
@@ -586,7 +886,7 @@ func TestDevicesRepository_List(t *testing.T) {
 					AddRow(model.NewDeviceID().String(), "First", "Brand", "available", oldCreated, now, uint(2)).
 					AddRow(model.NewDeviceID().String(), "Second", "Brand", "available", newCreated, now, uint(2))
 				mock.ExpectQuery(regexp.QuoteMeta(
-					`SELECT id, name, brand, state, created_at, updated_at, COUNT(*) OVER() as total_count FROM devices ORDER BY created_at ASC LIMIT 10 OFFSET 0`,
+					`SELECT id, name, brand, state, created_at, updated_at, metadata, COUNT(*) OVER() as total_count FROM devices ORDER BY created_at ASC LIMIT 10 OFFSET 0`,
 				)).
 					WillReturnRows(rows)
 			},
@@ -611,7 +911,7 @@ func TestDevicesRepository_List(t *testing.T) {
 					AddRow(model.NewDeviceID().String(), "Second", "Brand", "available", newCreated, now, uint(2)).
 					AddRow(model.NewDeviceID().String(), "First", "Brand", "available", oldCreated, now, uint(2))
 				mock.ExpectQuery(regexp.QuoteMeta(
-					`SELECT id, name, brand, state, created_at, updated_at, COUNT(*) OVER() as total_count FROM devices ORDER BY created_at DESC LIMIT 10 OFFSET 0`,
+					`SELECT id, name, brand, state, created_at, updated_at, metadata, COUNT(*) OVER() as total_count FROM devices ORDER BY created_at DESC LIMIT 10 OFFSET 0`,
 				)).
 					WillReturnRows(rows)
 			},
@@ -635,7 +935,7 @@ func TestDevicesRepository_List(t *testing.T) {
 					AddRow(model.NewDeviceID().String(), "Device 1", "Apple", "available", now, now, uint(2)).
 					AddRow(model.NewDeviceID().String(), "Device 2", "Samsung", "available", now, now, uint(2))
 				mock.ExpectQuery(regexp.QuoteMeta(
-					`SELECT id, name, brand, state, created_at, updated_at, COUNT(*) OVER() as total_count FROM devices ORDER BY created_at DESC LIMIT 10 OFFSET 0`,
+					`SELECT id, name, brand, state, created_at, updated_at, metadata, COUNT(*) OVER() as total_count FROM devices ORDER BY created_at DESC LIMIT 10 OFFSET 0`,
 				)).
 					WillReturnRows(rows)
 			},
@@ -657,7 +957,7 @@ func TestDevicesRepository_List(t *testing.T) {
 				rows := pgxmock.NewRows([]string{"id", "name", "brand", "state", "created_at", "updated_at", "total_count"}).
 					AddRow(model.NewDeviceID().String(), "Device 11", "Brand", "available", now, now, uint(25))
 				mock.ExpectQuery(regexp.QuoteMeta(
-					`SELECT id, name, brand, state, created_at, updated_at, COUNT(*) OVER() as total_count FROM devices ORDER BY created_at DESC LIMIT 10 OFFSET 10`,
+					`SELECT id, name, brand, state, created_at, updated_at, metadata, COUNT(*) OVER() as total_count FROM devices ORDER BY created_at DESC LIMIT 10 OFFSET 10`,
 				)).
 					WillReturnRows(rows)
 			},
@@ -682,7 +982,7 @@ func TestDevicesRepository_List(t *testing.T) {
 			setupMock: func(mock pgxmock.PgxPoolIface) {
 				rows := pgxmock.NewRows([]string{"id", "name", "brand", "state", "created_at", "updated_at", "total_count"})
 				mock.ExpectQuery(regexp.QuoteMeta(
-					`SELECT id, name, brand, state, created_at, updated_at, COUNT(*) OVER() as total_count FROM devices WHERE brand IN ($1) ORDER BY created_at DESC LIMIT 10 OFFSET 0`,
+					`SELECT id, name, brand, state, created_at, updated_at, metadata, COUNT(*) OVER() as total_count FROM devices WHERE brand IN ($1) ORDER BY created_at DESC LIMIT 10 OFFSET 0`,
 				)).
 					WithArgs("NonExistent").
 					WillReturnRows(rows)
@@ -701,7 +1001,7 @@ func TestDevicesRepository_List(t *testing.T) {
 			filter: model.DefaultDeviceFilter(),
 			setupMock: func(mock pgxmock.PgxPoolIface) {
 				mock.ExpectQuery(regexp.QuoteMeta(
-					`SELECT id, name, brand, state, created_at, updated_at, COUNT(*) OVER() as total_count FROM devices ORDER BY created_at DESC LIMIT 20 OFFSET 0`,
+					`SELECT id, name, brand, state, created_at, updated_at, metadata, COUNT(*) OVER() as total_count FROM devices ORDER BY created_at DESC LIMIT 20 OFFSET 0`,
 				)).
 					WillReturnError(errors.New("connection error"))
 			},
@@ -752,13 +1052,23 @@ func TestDevicesRepository_Update(t *testing.T) {
 				Brand:     "Updated Brand",
 				State:     model.StateInUse,
 				UpdatedAt: now,
+				Metadata:  map[string]any{"color": "black"},
 			},
 			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectBegin()
 				mock.ExpectExec(regexp.QuoteMeta(
-					`UPDATE devices SET name = $1, brand = $2, state = $3, updated_at = $4 WHERE id = $5`,
+					`UPDATE devices SET name = $1, brand = $2, state = $3, updated_at = $4, metadata = $5 WHERE id = $6`,
 				)).
-					WithArgs("Updated Name", "Updated Brand", "in-use", now, testID.String()).
+					WithArgs("Updated Name", "Updated Brand", "in-use", now, map[string]any{"color": "black"}, testID.String()).
 					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+				expectHistoryInsert(mock, model.HistoryOperationUpdate, nil, &model.Device{
+					ID:    testID,
+					Name:  "Updated Name",
+					Brand: "Updated Brand",
+					State: model.StateInUse,
+				}).
+					WillReturnResult(pgxmock.NewResult("INSERT", 1))
+				mock.ExpectCommit()
 			},
 			expectError: false,
 		},
@@ -772,11 +1082,13 @@ func TestDevicesRepository_Update(t *testing.T) {
 				UpdatedAt: now,
 			},
 			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectBegin()
 				mock.ExpectExec(regexp.QuoteMeta(
-					`UPDATE devices SET name = $1, brand = $2, state = $3, updated_at = $4 WHERE id = $5`,
+					`UPDATE devices SET name = $1, brand = $2, state = $3, updated_at = $4, metadata = $5 WHERE id = $6`,
 				)).
-					WithArgs("Updated Name", "Updated Brand", "available", now, testID.String()).
+					WithArgs("Updated Name", "Updated Brand", "available", now, map[string]any{}, testID.String()).
 					WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+				mock.ExpectRollback()
 			},
 			expectError: true,
 			expectedErr: model.ErrDeviceNotFound,
@@ -791,13 +1103,30 @@ func TestDevicesRepository_Update(t *testing.T) {
 				UpdatedAt: now,
 			},
 			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectBegin()
 				mock.ExpectExec(regexp.QuoteMeta(
-					`UPDATE devices SET name = $1, brand = $2, state = $3, updated_at = $4 WHERE id = $5`,
+					`UPDATE devices SET name = $1, brand = $2, state = $3, updated_at = $4, metadata = $5 WHERE id = $6`,
 				)).
-					WithArgs("Updated Name", "Updated Brand", "available", now, testID.String()).
+					WithArgs("Updated Name", "Updated Brand", "available", now, map[string]any{}, testID.String()).
 					WillReturnError(errors.New("connection error"))
+				mock.ExpectRollback()
+			},
+			expectError: true,
+		},
+		{
+			name: "failing to begin the transaction returns ErrDatabaseConnection",
+			device: &model.Device{
+				ID:        testID,
+				Name:      "Updated Name",
+				Brand:     "Updated Brand",
+				State:     model.StateAvailable,
+				UpdatedAt: now,
+			},
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectBegin().WillReturnError(errors.New("connection refused"))
 			},
 			expectError: true,
+			expectedErr: model.ErrDatabaseConnection,
 		},
 	}
 
@@ -820,130 +1149,540 @@ func TestDevicesRepository_Update(t *testing.T) {
 	}
 }
 
-func TestDevicesRepository_Delete(t *testing.T) {
+func TestDevicesRepository_UpdateIfUnmodified(t *testing.T) {
 	t.Parallel()
 
+	now := time.Now().UTC()
+	lastUpdatedAt := now.Add(-time.Minute)
 	testID := model.NewDeviceID()
 
+	device := &model.Device{
+		ID:        testID,
+		Name:      "Updated Name",
+		Brand:     "Updated Brand",
+		State:     model.StateInUse,
+		UpdatedAt: now,
+	}
+
+	const updateQuery = `UPDATE devices SET name = $1, brand = $2, state = $3, updated_at = $4, metadata = $5 WHERE id = $6 AND updated_at = $7`
+
 	cases := []struct {
 		name        string
-		deviceID    model.DeviceID
 		setupMock   func(mock pgxmock.PgxPoolIface)
-		expectError bool
 		expectedErr error
 	}{
 		{
-			name:     "successfully delete device",
-			deviceID: testID,
-			setupMock: func(mock pgxmock.PgxPoolIface) {
-				mock.ExpectExec(regexp.QuoteMeta(
-					`DELETE FROM devices WHERE id = $1`,
-				)).
-					WithArgs(testID.String()).
-					WillReturnResult(pgxmock.NewResult("DELETE", 1))
-			},
-			expectError: false,
-		},
-		{
-			name:     "delete nonexistent device returns ErrDeviceNotFound",
-			deviceID: testID,
+			name: "updates a device unmodified since it was read",
 			setupMock: func(mock pgxmock.PgxPoolIface) {
-				mock.ExpectExec(regexp.QuoteMeta(
-					`DELETE FROM devices WHERE id = $1`,
-				)).
-					WithArgs(testID.String()).
-					WillReturnResult(pgxmock.NewResult("DELETE", 0))
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(updateQuery)).
+					WithArgs("Updated Name", "Updated Brand", "in-use", now, map[string]any{}, testID.String(), lastUpdatedAt).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+				expectHistoryInsert(mock, model.HistoryOperationUpdate, nil, &model.Device{
+					ID:    testID,
+					Name:  "Updated Name",
+					Brand: "Updated Brand",
+					State: model.StateInUse,
+				}).
+					WillReturnResult(pgxmock.NewResult("INSERT", 1))
+				mock.ExpectCommit()
 			},
-			expectError: true,
-			expectedErr: model.ErrDeviceNotFound,
 		},
 		{
-			name:     "database error returns wrapped ErrDatabaseQuery",
-			deviceID: testID,
+			name: "device modified since it was read returns ErrDeviceModified",
 			setupMock: func(mock pgxmock.PgxPoolIface) {
-				mock.ExpectExec(regexp.QuoteMeta(
-					`DELETE FROM devices WHERE id = $1`,
-				)).
-					WithArgs(testID.String()).
-					WillReturnError(errors.New("connection error"))
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(updateQuery)).
+					WithArgs("Updated Name", "Updated Brand", "in-use", now, map[string]any{}, testID.String(), lastUpdatedAt).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+				mock.ExpectRollback()
 			},
-			expectError: true,
-			expectedErr: model.ErrDatabaseQuery,
+			expectedErr: model.ErrDeviceModified,
 		},
 	}
 
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			runRepoTest(t, tc.setupMock, func(t *testing.T, repo *repos.DevicesRepository) {
-				err := repo.Delete(t.Context(), tc.deviceID)
+				err := repo.UpdateIfUnmodified(t.Context(), device, lastUpdatedAt)
 
-				if tc.expectError {
-					require.Error(t, err)
-					if tc.expectedErr != nil {
-						require.ErrorIs(t, err, tc.expectedErr)
-					}
+				if tc.expectedErr != nil {
+					require.ErrorIs(t, err, tc.expectedErr)
 
 					return
 				}
+
 				require.NoError(t, err)
 			})
 		})
 	}
 }
 
-func TestDevicesRepository_Ping(t *testing.T) {
+func TestDevicesRepository_UpdateBatch(t *testing.T) {
 	t.Parallel()
 
+	now := time.Now().UTC()
+	devices := []*model.Device{
+		{ID: model.NewDeviceID(), Name: "iPhone", Brand: "Apple", State: model.StateInUse, UpdatedAt: now},
+		{ID: model.NewDeviceID(), Name: "Pixel", Brand: "Google", State: model.StateInUse, UpdatedAt: now},
+		{ID: model.NewDeviceID(), Name: "Galaxy", Brand: "Samsung", State: model.StateInUse, UpdatedAt: now},
+	}
+
+	expectUpdate := func(mock pgxmock.PgxPoolIface, device *model.Device) *pgxmock.ExpectedExec {
+		return mock.ExpectExec(regexp.QuoteMeta(
+			`UPDATE devices SET name = $1, brand = $2, state = $3, updated_at = $4, metadata = $5 WHERE id = $6`,
+		)).WithArgs(device.Name, device.Brand, "in-use", now, map[string]any{}, device.ID.String())
+	}
+
+	renamed := &model.Device{ID: devices[0].ID, Name: "iPhone 15", Brand: "Apple", State: model.StateInUse, UpdatedAt: now}
+
+	updated := pgxmock.NewResult("UPDATE", 1)
+	connectionErr := errors.New("conn closed")
+
 	cases := []struct {
-		name        string
-		setupMock   func(mock pgxmock.PgxPoolIface)
-		expectError bool
+		name         string
+		updates      []*model.Device
+		setupMock    func(mock pgxmock.PgxPoolIface)
+		expectedErrs []error
 	}{
 		{
-			name: "ping successful",
+			name:    "all updates are committed",
+			updates: devices,
 			setupMock: func(mock pgxmock.PgxPoolIface) {
-				mock.ExpectPing()
+				mock.ExpectBegin()
+				for _, device := range devices {
+					expectUpdate(mock, device).WillReturnResult(updated)
+				}
+				expectHistoryInsert(mock, model.HistoryOperationUpdate, nil, devices...).
+					WillReturnResult(pgxmock.NewResult("INSERT", 3))
+				mock.ExpectCommit()
 			},
-			expectError: false,
+			expectedErrs: []error{nil, nil, nil},
 		},
 		{
-			name: "ping failed",
+			name:    "failed snapshot rolls back the batch",
+			updates: devices,
 			setupMock: func(mock pgxmock.PgxPoolIface) {
-				mock.ExpectPing().WillReturnError(errors.New("connection error"))
+				mock.ExpectBegin()
+				for _, device := range devices {
+					expectUpdate(mock, device).WillReturnResult(updated)
+				}
+				expectHistoryInsert(mock, model.HistoryOperationUpdate, nil, devices...).
+					WillReturnError(connectionErr)
+				mock.ExpectRollback()
 			},
-			expectError: true,
+			expectedErrs: []error{model.ErrDatabaseQuery, model.ErrDatabaseQuery, model.ErrDatabaseQuery},
 		},
-	}
-
-	for _, tc := range cases {
-		t.Run(tc.name, func(t *testing.T) {
-			runRepoTest(t, tc.setupMock, func(t *testing.T, repo *repos.DevicesRepository) {
-				err := repo.Ping(t.Context())
-
-				if tc.expectError {
-					require.Error(t, err)
-
-					return
-				}
-				require.NoError(t, err)
-			})
-		})
-	}
-}
-
-func TestDevicesRepository_List_WithFullTextSearch(t *testing.T) {
-	t.Parallel()
-
-	now := time.Now().UTC()
-
-	cases := []struct {
-		name          string
-		filter        model.DeviceFilter
-		setupMock     func(mock pgxmock.PgxPoolIface)
-		expectError   bool
-		expectedCount int
-		validateList  func(*testing.T, *model.DeviceList)
-	}{
+		{
+			name:    "device listed twice takes consecutive history versions",
+			updates: []*model.Device{devices[0], renamed},
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectBegin()
+				expectUpdate(mock, devices[0]).WillReturnResult(updated)
+				expectUpdate(mock, renamed).WillReturnResult(updated)
+				mock.ExpectExec(regexp.QuoteMeta(historyInsertQuery(2))).
+					WithArgs(
+						pgxmock.AnyArg(), devices[0].ID.String(), "iPhone", "Apple", "in-use", "update",
+						1, devices[0].ID.String(), pgxmock.AnyArg(), (*string)(nil),
+						pgxmock.AnyArg(), devices[0].ID.String(), "iPhone 15", "Apple", "in-use", "update",
+						2, devices[0].ID.String(), pgxmock.AnyArg(), (*string)(nil),
+					).
+					WillReturnResult(pgxmock.NewResult("INSERT", 2))
+				mock.ExpectCommit()
+			},
+			expectedErrs: []error{nil, nil},
+		},
+		{
+			name:         "empty batch opens no transaction",
+			updates:      nil,
+			setupMock:    func(pgxmock.PgxPoolIface) {},
+			expectedErrs: []error{},
+		},
+		{
+			name:    "missing device rolls back the batch",
+			updates: devices,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectBegin()
+				expectUpdate(mock, devices[0]).WillReturnResult(updated)
+				expectUpdate(mock, devices[1]).WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+				mock.ExpectRollback()
+			},
+			expectedErrs: []error{nil, model.ErrDeviceNotFound, model.ErrBatchAborted},
+		},
+		{
+			name:    "statement error rolls back the batch",
+			updates: devices,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectBegin()
+				expectUpdate(mock, devices[0]).WillReturnResult(updated)
+				expectUpdate(mock, devices[1]).WillReturnError(&pgconn.PgError{Severity: "ERROR", Code: "23514"})
+				mock.ExpectRollback()
+			},
+			expectedErrs: []error{nil, &pgconn.PgError{Severity: "ERROR", Code: "23514"}, model.ErrBatchAborted},
+		},
+		{
+			name:    "dropped connection fails every device",
+			updates: devices,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectBegin()
+				expectUpdate(mock, devices[0]).WillReturnResult(updated)
+				expectUpdate(mock, devices[1]).WillReturnError(connectionErr)
+				mock.ExpectRollback().WillReturnError(connectionErr)
+			},
+			expectedErrs: []error{model.ErrDatabaseConnection, model.ErrDatabaseConnection, model.ErrDatabaseConnection},
+		},
+		{
+			name:    "terminated backend fails every device",
+			updates: devices,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectBegin()
+				expectUpdate(mock, devices[0]).WillReturnError(&pgconn.PgError{Severity: "FATAL", Code: "57P01"})
+				mock.ExpectRollback()
+			},
+			expectedErrs: []error{model.ErrDatabaseConnection, model.ErrDatabaseConnection, model.ErrDatabaseConnection},
+		},
+		{
+			name:    "failed begin fails every device",
+			updates: devices,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectBegin().WillReturnError(connectionErr)
+			},
+			expectedErrs: []error{model.ErrDatabaseConnection, model.ErrDatabaseConnection, model.ErrDatabaseConnection},
+		},
+		{
+			name:    "failed commit fails every device",
+			updates: devices,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectBegin()
+				for _, device := range devices {
+					expectUpdate(mock, device).WillReturnResult(updated)
+				}
+				expectHistoryInsert(mock, model.HistoryOperationUpdate, nil, devices...).
+					WillReturnResult(pgxmock.NewResult("INSERT", 3))
+				mock.ExpectCommit().WillReturnError(connectionErr)
+			},
+			expectedErrs: []error{model.ErrDatabaseConnection, model.ErrDatabaseConnection, model.ErrDatabaseConnection},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			runRepoTest(t, tc.setupMock, func(t *testing.T, repo *repos.DevicesRepository) {
+				errs := repo.UpdateBatch(t.Context(), tc.updates)
+
+				require.Len(t, errs, len(tc.expectedErrs))
+
+				for index, expected := range tc.expectedErrs {
+					if expected == nil {
+						require.NoError(t, errs[index], "device %d", index)
+
+						continue
+					}
+
+					var pgErr *pgconn.PgError
+					if errors.As(expected, &pgErr) {
+						require.ErrorAs(t, errs[index], &pgErr, "device %d", index)
+
+						continue
+					}
+
+					require.ErrorIs(t, errs[index], expected, "device %d", index)
+				}
+			})
+		})
+	}
+}
+
+func TestDevicesRepository_Delete(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+	testID := model.NewDeviceID()
+	columns := []string{"id", "name", "brand", "state", "created_at", "updated_at"}
+	deleteQuery := regexp.QuoteMeta(
+		`DELETE FROM devices WHERE id = $1 RETURNING id, name, brand, state, created_at, updated_at, metadata`,
+	)
+
+	cases := []struct {
+		name        string
+		deviceID    model.DeviceID
+		setupMock   func(mock pgxmock.PgxPoolIface)
+		expectError bool
+		expectedErr error
+	}{
+		{
+			name:     "successfully delete device",
+			deviceID: testID,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(deleteQuery).
+					WithArgs(testID.String()).
+					WillReturnRows(pgxmock.NewRows(columns).
+						AddRow(testID.String(), "iPhone", "Apple", "available", now, now))
+				expectHistoryInsert(mock, model.HistoryOperationDelete, nil, &model.Device{
+					ID:    testID,
+					Name:  "iPhone",
+					Brand: "Apple",
+					State: model.StateAvailable,
+				}).
+					WillReturnResult(pgxmock.NewResult("INSERT", 1))
+				mock.ExpectCommit()
+			},
+			expectError: false,
+		},
+		{
+			name:     "delete nonexistent device returns ErrDeviceNotFound",
+			deviceID: testID,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(deleteQuery).
+					WithArgs(testID.String()).
+					WillReturnRows(pgxmock.NewRows(columns))
+				mock.ExpectRollback()
+			},
+			expectError: true,
+			expectedErr: model.ErrDeviceNotFound,
+		},
+		{
+			name:     "database error returns wrapped ErrDatabaseQuery",
+			deviceID: testID,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(deleteQuery).
+					WithArgs(testID.String()).
+					WillReturnError(errors.New("connection error"))
+				mock.ExpectRollback()
+			},
+			expectError: true,
+			expectedErr: model.ErrDatabaseQuery,
+		},
+		{
+			name:     "failed snapshot keeps the device",
+			deviceID: testID,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(deleteQuery).
+					WithArgs(testID.String()).
+					WillReturnRows(pgxmock.NewRows(columns).
+						AddRow(testID.String(), "iPhone", "Apple", "available", now, now))
+				expectHistoryInsert(mock, model.HistoryOperationDelete, nil, &model.Device{
+					ID:    testID,
+					Name:  "iPhone",
+					Brand: "Apple",
+					State: model.StateAvailable,
+				}).
+					WillReturnError(errors.New("connection error"))
+				mock.ExpectRollback()
+			},
+			expectError: true,
+			expectedErr: model.ErrDatabaseQuery,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			runRepoTest(t, tc.setupMock, func(t *testing.T, repo *repos.DevicesRepository) {
+				err := repo.Delete(t.Context(), tc.deviceID)
+
+				if tc.expectError {
+					require.Error(t, err)
+					if tc.expectedErr != nil {
+						require.ErrorIs(t, err, tc.expectedErr)
+					}
+
+					return
+				}
+				require.NoError(t, err)
+			})
+		})
+	}
+}
+
+func TestDevicesRepository_RecoverStale(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+	staleBefore := now.Add(-30 * time.Minute)
+
+	columns := []string{"id", "name", "brand", "state", "created_at", "updated_at"}
+	query := regexp.QuoteMeta(
+		`UPDATE devices SET state = $1, updated_at = $2 WHERE id IN ` +
+			`(SELECT id FROM devices WHERE state = $3 AND updated_at < $4 ORDER BY updated_at LIMIT 2 FOR UPDATE SKIP LOCKED) ` +
+			`RETURNING id, name, brand, state, created_at, updated_at, metadata`,
+	)
+	unlimitedQuery := regexp.QuoteMeta(
+		`UPDATE devices SET state = $1, updated_at = $2 WHERE id IN ` +
+			`(SELECT id FROM devices WHERE state = $3 AND updated_at < $4 ORDER BY updated_at FOR UPDATE SKIP LOCKED) ` +
+			`RETURNING id, name, brand, state, created_at, updated_at, metadata`,
+	)
+
+	first, second := model.NewDeviceID(), model.NewDeviceID()
+
+	cases := []struct {
+		name        string
+		limit       uint
+		setupMock   func(mock pgxmock.PgxPoolIface)
+		expectError bool
+		expectedIDs []model.DeviceID
+	}{
+		{
+			name:  "returns the recovered devices",
+			limit: 2,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				rows := pgxmock.NewRows(columns).
+					AddRow(first.String(), "first", "Apple", "available", now, now).
+					AddRow(second.String(), "second", "Google", "available", now, now)
+				mock.ExpectBegin()
+				mock.ExpectQuery(query).
+					WithArgs("available", now, "in-use", staleBefore).
+					WillReturnRows(rows)
+				expectHistoryInsert(mock, model.HistoryOperationRecover, nil,
+					&model.Device{ID: first, Name: "first", Brand: "Apple", State: model.StateAvailable},
+					&model.Device{ID: second, Name: "second", Brand: "Google", State: model.StateAvailable},
+				).
+					WillReturnResult(pgxmock.NewResult("INSERT", 2))
+				mock.ExpectCommit()
+			},
+			expectedIDs: []model.DeviceID{first, second},
+		},
+		{
+			name:  "no stale devices",
+			limit: 2,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(query).
+					WithArgs("available", now, "in-use", staleBefore).
+					WillReturnRows(pgxmock.NewRows(columns))
+				mock.ExpectCommit()
+			},
+			expectedIDs: []model.DeviceID{},
+		},
+		{
+			name:  "zero limit recovers every stale device",
+			limit: 0,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				rows := pgxmock.NewRows(columns).
+					AddRow(first.String(), "first", "Apple", "available", now, now)
+				mock.ExpectBegin()
+				mock.ExpectQuery(unlimitedQuery).
+					WithArgs("available", now, "in-use", staleBefore).
+					WillReturnRows(rows)
+				expectHistoryInsert(mock, model.HistoryOperationRecover, nil,
+					&model.Device{ID: first, Name: "first", Brand: "Apple", State: model.StateAvailable},
+				).
+					WillReturnResult(pgxmock.NewResult("INSERT", 1))
+				mock.ExpectCommit()
+			},
+			expectedIDs: []model.DeviceID{first},
+		},
+		{
+			name:  "query error returns error",
+			limit: 2,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(query).
+					WithArgs("available", now, "in-use", staleBefore).
+					WillReturnError(errors.New("connection error"))
+				mock.ExpectRollback()
+			},
+			expectError: true,
+		},
+		{
+			name:  "failed snapshot releases no device",
+			limit: 2,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				rows := pgxmock.NewRows(columns).
+					AddRow(first.String(), "first", "Apple", "available", now, now)
+				mock.ExpectBegin()
+				mock.ExpectQuery(query).
+					WithArgs("available", now, "in-use", staleBefore).
+					WillReturnRows(rows)
+				expectHistoryInsert(mock, model.HistoryOperationRecover, nil,
+					&model.Device{ID: first, Name: "first", Brand: "Apple", State: model.StateAvailable},
+				).
+					WillReturnError(errors.New("connection error"))
+				mock.ExpectRollback()
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			runRepoTest(t, tc.setupMock, func(t *testing.T, repo *repos.DevicesRepository) {
+				devices, err := repo.RecoverStale(t.Context(), staleBefore, now, tc.limit)
+
+				if tc.expectError {
+					require.ErrorIs(t, err, model.ErrDatabaseQuery)
+					require.Nil(t, devices)
+
+					return
+				}
+				require.NoError(t, err)
+				require.Len(t, devices, len(tc.expectedIDs))
+
+				for index, id := range tc.expectedIDs {
+					require.Equal(t, id, devices[index].ID)
+					require.Equal(t, model.StateAvailable, devices[index].State)
+				}
+			})
+		})
+	}
+}
+
+func TestDevicesRepository_Ping(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name        string
+		setupMock   func(mock pgxmock.PgxPoolIface)
+		expectError bool
+	}{
+		{
+			name: "ping successful",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectPing()
+			},
+			expectError: false,
+		},
+		{
+			name: "ping failed",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectPing().WillReturnError(errors.New("connection error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			runRepoTest(t, tc.setupMock, func(t *testing.T, repo *repos.DevicesRepository) {
+				err := repo.Ping(t.Context())
+
+				if tc.expectError {
+					require.Error(t, err)
+
+					return
+				}
+				require.NoError(t, err)
+			})
+		})
+	}
+}
+
+func TestDevicesRepository_List_WithFullTextSearch(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+
+	cases := []struct {
+		name          string
+		filter        model.DeviceFilter
+		setupMock     func(mock pgxmock.PgxPoolIface)
+		expectError   bool
+		expectedCount int
+		validateList  func(*testing.T, *model.DeviceList)
+	}{
 		{
 			name: "search by exact name match",
 			filter: model.DeviceFilter{
@@ -957,7 +1696,7 @@ func TestDevicesRepository_List_WithFullTextSearch(t *testing.T) {
 					AddRow(model.NewDeviceID().String(), "iPhone 15 Pro", "Apple", "available", now, now, uint(2)).
 					AddRow(model.NewDeviceID().String(), "iPhone 14", "Apple", "in-use", now, now, uint(2))
 				mock.ExpectQuery(regexp.QuoteMeta(
-					`SELECT id, name, brand, state, created_at, updated_at, COUNT(*) OVER() as total_count FROM devices WHERE search_vector @@ plainto_tsquery('english', $1) ORDER BY created_at DESC LIMIT 20 OFFSET 0`,
+					`SELECT id, name, brand, state, created_at, updated_at, metadata, COUNT(*) OVER() as total_count FROM devices WHERE search_vector @@ plainto_tsquery('english', $1) ORDER BY created_at DESC LIMIT 20 OFFSET 0`,
 				)).
 					WithArgs("iPhone").
 					WillReturnRows(rows)
@@ -982,7 +1721,7 @@ func TestDevicesRepository_List_WithFullTextSearch(t *testing.T) {
 				rows := pgxmock.NewRows([]string{"id", "name", "brand", "state", "created_at", "updated_at", "total_count"}).
 					AddRow(model.NewDeviceID().String(), "Galaxy S24", "Samsung", "available", now, now, uint(1))
 				mock.ExpectQuery(regexp.QuoteMeta(
-					`SELECT id, name, brand, state, created_at, updated_at, COUNT(*) OVER() as total_count FROM devices WHERE search_vector @@ plainto_tsquery('english', $1) ORDER BY created_at DESC LIMIT 20 OFFSET 0`,
+					`SELECT id, name, brand, state, created_at, updated_at, metadata, COUNT(*) OVER() as total_count FROM devices WHERE search_vector @@ plainto_tsquery('english', $1) ORDER BY created_at DESC LIMIT 20 OFFSET 0`,
 				)).
 					WithArgs("Samsung").
 					WillReturnRows(rows)
@@ -1004,7 +1743,7 @@ func TestDevicesRepository_List_WithFullTextSearch(t *testing.T) {
 			setupMock: func(mock pgxmock.PgxPoolIface) {
 				rows := pgxmock.NewRows([]string{"id", "name", "brand", "state", "created_at", "updated_at", "total_count"})
 				mock.ExpectQuery(regexp.QuoteMeta(
-					`SELECT id, name, brand, state, created_at, updated_at, COUNT(*) OVER() as total_count FROM devices WHERE search_vector @@ plainto_tsquery('english', $1) ORDER BY created_at DESC LIMIT 20 OFFSET 0`,
+					`SELECT id, name, brand, state, created_at, updated_at, metadata, COUNT(*) OVER() as total_count FROM devices WHERE search_vector @@ plainto_tsquery('english', $1) ORDER BY created_at DESC LIMIT 20 OFFSET 0`,
 				)).
 					WithArgs("nonexistent").
 					WillReturnRows(rows)
@@ -1028,7 +1767,7 @@ func TestDevicesRepository_List_WithFullTextSearch(t *testing.T) {
 				rows := pgxmock.NewRows([]string{"id", "name", "brand", "state", "created_at", "updated_at", "total_count"}).
 					AddRow(model.NewDeviceID().String(), "iPhone 15 Pro", "Apple", "available", now, now, uint(1))
 				mock.ExpectQuery(regexp.QuoteMeta(
-					`SELECT id, name, brand, state, created_at, updated_at, COUNT(*) OVER() as total_count FROM devices WHERE (search_vector @@ plainto_tsquery('english', $1) AND state IN ($2)) ORDER BY created_at DESC LIMIT 20 OFFSET 0`,
+					`SELECT id, name, brand, state, created_at, updated_at, metadata, COUNT(*) OVER() as total_count FROM devices WHERE (search_vector @@ plainto_tsquery('english', $1) AND state IN ($2)) ORDER BY created_at DESC LIMIT 20 OFFSET 0`,
 				)).
 					WithArgs("iPhone", "available").
 					WillReturnRows(rows)
@@ -1053,7 +1792,7 @@ func TestDevicesRepository_List_WithFullTextSearch(t *testing.T) {
 				rows := pgxmock.NewRows([]string{"id", "name", "brand", "state", "created_at", "updated_at", "total_count"}).
 					AddRow(model.NewDeviceID().String(), "iPhone 15 Pro", "Apple", "available", now, now, uint(1))
 				mock.ExpectQuery(regexp.QuoteMeta(
-					`SELECT id, name, brand, state, created_at, updated_at, COUNT(*) OVER() as total_count FROM devices WHERE (search_vector @@ plainto_tsquery('english', $1) AND brand IN ($2)) ORDER BY created_at DESC LIMIT 20 OFFSET 0`,
+					`SELECT id, name, brand, state, created_at, updated_at, metadata, COUNT(*) OVER() as total_count FROM devices WHERE (search_vector @@ plainto_tsquery('english', $1) AND brand IN ($2)) ORDER BY created_at DESC LIMIT 20 OFFSET 0`,
 				)).
 					WithArgs("Pro", "Apple").
 					WillReturnRows(rows)
@@ -1079,7 +1818,7 @@ func TestDevicesRepository_List_WithFullTextSearch(t *testing.T) {
 				rows := pgxmock.NewRows([]string{"id", "name", "brand", "state", "created_at", "updated_at", "total_count"}).
 					AddRow(model.NewDeviceID().String(), "Galaxy S24 Ultra", "Samsung", "available", now, now, uint(1))
 				mock.ExpectQuery(regexp.QuoteMeta(
-					`SELECT id, name, brand, state, created_at, updated_at, COUNT(*) OVER() as total_count FROM devices WHERE (search_vector @@ plainto_tsquery('english', $1) AND brand IN ($2) AND state IN ($3)) ORDER BY created_at DESC LIMIT 20 OFFSET 0`,
+					`SELECT id, name, brand, state, created_at, updated_at, metadata, COUNT(*) OVER() as total_count FROM devices WHERE (search_vector @@ plainto_tsquery('english', $1) AND brand IN ($2) AND state IN ($3)) ORDER BY created_at DESC LIMIT 20 OFFSET 0`,
 				)).
 					WithArgs("Galaxy", "Samsung", "available").
 					WillReturnRows(rows)
@@ -1105,7 +1844,7 @@ func TestDevicesRepository_List_WithFullTextSearch(t *testing.T) {
 					AddRow(model.NewDeviceID().String(), "Device 1", "Brand A", "available", now, now, uint(2)).
 					AddRow(model.NewDeviceID().String(), "Device 2", "Brand B", "in-use", now, now, uint(2))
 				mock.ExpectQuery(regexp.QuoteMeta(
-					`SELECT id, name, brand, state, created_at, updated_at, COUNT(*) OVER() as total_count FROM devices ORDER BY created_at DESC LIMIT 20 OFFSET 0`,
+					`SELECT id, name, brand, state, created_at, updated_at, metadata, COUNT(*) OVER() as total_count FROM devices ORDER BY created_at DESC LIMIT 20 OFFSET 0`,
 				)).
 					WillReturnRows(rows)
 			},
@@ -1136,6 +1875,259 @@ func TestDevicesRepository_List_WithFullTextSearch(t *testing.T) {
 	}
 }
 
+func TestDevicesRepository_Search(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+
+	columns := []string{"id", "name", "brand", "state", "created_at", "updated_at", "total_count"}
+
+	cases := []struct {
+		name          string
+		query         string
+		filter        model.DeviceFilter
+		setupMock     func(mock pgxmock.PgxPoolIface)
+		expectError   bool
+		expectedCount int
+		validateList  func(*testing.T, *model.DeviceList)
+	}{
+		{
+			name:   "ranks full-text and substring matches",
+			query:  "phone",
+			filter: model.DeviceFilter{Page: 1, Size: 20},
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				rows := pgxmock.NewRows(columns).
+					AddRow(model.NewDeviceID().String(), "Android phone", "Google", "available", now, now, uint(2)).
+					AddRow(model.NewDeviceID().String(), "iPhone", "Apple", "available", now, now, uint(2))
+				mock.ExpectQuery(regexp.QuoteMeta(
+					`SELECT id, name, brand, state, created_at, updated_at, metadata, COUNT(*) OVER() as total_count FROM devices WHERE (search_vector @@ plainto_tsquery('english', $1) OR name ILIKE $2 OR brand ILIKE $3) ORDER BY ts_rank(search_vector, plainto_tsquery('english', $4)) DESC, created_at DESC LIMIT 20 OFFSET 0`,
+				)).
+					WithArgs("phone", "%phone%", "%phone%", "phone").
+					WillReturnRows(rows)
+			},
+			expectedCount: 2,
+			validateList: func(t *testing.T, list *model.DeviceList) {
+				require.Equal(t, "Android phone", list.Devices[0].Name)
+				require.Equal(t, "iPhone", list.Devices[1].Name)
+				require.Equal(t, "phone", list.Filters.Keyword)
+			},
+		},
+		{
+			name:  "applies filters and pagination",
+			query: "phone",
+			filter: model.DeviceFilter{
+				Brands: []string{"Apple"},
+				States: []model.State{model.StateAvailable},
+				Sort:   []string{"name"},
+				Page:   2,
+				Size:   1,
+			},
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				rows := pgxmock.NewRows(columns).
+					AddRow(model.NewDeviceID().String(), "iPhone 15", "Apple", "available", now, now, uint(3))
+				mock.ExpectQuery(regexp.QuoteMeta(
+					`SELECT id, name, brand, state, created_at, updated_at, metadata, COUNT(*) OVER() as total_count FROM devices WHERE (search_vector @@ plainto_tsquery('english', $1) OR name ILIKE $2 OR brand ILIKE $3) AND (brand IN ($4) AND state IN ($5)) ORDER BY ts_rank(search_vector, plainto_tsquery('english', $6)) DESC, name ASC LIMIT 1 OFFSET 1`,
+				)).
+					WithArgs("phone", "%phone%", "%phone%", "Apple", "available", "phone").
+					WillReturnRows(rows)
+			},
+			expectedCount: 1,
+			validateList: func(t *testing.T, list *model.DeviceList) {
+				require.Equal(t, uint(2), list.Pagination.Page)
+				require.Equal(t, uint(3), list.Pagination.TotalItems)
+				require.True(t, list.Pagination.HasNext)
+				require.True(t, list.Pagination.HasPrevious)
+				require.Empty(t, list.Pagination.NextCursor, "search pages by offset only")
+			},
+		},
+		{
+			name:   "escapes LIKE wildcards in the query",
+			query:  "100%_cotton",
+			filter: model.DeviceFilter{Page: 1, Size: 20},
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(regexp.QuoteMeta(`FROM devices WHERE (search_vector`)).
+					WithArgs("100%_cotton", `%100\%\_cotton%`, `%100\%\_cotton%`, "100%_cotton").
+					WillReturnRows(pgxmock.NewRows(columns))
+			},
+			expectedCount: 0,
+		},
+		{
+			name:   "blank query lists devices",
+			query:  "  ",
+			filter: model.DeviceFilter{Page: 1, Size: 20},
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(regexp.QuoteMeta(
+					`SELECT id, name, brand, state, created_at, updated_at, metadata, COUNT(*) OVER() as total_count FROM devices ORDER BY created_at DESC LIMIT 20 OFFSET 0`,
+				)).
+					WillReturnRows(pgxmock.NewRows(columns))
+			},
+			expectedCount: 0,
+		},
+		{
+			name:   "query error returns error",
+			query:  "phone",
+			filter: model.DeviceFilter{Page: 1, Size: 20},
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(regexp.QuoteMeta(`FROM devices WHERE (search_vector`)).
+					WithArgs("phone", "%phone%", "%phone%", "phone").
+					WillReturnError(errors.New("connection error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			runRepoTest(t, tc.setupMock, func(t *testing.T, repo *repos.DevicesRepository) {
+				list, err := repo.Search(t.Context(), tc.query, tc.filter)
+
+				if tc.expectError {
+					require.ErrorIs(t, err, model.ErrDatabaseQuery)
+					require.Nil(t, list)
+
+					return
+				}
+				require.NoError(t, err)
+				require.NotNil(t, list)
+				require.Len(t, list.Devices, tc.expectedCount)
+				if tc.validateList != nil {
+					tc.validateList(t, list)
+				}
+			})
+		})
+	}
+}
+
+func TestDevicesRepository_List_Cursor(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	codec := model.NewCursorCodec([]byte("test-secret"))
+	lastID := model.NewDeviceID()
+
+	issued, err := codec.Encode(model.Cursor{
+		Field:     "-createdAt",
+		Value:     now.Format(time.RFC3339Nano),
+		ID:        lastID.String(),
+		Direction: model.CursorDirectionNext,
+	})
+	require.NoError(t, err)
+
+	otherSort, err := codec.Encode(model.Cursor{Field: "name", Value: "iPhone", ID: lastID.String(), Direction: model.CursorDirectionNext})
+	require.NoError(t, err)
+
+	foreign, err := model.NewCursorCodec([]byte("other-secret")).Encode(model.Cursor{
+		Field:     "-createdAt",
+		Value:     now.Format(time.RFC3339Nano),
+		ID:        lastID.String(),
+		Direction: model.CursorDirectionNext,
+	})
+	require.NoError(t, err)
+
+	tampered := []byte(issued)
+	tampered[len(tampered)/2] ^= 1
+
+	cases := []struct {
+		name        string
+		cursor      string
+		setupMock   func(mock pgxmock.PgxPoolIface)
+		expectedErr error
+	}{
+		{
+			name:   "resumes after an issued cursor",
+			cursor: issued,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				rows := pgxmock.NewRows([]string{"id", "name", "brand", "state", "created_at", "updated_at", "total_count"}).
+					AddRow(model.NewDeviceID().String(), "Device", "Brand", "available", now, now, uint(1))
+				mock.ExpectQuery(regexp.QuoteMeta(
+					`SELECT id, name, brand, state, created_at, updated_at, metadata, COUNT(*) OVER() as total_count FROM devices WHERE (created_at, id) < ($1, $2) ORDER BY created_at DESC LIMIT 10`,
+				)).
+					WithArgs(now, lastID.String()).
+					WillReturnRows(rows)
+			},
+		},
+		{
+			name:        "rejects a tampered cursor",
+			cursor:      string(tampered),
+			expectedErr: model.ErrInvalidCursor,
+		},
+		{
+			name:        "rejects a cursor signed with another secret",
+			cursor:      foreign,
+			expectedErr: model.ErrInvalidCursor,
+		},
+		{
+			name:        "rejects a cursor issued for another sort",
+			cursor:      otherSort,
+			expectedErr: model.ErrInvalidCursor,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			if tc.setupMock != nil {
+				tc.setupMock(mock)
+			}
+
+			log := logger.NewTestLogger()
+			repo := repos.NewDevicesRepository(mock, repos.NewPgxScanner(), repos.NewCriteriaTranslator(&log), log,
+				repos.WithCursorCodec(codec))
+
+			_, err = repo.List(t.Context(), model.DeviceFilter{Page: 1, Size: 10, Sort: []string{"-createdAt"}, Cursor: tc.cursor})
+
+			require.ErrorIs(t, err, tc.expectedErr)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDevicesRepository_List_IssuesSignedCursors(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+	codec := model.NewCursorCodec([]byte("test-secret"))
+	lastID := model.NewDeviceID()
+
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	rows := pgxmock.NewRows([]string{"id", "name", "brand", "state", "created_at", "updated_at", "total_count"}).
+		AddRow(model.NewDeviceID().String(), "Device 1", "Brand", "available", now, now, uint(3)).
+		AddRow(lastID.String(), "Device 2", "Brand", "available", now, now, uint(3))
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT id, name, brand, state, created_at, updated_at, metadata, COUNT(*) OVER() as total_count FROM devices ORDER BY name ASC LIMIT 2 OFFSET 0`,
+	)).
+		WillReturnRows(rows)
+
+	log := logger.NewTestLogger()
+	repo := repos.NewDevicesRepository(mock, repos.NewPgxScanner(), repos.NewCriteriaTranslator(&log), log,
+		repos.WithCursorCodec(codec))
+
+	list, err := repo.List(t.Context(), model.DeviceFilter{Page: 1, Size: 2, Sort: []string{"name"}})
+	require.NoError(t, err)
+	require.True(t, list.Pagination.HasNext)
+
+	cursor, err := codec.Decode(list.Pagination.NextCursor)
+	require.NoError(t, err)
+	require.Equal(t, model.Cursor{
+		Field:     "name",
+		Value:     "Device 2",
+		ID:        lastID.String(),
+		Direction: model.CursorDirectionNext,
+	}, cursor)
+
+	_, err = model.NewCursorCodec([]byte("other-secret")).Decode(list.Pagination.NextCursor)
+	require.ErrorIs(t, err, model.ErrInvalidCursor)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestDevicesRepository_List_LogsWarningForInvalidSortField(t *testing.T) {
 	t.Parallel()
 
@@ -1163,7 +2155,7 @@ func TestDevicesRepository_List_LogsWarningForInvalidSortField(t *testing.T) {
 			runRepoTestWithLogger(t, func(mock pgxmock.PgxPoolIface) {
 				rows := pgxmock.NewRows([]string{"id", "name", "brand", "state", "created_at", "updated_at", "total_count"}).
 					AddRow(model.NewDeviceID().String(), "Device", "Brand", "available", now, now, uint(1))
-				mock.ExpectQuery(`SELECT id, name, brand, state, created_at, updated_at, COUNT\(\*\) OVER\(\) as total_count FROM devices ORDER BY created_at`).
+				mock.ExpectQuery(`SELECT id, name, brand, state, created_at, updated_at, metadata, COUNT\(\*\) OVER\(\) as total_count FROM devices ORDER BY created_at`).
 					WillReturnRows(rows)
 			}, func(t *testing.T, repo *repos.DevicesRepository, logBuffer *bytes.Buffer) {
 				filter := model.DeviceFilter{
@@ -1184,3 +2176,262 @@ func TestDevicesRepository_List_LogsWarningForInvalidSortField(t *testing.T) {
 		})
 	}
 }
+
+func TestDevicesRepository_Tracing(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+	testID := model.NewDeviceID()
+	columns := []string{"id", "name", "brand", "state", "created_at", "updated_at"}
+	deleteQuery := `DELETE FROM devices WHERE id = $1 RETURNING id, name, brand, state, created_at, updated_at, metadata`
+
+	cases := []struct {
+		name               string
+		setupMock          func(mock pgxmock.PgxPoolIface)
+		call               func(t *testing.T, repo *repos.DevicesRepository) error
+		expectedSpan       string
+		expectedOperation  string
+		expectedStatement  string
+		expectedStatusCode codes.Code
+	}{
+		{
+			name: "successful query records the operation and statement",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(deleteQuery)).
+					WithArgs(testID.String()).
+					WillReturnRows(pgxmock.NewRows(columns).
+						AddRow(testID.String(), "iPhone", "Apple", "available", now, now))
+				expectHistoryInsert(mock, model.HistoryOperationDelete, nil, &model.Device{
+					ID:    testID,
+					Name:  "iPhone",
+					Brand: "Apple",
+					State: model.StateAvailable,
+				}).
+					WillReturnResult(pgxmock.NewResult("INSERT", 1))
+				mock.ExpectCommit()
+			},
+			call: func(t *testing.T, repo *repos.DevicesRepository) error {
+				return repo.Delete(t.Context(), testID)
+			},
+			expectedSpan:       "db.devices.delete",
+			expectedOperation:  "DELETE",
+			expectedStatement:  deleteQuery,
+			expectedStatusCode: codes.Unset,
+		},
+		{
+			name: "failed query marks the span as an error",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(regexp.QuoteMeta(
+					`SELECT id, name, brand, state, created_at, updated_at, metadata FROM devices WHERE id = $1 LIMIT 1`,
+				)).
+					WithArgs(testID.String()).
+					WillReturnError(errors.New("connection error"))
+			},
+			call: func(t *testing.T, repo *repos.DevicesRepository) error {
+				_, err := repo.FetchByID(t.Context(), testID)
+
+				return err
+			},
+			expectedSpan:       "db.devices.fetch_by_id",
+			expectedOperation:  "SELECT",
+			expectedStatement:  `SELECT id, name, brand, state, created_at, updated_at, metadata FROM devices WHERE id = $1 LIMIT 1`,
+			expectedStatusCode: codes.Error,
+		},
+		{
+			name: "missing device is not recorded as an error",
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(deleteQuery)).
+					WithArgs(testID.String()).
+					WillReturnRows(pgxmock.NewRows(columns))
+				mock.ExpectRollback()
+			},
+			call: func(t *testing.T, repo *repos.DevicesRepository) error {
+				return repo.Delete(t.Context(), testID)
+			},
+			expectedSpan:       "db.devices.delete",
+			expectedOperation:  "DELETE",
+			expectedStatement:  deleteQuery,
+			expectedStatusCode: codes.Unset,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			tc.setupMock(mock)
+
+			recorder := tracetest.NewSpanRecorder()
+			tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+			log := logger.NewTestLogger()
+			repo := repos.NewDevicesRepository(
+				mock,
+				repos.NewPgxScanner(),
+				repos.NewCriteriaTranslator(&log),
+				log,
+				repos.WithTracerProvider(tp),
+			)
+
+			callErr := tc.call(t, repo)
+			require.NoError(t, mock.ExpectationsWereMet())
+
+			// The history snapshot of a change is recorded in a child span of its own.
+			spans := recorder.Ended()
+			index := slices.IndexFunc(spans, func(span sdktrace.ReadOnlySpan) bool {
+				return span.Name() == tc.expectedSpan
+			})
+			require.NotEqual(t, -1, index, "no %s span", tc.expectedSpan)
+
+			span := spans[index]
+			require.Equal(t, trace.SpanKindClient, span.SpanKind())
+			require.Equal(t, tc.expectedStatusCode, span.Status().Code)
+
+			attrs := make(map[attribute.Key]string, len(span.Attributes()))
+			for _, kv := range span.Attributes() {
+				attrs[kv.Key] = kv.Value.Emit()
+			}
+
+			require.Equal(t, "postgresql", attrs["db.system"])
+			require.Equal(t, tc.expectedOperation, attrs["db.operation"])
+			require.Equal(t, tc.expectedStatement, attrs["db.statement"])
+			require.NotContains(t, attrs["db.statement"], testID.String(), "parameter values stay out of the statement")
+
+			if tc.expectedStatusCode == codes.Error {
+				require.Error(t, callErr)
+				require.Len(t, span.Events(), 1)
+				require.Equal(t, "exception", span.Events()[0].Name)
+			} else {
+				require.Empty(t, span.Events())
+			}
+		})
+	}
+}
+
+func TestDevicesRepository_WithReadReplica(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+	device := model.NewDevice("Test Device", "Test Brand", model.StateAvailable)
+	columns := []string{"id", "name", "brand", "state", "created_at", "updated_at"}
+
+	cases := []struct {
+		name         string
+		setupPrimary func(mock pgxmock.PgxPoolIface)
+		setupReplica func(mock pgxmock.PgxPoolIface)
+		call         func(ctx context.Context, repo *repos.DevicesRepository) error
+	}{
+		{
+			name: "fetch by ID reads from the replica",
+			setupReplica: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(regexp.QuoteMeta(
+					`SELECT id, name, brand, state, created_at, updated_at, metadata FROM devices WHERE id = $1 LIMIT 1`,
+				)).
+					WithArgs(device.ID.String()).
+					WillReturnRows(pgxmock.NewRows(columns).
+						AddRow(device.ID.String(), device.Name, device.Brand, "available", now, now))
+			},
+			call: func(ctx context.Context, repo *repos.DevicesRepository) error {
+				_, err := repo.FetchByID(ctx, device.ID)
+
+				return err
+			},
+		},
+		{
+			name: "fetch by ID for update reads from the primary",
+			setupPrimary: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(regexp.QuoteMeta(
+					`SELECT id, name, brand, state, created_at, updated_at, metadata FROM devices WHERE id = $1 LIMIT 1`,
+				)).
+					WithArgs(device.ID.String()).
+					WillReturnRows(pgxmock.NewRows(columns).
+						AddRow(device.ID.String(), device.Name, device.Brand, "available", now, now))
+			},
+			call: func(ctx context.Context, repo *repos.DevicesRepository) error {
+				_, err := repo.FetchByIDForUpdate(ctx, device.ID)
+
+				return err
+			},
+		},
+		{
+			name: "list reads from the replica",
+			setupReplica: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, brand, state, created_at, updated_at, metadata, COUNT(*) OVER() as total_count FROM devices`)).
+					WillReturnRows(pgxmock.NewRows(append(columns, "total_count")))
+			},
+			call: func(ctx context.Context, repo *repos.DevicesRepository) error {
+				_, err := repo.List(ctx, model.DeviceFilter{Page: 1, Size: 10})
+
+				return err
+			},
+		},
+		{
+			name: "create writes to the primary",
+			setupPrimary: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO devices`)).
+					WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(),
+						pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg()).
+					WillReturnResult(pgxmock.NewResult("INSERT", 1))
+			},
+			call: func(ctx context.Context, repo *repos.DevicesRepository) error {
+				return repo.Create(ctx, device)
+			},
+		},
+		{
+			name: "recovering stale devices runs on the primary",
+			setupPrimary: func(mock pgxmock.PgxPoolIface) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(`UPDATE devices SET state = $1, updated_at = $2 WHERE id IN`)).
+					WithArgs("available", now, "in-use", now).
+					WillReturnRows(pgxmock.NewRows(columns))
+				mock.ExpectCommit()
+			},
+			call: func(ctx context.Context, repo *repos.DevicesRepository) error {
+				_, err := repo.RecoverStale(ctx, now, now, 0)
+
+				return err
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			primary, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer primary.Close()
+
+			replica, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer replica.Close()
+
+			if tc.setupPrimary != nil {
+				tc.setupPrimary(primary)
+			}
+
+			if tc.setupReplica != nil {
+				tc.setupReplica(replica)
+			}
+
+			log := logger.NewTestLogger()
+			repo := repos.NewDevicesRepository(
+				primary,
+				repos.NewPgxScanner(),
+				repos.NewCriteriaTranslator(&log),
+				log,
+				repos.WithReadReplica(replica),
+			)
+
+			require.NoError(t, tc.call(t.Context(), repo))
+			require.NoError(t, primary.ExpectationsWereMet())
+			require.NoError(t, replica.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,175 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/architeacher/devices/pkg/logger"
+	"github.com/architeacher/devices/services/svc-devices/internal/domain/model"
+	"github.com/architeacher/devices/services/svc-devices/internal/ports"
+)
+
+// AuditedDevicesService records every successful mutation of the wrapped service in the
+// audit log. Audit failures are logged and never undo or fail the mutation itself.
+// Reads are passed through unchanged.
+type AuditedDevicesService struct {
+	svc    ports.DevicesService
+	audit  ports.AuditLogger
+	logger logger.Logger
+}
+
+func NewAuditedDevicesService(
+	svc ports.DevicesService,
+	audit ports.AuditLogger,
+	log logger.Logger,
+) *AuditedDevicesService {
+	return &AuditedDevicesService{svc: svc, audit: audit, logger: log}
+}
+
+func (s *AuditedDevicesService) CreateDevice(
+	ctx context.Context,
+	name, brand string,
+	state model.State,
+) (*model.Device, error) {
+	device, err := s.svc.CreateDevice(ctx, name, brand, state)
+	if err != nil {
+		return nil, err
+	}
+
+	s.record(ctx, model.AuditOperationCreate, device.ID, nil, device)
+
+	return device, nil
+}
+
+func (s *AuditedDevicesService) GetDevice(ctx context.Context, id model.DeviceID) (*model.Device, error) {
+	return s.svc.GetDevice(ctx, id)
+}
+
+func (s *AuditedDevicesService) ListDevices(ctx context.Context, filter model.DeviceFilter) (*model.DeviceList, error) {
+	return s.svc.ListDevices(ctx, filter)
+}
+
+func (s *AuditedDevicesService) UpdateDevice(
+	ctx context.Context,
+	id model.DeviceID,
+	name, brand string,
+	state model.State,
+) (*model.Device, error) {
+	before := s.snapshot(ctx, id)
+
+	device, err := s.svc.UpdateDevice(ctx, id, name, brand, state)
+	if err != nil {
+		return nil, err
+	}
+
+	s.record(ctx, model.AuditOperationUpdate, id, before, device)
+
+	return device, nil
+}
+
+func (s *AuditedDevicesService) PatchDevice(
+	ctx context.Context,
+	id model.DeviceID,
+	updates map[string]any,
+) (*model.Device, error) {
+	before := s.snapshot(ctx, id)
+
+	device, err := s.svc.PatchDevice(ctx, id, updates)
+	if err != nil {
+		return nil, err
+	}
+
+	s.record(ctx, model.AuditOperationUpdate, id, before, device)
+
+	return device, nil
+}
+
+func (s *AuditedDevicesService) PatchDeviceIfUnmodified(
+	ctx context.Context,
+	id model.DeviceID,
+	updates map[string]any,
+	lastUpdatedAt time.Time,
+) (*model.Device, error) {
+	before := s.snapshot(ctx, id)
+
+	device, err := s.svc.PatchDeviceIfUnmodified(ctx, id, updates, lastUpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	s.record(ctx, model.AuditOperationUpdate, id, before, device)
+
+	return device, nil
+}
+
+func (s *AuditedDevicesService) DeleteDevice(ctx context.Context, id model.DeviceID) error {
+	before := s.snapshot(ctx, id)
+
+	if err := s.svc.DeleteDevice(ctx, id); err != nil {
+		return err
+	}
+
+	s.record(ctx, model.AuditOperationDelete, id, before, nil)
+
+	return nil
+}
+
+// RecoverStaleDevices records each released device. Only the recovered state is
+// returned by the release, so these entries carry no before snapshot.
+func (s *AuditedDevicesService) RecoverStaleDevices(
+	ctx context.Context,
+	idleFor time.Duration,
+	limit uint,
+) ([]*model.Device, error) {
+	devices, err := s.svc.RecoverStaleDevices(ctx, idleFor, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, device := range devices {
+		s.record(ctx, model.AuditOperationRecover, device.ID, nil, device)
+	}
+
+	return devices, nil
+}
+
+func (s *AuditedDevicesService) WatchDevice(ctx context.Context, id model.DeviceID) (*ports.DeviceSubscription, error) {
+	return s.svc.WatchDevice(ctx, id)
+}
+
+// snapshot reads the device ahead of a mutation. A failed read leaves the before
+// snapshot empty, and the mutation reports its own error if the device is missing.
+func (s *AuditedDevicesService) snapshot(ctx context.Context, id model.DeviceID) *model.Device {
+	device, err := s.svc.GetDevice(ctx, id)
+	if err != nil {
+		return nil
+	}
+
+	return device
+}
+
+func (s *AuditedDevicesService) record(
+	ctx context.Context,
+	operation string,
+	id model.DeviceID,
+	before, after *model.Device,
+) {
+	entry := model.AuditEntry{
+		Timestamp: time.Now().UTC(),
+		Operation: operation,
+		DeviceID:  id,
+		Actor:     model.ActorFromContext(ctx),
+		Before:    before,
+		After:     after,
+	}
+
+	// The mutation is already stored, so a caller that goes away must not lose its entry.
+	if err := s.audit.Log(context.WithoutCancel(ctx), entry); err != nil {
+		s.logger.Error().
+			Err(err).
+			Str("operation", operation).
+			Str("device_id", id.String()).
+			Str("actor", entry.Actor).
+			Msg("failed to write the audit log entry")
+	}
+}
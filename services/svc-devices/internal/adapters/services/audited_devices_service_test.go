@@ -0,0 +1,231 @@
+package services_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/architeacher/devices/pkg/logger"
+	"github.com/architeacher/devices/services/svc-devices/internal/adapters/services"
+	"github.com/architeacher/devices/services/svc-devices/internal/domain/model"
+	"github.com/architeacher/devices/services/svc-devices/internal/mocks"
+	"github.com/architeacher/devices/services/svc-devices/internal/ports"
+	"github.com/stretchr/testify/require"
+)
+
+var _ ports.DevicesService = (*services.AuditedDevicesService)(nil)
+
+func newAuditedService(inner *mocks.FakeDevicesService, audit *mocks.FakeAuditLogger) (*services.AuditedDevicesService, *bytes.Buffer) {
+	logBuffer := &bytes.Buffer{}
+
+	return services.NewAuditedDevicesService(inner, audit, logger.NewBufferedTestLogger(logBuffer)), logBuffer
+}
+
+func TestAuditedDevicesService_RecordsMutations(t *testing.T) {
+	t.Parallel()
+
+	before := model.NewDevice("iPhone", "Apple", model.StateAvailable)
+	after := &model.Device{
+		ID:        before.ID,
+		Name:      "iPhone 15",
+		Brand:     "Apple",
+		State:     model.StateInUse,
+		CreatedAt: before.CreatedAt,
+		UpdatedAt: before.UpdatedAt.Add(time.Minute),
+	}
+
+	cases := []struct {
+		name              string
+		mutate            func(ctx context.Context, svc *services.AuditedDevicesService) error
+		expectedOperation string
+		expectedBefore    *model.Device
+		expectedAfter     *model.Device
+	}{
+		{
+			name: "create has no before snapshot",
+			mutate: func(ctx context.Context, svc *services.AuditedDevicesService) error {
+				_, err := svc.CreateDevice(ctx, "iPhone 15", "Apple", model.StateInUse)
+
+				return err
+			},
+			expectedOperation: model.AuditOperationCreate,
+			expectedAfter:     after,
+		},
+		{
+			name: "update has both snapshots",
+			mutate: func(ctx context.Context, svc *services.AuditedDevicesService) error {
+				_, err := svc.UpdateDevice(ctx, before.ID, "iPhone 15", "Apple", model.StateInUse)
+
+				return err
+			},
+			expectedOperation: model.AuditOperationUpdate,
+			expectedBefore:    before,
+			expectedAfter:     after,
+		},
+		{
+			name: "patch is recorded as an update",
+			mutate: func(ctx context.Context, svc *services.AuditedDevicesService) error {
+				_, err := svc.PatchDevice(ctx, before.ID, map[string]any{"name": "iPhone 15"})
+
+				return err
+			},
+			expectedOperation: model.AuditOperationUpdate,
+			expectedBefore:    before,
+			expectedAfter:     after,
+		},
+		{
+			name: "delete has no after snapshot",
+			mutate: func(ctx context.Context, svc *services.AuditedDevicesService) error {
+				return svc.DeleteDevice(ctx, before.ID)
+			},
+			expectedOperation: model.AuditOperationDelete,
+			expectedBefore:    before,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			inner := &mocks.FakeDevicesService{}
+			inner.GetDeviceReturns(before, nil)
+			inner.CreateDeviceReturns(after, nil)
+			inner.UpdateDeviceReturns(after, nil)
+			inner.PatchDeviceReturns(after, nil)
+
+			audit := &mocks.FakeAuditLogger{}
+			svc, _ := newAuditedService(inner, audit)
+
+			require.NoError(t, tc.mutate(model.WithActor(t.Context(), "user-42"), svc))
+
+			require.Equal(t, 1, audit.LogCallCount())
+
+			_, entry := audit.LogArgsForCall(0)
+			require.Equal(t, tc.expectedOperation, entry.Operation)
+			require.Equal(t, before.ID, entry.DeviceID)
+			require.Equal(t, "user-42", entry.Actor)
+			require.Equal(t, tc.expectedBefore, entry.Before)
+			require.Equal(t, tc.expectedAfter, entry.After)
+			require.WithinDuration(t, time.Now().UTC(), entry.Timestamp, time.Minute)
+		})
+	}
+}
+
+func TestAuditedDevicesService_FailedMutationIsNotRecorded(t *testing.T) {
+	t.Parallel()
+
+	inner := &mocks.FakeDevicesService{}
+	inner.GetDeviceReturns(model.NewDevice("iPhone", "Apple", model.StateInUse), nil)
+	inner.DeleteDeviceReturns(model.ErrCannotDeleteInUseDevice)
+
+	audit := &mocks.FakeAuditLogger{}
+	svc, _ := newAuditedService(inner, audit)
+
+	err := svc.DeleteDevice(t.Context(), model.NewDeviceID())
+
+	require.ErrorIs(t, err, model.ErrCannotDeleteInUseDevice)
+	require.Zero(t, audit.LogCallCount())
+}
+
+func TestAuditedDevicesService_AuditFailureKeepsTheMutation(t *testing.T) {
+	t.Parallel()
+
+	created := model.NewDevice("iPhone", "Apple", model.StateAvailable)
+
+	inner := &mocks.FakeDevicesService{}
+	inner.CreateDeviceReturns(created, nil)
+
+	audit := &mocks.FakeAuditLogger{}
+	audit.LogReturns(errors.New("connection refused"))
+
+	svc, logBuffer := newAuditedService(inner, audit)
+
+	device, err := svc.CreateDevice(t.Context(), "iPhone", "Apple", model.StateAvailable)
+
+	require.NoError(t, err)
+	require.Same(t, created, device)
+	require.Equal(t, 1, inner.CreateDeviceCallCount())
+	require.Contains(t, logBuffer.String(), "failed to write the audit log entry")
+	require.Contains(t, logBuffer.String(), created.ID.String())
+}
+
+func TestAuditedDevicesService_AuditOutlivesCancelledRequest(t *testing.T) {
+	t.Parallel()
+
+	inner := &mocks.FakeDevicesService{}
+	inner.CreateDeviceReturns(model.NewDevice("iPhone", "Apple", model.StateAvailable), nil)
+
+	audit := &mocks.FakeAuditLogger{}
+	svc, _ := newAuditedService(inner, audit)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	_, err := svc.CreateDevice(ctx, "iPhone", "Apple", model.StateAvailable)
+	require.NoError(t, err)
+
+	auditCtx, _ := audit.LogArgsForCall(0)
+	require.NoError(t, auditCtx.Err())
+}
+
+func TestAuditedDevicesService_RecordsRecoveredDevices(t *testing.T) {
+	t.Parallel()
+
+	recovered := []*model.Device{
+		model.NewDevice("iPhone", "Apple", model.StateAvailable),
+		model.NewDevice("Pixel", "Google", model.StateAvailable),
+	}
+
+	inner := &mocks.FakeDevicesService{}
+	inner.RecoverStaleDevicesReturns(recovered, nil)
+
+	audit := &mocks.FakeAuditLogger{}
+	svc, _ := newAuditedService(inner, audit)
+
+	devices, err := svc.RecoverStaleDevices(t.Context(), time.Hour, 10)
+
+	require.NoError(t, err)
+	require.Equal(t, recovered, devices)
+	require.Equal(t, len(recovered), audit.LogCallCount())
+
+	for index, device := range recovered {
+		_, entry := audit.LogArgsForCall(index)
+		require.Equal(t, model.AuditOperationRecover, entry.Operation)
+		require.Equal(t, device.ID, entry.DeviceID)
+		require.Nil(t, entry.Before)
+		require.Same(t, device, entry.After)
+	}
+}
+
+func TestAuditedDevicesService_ReadsAreNotRecorded(t *testing.T) {
+	t.Parallel()
+
+	device := model.NewDevice("iPhone", "Apple", model.StateAvailable)
+
+	inner := &mocks.FakeDevicesService{}
+	inner.GetDeviceReturns(device, nil)
+	inner.ListDevicesReturns(&model.DeviceList{Devices: []*model.Device{device}}, nil)
+	inner.WatchDeviceReturns(&ports.DeviceSubscription{Current: device}, nil)
+
+	audit := &mocks.FakeAuditLogger{}
+	svc, _ := newAuditedService(inner, audit)
+
+	got, err := svc.GetDevice(t.Context(), device.ID)
+	require.NoError(t, err)
+	require.Same(t, device, got)
+
+	list, err := svc.ListDevices(t.Context(), model.DefaultDeviceFilter())
+	require.NoError(t, err)
+	require.Equal(t, []*model.Device{device}, list.Devices)
+
+	subscription, err := svc.WatchDevice(t.Context(), device.ID)
+	require.NoError(t, err)
+	require.Same(t, device, subscription.Current)
+
+	require.Equal(t, 1, inner.GetDeviceCallCount())
+	require.Equal(t, 1, inner.ListDevicesCallCount())
+	require.Equal(t, 1, inner.WatchDeviceCallCount())
+	require.Zero(t, audit.LogCallCount())
+}
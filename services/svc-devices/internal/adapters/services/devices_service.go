@@ -2,20 +2,24 @@ package services
 
 import (
 	"context"
+	"maps"
+	"time"
 
 	"github.com/architeacher/devices/services/svc-devices/internal/domain/model"
 	"github.com/architeacher/devices/services/svc-devices/internal/ports"
 )
 
 type DevicesService struct {
-	repo ports.DeviceRepository
+	repo   ports.DeviceRepository
+	events ports.DeviceEventBus
 }
 
-func NewDevicesService(repo ports.DeviceRepository) *DevicesService {
-	return &DevicesService{repo: repo}
+func NewDevicesService(repo ports.DeviceRepository, events ports.DeviceEventBus) *DevicesService {
+	return &DevicesService{repo: repo, events: events}
 }
 
 func (s *DevicesService) CreateDevice(ctx context.Context, name, brand string, state model.State) (*model.Device, error) {
+	name, brand = model.NormalizeDevice(name, brand)
 	device := model.NewDevice(name, brand, state)
 
 	if err := s.repo.Create(ctx, device); err != nil {
@@ -34,11 +38,12 @@ func (s *DevicesService) ListDevices(ctx context.Context, filter model.DeviceFil
 }
 
 func (s *DevicesService) UpdateDevice(ctx context.Context, id model.DeviceID, name, brand string, state model.State) (*model.Device, error) {
-	device, err := s.repo.FetchByID(ctx, id)
+	device, err := s.repo.FetchByIDForUpdate(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
+	name, brand = model.NormalizeDevice(name, brand)
 	if err := device.Update(name, brand, state); err != nil {
 		return nil, err
 	}
@@ -47,16 +52,18 @@ func (s *DevicesService) UpdateDevice(ctx context.Context, id model.DeviceID, na
 		return nil, err
 	}
 
+	s.events.Publish(device)
+
 	return device, nil
 }
 
 func (s *DevicesService) PatchDevice(ctx context.Context, id model.DeviceID, updates map[string]any) (*model.Device, error) {
-	device, err := s.repo.FetchByID(ctx, id)
+	device, err := s.repo.FetchByIDForUpdate(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := device.Patch(updates); err != nil {
+	if err := device.Patch(normalizePatch(updates)); err != nil {
 		return nil, err
 	}
 
@@ -64,11 +71,47 @@ func (s *DevicesService) PatchDevice(ctx context.Context, id model.DeviceID, upd
 		return nil, err
 	}
 
+	s.events.Publish(device)
+
+	return device, nil
+}
+
+// PatchDeviceIfUnmodified patches the device like PatchDevice, but only if it was last
+// updated at lastUpdatedAt. The check is repeated by the update itself, so a change
+// committed after the device was fetched is not overwritten either.
+func (s *DevicesService) PatchDeviceIfUnmodified(
+	ctx context.Context,
+	id model.DeviceID,
+	updates map[string]any,
+	lastUpdatedAt time.Time,
+) (*model.Device, error) {
+	device, err := s.repo.FetchByIDForUpdate(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// updated_at is stored with microsecond precision, while devices returned right
+	// after a mutation carry the full precision of the clock.
+	lastUpdatedAt = lastUpdatedAt.Truncate(time.Microsecond)
+	if !device.UpdatedAt.Truncate(time.Microsecond).Equal(lastUpdatedAt) {
+		return nil, model.ErrDeviceModified
+	}
+
+	if err := device.Patch(normalizePatch(updates)); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.UpdateIfUnmodified(ctx, device, lastUpdatedAt); err != nil {
+		return nil, err
+	}
+
+	s.events.Publish(device)
+
 	return device, nil
 }
 
 func (s *DevicesService) DeleteDevice(ctx context.Context, id model.DeviceID) error {
-	device, err := s.repo.FetchByID(ctx, id)
+	device, err := s.repo.FetchByIDForUpdate(ctx, id)
 	if err != nil {
 		return err
 	}
@@ -77,5 +120,71 @@ func (s *DevicesService) DeleteDevice(ctx context.Context, id model.DeviceID) er
 		return model.ErrCannotDeleteInUseDevice
 	}
 
-	return s.repo.Delete(ctx, id)
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	s.events.Close(id)
+
+	return nil
+}
+
+// RecoverStaleDevices releases devices whose holder stopped updating them, e.g. after
+// the client crashed, and notifies the watchers of each one.
+func (s *DevicesService) RecoverStaleDevices(ctx context.Context, idleFor time.Duration, limit uint) ([]*model.Device, error) {
+	now := time.Now().UTC()
+
+	devices, err := s.repo.RecoverStale(ctx, now.Add(-idleFor), now, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, device := range devices {
+		s.events.Publish(device)
+	}
+
+	return devices, nil
+}
+
+// normalizePatch returns updates with the name and brand it carries normalized, leaving
+// the caller's map untouched.
+func normalizePatch(updates map[string]any) map[string]any {
+	name, hasName := updates["name"].(string)
+	brand, hasBrand := updates["brand"].(string)
+
+	if !hasName && !hasBrand {
+		return updates
+	}
+
+	normalized := maps.Clone(updates)
+	name, brand = model.NormalizeDevice(name, brand)
+
+	if hasName {
+		normalized["name"] = name
+	}
+
+	if hasBrand {
+		normalized["brand"] = brand
+	}
+
+	return normalized
+}
+
+// WatchDevice subscribes before reading the device, so a change made in between is
+// delivered as an update rather than lost.
+func (s *DevicesService) WatchDevice(ctx context.Context, id model.DeviceID) (*ports.DeviceSubscription, error) {
+	updates, cancel := s.events.Subscribe(id)
+
+	device, err := s.repo.FetchByID(ctx, id)
+	if err != nil {
+		cancel()
+
+		return nil, err
+	}
+
+	return &ports.DeviceSubscription{
+		Current: device,
+		Updates: updates,
+		Cancel:  cancel,
+	}, nil
 }
@@ -0,0 +1,477 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/architeacher/devices/services/svc-devices/internal/adapters/services"
+	"github.com/architeacher/devices/services/svc-devices/internal/domain/model"
+	"github.com/architeacher/devices/services/svc-devices/internal/mocks"
+	"github.com/architeacher/devices/services/svc-devices/internal/ports"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	_ ports.DeviceEventBus   = (*mocks.FakeDeviceEventBus)(nil)
+	_ ports.DeviceRepository = (*mocks.FakeDeviceRepository)(nil)
+	_ ports.DevicesService   = (*mocks.FakeDevicesService)(nil)
+	_ ports.DevicesService   = (*services.DevicesService)(nil)
+)
+
+func TestDevicesService_CreateDevice(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name        string
+		createErr   error
+		expectedErr error
+	}{
+		{
+			name: "stores the new device",
+		},
+		{
+			name:        "repository error",
+			createErr:   errors.New("connection refused"),
+			expectedErr: errors.New("connection refused"),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := &mocks.FakeDeviceRepository{}
+			repo.CreateReturns(tc.createErr)
+
+			device, err := services.NewDevicesService(repo, &mocks.FakeDeviceEventBus{}).CreateDevice(context.Background(), "iPhone", "Apple", model.StateAvailable)
+
+			require.Equal(t, 1, repo.CreateCallCount())
+
+			if tc.expectedErr != nil {
+				require.EqualError(t, err, tc.expectedErr.Error())
+				require.Nil(t, device)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			_, stored := repo.CreateArgsForCall(0)
+			require.Same(t, device, stored)
+			require.Equal(t, "iPhone", stored.Name)
+			require.Equal(t, model.StateAvailable, stored.State)
+		})
+	}
+}
+
+func TestDevicesService_NormalizesNameAndBrand(t *testing.T) {
+	t.Parallel()
+
+	t.Run("create", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &mocks.FakeDeviceRepository{}
+
+		device, err := services.NewDevicesService(repo, &mocks.FakeDeviceEventBus{}).
+			CreateDevice(context.Background(), "  iPhone   15 ", "apple", model.StateAvailable)
+
+		require.NoError(t, err)
+
+		_, stored := repo.CreateArgsForCall(0)
+		require.Same(t, device, stored)
+		require.Equal(t, "iPhone 15", stored.Name)
+		require.Equal(t, "Apple", stored.Brand)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &mocks.FakeDeviceRepository{}
+		repo.FetchByIDForUpdateReturns(model.NewDevice("iPhone", "Apple", model.StateAvailable), nil)
+
+		device, err := services.NewDevicesService(repo, &mocks.FakeDeviceEventBus{}).
+			UpdateDevice(context.Background(), model.NewDeviceID(), " Pixel  8 ", "google ", model.StateAvailable)
+
+		require.NoError(t, err)
+
+		_, stored := repo.UpdateArgsForCall(0)
+		require.Same(t, device, stored)
+		require.Equal(t, "Pixel 8", stored.Name)
+		require.Equal(t, "Google", stored.Brand)
+	})
+
+	t.Run("update of an in-use device with an equivalent name and brand", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &mocks.FakeDeviceRepository{}
+		repo.FetchByIDForUpdateReturns(model.NewDevice("iPhone", "Apple", model.StateInUse), nil)
+
+		_, err := services.NewDevicesService(repo, &mocks.FakeDeviceEventBus{}).
+			UpdateDevice(context.Background(), model.NewDeviceID(), " iPhone ", "apple ", model.StateInUse)
+
+		require.NoError(t, err, "whitespace and brand casing alone are not a rename")
+	})
+
+	t.Run("patch", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &mocks.FakeDeviceRepository{}
+		repo.FetchByIDForUpdateReturns(model.NewDevice("iPhone", "Apple", model.StateAvailable), nil)
+
+		updates := map[string]any{"name": "  Galaxy   S24", "brand": "samsung"}
+
+		_, err := services.NewDevicesService(repo, &mocks.FakeDeviceEventBus{}).
+			PatchDevice(context.Background(), model.NewDeviceID(), updates)
+
+		require.NoError(t, err)
+
+		_, stored := repo.UpdateArgsForCall(0)
+		require.Equal(t, "Galaxy S24", stored.Name)
+		require.Equal(t, "Samsung", stored.Brand)
+		require.Equal(t, "  Galaxy   S24", updates["name"], "the caller's updates are left untouched")
+	})
+
+	t.Run("patch of the brand only", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &mocks.FakeDeviceRepository{}
+		repo.FetchByIDForUpdateReturns(model.NewDevice("iPhone", "Apple", model.StateAvailable), nil)
+
+		_, err := services.NewDevicesService(repo, &mocks.FakeDeviceEventBus{}).
+			PatchDevice(context.Background(), model.NewDeviceID(), map[string]any{"brand": " apple inc "})
+
+		require.NoError(t, err)
+
+		_, stored := repo.UpdateArgsForCall(0)
+		require.Equal(t, "iPhone", stored.Name)
+		require.Equal(t, "Apple Inc", stored.Brand)
+	})
+}
+
+func TestDevicesService_UpdateDevice(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name              string
+		state             model.State
+		fetchErr          error
+		updateErr         error
+		expectedErr       error
+		expectedUpdates   int
+		expectedPublishes int
+	}{
+		{
+			name:              "updates an available device",
+			state:             model.StateAvailable,
+			expectedUpdates:   1,
+			expectedPublishes: 1,
+		},
+		{
+			name:            "reports an update failure without publishing",
+			state:           model.StateAvailable,
+			updateErr:       model.ErrDatabaseQuery,
+			expectedErr:     model.ErrDatabaseQuery,
+			expectedUpdates: 1,
+		},
+		{
+			name:        "rejects renaming an in-use device",
+			state:       model.StateInUse,
+			expectedErr: model.ErrCannotUpdateInUseDevice,
+		},
+		{
+			name:        "device not found",
+			fetchErr:    model.ErrDeviceNotFound,
+			expectedErr: model.ErrDeviceNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			existing := model.NewDevice("iPhone", "Apple", tc.state)
+
+			repo := &mocks.FakeDeviceRepository{}
+			if tc.fetchErr != nil {
+				repo.FetchByIDForUpdateReturns(nil, tc.fetchErr)
+			} else {
+				repo.FetchByIDForUpdateReturns(existing, nil)
+			}
+
+			repo.UpdateReturns(tc.updateErr)
+
+			bus := &mocks.FakeDeviceEventBus{}
+
+			device, err := services.NewDevicesService(repo, bus).
+				UpdateDevice(model.WithActor(context.Background(), "user-42"), existing.ID, "Pixel", "Google", model.StateAvailable)
+
+			require.Equal(t, tc.expectedUpdates, repo.UpdateCallCount())
+			require.Equal(t, tc.expectedPublishes, bus.PublishCallCount(), "only stored changes are published")
+
+			if tc.expectedUpdates > 0 {
+				ctx, stored := repo.UpdateArgsForCall(0)
+				require.Equal(t, "Pixel", stored.Name)
+				require.Equal(t, "user-42", model.ActorFromContext(ctx), "the history records the caller")
+			}
+
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				require.Nil(t, device)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, "Pixel", device.Name)
+			require.Equal(t, "Google", device.Brand)
+			require.Same(t, device, bus.PublishArgsForCall(0))
+		})
+	}
+}
+
+func TestDevicesService_PatchDeviceIfUnmodified(t *testing.T) {
+	t.Parallel()
+
+	lastUpdatedAt := time.Date(2026, 1, 2, 3, 4, 5, 6000, time.UTC)
+
+	cases := []struct {
+		name              string
+		lastUpdatedAt     time.Time
+		updateErr         error
+		expectedErr       error
+		expectedUpdates   int
+		expectedPublishes int
+	}{
+		{
+			name:              "patches a device unmodified since it was read",
+			lastUpdatedAt:     lastUpdatedAt,
+			expectedUpdates:   1,
+			expectedPublishes: 1,
+		},
+		{
+			name:              "ignores precision finer than stored",
+			lastUpdatedAt:     lastUpdatedAt.Add(999 * time.Nanosecond),
+			expectedUpdates:   1,
+			expectedPublishes: 1,
+		},
+		{
+			name:          "rejects a device modified since it was read",
+			lastUpdatedAt: lastUpdatedAt.Add(-time.Second),
+			expectedErr:   model.ErrDeviceModified,
+		},
+		{
+			name:            "reports a change committed after the read",
+			lastUpdatedAt:   lastUpdatedAt,
+			updateErr:       model.ErrDeviceModified,
+			expectedErr:     model.ErrDeviceModified,
+			expectedUpdates: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			existing := model.NewDevice("iPhone", "Apple", model.StateAvailable)
+			existing.UpdatedAt = lastUpdatedAt
+
+			repo := &mocks.FakeDeviceRepository{}
+			repo.FetchByIDForUpdateReturns(existing, nil)
+			repo.UpdateIfUnmodifiedReturns(tc.updateErr)
+
+			bus := &mocks.FakeDeviceEventBus{}
+
+			device, err := services.NewDevicesService(repo, bus).
+				PatchDeviceIfUnmodified(context.Background(), existing.ID, map[string]any{"state": "in-use"}, tc.lastUpdatedAt)
+
+			require.Zero(t, repo.UpdateCallCount())
+			require.Equal(t, tc.expectedUpdates, repo.UpdateIfUnmodifiedCallCount())
+			require.Equal(t, tc.expectedPublishes, bus.PublishCallCount(), "only stored changes are published")
+
+			if tc.expectedUpdates > 0 {
+				_, stored, since := repo.UpdateIfUnmodifiedArgsForCall(0)
+				require.Equal(t, model.StateInUse, stored.State)
+				require.True(t, lastUpdatedAt.Equal(since), "the update is conditional on the stored updated_at")
+			}
+
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				require.Nil(t, device)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, model.StateInUse, device.State)
+		})
+	}
+}
+
+func TestDevicesService_DeleteDevice(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name            string
+		state           model.State
+		deleteErr       error
+		expectedErr     error
+		expectedDeletes int
+		expectedCloses  int
+	}{
+		{
+			name:            "deletes an available device",
+			state:           model.StateAvailable,
+			expectedDeletes: 1,
+			expectedCloses:  1,
+		},
+		{
+			name:            "reports a delete failure without closing the watchers",
+			state:           model.StateAvailable,
+			deleteErr:       model.ErrDatabaseQuery,
+			expectedErr:     model.ErrDatabaseQuery,
+			expectedDeletes: 1,
+		},
+		{
+			name:        "refuses to delete an in-use device",
+			state:       model.StateInUse,
+			expectedErr: model.ErrCannotDeleteInUseDevice,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			existing := model.NewDevice("iPhone", "Apple", tc.state)
+
+			repo := &mocks.FakeDeviceRepository{}
+			repo.FetchByIDForUpdateReturns(existing, nil)
+			repo.DeleteReturns(tc.deleteErr)
+
+			bus := &mocks.FakeDeviceEventBus{}
+
+			err := services.NewDevicesService(repo, bus).DeleteDevice(context.Background(), existing.ID)
+
+			require.ErrorIs(t, err, tc.expectedErr)
+			require.Equal(t, tc.expectedDeletes, repo.DeleteCallCount())
+			require.Equal(t, tc.expectedCloses, bus.CloseCallCount(), "watchers are released once the device is gone")
+
+			if tc.expectedDeletes > 0 {
+				_, id := repo.DeleteArgsForCall(0)
+				require.Equal(t, existing.ID, id)
+			}
+		})
+	}
+}
+
+func TestDevicesService_RecoverStaleDevices(t *testing.T) {
+	t.Parallel()
+
+	t.Run("publishes every recovered device", func(t *testing.T) {
+		t.Parallel()
+
+		recovered := []*model.Device{
+			model.NewDevice("iPhone", "Apple", model.StateAvailable),
+			model.NewDevice("Pixel", "Google", model.StateAvailable),
+		}
+
+		repo := &mocks.FakeDeviceRepository{}
+		repo.RecoverStaleReturns(recovered, nil)
+
+		bus := &mocks.FakeDeviceEventBus{}
+
+		before := time.Now().UTC()
+
+		devices, err := services.NewDevicesService(repo, bus).
+			RecoverStaleDevices(context.Background(), 30*time.Minute, 50)
+
+		require.NoError(t, err)
+		require.Equal(t, recovered, devices)
+
+		_, staleBefore, recoveredAt, limit := repo.RecoverStaleArgsForCall(0)
+		require.Equal(t, uint(50), limit)
+		require.Equal(t, recoveredAt.Add(-30*time.Minute), staleBefore)
+		require.False(t, recoveredAt.Before(before))
+
+		require.Equal(t, len(recovered), bus.PublishCallCount())
+		for index, device := range recovered {
+			require.Same(t, device, bus.PublishArgsForCall(index))
+		}
+	})
+
+	t.Run("publishes nothing when the repository fails", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &mocks.FakeDeviceRepository{}
+		repo.RecoverStaleReturns(nil, model.ErrDatabaseQuery)
+
+		bus := &mocks.FakeDeviceEventBus{}
+
+		devices, err := services.NewDevicesService(repo, bus).
+			RecoverStaleDevices(context.Background(), time.Minute, 0)
+
+		require.ErrorIs(t, err, model.ErrDatabaseQuery)
+		require.Nil(t, devices)
+		require.Zero(t, bus.PublishCallCount())
+	})
+}
+
+func TestDevicesService_WatchDevice(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name        string
+		fetchErr    error
+		expectedErr error
+	}{
+		{
+			name: "returns the device with its subscription",
+		},
+		{
+			name:        "device not found releases the subscription",
+			fetchErr:    model.ErrDeviceNotFound,
+			expectedErr: model.ErrDeviceNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			existing := model.NewDevice("iPhone", "Apple", model.StateAvailable)
+
+			repo := &mocks.FakeDeviceRepository{}
+			if tc.fetchErr != nil {
+				repo.FetchByIDReturns(nil, tc.fetchErr)
+			} else {
+				repo.FetchByIDReturns(existing, nil)
+			}
+
+			updates := make(chan *model.Device)
+			cancelled := false
+
+			bus := &mocks.FakeDeviceEventBus{}
+			bus.SubscribeReturns(updates, func() { cancelled = true })
+
+			subscription, err := services.NewDevicesService(repo, bus).WatchDevice(context.Background(), existing.ID)
+
+			require.Equal(t, 1, bus.SubscribeCallCount())
+			require.Equal(t, existing.ID, bus.SubscribeArgsForCall(0))
+
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				require.Nil(t, subscription)
+				require.True(t, cancelled)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Same(t, existing, subscription.Current)
+			require.Equal(t, (<-chan *model.Device)(updates), subscription.Updates)
+			require.False(t, cancelled)
+		})
+	}
+}
@@ -128,6 +128,11 @@ func Init() (*ServiceConfig, error) {
 		return nil, fmt.Errorf("unable to parse service configuration: %w", err)
 	}
 
+	// Reflection exposes the service schema, so production only enables it on request.
+	if _, ok := os.LookupEnv("GRPC_ENABLE_REFLECTION"); !ok && cfg.IsProduction() {
+		cfg.GRPCServer.EnableReflection = false
+	}
+
 	return cfg, nil
 }
 
@@ -293,6 +298,8 @@ func (l *Loader) applySecretToConfig(cfg *ServiceConfig, key, value string) erro
 		cfg.Database.Password = value
 	case "CACHE_PASSWORD":
 		cfg.Cache.Password = value
+	case "PAGINATION_CURSOR_SECRET":
+		cfg.Pagination.CursorSecret = value
 	}
 
 	return nil
@@ -2,6 +2,7 @@ package config
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -32,6 +33,14 @@ func TestInit_DefaultValues(t *testing.T) {
 	// HTTPServer defaults
 	assert.Equal(t, "0.0.0.0", cfg.GRPCServer.Host)
 	assert.Equal(t, uint(9090), cfg.GRPCServer.Port)
+	assert.Zero(t, cfg.GRPCServer.StreamRateLimit.RPS)
+	assert.Equal(t, uint(50), cfg.GRPCServer.StreamRateLimit.Burst)
+	assert.True(t, cfg.GRPCServer.EnableReflection)
+	assert.Zero(t, cfg.GRPCServer.MaxConcurrentStreams)
+	assert.Equal(t, 30*time.Second, cfg.GRPCServer.Keepalive.Time)
+	assert.Equal(t, 10*time.Second, cfg.GRPCServer.Keepalive.Timeout)
+	assert.Equal(t, 5*time.Second, cfg.GRPCServer.Keepalive.MinTime)
+	assert.True(t, cfg.GRPCServer.Keepalive.PermitWithoutStream)
 
 	// Vault defaults
 	assert.True(t, cfg.SecretsStorage.Enabled)
@@ -40,6 +49,52 @@ func TestInit_DefaultValues(t *testing.T) {
 	assert.Equal(t, "svc-devices", cfg.SecretsStorage.MountPath)
 }
 
+func TestInit_StreamRateLimit(t *testing.T) {
+	t.Setenv("GRPC_STREAM_RATE_LIMIT_RPS", "25")
+	t.Setenv("GRPC_STREAM_RATE_LIMIT_BURST", "10")
+
+	cfg, err := Init()
+	assert.NoError(t, err)
+
+	assert.Equal(t, uint(25), cfg.GRPCServer.StreamRateLimit.RPS)
+	assert.Equal(t, uint(10), cfg.GRPCServer.StreamRateLimit.Burst)
+}
+
+func TestInit_DeviceRecovery(t *testing.T) {
+	cfg, err := Init()
+	assert.NoError(t, err)
+
+	assert.False(t, cfg.DeviceRecovery.Enabled)
+	assert.Equal(t, 30*time.Minute, cfg.DeviceRecovery.RecoveryTimeout)
+	assert.Equal(t, uint(100), cfg.DeviceRecovery.BatchSize)
+	assert.Equal(t, time.Minute, cfg.DeviceRecovery.Interval)
+
+	t.Setenv("DEVICE_RECOVERY_ENABLED", "true")
+	t.Setenv("DEVICE_RECOVERY_TIMEOUT", "2h")
+	t.Setenv("DEVICE_RECOVERY_BATCH_SIZE", "25")
+	t.Setenv("DEVICE_RECOVERY_INTERVAL", "5m")
+
+	cfg, err = Init()
+	assert.NoError(t, err)
+
+	assert.True(t, cfg.DeviceRecovery.Enabled)
+	assert.Equal(t, 2*time.Hour, cfg.DeviceRecovery.RecoveryTimeout)
+	assert.Equal(t, uint(25), cfg.DeviceRecovery.BatchSize)
+	assert.Equal(t, 5*time.Minute, cfg.DeviceRecovery.Interval)
+}
+
+func TestInit_AuditLog(t *testing.T) {
+	cfg, err := Init()
+	assert.NoError(t, err)
+	assert.True(t, cfg.AuditLog.Enabled)
+
+	t.Setenv("AUDIT_LOG_ENABLED", "false")
+
+	cfg, err = Init()
+	assert.NoError(t, err)
+	assert.False(t, cfg.AuditLog.Enabled)
+}
+
 func TestGetEnvironment(t *testing.T) {
 	cases := []struct {
 		name     string
@@ -99,6 +154,52 @@ func TestGetEnvironment(t *testing.T) {
 	}
 }
 
+func TestInit_GRPCReflection(t *testing.T) {
+	cases := []struct {
+		name       string
+		env        string
+		reflection string
+		expected   bool
+	}{
+		{
+			name:     "enabled by default outside production",
+			env:      "development",
+			expected: true,
+		},
+		{
+			name:     "disabled by default in production",
+			env:      "production",
+			expected: false,
+		},
+		{
+			name:       "explicitly enabled in production",
+			env:        "production",
+			reflection: "true",
+			expected:   true,
+		},
+		{
+			name:       "explicitly disabled outside production",
+			env:        "staging",
+			reflection: "false",
+			expected:   false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("APP_ENVIRONMENT", tc.env)
+
+			if tc.reflection != "" {
+				t.Setenv("GRPC_ENABLE_REFLECTION", tc.reflection)
+			}
+
+			cfg, err := Init()
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, cfg.GRPCServer.EnableReflection)
+		})
+	}
+}
+
 func TestIsProduction(t *testing.T) {
 	cases := []struct {
 		name     string
@@ -20,6 +20,9 @@ type (
 		SecretsStorage SecretsStorage `json:"secrets_storage"`
 		GRPCServer     GRPCServer     `json:"grpc_server"`
 		Database       Database       `json:"database"`
+		DeviceRecovery DeviceRecovery `json:"device_recovery"`
+		Pagination     Pagination     `json:"pagination"`
+		AuditLog       AuditLog       `json:"audit_log"`
 		Cache          Cache          `json:"cache"`
 		Logging        Logging        `json:"logging"`
 		Telemetry      Telemetry      `json:"telemetry"`
@@ -51,11 +54,37 @@ type (
 	}
 
 	GRPCServer struct {
-		Host            string        `envconfig:"GRPC_SERVER_HOST" default:"0.0.0.0" json:"host"`
-		Port            uint          `envconfig:"GRPC_SERVER_PORT" default:"9090" json:"port"`
-		ShutdownTimeout time.Duration `envconfig:"GRPC_SHUTDOWN_TIMEOUT" default:"30s" json:"shutdown_timeout"`
-		MaxRecvMsgSize  int           `envconfig:"GRPC_MAX_RECV_MSG_SIZE" default:"4194304" json:"max_recv_msg_size"`
-		MaxSendMsgSize  int           `envconfig:"GRPC_MAX_SEND_MSG_SIZE" default:"4194304" json:"max_send_msg_size"`
+		Host             string          `envconfig:"GRPC_SERVER_HOST" default:"0.0.0.0" json:"host"`
+		Port             uint            `envconfig:"GRPC_SERVER_PORT" default:"9090" json:"port"`
+		ShutdownTimeout  time.Duration   `envconfig:"GRPC_SHUTDOWN_TIMEOUT" default:"30s" json:"shutdown_timeout"`
+		MaxRecvMsgSize   int             `envconfig:"GRPC_MAX_RECV_MSG_SIZE" default:"4194304" json:"max_recv_msg_size"`
+		MaxSendMsgSize   int             `envconfig:"GRPC_MAX_SEND_MSG_SIZE" default:"4194304" json:"max_send_msg_size"`
+		MaxRequestSize   int             `envconfig:"GRPC_MAX_REQUEST_SIZE" default:"0" json:"max_request_size"`
+		RequiredMetadata []string        `envconfig:"GRPC_REQUIRED_METADATA" default:"" json:"required_metadata"`
+		StreamRateLimit  StreamRateLimit `json:"stream_rate_limit"`
+		// EnableReflection registers the gRPC reflection service, e.g. for grpcurl. When
+		// GRPC_ENABLE_REFLECTION is unset it defaults to true outside production.
+		EnableReflection bool `envconfig:"GRPC_ENABLE_REFLECTION" default:"true" json:"enable_reflection"`
+		// MaxConcurrentStreams caps concurrent streams per client connection; 0 keeps the gRPC default.
+		MaxConcurrentStreams uint32        `envconfig:"GRPC_MAX_CONCURRENT_STREAMS" default:"0" json:"max_concurrent_streams"`
+		Keepalive            GRPCKeepalive `json:"keepalive"`
+	}
+
+	// GRPCKeepalive pings idle connections so dead peers are detected and dropped.
+	// Time and Timeout drive the server's own pings; MinTime and PermitWithoutStream
+	// bound the pings clients may send before the server closes the connection.
+	GRPCKeepalive struct {
+		Time                time.Duration `envconfig:"GRPC_KEEPALIVE_TIME" default:"30s" json:"time"`
+		Timeout             time.Duration `envconfig:"GRPC_KEEPALIVE_TIMEOUT" default:"10s" json:"timeout"`
+		MinTime             time.Duration `envconfig:"GRPC_KEEPALIVE_MIN_TIME" default:"5s" json:"min_time"`
+		PermitWithoutStream bool          `envconfig:"GRPC_KEEPALIVE_PERMIT_WITHOUT_STREAM" default:"true" json:"permit_without_stream"`
+	}
+
+	// StreamRateLimit throttles the messages server streams send per peer IP.
+	// A zero RPS disables the limiter.
+	StreamRateLimit struct {
+		RPS   uint `envconfig:"GRPC_STREAM_RATE_LIMIT_RPS" default:"0" json:"rps"`
+		Burst uint `envconfig:"GRPC_STREAM_RATE_LIMIT_BURST" default:"50" json:"burst"`
 	}
 
 	Database struct {
@@ -70,6 +99,30 @@ type (
 		ConnectTimeout  time.Duration `envconfig:"POSTGRES_CONNECT_TIMEOUT" default:"10s" json:"connect_timeout"`
 		MaxConnLifetime time.Duration `envconfig:"POSTGRES_MAX_CONN_LIFETIME" default:"1h" json:"max_conn_lifetime"`
 		MaxConnIdleTime time.Duration `envconfig:"POSTGRES_MAX_CONN_IDLE_TIME" default:"30m" json:"max_conn_idle_time"`
+
+		// ReadReplicaHost, when set, serves the read-only device queries from a replica
+		// reached with the same port, credentials and pool settings as the primary.
+		ReadReplicaHost string `envconfig:"POSTGRES_READ_REPLICA_HOST" default:"" json:"read_replica_host,omitempty"`
+	}
+
+	// DeviceRecovery releases devices left in use for longer than RecoveryTimeout, e.g.
+	// because their holder crashed. Every Interval it recovers them BatchSize at a time.
+	DeviceRecovery struct {
+		Enabled         bool          `envconfig:"DEVICE_RECOVERY_ENABLED" default:"false" json:"enabled"`
+		RecoveryTimeout time.Duration `envconfig:"DEVICE_RECOVERY_TIMEOUT" default:"30m" json:"recovery_timeout"`
+		BatchSize       uint          `envconfig:"DEVICE_RECOVERY_BATCH_SIZE" default:"100" json:"batch_size"`
+		Interval        time.Duration `envconfig:"DEVICE_RECOVERY_INTERVAL" default:"1m" json:"interval"`
+	}
+
+	// Pagination holds the secret list cursors are signed with. Every replica must share
+	// it; when it is empty each instance signs with a random secret of its own.
+	Pagination struct {
+		CursorSecret string `envconfig:"PAGINATION_CURSOR_SECRET" default:"" json:"cursor_secret,omitempty"`
+	}
+
+	// AuditLog records every device mutation in the device_audit_log table.
+	AuditLog struct {
+		Enabled bool `envconfig:"AUDIT_LOG_ENABLED" default:"true" json:"enabled"`
 	}
 
 	Cache struct {
@@ -0,0 +1,40 @@
+package model
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	AuditOperationCreate  = "create"
+	AuditOperationUpdate  = "update"
+	AuditOperationDelete  = "delete"
+	AuditOperationRecover = "recover"
+)
+
+// AuditEntry records one mutation of a device. Before is nil for a creation and After is
+// nil for a deletion.
+type AuditEntry struct {
+	Timestamp time.Time
+	Operation string
+	DeviceID  DeviceID
+	Actor     string
+	Before    *Device
+	After     *Device
+}
+
+type actorContextKey struct{}
+
+// WithActor returns a context carrying the identity of the caller performing a mutation.
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorContextKey{}, actor)
+}
+
+// ActorFromContext returns the caller set by WithActor, or an empty string.
+func ActorFromContext(ctx context.Context) string {
+	if actor, ok := ctx.Value(actorContextKey{}).(string); ok {
+		return actor
+	}
+
+	return ""
+}
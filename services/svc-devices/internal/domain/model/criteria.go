@@ -38,7 +38,9 @@ func (c Criteria) HasSorting() bool     { return len(c.sorting) > 0 }
 func (c Criteria) HasPagination() bool  { return c.page > 0 && c.size > 0 }
 func (c Criteria) HasCursor() bool      { return c.cursor != nil }
 
-func FromDeviceFilter(filter DeviceFilter) Criteria {
+// FromDeviceFilter builds the criteria matching filter, resuming after cursor when it is
+// set. Callers decode filter.Cursor with a CursorCodec, which rejects tampered tokens.
+func FromDeviceFilter(filter DeviceFilter, cursor *Cursor) Criteria {
 	builder := NewCriteria()
 
 	if filter.Keyword != "" {
@@ -66,11 +68,8 @@ func FromDeviceFilter(filter DeviceFilter) Criteria {
 		builder.OrderBy("-createdAt")
 	}
 
-	if filter.Cursor != "" {
-		cursor, err := DecodeCursor(filter.Cursor)
-		if err == nil {
-			builder.WithCursor(&cursor)
-		}
+	if cursor != nil {
+		builder.WithCursor(cursor)
 	}
 
 	builder.Paginate(filter.Page, filter.Size)
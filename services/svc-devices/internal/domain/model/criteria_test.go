@@ -322,7 +322,7 @@ func TestFromDeviceFilter(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			criteria := model.FromDeviceFilter(tc.filter)
+			criteria := model.FromDeviceFilter(tc.filter, nil)
 
 			require.Equal(t, tc.expectedHasSpec, criteria.HasSpec())
 			require.Equal(t, tc.expectedPage, criteria.Page())
@@ -341,7 +341,7 @@ func TestFromDeviceFilter_WithSort(t *testing.T) {
 		Size: 20,
 	}
 
-	criteria := model.FromDeviceFilter(filter)
+	criteria := model.FromDeviceFilter(filter, nil)
 
 	require.True(t, criteria.HasSorting())
 	require.Len(t, criteria.Sorting(), 1)
@@ -358,7 +358,7 @@ func TestFromDeviceFilter_WithMultiFieldSort(t *testing.T) {
 		Size: 20,
 	}
 
-	criteria := model.FromDeviceFilter(filter)
+	criteria := model.FromDeviceFilter(filter, nil)
 
 	require.True(t, criteria.HasSorting())
 	require.Len(t, criteria.Sorting(), 3)
@@ -381,7 +381,7 @@ func TestFromDeviceFilter_DefaultSort(t *testing.T) {
 		Size: 20,
 	}
 
-	criteria := model.FromDeviceFilter(filter)
+	criteria := model.FromDeviceFilter(filter, nil)
 
 	require.True(t, criteria.HasSorting())
 	require.Len(t, criteria.Sorting(), 1)
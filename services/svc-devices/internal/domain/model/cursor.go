@@ -1,15 +1,10 @@
 package model
 
 import (
-	"encoding/base64"
-	"encoding/json"
 	"errors"
-	"fmt"
 	"time"
-)
 
-var (
-	ErrInvalidCursor = errors.New("invalid cursor")
+	"github.com/architeacher/devices/pkg/cursor"
 )
 
 type (
@@ -23,6 +18,12 @@ type (
 		ID        string          `json:"id"`
 		Direction CursorDirection `json:"d"`
 	}
+
+	// CursorCodec issues cursors as opaque tokens signed with HMAC-SHA256 and only
+	// accepts tokens it issued, so clients cannot forge or alter a page position.
+	CursorCodec struct {
+		codec *cursor.CursorCodec
+	}
 )
 
 const (
@@ -30,33 +31,34 @@ const (
 	CursorDirectionPrev CursorDirection = "prev"
 )
 
-// EncodeCursor serializes a cursor to a URL-safe base64 string.
-func EncodeCursor(c Cursor) (string, error) {
-	data, err := json.Marshal(c)
-	if err != nil {
-		return "", fmt.Errorf("marshal cursor: %w", err)
-	}
+// NewCursorCodec creates a codec signing cursors with secret. Every replica must share
+// the secret, or cursors issued by one are rejected by the others.
+func NewCursorCodec(secret []byte) CursorCodec {
+	return CursorCodec{codec: cursor.NewCursor(secret)}
+}
 
-	return base64.RawURLEncoding.EncodeToString(data), nil
+// Encode serializes c into a signed, URL-safe token.
+func (c CursorCodec) Encode(cur Cursor) (string, error) {
+	return c.codec.EncodeValue(cur)
 }
 
-// DecodeCursor deserializes a cursor from a base64 string.
-func DecodeCursor(encoded string) (Cursor, error) {
-	if encoded == "" {
+// Decode verifies token and returns the cursor it holds. Tokens that are empty, were
+// tampered with or were signed with another secret fail with ErrInvalidCursor.
+func (c CursorCodec) Decode(token string) (Cursor, error) {
+	if token == "" {
 		return Cursor{}, ErrInvalidCursor
 	}
 
-	data, err := base64.RawURLEncoding.DecodeString(encoded)
-	if err != nil {
-		return Cursor{}, fmt.Errorf("%w: %v", ErrInvalidCursor, err)
+	var cur Cursor
+	if err := c.codec.DecodeValue(token, &cur); err != nil {
+		return Cursor{}, ErrInvalidCursor.WithCause(err)
 	}
 
-	var c Cursor
-	if err := json.Unmarshal(data, &c); err != nil {
-		return Cursor{}, fmt.Errorf("%w: %v", ErrInvalidCursor, err)
+	if _, err := cur.ParseCursorValue(); err != nil {
+		return Cursor{}, err
 	}
 
-	return c, nil
+	return cur, nil
 }
 
 // NewCursorFromDevice creates a cursor from a device for the given sort field.
@@ -92,10 +94,15 @@ func (c *Cursor) ParseCursorValue() (any, error) {
 	case "created_at", "-created_at", "createdAt", "-createdAt",
 		"updated_at", "-updated_at", "updatedAt", "-updatedAt":
 		if strVal, ok := c.Value.(string); ok {
-			return time.Parse(time.RFC3339Nano, strVal)
+			value, err := time.Parse(time.RFC3339Nano, strVal)
+			if err != nil {
+				return nil, ErrInvalidCursor.WithCause(err)
+			}
+
+			return value, nil
 		}
 
-		return nil, fmt.Errorf("%w: expected time string", ErrInvalidCursor)
+		return nil, ErrInvalidCursor.WithCause(errors.New("expected time string"))
 	default:
 		return c.Value, nil
 	}
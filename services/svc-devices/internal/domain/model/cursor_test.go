@@ -8,9 +8,11 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-func TestCursorEncodeDecode(t *testing.T) {
+func TestCursorCodec_RoundTrip(t *testing.T) {
 	t.Parallel()
 
+	codec := model.NewCursorCodec([]byte("test-secret"))
+
 	cases := []struct {
 		name   string
 		cursor model.Cursor
@@ -48,11 +50,11 @@ func TestCursorEncodeDecode(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			encoded, err := model.EncodeCursor(tc.cursor)
+			encoded, err := codec.Encode(tc.cursor)
 			require.NoError(t, err)
 			require.NotEmpty(t, encoded)
 
-			decoded, err := model.DecodeCursor(encoded)
+			decoded, err := codec.Decode(encoded)
 			require.NoError(t, err)
 			require.Equal(t, tc.cursor.Field, decoded.Field)
 			require.Equal(t, tc.cursor.ID, decoded.ID)
@@ -61,9 +63,23 @@ func TestCursorEncodeDecode(t *testing.T) {
 	}
 }
 
-func TestDecodeCursor_InvalidInput(t *testing.T) {
+func TestCursorCodec_DecodeInvalidInput(t *testing.T) {
 	t.Parallel()
 
+	codec := model.NewCursorCodec([]byte("test-secret"))
+
+	valid, err := codec.Encode(model.Cursor{Field: "name", Value: "iPhone", ID: "550e8400-e29b-41d4-a716-446655440000"})
+	require.NoError(t, err)
+
+	foreign, err := model.NewCursorCodec([]byte("other-secret")).Encode(model.Cursor{Field: "name", Value: "iPhone"})
+	require.NoError(t, err)
+
+	badTime, err := codec.Encode(model.Cursor{Field: "-createdAt", Value: "not-a-timestamp"})
+	require.NoError(t, err)
+
+	tampered := []byte(valid)
+	tampered[0] ^= 1
+
 	cases := []struct {
 		name    string
 		encoded string
@@ -77,8 +93,20 @@ func TestDecodeCursor_InvalidInput(t *testing.T) {
 			encoded: "not-valid-base64!!!",
 		},
 		{
-			name:    "valid base64 but invalid json",
-			encoded: "bm90LWpzb24",
+			name:    "unsigned cursor",
+			encoded: "eyJmIjoibmFtZSIsInYiOiJpUGhvbmUiLCJpZCI6IngiLCJkIjoibmV4dCJ9",
+		},
+		{
+			name:    "tampered cursor",
+			encoded: string(tampered),
+		},
+		{
+			name:    "signed with another secret",
+			encoded: foreign,
+		},
+		{
+			name:    "signed but unparsable value",
+			encoded: badTime,
 		},
 	}
 
@@ -86,7 +114,7 @@ func TestDecodeCursor_InvalidInput(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			_, err := model.DecodeCursor(tc.encoded)
+			_, err := codec.Decode(tc.encoded)
 			require.Error(t, err)
 			require.ErrorIs(t, err, model.ErrInvalidCursor)
 		})
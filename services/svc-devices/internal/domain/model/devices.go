@@ -1,11 +1,22 @@
 package model
 
 import (
+	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
 )
 
+// urnUUIDPrefix is the RFC 4122 URN namespace some external systems prepend to IDs.
+const urnUUIDPrefix = "urn:uuid:"
+
+// shortIDLength is the number of hex characters ShortID keeps.
+const shortIDLength = 8
+
 type DeviceID struct {
 	uuid.UUID
 }
@@ -14,7 +25,12 @@ func NewDeviceID() DeviceID {
 	return DeviceID{UUID: uuid.Must(uuid.NewV7())}
 }
 
+// ParseDeviceID parses a plain UUID or one in URN form (urn:uuid:...), ignoring case.
 func ParseDeviceID(s string) (DeviceID, error) {
+	if len(s) > len(urnUUIDPrefix) && strings.EqualFold(s[:len(urnUUIDPrefix)], urnUUIDPrefix) {
+		s = s[len(urnUUIDPrefix):]
+	}
+
 	id, err := uuid.Parse(s)
 	if err != nil {
 		return DeviceID{}, err
@@ -27,10 +43,36 @@ func (d DeviceID) String() string {
 	return d.UUID.String()
 }
 
+// ShortID returns 8 hex characters identifying the device in log messages and UI
+// labels. They are taken from the end of the UUID: IDs are UUIDv7, whose leading
+// characters encode the creation time and repeat across devices created together.
+func (d DeviceID) ShortID() string {
+	id := d.UUID.String()
+
+	return id[len(id)-shortIDLength:]
+}
+
 func (d DeviceID) IsZero() bool {
 	return d.UUID == uuid.Nil
 }
 
+// MarshalText implements encoding.TextMarshaler using the canonical UUID form.
+func (d DeviceID) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, accepting anything ParseDeviceID does.
+func (d *DeviceID) UnmarshalText(text []byte) error {
+	id, err := ParseDeviceID(string(text))
+	if err != nil {
+		return err
+	}
+
+	*d = id
+
+	return nil
+}
+
 type Device struct {
 	ID        DeviceID
 	Name      string
@@ -38,19 +80,60 @@ type Device struct {
 	State     State
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	// Metadata holds type-specific properties, such as a sensor's sampling rate,
+	// that have no dedicated field.
+	Metadata map[string]any
 }
 
 func NewDevice(name, brand string, state State) *Device {
+	// A freshly generated ID is never zero, so the error can be ignored.
+	device, _ := NewDeviceWithID(NewDeviceID(), name, brand, state)
+
+	return device
+}
+
+// NewDeviceWithID creates a device with a caller-supplied ID, letting import
+// workflows create-or-skip idempotently. A zero ID is rejected.
+func NewDeviceWithID(id DeviceID, name, brand string, state State) (*Device, error) {
+	if id.IsZero() {
+		return nil, ErrInvalidDeviceID
+	}
+
 	now := time.Now().UTC()
 
 	return &Device{
-		ID:        NewDeviceID(),
+		ID:        id,
 		Name:      name,
 		Brand:     brand,
 		State:     state,
 		CreatedAt: now,
 		UpdatedAt: now,
+	}, nil
+}
+
+// NormalizeDevice canonicalizes a device name and brand, so values differing only in
+// whitespace or brand casing are stored alike: both are trimmed with inner runs of
+// whitespace collapsed to one space, and the brand is title-cased. Brand words already
+// written in capitals, such as "HP" or "LG", are kept as they are.
+func NormalizeDevice(name, brand string) (string, string) {
+	return collapseSpaces(name), titleBrand(brand)
+}
+
+func titleBrand(brand string) string {
+	caser := cases.Title(language.Und)
+	words := strings.Fields(brand)
+
+	for i, word := range words {
+		if strings.ToUpper(word) != word {
+			words[i] = caser.String(word)
+		}
 	}
+
+	return strings.Join(words, " ")
+}
+
+func collapseSpaces(s string) string {
+	return strings.Join(strings.Fields(s), " ")
 }
 
 func (d *Device) CanUpdateNameAndBrand() bool {
@@ -107,6 +190,12 @@ func (d *Device) Patch(updates map[string]any) error {
 	return nil
 }
 
+// MaxPageSize is the largest page a device list may request.
+const MaxPageSize uint = 500
+
+// sortableFields lists the device fields a list may be sorted by, optionally prefixed with "-".
+var sortableFields = []string{"id", "name", "brand", "state", "createdAt", "updatedAt"}
+
 type DeviceFilter struct {
 	Keyword string
 	Brands  []string
@@ -125,6 +214,36 @@ func DefaultDeviceFilter() DeviceFilter {
 	}
 }
 
+// Validate reports every out-of-range or unsupported field in the filter, so callers
+// can reject the request instead of silently clamping it.
+func (f *DeviceFilter) Validate() error {
+	errs := &ValidationErrors{}
+
+	if f.Page < 1 {
+		errs.Add("page", "page must be greater than or equal to 1", ValidationCodeOutOfRange)
+	}
+
+	if f.Size < 1 || f.Size > MaxPageSize {
+		errs.Add("size", fmt.Sprintf("size must be between 1 and %d", MaxPageSize), ValidationCodeOutOfRange)
+	}
+
+	for _, field := range f.Sort {
+		if !slices.Contains(sortableFields, strings.TrimPrefix(field, "-")) {
+			errs.Add(
+				"sort",
+				fmt.Sprintf("unsupported sort field %q: must be one of %s", field, strings.Join(sortableFields, ", ")),
+				ValidationCodeInvalidEnum,
+			)
+		}
+	}
+
+	if errs.HasErrors() {
+		return errs
+	}
+
+	return nil
+}
+
 type Pagination struct {
 	Page           uint
 	Size           uint
@@ -136,6 +255,40 @@ type Pagination struct {
 	PreviousCursor string
 }
 
+// Compute sets the page, size and item count together with the fields derived
+// from them. A zero size or item count yields no pages and no neighbours.
+func (p *Pagination) Compute(page, size, totalItems uint) {
+	p.Page = page
+	p.Size = size
+	p.TotalItems = totalItems
+	p.TotalPages = 0
+
+	if size > 0 {
+		p.TotalPages = (totalItems + size - 1) / size
+	}
+
+	p.HasNext = page < p.TotalPages
+	p.HasPrevious = page > 1 && p.TotalPages > 0
+}
+
+// NextPage returns the number of the following page, or 0 when there is none.
+func (p *Pagination) NextPage() uint {
+	if !p.HasNext {
+		return 0
+	}
+
+	return p.Page + 1
+}
+
+// PrevPage returns the number of the preceding page, or 0 when there is none.
+func (p *Pagination) PrevPage() uint {
+	if !p.HasPrevious {
+		return 0
+	}
+
+	return p.Page - 1
+}
+
 type DeviceList struct {
 	Devices    []*Device
 	Pagination Pagination
@@ -1,6 +1,7 @@
 package model_test
 
 import (
+	"encoding/json"
 	"testing"
 	"time"
 
@@ -24,18 +25,39 @@ func TestParseDeviceID(t *testing.T) {
 	cases := []struct {
 		name        string
 		input       string
+		expected    string
 		expectError bool
 	}{
 		{
-			name:        "valid UUID",
-			input:       "019426d2-5b1e-7c8a-9f3e-123456789abc",
-			expectError: false,
+			name:     "valid UUID",
+			input:    "019426d2-5b1e-7c8a-9f3e-123456789abc",
+			expected: "019426d2-5b1e-7c8a-9f3e-123456789abc",
+		},
+		{
+			name:     "URN-prefixed UUID",
+			input:    "urn:uuid:019426d2-5b1e-7c8a-9f3e-123456789abc",
+			expected: "019426d2-5b1e-7c8a-9f3e-123456789abc",
+		},
+		{
+			name:     "uppercase UUID",
+			input:    "019426D2-5B1E-7C8A-9F3E-123456789ABC",
+			expected: "019426d2-5b1e-7c8a-9f3e-123456789abc",
+		},
+		{
+			name:     "uppercase URN-prefixed UUID",
+			input:    "URN:UUID:019426D2-5B1E-7C8A-9F3E-123456789ABC",
+			expected: "019426d2-5b1e-7c8a-9f3e-123456789abc",
 		},
 		{
 			name:        "invalid UUID",
 			input:       "not-a-uuid",
 			expectError: true,
 		},
+		{
+			name:        "URN prefix without UUID",
+			input:       "urn:uuid:not-a-uuid",
+			expectError: true,
+		},
 		{
 			name:        "empty string",
 			input:       "",
@@ -55,12 +77,61 @@ func TestParseDeviceID(t *testing.T) {
 			} else {
 				require.NoError(t, err)
 				require.False(t, id.IsZero())
-				require.Equal(t, tc.input, id.String())
+				require.Equal(t, tc.expected, id.String())
 			}
 		})
 	}
 }
 
+func TestParseDeviceID_NilUUID(t *testing.T) {
+	t.Parallel()
+
+	id, err := model.ParseDeviceID(uuid.Nil.String())
+
+	require.NoError(t, err)
+	require.True(t, id.IsZero())
+}
+
+func TestDeviceID_TextMarshaling(t *testing.T) {
+	t.Parallel()
+
+	type payload struct {
+		ID model.DeviceID `json:"id"`
+	}
+
+	t.Run("round trips through JSON", func(t *testing.T) {
+		t.Parallel()
+
+		id := model.NewDeviceID()
+
+		data, err := json.Marshal(payload{ID: id})
+		require.NoError(t, err)
+		require.JSONEq(t, `{"id":"`+id.String()+`"}`, string(data))
+
+		var decoded payload
+		require.NoError(t, json.Unmarshal(data, &decoded))
+		require.Equal(t, id, decoded.ID)
+	})
+
+	t.Run("unmarshals URN-prefixed UUID", func(t *testing.T) {
+		t.Parallel()
+
+		var id model.DeviceID
+
+		require.NoError(t, id.UnmarshalText([]byte("urn:uuid:019426d2-5b1e-7c8a-9f3e-123456789abc")))
+		require.Equal(t, "019426d2-5b1e-7c8a-9f3e-123456789abc", id.String())
+	})
+
+	t.Run("rejects invalid UUID", func(t *testing.T) {
+		t.Parallel()
+
+		var id model.DeviceID
+
+		require.Error(t, id.UnmarshalText([]byte("not-a-uuid")))
+		require.True(t, id.IsZero())
+	})
+}
+
 func TestDeviceID_String(t *testing.T) {
 	t.Parallel()
 
@@ -71,6 +142,47 @@ func TestDeviceID_String(t *testing.T) {
 	require.Equal(t, expectedID, id.String())
 }
 
+func TestDeviceID_ShortID(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		id       model.DeviceID
+		expected string
+	}{
+		{
+			name:     "last 8 hex characters of the UUID",
+			id:       model.DeviceID{UUID: uuid.MustParse("019426d2-5b1e-7c8a-9f3e-123456789abc")},
+			expected: "56789abc",
+		},
+		{
+			name:     "zero value",
+			id:       model.DeviceID{},
+			expected: "00000000",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tc.expected, tc.id.ShortID())
+		})
+	}
+
+	t.Run("exactly 8 hex characters", func(t *testing.T) {
+		t.Parallel()
+
+		require.Regexp(t, `^[0-9a-f]{8}$`, model.NewDeviceID().ShortID())
+	})
+
+	t.Run("differs between devices created together", func(t *testing.T) {
+		t.Parallel()
+
+		require.NotEqual(t, model.NewDeviceID().ShortID(), model.NewDeviceID().ShortID())
+	})
+}
+
 func TestDeviceID_IsZero(t *testing.T) {
 	t.Parallel()
 
@@ -120,6 +232,139 @@ func TestNewDevice(t *testing.T) {
 	require.Equal(t, device.CreatedAt, device.UpdatedAt)
 }
 
+func TestNewDeviceWithID(t *testing.T) {
+	t.Parallel()
+
+	t.Run("uses the provided ID", func(t *testing.T) {
+		t.Parallel()
+
+		id, err := model.ParseDeviceID("019426d2-5b1e-7c8a-9f3e-123456789abc")
+		require.NoError(t, err)
+
+		before := time.Now().UTC()
+		device, err := model.NewDeviceWithID(id, "iPhone", "Apple", model.StateInUse)
+
+		require.NoError(t, err)
+		require.Equal(t, id, device.ID)
+		require.Equal(t, "iPhone", device.Name)
+		require.Equal(t, "Apple", device.Brand)
+		require.Equal(t, model.StateInUse, device.State)
+		require.False(t, device.CreatedAt.Before(before))
+		require.Equal(t, device.CreatedAt, device.UpdatedAt)
+		require.Equal(t, time.UTC, device.CreatedAt.Location())
+	})
+
+	t.Run("zero ID is rejected", func(t *testing.T) {
+		t.Parallel()
+
+		device, err := model.NewDeviceWithID(model.DeviceID{UUID: uuid.Nil}, "iPhone", "Apple", model.StateAvailable)
+
+		require.ErrorIs(t, err, model.ErrInvalidDeviceID)
+		require.Nil(t, device)
+	})
+
+	t.Run("NewDevice still generates distinct non-zero IDs", func(t *testing.T) {
+		t.Parallel()
+
+		first := model.NewDevice("iPhone", "Apple", model.StateAvailable)
+		second := model.NewDevice("iPhone", "Apple", model.StateAvailable)
+
+		require.False(t, first.ID.IsZero())
+		require.False(t, second.ID.IsZero())
+		require.NotEqual(t, first.ID, second.ID)
+	})
+}
+
+func TestNormalizeDevice(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name          string
+		inputName     string
+		inputBrand    string
+		expectedName  string
+		expectedBrand string
+	}{
+		{
+			name:          "leading spaces",
+			inputName:     "   iPhone 15",
+			inputBrand:    "  Apple",
+			expectedName:  "iPhone 15",
+			expectedBrand: "Apple",
+		},
+		{
+			name:          "trailing spaces",
+			inputName:     "iPhone 15  ",
+			inputBrand:    "Apple\t\n",
+			expectedName:  "iPhone 15",
+			expectedBrand: "Apple",
+		},
+		{
+			name:          "lower-case brand is title-cased",
+			inputName:     "Galaxy S24",
+			inputBrand:    "samsung",
+			expectedName:  "Galaxy S24",
+			expectedBrand: "Samsung",
+		},
+		{
+			name:          "all-caps brand is kept",
+			inputName:     "Pavilion 15",
+			inputBrand:    "HP",
+			expectedName:  "Pavilion 15",
+			expectedBrand: "HP",
+		},
+		{
+			name:          "all-caps words of a brand are kept",
+			inputName:     "OLED C3",
+			inputBrand:    "LG  electronics",
+			expectedName:  "OLED C3",
+			expectedBrand: "LG Electronics",
+		},
+		{
+			name:          "mixed internal spaces collapse to one",
+			inputName:     "Pixel    8 \t Pro",
+			inputBrand:    "google   pixel",
+			expectedName:  "Pixel 8 Pro",
+			expectedBrand: "Google Pixel",
+		},
+		{
+			name:          "name casing is kept",
+			inputName:     "iPHONE se",
+			inputBrand:    "apple",
+			expectedName:  "iPHONE se",
+			expectedBrand: "Apple",
+		},
+		{
+			name:          "unicode brand without letter case",
+			inputName:     " Mate 60 ",
+			inputBrand:    "  华为  ",
+			expectedName:  "Mate 60",
+			expectedBrand: "华为",
+		},
+		{
+			name:          "unicode brand with letter case",
+			inputName:     "Ω1",
+			inputBrand:    "électro  österreich",
+			expectedName:  "Ω1",
+			expectedBrand: "Électro Österreich",
+		},
+		{
+			name: "empty values stay empty",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			name, brand := model.NormalizeDevice(tc.inputName, tc.inputBrand)
+
+			require.Equal(t, tc.expectedName, name)
+			require.Equal(t, tc.expectedBrand, brand)
+		})
+	}
+}
+
 func TestDevice_CanUpdateNameAndBrand(t *testing.T) {
 	t.Parallel()
 
@@ -340,3 +585,193 @@ func TestDefaultDeviceFilter(t *testing.T) {
 	require.Empty(t, filter.Brands)
 	require.Empty(t, filter.States)
 }
+
+func TestPagination_Compute(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name            string
+		page            uint
+		size            uint
+		totalItems      uint
+		expectedPages   uint
+		expectedHasNext bool
+		expectedHasPrev bool
+		expectedNext    uint
+		expectedPrev    uint
+	}{
+		{
+			name:            "page 1 of 1",
+			page:            1,
+			size:            20,
+			totalItems:      20,
+			expectedPages:   1,
+			expectedHasNext: false,
+			expectedHasPrev: false,
+		},
+		{
+			name:            "page 1 of many",
+			page:            1,
+			size:            10,
+			totalItems:      35,
+			expectedPages:   4,
+			expectedHasNext: true,
+			expectedHasPrev: false,
+			expectedNext:    2,
+		},
+		{
+			name:            "middle page",
+			page:            2,
+			size:            10,
+			totalItems:      35,
+			expectedPages:   4,
+			expectedHasNext: true,
+			expectedHasPrev: true,
+			expectedNext:    3,
+			expectedPrev:    1,
+		},
+		{
+			name:            "last page",
+			page:            4,
+			size:            10,
+			totalItems:      35,
+			expectedPages:   4,
+			expectedHasNext: false,
+			expectedHasPrev: true,
+			expectedPrev:    3,
+		},
+		{
+			name:            "single item",
+			page:            1,
+			size:            20,
+			totalItems:      1,
+			expectedPages:   1,
+			expectedHasNext: false,
+			expectedHasPrev: false,
+		},
+		{
+			name:            "zero items",
+			page:            1,
+			size:            20,
+			totalItems:      0,
+			expectedPages:   0,
+			expectedHasNext: false,
+			expectedHasPrev: false,
+		},
+		{
+			name:            "zero items beyond first page",
+			page:            3,
+			size:            20,
+			totalItems:      0,
+			expectedPages:   0,
+			expectedHasNext: false,
+			expectedHasPrev: false,
+		},
+		{
+			name:            "zero size",
+			page:            1,
+			size:            0,
+			totalItems:      5,
+			expectedPages:   0,
+			expectedHasNext: false,
+			expectedHasPrev: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var pagination model.Pagination
+			pagination.Compute(tc.page, tc.size, tc.totalItems)
+
+			require.Equal(t, tc.page, pagination.Page)
+			require.Equal(t, tc.size, pagination.Size)
+			require.Equal(t, tc.totalItems, pagination.TotalItems)
+			require.Equal(t, tc.expectedPages, pagination.TotalPages)
+			require.Equal(t, tc.expectedHasNext, pagination.HasNext)
+			require.Equal(t, tc.expectedHasPrev, pagination.HasPrevious)
+			require.Equal(t, tc.expectedNext, pagination.NextPage())
+			require.Equal(t, tc.expectedPrev, pagination.PrevPage())
+		})
+	}
+}
+
+func TestDeviceFilter_Validate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("default filter is valid", func(t *testing.T) {
+		t.Parallel()
+
+		filter := model.DefaultDeviceFilter()
+
+		require.NoError(t, filter.Validate())
+	})
+
+	t.Run("every sortable field is valid in both directions", func(t *testing.T) {
+		t.Parallel()
+
+		filter := model.DefaultDeviceFilter()
+		filter.Size = model.MaxPageSize
+		filter.Sort = []string{"id", "-name", "brand", "-state", "createdAt", "-updatedAt"}
+
+		require.NoError(t, filter.Validate())
+	})
+
+	cases := []struct {
+		name          string
+		mutate        func(*model.DeviceFilter)
+		expectedField string
+		expectedCode  string
+	}{
+		{
+			name:          "page zero",
+			mutate:        func(f *model.DeviceFilter) { f.Page = 0 },
+			expectedField: "page",
+			expectedCode:  model.ValidationCodeOutOfRange,
+		},
+		{
+			name:          "size zero",
+			mutate:        func(f *model.DeviceFilter) { f.Size = 0 },
+			expectedField: "size",
+			expectedCode:  model.ValidationCodeOutOfRange,
+		},
+		{
+			name:          "size above maximum",
+			mutate:        func(f *model.DeviceFilter) { f.Size = model.MaxPageSize + 1 },
+			expectedField: "size",
+			expectedCode:  model.ValidationCodeOutOfRange,
+		},
+		{
+			name:          "unsupported sort field",
+			mutate:        func(f *model.DeviceFilter) { f.Sort = []string{"name", "-price"} },
+			expectedField: "sort",
+			expectedCode:  model.ValidationCodeInvalidEnum,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			filter := model.DefaultDeviceFilter()
+			tc.mutate(&filter)
+
+			var validationErrs *model.ValidationErrors
+			require.ErrorAs(t, filter.Validate(), &validationErrs)
+			require.Len(t, validationErrs.Errors, 1)
+			require.Equal(t, tc.expectedField, validationErrs.Errors[0].Field)
+			require.Equal(t, tc.expectedCode, validationErrs.Errors[0].Code)
+		})
+	}
+
+	t.Run("reports every invalid field", func(t *testing.T) {
+		t.Parallel()
+
+		filter := model.DeviceFilter{Page: 0, Size: 1000, Sort: []string{"price"}}
+
+		var validationErrs *model.ValidationErrors
+		require.ErrorAs(t, filter.Validate(), &validationErrs)
+		require.Len(t, validationErrs.Errors, 3)
+	})
+}
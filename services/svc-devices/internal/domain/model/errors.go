@@ -1,16 +1,103 @@
 package model
 
-import "errors"
+import (
+	"errors"
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+)
+
+// Codes carried by DomainError, matching those of the gateway's API error responses.
+const (
+	ErrorCodeNotFound      = "NOT_FOUND"
+	ErrorCodeConflict      = "CONFLICT"
+	ErrorCodeInvalidID     = "INVALID_ID"
+	ErrorCodeInvalidState  = "INVALID_STATE"
+	ErrorCodeInvalidCursor = "INVALID_CURSOR"
+	ErrorCodeInternalError = "INTERNAL_ERROR"
+)
 
 var (
-	ErrDeviceNotFound          = errors.New("device not found")
-	ErrCannotUpdateInUseDevice = errors.New("cannot update name or brand of in-use device")
-	ErrCannotDeleteInUseDevice = errors.New("cannot delete in-use device")
-	ErrInvalidDeviceID         = errors.New("invalid device ID")
-	ErrInvalidState            = errors.New("invalid device state")
-	ErrDuplicateDevice         = errors.New("device already exists")
-	ErrDatabaseConnection      = errors.New("database connection error")
-	ErrDatabaseQuery           = errors.New("database query error")
+	ErrDeviceNotFound          = newDomainError(ErrorCodeNotFound, http.StatusNotFound, codes.NotFound, "device not found")
+	ErrCannotUpdateInUseDevice = newDomainError(ErrorCodeConflict, http.StatusConflict, codes.FailedPrecondition, "cannot update name or brand of in-use device")
+	ErrCannotDeleteInUseDevice = newDomainError(ErrorCodeConflict, http.StatusConflict, codes.FailedPrecondition, "cannot delete in-use device")
+	ErrDeviceModified          = newDomainError(ErrorCodeConflict, http.StatusConflict, codes.FailedPrecondition, "device was modified concurrently")
+	ErrInvalidDeviceID         = newDomainError(ErrorCodeInvalidID, http.StatusBadRequest, codes.InvalidArgument, "invalid device ID")
+	ErrInvalidState            = newDomainError(ErrorCodeInvalidState, http.StatusBadRequest, codes.InvalidArgument, "invalid device state")
+	ErrInvalidCursor           = newDomainError(ErrorCodeInvalidCursor, http.StatusBadRequest, codes.InvalidArgument, "invalid cursor")
+	ErrDuplicateDevice         = newDomainError(ErrorCodeConflict, http.StatusConflict, codes.AlreadyExists, "device already exists")
+	ErrDatabaseConnection      = newDomainError(ErrorCodeInternalError, http.StatusInternalServerError, codes.Internal, "database connection error")
+	ErrDatabaseQuery           = newDomainError(ErrorCodeInternalError, http.StatusInternalServerError, codes.Internal, "database query error")
+	ErrBatchAborted            = newDomainError(ErrorCodeConflict, http.StatusConflict, codes.Aborted, "batch aborted by an earlier failure")
+)
+
+// DomainError is a domain failure carrying the HTTP status and gRPC code it is answered
+// with, so transports map it without knowing every error.
+type DomainError struct {
+	Code       string
+	HTTPStatus int
+	GRPCCode   codes.Code
+	Message    string
+	Cause      error
+
+	// kind is the sentinel a copy made by WithCause was derived from.
+	kind *DomainError
+}
+
+func newDomainError(code string, httpStatus int, grpcCode codes.Code, message string) *DomainError {
+	return &DomainError{Code: code, HTTPStatus: httpStatus, GRPCCode: grpcCode, Message: message}
+}
+
+func (e *DomainError) Error() string {
+	if e.Cause == nil {
+		return e.Message
+	}
+
+	return e.Message + ": " + e.Cause.Error()
+}
+
+func (e *DomainError) Unwrap() error {
+	return e.Cause
+}
+
+// Is reports whether target is the sentinel e is, or was derived from.
+func (e *DomainError) Is(target error) bool {
+	sentinel, ok := target.(*DomainError)
+
+	return ok && sentinel.sentinel() == e.sentinel()
+}
+
+// WithCause returns a copy of e wrapping cause, which still matches e with errors.Is.
+func (e *DomainError) WithCause(cause error) *DomainError {
+	wrapped := *e
+	wrapped.Cause = cause
+	wrapped.kind = e.sentinel()
+
+	return &wrapped
+}
+
+func (e *DomainError) sentinel() *DomainError {
+	if e.kind != nil {
+		return e.kind
+	}
+
+	return e
+}
+
+// IsDomainError returns the first DomainError in err's chain.
+func IsDomainError(err error) (*DomainError, bool) {
+	var domainErr *DomainError
+	if errors.As(err, &domainErr) {
+		return domainErr, true
+	}
+
+	return nil, false
+}
+
+// Machine-readable codes attached to validation errors.
+const (
+	ValidationCodeOutOfRange  = "OUT_OF_RANGE"
+	ValidationCodeInvalidEnum = "INVALID_ENUM_VALUE"
 )
 
 type ValidationError struct {
@@ -0,0 +1,70 @@
+package model_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/architeacher/devices/services/svc-devices/internal/domain/model"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func TestDomainError_SentinelCodes(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name       string
+		err        *model.DomainError
+		code       string
+		httpStatus int
+		grpcCode   codes.Code
+	}{
+		{"device not found", model.ErrDeviceNotFound, model.ErrorCodeNotFound, http.StatusNotFound, codes.NotFound},
+		{"cannot update in-use device", model.ErrCannotUpdateInUseDevice, model.ErrorCodeConflict, http.StatusConflict, codes.FailedPrecondition},
+		{"cannot delete in-use device", model.ErrCannotDeleteInUseDevice, model.ErrorCodeConflict, http.StatusConflict, codes.FailedPrecondition},
+		{"device modified", model.ErrDeviceModified, model.ErrorCodeConflict, http.StatusConflict, codes.FailedPrecondition},
+		{"invalid device ID", model.ErrInvalidDeviceID, model.ErrorCodeInvalidID, http.StatusBadRequest, codes.InvalidArgument},
+		{"invalid state", model.ErrInvalidState, model.ErrorCodeInvalidState, http.StatusBadRequest, codes.InvalidArgument},
+		{"duplicate device", model.ErrDuplicateDevice, model.ErrorCodeConflict, http.StatusConflict, codes.AlreadyExists},
+		{"database connection", model.ErrDatabaseConnection, model.ErrorCodeInternalError, http.StatusInternalServerError, codes.Internal},
+		{"database query", model.ErrDatabaseQuery, model.ErrorCodeInternalError, http.StatusInternalServerError, codes.Internal},
+		{"batch aborted", model.ErrBatchAborted, model.ErrorCodeConflict, http.StatusConflict, codes.Aborted},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tc.code, tc.err.Code)
+			require.Equal(t, tc.httpStatus, tc.err.HTTPStatus)
+			require.Equal(t, tc.grpcCode, tc.err.GRPCCode)
+			require.Equal(t, tc.err.Message, tc.err.Error())
+
+			domainErr, ok := model.IsDomainError(fmt.Errorf("%w: connection refused", tc.err))
+			require.True(t, ok)
+			require.Same(t, tc.err, domainErr)
+		})
+	}
+}
+
+func TestDomainError_WithCause(t *testing.T) {
+	t.Parallel()
+
+	cause := errors.New("connection refused")
+	err := model.ErrDatabaseConnection.WithCause(cause)
+
+	require.ErrorIs(t, err, model.ErrDatabaseConnection)
+	require.ErrorIs(t, err, cause)
+	require.NotErrorIs(t, err, model.ErrDatabaseQuery, "sentinels sharing a code stay distinct")
+	require.Equal(t, "database connection error: connection refused", err.Error())
+	require.Nil(t, model.ErrDatabaseConnection.Cause, "the sentinel is left untouched")
+}
+
+func TestIsDomainError_OtherErrors(t *testing.T) {
+	t.Parallel()
+
+	_, ok := model.IsDomainError(errors.New("connection reset"))
+	require.False(t, ok)
+}
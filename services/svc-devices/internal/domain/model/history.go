@@ -0,0 +1,48 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// HistoryOperation is the change a history snapshot records.
+type HistoryOperation string
+
+const (
+	// HistoryOperationUpdate records an update or patch of the device.
+	HistoryOperationUpdate HistoryOperation = "update"
+	// HistoryOperationDelete records the device as it was when deleted.
+	HistoryOperationDelete HistoryOperation = "delete"
+	// HistoryOperationRecover records the release of a stale in-use device.
+	HistoryOperationRecover HistoryOperation = "recover"
+)
+
+func (o HistoryOperation) String() string {
+	return string(o)
+}
+
+// ParseHistoryOperation returns the history operation named by s.
+func ParseHistoryOperation(s string) (HistoryOperation, error) {
+	switch operation := HistoryOperation(s); operation {
+	case HistoryOperationUpdate, HistoryOperationDelete, HistoryOperationRecover:
+		return operation, nil
+	default:
+		return "", fmt.Errorf("invalid history operation: %s", s)
+	}
+}
+
+// DeviceHistoryEntry is an immutable snapshot of a device at one of its versions.
+// Versions start at 1 and grow by one with every snapshot of the device.
+type DeviceHistoryEntry struct {
+	EventID   uuid.UUID
+	DeviceID  DeviceID
+	Name      string
+	Brand     string
+	State     State
+	Operation HistoryOperation
+	Version   int
+	ChangedAt time.Time
+	ChangedBy string
+}
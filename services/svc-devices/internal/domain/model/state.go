@@ -26,6 +26,21 @@ func (s State) IsValid() bool {
 	}
 }
 
+// Validate reports why s is not one of the canonical states, so values received
+// over the wire are rejected instead of being coerced.
+func (s State) Validate() error {
+	if s.IsValid() {
+		return nil
+	}
+
+	valid := make([]string, 0, len(AllStates()))
+	for _, state := range AllStates() {
+		valid = append(valid, state.String())
+	}
+
+	return fmt.Errorf("%w %q: must be one of %s", ErrInvalidState, s.String(), strings.Join(valid, ", "))
+}
+
 func ParseState(s string) (State, error) {
 	state := State(strings.ToLower(strings.TrimSpace(s)))
 	if !state.IsValid() {
@@ -83,6 +83,45 @@ func TestState_IsValid(t *testing.T) {
 	}
 }
 
+func TestState_Validate(t *testing.T) {
+	t.Parallel()
+
+	for _, state := range model.AllStates() {
+		t.Run(state.String()+" is valid", func(t *testing.T) {
+			t.Parallel()
+			require.NoError(t, state.Validate())
+		})
+	}
+
+	cases := []struct {
+		name            string
+		state           model.State
+		expectedMessage string
+	}{
+		{
+			name:            "empty string is invalid",
+			state:           model.State(""),
+			expectedMessage: `invalid device state "": must be one of available, in-use, inactive`,
+		},
+		{
+			name:            "typo is invalid",
+			state:           model.State("availabl"),
+			expectedMessage: `invalid device state "availabl": must be one of available, in-use, inactive`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.state.Validate()
+
+			require.ErrorIs(t, err, model.ErrInvalidState)
+			require.EqualError(t, err, tc.expectedMessage)
+		})
+	}
+}
+
 func TestParseState(t *testing.T) {
 	t.Parallel()
 
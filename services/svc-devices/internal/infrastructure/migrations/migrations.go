@@ -0,0 +1,131 @@
+// Package migrations applies the SQL schema migrations in-process using golang-migrate,
+// so tests and tooling do not need the migrate/migrate container.
+package migrations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/golang-migrate/migrate/v4"
+	migratepgx "github.com/golang-migrate/migrate/v4/database/pgx/v5"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+)
+
+const (
+	sourceName   = "iofs"
+	databaseName = "pgx5"
+)
+
+// ErrNoVersion is returned by Version when no migration has been applied yet.
+var ErrNoVersion = errors.New("no migration applied")
+
+// Migrator runs the migrations found in a directory against a pgx pool.
+type Migrator struct {
+	migrate *migrate.Migrate
+}
+
+// New creates a Migrator for the migrations in migrationsDir. Closing the
+// Migrator releases its connection but leaves the pool open.
+func New(pool *pgxpool.Pool, migrationsDir string) (*Migrator, error) {
+	source, err := iofs.New(os.DirFS(migrationsDir), ".")
+	if err != nil {
+		return nil, fmt.Errorf("opening migrations source %s: %w", migrationsDir, err)
+	}
+
+	driver, err := migratepgx.WithInstance(stdlib.OpenDBFromPool(pool), &migratepgx.Config{})
+	if err != nil {
+		_ = source.Close()
+
+		return nil, fmt.Errorf("creating migrations database driver: %w", err)
+	}
+
+	m, err := migrate.NewWithInstance(sourceName, source, databaseName, driver)
+	if err != nil {
+		_ = source.Close()
+		_ = driver.Close()
+
+		return nil, fmt.Errorf("creating migrator: %w", err)
+	}
+
+	return &Migrator{migrate: m}, nil
+}
+
+// Up applies all pending migrations. Having nothing to apply is not an error.
+func (m *Migrator) Up(ctx context.Context) error {
+	return m.run(ctx, m.migrate.Up)
+}
+
+// Down rolls back every applied migration. Having nothing to roll back is not an error.
+func (m *Migrator) Down(ctx context.Context) error {
+	return m.run(ctx, m.migrate.Down)
+}
+
+// Version returns the current schema version and whether the last migration left
+// it dirty. It returns ErrNoVersion when no migration has been applied.
+func (m *Migrator) Version() (uint, bool, error) {
+	version, dirty, err := m.migrate.Version()
+	if errors.Is(err, migrate.ErrNilVersion) {
+		return 0, false, ErrNoVersion
+	}
+
+	if err != nil {
+		return 0, false, fmt.Errorf("reading schema version: %w", err)
+	}
+
+	return version, dirty, nil
+}
+
+// Close releases the migration source and database connection.
+func (m *Migrator) Close() error {
+	sourceErr, databaseErr := m.migrate.Close()
+
+	return errors.Join(sourceErr, databaseErr)
+}
+
+// run executes fn, asking golang-migrate to stop after the current migration once ctx is done.
+func (m *Migrator) run(ctx context.Context, fn func() error) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	stop := context.AfterFunc(ctx, func() {
+		select {
+		case m.migrate.GracefulStop <- true:
+		default:
+		}
+	})
+	defer stop()
+
+	if err := fn(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return fmt.Errorf("running migrations: %w", err)
+	}
+
+	return ctx.Err()
+}
+
+// RunUp applies all pending migrations in migrationsDir to the database behind pool.
+func RunUp(ctx context.Context, pool *pgxpool.Pool, migrationsDir string) error {
+	return withMigrator(pool, migrationsDir, func(m *Migrator) error {
+		return m.Up(ctx)
+	})
+}
+
+// RunDown rolls back all migrations in migrationsDir from the database behind pool.
+func RunDown(ctx context.Context, pool *pgxpool.Pool, migrationsDir string) error {
+	return withMigrator(pool, migrationsDir, func(m *Migrator) error {
+		return m.Down(ctx)
+	})
+}
+
+func withMigrator(pool *pgxpool.Pool, migrationsDir string, fn func(*Migrator) error) error {
+	m, err := New(pool, migrationsDir)
+	if err != nil {
+		return err
+	}
+
+	return errors.Join(fn(m), m.Close())
+}
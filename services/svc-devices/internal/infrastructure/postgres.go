@@ -4,22 +4,26 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/architeacher/devices/pkg/db"
 	"github.com/architeacher/devices/services/svc-devices/internal/config"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 func NewPool(ctx context.Context, cfg config.Database) (*pgxpool.Pool, error) {
-	connString := fmt.Sprintf(
-		"postgres://%s:%s@%s:%d/%s?sslmode=%s",
-		cfg.Username,
-		cfg.Password,
-		cfg.Host,
-		cfg.Port,
-		cfg.Database,
-		cfg.SSLMode,
-	)
-
-	poolConfig, err := pgxpool.ParseConfig(connString)
+	dsn := db.DSNConfig{
+		Host:     cfg.Host,
+		Port:     int(cfg.Port),
+		User:     cfg.Username,
+		Password: cfg.Password,
+		DBName:   cfg.Database,
+		SSLMode:  cfg.SSLMode,
+	}
+
+	if err := dsn.Validate(); err != nil {
+		return nil, fmt.Errorf("validating connection settings: %w", err)
+	}
+
+	poolConfig, err := pgxpool.ParseConfig(dsn.String())
 	if err != nil {
 		return nil, fmt.Errorf("parsing connection string: %w", err)
 	}
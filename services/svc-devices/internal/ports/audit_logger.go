@@ -0,0 +1,15 @@
+package ports
+
+//counterfeiter:generate -o ../mocks/audit_logger.go . AuditLogger
+
+import (
+	"context"
+
+	"github.com/architeacher/devices/services/svc-devices/internal/domain/model"
+)
+
+// AuditLogger appends device mutations to the audit trail.
+type AuditLogger interface {
+	// Log stores the entry. Entries are never changed once stored.
+	Log(ctx context.Context, entry model.AuditEntry) error
+}
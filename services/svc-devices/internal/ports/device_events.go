@@ -0,0 +1,32 @@
+package ports
+
+//counterfeiter:generate -o ../mocks/device_event_bus.go . DeviceEventBus
+
+import (
+	"github.com/architeacher/devices/services/svc-devices/internal/domain/model"
+)
+
+type (
+	// CancelFunc ends a subscription and releases its channel.
+	CancelFunc func()
+
+	// DeviceEventBus fans device changes out to the subscribers of each device.
+	DeviceEventBus interface {
+		// Subscribe returns a channel receiving every published version of the device, in
+		// publishing order. The channel is closed once the device is deleted or cancel is called.
+		Subscribe(id model.DeviceID) (<-chan *model.Device, CancelFunc)
+
+		// Publish delivers the device to the subscribers of its ID without blocking.
+		Publish(device *model.Device)
+
+		// Close ends every subscription to the device, closing their channels.
+		Close(id model.DeviceID)
+	}
+
+	// DeviceSubscription is the state of a watched device followed by its changes.
+	DeviceSubscription struct {
+		Current *model.Device
+		Updates <-chan *model.Device
+		Cancel  CancelFunc
+	}
+)
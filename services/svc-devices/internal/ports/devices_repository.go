@@ -1,7 +1,10 @@
 package ports
 
+//counterfeiter:generate -o ../mocks/device_repository.go . DeviceRepository
+
 import (
 	"context"
+	"time"
 
 	"github.com/architeacher/devices/services/svc-devices/internal/domain/model"
 )
@@ -15,23 +18,63 @@ type (
 	Fetcher interface {
 		// FetchByID retrieves a device by its ID.
 		FetchByID(ctx context.Context, id model.DeviceID) (*model.Device, error)
+
+		// FetchByIDForUpdate retrieves a device by its ID from the primary database, for
+		// reads whose result is written back and must not lag behind a read replica.
+		FetchByIDForUpdate(ctx context.Context, id model.DeviceID) (*model.Device, error)
+
+		// ListByIDs retrieves the devices with the given IDs in one query. The result
+		// follows the order of ids, holding nil where a device does not exist.
+		ListByIDs(ctx context.Context, ids []model.DeviceID) ([]*model.Device, error)
 	}
 
 	Finder interface {
 		// List retrieves a paginated list of devices with optional filters.
 		List(ctx context.Context, filter model.DeviceFilter) (*model.DeviceList, error)
+
+		// Search retrieves a paginated list of devices matching a full-text query,
+		// ranked by relevance.
+		Search(ctx context.Context, query string, filter model.DeviceFilter) (*model.DeviceList, error)
+
+		// GetByMetadataField retrieves the devices whose metadata holds value under key.
+		GetByMetadataField(ctx context.Context, key, value string) ([]*model.Device, error)
+
+		// ListChangedSince retrieves up to limit devices changed after the change at
+		// (since, sinceID), in the order they changed. A zero sinceID starts after since,
+		// and a zero limit returns every change.
+		ListChangedSince(ctx context.Context, since time.Time, sinceID model.DeviceID, limit uint) ([]*model.Device, error)
 	}
 
 	Updater interface {
-		// Update updates an existing device in the database.
+		// Update updates an existing device in the database and records the change in
+		// its history.
 		Update(ctx context.Context, device *model.Device) error
+
+		// UpdateIfUnmodified updates the device like Update, but only if it was last
+		// updated at lastUpdatedAt, failing with ErrDeviceModified otherwise.
+		UpdateIfUnmodified(ctx context.Context, device *model.Device, lastUpdatedAt time.Time) error
+
+		// UpdateBatch updates every device in a single transaction, returning one error
+		// per device. A failed update rolls back the whole batch.
+		UpdateBatch(ctx context.Context, updates []*model.Device) []error
+
+		// RecoverStale marks up to limit devices that have been in use since before
+		// staleBefore as available, records the change in their history, and returns
+		// them. A zero limit recovers them all.
+		RecoverStale(ctx context.Context, staleBefore, recoveredAt time.Time, limit uint) ([]*model.Device, error)
 	}
 
 	Deleter interface {
-		// Delete removes a device from the database by its ID.
+		// Delete removes a device from the database by its ID and records its last
+		// state in its history.
 		Delete(ctx context.Context, id model.DeviceID) error
 	}
 
+	Historian interface {
+		// GetHistory retrieves the snapshots of a device, oldest version first.
+		GetHistory(ctx context.Context, deviceID model.DeviceID) ([]*model.DeviceHistoryEntry, error)
+	}
+
 	// DeviceRepository defines the interface for device persistence operations.
 	DeviceRepository interface {
 		Saver
@@ -39,5 +82,6 @@ type (
 		Finder
 		Updater
 		Deleter
+		Historian
 	}
 )
@@ -6,6 +6,7 @@ package ports
 
 import (
 	"context"
+	"time"
 
 	"github.com/architeacher/devices/services/svc-devices/internal/domain/model"
 )
@@ -27,6 +28,17 @@ type DevicesService interface {
 	// PatchDevice partially updates a device with the given updates.
 	PatchDevice(ctx context.Context, id model.DeviceID, updates map[string]any) (*model.Device, error)
 
+	// PatchDeviceIfUnmodified partially updates a device like PatchDevice, but only if it
+	// was last updated at lastUpdatedAt, failing with ErrDeviceModified otherwise.
+	PatchDeviceIfUnmodified(ctx context.Context, id model.DeviceID, updates map[string]any, lastUpdatedAt time.Time) (*model.Device, error)
+
 	// DeleteDevice deletes a device by its ID.
 	DeleteDevice(ctx context.Context, id model.DeviceID) error
+
+	// RecoverStaleDevices releases up to limit devices left in use for longer than
+	// idleFor and returns them. A zero limit releases them all.
+	RecoverStaleDevices(ctx context.Context, idleFor time.Duration, limit uint) ([]*model.Device, error)
+
+	// WatchDevice returns the device together with a subscription to its later changes.
+	WatchDevice(ctx context.Context, id model.DeviceID) (*DeviceSubscription, error)
 }
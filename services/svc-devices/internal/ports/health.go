@@ -17,12 +17,30 @@ type HealthChecker interface {
 // DependencyStatus represents the health status of a dependency.
 type DependencyStatus struct {
 	Healthy bool   `json:"healthy"`
+	Status  string `json:"status,omitempty"`
 	Message string `json:"message,omitempty"`
 	Latency string `json:"latency,omitempty"`
 }
 
+const (
+	DependencyStatusUp       = "up"
+	DependencyStatusDegraded = "degraded"
+	DependencyStatusDown     = "down"
+)
+
+// PoolStats is a snapshot of the database connection pool.
+type PoolStats struct {
+	TotalConns    int32
+	IdleConns     int32
+	AcquiredConns int32
+	MaxConns      int32
+}
+
 // DatabaseHealthChecker defines the interface for database health checks.
 type DatabaseHealthChecker interface {
 	// Ping checks if the database connection is alive.
 	Ping(ctx context.Context) error
+
+	// PoolStats returns a snapshot of the database connection pool.
+	PoolStats(ctx context.Context) (PoolStats, error)
 }
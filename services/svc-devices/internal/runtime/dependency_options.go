@@ -7,15 +7,23 @@ import (
 	"github.com/architeacher/devices/pkg/logger"
 	"github.com/architeacher/devices/pkg/metrics/noop"
 	devicev1 "github.com/architeacher/devices/pkg/proto/device/v1"
+	"github.com/architeacher/devices/services/svc-devices/internal/adapters/events"
 	inboundgrpc "github.com/architeacher/devices/services/svc-devices/internal/adapters/inbound/grpc"
+	"github.com/architeacher/devices/services/svc-devices/internal/adapters/inbound/jobs"
 	"github.com/architeacher/devices/services/svc-devices/internal/adapters/repos"
 	"github.com/architeacher/devices/services/svc-devices/internal/adapters/services"
 	"github.com/architeacher/devices/services/svc-devices/internal/config"
+	"github.com/architeacher/devices/services/svc-devices/internal/domain/model"
 	"github.com/architeacher/devices/services/svc-devices/internal/infrastructure"
+	"github.com/architeacher/devices/services/svc-devices/internal/ports"
 	"github.com/architeacher/devices/services/svc-devices/internal/usecases"
+	"github.com/architeacher/devices/services/svc-devices/internal/usecases/queries"
 	"github.com/hashicorp/vault/api"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	// Registers the gzip codec so the server accepts and answers gzip-compressed calls.
+	_ "google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/reflection"
 )
 
@@ -25,13 +33,14 @@ func defaultOptions(ctx context.Context) []DependencyOption {
 		WithConfigLoader(ctx),
 		WithSecretsRepository(),
 		WithLogger(),
+		WithMetrics(),
+		WithTracing(),
 		WithDatabase(ctx),
 		WithDataRepositories(),
 		WithServices(),
 		WithApplication(),
+		WithJobs(),
 		WithGRPCServer(),
-		WithMetrics(),
-		WithTracing(),
 	}
 }
 
@@ -118,17 +127,52 @@ func WithDatabase(ctx context.Context) DependencyOption {
 			return nil
 		}
 
+		if d.config.Database.ReadReplicaHost == "" {
+			return nil
+		}
+
+		replicaConfig := d.config.Database
+		replicaConfig.Host = replicaConfig.ReadReplicaHost
+
+		readPool, err := infrastructure.NewPool(ctx, replicaConfig)
+		if err != nil {
+			return fmt.Errorf("connecting to database read replica: %w", err)
+		}
+
+		d.infra.dbReadPool = readPool
+
+		d.cleanupFuncs["DB read replica"] = func(ctx context.Context) error {
+			d.infra.dbReadPool.Close()
+
+			return nil
+		}
+
 		return nil
 	}
 }
 
 func WithDataRepositories() DependencyOption {
 	return func(d *dependencies) error {
+		repoOpts := []repos.DevicesRepositoryOption{
+			repos.WithTracerProvider(d.infra.tracerProvider),
+		}
+
+		if d.infra.dbReadPool != nil {
+			repoOpts = append(repoOpts, repos.WithReadReplica(d.infra.dbReadPool))
+		}
+
+		if secret := d.config.Pagination.CursorSecret; secret != "" {
+			repoOpts = append(repoOpts, repos.WithCursorCodec(model.NewCursorCodec([]byte(secret))))
+		} else {
+			d.infra.logger.Warn().Msg("PAGINATION_CURSOR_SECRET is not set, list cursors only resolve on the instance that issued them")
+		}
+
 		d.repos.deviceRepo = repos.NewDevicesRepository(
 			d.infra.dbPool,
 			repos.NewPgxScanner(),
 			repos.NewCriteriaTranslator(&d.infra.logger),
 			d.infra.logger,
+			repoOpts...,
 		)
 
 		return nil
@@ -137,8 +181,18 @@ func WithDataRepositories() DependencyOption {
 
 func WithServices() DependencyOption {
 	return func(d *dependencies) error {
+		var devicesSvc ports.DevicesService = services.NewDevicesService(d.repos.deviceRepo, events.NewDeviceEventBus())
+
+		if d.config.AuditLog.Enabled {
+			devicesSvc = services.NewAuditedDevicesService(
+				devicesSvc,
+				repos.NewPostgresAuditLogger(d.infra.dbPool),
+				d.infra.logger,
+			)
+		}
+
 		d.services = servicesDep{
-			devices: services.NewDevicesService(d.repos.deviceRepo),
+			devices: devicesSvc,
 		}
 
 		return nil
@@ -150,6 +204,10 @@ func WithApplication() DependencyOption {
 		grpcApp := usecases.NewApplication(
 			d.services.devices,
 			d.getDBHealthChecker(),
+			queries.PoolLimits{
+				MinConns: int32(d.config.Database.MinConnections),
+				MaxConns: int32(d.config.Database.MaxConnections),
+			},
 			d.infra.logger,
 			d.infra.tracerProvider,
 			d.infra.metricsClient,
@@ -163,6 +221,20 @@ func WithApplication() DependencyOption {
 	}
 }
 
+func WithJobs() DependencyOption {
+	return func(d *dependencies) error {
+		if d.config.DeviceRecovery.Enabled {
+			d.jobs.deviceRecovery = jobs.NewDeviceRecoveryJob(
+				d.apps.grpcApp.Commands.RecoverStaleDevices,
+				d.config.DeviceRecovery,
+				d.infra.logger,
+			)
+		}
+
+		return nil
+	}
+}
+
 func WithGRPCServer() DependencyOption {
 	return func(d *dependencies) error {
 		opts := []grpc.ServerOption{
@@ -170,11 +242,45 @@ func WithGRPCServer() DependencyOption {
 			grpc.MaxSendMsgSize(d.config.GRPCServer.MaxSendMsgSize),
 			grpc.StatsHandler(otelgrpc.NewServerHandler()),
 			grpc.ChainUnaryInterceptor(
+				inboundgrpc.RecoveryInterceptor(d.infra.logger, d.infra.metricsClient),
+				// Enforce required metadata before the extractor fills in a missing request ID.
+				inboundgrpc.RequireMetadataInterceptor(d.config.GRPCServer.RequiredMetadata...),
 				inboundgrpc.ContextExtractorInterceptor(),
 				inboundgrpc.AccessLogInterceptor(d.infra.logger, d.config.Logging.AccessLog),
+				inboundgrpc.MaxMessageSizeInterceptor(d.config.GRPCServer.MaxRequestSize, d.infra.metricsClient),
 			),
 		}
 
+		streamInterceptors := []grpc.StreamServerInterceptor{
+			inboundgrpc.StreamRecoveryInterceptor(d.infra.logger, d.infra.metricsClient),
+			inboundgrpc.StreamAccessLogInterceptor(d.infra.logger, d.config.Logging.AccessLog),
+		}
+
+		if rateLimit := d.config.GRPCServer.StreamRateLimit; rateLimit.RPS > 0 {
+			streamInterceptors = append(
+				streamInterceptors,
+				inboundgrpc.StreamRateLimitInterceptor(rateLimit.RPS, rateLimit.Burst),
+			)
+		}
+
+		opts = append(opts, grpc.ChainStreamInterceptor(streamInterceptors...))
+
+		keepaliveConfig := d.config.GRPCServer.Keepalive
+		opts = append(opts,
+			grpc.KeepaliveParams(keepalive.ServerParameters{
+				Time:    keepaliveConfig.Time,
+				Timeout: keepaliveConfig.Timeout,
+			}),
+			grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+				MinTime:             keepaliveConfig.MinTime,
+				PermitWithoutStream: keepaliveConfig.PermitWithoutStream,
+			}),
+		)
+
+		if maxStreams := d.config.GRPCServer.MaxConcurrentStreams; maxStreams > 0 {
+			opts = append(opts, grpc.MaxConcurrentStreams(maxStreams))
+		}
+
 		server := grpc.NewServer(opts...)
 
 		deviceHandler := inboundgrpc.NewDevicesHandler(d.apps.grpcApp)
@@ -183,7 +289,9 @@ func WithGRPCServer() DependencyOption {
 		healthHandler := inboundgrpc.NewHealthHandler(d.getDBHealthChecker())
 		devicev1.RegisterHealthServiceServer(server, healthHandler)
 
-		reflection.Register(server)
+		if d.config.GRPCServer.EnableReflection {
+			reflection.Register(server)
+		}
 
 		d.infra.grpcServer = server
 
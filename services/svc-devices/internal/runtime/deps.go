@@ -6,6 +6,7 @@ import (
 
 	"github.com/architeacher/devices/pkg/logger"
 	"github.com/architeacher/devices/pkg/metrics"
+	"github.com/architeacher/devices/services/svc-devices/internal/adapters/inbound/jobs"
 	"github.com/architeacher/devices/services/svc-devices/internal/adapters/repos"
 	"github.com/architeacher/devices/services/svc-devices/internal/config"
 	"github.com/architeacher/devices/services/svc-devices/internal/ports"
@@ -19,6 +20,7 @@ type (
 	infrastructureDep struct {
 		grpcServer     *grpc.Server
 		dbPool         *pgxpool.Pool
+		dbReadPool     *pgxpool.Pool
 		logger         logger.Logger
 		metricsClient  metrics.Client
 		tracerProvider otelTrace.TracerProvider
@@ -37,6 +39,10 @@ type (
 		grpcApp *usecases.Application
 	}
 
+	backgroundJobs struct {
+		deviceRecovery *jobs.DeviceRecoveryJob
+	}
+
 	dependencies struct {
 		config       *config.ServiceConfig
 		configLoader *config.Loader
@@ -49,6 +55,8 @@ type (
 
 		apps applications
 
+		jobs backgroundJobs
+
 		cleanupFuncs map[string]func(ctx context.Context) error
 	}
 
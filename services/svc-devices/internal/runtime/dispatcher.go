@@ -62,6 +62,16 @@ func (c *ServiceCtx) build() error {
 }
 
 func (c *ServiceCtx) startService() {
+	if job := c.deps.jobs.deviceRecovery; job != nil {
+		c.deps.infra.logger.Info().
+			Dur("recovery_timeout", job.RecoveryTimeout).
+			Dur("interval", job.Interval).
+			Msg("starting the device recovery job")
+
+		// The job stops together with the server context on shutdown.
+		go job.Start(c.serverCtx)
+	}
+
 	go func() {
 		if c.serverReady != nil {
 			c.serverReady <- struct{}{}
@@ -0,0 +1,139 @@
+//go:build integration
+
+package runtime
+
+import (
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/architeacher/devices/services/svc-devices/internal/config"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/http2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/keepalive"
+)
+
+// TestWithGRPCServer_KeepaliveEnforcement runs an aggressive and a well-behaved client
+// side by side against a server that accepts a ping at most every 10s. The gRPC client
+// raises any keepalive below 10s to 10s, so the aggressive client speaks HTTP/2
+// directly to ping every second.
+func TestWithGRPCServer_KeepaliveEnforcement(t *testing.T) {
+	t.Parallel()
+
+	const (
+		minPingInterval    = 10 * time.Second
+		aggressiveInterval = time.Second
+	)
+
+	address := startGRPCServer(t, config.GRPCServer{
+		MaxRecvMsgSize:   4 << 20,
+		MaxSendMsgSize:   4 << 20,
+		EnableReflection: true,
+		Keepalive: config.GRPCKeepalive{
+			Time:                time.Hour,
+			Timeout:             20 * time.Second,
+			MinTime:             minPingInterval,
+			PermitWithoutStream: true,
+		},
+	})
+
+	wellBehaved, err := grpc.NewClient(
+		address,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                30 * time.Second,
+			Timeout:             10 * time.Second,
+			PermitWithoutStream: true,
+		}),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = wellBehaved.Close() })
+
+	_, err = listServices(t.Context(), wellBehaved)
+	require.NoError(t, err)
+
+	received := pingUntilGoAway(t, address, aggressiveInterval)
+	require.Equal(t, http2.ErrCodeEnhanceYourCalm, received.errCode)
+	require.Equal(t, "too_many_pings", received.debugData)
+
+	require.Equal(t, connectivity.Ready, wellBehaved.GetState(), "the well-behaved client was disconnected")
+
+	_, err = listServices(t.Context(), wellBehaved)
+	require.NoError(t, err)
+}
+
+// goAway holds a GOAWAY frame received from the server.
+type goAway struct {
+	errCode   http2.ErrCode
+	debugData string
+}
+
+// pingUntilGoAway opens a raw HTTP/2 connection to address and sends a PING every
+// interval until the server answers with GOAWAY.
+func pingUntilGoAway(t *testing.T, address string, interval time.Duration) goAway {
+	t.Helper()
+
+	conn, err := net.Dial("tcp", address)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	// The server allows two pings too early, so the GOAWAY follows the fourth ping.
+	require.NoError(t, conn.SetDeadline(time.Now().Add(10*interval)))
+
+	_, err = conn.Write([]byte(http2.ClientPreface))
+	require.NoError(t, err)
+
+	var writeMu sync.Mutex
+	framer := http2.NewFramer(conn, conn)
+	require.NoError(t, framer.WriteSettings())
+
+	received := make(chan goAway, 1)
+	readErr := make(chan error, 1)
+
+	go func() {
+		for {
+			frame, err := framer.ReadFrame()
+			if err != nil {
+				readErr <- err
+
+				return
+			}
+
+			switch frame := frame.(type) {
+			case *http2.SettingsFrame:
+				if !frame.IsAck() {
+					writeMu.Lock()
+					_ = framer.WriteSettingsAck()
+					writeMu.Unlock()
+				}
+			case *http2.GoAwayFrame:
+				// The frame is only valid until the next read, so its data is copied.
+				received <- goAway{errCode: frame.ErrCode, debugData: string(frame.DebugData())}
+
+				return
+			}
+		}
+	}()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		writeMu.Lock()
+		err := framer.WritePing(false, [8]byte{})
+		writeMu.Unlock()
+		require.NoError(t, err)
+
+		select {
+		case frame := <-received:
+			return frame
+		case err := <-readErr:
+			require.FailNow(t, "connection closed without GOAWAY", err)
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package runtime
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/architeacher/devices/pkg/logger"
+	"github.com/architeacher/devices/pkg/metrics/noop"
+	devicev1 "github.com/architeacher/devices/pkg/proto/device/v1"
+	"github.com/architeacher/devices/services/svc-devices/internal/adapters/repos"
+	"github.com/architeacher/devices/services/svc-devices/internal/config"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/keepalive"
+	reflectionv1 "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	"google.golang.org/grpc/status"
+)
+
+func TestWithGRPCServer_Reflection(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name             string
+		enableReflection bool
+	}{
+		{
+			name:             "reflection enabled lists the device service",
+			enableReflection: true,
+		},
+		{
+			name:             "reflection disabled is unimplemented",
+			enableReflection: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			address := startGRPCServer(t, config.GRPCServer{
+				MaxRecvMsgSize:   4 << 20,
+				MaxSendMsgSize:   4 << 20,
+				EnableReflection: tc.enableReflection,
+			})
+
+			conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+			require.NoError(t, err)
+			t.Cleanup(func() { _ = conn.Close() })
+
+			services, err := listServices(t.Context(), conn)
+
+			if !tc.enableReflection {
+				require.Equal(t, codes.Unimplemented, status.Code(err))
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Contains(t, services, devicev1.DeviceService_ServiceDesc.ServiceName)
+			require.Contains(t, services, devicev1.HealthService_ServiceDesc.ServiceName)
+		})
+	}
+}
+
+func TestWithGRPCServer_KeepaliveKeepsIdleConnectionOpen(t *testing.T) {
+	t.Parallel()
+
+	// One second is the shortest server ping interval gRPC accepts.
+	const pingInterval = time.Second
+
+	address := startGRPCServer(t, config.GRPCServer{
+		MaxRecvMsgSize:   4 << 20,
+		MaxSendMsgSize:   4 << 20,
+		EnableReflection: true,
+		Keepalive: config.GRPCKeepalive{
+			Time:                pingInterval,
+			Timeout:             pingInterval,
+			MinTime:             pingInterval,
+			PermitWithoutStream: true,
+		},
+	})
+
+	conn, err := grpc.NewClient(
+		address,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                10 * time.Second,
+			Timeout:             pingInterval,
+			PermitWithoutStream: true,
+		}),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	_, err = listServices(t.Context(), conn)
+	require.NoError(t, err)
+	require.Equal(t, connectivity.Ready, conn.GetState())
+
+	// Stay idle across several server pings; the connection must not change state.
+	idleCtx, cancel := context.WithTimeout(t.Context(), 3*pingInterval)
+	defer cancel()
+
+	require.False(t, conn.WaitForStateChange(idleCtx, connectivity.Ready), "idle connection was dropped")
+
+	_, err = listServices(t.Context(), conn)
+	require.NoError(t, err)
+}
+
+// startGRPCServer builds the server through WithGRPCServer and serves it on a loopback port.
+func startGRPCServer(t *testing.T, grpcConfig config.GRPCServer) string {
+	t.Helper()
+
+	log := logger.NewTestLogger()
+
+	deps := &dependencies{
+		config: &config.ServiceConfig{GRPCServer: grpcConfig},
+		infra: infrastructureDep{
+			logger:        log,
+			metricsClient: noop.NewMetricsClient(),
+		},
+		repos: repositories{
+			deviceRepo: repos.NewDevicesRepository(nil, repos.NewPgxScanner(), repos.NewCriteriaTranslator(&log), log),
+		},
+		cleanupFuncs: make(map[string]func(ctx context.Context) error),
+	}
+
+	require.NoError(t, WithGRPCServer()(deps))
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	go func() { _ = deps.infra.grpcServer.Serve(listener) }()
+	t.Cleanup(deps.infra.grpcServer.Stop)
+
+	return listener.Addr().String()
+}
+
+// listServices asks the server's reflection service for every registered service name.
+func listServices(ctx context.Context, conn *grpc.ClientConn) ([]string, error) {
+	stream, err := reflectionv1.NewServerReflectionClient(conn).ServerReflectionInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = stream.Send(&reflectionv1.ServerReflectionRequest{
+		MessageRequest: &reflectionv1.ServerReflectionRequest_ListServices{},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+
+	_ = stream.CloseSend()
+
+	names := make([]string, 0, len(resp.GetListServicesResponse().GetService()))
+	for _, service := range resp.GetListServicesResponse().GetService() {
+		names = append(names, service.GetName())
+	}
+
+	return names, nil
+}
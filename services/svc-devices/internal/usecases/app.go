@@ -11,15 +11,17 @@ import (
 
 type (
 	Commands struct {
-		CreateDevice commands.CreateDeviceCommandHandler
-		UpdateDevice commands.UpdateDeviceCommandHandler
-		PatchDevice  commands.PatchDeviceCommandHandler
-		DeleteDevice commands.DeleteDeviceCommandHandler
+		CreateDevice        commands.CreateDeviceCommandHandler
+		UpdateDevice        commands.UpdateDeviceCommandHandler
+		PatchDevice         commands.PatchDeviceCommandHandler
+		DeleteDevice        commands.DeleteDeviceCommandHandler
+		RecoverStaleDevices commands.RecoverStaleDevicesCommandHandler
 	}
 
 	Queries struct {
 		GetDevice         queries.GetDeviceQueryHandler
 		ListDevices       queries.ListDevicesQueryHandler
+		WatchDevice       queries.WatchDeviceQueryHandler
 		FetchLiveness     queries.FetchLivenessQueryHandler
 		FetchReadiness    queries.FetchReadinessQueryHandler
 		FetchHealthReport queries.FetchHealthReportQueryHandler
@@ -34,22 +36,25 @@ type (
 func NewApplication(
 	devicesSvc ports.DevicesService,
 	dbHealthChecker ports.DatabaseHealthChecker,
+	poolLimits queries.PoolLimits,
 	log logger.Logger,
 	tracerProvider otelTrace.TracerProvider,
 	metricsClient metrics.Client,
 ) *Application {
 	return &Application{
 		Commands: Commands{
-			CreateDevice: commands.NewCreateDeviceCommandHandler(devicesSvc, log, metricsClient, tracerProvider),
-			UpdateDevice: commands.NewUpdateDeviceCommandHandler(devicesSvc, log, metricsClient, tracerProvider),
-			PatchDevice:  commands.NewPatchDeviceCommandHandler(devicesSvc, log, metricsClient, tracerProvider),
-			DeleteDevice: commands.NewDeleteDeviceCommandHandler(devicesSvc, log, metricsClient, tracerProvider),
+			CreateDevice:        commands.NewCreateDeviceCommandHandler(devicesSvc, log, metricsClient, tracerProvider),
+			UpdateDevice:        commands.NewUpdateDeviceCommandHandler(devicesSvc, log, metricsClient, tracerProvider),
+			PatchDevice:         commands.NewPatchDeviceCommandHandler(devicesSvc, log, metricsClient, tracerProvider),
+			DeleteDevice:        commands.NewDeleteDeviceCommandHandler(devicesSvc, log, metricsClient, tracerProvider),
+			RecoverStaleDevices: commands.NewRecoverStaleDevicesCommandHandler(devicesSvc, log, metricsClient, tracerProvider),
 		},
 		Queries: Queries{
 			GetDevice:         queries.NewGetDeviceQueryHandler(devicesSvc, log, metricsClient, tracerProvider),
 			ListDevices:       queries.NewListDevicesQueryHandler(devicesSvc, log, metricsClient, tracerProvider),
+			WatchDevice:       queries.NewWatchDeviceQueryHandler(devicesSvc, log, metricsClient, tracerProvider),
 			FetchLiveness:     queries.NewFetchLivenessQueryHandler(log, metricsClient, tracerProvider),
-			FetchReadiness:    queries.NewFetchReadinessQueryHandler(dbHealthChecker, log, metricsClient, tracerProvider),
+			FetchReadiness:    queries.NewFetchReadinessQueryHandler(dbHealthChecker, poolLimits, log, metricsClient, tracerProvider),
 			FetchHealthReport: queries.NewFetchHealthReportQueryHandler(dbHealthChecker, log, metricsClient, tracerProvider),
 		},
 	}
@@ -3,6 +3,7 @@ package commands_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/architeacher/devices/pkg/logger"
 	"github.com/architeacher/devices/pkg/metrics/noop"
@@ -335,3 +336,36 @@ func TestPatchDeviceCommandHandler(t *testing.T) {
 		})
 	}
 }
+
+func TestPatchDeviceCommandHandler_LastUpdatedAt(t *testing.T) {
+	t.Parallel()
+
+	lastUpdatedAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	svc := &mocks.FakeDevicesService{}
+	svc.PatchDeviceIfUnmodifiedReturns(nil, model.ErrDeviceModified)
+
+	handler := commands.NewPatchDeviceCommandHandler(
+		svc,
+		logger.New("debug", "console"),
+		noop.NewMetricsClient(),
+		infrastructure.NewNoopTracerProvider(),
+	)
+
+	cmd := commands.PatchDeviceCommand{
+		ID:            model.NewDeviceID(),
+		Updates:       map[string]any{"state": "in-use"},
+		LastUpdatedAt: lastUpdatedAt,
+	}
+
+	_, err := handler.Handle(t.Context(), cmd)
+
+	require.ErrorIs(t, err, model.ErrDeviceModified)
+	require.Zero(t, svc.PatchDeviceCallCount())
+	require.Equal(t, 1, svc.PatchDeviceIfUnmodifiedCallCount())
+
+	_, id, updates, since := svc.PatchDeviceIfUnmodifiedArgsForCall(0)
+	require.Equal(t, cmd.ID, id)
+	require.Equal(t, cmd.Updates, updates)
+	require.Equal(t, lastUpdatedAt, since)
+}
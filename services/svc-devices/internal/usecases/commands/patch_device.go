@@ -2,6 +2,7 @@ package commands
 
 import (
 	"context"
+	"time"
 
 	"github.com/architeacher/devices/pkg/decorator"
 	"github.com/architeacher/devices/pkg/logger"
@@ -15,6 +16,9 @@ type (
 	PatchDeviceCommand struct {
 		ID      model.DeviceID
 		Updates map[string]any
+		// LastUpdatedAt, when set, applies the patch only if the device was last
+		// updated at that time.
+		LastUpdatedAt time.Time
 	}
 
 	PatchDeviceCommandHandler = decorator.CommandHandler[PatchDeviceCommand, *model.Device]
@@ -39,5 +43,9 @@ func NewPatchDeviceCommandHandler(
 }
 
 func (h patchDeviceCommandHandler) Handle(ctx context.Context, cmd PatchDeviceCommand) (*model.Device, error) {
+	if !cmd.LastUpdatedAt.IsZero() {
+		return h.devicesService.PatchDeviceIfUnmodified(ctx, cmd.ID, cmd.Updates, cmd.LastUpdatedAt)
+	}
+
 	return h.devicesService.PatchDevice(ctx, cmd.ID, cmd.Updates)
 }
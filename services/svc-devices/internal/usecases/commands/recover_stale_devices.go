@@ -0,0 +1,44 @@
+package commands
+
+import (
+	"context"
+	"time"
+
+	"github.com/architeacher/devices/pkg/decorator"
+	"github.com/architeacher/devices/pkg/logger"
+	"github.com/architeacher/devices/pkg/metrics"
+	"github.com/architeacher/devices/services/svc-devices/internal/domain/model"
+	"github.com/architeacher/devices/services/svc-devices/internal/ports"
+	otelTrace "go.opentelemetry.io/otel/trace"
+)
+
+type (
+	RecoverStaleDevicesCommand struct {
+		IdleFor   time.Duration
+		BatchSize uint
+	}
+
+	RecoverStaleDevicesCommandHandler = decorator.CommandHandler[RecoverStaleDevicesCommand, []*model.Device]
+
+	recoverStaleDevicesCommandHandler struct {
+		devicesService ports.DevicesService
+	}
+)
+
+func NewRecoverStaleDevicesCommandHandler(
+	svc ports.DevicesService,
+	log logger.Logger,
+	metricsClient metrics.Client,
+	tracerProvider otelTrace.TracerProvider,
+) RecoverStaleDevicesCommandHandler {
+	return decorator.ApplyCommandDecorators[RecoverStaleDevicesCommand, []*model.Device](
+		recoverStaleDevicesCommandHandler{devicesService: svc},
+		log,
+		metricsClient,
+		tracerProvider,
+	)
+}
+
+func (h recoverStaleDevicesCommandHandler) Handle(ctx context.Context, cmd RecoverStaleDevicesCommand) ([]*model.Device, error) {
+	return h.devicesService.RecoverStaleDevices(ctx, cmd.IdleFor, cmd.BatchSize)
+}
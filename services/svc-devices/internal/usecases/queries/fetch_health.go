@@ -57,10 +57,12 @@ func (h fetchHealthReportQueryHandler) Execute(ctx context.Context, _ FetchHealt
 
 	dbStatus := ports.DependencyStatus{
 		Healthy: dbErr == nil,
+		Status:  ports.DependencyStatusUp,
 		Latency: fmt.Sprintf("%dms", latency.Milliseconds()),
 	}
 
 	if dbErr != nil {
+		dbStatus.Status = ports.DependencyStatusDown
 		dbStatus.Message = dbErr.Error()
 	}
 
@@ -2,6 +2,8 @@ package queries
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/architeacher/devices/pkg/decorator"
 	"github.com/architeacher/devices/pkg/logger"
@@ -10,45 +12,113 @@ import (
 	otelTrace "go.opentelemetry.io/otel/trace"
 )
 
+// dbPoolCheck is the readiness check reporting the database connection pool.
+const dbPoolCheck = "db_pool"
+
 type (
 	FetchReadinessQuery struct{}
 
+	// PoolLimits are the connection pool bounds the readiness check expects.
+	// A zero bound is not checked.
+	PoolLimits struct {
+		MinConns int32
+		MaxConns int32
+	}
+
 	ReadinessResult struct {
-		Status string `json:"status"`
-		Ready  bool   `json:"ready"`
+		Status string                            `json:"status"`
+		Ready  bool                              `json:"ready"`
+		Checks map[string]ports.DependencyStatus `json:"checks,omitempty"`
 	}
 
 	FetchReadinessQueryHandler = decorator.QueryHandler[FetchReadinessQuery, *ReadinessResult]
 
 	fetchReadinessQueryHandler struct {
 		dbHealthChecker ports.DatabaseHealthChecker
+		poolLimits      PoolLimits
 	}
 )
 
 func NewFetchReadinessQueryHandler(
 	dbHealthChecker ports.DatabaseHealthChecker,
+	poolLimits PoolLimits,
 	log logger.Logger,
 	metricsClient metrics.Client,
 	tracerProvider otelTrace.TracerProvider,
 ) FetchReadinessQueryHandler {
 	return decorator.ApplyQueryDecorators[FetchReadinessQuery, *ReadinessResult](
-		fetchReadinessQueryHandler{dbHealthChecker: dbHealthChecker},
+		fetchReadinessQueryHandler{dbHealthChecker: dbHealthChecker, poolLimits: poolLimits},
 		log,
 		metricsClient,
 		tracerProvider,
 	)
 }
 
+// Execute reports the service ready while the database answers. A pool running
+// outside its limits keeps the service ready but marks it degraded.
 func (h fetchReadinessQueryHandler) Execute(ctx context.Context, _ FetchReadinessQuery) (*ReadinessResult, error) {
-	if err := h.dbHealthChecker.Ping(ctx); err != nil {
+	start := time.Now()
+	err := h.dbHealthChecker.Ping(ctx)
+	latency := fmt.Sprintf("%dms", time.Since(start).Milliseconds())
+
+	if err != nil {
 		return &ReadinessResult{
 			Status: "unavailable",
 			Ready:  false,
+			Checks: map[string]ports.DependencyStatus{
+				dbPoolCheck: {
+					Healthy: false,
+					Status:  ports.DependencyStatusDown,
+					Message: err.Error(),
+					Latency: latency,
+				},
+			},
 		}, nil
 	}
 
+	poolStatus := h.checkPool(ctx)
+	poolStatus.Latency = latency
+
+	status := "ok"
+	if poolStatus.Status != ports.DependencyStatusUp {
+		status = ports.DependencyStatusDegraded
+	}
+
 	return &ReadinessResult{
-		Status: "ok",
+		Status: status,
 		Ready:  true,
+		Checks: map[string]ports.DependencyStatus{dbPoolCheck: poolStatus},
 	}, nil
 }
+
+func (h fetchReadinessQueryHandler) checkPool(ctx context.Context) ports.DependencyStatus {
+	stats, err := h.dbHealthChecker.PoolStats(ctx)
+	if err != nil {
+		return ports.DependencyStatus{
+			Healthy: true,
+			Status:  ports.DependencyStatusDegraded,
+			Message: fmt.Sprintf("reading pool stats: %v", err),
+		}
+	}
+
+	if h.poolLimits.MinConns > 0 && stats.IdleConns < h.poolLimits.MinConns {
+		return ports.DependencyStatus{
+			Healthy: true,
+			Status:  ports.DependencyStatusDegraded,
+			Message: fmt.Sprintf("%d idle connections, below the minimum of %d", stats.IdleConns, h.poolLimits.MinConns),
+		}
+	}
+
+	if h.poolLimits.MaxConns > 0 && stats.TotalConns > h.poolLimits.MaxConns {
+		return ports.DependencyStatus{
+			Healthy: true,
+			Status:  ports.DependencyStatusDegraded,
+			Message: fmt.Sprintf("%d connections, above the maximum of %d", stats.TotalConns, h.poolLimits.MaxConns),
+		}
+	}
+
+	return ports.DependencyStatus{
+		Healthy: true,
+		Status:  ports.DependencyStatusUp,
+	}
+}
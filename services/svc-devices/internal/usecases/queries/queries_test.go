@@ -1,6 +1,7 @@
 package queries_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/architeacher/devices/pkg/logger"
@@ -8,6 +9,7 @@ import (
 	"github.com/architeacher/devices/services/svc-devices/internal/domain/model"
 	"github.com/architeacher/devices/services/svc-devices/internal/infrastructure"
 	"github.com/architeacher/devices/services/svc-devices/internal/mocks"
+	"github.com/architeacher/devices/services/svc-devices/internal/ports"
 	"github.com/architeacher/devices/services/svc-devices/internal/usecases/queries"
 	"github.com/stretchr/testify/require"
 )
@@ -212,24 +214,76 @@ func TestFetchReadinessQueryHandler(t *testing.T) {
 	tp := infrastructure.NewNoopTracerProvider()
 	mc := noop.NewMetricsClient()
 
+	limits := queries.PoolLimits{MinConns: 2, MaxConns: 10}
+
 	cases := []struct {
-		name          string
-		setupChecker  func(*mocks.FakeDatabaseHealthChecker)
-		expectedReady bool
+		name               string
+		limits             queries.PoolLimits
+		setupChecker       func(*mocks.FakeDatabaseHealthChecker)
+		expectedReady      bool
+		expectedStatus     string
+		expectedPoolStatus string
 	}{
 		{
-			name: "service is ready when db is healthy",
+			name:   "service is ready when db is healthy",
+			limits: limits,
 			setupChecker: func(fake *mocks.FakeDatabaseHealthChecker) {
 				fake.PingReturns(nil)
+				fake.PoolStatsReturns(ports.PoolStats{TotalConns: 4, IdleConns: 3, MaxConns: 10}, nil)
 			},
-			expectedReady: true,
+			expectedReady:      true,
+			expectedStatus:     "ok",
+			expectedPoolStatus: ports.DependencyStatusUp,
 		},
 		{
-			name: "service is not ready when db is unhealthy",
+			name:   "service is not ready when db is unhealthy",
+			limits: limits,
 			setupChecker: func(fake *mocks.FakeDatabaseHealthChecker) {
 				fake.PingReturns(model.ErrDatabaseConnection)
 			},
-			expectedReady: false,
+			expectedReady:      false,
+			expectedStatus:     "unavailable",
+			expectedPoolStatus: ports.DependencyStatusDown,
+		},
+		{
+			name:   "too few idle connections degrade the pool",
+			limits: limits,
+			setupChecker: func(fake *mocks.FakeDatabaseHealthChecker) {
+				fake.PoolStatsReturns(ports.PoolStats{TotalConns: 10, IdleConns: 1, AcquiredConns: 9, MaxConns: 10}, nil)
+			},
+			expectedReady:      true,
+			expectedStatus:     ports.DependencyStatusDegraded,
+			expectedPoolStatus: ports.DependencyStatusDegraded,
+		},
+		{
+			name:   "too many connections degrade the pool",
+			limits: limits,
+			setupChecker: func(fake *mocks.FakeDatabaseHealthChecker) {
+				fake.PoolStatsReturns(ports.PoolStats{TotalConns: 12, IdleConns: 5, MaxConns: 12}, nil)
+			},
+			expectedReady:      true,
+			expectedStatus:     ports.DependencyStatusDegraded,
+			expectedPoolStatus: ports.DependencyStatusDegraded,
+		},
+		{
+			name:   "unreadable pool stats degrade the pool",
+			limits: limits,
+			setupChecker: func(fake *mocks.FakeDatabaseHealthChecker) {
+				fake.PoolStatsReturns(ports.PoolStats{}, errors.New("pool closed"))
+			},
+			expectedReady:      true,
+			expectedStatus:     ports.DependencyStatusDegraded,
+			expectedPoolStatus: ports.DependencyStatusDegraded,
+		},
+		{
+			name:   "zero limits are not checked",
+			limits: queries.PoolLimits{},
+			setupChecker: func(fake *mocks.FakeDatabaseHealthChecker) {
+				fake.PoolStatsReturns(ports.PoolStats{TotalConns: 40}, nil)
+			},
+			expectedReady:      true,
+			expectedStatus:     "ok",
+			expectedPoolStatus: ports.DependencyStatusUp,
 		},
 	}
 
@@ -240,13 +294,19 @@ func TestFetchReadinessQueryHandler(t *testing.T) {
 			dbChecker := &mocks.FakeDatabaseHealthChecker{}
 			tc.setupChecker(dbChecker)
 
-			handler := queries.NewFetchReadinessQueryHandler(dbChecker, log, mc, tp)
+			handler := queries.NewFetchReadinessQueryHandler(dbChecker, tc.limits, log, mc, tp)
 
 			result, err := handler.Execute(t.Context(), queries.FetchReadinessQuery{})
 
 			require.NoError(t, err)
 			require.NotNil(t, result)
 			require.Equal(t, tc.expectedReady, result.Ready)
+			require.Equal(t, tc.expectedStatus, result.Status)
+
+			pool, ok := result.Checks["db_pool"]
+			require.True(t, ok)
+			require.Equal(t, tc.expectedPoolStatus, pool.Status)
+			require.Regexp(t, `^\d+ms$`, pool.Latency)
 		})
 	}
 }
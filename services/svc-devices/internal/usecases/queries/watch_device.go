@@ -0,0 +1,42 @@
+package queries
+
+import (
+	"context"
+
+	"github.com/architeacher/devices/pkg/decorator"
+	"github.com/architeacher/devices/pkg/logger"
+	"github.com/architeacher/devices/pkg/metrics"
+	"github.com/architeacher/devices/services/svc-devices/internal/domain/model"
+	"github.com/architeacher/devices/services/svc-devices/internal/ports"
+	otelTrace "go.opentelemetry.io/otel/trace"
+)
+
+type (
+	WatchDeviceQuery struct {
+		ID model.DeviceID
+	}
+
+	WatchDeviceQueryHandler = decorator.QueryHandler[WatchDeviceQuery, *ports.DeviceSubscription]
+
+	watchDeviceQueryHandler struct {
+		devicesService ports.DevicesService
+	}
+)
+
+func NewWatchDeviceQueryHandler(
+	svc ports.DevicesService,
+	log logger.Logger,
+	metricsClient metrics.Client,
+	tracerProvider otelTrace.TracerProvider,
+) WatchDeviceQueryHandler {
+	return decorator.ApplyQueryDecorators[WatchDeviceQuery, *ports.DeviceSubscription](
+		watchDeviceQueryHandler{devicesService: svc},
+		log,
+		metricsClient,
+		tracerProvider,
+	)
+}
+
+func (h watchDeviceQueryHandler) Execute(ctx context.Context, query WatchDeviceQuery) (*ports.DeviceSubscription, error) {
+	return h.devicesService.WatchDevice(ctx, query.ID)
+}
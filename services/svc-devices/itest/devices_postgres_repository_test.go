@@ -7,12 +7,22 @@ import (
 	"fmt"
 	"path/filepath"
 	"runtime"
+	"slices"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/architeacher/devices/pkg/logger"
+	"github.com/architeacher/devices/pkg/metrics/noop"
+	"github.com/architeacher/devices/services/svc-devices/internal/adapters/events"
+	"github.com/architeacher/devices/services/svc-devices/internal/adapters/inbound/jobs"
 	"github.com/architeacher/devices/services/svc-devices/internal/adapters/repos"
+	"github.com/architeacher/devices/services/svc-devices/internal/adapters/services"
+	"github.com/architeacher/devices/services/svc-devices/internal/config"
 	"github.com/architeacher/devices/services/svc-devices/internal/domain/model"
+	"github.com/architeacher/devices/services/svc-devices/internal/infrastructure"
+	"github.com/architeacher/devices/services/svc-devices/internal/infrastructure/migrations"
+	"github.com/architeacher/devices/services/svc-devices/internal/usecases/commands"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/stretchr/testify/suite"
 	"github.com/testcontainers/testcontainers-go"
@@ -25,7 +35,6 @@ const (
 	postgresDatabase = "devices_test"
 	postgresUsername = "test"
 	postgresPassword = "test"
-	migrateImage     = "migrate/migrate:v4.19.1"
 )
 
 type DevicesRepositoryIntegrationTestSuite struct {
@@ -76,6 +85,10 @@ func (s *DevicesRepositoryIntegrationTestSuite) SetupSuite() {
 
 func (s *DevicesRepositoryIntegrationTestSuite) TearDownSuite() {
 	if s.pool != nil {
+		if migrationsPath, err := getMigrationsPath(); err == nil {
+			s.NoError(migrations.RunDown(s.suiteCtx, s.pool, migrationsPath))
+		}
+
 		s.pool.Close()
 	}
 	if s.container != nil {
@@ -88,46 +101,15 @@ func (s *DevicesRepositoryIntegrationTestSuite) TearDownSuite() {
 
 func (s *DevicesRepositoryIntegrationTestSuite) SetupTest() {
 	ctx := s.T().Context()
-	_, err := s.pool.Exec(ctx, "TRUNCATE TABLE devices")
+	_, err := s.pool.Exec(ctx, "TRUNCATE TABLE devices, device_audit_log, device_history")
 	s.Require().NoError(err)
 }
 
 func (s *DevicesRepositoryIntegrationTestSuite) runMigrations() {
-	postgresPort, err := s.container.MappedPort(s.suiteCtx, "5432/tcp")
-	s.Require().NoError(err)
-
-	dbURL := fmt.Sprintf(
-		"postgres://%s:%s@host.docker.internal:%s/%s?sslmode=disable",
-		postgresUsername,
-		postgresPassword,
-		postgresPort.Port(),
-		postgresDatabase,
-	)
-
 	migrationsPath, err := getMigrationsPath()
 	s.Require().NoError(err)
 
-	migrateContainer, err := testcontainers.GenericContainer(s.suiteCtx, testcontainers.GenericContainerRequest{
-		ContainerRequest: testcontainers.ContainerRequest{
-			Image: migrateImage,
-			Cmd: []string{
-				"-path", "/migrations",
-				"-database", dbURL,
-				"up",
-			},
-			Mounts: testcontainers.Mounts(
-				testcontainers.BindMount(migrationsPath, "/migrations"),
-			),
-			WaitingFor: wait.ForExit().WithExitTimeout(30 * time.Second),
-		},
-		Started: true,
-	})
-	s.Require().NoError(err)
-	defer migrateContainer.Terminate(s.suiteCtx)
-
-	state, err := migrateContainer.State(s.suiteCtx)
-	s.Require().NoError(err)
-	s.Require().Equal(0, state.ExitCode, "migrations failed")
+	s.Require().NoError(migrations.RunUp(s.suiteCtx, s.pool, migrationsPath))
 }
 
 func getMigrationsPath() (string, error) {
@@ -156,6 +138,35 @@ func (s *DevicesRepositoryIntegrationTestSuite) seedDevices(ctx context.Context,
 	}
 }
 
+func (s *DevicesRepositoryIntegrationTestSuite) TestMigrations_VersionIncrementsAfterRunUp() {
+	ctx := s.T().Context()
+
+	migrationsPath, err := getMigrationsPath()
+	s.Require().NoError(err)
+
+	s.Require().NoError(migrations.RunDown(ctx, s.pool, migrationsPath))
+
+	migrator, err := migrations.New(s.pool, migrationsPath)
+	s.Require().NoError(err)
+	defer func() { s.NoError(migrator.Close()) }()
+
+	_, _, err = migrator.Version()
+	s.Require().ErrorIs(err, migrations.ErrNoVersion)
+
+	s.Require().NoError(migrations.RunUp(ctx, s.pool, migrationsPath))
+
+	version, dirty, err := migrator.Version()
+	s.Require().NoError(err)
+	s.False(dirty)
+	s.Equal(uint(6), version)
+
+	s.Require().NoError(migrator.Up(ctx), "re-running up must be a no-op")
+
+	version, _, err = migrator.Version()
+	s.Require().NoError(err)
+	s.Equal(uint(6), version)
+}
+
 func (s *DevicesRepositoryIntegrationTestSuite) TestCreate_Success() {
 	ctx := s.T().Context()
 
@@ -172,6 +183,214 @@ func (s *DevicesRepositoryIntegrationTestSuite) TestCreate_Success() {
 	s.Require().Equal(device.State, retrieved.State)
 }
 
+func (s *DevicesRepositoryIntegrationTestSuite) TestMetadata_RoundTrip() {
+	ctx := s.T().Context()
+
+	sensor := model.NewDevice("Sensor", "Bosch", model.StateAvailable)
+	sensor.Metadata = map[string]any{"color": "black"}
+	s.Require().NoError(s.repo.Create(ctx, sensor))
+
+	watch := model.NewDevice("Watch", "Garmin", model.StateAvailable)
+	watch.Metadata = map[string]any{"color": "white"}
+	s.Require().NoError(s.repo.Create(ctx, watch))
+
+	plain := model.NewDevice("Phone", "Apple", model.StateAvailable)
+	s.Require().NoError(s.repo.Create(ctx, plain))
+
+	retrieved, err := s.repo.FetchByID(ctx, sensor.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(map[string]any{"color": "black"}, retrieved.Metadata)
+
+	retrieved, err = s.repo.FetchByID(ctx, plain.ID)
+	s.Require().NoError(err)
+	s.Require().Empty(retrieved.Metadata, "a device without metadata is stored with an empty object")
+
+	matches, err := s.repo.GetByMetadataField(ctx, "color", "black")
+	s.Require().NoError(err)
+	s.Require().Len(matches, 1)
+	s.Require().Equal(sensor.ID, matches[0].ID)
+	s.Require().Equal(map[string]any{"color": "black"}, matches[0].Metadata)
+
+	watch.Metadata["color"] = "black"
+	s.Require().NoError(s.repo.Update(ctx, watch))
+
+	matches, err = s.repo.GetByMetadataField(ctx, "color", "black")
+	s.Require().NoError(err)
+	s.Require().Len(matches, 2)
+}
+
+func (s *DevicesRepositoryIntegrationTestSuite) TestHistory_RecordsEveryVersion() {
+	ctx := model.WithActor(s.T().Context(), "user-42")
+
+	svc := services.NewDevicesService(s.repo, events.NewDeviceEventBus())
+
+	device, err := svc.CreateDevice(ctx, "iPhone", "Apple", model.StateAvailable)
+	s.Require().NoError(err)
+
+	names := []string{"iPhone 13", "iPhone 14", "iPhone 15"}
+	for _, name := range names {
+		_, err = svc.UpdateDevice(ctx, device.ID, name, "Apple", model.StateAvailable)
+		s.Require().NoError(err)
+	}
+
+	history, err := s.repo.GetHistory(ctx, device.ID)
+	s.Require().NoError(err)
+	s.Require().Len(history, len(names))
+
+	for index, entry := range history {
+		s.Equal(index+1, entry.Version)
+		s.Equal(names[index], entry.Name)
+		s.Equal(device.ID, entry.DeviceID)
+		s.Equal(model.HistoryOperationUpdate, entry.Operation)
+		s.Equal("user-42", entry.ChangedBy)
+
+		if index > 0 {
+			s.False(entry.ChangedAt.Before(history[index-1].ChangedAt), "entries are in chronological order")
+		}
+	}
+
+	s.Require().NoError(svc.DeleteDevice(s.T().Context(), device.ID))
+
+	history, err = s.repo.GetHistory(ctx, device.ID)
+	s.Require().NoError(err)
+	s.Require().Len(history, len(names)+1, "the history outlives the device")
+	s.Equal("iPhone 15", history[len(names)].Name)
+	s.Equal(model.HistoryOperationDelete, history[len(names)].Operation)
+	s.Empty(history[len(names)].ChangedBy)
+
+	_, err = s.pool.Exec(ctx, "DELETE FROM device_history WHERE device_id = $1", device.ID.String())
+	s.Require().ErrorContains(err, "append-only")
+}
+
+func (s *DevicesRepositoryIntegrationTestSuite) TestHistory_ConcurrentUpdatesGetConsecutiveVersions() {
+	ctx := s.T().Context()
+
+	device := model.NewDevice("iPhone", "Apple", model.StateAvailable)
+	s.Require().NoError(s.repo.Create(ctx, device))
+
+	const updates = 10
+
+	var wg sync.WaitGroup
+	errs := make(chan error, updates)
+
+	for index := range updates {
+		wg.Go(func() {
+			update := *device
+			update.Name = fmt.Sprintf("iPhone %d", index)
+			update.UpdatedAt = time.Now().UTC()
+			errs <- s.repo.Update(ctx, &update)
+		})
+	}
+
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		s.Require().NoError(err)
+	}
+
+	history, err := s.repo.GetHistory(ctx, device.ID)
+	s.Require().NoError(err)
+	s.Require().Len(history, updates)
+
+	for index, entry := range history {
+		s.Equal(index+1, entry.Version)
+	}
+}
+
+func (s *DevicesRepositoryIntegrationTestSuite) TestListChangedSince() {
+	ctx := s.T().Context()
+
+	base := time.Now().UTC().Truncate(time.Microsecond).Add(-time.Hour)
+
+	devices := make([]*model.Device, 0, 4)
+	for index, name := range []string{"oldest", "old", "recent", "newest"} {
+		device := model.NewDevice(name, "Brand", model.StateAvailable)
+		device.CreatedAt = base
+		device.UpdatedAt = base.Add(time.Duration(index) * time.Minute)
+		devices = append(devices, device)
+	}
+
+	// Seed out of chronological order, so the result order comes from updated_at.
+	s.seedDevices(ctx, []*model.Device{devices[2], devices[0], devices[3], devices[1]})
+
+	names := func(devices []*model.Device) []string {
+		result := make([]string, 0, len(devices))
+		for _, device := range devices {
+			result = append(result, device.Name)
+		}
+
+		return result
+	}
+
+	changed, err := s.repo.ListChangedSince(ctx, devices[0].UpdatedAt, model.DeviceID{}, 0)
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"old", "recent", "newest"}, names(changed), "since is exclusive")
+
+	changed, err = s.repo.ListChangedSince(ctx, base.Add(-time.Second), model.DeviceID{}, 2)
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"oldest", "old"}, names(changed))
+
+	last := changed[len(changed)-1]
+
+	changed, err = s.repo.ListChangedSince(ctx, last.UpdatedAt, last.ID, 2)
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"recent", "newest"}, names(changed), "polling resumes after the last change seen")
+
+	changed, err = s.repo.ListChangedSince(ctx, devices[3].UpdatedAt, devices[3].ID, 10)
+	s.Require().NoError(err)
+	s.Require().Empty(changed)
+}
+
+func (s *DevicesRepositoryIntegrationTestSuite) TestListChangedSince_TiesAcrossPageBoundary() {
+	ctx := s.T().Context()
+
+	updatedAt := time.Now().UTC().Truncate(time.Microsecond).Add(-time.Hour)
+
+	// Five devices changed in the same instant, read two at a time: every page boundary
+	// falls between devices sharing updated_at.
+	devices := make([]*model.Device, 0, 5)
+	for index := range cap(devices) {
+		device := model.NewDevice(fmt.Sprintf("device-%d", index), "Brand", model.StateAvailable)
+		device.CreatedAt = updatedAt
+		device.UpdatedAt = updatedAt
+		devices = append(devices, device)
+	}
+
+	s.seedDevices(ctx, devices)
+
+	expected := make([]string, 0, len(devices))
+	for _, device := range devices {
+		expected = append(expected, device.ID.String())
+	}
+
+	slices.Sort(expected)
+
+	var (
+		seen    []string
+		since   = updatedAt.Add(-time.Second)
+		sinceID model.DeviceID
+	)
+
+	for range len(devices) {
+		page, err := s.repo.ListChangedSince(ctx, since, sinceID, 2)
+		s.Require().NoError(err)
+
+		if len(page) == 0 {
+			break
+		}
+
+		for _, device := range page {
+			seen = append(seen, device.ID.String())
+		}
+
+		last := page[len(page)-1]
+		since, sinceID = last.UpdatedAt, last.ID
+	}
+
+	s.Require().Equal(expected, seen)
+}
+
 func (s *DevicesRepositoryIntegrationTestSuite) TestCreate_AllStates() {
 	ctx := s.T().Context()
 
@@ -685,6 +904,139 @@ func (s *DevicesRepositoryIntegrationTestSuite) TestUpdate_StateTransition() {
 	}
 }
 
+func (s *DevicesRepositoryIntegrationTestSuite) seedBatch(ctx context.Context, count int) []*model.Device {
+	devices := make([]*model.Device, count)
+	for index := range devices {
+		devices[index] = model.NewDevice(fmt.Sprintf("Device %d", index+1), "Brand", model.StateAvailable)
+	}
+	s.seedDevices(ctx, devices)
+
+	return devices
+}
+
+// renameAll returns updated copies of devices, leaving the seeded ones untouched.
+func renameAll(devices []*model.Device) []*model.Device {
+	updates := make([]*model.Device, len(devices))
+	for index, device := range devices {
+		update := *device
+		update.Name = device.Name + " updated"
+		update.State = model.StateInUse
+		update.UpdatedAt = time.Now().UTC()
+		updates[index] = &update
+	}
+
+	return updates
+}
+
+func (s *DevicesRepositoryIntegrationTestSuite) requireUnchanged(ctx context.Context, devices []*model.Device) {
+	for _, device := range devices {
+		stored, err := s.repo.FetchByID(ctx, device.ID)
+		s.Require().NoError(err)
+		s.Require().Equal(device.Name, stored.Name)
+		s.Require().Equal(model.StateAvailable, stored.State)
+	}
+}
+
+func (s *DevicesRepositoryIntegrationTestSuite) TestUpdateBatch_AllSucceed() {
+	ctx := s.T().Context()
+
+	updates := renameAll(s.seedBatch(ctx, 5))
+
+	for index, err := range s.repo.UpdateBatch(ctx, updates) {
+		s.Require().NoError(err, "device %d", index)
+	}
+
+	for _, update := range updates {
+		stored, err := s.repo.FetchByID(ctx, update.ID)
+		s.Require().NoError(err)
+		s.Require().Equal(update.Name, stored.Name)
+		s.Require().Equal(model.StateInUse, stored.State)
+	}
+}
+
+func (s *DevicesRepositoryIntegrationTestSuite) TestUpdateBatch_DuplicateDeviceGetsConsecutiveVersions() {
+	ctx := s.T().Context()
+
+	updates := renameAll(s.seedBatch(ctx, 1))
+
+	again := *updates[0]
+	again.Name += " again"
+	updates = append(updates, &again)
+
+	for index, err := range s.repo.UpdateBatch(ctx, updates) {
+		s.Require().NoError(err, "device %d", index)
+	}
+
+	history, err := s.repo.GetHistory(ctx, again.ID)
+	s.Require().NoError(err)
+	s.Require().Len(history, 2)
+
+	for index, entry := range history {
+		s.Equal(index+1, entry.Version)
+		s.Equal(updates[index].Name, entry.Name)
+	}
+}
+
+func (s *DevicesRepositoryIntegrationTestSuite) TestUpdateBatch_FailureRollsBackEveryUpdate() {
+	ctx := s.T().Context()
+
+	devices := s.seedBatch(ctx, 5)
+	updates := renameAll(devices)
+	updates[2] = model.NewDevice("Never seeded", "Brand", model.StateInUse)
+
+	errs := s.repo.UpdateBatch(ctx, updates)
+
+	s.Require().Len(errs, 5)
+	s.Require().NoError(errs[0])
+	s.Require().NoError(errs[1])
+	s.Require().ErrorIs(errs[2], model.ErrDeviceNotFound)
+	s.Require().ErrorIs(errs[3], model.ErrBatchAborted)
+	s.Require().ErrorIs(errs[4], model.ErrBatchAborted)
+
+	s.requireUnchanged(ctx, devices)
+}
+
+func (s *DevicesRepositoryIntegrationTestSuite) TestUpdateBatch_DroppedConnectionFailsEveryDevice() {
+	ctx := s.T().Context()
+
+	// The trigger terminates the backend running the batch once the third device is updated.
+	_, err := s.pool.Exec(ctx, `
+		CREATE FUNCTION terminate_batch_backend() RETURNS trigger AS $$
+		BEGIN
+			IF NEW.name = 'terminate backend' THEN
+				PERFORM pg_terminate_backend(pg_backend_pid());
+			END IF;
+			RETURN NEW;
+		END;
+		$$ LANGUAGE plpgsql;
+
+		CREATE TRIGGER terminate_batch_backend BEFORE UPDATE ON devices
+			FOR EACH ROW EXECUTE FUNCTION terminate_batch_backend();
+	`)
+	s.Require().NoError(err)
+
+	defer func() {
+		_, err := s.pool.Exec(context.Background(), `
+			DROP TRIGGER terminate_batch_backend ON devices;
+			DROP FUNCTION terminate_batch_backend();
+		`)
+		s.Require().NoError(err)
+	}()
+
+	devices := s.seedBatch(ctx, 5)
+	updates := renameAll(devices)
+	updates[2].Name = "terminate backend"
+
+	errs := s.repo.UpdateBatch(ctx, updates)
+
+	s.Require().Len(errs, 5)
+	for index, err := range errs {
+		s.Require().ErrorIs(err, model.ErrDatabaseConnection, "device %d", index)
+	}
+
+	s.requireUnchanged(ctx, devices)
+}
+
 func (s *DevicesRepositoryIntegrationTestSuite) TestDelete_Success() {
 	ctx := s.T().Context()
 
@@ -904,3 +1256,265 @@ func (s *DevicesRepositoryIntegrationTestSuite) TestList_MultiValueCombinedFilte
 	}
 }
 
+func (s *DevicesRepositoryIntegrationTestSuite) TestSearch_MatchesNameAndBrand() {
+	ctx := s.T().Context()
+
+	s.seedDevices(ctx, []*model.Device{
+		model.NewDevice("iPhone", "Apple", model.StateAvailable),
+		model.NewDevice("Android phone", "Google", model.StateAvailable),
+		model.NewDevice("laptop", "Dell", model.StateAvailable),
+	})
+
+	list, err := s.repo.Search(ctx, "phone", model.DeviceFilter{Page: 1, Size: 10})
+
+	s.Require().NoError(err)
+	s.Require().Len(list.Devices, 2)
+	s.Require().Equal(uint(2), list.Pagination.TotalItems)
+
+	s.Require().Equal("Android phone", list.Devices[0].Name, "full-text matches rank first")
+	s.Require().Equal("iPhone", list.Devices[1].Name)
+}
+
+func (s *DevicesRepositoryIntegrationTestSuite) TestSearch_Pagination() {
+	ctx := s.T().Context()
+
+	for index := 0; index < 5; index++ {
+		s.seedDevice(ctx, model.NewDevice(fmt.Sprintf("phone %d", index+1), "Brand", model.StateAvailable))
+		time.Sleep(time.Millisecond)
+	}
+
+	s.seedDevice(ctx, model.NewDevice("laptop", "Brand", model.StateAvailable))
+
+	filter := model.DeviceFilter{Page: 1, Size: 2, Sort: []string{"name"}}
+
+	seen := make(map[string]struct{})
+
+	for page := uint(1); page <= 3; page++ {
+		filter.Page = page
+
+		list, err := s.repo.Search(ctx, "phone", filter)
+
+		s.Require().NoError(err)
+		s.Require().Equal(uint(5), list.Pagination.TotalItems)
+		s.Require().Equal(uint(3), list.Pagination.TotalPages)
+		s.Require().Equal(page < 3, list.Pagination.HasNext)
+		s.Require().Equal(page > 1, list.Pagination.HasPrevious)
+
+		for _, device := range list.Devices {
+			seen[device.Name] = struct{}{}
+		}
+	}
+
+	s.Require().Len(seen, 5, "pages neither overlap nor skip devices")
+	s.Require().NotContains(seen, "laptop")
+}
+
+func (s *DevicesRepositoryIntegrationTestSuite) TestListByIDs_KeepsRequestedOrder() {
+	ctx := s.T().Context()
+
+	first := model.NewDevice("first", "Apple", model.StateAvailable)
+	second := model.NewDevice("second", "Google", model.StateInUse)
+	s.seedDevices(ctx, []*model.Device{first, second})
+
+	missing := model.NewDeviceID()
+
+	devices, err := s.repo.ListByIDs(ctx, []model.DeviceID{second.ID, missing, first.ID})
+
+	s.Require().NoError(err)
+	s.Require().Len(devices, 3)
+	s.Require().Equal(second.ID, devices[0].ID)
+	s.Require().Nil(devices[1])
+	s.Require().Equal(first.ID, devices[2].ID)
+}
+
+func (s *DevicesRepositoryIntegrationTestSuite) TestPoolStats_ReflectsOpenConnections() {
+	ctx := s.T().Context()
+
+	s.Require().NoError(s.repo.Ping(ctx))
+
+	stats, err := s.repo.PoolStats(ctx)
+
+	s.Require().NoError(err)
+	s.Require().Positive(stats.TotalConns)
+	s.Require().Equal(s.pool.Config().MaxConns, stats.MaxConns)
+}
+
+func (s *DevicesRepositoryIntegrationTestSuite) TestReadReplica_ServesReadsWhileWritesGoToPrimary() {
+	ctx := s.T().Context()
+
+	// Both pools reach the same container, the second one standing in for a replica.
+	primary, err := pgxpool.New(ctx, s.pool.Config().ConnString())
+	s.Require().NoError(err)
+	defer primary.Close()
+
+	replica, err := pgxpool.New(ctx, s.pool.Config().ConnString())
+	s.Require().NoError(err)
+	defer replica.Close()
+
+	log := logger.NewTestLogger()
+	repo := repos.NewDevicesRepository(
+		primary,
+		repos.NewPgxScanner(),
+		repos.NewCriteriaTranslator(&log),
+		log,
+		repos.WithReadReplica(replica),
+	)
+
+	device := model.NewDevice("Replica Phone", "Apple", model.StateAvailable)
+	s.Require().NoError(repo.Create(ctx, device))
+
+	device.Name = "Renamed Phone"
+	s.Require().NoError(repo.Update(ctx, device))
+
+	primaryWrites := primary.Stat().AcquireCount()
+	s.Require().Equal(int64(2), primaryWrites)
+	s.Require().Zero(replica.Stat().AcquireCount())
+
+	fetched, err := repo.FetchByID(ctx, device.ID)
+	s.Require().NoError(err)
+	s.Require().Equal("Renamed Phone", fetched.Name)
+
+	list, err := repo.List(ctx, model.DeviceFilter{Page: 1, Size: 10})
+	s.Require().NoError(err)
+	s.Require().Len(list.Devices, 1)
+
+	_, err = repo.ListByIDs(ctx, []model.DeviceID{device.ID})
+	s.Require().NoError(err)
+
+	s.Require().Equal(int64(3), replica.Stat().AcquireCount())
+	s.Require().Equal(primaryWrites, primary.Stat().AcquireCount(), "reads must not touch the primary")
+
+	_, err = repo.FetchByIDForUpdate(ctx, device.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(primaryWrites+1, primary.Stat().AcquireCount(), "reads before a write go to the primary")
+
+	s.Require().NoError(repo.Delete(ctx, device.ID))
+	s.Require().Equal(primaryWrites+2, primary.Stat().AcquireCount())
+}
+
+func (s *DevicesRepositoryIntegrationTestSuite) TestDeviceRecoveryJob_ReleasesStaleInUseDevices() {
+	ctx := s.T().Context()
+
+	stale := model.NewDevice("stale", "Apple", model.StateInUse)
+	stale.UpdatedAt = time.Now().UTC().Add(-2 * time.Hour)
+
+	active := model.NewDevice("active", "Google", model.StateInUse)
+
+	inactive := model.NewDevice("inactive", "Samsung", model.StateInactive)
+	inactive.UpdatedAt = stale.UpdatedAt
+
+	s.seedDevices(ctx, []*model.Device{stale, active, inactive})
+
+	log := logger.NewTestLogger()
+	handler := commands.NewRecoverStaleDevicesCommandHandler(
+		services.NewDevicesService(s.repo, events.NewDeviceEventBus()),
+		log,
+		noop.NewMetricsClient(),
+		infrastructure.NewNoopTracerProvider(),
+	)
+
+	job := jobs.NewDeviceRecoveryJob(handler, config.DeviceRecovery{
+		RecoveryTimeout: time.Hour,
+		BatchSize:       1,
+	}, log)
+
+	s.Require().NoError(job.Run(ctx))
+
+	recovered, err := s.repo.FetchByID(ctx, stale.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(model.StateAvailable, recovered.State)
+	s.Require().True(recovered.UpdatedAt.After(stale.UpdatedAt))
+
+	stillInUse, err := s.repo.FetchByID(ctx, active.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(model.StateInUse, stillInUse.State, "recently used devices are kept")
+
+	untouched, err := s.repo.FetchByID(ctx, inactive.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(model.StateInactive, untouched.State, "only in-use devices are recovered")
+
+	history, err := s.repo.GetHistory(ctx, stale.ID)
+	s.Require().NoError(err)
+	s.Require().Len(history, 1)
+	s.Equal(model.HistoryOperationRecover, history[0].Operation)
+	s.Equal(model.StateAvailable, history[0].State)
+}
+
+func (s *DevicesRepositoryIntegrationTestSuite) TestAuditLog_RecordsMutations() {
+	ctx := model.WithActor(s.T().Context(), "user-42")
+
+	svc := services.NewAuditedDevicesService(
+		services.NewDevicesService(s.repo, events.NewDeviceEventBus()),
+		repos.NewPostgresAuditLogger(s.pool),
+		logger.NewTestLogger(),
+	)
+
+	device, err := svc.CreateDevice(ctx, "iPhone", "Apple", model.StateAvailable)
+	s.Require().NoError(err)
+
+	_, err = svc.UpdateDevice(ctx, device.ID, "iPhone 15", "Apple", model.StateAvailable)
+	s.Require().NoError(err)
+
+	s.Require().NoError(svc.DeleteDevice(ctx, device.ID))
+
+	rows, err := s.pool.Query(ctx, `
+		SELECT operation, actor, before->>'name', after->>'name'
+		FROM device_audit_log
+		WHERE device_id = $1
+		ORDER BY id
+	`, device.ID.String())
+	s.Require().NoError(err)
+	defer rows.Close()
+
+	type auditRow struct {
+		operation  string
+		actor      *string
+		beforeName *string
+		afterName  *string
+	}
+
+	var entries []auditRow
+	for rows.Next() {
+		var row auditRow
+		s.Require().NoError(rows.Scan(&row.operation, &row.actor, &row.beforeName, &row.afterName))
+		entries = append(entries, row)
+	}
+	s.Require().NoError(rows.Err())
+
+	s.Require().Len(entries, 3)
+
+	s.Equal(model.AuditOperationCreate, entries[0].operation)
+	s.Nil(entries[0].beforeName)
+	s.Equal("iPhone", *entries[0].afterName)
+
+	s.Equal(model.AuditOperationUpdate, entries[1].operation)
+	s.Equal("iPhone", *entries[1].beforeName)
+	s.Equal("iPhone 15", *entries[1].afterName)
+
+	s.Equal(model.AuditOperationDelete, entries[2].operation)
+	s.Equal("iPhone 15", *entries[2].beforeName)
+	s.Nil(entries[2].afterName)
+
+	for _, entry := range entries {
+		s.Require().NotNil(entry.actor)
+		s.Equal("user-42", *entry.actor)
+	}
+}
+
+func (s *DevicesRepositoryIntegrationTestSuite) TestAuditLog_IsAppendOnly() {
+	ctx := s.T().Context()
+
+	device := model.NewDevice("iPhone", "Apple", model.StateAvailable)
+	s.Require().NoError(repos.NewPostgresAuditLogger(s.pool).Log(ctx, model.AuditEntry{
+		Timestamp: time.Now().UTC(),
+		Operation: model.AuditOperationCreate,
+		DeviceID:  device.ID,
+		After:     device,
+	}))
+
+	_, err := s.pool.Exec(ctx, "UPDATE device_audit_log SET actor = 'someone-else'")
+	s.Require().ErrorContains(err, "append-only")
+
+	_, err = s.pool.Exec(ctx, "DELETE FROM device_audit_log")
+	s.Require().ErrorContains(err, "append-only")
+}
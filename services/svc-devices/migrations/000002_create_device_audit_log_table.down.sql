@@ -0,0 +1,5 @@
+DROP TRIGGER IF EXISTS device_audit_log_append_only ON device_audit_log;
+DROP FUNCTION IF EXISTS reject_device_audit_log_change();
+DROP INDEX IF EXISTS idx_device_audit_log_occurred_at;
+DROP INDEX IF EXISTS idx_device_audit_log_device_id;
+DROP TABLE IF EXISTS device_audit_log;
@@ -0,0 +1,30 @@
+CREATE TABLE IF NOT EXISTS device_audit_log (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    occurred_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    operation VARCHAR(32) NOT NULL,
+    device_id UUID NOT NULL,
+    actor VARCHAR(255),
+    before JSONB,
+    after JSONB
+);
+
+CREATE INDEX idx_device_audit_log_device_id ON device_audit_log(device_id, occurred_at);
+CREATE INDEX idx_device_audit_log_occurred_at ON device_audit_log(occurred_at DESC);
+
+CREATE FUNCTION reject_device_audit_log_change() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'device_audit_log is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER device_audit_log_append_only
+    BEFORE UPDATE OR DELETE ON device_audit_log
+    FOR EACH ROW EXECUTE FUNCTION reject_device_audit_log_change();
+
+COMMENT ON TABLE device_audit_log IS 'Append-only trail of device mutations';
+COMMENT ON COLUMN device_audit_log.occurred_at IS 'Timestamp when the mutation was applied';
+COMMENT ON COLUMN device_audit_log.operation IS 'Mutation kind: create, update, delete or recover';
+COMMENT ON COLUMN device_audit_log.device_id IS 'Identifier of the mutated device, kept after the device is deleted';
+COMMENT ON COLUMN device_audit_log.actor IS 'Subject of the caller that requested the mutation, if known';
+COMMENT ON COLUMN device_audit_log.before IS 'Device snapshot before the mutation, null for a creation';
+COMMENT ON COLUMN device_audit_log.after IS 'Device snapshot after the mutation, null for a deletion';
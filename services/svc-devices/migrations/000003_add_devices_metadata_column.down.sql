@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_devices_metadata;
+ALTER TABLE devices DROP COLUMN IF EXISTS metadata;
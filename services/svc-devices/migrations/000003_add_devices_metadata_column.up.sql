@@ -0,0 +1,5 @@
+ALTER TABLE devices ADD COLUMN IF NOT EXISTS metadata JSONB NOT NULL DEFAULT '{}';
+
+CREATE INDEX idx_devices_metadata ON devices USING GIN (metadata jsonb_path_ops);
+
+COMMENT ON COLUMN devices.metadata IS 'Type-specific device properties, such as a sensor sampling rate, as a JSON object';
@@ -0,0 +1 @@
+CREATE INDEX IF NOT EXISTS idx_devices_updated_at_id ON devices(updated_at, id);
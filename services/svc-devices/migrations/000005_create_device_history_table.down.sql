@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS device_history_append_only ON device_history;
+DROP FUNCTION IF EXISTS reject_device_history_change();
+DROP TABLE IF EXISTS device_history;
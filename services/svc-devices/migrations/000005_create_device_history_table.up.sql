@@ -0,0 +1,28 @@
+CREATE TABLE IF NOT EXISTS device_history (
+    event_id UUID PRIMARY KEY,
+    device_id UUID NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    brand VARCHAR(255) NOT NULL,
+    state device_state NOT NULL,
+    version INT NOT NULL,
+    changed_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    changed_by TEXT,
+    CONSTRAINT device_history_device_version_unique UNIQUE (device_id, version)
+);
+
+CREATE FUNCTION reject_device_history_change() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'device_history is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER device_history_append_only
+    BEFORE UPDATE OR DELETE ON device_history
+    FOR EACH ROW EXECUTE FUNCTION reject_device_history_change();
+
+COMMENT ON TABLE device_history IS 'Immutable snapshots of every device version, for point-in-time reconstruction';
+COMMENT ON COLUMN device_history.event_id IS 'Identifier of the snapshot';
+COMMENT ON COLUMN device_history.device_id IS 'Identifier of the device, kept after the device is deleted';
+COMMENT ON COLUMN device_history.version IS 'Snapshot sequence number per device, starting at 1';
+COMMENT ON COLUMN device_history.changed_at IS 'Timestamp when the snapshot was recorded';
+COMMENT ON COLUMN device_history.changed_by IS 'Subject of the caller that requested the change, if known';
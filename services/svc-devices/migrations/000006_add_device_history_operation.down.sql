@@ -0,0 +1,2 @@
+ALTER TABLE device_history DROP COLUMN IF EXISTS operation;
+DROP TYPE IF EXISTS device_history_operation;
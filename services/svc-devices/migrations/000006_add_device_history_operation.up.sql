@@ -0,0 +1,6 @@
+CREATE TYPE device_history_operation AS ENUM ('update', 'delete', 'recover');
+
+ALTER TABLE device_history
+    ADD COLUMN IF NOT EXISTS operation device_history_operation NOT NULL DEFAULT 'update';
+
+COMMENT ON COLUMN device_history.operation IS 'Change the snapshot records: update, delete, or recover by the stale device job';
@@ -12,26 +12,26 @@ import (
 	"github.com/architeacher/devices/pkg/logger"
 	"github.com/architeacher/devices/pkg/metrics/noop"
 	devicev1 "github.com/architeacher/devices/pkg/proto/device/v1"
+	"github.com/architeacher/devices/services/svc-devices/internal/adapters/events"
 	inboundgrpc "github.com/architeacher/devices/services/svc-devices/internal/adapters/inbound/grpc"
 	"github.com/architeacher/devices/services/svc-devices/internal/adapters/repos"
 	"github.com/architeacher/devices/services/svc-devices/internal/adapters/services"
+	"github.com/architeacher/devices/services/svc-devices/internal/infrastructure/migrations"
 	"github.com/architeacher/devices/services/svc-devices/internal/usecases"
+	"github.com/architeacher/devices/services/svc-devices/internal/usecases/queries"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/testcontainers/testcontainers-go"
 	"github.com/testcontainers/testcontainers-go/modules/postgres"
-	"github.com/testcontainers/testcontainers-go/network"
 	"github.com/testcontainers/testcontainers-go/wait"
 	otelNoop "go.opentelemetry.io/otel/trace/noop"
 	"google.golang.org/grpc"
 )
 
 const (
-	postgresImage        = "postgres:18-alpine"
-	postgresDatabase     = "devices_test"
-	postgresUsername     = "test"
-	postgresPassword     = "test"
-	postgresNetworkAlias = "postgres"
-	migrateImage         = "migrate/migrate:v4.19.1"
+	postgresImage    = "postgres:18-alpine"
+	postgresDatabase = "devices_test"
+	postgresUsername = "test"
+	postgresPassword = "test"
 )
 
 // TestServer provides a gRPC server with PostgreSQL for integration testing.
@@ -66,19 +66,11 @@ func New(ctx context.Context, opts ...Option) (*TestServer, error) {
 	}
 	containerCtx, containerStop := context.WithTimeout(ctx, 5*time.Minute)
 
-	testNetwork, err := network.New(containerCtx)
-	if err != nil {
-		containerStop()
-
-		return nil, fmt.Errorf("creating test network: %w", err)
-	}
-
 	container, err := postgres.Run(containerCtx,
 		postgresImage,
 		postgres.WithDatabase(postgresDatabase),
 		postgres.WithUsername(postgresUsername),
 		postgres.WithPassword(postgresPassword),
-		network.WithNetwork([]string{postgresNetworkAlias}, testNetwork),
 		testcontainers.WithWaitStrategy(
 			wait.ForLog("database system is ready to accept connections").
 				WithOccurrence(2).
@@ -107,7 +99,7 @@ func New(ctx context.Context, opts ...Option) (*TestServer, error) {
 		return nil, fmt.Errorf("creating database pool: %w", err)
 	}
 
-	if err := runMigrations(containerCtx, testNetwork.Name, cfg.migrationsPath); err != nil {
+	if err := runMigrations(containerCtx, pool, cfg.migrationsPath); err != nil {
 		pool.Close()
 		container.Terminate(containerCtx)
 		containerStop()
@@ -120,11 +112,12 @@ func New(ctx context.Context, opts ...Option) (*TestServer, error) {
 	tracerProvider := otelNoop.NewTracerProvider()
 
 	deviceRepo := repos.NewDevicesRepository(pool, repos.NewPgxScanner(), repos.NewCriteriaTranslator(&log), log)
-	deviceSvc := services.NewDevicesService(deviceRepo)
+	deviceSvc := services.NewDevicesService(deviceRepo, events.NewDeviceEventBus())
 
 	app := usecases.NewApplication(
 		deviceSvc,
 		deviceRepo,
+		queries.PoolLimits{},
 		log,
 		tracerProvider,
 		metricsClient,
@@ -189,61 +182,19 @@ func (s *TestServer) Close() {
 	}
 }
 
-func runMigrations(ctx context.Context, networkName, customPath string) error {
-	dbURL := fmt.Sprintf(
-		"postgres://%s:%s@%s:5432/%s?sslmode=disable",
-		postgresUsername,
-		postgresPassword,
-		postgresNetworkAlias,
-		postgresDatabase,
-	)
-
+func runMigrations(ctx context.Context, pool *pgxpool.Pool, customPath string) error {
 	migrationsPath := customPath
-	var err error
 	if migrationsPath == "" {
+		var err error
+
 		migrationsPath, err = getMigrationsPath()
 		if err != nil {
 			return fmt.Errorf("getting migrations path: %w", err)
 		}
 	}
 
-	migrateContainer, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
-		ContainerRequest: testcontainers.ContainerRequest{
-			Image:    migrateImage,
-			Networks: []string{networkName},
-			Cmd: []string{
-				"-path", "/migrations",
-				"-database", dbURL,
-				"up",
-			},
-			Mounts: testcontainers.Mounts(
-				testcontainers.BindMount(migrationsPath, "/migrations"),
-			),
-			WaitingFor: wait.ForExit().WithExitTimeout(30 * time.Second),
-		},
-		Started: true,
-	})
-	if err != nil {
-		return fmt.Errorf("running migrate container: %w", err)
-	}
-	defer migrateContainer.Terminate(ctx)
-
-	state, err := migrateContainer.State(ctx)
-	if err != nil {
-		return fmt.Errorf("getting migrate container state: %w", err)
-	}
-
-	if state.ExitCode != 0 {
-		logs, _ := migrateContainer.Logs(ctx)
-		var logContent string
-		if logs != nil {
-			defer logs.Close()
-			buf := make([]byte, 4096)
-			n, _ := logs.Read(buf)
-			logContent = string(buf[:n])
-		}
-
-		return fmt.Errorf("migrations failed with exit code %d, path: %s, logs: %s", state.ExitCode, migrationsPath, logContent)
+	if err := migrations.RunUp(ctx, pool, migrationsPath); err != nil {
+		return fmt.Errorf("applying migrations from %s: %w", migrationsPath, err)
 	}
 
 	return nil